@@ -0,0 +1,121 @@
+//The build manifest, which decides which compiled files are up to date through content hashes
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// BuildManifestFileName is the name of the file kept in CompiledOutputPath that holds the content hashes of the translation text files (and the settings) that the compiled language files were built from
+const BuildManifestFileName = "BuildManifest.json"
+
+// The contents of BuildManifestFileName
+type buildManifest struct {
+	SettingsHash string                           //The hash of the settings that change the compiled files (see buildSettingsHash())
+	Languages    map[string]buildManifestLanguage //Keyed to the language identifier
+}
+type buildManifestLanguage struct {
+	InputFileName string
+	InputHash     string //The sha256 of the translation text file’s contents
+}
+
+// Reads and updates of the build manifest are done one at a time, as languages are processed in parallel
+var buildManifestLock sync.Mutex
+
+// Returns the hash of the settings that change the contents of the compiled files. Keys and signatures are checked separately when the compiled files are read
+func (settings *ProcessSettings) buildSettingsHash() string {
+	b, _ := json.Marshal(struct {
+		DefaultLanguage        string
+		CompressCompiled       bool
+		CompressionLevel       int
+		AllowBigStrings        bool
+		AllowJSONTrailingComma bool
+		YAMLStrictStrings      bool
+		I18nextJSON            bool
+		LanguageAliases        map[string]string
+		CombinedDictionary     bool
+		Encrypted              bool
+	}{
+		settings.DefaultLanguage,
+		settings.CompressCompiled,
+		settings.CompressionLevel,
+		settings.AllowBigStrings,
+		settings.AllowJSONTrailingComma,
+		settings.YAMLStrictStrings,
+		settings.I18nextJSON,
+		settings.LanguageAliases,
+		settings.CombinedDictionary,
+		settings.encryptionKey != nil,
+	})
+	hashSumBytes := sha256.Sum256(b)
+	return hex.EncodeToString(hashSumBytes[:])
+}
+
+// Returns the hash of a translation text file’s contents
+func (settings *ProcessSettings) hashInputFile(fileName string) (string, error) {
+	b, err := settings.readFile(settings.InputPath + fileName)
+	if err != nil {
+		return "", err
+	}
+	hashSumBytes := sha256.Sum256(b)
+	return hex.EncodeToString(hashSumBytes[:]), nil
+}
+
+// Reads the build manifest with the given read function. A missing or unreadable manifest is returned empty, which causes all languages to be compiled again
+func readBuildManifest(readFile func(name string) ([]byte, error), filePath string) buildManifest {
+	var manifest buildManifest
+	if b, err := readFile(filePath); err != nil || json.Unmarshal(b, &manifest) != nil {
+		manifest = buildManifest{}
+	}
+	if manifest.Languages == nil {
+		manifest.Languages = make(map[string]buildManifestLanguage)
+	}
+	return manifest
+}
+
+// Returns if the build manifest shows that the language’s compiled file was built from the translation text file with the given hash and from the current settings
+func (settings *ProcessSettings) isBuildCurrent(pf *ProcessedFile, inputHash string) bool {
+	_, mismatch := settings.buildManifestMismatch(pf, inputHash)
+	return !mismatch
+}
+
+// Returns if the build manifest does not match the translation text file with the given hash or the current settings, and why (PWR_NotInManifest, PWR_ChangedSettings, or PWR_ChangedHash)
+func (settings *ProcessSettings) buildManifestMismatch(pf *ProcessedFile, inputHash string) (reason PlannedWriteReason, mismatch bool) {
+	buildManifestLock.Lock()
+	defer buildManifestLock.Unlock()
+	manifest := readBuildManifest(settings.readFile, settings.CompiledOutputPath+BuildManifestFileName)
+	if lang, ok := manifest.Languages[pf.LangIdentifier]; !ok {
+		return PWR_NotInManifest, true
+	} else if manifest.SettingsHash != settings.buildSettingsHash() {
+		return PWR_ChangedSettings, true
+	} else if lang.InputFileName != pf.InputFileName || lang.InputHash != inputHash {
+		return PWR_ChangedHash, true
+	}
+	return 0, false
+}
+
+// Stores the hash of the translation text file that the language’s compiled file was just built from. If the settings changed, the other languages are removed from the manifest
+func (settings *ProcessSettings) updateBuildManifest(pf *ProcessedFile, inputHash string) error {
+	buildManifestLock.Lock()
+	defer buildManifestLock.Unlock()
+
+	//Read the manifest that is being written over
+	filePath := settings.CompiledOutputPath + BuildManifestFileName
+	manifest := readBuildManifest(os.ReadFile, filePath)
+	if settingsHash := settings.buildSettingsHash(); manifest.SettingsHash != settingsHash {
+		manifest = buildManifest{SettingsHash: settingsHash, Languages: make(map[string]buildManifestLanguage)}
+	}
+	manifest.Languages[pf.LangIdentifier] = buildManifestLanguage{pf.InputFileName, inputHash}
+
+	//Write it back out
+	b, err := json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, append(b, '\n'), 0644)
+}
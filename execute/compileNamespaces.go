@@ -0,0 +1,125 @@
+//Recompile only some namespaces of the default language
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+)
+
+// CompileNamespaces recompiles only the given namespaces of the default language (see translate.LanguageTextFile.RecompileNamespaces()), which is much faster than a full compile while editing strings. The default language’s compiled translation file and compiled dictionaries must already exist, and the namespaces and Translation IDs must be unchanged since they were compiled (so their indexes do not shift).
+//
+// The default language’s compiled translation file, the compiled variable (and combined) dictionaries, and the Go dictionary files that changed are written (following OutputCompiled and OutputGoDictionary). Other languages are not processed. Returns the warnings from compiling.
+func (settings *ProcessSettings) CompileNamespaces(namespaces ...string) (warnings []string, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return nil, err
+	}
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+
+	//Load the compiled dictionary
+	dict, err := settings.loadCompiledDictionary()
+	if err != nil {
+		return nil, err
+	}
+
+	//Load the compiled default language
+	var current *translate.Language
+	if err := settings.loadCompiledFile(settings.DefaultLanguage+compiledFileExt, "compiled translation file", func(r io.Reader) (err error) {
+		current, err = dict.LoadDefault(r, settings.CompressCompiled)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	//Recompile the namespaces from the default language’s translation text file
+	filePath, lf, err := settings.findTextFile(settings.DefaultLanguage)
+	if err != nil {
+		return nil, err
+	}
+	f, err := settings.openFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open language file “%s”: %s", filePath, err.Error())
+	}
+	defer func() { _ = f.Close() }()
+	lang, warnings, err := lf.RecompileNamespaces(f, current, namespaces, settings.AllowBigStrings)
+	if err != nil {
+		return warnings, fmt.Errorf("Could not compile “%s”: %s", filePath, err.Error())
+	}
+	if err := settings.checkLockFile(); err != nil {
+		return warnings, err
+	}
+
+	//Output the Go dictionaries
+	if settings.outputGoDictionary() {
+		if err, _ := lang.SaveGoDictionaries(settings.GoOutputPath, settings.GoDictHeader); err != nil {
+			return warnings, fmt.Errorf("Could not save go dictionaries: %s", err.Error())
+		}
+	}
+
+	//Output the compiled files. The compiled dictionary is unchanged
+	if !settings.outputCompiled() {
+		return warnings, nil
+	}
+	saveCompiledFile := func(fileName, fileDesc string, save func(w io.Writer, isCompressed bool, options ...translate.SaveOption) error) error {
+		if fc, err := settings.createCompiledFile(fileName); err != nil {
+			return fmt.Errorf("Could not open %s “%s”: %s", fileDesc, fileName, err.Error())
+		} else {
+			defer func() { _ = fc.Close() }()
+			if err := save(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
+				return fmt.Errorf("Could not save %s “%s”: %s", fileDesc, fileName, err.Error())
+			} else if err := fc.sign(); err != nil {
+				return fmt.Errorf("Could not sign %s “%s”: %s", fileDesc, fileName, err.Error())
+			}
+		}
+		return nil
+	}
+	if err := saveCompiledFile(VarDictionaryFileBase+compiledFileExt, "compiled variable dictionary file", lang.SaveGTRVarsDict); err != nil {
+		return warnings, err
+	}
+	if settings.CombinedDictionary {
+		if err := saveCompiledFile(CombinedDictionaryFileBase+compiledFileExt, "compiled combined dictionary file", lang.SaveGTRCombinedDict); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, saveCompiledFile(settings.DefaultLanguage+compiledFileExt, "compiled translation file", lang.SaveGTR)
+}
+
+// Opens a file in CompiledOutputPath and loads it
+func (settings *ProcessSettings) loadCompiledFile(fileName, fileDesc string, load func(r io.Reader) error) error {
+	if f, err := settings.openFile(settings.CompiledOutputPath + fileName); err != nil {
+		return fmt.Errorf("Could not open %s “%s”: %s", fileDesc, fileName, err.Error())
+	} else {
+		defer func() { _ = f.Close() }()
+		if err := load(f); err != nil {
+			return fmt.Errorf("Could not load %s “%s”: %s", fileDesc, fileName, err.Error())
+		}
+	}
+	return nil
+}
+
+// Loads the compiled dictionary (and its variables) from CompiledOutputPath into a new dictionary, which decrypts with the encryption key
+func (settings *ProcessSettings) loadCompiledDictionary() (dict *translate.Dictionary, err error) {
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	dict = translate.NewDictionary()
+	if err := dict.SetDecryptionKey(settings.encryptionKey); err != nil {
+		return nil, err
+	}
+	if settings.CombinedDictionary {
+		err = settings.loadCompiledFile(CombinedDictionaryFileBase+compiledFileExt, "compiled combined dictionary file", func(r io.Reader) error {
+			return dict.LoadCombinedDictionary(r, settings.CompressCompiled)
+		})
+	} else if err = settings.loadCompiledFile(DictionaryFileBase+compiledFileExt, "compiled dictionary file", func(r io.Reader) error {
+		return dict.LoadDictionary(r, settings.CompressCompiled)
+	}); err == nil {
+		err = settings.loadCompiledFile(VarDictionaryFileBase+compiledFileExt, "compiled variable dictionary file", func(r io.Reader) error {
+			return dict.LoadDictionaryVars(r, settings.CompressCompiled)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dict, nil
+}
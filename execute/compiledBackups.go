@@ -0,0 +1,44 @@
+//Rotating backups of overwritten compiled files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// Creates (or truncates) a compiled file in CompiledOutputPath. If CompiledBackups is set, the file’s previous versions are first rotated into “$FileName.1” (newest) through “$FileName.$CompiledBackups”. compiledFile.sign() must be called after the file is written
+func (settings *ProcessSettings) createCompiledFile(fileName string) (*compiledFile, error) {
+	if err := settings.rotateCompiledBackups(settings.CompiledOutputPath + fileName); err != nil {
+		return nil, fmt.Errorf("Could not back up: %s", err.Error())
+	} else if f, err := os.Create(settings.CompiledOutputPath + fileName); err != nil {
+		return nil, err
+	} else {
+		return &compiledFile{f: f, settings: settings}, nil
+	}
+}
+
+// Moves the file to “$FilePath.1” after moving each existing “$FilePath.$N” to “$FilePath.$N+1”. The oldest backup past CompiledBackups is removed
+func (settings *ProcessSettings) rotateCompiledBackups(filePath string) error {
+	//Nothing to do if backups are off or the file does not exist yet
+	if settings.CompiledBackups == 0 {
+		return nil
+	} else if _, err := os.Stat(filePath); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	//Remove the oldest backup and shift the rest
+	backupName := func(n uint) string { return fmt.Sprintf("%s.%d", filePath, n) }
+	if err := os.Remove(backupName(settings.CompiledBackups)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for n := settings.CompiledBackups - 1; n > 0; n-- {
+		if err := os.Rename(backupName(n), backupName(n+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(filePath, backupName(1))
+}
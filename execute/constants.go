@@ -5,12 +5,15 @@ package execute
 
 //goland:noinspection GoSnakeCaseUsage
 const (
-	SettingsFileName      = "settings-gol10n.json"
-	VarDictionaryFileBase = "variables"
-	YAML_Extension        = "yaml"
-	JSON_Extension        = "json"
+	SettingsFileName = "settings-gol10n.json"
+	YAML_Extension   = "yaml"
+	JSON_Extension   = "json"
+	TOML_Extension   = "toml"
 )
 
+// Matches a language identifier with an optional script and region, whose script and region can be in any case. Example: zh-Hans-CN
+const languageIdentifierRegex = `[a-z]{2,3}(-[A-Za-z]{4})?(-[A-Za-z]{2,3})?`
+
 //goland:noinspection GoSnakeCaseUsage,GoCommentStart
 const (
 	_ ProcessedFileFlag = 1 << iota
@@ -25,6 +28,8 @@ const (
 	PFF_Load_NotFound     //File was not loaded because its translation text file was not found
 	PFF_Load_YAML         //If this was loaded from a YAML translation text file
 	PFF_Load_JSON         //If this was loaded from a JSON translation text file
+	PFF_Load_TOML         //If this was loaded from a TOML translation text file
+	PFF_Load_Custom       //If this was loaded from a translation text file of a custom format (see translate.RegisterTextFormat())
 	PFF_Load_Compiled     //If this was loaded from a .gtr file (compression state is assumed from ProcessSettings.CompressCompiled)
 
 	//Error information
@@ -53,6 +58,8 @@ var ProcessedFileFlagNames = []ProcessedFileFlagName{
 	createPFFN(PFF_Load_NotFound, "Load_NotFound", "LoNF"),
 	createPFFN(PFF_Load_YAML, "Load_YAML", "LoYA"),
 	createPFFN(PFF_Load_JSON, "Load_JSON", "LoJS"),
+	createPFFN(PFF_Load_TOML, "Load_TOML", "LoTO"),
+	createPFFN(PFF_Load_Custom, "Load_Custom", "LoCu"),
 	createPFFN(PFF_Load_Compiled, "Load_Compiled", "LoCo"),
 	createPFFN(PFF_Error_DuringProcessing, "Error_DuringProcessing", "Er  "),
 	createPFFN(PFF_OutputSuccess_CompiledLanguage, "OutputSuccess_CompiledLanguage", "OuCL"),
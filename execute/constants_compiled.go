@@ -5,6 +5,19 @@ package execute
 //goland:noinspection GoSnakeCaseUsage
 const (
 	DictionaryFileBase         = "dictionary"
+	VarDictionaryFileBase      = "variables"
+	CombinedDictionaryFileBase = "dictionary_variables"
 	GTR_Extension_Compressed   = ".gtr.gz"
 	GTR_Extension_Uncompressed = ".gtr"
 )
+
+// The file name and extension of bundle archives (see ProcessSettings.Pack())
+//
+//goland:noinspection GoSnakeCaseUsage
+const (
+	BundleArchiveFileBase = "bundle"
+	GTRB_Extension        = ".gtrb"
+)
+
+// The infix of standalone compiled language files, which are named “$LanguageIdentifier.standalone.gtr” (see ProcessSettings.Standalone())
+const StandaloneFileInfix = ".standalone"
@@ -0,0 +1,73 @@
+//Convert translation text files between file types
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"os"
+	"strings"
+)
+
+// ConvertFile converts the language’s translation text file in InputPath to another file type (see translate.LanguageTextFile.Convert()), keeping its settings. toExtension is YAML_Extension, JSON_Extension, or TOML_Extension, so a converted file can always be converted back. Gettext PO files are not translation text files (they cannot hold the Settings object), so they cannot be converted to or from. Returns the path of the written file.
+//
+// The file is written as “$InputPath/$LanguageIdentifier.$toExtension”, and the original file is removed if it had a different path (so the language does not have 2 files). Comments are not kept. JSON files cannot be written when I18nextJSON is set, as they would be read as i18next JSON files
+func (settings *ProcessSettings) ConvertFile(languageIdentifier, toExtension string) (filePath string, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return "", err
+	} else if settings.FS != nil {
+		return "", errors.New("Converted files cannot be written to a ProcessSettings.FS")
+	}
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+
+	//Get the file type to convert to
+	var to translate.LanguageTextFile
+	switch toExtension = strings.ToLower(toExtension); toExtension {
+	case YAML_Extension:
+		to = cond(settings.YAMLStrictStrings, translate.LF_YAML_StrictStrings, translate.LF_YAML)
+	case JSON_Extension:
+		if to = settings.jsonTextFile(); to == translate.LF_JSON_I18Next {
+			return "", errors.New("JSON files cannot be written when I18nextJSON is set")
+		}
+	case TOML_Extension:
+		to = translate.LF_TOML
+	default:
+		return "", fmt.Errorf("Files can only be converted to %s, %s, or %s", YAML_Extension, JSON_Extension, TOML_Extension)
+	}
+
+	//Read the language’s file
+	fromPath, from, err := settings.findTextFile(languageIdentifier)
+	if err != nil {
+		return "", err
+	}
+	var original []byte
+	if f, err := settings.openFile(fromPath); err != nil {
+		return "", err
+	} else {
+		original, err = io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	//Convert the file and replace the original
+	converted, err := from.Convert(bytes.NewReader(original), to)
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", fromPath, err.Error())
+	}
+	filePath = settings.InputPath + languageIdentifier + "." + toExtension
+	if err := os.WriteFile(filePath, converted, 0644); err != nil {
+		return "", err
+	} else if filePath != fromPath {
+		if err := os.Remove(fromPath); err != nil {
+			return filePath, fmt.Errorf("Could not remove “%s”: %s", fromPath, err.Error())
+		}
+	}
+	return filePath, nil
+}
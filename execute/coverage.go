@@ -0,0 +1,111 @@
+//Translation coverage of the languages
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"sort"
+	"strings"
+)
+
+// LanguageCoverage is the translation coverage of a language (see ProcessSettings.Coverage())
+type LanguageCoverage struct {
+	LanguageIdentifier string
+	NumTranslations    uint32
+	NumTranslated      uint32              //The number of translations the language has its own rules for, instead of getting them from its fallback languages
+	NumMissing         uint32              //NumTranslations - NumTranslated
+	NumExtra           uint32              //The number of Translation IDs in the translation text file that are not in the default language. Translation IDs of extra namespaces are not counted
+	Coverage           float64             //The percentage of translations that are translated
+	ExtraIDs           map[string][]string //The extra Translation IDs, keyed to their namespaces
+	ExtraNamespaces    []string            //The namespaces in the translation text file that are not in the default language
+	Namespaces         []NamespaceCoverage //In dictionary order
+}
+
+// NamespaceCoverage is the translation coverage of a namespace in a language (see LanguageCoverage)
+type NamespaceCoverage struct {
+	translate.NamespaceCoverage
+	NumExtra uint32  //The number of Translation IDs of the namespace in the translation text file that are not in the default language
+	Coverage float64 //The percentage of translations that are translated
+}
+
+// Coverage processes all files (like Directory()) without writing any, and returns the translation coverage of the languages, sorted by their language identifiers. If no language identifiers are given, all languages are included.
+//
+// All translation text files are read (see ValidateOnly), since extra Translation IDs are only known from them. The coverages of the languages that loaded are returned even if there is an error
+func (settings *ProcessSettings) Coverage(languageIdentifiers ...string) ([]LanguageCoverage, ProcessedFileList, error) {
+	//Process the languages without writing any files or promoting warnings
+	statsSettings := *settings
+	statsSettings.ValidateOnly, statsSettings.Strict = true, false
+	list, err := statsSettings.Directory()
+
+	//Get the requested languages
+	var langIdents []string
+	if len(languageIdentifiers) == 0 {
+		for langIdent := range list {
+			langIdents = append(langIdents, langIdent)
+		}
+	} else {
+		for _, langIdent := range languageIdentifiers {
+			langIdent = settings.ResolveLanguageAlias(langIdent)
+			if _, ok := list[langIdent]; !ok && err == nil {
+				return nil, list, fmt.Errorf("Language “%s” was not found", langIdent)
+			}
+			langIdents = append(langIdents, langIdent)
+		}
+	}
+	sort.Strings(langIdents)
+
+	//Create the coverages of the loaded languages
+	ret := make([]LanguageCoverage, 0, len(langIdents))
+	for _, langIdent := range langIdents {
+		if pf := list[langIdent]; pf != nil && pf.Lang != nil {
+			ret = append(ret, newLanguageCoverage(pf))
+		}
+	}
+	return ret, list, err
+}
+
+// Creates the coverage of a loaded language
+func newLanguageCoverage(pf *ProcessedFile) LanguageCoverage {
+	lc := LanguageCoverage{
+		LanguageIdentifier: pf.LangIdentifier,
+		NumTranslations:    pf.Lang.NumTranslations(),
+		NumTranslated:      pf.Lang.NumTranslated(),
+		ExtraIDs:           make(map[string][]string),
+	}
+	lc.NumMissing = lc.NumTranslations - lc.NumTranslated
+	lc.Coverage = coveragePercent(lc.NumTranslated, lc.NumTranslations)
+
+	//Get the extras from the warnings, which are in the format “[Code] Namespace[.TranslationID]: Message”
+	for _, warning := range pf.Warnings {
+		code := translate.WarningCode(warning)
+		if code != translate.WC_ExtraTranslation && code != translate.WC_ExtraNamespace {
+			continue
+		}
+		location := strings.TrimPrefix(warning, code+" ")
+		if end := strings.Index(location, ": "); end != -1 {
+			location = location[:end]
+		}
+		if code == translate.WC_ExtraNamespace {
+			lc.ExtraNamespaces = append(lc.ExtraNamespaces, location)
+		} else if namespaceName, translationID, ok := strings.Cut(location, "."); ok {
+			lc.ExtraIDs[namespaceName] = append(lc.ExtraIDs[namespaceName], translationID)
+			lc.NumExtra++
+		}
+	}
+
+	//Create the namespace coverages
+	for _, nc := range pf.Lang.NamespaceCoverage() {
+		lc.Namespaces = append(lc.Namespaces, NamespaceCoverage{nc, uint32(len(lc.ExtraIDs[nc.Name])), coveragePercent(nc.NumTranslated, nc.NumTranslations)})
+	}
+	return lc
+}
+
+// Returns the percentage of translated translations. Having no translations is full coverage
+func coveragePercent(numTranslated, numTranslations uint32) float64 {
+	if numTranslations == 0 {
+		return 100
+	}
+	return float64(numTranslated) * 100 / float64(numTranslations)
+}
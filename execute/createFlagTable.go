@@ -5,6 +5,8 @@ package execute
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
 )
 
 // CreateFlagTable creates an aligned ascii table that shows which flags are set on which ProcessedFiles. The row headers are the ProcessedFileFlagNames.ShortName and the column headers are the ProcessedFile.LangIdentifier.
@@ -82,3 +84,26 @@ func (list ProcessedFileList) CreateFlagTable() []string {
 
 	return outRows
 }
+
+// Coverage creates 1 line per language (sorted by ProcessedFile.LangIdentifier) with the number of translations the language has its own rules for out of the total number of translations (see translate.Language.NumTranslated()). Languages that did not load are skipped
+func (list ProcessedFileList) Coverage() []string {
+	langIdents := make([]string, 0, len(list))
+	maxLangLen := 0
+	for langIdent, pf := range list {
+		if pf.Lang != nil {
+			langIdents = append(langIdents, langIdent)
+			if len(langIdent) > maxLangLen {
+				maxLangLen = len(langIdent)
+			}
+		}
+	}
+	sort.Strings(langIdents)
+
+	outRows := make([]string, len(langIdents))
+	for i, langIdent := range langIdents {
+		l := list[langIdent].Lang
+		numTranslated, numTranslations := l.NumTranslated(), l.NumTranslations()
+		outRows[i] = fmt.Sprintf("%-*s %d/%d (%.1f%%)", maxLangLen+1, langIdent+":", numTranslated, numTranslations, coveragePercent(numTranslated, numTranslations))
+	}
+	return outRows
+}
@@ -0,0 +1,45 @@
+//Decompiling compiled translation files back into translation text files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+)
+
+// Decompile reconstructs a language’s YAML translation text file from its compiled translation file and the compiled dictionaries in CompiledOutputPath (see translate.Language.Decompile()), and writes it to w. This recovers lost sources, or audits what was shipped.
+func (settings *ProcessSettings) Decompile(w io.Writer, languageIdentifier string) error {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return err
+	}
+
+	//Load the compiled dictionary
+	dict, err := settings.loadCompiledDictionary()
+	if err != nil {
+		return err
+	}
+
+	//Load the compiled language
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+	var lang *translate.Language
+	if err := settings.loadCompiledFile(languageIdentifier+cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed), "compiled translation file", func(r io.Reader) (err error) {
+		if languageIdentifier == settings.DefaultLanguage {
+			lang, err = dict.LoadDefault(r, settings.CompressCompiled)
+		} else {
+			lang, err = dict.Load(r, settings.CompressCompiled)
+		}
+		return
+	}); err != nil {
+		return err
+	}
+
+	//Decompile and write it
+	b, err := lang.Decompile()
+	if err != nil {
+		return fmt.Errorf("Could not decompile “%s”: %s", languageIdentifier, err.Error())
+	}
+	return b.WriteText(w, translate.LF_YAML)
+}
@@ -0,0 +1,46 @@
+//Compare compiled dictionary files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"path/filepath"
+	"strings"
+)
+
+// DiffDictionaries compares the Translation IDs of 2 compiled dictionary files (see translate.Dictionary.Diff()), to catch changes that shift the TransIndexes of binaries built against the old dictionary. The files are decompressed by their extensions (GTR_Extension_Compressed), and are read as compiled combined dictionary files if their names start with CombinedDictionaryFileBase
+func (settings *ProcessSettings) DiffDictionaries(oldFilePath, newFilePath string) (translate.DictionaryDiff, error) {
+	oldDict, err := settings.loadDictionaryFile(oldFilePath)
+	if err != nil {
+		return translate.DictionaryDiff{}, err
+	}
+	newDict, err := settings.loadDictionaryFile(newFilePath)
+	if err != nil {
+		return translate.DictionaryDiff{}, err
+	}
+	return oldDict.Diff(newDict)
+}
+
+// Loads a compiled dictionary file from any path into a new dictionary
+func (settings *ProcessSettings) loadDictionaryFile(filePath string) (*translate.Dictionary, error) {
+	fileName := filepath.Base(filePath)
+	f, err := settings.openFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open compiled dictionary file “%s”: %s", filePath, err.Error())
+	}
+	defer func() { _ = f.Close() }()
+
+	dict := translate.NewDictionary()
+	isCompressed := strings.HasSuffix(fileName, GTR_Extension_Compressed)
+	if strings.HasPrefix(fileName, CombinedDictionaryFileBase) {
+		err = dict.LoadCombinedDictionary(f, isCompressed)
+	} else {
+		err = dict.LoadDictionary(f, isCompressed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not load compiled dictionary file “%s”: %s", filePath, err.Error())
+	}
+	return dict, nil
+}
@@ -0,0 +1,98 @@
+//Dry runs, which report the files that would be written instead of writing them
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"os"
+)
+
+// PlannedWriteReason is why a file would be written in a dry run (see ProcessSettings.DryRun)
+type PlannedWriteReason uint8
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	PWR_Missing          PlannedWriteReason = iota //The file does not exist yet
+	PWR_Forced                                     //ProcessSettings.Force is set
+	PWR_NotInManifest                              //The language is not in the build manifest (see BuildManifestFileName)
+	PWR_ChangedSettings                            //The settings that change the compiled files are different from the ones in the build manifest
+	PWR_ChangedHash                                //The translation text file’s contents are different from the ones in the build manifest
+	PWR_InvalidSignature                           //The compiled files do not have valid signatures (see ProcessSettings.SigningKeyFile)
+	PWR_Outdated                                   //The compiled file does not match the current dictionary or encryption key
+	PWR_ChangedContent                             //The generated go dictionary or go:embed file’s contents changed
+)
+
+var plannedWriteReasonNames = []string{"Missing", "Forced", "NotInManifest", "ChangedSettings", "ChangedHash", "InvalidSignature", "Outdated", "ChangedContent"}
+
+// String returns the name of the PlannedWriteReason
+func (r PlannedWriteReason) String() string {
+	if int(r) < len(plannedWriteReasonNames) {
+		return plannedWriteReasonNames[r]
+	}
+	return "Unknown"
+}
+
+// PlannedWrite is a file that would be written in a dry run, and why
+type PlannedWrite struct {
+	FilePath string
+	Reason   PlannedWriteReason
+}
+
+// Returns why the language’s compiled file would be written, since it could not be used
+func (settings *ProcessSettings) compiledWriteReason(pf *ProcessedFile, inputHash string) PlannedWriteReason {
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	if fileInfo, err := settings.statFile(settings.CompiledOutputPath + pf.LangIdentifier + compiledFileExt); err != nil || fileInfo.IsDir() {
+		return PWR_Missing
+	} else if settings.force() {
+		return PWR_Forced
+	} else if reason, mismatch := settings.buildManifestMismatch(pf, inputHash); mismatch {
+		return reason
+	} else if !settings.hasValidSignatures(settings.compiledFileNames(pf.LangIdentifier)...) {
+		return PWR_InvalidSignature
+	}
+	return PWR_Outdated
+}
+
+// Stores the files that processing the language would write (in the order they would be written) into ProcessedFile.PlannedWrites, without writing them
+func (settings *ProcessSettings) planWrites(pf *ProcessedFile, inputHash string) error {
+	compiledReason := settings.compiledWriteReason(pf, inputHash)
+	plan := func(filePath string, reason PlannedWriteReason) {
+		if _, err := os.Stat(filePath); err != nil {
+			reason = PWR_Missing
+		}
+		pf.PlannedWrites = append(pf.PlannedWrites, PlannedWrite{filePath, reason})
+	}
+
+	//The go dictionary files, compiled dictionaries, and go:embed file of the default language
+	compiledFileNames := settings.compiledFileNames(pf.LangIdentifier)
+	if pf.LangIdentifier == settings.DefaultLanguage {
+		if settings.outputGoDictionary() {
+			err, changedFiles := pf.Lang.PlanGoDictionaryFiles(settings.GoOutputPath, settings.GoDictHeader)
+			if err != nil {
+				return fmt.Errorf("Could not check go dictionaries: %s", err.Error())
+			}
+			for _, filePath := range changedFiles {
+				plan(filePath, PWR_ChangedContent)
+			}
+		}
+		if settings.outputCompiled() {
+			for _, fileName := range compiledFileNames[1:] {
+				plan(settings.CompiledOutputPath+fileName, compiledReason)
+			}
+			if len(settings.GoEmbedPackage) != 0 {
+				if fileName, _, changed, err := settings.goEmbedFile(); err != nil {
+					return fmt.Errorf("Could not check %s: %s", GoEmbedFileName, err.Error())
+				} else if changed {
+					plan(fileName, PWR_ChangedContent)
+				}
+			}
+		}
+	}
+
+	//The compiled translation file
+	if settings.outputCompiled() {
+		plan(settings.CompiledOutputPath+compiledFileNames[0], compiledReason)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+//Graph of embedded translations
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+)
+
+// EmbedGraph processes the language with its fallbacks (see File()) and returns the graph of its embedded translations (see translate.Language.EmbedGraph())
+func (settings *ProcessSettings) EmbedGraph(languageIdentifier string) (translate.EmbedGraph, error) {
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+	loadedLanguages, err := settings.File(languageIdentifier)
+	if err != nil {
+		return translate.EmbedGraph{}, err
+	} else if pf, ok := loadedLanguages[languageIdentifier]; !ok || pf.Lang == nil {
+		return translate.EmbedGraph{}, fmt.Errorf("Language “%s” was not loaded", languageIdentifier)
+	} else {
+		return pf.Lang.EmbedGraph(), nil
+	}
+}
@@ -0,0 +1,42 @@
+//Encrypt the translation strings of the compiled files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"os"
+	"strings"
+)
+
+// Reads EncryptionKeyFile, which holds a hex encoded AES key of 16, 24, or 32 bytes (like from “openssl rand -hex 32”), and sets it as the package level decryption key so encrypted compiled files can be read
+func (settings *ProcessSettings) loadEncryptionKey() error {
+	settings.encryptionKey = nil
+	if len(settings.EncryptionKeyFile) == 0 {
+		return translate.LanguageFile(translate.LF_GTR).SetDecryptionKey(nil)
+	}
+
+	b, err := os.ReadFile(settings.EncryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("Could not read encryption key file “%s”: %s", settings.EncryptionKeyFile, err.Error())
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return fmt.Errorf("Encryption key file “%s” is not hex encoded: %s", settings.EncryptionKeyFile, err.Error())
+	} else if err := translate.LanguageFile(translate.LF_GTR).SetDecryptionKey(key); err != nil {
+		return fmt.Errorf("Encryption key file “%s”: %s", settings.EncryptionKeyFile, err.Error())
+	}
+	settings.encryptionKey = key
+	return nil
+}
+
+// Returns if a compiled language file cannot be used because it was not encrypted with the current key (or is encrypted when no key is given), so it must be compiled again. err is from loading the file, and lang is the loaded language
+func (settings *ProcessSettings) needsReencryption(lang *translate.Language, err error) bool {
+	if err != nil {
+		return errors.Is(err, translate.ErrMissingDecryptionKey) || errors.Is(err, translate.ErrWrongDecryptionKey)
+	}
+	return lang.IsEncrypted() != (settings.encryptionKey != nil)
+}
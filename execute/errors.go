@@ -0,0 +1,25 @@
+//Errors that can be checked with errors.Is() and errors.As()
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+)
+
+// ErrFileNotFound is matched through errors.Is() by the errors returned when a translation text file is not found
+var ErrFileNotFound = errors.New("File not found")
+
+// An error for a file that was not found. Its message is kept as is, and it matches ErrFileNotFound (and the error it wraps) through errors.Is()
+type fileNotFoundError struct {
+	err error
+}
+
+func (e *fileNotFoundError) Error() string        { return e.err.Error() }
+func (e *fileNotFoundError) Unwrap() error        { return e.err }
+func (e *fileNotFoundError) Is(target error) bool { return target == ErrFileNotFound }
+
+// Marks the error as being for a file that was not found
+func fileNotFound(err error) error {
+	return &fileNotFoundError{err}
+}
@@ -5,35 +5,68 @@
 package execute
 
 import (
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"github.com/dakusan/gol10n/translate"
+	"io/fs"
 	"os"
 	"regexp"
+	"runtime"
 	"strings"
 	"sync"
+	"time"
 )
 
 // ProcessSettings are taken from $SettingsFileName and are used to automatically read translation text files, compiled translation files, and go dictionary files.
 //
-// Compiled files are read from (and not written to) if their modification timestamps are newer than their translation text files, unless IgnoreTimestamps=true.
+// Compiled files are read from (and not written to) if the build manifest (see BuildManifestFileName) shows they were built from the current contents of their translation text files and the current settings, unless Force=true.
 //
 // Updating the default language may force all other languages to be updated.
 type ProcessSettings struct {
 	//The settings from $SettingsFileName
-	DefaultLanguage        string //The identifier for the default language
-	InputPath              string //The directory with the translation text files
-	GoOutputPath           string //The directory to output the generated Go files to. Each namespace gets its own directory and file in the format “$NamespaceName/translationIDs.go”
-	CompiledOutputPath     string //The directory to output the compiled binary translation files to. Each language gets its own .gtr or .gtr.gz (gzip compressed) file
-	GoDictHeader           string //Extra code included just above the const in generated go dictionaries
-	CompressCompiled       bool   //Whether the compiled binary translation files are saved as .gtr or .gtr.gz (gzip compressed)
-	AllowBigStrings        bool   //If the translation strings can be larger than 64KB. If true, and a large translation string is found, then compiled binary translation files will become larger
-	AllowJSONTrailingComma bool   //If JSON files can have trailing commas. If true, a sanitization process is ran over the JSON that changes the regular expression “,\s*\n\s*}” to just “}”
+	DefaultLanguage        string                    //The identifier for the default language
+	InputPath              string                    //The directory with the translation text files
+	GoOutputPath           string                    //The directory to output the generated Go files to. Each namespace gets its own directory and file in the format “$NamespaceName/translationIDs.go”
+	CompiledOutputPath     string                    //The directory to output the compiled binary translation files to. Each language gets its own .gtr or .gtr.gz (gzip compressed) file
+	GoDictHeader           string                    //Extra code included just above the const in generated go dictionaries
+	CompressCompiled       bool                      //Whether the compiled binary translation files are saved as .gtr or .gtr.gz (gzip compressed)
+	CompressionLevel       int                       //The gzip compression level of compressed compiled files, from 1 (fastest) to 9 (smallest). If 0, the default level is used
+	AllowBigStrings        bool                      //If the translation strings can be larger than 64KB. If true, and a large translation string is found, then compiled binary translation files will become larger
+	AllowJSONTrailingComma bool                      //If JSON files can have trailing commas. If true, a sanitization process is ran over the JSON that changes the regular expression “,\s*\n\s*}” to just “}”
+	YAMLStrictStrings      bool                      //If YAML files must have all keys and values as strings. If true, unquoted numbers, booleans, and nulls are errors instead of being converted to strings
+	I18nextJSON            bool                      //If JSON files are i18next JSON files (see translate.LF_JSON_I18Next). They cannot be written to by the fmt and import modes
+	LanguageAliases        translate.LanguageAliases //Deprecated or alternate language identifiers mapped to the language identifiers that have files. These are used when resolving requested languages and fallback names
+	CombinedDictionary     bool                      //If the compiled dictionary and variable dictionary are also output together as one combined file. When true, the combined file is read instead of the two split files
+	GoEmbedPackage         string                    //If given, an “embed.go” file with this package name is written into the parent directory of CompiledOutputPath, which embeds the compiled files and loads them through load_compiled.LoadFromFS()
+	CompiledBackups        uint                      //The number of previous versions of each compiled file to keep when it is overwritten, as “$FileName.1” (newest) through “$FileName.$CompiledBackups”
+	LockFile               string                    //If given, the path to the string freeze lock file. Processing the default language’s translation text file fails if the text of any Translation ID locked in this file has changed (see Lock() and Unlock())
+	SigningKeyFile         string                    //If given, the path to a PEM encoded PKCS #8 Ed25519 private key. Each written compiled file is signed with it into a detached “$FileName.sig” signature file, and compiled files without a valid signature are compiled again instead of being read
+	EncryptionKeyFile      string                    //If given, the path to a file with a hex encoded AES key (16, 24, or 32 bytes). The translation strings of written compiled language files are encrypted with it (see translate.WithEncryptionKey()), and compiled files not encrypted with it are compiled again instead of being read
+	MaxCompileGoroutines   uint                      //The most Translation IDs of a translation text file that are compiled at once, each in its own goroutine. If 0, runtime.GOMAXPROCS(0) is used (see translate.CompileLimits)
+	MaxParallel            uint                      //The most translation text files processed at once by Directory() (each in its own goroutine), and the most namespaces whose go dictionary files are written at once. If 0, runtime.GOMAXPROCS(0) is used
+	MaxCompileMemory       uint64                    //If not 0, the most bytes of compiled translation strings and rules a translation text file can produce. Files that exceed it fail with a *translate.CompileLimitError
+	Strict                 bool                      //If the warnings of processed translation text files are promoted to errors, except those whose warning codes are in StrictAllowedWarnings (see translate.WarningCode()). Warnings are only known for translation text files that are read (see ValidateOnly and Force)
+	StrictAllowedWarnings  []string                  //The warning codes (like “MissingTranslation” or “[MissingTranslation]”) that are not promoted to errors by Strict. “Other” allows the warnings without a code
+
+	//If given, called as Directory() starts and finishes processing each file, and with the warnings of each file once its checks are done. It is never called concurrently, but may be called from different goroutines
+	Progress func(event ProgressEvent) `json:"-"`
+
+	//If given, translation text files and compiled files are read from this file system (with InputPath and CompiledOutputPath being paths inside it) instead of the OS. Output files are still written to the OS
+	FS fs.FS `json:"-"`
 
 	//Extra settings added by [command line] flags
 	OutputGoDictionary bool `json:"-"` //Whether to output go dictionary files
 	OutputCompiled     bool `json:"-"` //Whether to output compiled .gtr files
-	IgnoreTimestamps   bool `json:"-"` //Whether to force outputting all files, ignoring timestamps
+	Force              bool `json:"-"` //Whether to force outputting all files, ignoring the build manifest
+	IgnoreTimestamps   bool `json:"-"` //Deprecated: Use Force. The same as Force
+	ValidateOnly       bool `json:"-"` //Whether to only validate the translation text files. They are all read (ignoring compiled files) and checked, but no files are written, overriding OutputGoDictionary and OutputCompiled
+	DryRun             bool `json:"-"` //Whether to process the files like normal, but store the files that would be written in ProcessedFile.PlannedWrites instead of writing them
+
+	signingKey    ed25519.PrivateKey //Read from SigningKeyFile by checkSettings()
+	encryptionKey []byte             //Read from EncryptionKeyFile by checkSettings()
 }
 
 // ProcessedFile is an item in the list of processed files and what was done to/with them.
@@ -44,6 +77,9 @@ type ProcessedFile struct {
 	Err            error
 	Flags          ProcessedFileFlag
 	Lang           *translate.Language //Only filled if Flags.PFF_Language_Success*
+	OutputFiles    []string            //The paths of the files that were written (compiled files, go dictionary files, and GoEmbedFileName) in the order they were written
+	PlannedWrites  []PlannedWrite      //Only filled if ProcessSettings.DryRun: The files that would have been written to OutputFiles, and why
+	Stats          ProcessedFileStats  //The processing time and file sizes
 }
 type ProcessedFileFlag uint
 
@@ -54,6 +90,11 @@ type ProcessedFileList map[string]*ProcessedFile
 //
 // No ProcessedFiles are returned if any of the following errors occur: Directory error, language identity used more than once, default language not found
 func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
+	return settings.DirectoryCtx(context.Background())
+}
+
+// DirectoryCtx is the same as Directory(), but stops processing if the context is cancelled. Files that already started processing are finished first. The returned error then wraps the context’s error
+func (settings *ProcessSettings) DirectoryCtx(ctx context.Context) (ProcessedFileList, error) {
 	//Check and update the settings
 	if err := settings.checkSettings(); err != nil {
 		return nil, err
@@ -61,7 +102,7 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 
 	//Get a list of the files in the directory
 	var d []os.DirEntry
-	if _d, err := os.ReadDir(settings.InputPath); err != nil {
+	if _d, err := settings.readDir(settings.InputPath); err != nil {
 		return nil, errors.New("Error reading input path: " + err.Error())
 	} else {
 		d = _d
@@ -72,9 +113,9 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 	defaultLanguageFileIndex := -1
 	{
 		var langIdentsFound = make(ProcessedFileList)
-		checkFiletype := regexp.MustCompile(`^[a-z]{2,3}(-[a-z]{2,3})?\.(` + YAML_Extension + `|` + JSON_Extension + `)$`)
+		checkFiletype := textFileNameRegex()
 		for _, f := range d {
-			//Only process files whose file extension matches json or yaml
+			//Only process files whose file extension is a translation text file extension
 			fName := f.Name()
 			if f.IsDir() || !checkFiletype.MatchString(strings.ToLower(fName)) {
 				continue
@@ -84,6 +125,8 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 			langIdent := fName[0:strings.LastIndexByte(fName, '.')]
 			if _, ok := langIdentsFound[langIdent]; ok {
 				return nil, fmt.Errorf("Language identity “%s” found again in file: %s", langIdent, fName)
+			} else if target, ok := settings.LanguageAliases[langIdent]; ok {
+				return nil, fmt.Errorf("Language identity “%s” in file “%s” is an alias of “%s”", langIdent, fName, target)
 			}
 
 			//Store the file to process
@@ -104,32 +147,53 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 
 	//Return an error if the default language was not found
 	if defaultLanguageFileIndex == -1 {
-		return nil, fmt.Errorf("Default language “%s” not found", settings.DefaultLanguage)
+		return nil, fileNotFound(fmt.Errorf("Default language “%s” not found", settings.DefaultLanguage))
 	}
 
 	//Processed language files start in unhandledLanguages
 	unhandledLanguages := make(ProcessedFileList, len(filesToProcess))
 	handledLanguages := make(ProcessedFileList, len(filesToProcess))
 
+	//Report the progress of the files (see Progress)
+	progress := settings.progressReporter(uint(len(filesToProcess)))
+
 	//Process the default language. If there is an error with it, stop here
+	if ctx.Err() != nil {
+		return nil, cancelledError(ctx)
+	}
 	{
 		pf := &filesToProcess[defaultLanguageFileIndex]
+		progress(PE_FileStarted, pf)
 		pf.Err = settings.processFile(pf, false)
+		progress(PE_FileFinished, pf)
 		if pf.Err != nil {
 			unhandledLanguages[settings.DefaultLanguage] = pf
-			return unhandledLanguages, fmt.Errorf("Default language error: " + pf.Err.Error())
+			return unhandledLanguages, fmt.Errorf("Default language error: %s", pf.Err.Error())
 		}
 	}
 
-	//Process the other languages
+	//Process the other languages, at most MaxParallel at once. No more are started once the context is cancelled
 	{
 		var waitForFiles sync.WaitGroup
+		semaphore := make(chan struct{}, cond(settings.MaxParallel == 0, uint(runtime.GOMAXPROCS(0)), settings.MaxParallel))
 		for _fIndex := range filesToProcess {
 			if _fIndex != defaultLanguageFileIndex {
+				select {
+				case semaphore <- struct{}{}:
+				case <-ctx.Done():
+				}
+				if ctx.Err() != nil {
+					break
+				}
 				waitForFiles.Add(1)
 				go func(fIndex int, pf *ProcessedFile) {
-					defer waitForFiles.Done()
+					defer func() {
+						<-semaphore
+						waitForFiles.Done()
+					}()
+					progress(PE_FileStarted, pf)
 					pf.Err = settings.processFile(pf, false)
+					progress(PE_FileFinished, pf)
 				}(_fIndex, &filesToProcess[_fIndex])
 			}
 		}
@@ -142,8 +206,10 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 			hasErrors = hasErrors || fInfo.Err != nil
 		}
 
-		//Return if there are errors
-		if hasErrors {
+		//Return if cancelled or there are errors
+		if ctx.Err() != nil {
+			return unhandledLanguages, cancelledError(ctx)
+		} else if hasErrors {
 			return unhandledLanguages, errors.New("There were errors while processing files")
 		}
 	}
@@ -188,7 +254,7 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 			moveLang(langIdent)
 		} else
 		//Add errors for languages that have non-existent fallbacks
-		if fallback := getLang(pf.Lang.FallbackName()); fallback == nil {
+		if fallback := getLang(settings.ResolveLanguageAlias(pf.Lang.FallbackName())); fallback == nil {
 			pf.Err = fmt.Errorf("Fallback “%s” does not exist", pf.Lang.FallbackName())
 			hasErrors = true
 			moveLang(langIdent)
@@ -201,8 +267,8 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 		numProcessedThisIteration := 0
 		for _, langIdent := range getMapKeys(unhandledLanguages) {
 			pf := unhandledLanguages[langIdent]
-			if fb, ok := handledLanguages[pf.Lang.FallbackName()]; ok {
-				if err := pf.Lang.SetFallback(fb.Lang); err != nil {
+			if fb, ok := handledLanguages[settings.ResolveLanguageAlias(pf.Lang.FallbackName())]; ok {
+				if err := pf.Lang.SetFallbackWithAliases(fb.Lang, settings.LanguageAliases); err != nil {
 					pf.Err = fmt.Errorf("Fallback “%s” had error while setting: %s", pf.Lang.FallbackName(), err.Error())
 					hasErrors = true
 				} else {
@@ -224,11 +290,34 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 		}
 	}
 
+	//Check the variables used through fallback languages, and the embedded translations
+	for _, pf := range handledLanguages {
+		if pf.Flags&PFF_Language_SuccessfullyLoaded != 0 && pf.Lang != nil {
+			pf.Warnings = append(pf.Warnings, pf.Lang.CheckFallbackVariables()...)
+			pf.Warnings = append(pf.Warnings, pf.Lang.CheckEmbeddedTranslations()...)
+		}
+	}
+
+	//Report the warnings of the files, in the order they were found in the directory
+	for fIndex := range filesToProcess {
+		if pf := &filesToProcess[fIndex]; pf.Flags&PFF_Language_SuccessfullyLoaded != 0 && len(pf.Warnings) != 0 {
+			progress(PE_FileWarnings, pf)
+		}
+	}
+
 	//Return if errors exist
 	if hasErrors {
 		return handledLanguages, errors.New("There were errors while processing fallbacks")
 	}
 
+	//In strict mode, promote the warnings to errors
+	for _, pf := range handledLanguages {
+		hasErrors = settings.applyStrict(pf) || hasErrors
+	}
+	if hasErrors {
+		return handledLanguages, errors.New("There were warnings that strict mode does not allow")
+	}
+
 	//Return success
 	return handledLanguages, nil
 }
@@ -237,9 +326,14 @@ func (settings *ProcessSettings) Directory() (ProcessedFileList, error) {
 //
 // The languages in the fallback chain and the default language are also processed for the returned Language objects.
 func (settings *ProcessSettings) File(languageIdentifier string) (loadedLanguages ProcessedFileList, err error) {
+	return settings.FileCtx(context.Background(), languageIdentifier)
+}
+
+// FileCtx is the same as File(), but stops processing if the context is cancelled. The file that is already processing is finished first. The returned error then wraps the context’s error
+func (settings *ProcessSettings) FileCtx(ctx context.Context, languageIdentifier string) (loadedLanguages ProcessedFileList, err error) {
 	//Process the language, its fallbacks, and the default
 	var languageLoadOrder []string
-	if loadedLanguages, languageLoadOrder, err = settings.processLangAndDefault(languageIdentifier, true, false); err != nil {
+	if loadedLanguages, languageLoadOrder, err = settings.processLangAndDefault(ctx, languageIdentifier, true, false); err != nil {
 		return loadedLanguages, err
 	}
 
@@ -257,7 +351,7 @@ func (settings *ProcessSettings) File(languageIdentifier string) (loadedLanguage
 				pf.Err = fmt.Errorf("Error setting fallback to “%s”", settings.DefaultLanguage)
 				return loadedLanguages, fmt.Errorf("Error setting “%s” fallback to “%s”", pf.LangIdentifier, settings.DefaultLanguage)
 			}
-		} else if err := pf.Lang.SetFallback(loadedLanguages[pf.Lang.FallbackName()].Lang); err != nil {
+		} else if err := pf.Lang.SetFallbackWithAliases(loadedLanguages[settings.ResolveLanguageAlias(pf.Lang.FallbackName())].Lang, settings.LanguageAliases); err != nil {
 			pf.Err = fmt.Errorf("Error setting fallback to “%s”", pf.Lang.FallbackName())
 			return loadedLanguages, fmt.Errorf("Error setting “%s” fallback to “%s”", pf.LangIdentifier, pf.Lang.FallbackName())
 		}
@@ -266,6 +360,19 @@ func (settings *ProcessSettings) File(languageIdentifier string) (loadedLanguage
 		pf.Flags = (pf.Flags | PFF_Language_SuccessfullyLoaded) & ^PFF_Language_SuccessNoFallbackSet
 	}
 
+	//Check the variables used through fallback languages, and the embedded translations
+	for _, pf := range loadedLanguages {
+		pf.Warnings = append(pf.Warnings, pf.Lang.CheckFallbackVariables()...)
+		pf.Warnings = append(pf.Warnings, pf.Lang.CheckEmbeddedTranslations()...)
+	}
+
+	//In strict mode, promote the warnings to errors
+	for _, langIdent := range languageLoadOrder {
+		if pf := loadedLanguages[langIdent]; settings.applyStrict(pf) {
+			return loadedLanguages, fmt.Errorf("File “%s” has warnings that strict mode does not allow", pf.InputFileName)
+		}
+	}
+
 	//Return success
 	return loadedLanguages, nil
 }
@@ -277,7 +384,7 @@ func (settings *ProcessSettings) File(languageIdentifier string) (loadedLanguage
 // The languages in the fallback chain will not be processed. Because of this, there will be no Language objects returned.
 func (settings *ProcessSettings) FileNoReturn(languageIdentifier string) error {
 	//Process the language and the default only
-	_, _, err := settings.processLangAndDefault(languageIdentifier, false, false)
+	_, _, err := settings.processLangAndDefault(context.Background(), languageIdentifier, false, false)
 	return err
 }
 
@@ -286,28 +393,44 @@ func (settings *ProcessSettings) FileNoReturn(languageIdentifier string) error {
 // This will only work if a compiled dictionary already exists.
 func (settings *ProcessSettings) FileCompileOnly(languageIdentifier string) error {
 	//Process the language only
-	_, _, err := settings.processLangAndDefault(languageIdentifier, false, true)
+	_, _, err := settings.processLangAndDefault(context.Background(), languageIdentifier, false, true)
 	return err
 }
 
+// ResolveLanguageAlias returns the language identifier that the given identifier is an alias of (through LanguageAliases), or the given identifier if it is not an alias
+func (settings *ProcessSettings) ResolveLanguageAlias(languageIdentifier string) string {
+	return settings.LanguageAliases.Resolve(languageIdentifier)
+}
+
 //------------------Combined processing for the above functions-----------------
 
 func (settings *ProcessSettings) checkSettings() error {
 	//Check default language name
 	var errs []string
-	if !regexp.MustCompile(`^[a-z]{2,3}(-[a-z]{2,3})?$`).MatchString(strings.ToLower(settings.DefaultLanguage)) {
+	if !regexp.MustCompile(`^` + languageIdentifierRegex + `$`).MatchString(strings.ToLower(settings.DefaultLanguage)) {
 		errs = append(errs, fmt.Sprintf("Invalid default language identifier: %s", settings.DefaultLanguage))
 	}
 
+	//Check the language aliases
+	if err := settings.LanguageAliases.Check(); err != nil {
+		errs = append(errs, err.Error())
+	} else if _, ok := settings.LanguageAliases[settings.DefaultLanguage]; ok {
+		errs = append(errs, fmt.Sprintf("The default language identifier “%s” cannot be a language alias", settings.DefaultLanguage))
+	}
+
 	//Confirm a directory path is valid and make sure the path ends in a forward slash
-	checkDir := func(dirPath, dirName string) string {
-		//Make sure the path ends in a forward slash
+	addSlash := func(dirPath string) string {
 		if len(dirPath) == 0 || dirPath[len(dirPath)-1] != '/' {
 			dirPath = dirPath + string('/')
 		}
+		return dirPath
+	}
+	checkDir := func(dirPath, dirName string, stat func(name string) (fs.FileInfo, error)) string {
+		//Make sure the path ends in a forward slash
+		dirPath = addSlash(dirPath)
 
 		//Confirm directory path is valid
-		if info, err := os.Stat(dirPath); err != nil {
+		if info, err := stat(dirPath); err != nil {
 			errs = append(errs, fmt.Sprintf("Directory “%s” at “%s” could not be opened: %s", dirName, dirPath, err.Error()))
 		} else if !info.IsDir() {
 			errs = append(errs, fmt.Sprintf("Tried to read directory “%s” at “%s” but it is not a directory", dirName, dirPath))
@@ -317,14 +440,39 @@ func (settings *ProcessSettings) checkSettings() error {
 	}
 
 	//Check input and output directories
-	settings.InputPath = checkDir(settings.InputPath, "Input path")
-	if settings.OutputGoDictionary {
-		settings.GoOutputPath = checkDir(settings.GoOutputPath, "Go dictionary path")
+	settings.InputPath = checkDir(settings.InputPath, "Input path", settings.statFile)
+	if settings.outputGoDictionary() {
+		settings.GoOutputPath = checkDir(settings.GoOutputPath, "Go dictionary path", os.Stat)
+	}
+	if settings.outputCompiled() {
+		settings.CompiledOutputPath = checkDir(settings.CompiledOutputPath, "Compiled output path", os.Stat)
+	} else {
+		settings.CompiledOutputPath = addSlash(settings.CompiledOutputPath)
+	}
+
+	//Check the compression level
+	if settings.CompressionLevel < 0 || settings.CompressionLevel > gzip.BestCompression {
+		errs = append(errs, fmt.Sprintf("Compression level (%d) must be from %d to %d, or 0 for the default", settings.CompressionLevel, gzip.BestSpeed, gzip.BestCompression))
+	}
+
+	//Read the signing key
+	if err := settings.loadSigningKey(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	//Read the encryption key
+	if err := settings.loadEncryptionKey(); err != nil {
+		errs = append(errs, err.Error())
 	}
-	if settings.OutputCompiled {
-		settings.CompiledOutputPath = checkDir(settings.CompiledOutputPath, "Compiled output path")
+
+	//Check the warning codes allowed by strict mode
+	if err := settings.checkStrictAllowedWarnings(); err != nil {
+		errs = append(errs, err.Error())
 	}
 
+	//Set the compile limits of the package level dictionary
+	translate.LanguageFile(translate.LF_YAML).SetCompileLimits(translate.CompileLimits{MaxGoroutines: settings.MaxCompileGoroutines, MaxMemory: settings.MaxCompileMemory, MaxNamespaceGoroutines: settings.MaxParallel})
+
 	//Handle if there are errors
 	if len(errs) != 0 {
 		return errors.New(strings.Join(errs, "\n"))
@@ -334,20 +482,55 @@ func (settings *ProcessSettings) checkSettings() error {
 	return nil
 }
 
+// Returns the error for when processing stops because the context was cancelled. It wraps the context’s error
+func cancelledError(ctx context.Context) error {
+	return fmt.Errorf("Processing was cancelled: %w", ctx.Err())
+}
+
+// Returns if all files are processed, ignoring the build manifest (see Force)
+func (settings *ProcessSettings) force() bool {
+	return settings.Force || settings.IgnoreTimestamps
+}
+
+// Returns if the go dictionary files are output (see ValidateOnly)
+func (settings *ProcessSettings) outputGoDictionary() bool {
+	return settings.OutputGoDictionary && !settings.ValidateOnly
+}
+
+// Returns if the compiled files are output (see ValidateOnly)
+func (settings *ProcessSettings) outputCompiled() bool {
+	return settings.OutputCompiled && !settings.ValidateOnly
+}
+
+// Returns the options that compiled files are saved with
+func (settings *ProcessSettings) saveOptions() []translate.SaveOption {
+	var options []translate.SaveOption
+	if settings.CompressionLevel != 0 {
+		options = append(options, translate.WithCompressionLevel(settings.CompressionLevel))
+	}
+	if settings.encryptionKey != nil {
+		options = append(options, translate.WithEncryptionKey(settings.encryptionKey))
+	}
+	return options
+}
+
 func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictionaryLoadOnly bool) error {
 	//Constants for errors
 	type errAction string
 	type errFileType string
 	//goland:noinspection GoSnakeCaseUsage
 	const (
-		ea_get            errAction   = "get"
-		ea_open           errAction   = "open"
-		ea_load           errAction   = "load"
-		ea_save           errAction   = "save"
-		eft_comp_dict     errFileType = "compiled dictionary file"
-		eft_comp_var_dict errFileType = "compiled variable dictionary file"
-		eft_comp_lang     errFileType = "compiled translation file"
-		eft_lang          errFileType = "language file"
+		ea_get             errAction   = "get"
+		ea_open            errAction   = "open"
+		ea_load            errAction   = "load"
+		ea_save            errAction   = "save"
+		ea_sign            errAction   = "sign"
+		ea_hash            errAction   = "hash"
+		eft_comp_dict      errFileType = "compiled dictionary file"
+		eft_comp_var_dict  errFileType = "compiled variable dictionary file"
+		eft_comp_comb_dict errFileType = "compiled combined dictionary file"
+		eft_comp_lang      errFileType = "compiled translation file"
+		eft_lang           errFileType = "language file"
 	)
 
 	//The most common error return
@@ -355,18 +538,39 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 		if err == nil {
 			return fmt.Errorf("Could not %s %s “%s”", action, identifier, filename)
 		}
-		return fmt.Errorf("Could not %s %s “%s”: %s", action, identifier, filename, err.Error())
+		return fmt.Errorf("Could not %s %s “%s”: %w", action, identifier, filename, err)
 	}
 
+	//Time the processing
+	startTime := time.Now()
+	defer func() { pf.Stats.ProcessTime = time.Since(startTime) }()
+
 	//Load the compiled dictionary
 	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
 	loadCompiledDictionary := func() (bool, error) {
+		//Load the combined dictionary if requested. If it does not exist, then do not try to load compiled file
+		if settings.CombinedDictionary {
+			var dictFile fs.File
+			dictFileName := CombinedDictionaryFileBase + compiledFileExt
+			if dictInfo, err := settings.statFile(settings.CompiledOutputPath + dictFileName); err != nil || dictInfo.IsDir() {
+				return false, nil
+			} else if dictFile, err = settings.openFile(settings.CompiledOutputPath + dictFileName); err != nil {
+				return false, nil
+			}
+			defer func() { _ = dictFile.Close() }()
+
+			if err, ok := translate.LF_GTR.LoadCombinedDictionary(dictFile, settings.CompressCompiled); !ok {
+				return false, couldNotErr(ea_load, eft_comp_comb_dict, dictFileName, err)
+			}
+			return true, nil
+		}
+
 		{
-			var dictFile *os.File
+			var dictFile fs.File
 			dictFileName := DictionaryFileBase + compiledFileExt
-			if dictInfo, err := os.Stat(settings.CompiledOutputPath + dictFileName); err != nil || dictInfo.IsDir() {
+			if dictInfo, err := settings.statFile(settings.CompiledOutputPath + dictFileName); err != nil || dictInfo.IsDir() {
 				return false, nil
-			} else if dictFile, err = os.Open(settings.CompiledOutputPath + dictFileName); err != nil {
+			} else if dictFile, err = settings.openFile(settings.CompiledOutputPath + dictFileName); err != nil {
 				return false, nil
 			}
 			defer func() { _ = dictFile.Close() }()
@@ -384,11 +588,11 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 				translate.LanguageFile(translate.LF_GTR).ClearCurrentDictionary()
 			}
 		}()
-		var dictVarFile *os.File
+		var dictVarFile fs.File
 		dictVarFileName := VarDictionaryFileBase + compiledFileExt
-		if dictVarInfo, err := os.Stat(settings.CompiledOutputPath + dictVarFileName); err != nil || dictVarInfo.IsDir() {
+		if dictVarInfo, err := settings.statFile(settings.CompiledOutputPath + dictVarFileName); err != nil || dictVarInfo.IsDir() {
 			return false, nil
-		} else if dictVarFile, err = os.Open(settings.CompiledOutputPath + dictVarFileName); err != nil {
+		} else if dictVarFile, err = settings.openFile(settings.CompiledOutputPath + dictVarFileName); err != nil {
 			return false, nil
 		}
 		defer func() { _ = dictVarFile.Close() }()
@@ -402,7 +606,7 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 	//Attempt to load a compiled version
 	loadCompiled := func(fileName string) (bool, error) {
 		//Open the file
-		f, err := os.Open(settings.CompiledOutputPath + fileName)
+		f, err := settings.openFile(settings.CompiledOutputPath + fileName)
 		if err != nil {
 			return false, nil
 		}
@@ -424,8 +628,11 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 			pf.Lang, err = translate.LF_GTR.Load(f, settings.CompressCompiled)
 		}
 
-		//If ErrDictionaryDoesNotMatch then return as failed without error
-		if err != nil && regexp.MustCompile(`^@\d+ `+regexp.QuoteMeta(translate.ErrDictionaryDoesNotMatch)+`$`).MatchString(err.Error()) {
+		//If ErrDictionaryMismatch, or the file was not encrypted with the current key, then return as failed without error
+		if errors.Is(err, translate.ErrDictionaryMismatch) || settings.needsReencryption(pf.Lang, err) {
+			if pf.Lang = nil; pf.LangIdentifier == settings.DefaultLanguage { //The default language’s text file creates the dictionary
+				translate.LanguageFile(translate.LF_GTR).ClearCurrentDictionary()
+			}
 			pf.Flags = (pf.Flags | PFF_Load_NotAttempted) & ^PFF_Load_Compiled
 			return false, nil
 		}
@@ -442,6 +649,11 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 			return false, fmt.Errorf("Compiled translation file “%s” language identifier “%s” does not match", pf.LangIdentifier+compiledFileExt, pf.Lang.LanguageIdentifier())
 		}
 
+		//Warn if the locale data differs from what the file was compiled with
+		if warning := pf.Lang.CLDRVersionWarning(); len(warning) != 0 {
+			pf.Warnings = append(pf.Warnings, warning)
+		}
+
 		//Return success
 		pf.Flags |= PFF_Language_SuccessNoFallbackSet
 		return true, nil
@@ -460,12 +672,17 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 		return nil
 	}
 
-	//If there is a newer (or equal timestamp) compiled version of the file use it instead
-	if fileInfo, err := os.Stat(settings.InputPath + pf.InputFileName); err != nil || fileInfo.IsDir() {
+	//If the compiled version of the file was built from the same translation text file contents and settings, use it instead (see BuildManifestFileName)
+	var inputHash string
+	if fileInfo, err := settings.statFile(settings.InputPath + pf.InputFileName); err != nil || fileInfo.IsDir() {
 		return couldNotErr(ea_get, "file info for", pf.InputFileName, nil)
-	} else if settings.IgnoreTimestamps {
-		//Do not continue if/else chain if we are ignoring timestamps
-	} else if compFileInfo, err := os.Stat(settings.CompiledOutputPath + pf.LangIdentifier + compiledFileExt); err == nil && !compFileInfo.IsDir() && !compFileInfo.ModTime().Before(fileInfo.ModTime()) {
+	} else if pf.Stats.InputSize = fileInfo.Size(); settings.ValidateOnly {
+		//Do not continue if/else chain if only validating, as nothing is written
+	} else if inputHash, err = settings.hashInputFile(pf.InputFileName); err != nil {
+		return couldNotErr(ea_hash, eft_lang, pf.InputFileName, err)
+	} else if settings.force() {
+		//Do not continue if/else chain if we are forcing all files to be processed
+	} else if compFileInfo, err := settings.statFile(settings.CompiledOutputPath + pf.LangIdentifier + compiledFileExt); err == nil && !compFileInfo.IsDir() && settings.isBuildCurrent(pf, inputHash) && settings.hasValidSignatures(settings.compiledFileNames(pf.LangIdentifier)...) {
 		if success, err := loadCompiled(compFileInfo.Name()); err != nil {
 			return err
 		} else if success {
@@ -475,10 +692,13 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 
 	//Open the file for reading
 	{
-		var f *os.File
+		var f fs.File
 		pf.Flags &= ^PFF_Load_NotAttempted
-		if _f, err := os.Open(settings.InputPath + pf.InputFileName); err != nil {
+		if _f, err := settings.openFile(settings.InputPath + pf.InputFileName); err != nil {
 			pf.Flags |= PFF_Load_NotFound
+			if errors.Is(err, fs.ErrNotExist) {
+				return fileNotFound(couldNotErr(ea_open, eft_lang, pf.InputFileName, err))
+			}
 			return couldNotErr(ea_open, eft_lang, pf.InputFileName, err)
 		} else {
 			f = _f
@@ -487,25 +707,26 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 
 		//Read the language file
 		var e error
-		switch ext := pf.InputFileName[len(pf.LangIdentifier)+1:]; ext {
+		ext := pf.InputFileName[len(pf.LangIdentifier)+1:]
+		loader, ok := settings.textFileType(ext)
+		if !ok {
+			pf.Flags |= PFF_Load_NotFound
+			return fmt.Errorf("Extension “%s” for file “%s” must be %s", ext, pf.InputFileName, strings.Join(TextFileExtensions(), " or "))
+		}
+		switch ext {
 		case YAML_Extension:
 			pf.Flags |= PFF_Load_YAML
-			if pf.LangIdentifier == settings.DefaultLanguage {
-				pf.Lang, pf.Warnings, e = translate.LF_YAML.LoadDefault(f, settings.AllowBigStrings)
-			} else {
-				pf.Lang, pf.Warnings, e = translate.LF_YAML.Load(f, settings.AllowBigStrings)
-			}
 		case JSON_Extension:
 			pf.Flags |= PFF_Load_JSON
-			loader := cond(settings.AllowJSONTrailingComma, translate.LF_JSON_AllowTrailingComma, translate.LF_JSON)
-			if pf.LangIdentifier == settings.DefaultLanguage {
-				pf.Lang, pf.Warnings, e = loader.LoadDefault(f, settings.AllowBigStrings)
-			} else {
-				pf.Lang, pf.Warnings, e = loader.Load(f, settings.AllowBigStrings)
-			}
+		case TOML_Extension:
+			pf.Flags |= PFF_Load_TOML
 		default:
-			pf.Flags |= PFF_Load_NotFound
-			return fmt.Errorf("Extension “%s” for file “%s” must be %s", ext, pf.InputFileName, strings.Join([]string{YAML_Extension, JSON_Extension}, " or "))
+			pf.Flags |= PFF_Load_Custom
+		}
+		if pf.LangIdentifier == settings.DefaultLanguage {
+			pf.Lang, pf.Warnings, e = loader.LoadDefault(f, settings.AllowBigStrings)
+		} else {
+			pf.Lang, pf.Warnings, e = loader.Load(f, settings.AllowBigStrings)
 		}
 
 		//If there is an error, return it
@@ -521,56 +742,115 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 		return fmt.Errorf("Language file “%s” language identifier “%s” does not match", pf.InputFileName, pf.Lang.LanguageIdentifier())
 	}
 
+	//Make sure no locked Translation IDs of the default language have changed
+	if pf.LangIdentifier == settings.DefaultLanguage {
+		if err := settings.checkLockFile(); err != nil {
+			pf.Flags |= PFF_Error_DuringProcessing
+			return err
+		}
+	}
+
+	//In a dry run, store the files that would be written instead of writing them
+	if settings.DryRun {
+		if err := settings.planWrites(pf, inputHash); err != nil {
+			pf.Flags |= PFF_Error_DuringProcessing
+			return err
+		}
+		pf.Flags |= PFF_Language_SuccessNoFallbackSet
+		return nil
+	}
+
 	//Output the resultant files for the default language
 	if pf.LangIdentifier == settings.DefaultLanguage {
-		if settings.OutputGoDictionary {
-			if err, numUpdated := pf.Lang.SaveGoDictionaries(settings.GoOutputPath, settings.GoDictHeader); err != nil {
+		if settings.outputGoDictionary() {
+			err, updatedFiles := pf.Lang.SaveGoDictionaryFiles(settings.GoOutputPath, settings.GoDictHeader)
+			pf.OutputFiles = append(pf.OutputFiles, updatedFiles...)
+			if err != nil {
 				return fmt.Errorf("Could not save go dictionaries: %s", err.Error())
-			} else if numUpdated > 0 {
+			} else if len(updatedFiles) > 0 {
 				pf.Flags |= PFF_OutputSuccess_GoDictionaries
 			}
 		}
-		if settings.OutputCompiled {
+		if settings.outputCompiled() {
 			//The compiled dictionary
 			{
 				dictFileName := DictionaryFileBase + compiledFileExt
-				if fc, err := os.Create(settings.CompiledOutputPath + dictFileName); err != nil {
+				if fc, err := settings.createCompiledFile(dictFileName); err != nil {
 					return couldNotErr(ea_open, eft_comp_dict, dictFileName, err)
 				} else {
 					defer func() { _ = fc.Close() }()
-					if err := pf.Lang.SaveGTRDict(fc, settings.CompressCompiled); err != nil {
+					if err := pf.Lang.SaveGTRDict(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
 						return couldNotErr(ea_save, eft_comp_dict, dictFileName, err)
+					} else if err := fc.sign(); err != nil {
+						return couldNotErr(ea_sign, eft_comp_dict, dictFileName, err)
 					}
+					pf.OutputFiles = append(pf.OutputFiles, settings.CompiledOutputPath+dictFileName)
 				}
 			}
 
 			//The compiled variable dictionary
 			dictFileName := VarDictionaryFileBase + compiledFileExt
-			if fc, err := os.Create(settings.CompiledOutputPath + dictFileName); err != nil {
+			if fc, err := settings.createCompiledFile(dictFileName); err != nil {
 				return couldNotErr(ea_open, eft_comp_var_dict, dictFileName, err)
 			} else {
 				defer func() { _ = fc.Close() }()
-				if err := pf.Lang.SaveGTRVarsDict(fc, settings.CompressCompiled); err != nil {
+				if err := pf.Lang.SaveGTRVarsDict(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
 					return couldNotErr(ea_save, eft_comp_var_dict, dictFileName, err)
+				} else if err := fc.sign(); err != nil {
+					return couldNotErr(ea_sign, eft_comp_var_dict, dictFileName, err)
+				}
+				pf.OutputFiles = append(pf.OutputFiles, settings.CompiledOutputPath+dictFileName)
+			}
+
+			//The compiled combined dictionary
+			if settings.CombinedDictionary {
+				dictFileName := CombinedDictionaryFileBase + compiledFileExt
+				if fc, err := settings.createCompiledFile(dictFileName); err != nil {
+					return couldNotErr(ea_open, eft_comp_comb_dict, dictFileName, err)
+				} else {
+					defer func() { _ = fc.Close() }()
+					if err := pf.Lang.SaveGTRCombinedDict(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
+						return couldNotErr(ea_save, eft_comp_comb_dict, dictFileName, err)
+					} else if err := fc.sign(); err != nil {
+						return couldNotErr(ea_sign, eft_comp_comb_dict, dictFileName, err)
+					}
+					pf.OutputFiles = append(pf.OutputFiles, settings.CompiledOutputPath+dictFileName)
 				}
 			}
 
 			pf.Flags |= PFF_OutputSuccess_CompiledDictionary
+
+			//The go:embed file
+			if len(settings.GoEmbedPackage) != 0 {
+				if updatedFile, err := settings.saveGoEmbedFile(); err != nil {
+					return fmt.Errorf("Could not save %s: %s", GoEmbedFileName, err.Error())
+				} else if len(updatedFile) != 0 {
+					pf.OutputFiles = append(pf.OutputFiles, updatedFile)
+				}
+			}
 		}
 	}
 
 	//Output the compiled translation file
-	if settings.OutputCompiled {
+	if settings.outputCompiled() {
 		outFileName := pf.LangIdentifier + compiledFileExt
-		if fc, err := os.Create(settings.CompiledOutputPath + outFileName); err != nil {
+		if fc, err := settings.createCompiledFile(outFileName); err != nil {
 			return couldNotErr(ea_open, eft_comp_lang, outFileName, err)
 		} else {
 			defer func() { _ = fc.Close() }()
-			if err := pf.Lang.SaveGTR(fc, settings.CompressCompiled); err != nil {
+			if err := pf.Lang.SaveGTR(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
 				return couldNotErr(ea_save, eft_comp_lang, outFileName, err)
+			} else if err := fc.sign(); err != nil {
+				return couldNotErr(ea_sign, eft_comp_lang, outFileName, err)
+			} else if fileInfo, err := fc.f.Stat(); err == nil {
+				pf.Stats.CompiledSize = fileInfo.Size()
 			}
 		}
+		if err := settings.updateBuildManifest(pf, inputHash); err != nil {
+			return fmt.Errorf("Could not update build manifest “%s”: %s", BuildManifestFileName, err.Error())
+		}
 		pf.Flags |= PFF_OutputSuccess_CompiledLanguage
+		pf.OutputFiles = append(pf.OutputFiles, settings.CompiledOutputPath+outFileName)
 	}
 
 	//Return success
@@ -578,13 +858,14 @@ func (settings *ProcessSettings) processFile(pf *ProcessedFile, compiledDictiona
 	return nil
 }
 
-func (settings *ProcessSettings) processLangAndDefault(languageIdentifier string, processFallbacks, forceCompiledDictionaryLoad bool) (loadedLanguages ProcessedFileList, languageLoadOrder []string, err error) {
+func (settings *ProcessSettings) processLangAndDefault(ctx context.Context, languageIdentifier string, processFallbacks, forceCompiledDictionaryLoad bool) (loadedLanguages ProcessedFileList, languageLoadOrder []string, err error) {
 	//Check and update the settings
 	if err := settings.checkSettings(); err != nil {
 		return nil, nil, err
 	}
 
 	//The list of languages that still need to be processed
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
 	loadedLanguages = make(ProcessedFileList)
 	languageLoadOrder = []string{settings.DefaultLanguage}
 	if languageIdentifier != settings.DefaultLanguage {
@@ -593,6 +874,11 @@ func (settings *ProcessSettings) processLangAndDefault(languageIdentifier string
 
 FileLoop:
 	for curLangIndex := 0; curLangIndex < len(languageLoadOrder); curLangIndex++ {
+		//Stop if the context was cancelled
+		if ctx.Err() != nil {
+			return loadedLanguages, languageLoadOrder, cancelledError(ctx)
+		}
+
 		//Add the ProcessedFile to the return list
 		curLang := languageLoadOrder[curLangIndex]
 		pf := &ProcessedFile{
@@ -602,9 +888,9 @@ FileLoop:
 		loadedLanguages[curLang] = pf
 
 		//Attempt to find the language file from the possible translation text file extensions
-		for _, ext := range []string{YAML_Extension, JSON_Extension} {
+		for _, ext := range TextFileExtensions() {
 			//Find if there is a matching translation text file extension
-			if fInfo, err := os.Stat(settings.InputPath + curLang + "." + ext); err != nil || fInfo.IsDir() {
+			if fInfo, err := settings.statFile(settings.InputPath + curLang + "." + ext); err != nil || fInfo.IsDir() {
 				continue
 			} else {
 				pf.InputFileName = fInfo.Name()
@@ -622,15 +908,18 @@ FileLoop:
 			//Process the language and handle errors
 			if pf.Err = settings.processFile(pf, false); pf.Err != nil {
 				return loadedLanguages, languageLoadOrder, pf.Err
+			} else if settings.applyStrict(pf) {
+				return loadedLanguages, languageLoadOrder, pf.Err
 			}
 
 			//Add the fallback language to be processed
-			if !processFallbacks || curLang == settings.DefaultLanguage || pf.Lang.FallbackName() == settings.DefaultLanguage || len(pf.Lang.FallbackName()) == 0 {
+			fallbackName := settings.ResolveLanguageAlias(pf.Lang.FallbackName())
+			if !processFallbacks || curLang == settings.DefaultLanguage || fallbackName == settings.DefaultLanguage || len(fallbackName) == 0 {
 				//No language to add
-			} else if _, ok := loadedLanguages[pf.Lang.FallbackName()]; ok {
-				return loadedLanguages, languageLoadOrder, fmt.Errorf("File “%s” has a fallback loop on “%s” starting from “%s”", pf.InputFileName, pf.Lang.FallbackName(), languageIdentifier)
+			} else if _, ok := loadedLanguages[fallbackName]; ok {
+				return loadedLanguages, languageLoadOrder, fmt.Errorf("File “%s” has a fallback loop on “%s” starting from “%s”", pf.InputFileName, fallbackName, languageIdentifier)
 			} else {
-				languageLoadOrder = append(languageLoadOrder, pf.Lang.FallbackName())
+				languageLoadOrder = append(languageLoadOrder, fallbackName)
 			}
 
 			continue FileLoop
@@ -638,7 +927,7 @@ FileLoop:
 
 		//Return error if file not found
 		pf.Flags = (pf.Flags | PFF_Load_NotFound) & ^PFF_Load_NotAttempted
-		return loadedLanguages, languageLoadOrder, fmt.Errorf("File for “%s” was not found starting from “%s”", curLang, languageIdentifier)
+		return loadedLanguages, languageLoadOrder, fileNotFound(fmt.Errorf("File for “%s” was not found starting from “%s”", curLang, languageIdentifier))
 	}
 
 	//Return success
@@ -0,0 +1,39 @@
+//Convert Mozilla Fluent (FTL) files into translation text files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"os"
+)
+
+// ImportFluent reads Fluent (FTL) files and writes the language’s translation text file into InputPath (see translate.LanguageTextFile.ImportFluent()). Returns the path of the written file and the conversion warnings.
+//
+// If the language’s translation text file already exists, it is overwritten in the same file type (comments are not kept). Otherwise, a YAML file is created. The default language’s file does not need to exist yet when importing the default language. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportFluent(files []translate.FluentFile, languageIdentifier string) (filePath string, warnings []string, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return "", nil, err
+	}
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+
+	//If the default language’s file does not exist yet, it is created from only the Fluent files
+	if languageIdentifier == settings.DefaultLanguage {
+		if _, _, err := settings.findTextFile(languageIdentifier); err != nil {
+			var lf translate.LanguageTextFile
+			var fileText []byte
+			if filePath, lf, err = settings.importedFilePath(languageIdentifier); err != nil {
+				return "", nil, err
+			} else if fileText, warnings, err = lf.ImportFluent(files, languageIdentifier, nil, 0, nil, 0); err != nil {
+				return "", warnings, err
+			}
+			return filePath, warnings, os.WriteFile(filePath, fileText, 0644)
+		}
+	}
+
+	return settings.importMobile(languageIdentifier, func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error) {
+		return lf.ImportFluent(files, languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	})
+}
@@ -0,0 +1,99 @@
+//Format translation text files into their canonical layout
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// FormatFiles rewrites translation text files in their canonical layout (see translate.LanguageTextFile.Format()). Formatting never makes a semantic change.
+//
+// If no file paths are given, all the translation text files in InputPath are formatted. Given file paths are used as is (they are not relative to InputPath).
+//
+// If checkOnly is true, no files are written. Returns the files that were not already in the canonical layout. Files that fail to format are returned in the error.
+func (settings *ProcessSettings) FormatFiles(checkOnly bool, filePaths ...string) (changedFiles []string, err error) {
+	//Formatted files can only be written to the OS
+	if !checkOnly && settings.FS != nil {
+		return nil, errors.New("Formatted files cannot be written to a ProcessSettings.FS")
+	}
+
+	//Get the translation text files in the input directory
+	if len(filePaths) == 0 {
+		inputPath := settings.InputPath
+		if len(inputPath) == 0 || (inputPath[len(inputPath)-1] != '/' && inputPath[len(inputPath)-1] != '\\') {
+			inputPath = inputPath + "/"
+		}
+		d, err := settings.readDir(inputPath)
+		if err != nil {
+			return nil, errors.New("Error reading input path: " + err.Error())
+		}
+		checkFiletype := regexp.MustCompile(`^` + languageIdentifierRegex + `\.(` + YAML_Extension + `|` + JSON_Extension + `)$`)
+		for _, f := range d {
+			if !f.IsDir() && checkFiletype.MatchString(strings.ToLower(f.Name())) {
+				filePaths = append(filePaths, inputPath+f.Name())
+			}
+		}
+	}
+
+	//Format the files
+	var errs []string
+	for _, filePath := range filePaths {
+		if changed, err := settings.formatFile(filePath, checkOnly); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", filePath, err.Error()))
+		} else if changed {
+			changedFiles = append(changedFiles, filePath)
+		}
+	}
+
+	if len(errs) != 0 {
+		return changedFiles, errors.New(strings.Join(errs, "\n"))
+	}
+	return changedFiles, nil
+}
+
+// Formats a single translation text file. Returns if the file was not already in the canonical layout
+func (settings *ProcessSettings) formatFile(filePath string, checkOnly bool) (bool, error) {
+	//Get the file type from the extension
+	var lf translate.LanguageTextFile
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(filePath), ".")) {
+	case YAML_Extension:
+		lf = cond(settings.YAMLStrictStrings, translate.LF_YAML_StrictStrings, translate.LF_YAML)
+	case JSON_Extension:
+		lf = settings.jsonTextFile()
+	default:
+		return false, fmt.Errorf("Extension must be %s or %s", YAML_Extension, JSON_Extension)
+	}
+
+	//Read the file
+	var original []byte
+	if f, err := settings.openFile(filePath); err != nil {
+		return false, err
+	} else {
+		original, err = io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	//Format the file and write it if it changed
+	if formatted, err := lf.Format(bytes.NewReader(original)); err != nil {
+		return false, err
+	} else if bytes.Equal(formatted, original) {
+		return false, nil
+	} else if !checkOnly {
+		if err := os.WriteFile(filePath, formatted, 0644); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,52 @@
+//Read files from either the OS or ProcessSettings.FS
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// Converts an OS style path into a valid fs.FS path (unrooted, cleaned, and “.” for the root)
+func fsPath(p string) string {
+	p = strings.TrimPrefix(path.Clean(strings.ReplaceAll(p, "\\", "/")), "/")
+	return cond(len(p) == 0, ".", p)
+}
+
+// Opens a file for reading
+func (settings *ProcessSettings) openFile(name string) (fs.File, error) {
+	if settings.FS == nil {
+		return os.Open(name)
+	}
+	return settings.FS.Open(fsPath(name))
+}
+
+// Gets the info of a file that is read from
+func (settings *ProcessSettings) statFile(name string) (fs.FileInfo, error) {
+	if settings.FS == nil {
+		return os.Stat(name)
+	}
+	return fs.Stat(settings.FS, fsPath(name))
+}
+
+// Reads a directory that files are read from
+func (settings *ProcessSettings) readDir(name string) ([]fs.DirEntry, error) {
+	if settings.FS == nil {
+		return os.ReadDir(name)
+	}
+	return fs.ReadDir(settings.FS, fsPath(name))
+}
+
+// Reads a whole file that is read from
+func (settings *ProcessSettings) readFile(name string) ([]byte, error) {
+	f, err := settings.openFile(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return io.ReadAll(f)
+}
@@ -0,0 +1,70 @@
+//Write the go:embed file for the compiled files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// GoEmbedFileName is the name of the file written (next to the compiled directory) when ProcessSettings.GoEmbedPackage is given
+const GoEmbedFileName = "embed.go"
+
+// Writes GoEmbedFileName into the parent directory of CompiledOutputPath. The file is only written if its contents changed. Returns the file’s path if it was updated
+func (settings *ProcessSettings) saveGoEmbedFile() (updatedFile string, err error) {
+	fileName, contents, changed, err := settings.goEmbedFile()
+	if err != nil || !changed {
+		return "", err
+	} else if err := os.WriteFile(fileName, contents, 0644); err != nil {
+		return "", err
+	}
+	return fileName, nil
+}
+
+// Returns the path and contents of GoEmbedFileName, and if its contents differ from the file that is already there
+func (settings *ProcessSettings) goEmbedFile() (fileName string, contents []byte, changed bool, err error) {
+	//Confirm the package name
+	if !regexp.MustCompile(`^[a-zA-Z_]\w*$`).MatchString(settings.GoEmbedPackage) {
+		return "", nil, false, fmt.Errorf("GoEmbedPackage “%s” is not a valid package name", settings.GoEmbedPackage)
+	}
+
+	//Get the compiled directory name and its parent directory
+	compiledDir := filepath.Clean(strings.TrimRight(settings.CompiledOutputPath, "/\\"))
+	parentDir, compiledDirName := filepath.Dir(compiledDir), filepath.Base(compiledDir)
+	if compiledDirName == "." || compiledDirName == ".." || compiledDirName == string(filepath.Separator) {
+		return "", nil, false, fmt.Errorf("Compiled output path “%s” must be a named directory to be embedded", settings.CompiledOutputPath)
+	}
+
+	//Create the file contents
+	var builder bytes.Buffer
+	_, _ = fmt.Fprintf(&builder, `// Code generated by gol10n. DO NOT EDIT.
+
+package %s
+
+import (
+	"embed"
+	"github.com/dakusan/gol10n/load_compiled"
+	"github.com/dakusan/gol10n/translate"
+)
+
+// CompiledFS holds the compiled translation files
+//
+//go:embed %s/*%s
+var CompiledFS embed.FS
+
+// LoadCompiled loads the dictionary and all the languages from CompiledFS. The returned map is keyed by language identifier
+func LoadCompiled() (languages map[string]*translate.Language, defaultLanguage *translate.Language, err error) {
+	return load_compiled.LoadFromFS(CompiledFS, %q, %q, %t)
+}
+`, settings.GoEmbedPackage, compiledDirName, cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed), compiledDirName, settings.DefaultLanguage, settings.CompressCompiled)
+
+	//The file only needs to be written if it changed
+	fileName = filepath.Join(parentDir, GoEmbedFileName)
+	oldContents, err := os.ReadFile(fileName)
+	return fileName, builder.Bytes(), err != nil || !bytes.Equal(oldContents, builder.Bytes()), nil
+}
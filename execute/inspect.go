@@ -0,0 +1,113 @@
+//Inspecting compiled translation files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// CompiledInspection is a compiled translation file read by InspectCompiledFile()
+type CompiledInspection struct {
+	Header        translate.GTRHeader
+	Lang          *translate.Language //nil if LangErr is set
+	LangErr       error               //Why the translations could not be read, like when they are encrypted and no EncryptionKeyFile is given
+	HasDictionary bool                //If the compiled dictionary in CompiledOutputPath matches the file, so Lang has the names of the namespaces, Translation IDs, and variables
+}
+
+// InspectCompiled reads the language’s compiled translation file from CompiledOutputPath without needing its dictionary (see translate.ParseGTR()), so its settings can be inspected. This includes the CLDR version it was compiled with (see translate.Language.CLDRVersionWarning()).
+//
+// The returned language cannot be used for lookups by namespace and translation ID.
+func (settings *ProcessSettings) InspectCompiled(languageIdentifier string) (*translate.Language, error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return nil, err
+	}
+
+	//Read the file
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+	fileName := languageIdentifier + cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	b, err := settings.readCompiledLanguageFile(settings.CompiledOutputPath+fileName, settings.CompressCompiled)
+	if err != nil {
+		return nil, err
+	}
+
+	//Parse the file
+	lang, err := translate.ParseGTR(b)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse compiled translation file “%s”: %s", fileName, err.Error())
+	}
+	return lang, nil
+}
+
+// InspectCompiledFile reads a compiled translation file, so its header, settings, and translations can be inspected (see translate.Language.DecompileTranslation()). A path that ends in GTR_Extension_Uncompressed or GTR_Extension_Compressed is read from anywhere (and is decompressed by its extension). Otherwise, it is a language identifier whose compiled translation file is read from CompiledOutputPath.
+//
+// If the compiled dictionary in CompiledOutputPath matches the file, the language is loaded against it (with the EncryptionKeyFile key). Otherwise, it is read without a dictionary (see translate.ParseGTR()), and encrypted translation strings cannot be read. An error is only returned if the header cannot be read
+func (settings *ProcessSettings) InspectCompiledFile(filePath string) (ret CompiledInspection, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return ret, err
+	}
+
+	//Read the file and its header
+	isCompressed := strings.HasSuffix(filePath, GTR_Extension_Compressed)
+	if !isCompressed && !strings.HasSuffix(filePath, GTR_Extension_Uncompressed) {
+		isCompressed = settings.CompressCompiled
+		filePath = settings.CompiledOutputPath + settings.ResolveLanguageAlias(filePath) + cond(isCompressed, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	}
+	b, err := settings.readCompiledLanguageFile(filePath, isCompressed)
+	if err != nil {
+		return ret, err
+	}
+	fileName := filepath.Base(filePath)
+	if ret.Header, err = translate.ParseGTRHeader(b); err != nil {
+		return ret, fmt.Errorf("Could not parse compiled translation file “%s”: %s", fileName, err.Error())
+	}
+
+	//Load the language against the compiled dictionary if it matches
+	if dict, err := settings.loadCompiledDictionary(); err == nil {
+		if ret.Lang, err = dict.Load(bytes.NewReader(b), false); err == nil {
+			ret.HasDictionary = true
+			return ret, nil
+		} else if !errors.Is(err, translate.ErrDictionaryMismatch) {
+			ret.LangErr = fmt.Errorf("Could not load compiled translation file “%s”: %s", fileName, err.Error())
+			return ret, nil
+		}
+	}
+
+	//Otherwise, parse it without the dictionary
+	if ret.Lang, err = translate.ParseGTR(b); err != nil {
+		ret.LangErr = fmt.Errorf("Could not parse compiled translation file “%s”: %s", fileName, err.Error())
+	}
+	return ret, nil
+}
+
+// Reads a compiled translation file into memory, decompressing it if it is compressed
+func (settings *ProcessSettings) readCompiledLanguageFile(filePath string, isCompressed bool) ([]byte, error) {
+	fileName := filepath.Base(filePath)
+	f, err := settings.openFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open compiled translation file “%s”: %s", fileName, err.Error())
+	}
+	defer func() { _ = f.Close() }()
+	var r io.Reader = f
+	if isCompressed {
+		if gz, err := gzip.NewReader(f); err != nil {
+			return nil, fmt.Errorf("Could not decompress compiled translation file “%s”: %s", fileName, err.Error())
+		} else {
+			r = gz
+		}
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read compiled translation file “%s”: %s", fileName, err.Error())
+	}
+	return b, nil
+}
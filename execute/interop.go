@@ -0,0 +1,147 @@
+//Convert translation text files to and from Android, Apple, and Flutter resource files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"os"
+)
+
+// ExportAndroidStrings writes an Android strings.xml resource file of the language (see translate.ExportAndroidStrings()). Returns the conversion warnings
+func (settings *ProcessSettings) ExportAndroidStrings(w io.Writer, languageIdentifier string) (warnings []string, err error) {
+	err = settings.withMobileFiles(languageIdentifier, func(sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) error {
+		warnings, err = translate.ExportAndroidStrings(w, sourceFile, sourceType, targetFile, targetType)
+		return err
+	})
+	return
+}
+
+// ExportAppleStrings writes Apple .strings and .stringsdict resource files of the language (see translate.ExportAppleStrings()). Either writer can be nil. Returns the conversion warnings
+func (settings *ProcessSettings) ExportAppleStrings(stringsW, stringsdictW io.Writer, languageIdentifier string) (warnings []string, err error) {
+	err = settings.withMobileFiles(languageIdentifier, func(sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) error {
+		warnings, err = translate.ExportAppleStrings(stringsW, stringsdictW, sourceFile, sourceType, targetFile, targetType)
+		return err
+	})
+	return
+}
+
+// ImportAndroidStrings reads an Android strings.xml resource file and writes the language’s translation text file into InputPath (see translate.LanguageTextFile.ImportAndroidStrings()). Returns the path of the written file and the conversion warnings.
+//
+// If the language’s translation text file already exists, it is overwritten in the same file type (comments are not kept). Otherwise, a YAML file is created. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportAndroidStrings(r io.Reader, languageIdentifier string) (filePath string, warnings []string, err error) {
+	return settings.importMobile(languageIdentifier, func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error) {
+		return lf.ImportAndroidStrings(r, languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	})
+}
+
+// ImportAppleStrings reads Apple .strings and .stringsdict resource files (either can be nil) and writes the language’s translation text file into InputPath (see translate.LanguageTextFile.ImportAppleStrings()). Returns the path of the written file and the conversion warnings.
+//
+// If the language’s translation text file already exists, it is overwritten in the same file type (comments are not kept). Otherwise, a YAML file is created. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportAppleStrings(stringsFile, stringsdictFile io.Reader, languageIdentifier string) (filePath string, warnings []string, err error) {
+	return settings.importMobile(languageIdentifier, func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error) {
+		return lf.ImportAppleStrings(stringsFile, stringsdictFile, languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	})
+}
+
+// ExportARB writes a Flutter ARB file of the language (see translate.ExportARB()). The default language’s file includes the metadata required of the template ARB file. Returns the conversion warnings
+func (settings *ProcessSettings) ExportARB(w io.Writer, languageIdentifier string) (warnings []string, err error) {
+	err = settings.withMobileFiles(languageIdentifier, func(sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) error {
+		warnings, err = translate.ExportARB(w, sourceFile, sourceType, targetFile, targetType)
+		return err
+	})
+	return
+}
+
+// ImportARB reads a Flutter ARB file and writes the language’s translation text file into InputPath (see translate.LanguageTextFile.ImportARB()). Returns the path of the written file and the conversion warnings.
+//
+// If the language’s translation text file already exists, it is overwritten in the same file type (comments are not kept). Otherwise, a YAML file is created. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportARB(r io.Reader, languageIdentifier string) (filePath string, warnings []string, err error) {
+	return settings.importMobile(languageIdentifier, func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error) {
+		return lf.ImportARB(r, languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	})
+}
+
+// The callback for withMobileFiles(). The target file is nil for the default language, or if it does not exist
+type mobileFilesFunc func(sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) error
+
+// Opens the default language’s and the language’s translation text files for the callback. The language’s file is optional
+func (settings *ProcessSettings) withMobileFiles(languageIdentifier string, callback mobileFilesFunc) error {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return err
+	}
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+
+	//Open the default language’s file
+	sourcePath, sourceType, err := settings.findTextFile(settings.DefaultLanguage)
+	if err != nil {
+		return err
+	}
+	sourceFile, err := settings.openFile(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sourceFile.Close() }()
+
+	//Open the language’s file
+	var targetFile io.Reader
+	var targetType translate.LanguageTextFile
+	if languageIdentifier != settings.DefaultLanguage {
+		if targetPath, _targetType, err := settings.findTextFile(languageIdentifier); err != nil {
+			//The language’s file does not exist yet
+		} else if f, err := settings.openFile(targetPath); err != nil {
+			return err
+		} else {
+			defer func() { _ = f.Close() }()
+			targetFile, targetType = f, _targetType
+		}
+	}
+
+	return callback(sourceFile, sourceType, targetFile, targetType)
+}
+
+// Writes the language’s translation text file from an import function
+func (settings *ProcessSettings) importMobile(
+	languageIdentifier string,
+	importFunc func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error),
+) (filePath string, warnings []string, err error) {
+	//Import the file in the file type of the existing file (or YAML)
+	var fileText []byte
+	err = settings.withMobileFiles(languageIdentifier, func(sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) error {
+		var lf translate.LanguageTextFile
+		var err error
+		if filePath, lf, err = settings.importedFilePath(languageIdentifier); err != nil {
+			return err
+		}
+		fileText, warnings, err = importFunc(lf, sourceFile, sourceType, targetFile, targetType)
+		return err
+	})
+	if err != nil {
+		return "", warnings, err
+	}
+
+	return filePath, warnings, os.WriteFile(filePath, fileText, 0644)
+}
+
+// Returns the path and file type to write the language’s imported translation text file to, which is its existing file, or otherwise a new YAML file
+func (settings *ProcessSettings) importedFilePath(languageIdentifier string) (string, translate.LanguageTextFile, error) {
+	//Files can only be written to the OS
+	if settings.FS != nil {
+		return "", 0, errors.New("Imported files cannot be written to a ProcessSettings.FS")
+	}
+
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+	lf := cond(settings.YAMLStrictStrings, translate.LF_YAML_StrictStrings, translate.LF_YAML)
+	filePath := settings.InputPath + languageIdentifier + "." + YAML_Extension
+	if _filePath, _lf, err := settings.findTextFile(languageIdentifier); err == nil {
+		filePath, lf = _filePath, _lf
+	}
+	if !lf.CanBeWritten() {
+		return "", 0, fmt.Errorf("“%s” cannot be written as %s", filePath, unwritableTextFileName(lf))
+	}
+	return filePath, lf, nil
+}
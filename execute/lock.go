@@ -0,0 +1,216 @@
+//String freeze lock files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"os"
+	"sort"
+	"strings"
+)
+
+// The first line of a written lock file
+const lockFileHeader = "#gol10n string freeze lock file. Lines are “Namespace.TranslationID ContentHash”. Change with “gol10n lock” and “gol10n unlock”"
+
+// Lock adds the Translation IDs of the default language to the LockFile with the content hashes of their current text. Once locked, compiling the default language fails if their text changes, until they are unlocked. Each identifier is either a “Namespace” (all of its Translation IDs) or a “Namespace.TranslationID”. If no identifiers are given, all Translation IDs are locked.
+//
+// Already locked Translation IDs are updated to their current text. Returns the locked Translation IDs.
+func (settings *ProcessSettings) Lock(identifiers ...string) (locked []string, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return nil, err
+	}
+
+	//Read the lock file and current hashes
+	lockEntries, err := settings.readLockFile()
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := settings.defaultTranslationHashes()
+	if err != nil {
+		return nil, err
+	}
+
+	//Add the matching Translation IDs
+	for _, tid := range sortedKeys(hashes) {
+		if len(identifiers) == 0 || matchesLockIdentifier(tid, identifiers) {
+			lockEntries[tid] = hashes[tid]
+			locked = append(locked, tid)
+		}
+	}
+	if err := checkLockIdentifiersMatched(identifiers, locked, "in the default language"); err != nil {
+		return nil, err
+	}
+
+	return locked, settings.writeLockFile(lockEntries)
+}
+
+// Unlock removes Translation IDs from the LockFile so their text can change again. Each identifier is either a “Namespace” (all of its locked Translation IDs) or a “Namespace.TranslationID”. Returns the unlocked Translation IDs.
+func (settings *ProcessSettings) Unlock(identifiers ...string) (unlocked []string, err error) {
+	if len(identifiers) == 0 {
+		return nil, errors.New("At least 1 namespace or Translation ID is required")
+	} else if err := settings.checkSettings(); err != nil {
+		return nil, err
+	}
+	lockEntries, err := settings.readLockFile()
+	if err != nil {
+		return nil, err
+	}
+
+	//Remove the matching Translation IDs
+	for _, tid := range sortedKeys(lockEntries) {
+		if matchesLockIdentifier(tid, identifiers) {
+			delete(lockEntries, tid)
+			unlocked = append(unlocked, tid)
+		}
+	}
+	if err := checkLockIdentifiersMatched(identifiers, unlocked, "locked"); err != nil {
+		return nil, err
+	}
+
+	return unlocked, settings.writeLockFile(lockEntries)
+}
+
+// Makes sure none of the locked Translation IDs in the LockFile changed in the default language’s translation text file. Does nothing if there is no LockFile
+func (settings *ProcessSettings) checkLockFile() error {
+	//Read the lock file and current hashes
+	if len(settings.LockFile) == 0 {
+		return nil
+	} else if _, err := os.Stat(settings.LockFile); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	lockEntries, err := settings.readLockFile()
+	if err != nil {
+		return err
+	} else if len(lockEntries) == 0 {
+		return nil
+	}
+	hashes, err := settings.defaultTranslationHashes()
+	if err != nil {
+		return err
+	}
+
+	//Compare the hashes
+	var errs []string
+	for _, tid := range sortedKeys(lockEntries) {
+		if hash, ok := hashes[tid]; !ok {
+			errs = append(errs, fmt.Sprintf("Locked Translation ID “%s” was removed", tid))
+		} else if hash != lockEntries[tid] {
+			errs = append(errs, fmt.Sprintf("Locked Translation ID “%s” was changed", tid))
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("String freeze (%s) violated. Revert the changes or unlock the Translation IDs:\n%s", settings.LockFile, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Returns the content hashes of the Translation IDs in the default language’s translation text file
+func (settings *ProcessSettings) defaultTranslationHashes() (map[string]string, error) {
+	filePath, lf, err := settings.findTextFile(settings.DefaultLanguage)
+	if err != nil {
+		return nil, err
+	}
+	f, err := settings.openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	hashes, err := translate.TranslationHashes(f, lf)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read “%s”: %s", filePath, err.Error())
+	}
+	return hashes, nil
+}
+
+// Reads the Translation IDs and content hashes in the LockFile. A missing file has no entries
+func (settings *ProcessSettings) readLockFile() (map[string]string, error) {
+	if len(settings.LockFile) == 0 {
+		return nil, errors.New("The LockFile setting is not set")
+	}
+	lockEntries := make(map[string]string)
+	data, err := os.ReadFile(settings.LockFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return lockEntries, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("Could not read lock file “%s”: %s", settings.LockFile, err.Error())
+	}
+
+	//Read the “Namespace.TranslationID ContentHash” lines, skipping blank lines and comments
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || strings.IndexByte(fields[0], '.') == -1 {
+			return nil, fmt.Errorf("Lock file “%s” line %d: Must be in the format “Namespace.TranslationID ContentHash”", settings.LockFile, lineNum)
+		}
+		lockEntries[fields[0]] = fields[1]
+	}
+	return lockEntries, scanner.Err()
+}
+
+// Writes the Translation IDs and content hashes to the LockFile, sorted by Translation ID
+func (settings *ProcessSettings) writeLockFile(lockEntries map[string]string) error {
+	var buf strings.Builder
+	buf.WriteString(lockFileHeader + "\n")
+	for _, tid := range sortedKeys(lockEntries) {
+		buf.WriteString(tid + " " + lockEntries[tid] + "\n")
+	}
+	if err := os.WriteFile(settings.LockFile, []byte(buf.String()), 0644); err != nil {
+		return fmt.Errorf("Could not write lock file “%s”: %s", settings.LockFile, err.Error())
+	}
+	return nil
+}
+
+// Returns if a “Namespace.TranslationID” matches any of the “Namespace” or “Namespace.TranslationID” identifiers
+func matchesLockIdentifier(tid string, identifiers []string) bool {
+	for _, identifier := range identifiers {
+		if tid == identifier || strings.HasPrefix(tid, identifier+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns an error listing the identifiers that did not match any of the Translation IDs
+func checkLockIdentifiersMatched(identifiers, tids []string, notFoundDesc string) error {
+	var notFound []string
+	for _, identifier := range identifiers {
+		if !anyMatchesLockIdentifier(tids, identifier) {
+			notFound = append(notFound, identifier)
+		}
+	}
+	if len(notFound) != 0 {
+		return fmt.Errorf("Not found %s: %s", notFoundDesc, strings.Join(notFound, ", "))
+	}
+	return nil
+}
+
+// Returns if any of the Translation IDs match the identifier
+func anyMatchesLockIdentifier(tids []string, identifier string) bool {
+	for _, tid := range tids {
+		if matchesLockIdentifier(tid, []string{identifier}) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the keys of a map in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
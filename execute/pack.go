@@ -0,0 +1,65 @@
+//Pack the compiled files into a bundle archive
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Pack processes all files in the InputPath directory (see Directory()), and then writes the compiled dictionary, the compiled variable dictionary, and every compiled language from CompiledOutputPath into a single .gtrb bundle archive (see translate.SaveGTRB()). The archive is read with translate.LoadBundle().
+//
+// OutputCompiled must be true. Nothing is written if any language fails to process. The archive’s files are compressed if CompressCompiled is true
+func (settings *ProcessSettings) Pack(w io.Writer) (ProcessedFileList, error) {
+	//Process the languages so the compiled files are up to date
+	if !settings.outputCompiled() {
+		return nil, errors.New("Compiled files must be output to pack them")
+	}
+	processedFiles, err := settings.Directory()
+	if err != nil {
+		return processedFiles, err
+	}
+
+	//Confirm all the languages were processed
+	var langIdents, failedLangIdents []string
+	for langIdent, pf := range processedFiles {
+		if pf.Err != nil || pf.Lang == nil {
+			failedLangIdents = append(failedLangIdents, langIdent)
+		} else {
+			langIdents = append(langIdents, langIdent)
+		}
+	}
+	if len(failedLangIdents) != 0 {
+		sort.Strings(failedLangIdents)
+		return processedFiles, fmt.Errorf("Languages failed to process: “%s”", strings.Join(failedLangIdents, "”, “"))
+	}
+
+	//Read the compiled files
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	readCompiledFile := func(fileName string) ([]byte, error) {
+		if b, err := settings.readFile(settings.CompiledOutputPath + fileName); err != nil {
+			return nil, fmt.Errorf("Could not read compiled file “%s”: %s", fileName, err.Error())
+		} else {
+			return b, nil
+		}
+	}
+	files := translate.BundleArchiveFiles{DefaultLanguageIdentifier: settings.DefaultLanguage, Languages: make(map[string][]byte, len(langIdents))}
+	if files.Dictionary, err = readCompiledFile(DictionaryFileBase + compiledFileExt); err != nil {
+		return processedFiles, err
+	} else if files.VarDictionary, err = readCompiledFile(VarDictionaryFileBase + compiledFileExt); err != nil {
+		return processedFiles, err
+	}
+	for _, langIdent := range langIdents {
+		if files.Languages[langIdent], err = readCompiledFile(langIdent + compiledFileExt); err != nil {
+			return processedFiles, err
+		}
+	}
+
+	//Write the archive
+	return processedFiles, translate.SaveGTRB(w, files, settings.CompressCompiled)
+}
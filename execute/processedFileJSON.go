@@ -0,0 +1,79 @@
+//JSON serialization of ProcessedFiles
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"encoding/json"
+)
+
+// The JSON schema of a ProcessedFile. See ProcessedFile.MarshalJSON()
+type processedFileJSON struct {
+	LanguageIdentifier string
+	InputFileName      string
+	Flags              []string           //ProcessedFileFlagNames.Name of the set flags
+	Error              *string            //Null if there was no error
+	Warnings           []string           //Always an array, even when empty
+	OutputFiles        []string           //Always an array, even when empty
+	LanguageName       string             `json:",omitempty"` //Only filled if Flags.PFF_Language_Success*
+	PlannedWrites      []plannedWriteJSON `json:",omitempty"` //Only filled if files would be written in a dry run
+	Stats              processedFileStatsJSON
+}
+
+// The JSON schema of a PlannedWrite
+type plannedWriteJSON struct {
+	FilePath string
+	Reason   string //PlannedWriteReason.String()
+}
+
+// The JSON schema of ProcessedFileStats
+type processedFileStatsJSON struct {
+	ProcessTimeMs    float64
+	InputSize        int64
+	CompiledSize     int64
+	CompressionRatio float64
+}
+
+// Names returns the ProcessedFileFlagNames.Name of the set flags, in the order of ProcessedFileFlagNames
+func (flags ProcessedFileFlag) Names() []string {
+	names := make([]string, 0, len(ProcessedFileFlagNames))
+	for _, f := range ProcessedFileFlagNames {
+		if flags&f.Flag != 0 {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// MarshalJSON encodes the processed file as a JSON object with the members: LanguageIdentifier, InputFileName, Flags (an array of the set ProcessedFileFlagNames.Name), Error (a string, or null if there was no error), Warnings (an array of strings), OutputFiles (an array of strings), LanguageName (only if the language was loaded), PlannedWrites (only if files would be written in a dry run, an array of objects with FilePath and Reason [PlannedWriteReason.String()]), and Stats (an object of the ProcessedFileStats, with ProcessTimeMs and CompressionRatio)
+func (pf *ProcessedFile) MarshalJSON() ([]byte, error) {
+	ret := processedFileJSON{
+		LanguageIdentifier: pf.LangIdentifier,
+		InputFileName:      pf.InputFileName,
+		Flags:              pf.Flags.Names(),
+		Warnings:           cond(pf.Warnings == nil, []string{}, pf.Warnings),
+		OutputFiles:        cond(pf.OutputFiles == nil, []string{}, pf.OutputFiles),
+		Stats: processedFileStatsJSON{
+			float64(pf.Stats.ProcessTime.Microseconds()) / 1000, pf.Stats.InputSize, pf.Stats.CompiledSize, pf.Stats.CompressionRatio(),
+		},
+	}
+	if pf.Err != nil {
+		errStr := pf.Err.Error()
+		ret.Error = &errStr
+	}
+	if pf.Lang != nil {
+		ret.LanguageName = pf.Lang.Name()
+	}
+	for _, pw := range pf.PlannedWrites {
+		ret.PlannedWrites = append(ret.PlannedWrites, plannedWriteJSON{pw.FilePath, pw.Reason.String()})
+	}
+	return json.Marshal(ret)
+}
+
+// MarshalJSON encodes the list as a JSON object of ProcessedFiles (see ProcessedFile.MarshalJSON()) keyed to their language identifier, in sorted order. A nil list is encoded as an empty object
+func (list ProcessedFileList) MarshalJSON() ([]byte, error) {
+	if list == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]*ProcessedFile(list))
+}
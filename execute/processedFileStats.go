@@ -0,0 +1,63 @@
+//The processing times and file sizes of ProcessedFiles
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ProcessedFileStats are the processing time and file sizes of a ProcessedFile, so the languages that slow down builds or grow the compiled files can be found
+type ProcessedFileStats struct {
+	ProcessTime  time.Duration //The wall time to load the language and write its output files (including the dictionary files for the default language)
+	InputSize    int64         //The size of the translation text file. 0 if it was not found
+	CompiledSize int64         //The size of the compiled language file as written (compressed if ProcessSettings.CompressCompiled). 0 if it was not written
+}
+
+// CompressionRatio returns how many times smaller the compiled language file is than its translation text file. 0 if either size is unknown
+func (stats ProcessedFileStats) CompressionRatio() float64 {
+	if stats.InputSize == 0 || stats.CompiledSize == 0 {
+		return 0
+	}
+	return float64(stats.InputSize) / float64(stats.CompiledSize)
+}
+
+// Stats creates 1 line per language (sorted by ProcessedFile.LangIdentifier) with its ProcessedFileStats
+func (list ProcessedFileList) Stats() []string {
+	langIdents := make([]string, 0, len(list))
+	maxLangLen := 0
+	for langIdent := range list {
+		langIdents = append(langIdents, langIdent)
+		if len(langIdent) > maxLangLen {
+			maxLangLen = len(langIdent)
+		}
+	}
+	sort.Strings(langIdents)
+
+	outRows := make([]string, len(langIdents))
+	for i, langIdent := range langIdents {
+		stats := list[langIdent].Stats
+		outRows[i] = fmt.Sprintf("%-*s %s, input %s", maxLangLen+1, langIdent+":", stats.ProcessTime.Round(time.Microsecond), formatFileSize(stats.InputSize))
+		if stats.CompiledSize != 0 {
+			outRows[i] += fmt.Sprintf(", compiled %s (%.2fx)", formatFileSize(stats.CompiledSize), stats.CompressionRatio())
+		}
+	}
+	return outRows
+}
+
+// Returns the size with a unit (B, KB, MB, or GB)
+func formatFileSize(size int64) string {
+	const unitSize = 1024
+	if size < unitSize {
+		return fmt.Sprintf("%dB", size)
+	}
+	floatSize := float64(size)
+	for _, unit := range []string{"KB", "MB", "GB"} {
+		if floatSize /= unitSize; floatSize < unitSize || unit == "GB" {
+			return fmt.Sprintf("%.1f%s", floatSize, unit)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+//Progress reporting of the files processed by Directory()
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"sync"
+)
+
+// ProgressEventType is what happened to a file in a ProgressEvent
+type ProgressEventType uint8
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	PE_FileStarted  ProgressEventType = iota //The file started processing
+	PE_FileFinished                          //The file finished processing (with or without an error). Its fallback was not set yet
+	PE_FileWarnings                          //The file has warnings, after all of its checks (including the fallback checks) are done. Only sent for files that loaded
+)
+
+var progressEventTypeNames = []string{"FileStarted", "FileFinished", "FileWarnings"}
+
+// String returns the name of the ProgressEventType
+func (t ProgressEventType) String() string {
+	if int(t) < len(progressEventTypeNames) {
+		return progressEventTypeNames[t]
+	}
+	return "Unknown"
+}
+
+// ProgressEvent is sent to ProcessSettings.Progress as Directory() processes the files
+type ProgressEvent struct {
+	Type           ProgressEventType
+	LangIdentifier string
+	InputFileName  string
+	Err            error    //Only on PE_FileFinished
+	Warnings       []string //Only on PE_FileFinished and PE_FileWarnings. On PE_FileFinished, this only holds the warnings found so far
+	NumFiles       uint     //The number of translation text files being processed
+	NumFinished    uint     //The number of files that finished processing, including this one on PE_FileFinished
+}
+
+// Returns the function that sends the ProgressEvents of the files to Progress, one at a time. Does nothing if Progress is not given
+func (settings *ProcessSettings) progressReporter(numFiles uint) func(eventType ProgressEventType, pf *ProcessedFile) {
+	if settings.Progress == nil {
+		return func(ProgressEventType, *ProcessedFile) {}
+	}
+
+	var lock sync.Mutex
+	var numFinished uint
+	return func(eventType ProgressEventType, pf *ProcessedFile) {
+		lock.Lock()
+		defer lock.Unlock()
+		event := ProgressEvent{Type: eventType, LangIdentifier: pf.LangIdentifier, InputFileName: pf.InputFileName, NumFiles: numFiles}
+		switch eventType {
+		case PE_FileFinished:
+			numFinished++
+			event.Err, event.Warnings = pf.Err, pf.Warnings
+		case PE_FileWarnings:
+			event.Warnings = pf.Warnings
+		}
+		event.NumFinished = numFinished
+		settings.Progress(event)
+	}
+}
@@ -0,0 +1,100 @@
+//Export translation text files as spreadsheets for translators
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Spreadsheet file extensions
+const (
+	CSV_Extension  = "csv"
+	XLSX_Extension = "xlsx"
+)
+
+// ExportSheet writes a spreadsheet of the translations of the languages (see translate.ExportSheet()). If no languages are given, all languages with translation text files in InputPath are included. The default language is always the first language column
+func (settings *ProcessSettings) ExportSheet(w io.Writer, format translate.SheetFormat, languageIdentifiers ...string) error {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return err
+	}
+
+	//Get the languages in the input directory
+	if len(languageIdentifiers) == 0 {
+		d, err := settings.readDir(settings.InputPath)
+		if err != nil {
+			return errors.New("Error reading input path: " + err.Error())
+		}
+		checkFiletype := textFileNameRegex()
+		for _, f := range d {
+			if fName := f.Name(); !f.IsDir() && checkFiletype.MatchString(strings.ToLower(fName)) {
+				languageIdentifiers = append(languageIdentifiers, fName[0:strings.LastIndexByte(fName, '.')])
+			}
+		}
+		sort.Strings(languageIdentifiers)
+	}
+
+	//Open the files with the default language first
+	var languages []translate.SheetLanguage
+	isAdded := make(map[string]bool)
+	for _, langIdent := range append([]string{settings.DefaultLanguage}, languageIdentifiers...) {
+		if langIdent = settings.ResolveLanguageAlias(langIdent); isAdded[langIdent] {
+			continue
+		}
+		isAdded[langIdent] = true
+
+		filePath, lf, err := settings.findTextFile(langIdent)
+		if err != nil {
+			return err
+		}
+		f, err := settings.openFile(filePath)
+		if err != nil {
+			return err
+		}
+		//goland:noinspection GoDeferInLoop
+		defer func() { _ = f.Close() }()
+		languages = append(languages, translate.SheetLanguage{File: f, Type: lf})
+	}
+
+	return translate.ExportSheet(w, format, languages)
+}
+
+// ImportSheet reads a spreadsheet written by ExportSheet() and writes the translation text files of its language columns into InputPath (see translate.LanguageTextFile.ImportSheet()). The default language’s column is not imported, and is used to find translations made against text that has since changed. Returns the paths of the written files, and the conflicts (prefixed by their language identifier), which are the translations that were not imported.
+//
+// If a language’s translation text file already exists, it is overwritten in the same file type (comments are not kept). Otherwise, a YAML file is created. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportSheet(r io.Reader, format translate.SheetFormat) (filePaths []string, conflicts []string, err error) {
+	//Read the spreadsheet
+	if err := settings.checkSettings(); err != nil {
+		return nil, nil, err
+	}
+	sheet, err := translate.ReadSheet(r, format)
+	if err != nil {
+		return nil, nil, err
+	}
+	languages := sheet.Languages()
+	if settings.ResolveLanguageAlias(languages[0]) != settings.DefaultLanguage {
+		return nil, nil, fmt.Errorf("The first language column “%s” must be the default language “%s”", languages[0], settings.DefaultLanguage)
+	}
+
+	//Import each language
+	for _, langIdent := range languages[1:] {
+		filePath, langConflicts, err := settings.importMobile(langIdent, func(lf translate.LanguageTextFile, sourceFile io.Reader, sourceType translate.LanguageTextFile, targetFile io.Reader, targetType translate.LanguageTextFile) ([]byte, []string, error) {
+			return lf.ImportSheet(sheet, langIdent, sourceFile, sourceType, targetFile, targetType)
+		})
+		for _, conflict := range langConflicts {
+			conflicts = append(conflicts, langIdent+": "+conflict)
+		}
+		if err != nil {
+			return filePaths, conflicts, fmt.Errorf("%s: %s", langIdent, err.Error())
+		}
+		filePaths = append(filePaths, filePath)
+	}
+
+	return filePaths, conflicts, nil
+}
@@ -0,0 +1,105 @@
+//Sign the compiled files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// SignatureExtension is appended to the name of a compiled file for its detached signature file (see ProcessSettings.SigningKeyFile)
+const SignatureExtension = ".sig"
+
+// A compiled file being written. Its contents are kept so it can be signed if SigningKeyFile is given
+type compiledFile struct {
+	f        *os.File
+	settings *ProcessSettings
+	contents bytes.Buffer
+}
+
+func (fc *compiledFile) Write(p []byte) (int, error) {
+	if fc.settings.signingKey != nil {
+		fc.contents.Write(p)
+	}
+	return fc.f.Write(p)
+}
+
+func (fc *compiledFile) Close() error {
+	return fc.f.Close()
+}
+
+// Writes the signature file of the compiled file. Does nothing if SigningKeyFile is not given. This must be called after the file is completely written
+func (fc *compiledFile) sign() error {
+	if fc.settings.signingKey == nil {
+		return nil
+	}
+	sigFilePath := fc.f.Name() + SignatureExtension
+	if err := fc.settings.rotateCompiledBackups(sigFilePath); err != nil {
+		return fmt.Errorf("Could not back up signature: %s", err.Error())
+	} else if err := os.WriteFile(sigFilePath, ed25519.Sign(fc.settings.signingKey, fc.contents.Bytes()), 0644); err != nil {
+		return fmt.Errorf("Could not write signature: %s", err.Error())
+	}
+	return nil
+}
+
+// Reads SigningKeyFile, which must be a PEM encoded PKCS #8 Ed25519 private key (like from “openssl genpkey -algorithm ed25519”)
+func (settings *ProcessSettings) loadSigningKey() error {
+	settings.signingKey = nil
+	if len(settings.SigningKeyFile) == 0 {
+		return nil
+	}
+
+	b, err := os.ReadFile(settings.SigningKeyFile)
+	if err != nil {
+		return fmt.Errorf("Could not read signing key file “%s”: %s", settings.SigningKeyFile, err.Error())
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return fmt.Errorf("Signing key file “%s” is not PEM encoded", settings.SigningKeyFile)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("Could not parse signing key file “%s”: %s", settings.SigningKeyFile, err.Error())
+	} else if edKey, ok := key.(ed25519.PrivateKey); !ok {
+		return fmt.Errorf("Signing key file “%s” is not an Ed25519 private key", settings.SigningKeyFile)
+	} else {
+		settings.signingKey = edKey
+	}
+	return nil
+}
+
+// Returns if the compiled files in CompiledOutputPath have valid signatures from the signing key, so they can be used instead of being compiled (and signed) again. Always true if SigningKeyFile is not given
+func (settings *ProcessSettings) hasValidSignatures(fileNames ...string) bool {
+	if settings.signingKey == nil {
+		return true
+	}
+	publicKey := settings.signingKey.Public().(ed25519.PublicKey)
+	for _, fileName := range fileNames {
+		if b, err := settings.readFile(settings.CompiledOutputPath + fileName); err != nil {
+			return false
+		} else if sig, err := settings.readFile(settings.CompiledOutputPath + fileName + SignatureExtension); err != nil {
+			return false
+		} else if !ed25519.Verify(publicKey, b, sig) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the names of the compiled files that are written when processing the language
+func (settings *ProcessSettings) compiledFileNames(languageIdentifier string) []string {
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	fileNames := []string{languageIdentifier + compiledFileExt}
+	if languageIdentifier == settings.DefaultLanguage {
+		fileNames = append(fileNames, DictionaryFileBase+compiledFileExt, VarDictionaryFileBase+compiledFileExt)
+		if settings.CombinedDictionary {
+			fileNames = append(fileNames, CombinedDictionaryFileBase+compiledFileExt)
+		}
+	}
+	return fileNames
+}
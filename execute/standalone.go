@@ -0,0 +1,64 @@
+//Write standalone compiled language files
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Standalone processes all files in the InputPath directory (see Directory()), and then writes a standalone compiled language file for each of the languages into CompiledOutputPath, named “$LanguageIdentifier.standalone.gtr” (see translate.Language.SaveGTRStandalone()). Each holds the dictionary and the language’s fallback languages, so it can be loaded by itself through translate.LF_GTR.LoadStandalone().
+//
+// If no language identifiers are given, a file is written for every language. OutputCompiled must be true. The files are compressed if CompressCompiled is true
+func (settings *ProcessSettings) Standalone(languageIdentifiers ...string) (ProcessedFileList, error) {
+	//Process the languages so their fallbacks are set
+	if !settings.outputCompiled() {
+		return nil, errors.New("Compiled files must be output to write standalone files")
+	}
+	processedFiles, err := settings.Directory()
+	if err != nil {
+		return processedFiles, err
+	}
+
+	//Get the languages to write
+	if len(languageIdentifiers) == 0 {
+		for langIdent := range processedFiles {
+			languageIdentifiers = append(languageIdentifiers, langIdent)
+		}
+		sort.Strings(languageIdentifiers)
+	}
+
+	//Write the standalone files
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	for _, langIdent := range languageIdentifiers {
+		langIdent = settings.ResolveLanguageAlias(langIdent)
+		pf, ok := processedFiles[langIdent]
+		if !ok {
+			return processedFiles, fmt.Errorf("Language “%s” was not found", langIdent)
+		} else if pf.Err != nil || pf.Lang == nil || pf.Flags&PFF_Language_SuccessfullyLoaded == 0 {
+			return processedFiles, fmt.Errorf("Language “%s” failed to process", langIdent)
+		} else if err := settings.saveStandaloneFile(pf, langIdent+StandaloneFileInfix+compiledFileExt); err != nil {
+			return processedFiles, err
+		}
+	}
+
+	return processedFiles, nil
+}
+
+// Writes the standalone compiled language file of the processed language
+func (settings *ProcessSettings) saveStandaloneFile(pf *ProcessedFile, fileName string) error {
+	fc, err := settings.createCompiledFile(fileName)
+	if err != nil {
+		return fmt.Errorf("Could not open standalone file “%s”: %s", fileName, err.Error())
+	}
+	defer func() { _ = fc.Close() }()
+	if err := pf.Lang.SaveGTRStandalone(fc, settings.CompressCompiled, settings.saveOptions()...); err != nil {
+		return fmt.Errorf("Could not save standalone file “%s”: %s", fileName, err.Error())
+	} else if err := fc.sign(); err != nil {
+		return fmt.Errorf("Could not sign standalone file “%s”: %s", fileName, err.Error())
+	}
+	pf.OutputFiles = append(pf.OutputFiles, settings.CompiledOutputPath+fileName)
+	return nil
+}
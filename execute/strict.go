@@ -0,0 +1,82 @@
+//Strict mode, which promotes warnings to errors
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"strings"
+)
+
+// The warning codes that StrictAllowedWarnings can hold
+var strictWarningCodes = []string{translate.WC_MissingTranslation, translate.WC_MissingNamespace, translate.WC_ExtraTranslation, translate.WC_ExtraNamespace, translate.WC_VariableMismatch, translate.WC_BrokenEmbed, translate.WC_Other}
+
+// Makes sure the warning codes in StrictAllowedWarnings exist
+func (settings *ProcessSettings) checkStrictAllowedWarnings() error {
+	var unknown []string
+	for _, allowed := range settings.StrictAllowedWarnings {
+		found := false
+		for _, code := range strictWarningCodes {
+			if found = strictCodeMatches(allowed, code); found {
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, allowed)
+		}
+	}
+	if len(unknown) != 0 {
+		return fmt.Errorf("Unknown strict allowed warning codes (%s). They must be one of: %s", strings.Join(unknown, ", "), strings.Join(strictWarningCodes, ", "))
+	}
+	return nil
+}
+
+// Returns if an allowed warning code (with or without its brackets) matches the warning code
+func strictCodeMatches(allowed, code string) bool {
+	allowed = strings.TrimSpace(allowed)
+	return strings.EqualFold(allowed, code) || strings.EqualFold("["+allowed+"]", code)
+}
+
+// Returns if strict mode allows the warning through its code (see translate.WarningCode()). The allowed codes can be given with or without their brackets
+func (settings *ProcessSettings) strictAllowsWarning(warning string) bool {
+	code := translate.WarningCode(warning)
+	for _, allowed := range settings.StrictAllowedWarnings {
+		if strictCodeMatches(allowed, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// In strict mode, moves the file’s warnings that are not allowed into its error. Returns if the file has an error
+func (settings *ProcessSettings) applyStrict(pf *ProcessedFile) bool {
+	if !settings.Strict || len(pf.Warnings) == 0 {
+		return pf.Err != nil
+	}
+
+	//Split the warnings
+	var warnings, promoted []string
+	for _, warning := range pf.Warnings {
+		if settings.strictAllowsWarning(warning) {
+			warnings = append(warnings, warning)
+		} else {
+			promoted = append(promoted, warning)
+		}
+	}
+	if len(promoted) == 0 {
+		return pf.Err != nil
+	}
+
+	//Add them to the error
+	pf.Warnings = warnings
+	pf.Flags |= PFF_Error_DuringProcessing
+	errStr := fmt.Sprintf("Strict mode does not allow %d warnings:\n%s", len(promoted), strings.Join(promoted, "\n"))
+	if pf.Err != nil {
+		pf.Err = fmt.Errorf("%w\n%s", pf.Err, errStr) //Keep the original error so errors.Is() and errors.As() still find it
+	} else {
+		pf.Err = errors.New(errStr)
+	}
+	return true
+}
@@ -3,6 +3,12 @@
 
 package execute
 
+import (
+	"github.com/dakusan/gol10n/translate"
+	"regexp"
+	"strings"
+)
+
 // Keys returns the keys of the map m. The keys will be in an indeterminate order.
 // I had this as a compat for go1.21, but maps.Keys was removed from go1.21 in the release version
 func getMapKeys[M ~map[K]V, K comparable, V any](m M) []K {
@@ -16,6 +22,64 @@ func getMapKeys[M ~map[K]V, K comparable, V any](m M) []K {
 	return ret
 }
 
+// Returns the file type that JSON translation text files are read as
+func (settings *ProcessSettings) jsonTextFile() translate.LanguageTextFile {
+	if settings.I18nextJSON {
+		return translate.LF_JSON_I18Next
+	}
+	return cond(settings.AllowJSONTrailingComma, translate.LF_JSON_AllowTrailingComma, translate.LF_JSON)
+}
+
+// TextFileExtensions returns the extensions of translation text files, which are YAML_Extension, JSON_Extension, TOML_Extension, and the extensions of the custom formats registered through translate.RegisterTextFormat()
+func TextFileExtensions() []string {
+	return append([]string{YAML_Extension, JSON_Extension, TOML_Extension}, translate.TextFormatExtensions()...)
+}
+
+// IsTextFileExtension returns if the extension is a translation text file extension (see TextFileExtensions())
+func IsTextFileExtension(ext string) bool {
+	for _, textExt := range TextFileExtensions() {
+		if ext == textExt {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the regex that matches lowercased translation text file names
+func textFileNameRegex() *regexp.Regexp {
+	exts := TextFileExtensions()
+	for i, ext := range exts {
+		exts[i] = regexp.QuoteMeta(ext)
+	}
+	return regexp.MustCompile(`^` + languageIdentifierRegex + `\.(` + strings.Join(exts, "|") + `)$`)
+}
+
+// Returns the file type that translation text files with the extension are read as
+func (settings *ProcessSettings) textFileType(ext string) (translate.LanguageTextFile, bool) {
+	switch ext {
+	case YAML_Extension:
+		return cond(settings.YAMLStrictStrings, translate.LF_YAML_StrictStrings, translate.LF_YAML), true
+	case JSON_Extension:
+		return settings.jsonTextFile(), true
+	case TOML_Extension:
+		return translate.LF_TOML, true
+	default:
+		return translate.TextFormatByExtension(ext)
+	}
+}
+
+// Returns the name of a file type that cannot be written, for error messages
+func unwritableTextFileName(lf translate.LanguageTextFile) string {
+	switch lf {
+	case translate.LF_TOML:
+		return "TOML"
+	case translate.LF_JSON_I18Next:
+		return "i18next JSON"
+	default:
+		return "a custom format"
+	}
+}
+
 // Conditional
 func cond[T any](isTrue bool, ifTrue, ifFalse T) T {
 	if isTrue {
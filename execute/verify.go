@@ -0,0 +1,203 @@
+//Verify the compiled output set before deploying it
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"strings"
+)
+
+// VerifyReport is the result of Verify()
+type VerifyReport struct {
+	translate.PackReport                         //The checks of the compiled dictionary and the compiled language files (see translate.VerifyPack())
+	LanguageFiles        []string                //The file names of the compiled language files, in the same order as PackReport.Languages
+	Problems             []translate.PackProblem //Problems of the compiled output set as a whole, like the variable dictionary not loading or the default language being missing
+}
+
+// OK returns if no problems were found
+func (r *VerifyReport) OK() bool {
+	return len(r.Problems) == 0 && r.PackReport.OK()
+}
+
+// Err returns all problems joined as a single error, or nil if no problems were found
+func (r *VerifyReport) Err() error {
+	var errs []string
+	for _, p := range r.Problems {
+		errs = append(errs, p.Error())
+	}
+	if err := r.PackReport.Err(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, "\n"))
+}
+
+// Verify checks the compiled dictionary, the compiled variable (or combined) dictionary, and every compiled language file in CompiledOutputPath before they are deployed, and returns a report of all problems found. Standalone files and backups are not checked.
+//
+// On top of the checks of translate.VerifyPack(), the variable dictionary must load, the default language must exist, and each language’s fallback chain (through LanguageAliases) must resolve to the default language without loops. Languages with no problems are then fully loaded and have their fallbacks set. Encrypted language files are only fully loaded if EncryptionKeyFile is given. An error is only returned if the settings are invalid or the directory cannot be read
+func (settings *ProcessSettings) Verify() (report VerifyReport, err error) {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return report, err
+	}
+	compiledFileExt := cond(settings.CompressCompiled, GTR_Extension_Compressed, GTR_Extension_Uncompressed)
+	addProblem := func(kind translate.PackProblemKind, message string) {
+		report.Problems = append(report.Problems, translate.PackProblem{Kind: kind, Message: message})
+	}
+
+	//Get the compiled language files
+	entries, err := settings.readDir(settings.CompiledOutputPath)
+	if err != nil {
+		return report, fmt.Errorf("Could not read compiled output path “%s”: %s", settings.CompiledOutputPath, err.Error())
+	}
+	var langIdents []string
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(fileName, compiledFileExt) {
+			continue
+		}
+		langIdent := strings.TrimSuffix(fileName, compiledFileExt)
+		if langIdent == DictionaryFileBase || langIdent == VarDictionaryFileBase || langIdent == CombinedDictionaryFileBase || strings.HasSuffix(langIdent, StandaloneFileInfix) {
+			continue
+		}
+		langIdents = append(langIdents, langIdent)
+		report.LanguageFiles = append(report.LanguageFiles, fileName)
+	}
+
+	//Read the files and verify them as a pack
+	dictBytes, dictErr := settings.readFile(settings.CompiledOutputPath + DictionaryFileBase + compiledFileExt)
+	langBytes, langErrs := make([][]byte, len(langIdents)), make([]error, len(langIdents))
+	langReaders := make([]io.Reader, len(langIdents))
+	for i, fileName := range report.LanguageFiles {
+		langBytes[i], langErrs[i] = settings.readCompiledLanguageFile(settings.CompiledOutputPath+fileName, settings.CompressCompiled)
+		langReaders[i] = bytes.NewReader(langBytes[i])
+	}
+	report.PackReport = translate.VerifyPack(bytes.NewReader(dictBytes), langReaders...)
+	if dictErr != nil {
+		report.DictionaryProblems = []translate.PackProblem{{Kind: translate.PP_ReadError, Message: fmt.Sprintf("Could not read compiled dictionary file “%s”: %s", DictionaryFileBase+compiledFileExt, dictErr.Error())}}
+	}
+	for i, err := range langErrs {
+		if err != nil {
+			report.Languages[i].Problems = []translate.PackProblem{{Kind: translate.PP_ReadError, Message: err.Error()}}
+		} else if identifier := report.Languages[i].LanguageIdentifier; len(identifier) != 0 && identifier != langIdents[i] {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, translate.PackProblem{Kind: translate.PP_InvalidSettings, Message: fmt.Sprintf("The file name does not match its language identifier “%s”", identifier)})
+		}
+	}
+
+	//Load the dictionary with its variables
+	dict, err := settings.loadCompiledDictionary()
+	if err != nil {
+		addProblem(translate.PP_ReadError, err.Error())
+	}
+
+	//The default language must exist
+	langIndexes := make(map[string]int, len(langIdents))
+	for i, langIdent := range langIdents {
+		langIndexes[langIdent] = i
+	}
+	if _, ok := langIndexes[settings.DefaultLanguage]; !ok {
+		addProblem(translate.PP_MissingFallback, fmt.Sprintf("The default language “%s” is missing", settings.DefaultLanguage))
+	}
+
+	//Fallbacks are resolved through the language aliases, and must lead to the default language without loops
+	fallbackIndex := func(i int) (int, bool) {
+		fallbackName := settings.ResolveLanguageAlias(report.Languages[i].FallbackName)
+		if len(fallbackName) == 0 {
+			fallbackName = settings.DefaultLanguage
+		}
+		fi, ok := langIndexes[fallbackName]
+		return fi, ok
+	}
+	for i := range report.Languages {
+		l := &report.Languages[i]
+		problems := l.Problems[:0]
+		for _, p := range l.Problems {
+			if p.Kind != translate.PP_MissingFallback {
+				problems = append(problems, p)
+			}
+		}
+		l.Problems = problems
+		if langIdents[i] == settings.DefaultLanguage {
+			if len(l.FallbackName) != 0 {
+				l.Problems = append(l.Problems, translate.PackProblem{Kind: translate.PP_InvalidSettings, Message: fmt.Sprintf("The default language cannot have a fallback language (“%s”)", l.FallbackName)})
+			}
+			continue
+		} else if _, ok := fallbackIndex(i); !ok {
+			if len(l.FallbackName) != 0 {
+				l.Problems = append(l.Problems, translate.PackProblem{Kind: translate.PP_MissingFallback, Message: fmt.Sprintf("Fallback language “%s” does not exist", l.FallbackName)})
+			}
+			continue
+		}
+		for cur, chainLen := i, 0; langIdents[cur] != settings.DefaultLanguage; chainLen++ {
+			next, ok := fallbackIndex(cur)
+			if !ok {
+				break
+			} else if chainLen == len(langIdents) {
+				l.Problems = append(l.Problems, translate.PackProblem{Kind: translate.PP_MissingFallback, Message: "Fallback loop detected"})
+				break
+			}
+			cur = next
+		}
+	}
+	if dict == nil {
+		return report, nil
+	}
+
+	//Fully load the languages without problems. Encrypted languages are skipped without the encryption key
+	const (
+		lsNotLoaded = iota
+		lsSkipped
+		lsLoaded
+		lsFallbackSet
+	)
+	langs, langStates := make([]*translate.Language, len(langIdents)), make([]int, len(langIdents))
+	for i, b := range langBytes {
+		if len(report.Languages[i].Problems) != 0 {
+			continue
+		} else if header, err := translate.ParseGTRHeader(b); err == nil && header.FileType != "GT" && settings.encryptionKey == nil {
+			langStates[i] = lsSkipped
+			continue
+		}
+		if langIdents[i] == settings.DefaultLanguage {
+			langs[i], err = dict.LoadDefault(bytes.NewReader(b), false)
+		} else {
+			langs[i], err = dict.Load(bytes.NewReader(b), false)
+		}
+		if err != nil {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, translate.PackProblem{Kind: translate.PP_Corrupt, Message: err.Error()})
+		} else {
+			langStates[i] = cond(langIdents[i] == settings.DefaultLanguage, lsFallbackSet, lsLoaded)
+		}
+	}
+
+	//Set the fallbacks of the loaded languages, starting from the end of their chains
+	var setFallback func(i int) int
+	setFallback = func(i int) int {
+		if langStates[i] != lsLoaded {
+			return langStates[i]
+		}
+		langStates[i] = lsNotLoaded
+		fi, _ := fallbackIndex(i)
+		if fallbackState := setFallback(fi); fallbackState == lsSkipped {
+			langStates[i] = lsSkipped
+		} else if fallbackState != lsFallbackSet {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, translate.PackProblem{Kind: translate.PP_MissingFallback, Message: fmt.Sprintf("Fallback language “%s” could not be loaded", langIdents[fi])})
+		} else if err := langs[i].SetFallbackWithAliases(langs[fi], settings.LanguageAliases); err != nil {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, translate.PackProblem{Kind: translate.PP_MissingFallback, Message: fmt.Sprintf("Could not set fallback language “%s”: %s", langIdents[fi], err.Error())})
+		} else {
+			langStates[i] = lsFallbackSet
+		}
+		return langStates[i]
+	}
+	for i := range langs {
+		setFallback(i)
+	}
+	return report, nil
+}
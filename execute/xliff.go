@@ -0,0 +1,103 @@
+//Exchange translation text files with translation vendors through XLIFF 2.0
+//go:build !gol10n_read_compiled_only
+
+package execute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"os"
+)
+
+// XLIFF_Extension is the file extension for XLIFF files
+const XLIFF_Extension = "xlf"
+
+// ExportXLIFF writes an XLIFF 2.0 document for translating the default language into the given language (see translate.ExportXLIFF()). The language’s translation text file is optional, and its existing translations are included when it exists
+func (settings *ProcessSettings) ExportXLIFF(w io.Writer, languageIdentifier string) error {
+	//Check and update the settings
+	if err := settings.checkSettings(); err != nil {
+		return err
+	}
+	languageIdentifier = settings.ResolveLanguageAlias(languageIdentifier)
+	if languageIdentifier == settings.DefaultLanguage {
+		return errors.New("Cannot export the default language to XLIFF")
+	}
+
+	//Open the default language’s file
+	sourcePath, sourceType, err := settings.findTextFile(settings.DefaultLanguage)
+	if err != nil {
+		return err
+	}
+	sourceFile, err := settings.openFile(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sourceFile.Close() }()
+
+	//Open the language’s file if it exists
+	var targetFile io.Reader
+	targetPath, targetType, err := settings.findTextFile(languageIdentifier)
+	if err == nil {
+		f, err := settings.openFile(targetPath)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		targetFile = f
+	}
+
+	return translate.ExportXLIFF(w, sourceFile, sourceType, languageIdentifier, targetFile, targetType)
+}
+
+// ImportXLIFF reads an XLIFF 2.0 document created by ExportXLIFF() and writes its target language’s translation text file into InputPath (see translate.LanguageTextFile.ImportXLIFF()). Returns the path of the written file.
+//
+// If the language’s translation text file already exists, it is overwritten in the same file type (comments and untranslated units are not kept). Otherwise, a YAML file is created. TOML, i18next JSON, and custom format files cannot be written.
+func (settings *ProcessSettings) ImportXLIFF(r io.Reader) (filePath string, err error) {
+	//Files can only be written to the OS
+	if err := settings.checkSettings(); err != nil {
+		return "", err
+	} else if settings.FS != nil {
+		return "", errors.New("Imported files cannot be written to a ProcessSettings.FS")
+	}
+
+	//Import the XLIFF document as YAML, which also gets the language identifier
+	var fileText []byte
+	var langIdent string
+	xliffText, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	} else if fileText, langIdent, err = cond(settings.YAMLStrictStrings, translate.LF_YAML_StrictStrings, translate.LF_YAML).ImportXLIFF(bytes.NewReader(xliffText)); err != nil {
+		return "", err
+	} else if langIdent == settings.DefaultLanguage {
+		return "", errors.New("Cannot import XLIFF into the default language")
+	}
+
+	//If the language’s file already exists, write in its file type
+	if _filePath, lf, err := settings.findTextFile(langIdent); err != nil {
+		filePath = settings.InputPath + langIdent + "." + YAML_Extension
+	} else if !lf.CanBeWritten() {
+		return "", fmt.Errorf("“%s” cannot be written as %s", _filePath, unwritableTextFileName(lf))
+	} else if filePath = _filePath; lf != translate.LF_YAML && lf != translate.LF_YAML_StrictStrings {
+		if fileText, _, err = lf.ImportXLIFF(bytes.NewReader(xliffText)); err != nil {
+			return "", err
+		}
+	}
+
+	return filePath, os.WriteFile(filePath, fileText, 0644)
+}
+
+// Returns the path and file type of a language’s translation text file in InputPath
+func (settings *ProcessSettings) findTextFile(languageIdentifier string) (filePath string, lf translate.LanguageTextFile, err error) {
+	for _, ext := range TextFileExtensions() {
+		filePath = settings.InputPath + languageIdentifier + "." + ext
+		if fInfo, err := settings.statFile(filePath); err != nil || fInfo.IsDir() {
+			continue
+		} else if _lf, ok := settings.textFileType(ext); ok {
+			return filePath, _lf, nil
+		}
+	}
+	return "", lf, fileNotFound(fmt.Errorf("File for “%s” was not found", languageIdentifier))
+}
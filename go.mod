@@ -3,6 +3,7 @@ module github.com/dakusan/gol10n
 go 1.20
 
 require (
+	github.com/BurntSushi/toml v1.3.2
 	github.com/fsnotify/fsnotify v1.7.0
 	github.com/klauspost/lctime v0.1.0
 	github.com/spf13/pflag v1.0.5
@@ -0,0 +1,75 @@
+// Package httpl10n negotiates the language of HTTP requests and stores it in their context
+package httpl10n
+
+import (
+	"context"
+	"github.com/dakusan/gol10n/translate"
+	"golang.org/x/text/language"
+	"net/http"
+	"strings"
+)
+
+// Negotiator picks the language of HTTP requests from the registry. The sources are checked in order: the query parameter, the cookie, and then the Accept-Language headers. The registry’s default language is used if none of them match
+type Negotiator struct {
+	Registry   *translate.Registry
+	QueryParam string //The query parameter holding a language identifier (Example: “lang” for “?lang=fr-CA”). Not checked if blank
+	CookieName string //The cookie holding a language identifier. Not checked if blank
+}
+
+// The context key of the language
+type contextKey struct{}
+
+// NewContext returns a copy of the context that holds the language
+func NewContext(ctx context.Context, lang *translate.Language) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext returns the language stored in the context by NewContext() or the Negotiator’s middleware. Returns nil if there is none
+func FromContext(ctx context.Context) *translate.Language {
+	lang, _ := ctx.Value(contextKey{}).(*translate.Language)
+	return lang
+}
+
+// Middleware stores the negotiated language of each request in its context (see FromContext()) before calling the next handler. Example:
+//
+//	http.ListenAndServe(":8080", httpl10n.Negotiator{Registry: registry, QueryParam: "lang"}.Middleware(mux))
+//
+// Responses that depend on the language should be cached per language (like with a “Vary: Accept-Language” header)
+func (n Negotiator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), n.Language(r))))
+	})
+}
+
+// Language returns the negotiated language of the request. Query parameters and cookies that are not valid language identifiers, or do not match a language of the registry, are skipped
+func (n Negotiator) Language(r *http.Request) *translate.Language {
+	//Check the query parameter and cookie
+	if len(n.QueryParam) != 0 {
+		if l := n.matchIdentifier(r.URL.Query().Get(n.QueryParam)); l != nil {
+			return l
+		}
+	}
+	if len(n.CookieName) != 0 {
+		if cookie, err := r.Cookie(n.CookieName); err == nil {
+			if l := n.matchIdentifier(cookie.Value); l != nil {
+				return l
+			}
+		}
+	}
+
+	//Fall back to the Accept-Language headers
+	return n.Registry.Match(strings.Join(r.Header.Values("Accept-Language"), ","))
+}
+
+// Returns the registry’s language that matches the language identifier, or nil if there is none
+func (n Negotiator) matchIdentifier(languageIdentifier string) *translate.Language {
+	if len(languageIdentifier) == 0 {
+		return nil
+	} else if tag, err := language.Parse(languageIdentifier); err != nil {
+		return nil
+	} else if l, confidence := n.Registry.MatchTagsConfidence(tag); confidence == language.No {
+		return nil
+	} else {
+		return l
+	}
+}
@@ -5,13 +5,26 @@ import (
 	"fmt"
 	"github.com/dakusan/gol10n/execute"
 	"github.com/dakusan/gol10n/translate"
+	"io"
+	"io/fs"
 	"os"
+	"path"
 )
 
-// LoadDefault loads the compiled dictionary and default language
+// LoadDefault loads the compiled dictionary and default language. If the dictionary file does not exist, the combined dictionary file is used instead
 func LoadDefault(compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool) (*translate.Language, error) {
-	//Get the fixed compiled path and the file extensions
-	compiledDirectoryPath = addSlash(compiledDirectoryPath)
+	return LoadDefaultFS(nil, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed)
+}
+
+// LoadDefaultFS is the same as LoadDefault, except the files are read from the file system (like an embed.FS). If fsys is nil, the files are read from the OS
+func LoadDefaultFS(fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool) (*translate.Language, error) {
+	return loadDefaultFS(nil, fsys, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed)
+}
+
+// LoadDefaultFS against the dictionary. If d is nil, the package level dictionary is used
+func loadDefaultFS(d *translate.Dictionary, fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool) (*translate.Language, error) {
+	//Get the file opener and the file extensions
+	open := getOpener(fsys, compiledDirectoryPath)
 	fileExt := execute.GTR_Extension_Uncompressed
 	if isCompressed {
 		fileExt = execute.GTR_Extension_Compressed
@@ -22,18 +35,23 @@ func LoadDefault(compiledDirectoryPath, defaultLanguageIdentifier string, isComp
 		return fmt.Errorf("%s error: %s", partName, err.Error())
 	}
 
-	//Load the dictionary
-	if f, err := os.Open(compiledDirectoryPath + execute.DictionaryFileBase + fileExt); err != nil {
-		return nil, tError("Translation dictionary", err)
-	} else {
+	//Load the dictionary. If it does not exist, the combined dictionary is used instead
+	if f, err := open(execute.DictionaryFileBase + fileExt); err == nil {
 		defer func() { _ = f.Close() }()
-		if err, ok := translate.LF_GTR.LoadDictionary(f, isCompressed); !ok {
+		if err, ok := loadDictionary(d, f, isCompressed, false); !ok {
 			return nil, tError("Translation dictionary", err)
 		}
+	} else if fc, errCombined := open(execute.CombinedDictionaryFileBase + fileExt); errCombined == nil {
+		defer func() { _ = fc.Close() }()
+		if err, ok := loadDictionary(d, fc, isCompressed, true); !ok {
+			return nil, tError("Translation combined dictionary", err)
+		}
+	} else {
+		return nil, tError("Translation dictionary", err)
 	}
 
 	//Load the default language
-	if l, err := loadLanguage(compiledDirectoryPath+defaultLanguageIdentifier+fileExt, true, isCompressed); err != nil {
+	if l, err := loadLanguage(d, open, defaultLanguageIdentifier+fileExt, true, isCompressed); err != nil {
 		return nil, tError("Default language", err)
 	} else {
 		return l, nil
@@ -42,13 +60,24 @@ func LoadDefault(compiledDirectoryPath, defaultLanguageIdentifier string, isComp
 
 // Load loads the language and its fallbacks. Dictionary must be loaded first (Through LoadDefault())
 func Load(compiledDirectoryPath, langIdentifier string, isCompressed bool, defaultLanguage *translate.Language) (*translate.Language, error) {
+	return LoadWithAliases(compiledDirectoryPath, langIdentifier, isCompressed, defaultLanguage, nil)
+}
+
+// LoadWithAliases is the same as Load, except the requested language and fallback language identifiers are first resolved through the aliases
+func LoadWithAliases(compiledDirectoryPath, langIdentifier string, isCompressed bool, defaultLanguage *translate.Language, aliases translate.LanguageAliases) (*translate.Language, error) {
+	return LoadFS(nil, compiledDirectoryPath, langIdentifier, isCompressed, defaultLanguage, aliases)
+}
+
+// LoadFS is the same as LoadWithAliases, except the files are read from the file system (like an embed.FS). If fsys is nil, the files are read from the OS. The aliases may be nil
+func LoadFS(fsys fs.FS, compiledDirectoryPath, langIdentifier string, isCompressed bool, defaultLanguage *translate.Language, aliases translate.LanguageAliases) (*translate.Language, error) {
 	//If the requested language is also the default language, then nothing to do
+	langIdentifier = aliases.Resolve(langIdentifier)
 	if langIdentifier == defaultLanguage.LanguageIdentifier() {
 		return defaultLanguage, nil
 	}
 
-	//Get the fixed compiled path and the file extensions
-	compiledDirectoryPath = addSlash(compiledDirectoryPath)
+	//Get the file opener and the file extensions
+	open := getOpener(fsys, compiledDirectoryPath)
 	fileExt := execute.GTR_Extension_Uncompressed
 	if isCompressed {
 		fileExt = execute.GTR_Extension_Compressed
@@ -60,7 +89,7 @@ func Load(compiledDirectoryPath, langIdentifier string, isCompressed bool, defau
 	for {
 		//Get the next language in the fallback chain
 		var l *translate.Language
-		if _l, err := loadLanguage(compiledDirectoryPath+curLang+fileExt, false, isCompressed); err != nil {
+		if _l, err := loadLanguage(nil, open, curLang+fileExt, false, isCompressed); err != nil {
 			return nil, fmt.Errorf("Error loading “%s” (under language “%s”): %s", curLang, langIdentifier, err.Error())
 		} else {
 			l = _l
@@ -68,7 +97,7 @@ func Load(compiledDirectoryPath, langIdentifier string, isCompressed bool, defau
 		loadedLanguages = append(loadedLanguages, l)
 
 		//If the fallback for this language is not defined, or is set as the default language, then stop here
-		curLang = l.FallbackName()
+		curLang = aliases.Resolve(l.FallbackName())
 		if len(curLang) == 0 || curLang == defaultLanguage.LanguageIdentifier() {
 			break
 		}
@@ -84,7 +113,7 @@ func Load(compiledDirectoryPath, langIdentifier string, isCompressed bool, defau
 	//Set the fallbacks on each of the languages in the chain
 	loadedLanguages = append(loadedLanguages, defaultLanguage)
 	for i := len(loadedLanguages) - 2; i >= 0; i-- {
-		if err := loadedLanguages[i].SetFallback(loadedLanguages[i+1]); err != nil {
+		if err := loadedLanguages[i].SetFallbackWithAliases(loadedLanguages[i+1], aliases); err != nil {
 			return nil, fmt.Errorf(
 				"Error setting fallback “%s” on “%s” (under default language “%s”): %s",
 				loadedLanguages[i+1].LanguageIdentifier(),
@@ -98,18 +127,138 @@ func Load(compiledDirectoryPath, langIdentifier string, isCompressed bool, defau
 	return loadedLanguages[0], nil
 }
 
-func loadLanguage(path string, isDefault, isCompressed bool) (*translate.Language, error) {
+// LoadDictionaryVarsLazilyFS registers the compiled variable dictionary file in the directory of the file system to be loaded the first time the variables are needed (see translate.Dictionary.LoadDictionaryVarsLazily()). The dictionary must be loaded first (Through LoadDefault()). If fsys is nil, the file is read from the OS
+func LoadDictionaryVarsLazilyFS(fsys fs.FS, compiledDirectoryPath string, isCompressed bool) error {
+	open := getOpener(fsys, compiledDirectoryPath)
+	fileName := execute.VarDictionaryFileBase + execute.GTR_Extension_Uncompressed
+	if isCompressed {
+		fileName = execute.VarDictionaryFileBase + execute.GTR_Extension_Compressed
+	}
+	return translate.LF_GTR.LoadDictionaryVarsLazily(func() (io.ReadCloser, error) {
+		return open(fileName)
+	}, isCompressed)
+}
+
+// LoadFromFS loads the compiled dictionary, the default language, and every other compiled language (with their fallbacks) found in the directory of the file system. It is meant for compiled files embedded through an embed.FS (see the GoEmbedPackage setting). If fsys is nil, the files are read from the OS. The returned map is keyed by language identifier and includes the default language
+func LoadFromFS(fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool) (languages map[string]*translate.Language, defaultLanguage *translate.Language, err error) {
+	return loadFromFS(nil, fsys, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed, nil)
+}
+
+// LoadFromFSWithAliases is the same as LoadFromFS, except the fallback language identifiers are first resolved through the aliases. The returned map is still only keyed by the language identifiers that have files (see translate.Bundle.Lookup() to look them up through the aliases)
+func LoadFromFSWithAliases(fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool, aliases translate.LanguageAliases) (languages map[string]*translate.Language, defaultLanguage *translate.Language, err error) {
+	return loadFromFS(nil, fsys, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed, aliases)
+}
+
+// LoadFromFS against the dictionary. If d is nil, the package level dictionary is used. The aliases may be nil
+func loadFromFS(d *translate.Dictionary, fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool, aliases translate.LanguageAliases) (languages map[string]*translate.Language, defaultLanguage *translate.Language, err error) {
+	//Load the dictionary and default language
+	if defaultLanguage, err = loadDefaultFS(d, fsys, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed); err != nil {
+		return nil, nil, err
+	}
+
+	//Get the files in the directory
+	var entries []fs.DirEntry
+	if fsys == nil {
+		entries, err = os.ReadDir(compiledDirectoryPath)
+	} else {
+		entries, err = fs.ReadDir(fsys, path.Clean(compiledDirectoryPath))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("Directory error: %s", err.Error())
+	}
+
+	//Loads a language and its fallbacks (if not already loaded)
+	open := getOpener(fsys, compiledDirectoryPath)
+	fileExt := execute.GTR_Extension_Uncompressed
+	if isCompressed {
+		fileExt = execute.GTR_Extension_Compressed
+	}
+	languages = map[string]*translate.Language{defaultLanguageIdentifier: defaultLanguage}
+	var loadLang func(langIdentifier string, chain []string) (*translate.Language, error)
+	loadLang = func(langIdentifier string, chain []string) (*translate.Language, error) {
+		//If already loaded or in the fallback chain
+		if l, ok := languages[langIdentifier]; ok {
+			return l, nil
+		}
+		for _, ident := range chain {
+			if ident == langIdentifier {
+				return nil, fmt.Errorf("Error loading “%s”: fallback loop detected", langIdentifier)
+			}
+		}
+
+		//Load the language
+		l, err := loadLanguage(d, open, langIdentifier+fileExt, false, isCompressed)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading “%s”: %s", langIdentifier, err.Error())
+		}
+
+		//Load and set the fallback
+		fallbackLang := defaultLanguage
+		if fallbackName := aliases.Resolve(l.FallbackName()); len(fallbackName) != 0 {
+			if fallbackLang, err = loadLang(fallbackName, append(chain, langIdentifier)); err != nil {
+				return nil, err
+			}
+		}
+		if err := l.SetFallbackWithAliases(fallbackLang, aliases); err != nil {
+			return nil, fmt.Errorf("Error setting fallback “%s” on “%s”: %s", fallbackLang.LanguageIdentifier(), langIdentifier, err.Error())
+		}
+
+		languages[langIdentifier] = l
+		return l, nil
+	}
+
+	//Load all the languages in the directory
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || len(fileName) <= len(fileExt) || fileName[len(fileName)-len(fileExt):] != fileExt {
+			continue
+		}
+		langIdentifier := fileName[0 : len(fileName)-len(fileExt)]
+		if langIdentifier == execute.DictionaryFileBase || langIdentifier == execute.VarDictionaryFileBase || langIdentifier == execute.CombinedDictionaryFileBase {
+			continue
+		}
+		if _, err := loadLang(langIdentifier, nil); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return languages, defaultLanguage, nil
+}
+
+// Opens a file inside the compiled directory
+type fileOpener func(fileName string) (io.ReadCloser, error)
+
+// Returns the fileOpener for either the OS (if fsys is nil) or the file system
+func getOpener(fsys fs.FS, compiledDirectoryPath string) fileOpener {
+	if fsys == nil {
+		compiledDirectoryPath = addSlash(compiledDirectoryPath)
+		return func(fileName string) (io.ReadCloser, error) {
+			return os.Open(compiledDirectoryPath + fileName)
+		}
+	}
+
+	return func(fileName string) (io.ReadCloser, error) {
+		return fsys.Open(path.Join(compiledDirectoryPath, fileName))
+	}
+}
+
+// Loads a compiled language file against the dictionary. If d is nil, the package level dictionary is used
+func loadLanguage(d *translate.Dictionary, open fileOpener, fileName string, isDefault, isCompressed bool) (*translate.Language, error) {
 	//Open the file
-	var f *os.File
+	var f io.ReadCloser
 	var err error
-	if f, err = os.Open(path); err != nil {
+	if f, err = open(fileName); err != nil {
 		return nil, err
 	}
 	defer func() { _ = f.Close() }()
 
 	//If default language
 	if isDefault {
-		if l, err := translate.LF_GTR.LoadDefault(f, isCompressed); err != nil {
+		loadDefault := translate.LF_GTR.LoadDefault
+		if d != nil {
+			loadDefault = d.LoadDefault
+		}
+		if l, err := loadDefault(f, isCompressed); err != nil {
 			return nil, err
 		} else {
 			return l, nil
@@ -117,21 +266,42 @@ func loadLanguage(path string, isDefault, isCompressed bool) (*translate.Languag
 	}
 
 	//If not default language
-	if l, err := translate.LF_GTR.Load(f, isCompressed); err != nil {
+	load := translate.LF_GTR.Load
+	if d != nil {
+		load = d.Load
+	}
+	if l, err := load(f, isCompressed); err != nil {
 		return nil, err
 	} else {
 		return l, nil
 	}
 }
 
-func addSlash(path string) string {
-	if len(path) == 0 || (path[len(path)-1] != '/' && path[len(path)-1] != '\\') {
-		path = path + "/"
+// Loads a compiled dictionary (or combined dictionary) file into the dictionary. If d is nil, the package level dictionary is used. Returns ok=true if the dictionary is loaded (see translate.LanguageBinaryFile.LoadDictionary())
+func loadDictionary(d *translate.Dictionary, r io.Reader, isCompressed, isCombined bool) (err error, ok bool) {
+	if d == nil {
+		if isCombined {
+			return translate.LF_GTR.LoadCombinedDictionary(r, isCompressed)
+		}
+		return translate.LF_GTR.LoadDictionary(r, isCompressed)
+	}
+
+	if isCombined {
+		err = d.LoadCombinedDictionary(r, isCompressed)
+	} else {
+		err = d.LoadDictionary(r, isCompressed)
+	}
+	return err, err == nil || d.IsLoaded()
+}
+
+func addSlash(dirPath string) string {
+	if len(dirPath) == 0 || (dirPath[len(dirPath)-1] != '/' && dirPath[len(dirPath)-1] != '\\') {
+		dirPath = dirPath + "/"
 	}
-	return path
+	return dirPath
 }
 
 // Remove warnings about unused functions
 func init() {
-	_, _ = LoadDefault, Load
+	_, _, _, _, _, _, _ = LoadDefault, LoadDefaultFS, Load, LoadWithAliases, LoadFS, LoadFromFS, LoadFromFSWithAliases
 }
@@ -0,0 +1,158 @@
+//Reload compiled languages when they change
+
+package load_compiled
+
+import (
+	"errors"
+	"github.com/dakusan/gol10n/execute"
+	"github.com/dakusan/gol10n/translate"
+	"github.com/fsnotify/fsnotify"
+	"io/fs"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Reloader holds the compiled languages of a directory behind a stable handle, so long-running servers can pick up new translations without restarting. New languages are loaded by Reload() (or automatically through Watch()), and are swapped in atomically once they are all loaded.
+//
+// Each load uses its own dictionary (see translate.NewDictionary()), so it does not use or change the package level dictionary, and languages that are still being used from a previous load keep working. Always get the languages from the Reloader when they are needed (like once per request) instead of storing them. All functions are concurrency safe
+type Reloader struct {
+	fsys                      fs.FS
+	compiledDirectoryPath     string
+	defaultLanguageIdentifier string
+	isCompressed              bool
+	aliases                   translate.LanguageAliases
+	loaded                    atomic.Pointer[reloaderLoad]
+	reloadMutex               sync.Mutex //Only 1 reload can run at a time
+	watchMutex                sync.Mutex
+	watcher                   *fsnotify.Watcher //Only set while watching
+}
+
+// The languages from a load
+type reloaderLoad struct {
+	languages       translate.Bundle
+	defaultLanguage *translate.Language
+}
+
+// The time to wait after the last change to the compiled directory before reloading, so a compile that writes many files only causes 1 reload
+const reloadDelay = time.Millisecond * 100
+
+// NewReloader loads the compiled dictionary, the default language, and every other compiled language in the directory (see LoadFromFS()). If fsys is nil, the files are read from the OS
+func NewReloader(fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool) (*Reloader, error) {
+	return NewReloaderWithAliases(fsys, compiledDirectoryPath, defaultLanguageIdentifier, isCompressed, nil)
+}
+
+// NewReloaderWithAliases is the same as NewReloader, except the fallback language identifiers are resolved through the aliases (see LoadFromFSWithAliases()), as are the identifiers given to Language(). The aliases may be nil
+func NewReloaderWithAliases(fsys fs.FS, compiledDirectoryPath, defaultLanguageIdentifier string, isCompressed bool, aliases translate.LanguageAliases) (*Reloader, error) {
+	r := &Reloader{fsys: fsys, compiledDirectoryPath: compiledDirectoryPath, defaultLanguageIdentifier: defaultLanguageIdentifier, isCompressed: isCompressed, aliases: aliases}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the languages from the directory again and swaps them in. If there is an error then the current languages are kept
+func (r *Reloader) Reload() error {
+	r.reloadMutex.Lock()
+	defer r.reloadMutex.Unlock()
+
+	languages, defaultLanguage, err := loadFromFS(translate.NewDictionary(), r.fsys, r.compiledDirectoryPath, r.defaultLanguageIdentifier, r.isCompressed, r.aliases)
+	if err != nil {
+		return err
+	}
+	r.loaded.Store(&reloaderLoad{languages, defaultLanguage})
+	return nil
+}
+
+// Languages returns the current languages keyed by language identifier, which includes the default language. The returned bundle must not be modified
+func (r *Reloader) Languages() translate.Bundle {
+	return r.loaded.Load().languages
+}
+
+// Language returns the current language of the language identifier (resolved through the aliases), or nil if it was not loaded
+func (r *Reloader) Language(languageIdentifier string) *translate.Language {
+	return r.loaded.Load().languages.Lookup(languageIdentifier, r.aliases)
+}
+
+// Default returns the current default language
+func (r *Reloader) Default() *translate.Language {
+	return r.loaded.Load().defaultLanguage
+}
+
+// Watch watches the compiled directory (which must be read from the OS) in its own goroutine, and reloads the languages when compiled files in it change. onReload (if not nil) is called after each automatic reload with its error, or the watcher’s errors. Watching continues until Close() is called
+func (r *Reloader) Watch(onReload func(err error)) error {
+	r.watchMutex.Lock()
+	defer r.watchMutex.Unlock()
+
+	//Confirm the directory can be watched
+	if r.fsys != nil {
+		return errors.New("Cannot watch a file system")
+	} else if r.watcher != nil {
+		return errors.New("Already watching")
+	}
+
+	//Create the watcher
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	} else if err := watcher.Add(r.compiledDirectoryPath); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+	r.watcher = watcher
+
+	//Sends the error to onReload
+	report := func(err error) {
+		if onReload != nil {
+			onReload(err)
+		}
+	}
+
+	//Reload after the compiled files stop changing
+	fileExt := execute.GTR_Extension_Uncompressed
+	if r.isCompressed {
+		fileExt = execute.GTR_Extension_Compressed
+	}
+	go func() {
+		var reloadTimer *time.Timer
+		defer func() {
+			if reloadTimer != nil {
+				reloadTimer.Stop()
+			}
+		}()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				} else if !strings.HasSuffix(event.Name, fileExt) {
+					continue
+				} else if reloadTimer == nil {
+					reloadTimer = time.AfterFunc(reloadDelay, func() { report(r.Reload()) })
+				} else {
+					reloadTimer.Reset(reloadDelay)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				report(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// Close stops watching the compiled directory (see Watch()). The current languages can still be used
+func (r *Reloader) Close() error {
+	r.watchMutex.Lock()
+	defer r.watchMutex.Unlock()
+
+	if r.watcher == nil {
+		return nil
+	}
+	err := r.watcher.Close()
+	r.watcher = nil
+	return err
+}
@@ -8,19 +8,19 @@ gol10n.exe [Mode] [flags]:
 
 Modes (Mutually exclusive):
 
-	 Directory mode: [No arguments given]
+	 Build mode: [arg1=build]
 	    Processes all files in the “InputPath” directory
-	    Can be used in conjunction with -w
-	 File mode: [arg1=language identifier]
+	    Can be used in conjunction with -k or --dry-run
+	 Watch mode: [arg1=watch]
+	    Processes all files in the “InputPath” directory, and then continually watches the directory for relevant changes
+	 File mode: [arg1=file] [arg2=language identifier]
 	    Processes a single language file
 	    The default language will need to be processed if a compiled dictionary does not exist
-	    Can be used in conjunction with -s or -f
+	    Can be used in conjunction with -s, -f, or -k
 
 	-s, --single-file               Mode=File. The default language will not be processed
 	                                This will only work if a compiled dictionary already exists
 	-f, --fallbacks                 Mode=File. Also process the language’s fallback files
-	-w, --watch                     Mode=Directory. Continually watches the directory for relevant changes
-	                                Only processes and updates the necessary files when a change is detected
 	    --create-settings           Create the default settings-gol10n.json file
 	-h, --help                      This help prompt
 
@@ -28,7 +28,7 @@ File flags (Modify how non-translation-text-files are interacted with):
 
 	-d, --go-dictionary[=false]     Output the go dictionary files when processing the default language (default true)
 	-c, --output-compiled[=false]   Output the compiled translation files and dictionary (default true)
-	-i, --ignore-timestamps         Always read from translation text files [ignore compiled files even if they are newer]
+	-F, --force                     Always read from translation text files [ignore compiled files even if the build manifest shows they are up to date]
 
 The following are for overriding settings from settings-gol10n.json. If not given, the values from the settings file will be used:
 
@@ -44,26 +44,107 @@ The following are for overriding settings from settings-gol10n.json. If not give
 	-j, --allow-json-comma          If JSON files can have trailing commas. If true, a sanitization process is ran over the JSON
 
 Command line display modifiers:
-See using_in_go.md#ProcessedFile  Mode=Directory, -w, -f
+See using_in_go.md#ProcessedFile  Mode=Build, Watch, File with -f
 
-	-t, --table[=false]             Output an ascii table of the processed languages and their flags (default true)
-	-v, --verbose                   Output a list of processed files and their processing flags
+	-t, --table[=false]             Output an ascii table of the processed languages and their flags, and their translation coverage (default true)
+	-v, --verbose                   Output a list of processed files, their processing flags, and their processing times and sizes
 	-x, --warnings[=false]          Output a list of warnings when processing non-default language translation files (default true)
+	    --output-format string      The format to output the processed languages (or the embed graph or the stats) in (text, json, or yaml)
+	                                The json and yaml formats replace the above (default "text")
+	    --json                      The same as --output-format=json
 */
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/dakusan/gol10n/execute"
+	"github.com/dakusan/gol10n/translate"
 	"github.com/dakusan/gol10n/watch"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+	"io"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
+// The first arguments that select the non-processing modes
+const (
+	buildModeArg         = "build"
+	watchModeArg         = "watch"
+	fileModeArg          = "file"
+	formatModeArg        = "fmt"
+	exportXliffModeArg   = "export-xliff"
+	importXliffModeArg   = "import-xliff"
+	exportAndroidModeArg = "export-android"
+	importAndroidModeArg = "import-android"
+	exportAppleModeArg   = "export-apple"
+	importAppleModeArg   = "import-apple"
+	exportArbModeArg     = "export-arb"
+	importArbModeArg     = "import-arb"
+	importFluentModeArg  = "import-fluent"
+	exportSheetModeArg   = "export-sheet"
+	importSheetModeArg   = "import-sheet"
+	lockModeArg          = "lock"
+	unlockModeArg        = "unlock"
+	inspectModeArg       = "inspect"
+	decompileModeArg     = "decompile"
+	diffDictModeArg      = "diff-dict"
+	verifyModeArg        = "verify"
+	statsModeArg         = "stats"
+	compileNsModeArg     = "compile-namespaces"
+	embedGraphModeArg    = "embed-graph"
+	packModeArg          = "pack"
+	standaloneModeArg    = "standalone"
+	convertModeArg       = "convert"
+)
+
+// The conversion modes, with a description of their arguments and their number of arguments (not including the mode argument). A maxArgs of -1 is unlimited
+var convertModes = map[string]struct {
+	argsDesc         string
+	minArgs, maxArgs int
+}{
+	exportXliffModeArg:   {"a language identifier and an optional output file path", 1, 2},
+	importXliffModeArg:   {"at least 1 XLIFF file path", 1, -1},
+	exportAndroidModeArg: {"a language identifier and an optional output file path", 1, 2},
+	importAndroidModeArg: {"a language identifier and a strings.xml file path", 2, 2},
+	exportAppleModeArg:   {"a language identifier and an output directory", 2, 2},
+	importAppleModeArg:   {"a language identifier and .strings and/or .stringsdict file paths", 2, 3},
+	exportArbModeArg:     {"a language identifier and an optional output file path", 1, 2},
+	importArbModeArg:     {"a language identifier and an ARB file path", 2, 2},
+	importFluentModeArg:  {"a language identifier and at least 1 FTL file path", 2, -1},
+	exportSheetModeArg:   {"an output file path and optional language identifiers", 1, -1},
+	importSheetModeArg:   {"a spreadsheet file path", 1, 1},
+	lockModeArg:          {"optional namespaces or translation IDs", 0, -1},
+	unlockModeArg:        {"at least 1 namespace or translation ID", 1, -1},
+	inspectModeArg:       {"a language identifier or a compiled translation file path", 1, 1},
+	decompileModeArg:     {"a language identifier and an optional output file path", 1, 2},
+	diffDictModeArg:      {"an old and a new compiled dictionary file path", 2, 2},
+	verifyModeArg:        {"no arguments", 0, 0},
+	statsModeArg:         {"optional language identifiers", 0, -1},
+	compileNsModeArg:     {"at least 1 namespace", 1, -1},
+	embedGraphModeArg:    {"a language identifier and an optional output file path", 1, 2},
+	packModeArg:          {"an optional output file path", 0, 1},
+	standaloneModeArg:    {"optional language identifiers", 0, -1},
+	convertModeArg:       {"a language identifier", 1, 1},
+}
+
+// The values of the --output-format flag
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+	outputFormatYAML = "yaml"
+)
+
+// The base file name of exported Apple resource files
+const appleFileBase = "Localizable"
+
 func main() {
 	retVal := 0
 	if !mainWrapper() {
@@ -77,7 +158,12 @@ func mainWrapper() bool {
 	//Mode flags
 	flagSingleFile := pflag.BoolP("single-file", "s", false, "Mode=File. The default language will not be processed\nThis will only work if a compiled dictionary already exists")
 	flagFallbackFiles := pflag.BoolP("fallbacks", "f", false, "Mode=File. Also process the language’s fallback files")
-	flagWatchFiles := pflag.BoolP("watch", "w", false, "Mode=Directory. Continually watches the directory for relevant changes\nOnly processes and updates the necessary files when a change is detected")
+	flagWatchFiles := pflag.BoolP("watch", "w", false, "Deprecated. The same as the watch mode")
+	flagCheck := pflag.BoolP("check", "k", false, "Mode=Format, Build, or File. Do not write any files\nMode=Format fails if any file is not in the canonical layout (for pre-commit hooks)\nOtherwise, all translation text files are read (ignoring compiled files) and validated (for CI)")
+	flagDryRun := pflag.Bool("dry-run", false, "Mode=Build, or File with -f. Process the files like normal, but do not write any files\nInstead, output which compiled files and go dictionaries would be created or updated, and why")
+	flagConvertTo := pflag.String("to", execute.YAML_Extension, "Mode=Convert. The file type to convert to ("+execute.YAML_Extension+", "+execute.JSON_Extension+", or "+execute.TOML_Extension+")")
+	flagInspectFull := pflag.Bool("full", false, "Mode=Inspect. Also output every translation’s rules in the translation text file syntax")
+	flagMinCoverage := pflag.Float64("min-coverage", 0, "Mode=Stats. Fail if the coverage of any language is below this percentage (Example: 95)")
 	flagCreateSettingsFile := pflag.Bool("create-settings", false, "Create the default "+execute.SettingsFileName+" file")
 	flagShowHelp := pflag.BoolP("help", "h", false, "This help prompt")
 
@@ -91,6 +177,7 @@ func mainWrapper() bool {
 		CompressCompiled:   true,
 		OutputGoDictionary: true,
 		OutputCompiled:     true,
+		LanguageAliases:    translate.LanguageAliases{},
 	}
 
 	//Settings overrides
@@ -116,6 +203,8 @@ func mainWrapper() bool {
 			myInfo.flagValue = pflag.BoolP(sFixedName, string(shortLetter), false, usageText)
 		case *string:
 			myInfo.flagValue = pflag.StringP(sFixedName, string(shortLetter), "", usageText)
+		case *uint:
+			myInfo.flagValue = pflag.UintP(sFixedName, string(shortLetter), 0, usageText)
 		default:
 			panic("Unreachable code")
 		}
@@ -126,7 +215,8 @@ func mainWrapper() bool {
 	//Create file flags
 	addSetting('d', "GoDictionary", &settings.OutputGoDictionary, "Output the go dictionary files when processing the default language")
 	addSetting('c', "OutputCompiled", &settings.OutputCompiled, "Output the compiled translation files and dictionary")
-	addSetting('i', "IgnoreTimestamps", &settings.IgnoreTimestamps, "Always read from translation text files [ignore compiled files even if they are newer]")
+	addSetting('F', "Force", &settings.Force, "Always read from translation text files [ignore compiled files even if the build manifest shows they are up to date]")
+	flagIgnoreTimestamps := pflag.BoolP("ignore-timestamps", "i", false, "Deprecated. The same as --force")
 
 	//Settings flags
 	addSetting('l', "DefaultLanguage", &settings.DefaultLanguage, "The identifier for the default language")
@@ -136,17 +226,33 @@ func mainWrapper() bool {
 	addSetting('m', "CompressCompiled", &settings.CompressCompiled, "Whether the compiled binary translation files are saved as .gtr or .gtr.gz (gzip compressed)")
 	addSetting('b', "AllowBigStrings", &settings.AllowBigStrings, "If translation strings can be larger than 64KB\nIf true, and a large translation is found, then compiled binary files will become larger")
 	addSetting('j', "AllowJsonComma", &settings.AllowJSONTrailingComma, "If JSON files can have trailing commas. If true, a sanitization process is ran over the JSON")
+	addSetting('y', "YamlStrictStrings", &settings.YAMLStrictStrings, "If YAML keys and values must all be strings\nIf true, unquoted numbers, booleans, and nulls are errors instead of being converted to strings")
+	addSetting('n', "I18nextJson", &settings.I18nextJSON, "If JSON files are i18next JSON files\nTheir nested keys, plural suffixes, interpolations, and nesting are converted")
+	addSetting('u', "CombinedDictionary", &settings.CombinedDictionary, "Also output the compiled dictionary and variable dictionary together as one combined file\nWhen true, the combined file is read instead of the two split files")
+	addSetting('e', "GoEmbedPackage", &settings.GoEmbedPackage, "If given, an “embed.go” file with this package name is written next to the compiled output directory\nIt embeds the compiled files into the binary")
+	addSetting('r', "LockFile", &settings.LockFile, "If given, the string freeze lock file\nProcessing the default language fails if a translation locked in it has changed")
+	addSetting('K', "SigningKeyFile", &settings.SigningKeyFile, "If given, the Ed25519 private key (PKCS #8 PEM) that signs the compiled files\nEach gets a detached “.sig” signature file")
+	addSetting('E', "EncryptionKeyFile", &settings.EncryptionKeyFile, "If given, the file with the hex encoded AES key that encrypts the translation strings of the compiled files\nThe key is needed to load them")
+	addSetting('J', "MaxParallel", &settings.MaxParallel, "The most translation text files processed at once, and the most namespaces whose go dictionary files are written at once\nIf 0, the number of CPUs is used")
+	addSetting('S', "Strict", &settings.Strict, "Promote the warnings of the processed translation text files to errors\nExcept those whose warning codes are in the StrictAllowedWarnings setting. Best used with -k or -F")
 
 	//Output flags
-	flagShowTable := pflag.BoolP("table", "t", true, "Output an ascii table of the processed languages and their flags")
-	flagShowProcessedFlags := pflag.BoolP("verbose", "v", false, "Output a list of processed files and their processing flags")
+	flagShowTable := pflag.BoolP("table", "t", true, "Output an ascii table of the processed languages and their flags, and their translation coverage")
+	flagShowProcessedFlags := pflag.BoolP("verbose", "v", false, "Output a list of processed files, their processing flags, and their processing times and sizes")
 	flagShowProcessedWarnings := pflag.BoolP("warnings", "x", true, "Output a list of warnings when processing non-default language translation files")
+	flagShowProgress := pflag.Bool("progress", false, "Mode=Build. Output a progress bar of the processed languages to stderr")
+	flagOutputFormat := pflag.String("output-format", outputFormatText, "The format to output the processed languages (or the embed graph or the stats) in ("+outputFormatText+", "+outputFormatJSON+", or "+outputFormatYAML+")\nThe "+outputFormatJSON+" and "+outputFormatYAML+" formats replace the above")
+	flagOutputJSON := pflag.Bool("json", false, "The same as --output-format="+outputFormatJSON)
 	for _, flagName := range []string{"go-dictionary", "output-compiled", "table", "warnings"} {
 		pflag.Lookup(flagName).NoOptDefVal = "false"
 		pflag.Lookup(flagName).DefValue = "true"
 
 	}
 
+	//The legacy mode selection (-w, no arguments, or a language identifier) still works for one release
+	_ = pflag.CommandLine.MarkDeprecated("watch", "use “"+watchModeArg+"” instead")
+	_ = pflag.CommandLine.MarkDeprecated("ignore-timestamps", "use --force instead")
+
 	//Set up help prompt
 	stdErr := func(str string) bool {
 		_, _ = fmt.Fprintln(os.Stderr, str)
@@ -165,12 +271,38 @@ func mainWrapper() bool {
 		//Add the titles
 		titleFlagSection('d', "File flags (Modify how non-translation-text-files are interacted with):")
 		titleFlagSection('l', "The following are for overriding settings from %s. If not given, the values from the settings file will be used:", execute.SettingsFileName)
-		titleFlagSection('t', "Command line display modifiers:\nSee using_in_go.md#ProcessedFile  Mode=Directory, -w, -f\n")
+		titleFlagSection('t', "Command line display modifiers:\nSee using_in_go.md#ProcessedFile  Mode=Build, Watch, File with -f\n")
 
 		//Modes information
 		modesStrings := []string{
-			"   Directory mode: [No arguments given]\n      Processes all files in the “InputPath” directory\n      Can be used in conjunction with -w",
-			"   File mode: [arg1=language identifier]\n      Processes a single language file\n      The default language will need to be processed if a compiled dictionary does not exist\n      Can be used in conjunction with -s or -f",
+			"   Build mode: [arg1=build]\n      Processes all files in the “InputPath” directory\n      Can be used in conjunction with -k or --dry-run",
+			"   Watch mode: [arg1=watch]\n      Processes all files in the “InputPath” directory, and then continually watches the directory for relevant changes\n      Only processes and updates the necessary files when a change is detected\n      Once changes settle for 2 seconds, all the languages are output again",
+			"   File mode: [arg1=file] [arg2=language identifier]\n      Processes a single language file\n      The default language will need to be processed if a compiled dictionary does not exist\n      Can be used in conjunction with -s, -f, or -k",
+			"   Format mode: [arg1=fmt] [optional file paths]\n      Rewrites translation text files in a canonical layout without changing their content\n      If no file paths are given, all translation text files in the “InputPath” directory are formatted\n      Can be used in conjunction with -k",
+			"   Export XLIFF mode: [arg1=export-xliff] [arg2=language identifier] [optional output file path]\n      Writes an XLIFF 2.0 file for translating the default language into the language\n      If no output file path is given, it is written to stdout",
+			"   Import XLIFF mode: [arg1=import-xliff] [XLIFF file paths]\n      Writes the translation text files in the “InputPath” directory from XLIFF 2.0 files created through export-xliff",
+			"   Export Android mode: [arg1=export-android] [arg2=language identifier] [optional output file path]\n      Writes an Android strings.xml file of the language\n      If no output file path is given, it is written to stdout",
+			"   Import Android mode: [arg1=import-android] [arg2=language identifier] [strings.xml file path]\n      Writes the language’s translation text file in the “InputPath” directory from an Android strings.xml file",
+			"   Export Apple mode: [arg1=export-apple] [arg2=language identifier] [output directory]\n      Writes Apple Localizable.strings and Localizable.stringsdict files of the language into the directory",
+			"   Import Apple mode: [arg1=import-apple] [arg2=language identifier] [.strings and/or .stringsdict file paths]\n      Writes the language’s translation text file in the “InputPath” directory from Apple .strings and .stringsdict files",
+			"   Export ARB mode: [arg1=export-arb] [arg2=language identifier] [optional output file path]\n      Writes a Flutter ARB file of the language\n      If no output file path is given, it is written to stdout",
+			"   Import ARB mode: [arg1=import-arb] [arg2=language identifier] [ARB file path]\n      Writes the language’s translation text file in the “InputPath” directory from a Flutter ARB file",
+			"   Import Fluent mode: [arg1=import-fluent] [arg2=language identifier] [FTL file paths]\n      Writes the language’s translation text file in the “InputPath” directory from Mozilla Fluent files\n      Each file becomes the namespace named after its file name (without the extension)",
+			"   Export sheet mode: [arg1=export-sheet] [arg2=output file path] [optional language identifiers]\n      Writes a CSV or XLSX (by the file extension) spreadsheet with a row per translation and a column per language\n      If no language identifiers are given, all languages in the “InputPath” directory are included",
+			"   Import sheet mode: [arg1=import-sheet] [arg2=spreadsheet file path]\n      Writes the translation text files in the “InputPath” directory from the language columns of a CSV or XLSX (by the file extension) spreadsheet created through export-sheet\n      Translations that conflict with changes made since the export are reported and not imported",
+			"   Lock mode: [arg1=lock] [optional “Namespace” or “Namespace.TranslationID” identifiers]\n      Adds the default language’s translations to the “LockFile” (string freeze)\n      Processing the default language fails if a locked translation changes\n      If no identifiers are given, all translations are locked",
+			"   Unlock mode: [arg1=unlock] [“Namespace” or “Namespace.TranslationID” identifiers]\n      Removes translations from the “LockFile” so they can be changed",
+			"   Inspect mode: [arg1=inspect] [arg2=language identifier or " + execute.GTR_Extension_Uncompressed + "/" + execute.GTR_Extension_Compressed + " file path]\n      Outputs the header, settings, and namespace, translation, and rule counts of a compiled translation file, including the CLDR version it was compiled with\n      Warns if this build uses a different CLDR version\n      Can be used in conjunction with --full",
+			"   Decompile mode: [arg1=decompile] [arg2=language identifier] [optional output file path]\n      Reconstructs a YAML translation text file from the language’s compiled translation file and the compiled dictionaries\n      Translations from fallback languages are not included, and compile settings like escapes are not recovered\n      If no output file path is given, it is written to stdout",
+			"   Diff dictionary mode: [arg1=diff-dict] [arg2=old compiled dictionary file path] [arg3=new compiled dictionary file path]\n      Lists the translation IDs that were added, removed, or re-indexed between 2 compiled dictionary files\n      Fails if the indexes of existing translation IDs changed, which breaks binaries built against the old dictionary",
+			"   Verify mode: [arg1=verify]\n      Checks the compiled dictionary, variable dictionary, and every compiled language file in the “CompiledOutputPath” directory before deploying them\n      Checks that the files are valid, match the dictionary, have valid settings, and that their fallback languages resolve\n      Outputs a pass/fail report, and fails if any problems are found",
+			"   Stats mode: [arg1=stats] [optional language identifiers]\n      Reads all translation text files (without writing any files) and outputs each language’s translation coverage, its missing and extra translation IDs, and a breakdown per namespace\n      If no language identifiers are given, all languages are included\n      Can be used in conjunction with --min-coverage or --output-format",
+			"   Compile namespaces mode: [arg1=compile-namespaces] [namespaces]\n      Recompiles only the namespaces of the default language, and updates its compiled files and Go dictionaries\n      The namespaces and translation IDs must be unchanged since the last full compile\n      Other languages are not processed",
+			"   Embed graph mode: [arg1=embed-graph] [arg2=language identifier] [optional output file path]\n      Processes the language (like File mode) and writes the graph of its embedded translations as Graphviz DOT, or JSON or YAML with --output-format\n      Includes the nested embedded translation depths and the translations with VariableTranslation variables\n      If no output file path is given, it is written to stdout",
+			"   Pack mode: [arg1=pack] [optional output file path]\n      Processes all files (like Build mode) and packs the compiled dictionary files and all compiled languages into a single " + execute.GTRB_Extension + " bundle archive\n      If no output file path is given, it is written to “$OutputPath/" + execute.BundleArchiveFileBase + execute.GTRB_Extension + "”",
+			"   Standalone mode: [arg1=standalone] [optional language identifiers]\n      Processes all files (like Build mode) and writes a standalone compiled file for each language to “$OutputPath/$LanguageIdentifier" + execute.StandaloneFileInfix + execute.GTR_Extension_Uncompressed + "”\n      Each holds the dictionary and the language’s fallback languages, so it can be loaded by itself\n      If no language identifiers are given, a file is written for every language",
+			"   Convert mode: [arg1=convert] [arg2=language identifier]\n      Converts the language’s translation text file in the “InputPath” directory to the file type of --to (keeping its settings)\n      The original file is replaced, and comments are not kept",
+			"   Deprecated modes (removed in the next release):\n      [No arguments given] is the same as the build mode\n      [arg1=language identifier] is the same as the file mode\n      -w is the same as the watch mode",
 		}
 
 		FullMessage := fmt.Sprintf(
@@ -226,35 +358,224 @@ func mainWrapper() bool {
 			*v = *s.flagValue.(*bool)
 		case *string:
 			*v = *s.flagValue.(*string)
+		case *uint:
+			*v = *s.flagValue.(*uint)
 		default:
 			panic("Unreachable code")
 		}
 	}
 
-	//Make sure no mode mutually exclusive flags are set together
-	{
-		count := 0
-		for _, b := range []*bool{flagSingleFile, flagFallbackFiles, flagWatchFiles} {
-			if *b {
-				count++
-			}
+	//The deprecated --ignore-timestamps is the same as --force
+	if *flagIgnoreTimestamps {
+		settings.Force = true
+	}
+
+	//Translate the deprecated mode selection into the modes
+	args := pflag.Args()
+	if *flagWatchFiles {
+		if len(args) != 0 {
+			return stdErr(fmt.Sprintf("-w flag cannot be used with a mode"))
 		}
-		if count > 1 {
-			return stdErr(fmt.Sprintf("-s -f -w flags cannot be used together"))
+		args = []string{watchModeArg}
+	} else if len(args) == 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Running without a mode is deprecated, use “%s” instead\n", buildModeArg)
+		args = []string{buildModeArg}
+	} else if _, isConvertMode := convertModes[args[0]]; !isConvertMode && args[0] != formatModeArg && args[0] != buildModeArg && args[0] != watchModeArg && args[0] != fileModeArg {
+		_, _ = fmt.Fprintf(os.Stderr, "Giving a language identifier without a mode is deprecated, use “%s %s” instead\n", fileModeArg, args[0])
+		args = append([]string{fileModeArg}, args...)
+	}
+	mode := args[0]
+	arg := func(i int) string {
+		if i < len(args) {
+			return args[i]
 		}
+		return ""
 	}
 
 	//Make sure we are in the proper mode for the mode flags
-	hasLangIdentifier := pflag.NArg() > 0
-	if hasLangIdentifier && *flagWatchFiles {
-		return stdErr(fmt.Sprintf("-w flag cannot be used in mode=File"))
-	} else if !hasLangIdentifier && (*flagSingleFile || *flagFallbackFiles) {
-		return stdErr(fmt.Sprintf("-s and -f flags cannot be used in mode=Directory"))
+	isFormatMode := mode == formatModeArg
+	convertMode, isConvertMode := convertModes[mode]
+	if (mode == buildModeArg || mode == watchModeArg) && len(args) != 1 {
+		return stdErr(fmt.Sprintf("%s does not take arguments", mode))
+	} else if mode == fileModeArg && len(args) != 2 {
+		return stdErr(fmt.Sprintf("%s requires a language identifier", mode))
+	} else if mode != fileModeArg && (*flagSingleFile || *flagFallbackFiles) {
+		return stdErr(fmt.Sprintf("-s and -f flags can only be used in mode=File"))
+	} else if *flagSingleFile && *flagFallbackFiles {
+		return stdErr(fmt.Sprintf("-s and -f flags cannot be used together"))
+	} else if isConvertMode && (len(args)-1 < convertMode.minArgs || (convertMode.maxArgs != -1 && len(args)-1 > convertMode.maxArgs)) {
+		return stdErr(fmt.Sprintf("%s requires %s", mode, convertMode.argsDesc))
+	} else if *flagDryRun && mode != buildModeArg && !(mode == fileModeArg && *flagFallbackFiles) {
+		return stdErr(fmt.Sprintf("--dry-run flag can only be used in mode=Build, or mode=File with -f"))
+	} else if *flagShowProgress && mode != buildModeArg {
+		return stdErr(fmt.Sprintf("--progress flag can only be used in mode=Build"))
+	} else if *flagDryRun && *flagCheck {
+		return stdErr(fmt.Sprintf("--dry-run and -k flags cannot be used together"))
+	} else if isConvertMode && *flagCheck {
+		return stdErr(fmt.Sprintf("-k flag cannot be used in the conversion modes"))
+	} else if mode != convertModeArg && pflag.Lookup("to").Changed {
+		return stdErr(fmt.Sprintf("--to flag can only be used in mode=Convert"))
+	} else if mode != inspectModeArg && *flagInspectFull {
+		return stdErr(fmt.Sprintf("--full flag can only be used in mode=Inspect"))
+	} else if mode != statsModeArg && pflag.Lookup("min-coverage").Changed {
+		return stdErr(fmt.Sprintf("--min-coverage flag can only be used in mode=Stats"))
+	} else if *flagMinCoverage < 0 || *flagMinCoverage > 100 {
+		return stdErr(fmt.Sprintf("--min-coverage must be from 0 to 100"))
+	}
+	settings.ValidateOnly = *flagCheck && !isFormatMode
+	settings.DryRun = *flagDryRun
+
+	//Get the output format
+	outputFormat := strings.ToLower(*flagOutputFormat)
+	if outputFormat != outputFormatText && outputFormat != outputFormatJSON && outputFormat != outputFormatYAML {
+		return stdErr(fmt.Sprintf("--output-format must be %s, %s, or %s", outputFormatText, outputFormatJSON, outputFormatYAML))
+	} else if *flagOutputJSON {
+		if pflag.Lookup("output-format").Changed && outputFormat != outputFormatJSON {
+			return stdErr(fmt.Sprintf("--json flag cannot be used with --output-format=%s", outputFormat))
+		}
+		outputFormat = outputFormatJSON
 	}
 
 	//Run the requested mode
-	languageIdentifier := pflag.Arg(0)
+	languageIdentifier := arg(1)
 	switch {
+	case isFormatMode:
+		changedFiles, err := settings.FormatFiles(*flagCheck, args[1:]...)
+		for _, fileName := range changedFiles {
+			if *flagCheck {
+				fmt.Printf("Not formatted: %s\n", fileName)
+			} else {
+				fmt.Printf("Formatted: %s\n", fileName)
+			}
+		}
+		if err != nil {
+			fmt.Println("Errors: " + err.Error())
+			return false
+		}
+		return !*flagCheck || len(changedFiles) == 0
+	case mode == exportXliffModeArg:
+		return exportToFile(arg(1), arg(2), func(w io.Writer) ([]string, error) {
+			return nil, settings.ExportXLIFF(w, arg(1))
+		})
+	case mode == exportAndroidModeArg:
+		return exportToFile(arg(1), arg(2), func(w io.Writer) ([]string, error) {
+			return settings.ExportAndroidStrings(w, arg(1))
+		})
+	case mode == exportAppleModeArg:
+		return exportApple(&settings, arg(1), arg(2))
+	case mode == exportArbModeArg:
+		return exportToFile(arg(1), arg(2), func(w io.Writer) ([]string, error) {
+			return settings.ExportARB(w, arg(1))
+		})
+	case mode == exportSheetModeArg:
+		format, languagesDesc := translate.SF_CSV, "all languages"
+		if strings.EqualFold(filepath.Ext(arg(1)), "."+execute.XLSX_Extension) {
+			format = translate.SF_XLSX
+		}
+		if len(args) > 2 {
+			languagesDesc = strings.Join(args[2:], "”, “")
+		}
+		return exportToFile(languagesDesc, arg(1), func(w io.Writer) ([]string, error) {
+			return nil, settings.ExportSheet(w, format, args[2:]...)
+		})
+	case mode == importSheetModeArg:
+		outputPaths, conflicts, err := importSheet(&settings, arg(1))
+		for _, conflict := range conflicts {
+			_, _ = fmt.Fprintln(os.Stderr, "Conflict: "+conflict)
+		}
+		if len(outputPaths) != 0 {
+			fmt.Printf("Imported “%s” to “%s”\n", arg(1), strings.Join(outputPaths, "”, “"))
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+			return false
+		}
+		return len(conflicts) == 0
+	case mode == lockModeArg || mode == unlockModeArg:
+		lockFunc, actionDesc := settings.Lock, "Locked"
+		if mode == unlockModeArg {
+			lockFunc, actionDesc = settings.Unlock, "Unlocked"
+		}
+		tids, err := lockFunc(args[1:]...)
+		if err != nil {
+			fmt.Println(err.Error())
+			return false
+		}
+		fmt.Printf("%s %d translation IDs in “%s”\n", actionDesc, len(tids), settings.LockFile)
+		return true
+	case mode == inspectModeArg:
+		return inspectCompiled(&settings, arg(1), *flagInspectFull)
+	case mode == decompileModeArg:
+		return exportToFile(arg(1), arg(2), func(w io.Writer) ([]string, error) {
+			return nil, settings.Decompile(w, arg(1))
+		})
+	case mode == diffDictModeArg:
+		return diffDictionaries(&settings, arg(1), arg(2))
+	case mode == verifyModeArg:
+		return verifyCompiled(&settings)
+	case mode == statsModeArg:
+		return coverageStats(&settings, args[1:], *flagMinCoverage, outputFormat)
+	case mode == embedGraphModeArg:
+		format := translate.EGF_DOT
+		if outputFormat != outputFormatText {
+			format = translate.EGF_JSON
+		}
+		return exportToFile(arg(1), arg(2), func(w io.Writer) ([]string, error) {
+			if g, err := settings.EmbedGraph(arg(1)); err != nil {
+				return nil, err
+			} else if outputFormat != outputFormatYAML {
+				return nil, g.Write(w, format)
+			} else {
+				var buf bytes.Buffer
+				if err := g.Write(&buf, format); err != nil {
+					return nil, err
+				}
+				return nil, writeJSONAsYAML(w, buf.Bytes())
+			}
+		})
+	case mode == packModeArg:
+		return packBundle(&settings, arg(1), *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
+	case mode == standaloneModeArg:
+		dirData, err := settings.Standalone(args[1:]...)
+		outputDirData(dirData, err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
+		return err == nil
+	case mode == convertModeArg:
+		outputPath, err := settings.ConvertFile(arg(1), *flagConvertTo)
+		if err != nil {
+			fmt.Println(err.Error())
+			return false
+		}
+		fmt.Printf("Converted “%s” to “%s”\n", arg(1), outputPath)
+		return true
+	case mode == compileNsModeArg:
+		warnings, err := settings.CompileNamespaces(args[1:]...)
+		printWarnings(warnings)
+		if err != nil {
+			fmt.Println(err.Error())
+			return false
+		}
+		fmt.Printf("Compiled “%s”\n", strings.Join(args[1:], "”, “"))
+		return true
+	case mode == importAndroidModeArg || mode == importAppleModeArg || mode == importArbModeArg || mode == importFluentModeArg:
+		outputPath, warnings, err := importMobile(&settings, arg(0), arg(1), args[2:])
+		printWarnings(warnings)
+		if err != nil {
+			fmt.Println(err.Error())
+			return false
+		}
+		fmt.Printf("Imported “%s” to “%s”\n", strings.Join(args[2:], "”, “"), outputPath)
+		return true
+	case mode == importXliffModeArg:
+		success := true
+		for _, filePath := range args[1:] {
+			if outputPath, err := importXliff(&settings, filePath); err != nil {
+				fmt.Printf("Importing “%s”: %s\n", filePath, err.Error())
+				success = false
+			} else {
+				fmt.Printf("Imported “%s” to “%s”\n", filePath, outputPath)
+			}
+		}
+		return success
 	case *flagSingleFile:
 		if err := settings.FileCompileOnly(languageIdentifier); err != nil {
 			fmt.Println(err.Error())
@@ -265,10 +586,11 @@ func mainWrapper() bool {
 		}
 	case *flagFallbackFiles:
 		dirData, err := settings.File(languageIdentifier)
-		outputDirData(dirData, err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings)
+		outputDirData(dirData, err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
+		outputPlannedWrites(dirData, settings.DryRun && outputFormat == outputFormatText)
 		return err == nil
-	case *flagWatchFiles:
-		ret := watch.Execute(&settings)
+	case mode == watchModeArg:
+		ret := watch.Execute(context.Background(), &settings)
 		for msg := range ret {
 			switch msg.Type {
 			case watch.WR_Message:
@@ -281,7 +603,10 @@ func mainWrapper() bool {
 				}
 			case watch.WR_ProcessedDirectory:
 				fmt.Println("Finished processing input directory")
-				outputDirData(msg.Files, msg.Err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings)
+				outputDirData(msg.Files, msg.Err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
+			case watch.WR_Summary:
+				fmt.Println("Summary after the changes settled")
+				outputDirData(msg.Files, msg.Err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
 			case watch.WR_ErroredOut:
 				fmt.Printf("Fatal error, exiting: %s\n", msg.Err)
 				return true
@@ -291,7 +616,7 @@ func mainWrapper() bool {
 			}
 		}
 		panic("Unreachable code")
-	case hasLangIdentifier:
+	case mode == fileModeArg:
 		if err := settings.FileNoReturn(languageIdentifier); err != nil {
 			fmt.Println(err.Error())
 			return false
@@ -299,16 +624,380 @@ func mainWrapper() bool {
 			fmt.Println("Success")
 			return true
 		}
-	case !hasLangIdentifier:
+	case mode == buildModeArg:
+		if *flagShowProgress {
+			settings.Progress = progressBar()
+		}
 		dirData, err := settings.Directory()
-		outputDirData(dirData, err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings)
+		if *flagShowProgress {
+			_, _ = fmt.Fprintln(os.Stderr)
+		}
+		outputDirData(dirData, err, *flagShowTable, *flagShowProcessedFlags, *flagShowProcessedWarnings, outputFormat)
+		outputPlannedWrites(dirData, settings.DryRun && outputFormat == outputFormatText)
 		return err == nil
 	default:
 		panic("Unreachable code")
 	}
 }
 
-func outputDirData(ret execute.ProcessedFileList, err error, showTable, showProcessedFlags, showWarnings bool) {
+// Exports the language to the output file path (or stdout if not given). Warnings are written to stderr. Returns if successful
+func exportToFile(languageIdentifier, outputFilePath string, export func(w io.Writer) ([]string, error)) bool {
+	if len(outputFilePath) == 0 {
+		warnings, err := export(os.Stdout)
+		printWarnings(warnings)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err.Error())
+			return false
+		}
+		return true
+	}
+
+	var warnings []string
+	f, err := os.Create(outputFilePath)
+	if err == nil {
+		warnings, err = export(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	printWarnings(warnings)
+	if err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+	fmt.Printf("Exported “%s” to “%s”\n", languageIdentifier, outputFilePath)
+	return true
+}
+
+// Packs the compiled files into a bundle archive at the output file path (or in the compiled directory if not given). Returns if successful
+func packBundle(settings *execute.ProcessSettings, outputFilePath string, showTable, showProcessedFlags, showWarnings bool, outputFormat string) bool {
+	if len(outputFilePath) == 0 {
+		outputFilePath = filepath.Join(settings.CompiledOutputPath, execute.BundleArchiveFileBase+execute.GTRB_Extension)
+	}
+
+	//The archive is only written if packing succeeds
+	var buf bytes.Buffer
+	dirData, err := settings.Pack(&buf)
+	outputDirData(dirData, err, showTable, showProcessedFlags, showWarnings, outputFormat)
+	if err != nil {
+		return false
+	} else if err := os.WriteFile(outputFilePath, buf.Bytes(), 0644); err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+	if outputFormat == outputFormatText {
+		fmt.Printf("Packed “%s”\n", outputFilePath)
+	}
+	return true
+}
+
+// Exports the language’s Apple resource files into the output directory. Returns if successful
+func exportApple(settings *execute.ProcessSettings, languageIdentifier, outputDirectory string) bool {
+	var stringsBuf, stringsdictBuf bytes.Buffer
+	warnings, err := settings.ExportAppleStrings(&stringsBuf, &stringsdictBuf, languageIdentifier)
+	printWarnings(warnings)
+	if err == nil {
+		err = os.MkdirAll(outputDirectory, 0755)
+	}
+	if err == nil {
+		err = os.WriteFile(filepath.Join(outputDirectory, appleFileBase+".strings"), stringsBuf.Bytes(), 0644)
+	}
+	if err == nil {
+		err = os.WriteFile(filepath.Join(outputDirectory, appleFileBase+".stringsdict"), stringsdictBuf.Bytes(), 0644)
+	}
+	if err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+	fmt.Printf("Exported “%s” to “%s”\n", languageIdentifier, outputDirectory)
+	return true
+}
+
+// Imports Android, Apple, Flutter, or Fluent resource files for the import mode. Apple files are told apart by their extension. Returns the path of the written translation text file
+func importMobile(settings *execute.ProcessSettings, mode string, languageIdentifier string, filePaths []string) (string, []string, error) {
+	//Open the files
+	var files []io.Reader
+	var stringsFile, stringsdictFile io.Reader
+	for _, filePath := range filePaths {
+		f, err := os.Open(filePath)
+		if err != nil {
+			return "", nil, err
+		}
+		//goland:noinspection GoDeferInLoop
+		defer func() { _ = f.Close() }()
+		files = append(files, f)
+		if strings.EqualFold(filepath.Ext(filePath), ".stringsdict") {
+			stringsdictFile = f
+		} else {
+			stringsFile = f
+		}
+	}
+
+	switch mode {
+	case importAppleModeArg:
+		return settings.ImportAppleStrings(stringsFile, stringsdictFile, languageIdentifier)
+	case importArbModeArg:
+		return settings.ImportARB(files[0], languageIdentifier)
+	case importFluentModeArg:
+		fluentFiles := make([]translate.FluentFile, len(files))
+		for i, f := range files {
+			namespaceName := strings.TrimSuffix(filepath.Base(filePaths[i]), filepath.Ext(filePaths[i]))
+			fluentFiles[i] = translate.FluentFile{Namespace: regexp.MustCompile(`\W`).ReplaceAllString(namespaceName, "_"), File: f}
+		}
+		return settings.ImportFluent(fluentFiles, languageIdentifier)
+	default:
+		return settings.ImportAndroidStrings(files[0], languageIdentifier)
+	}
+}
+
+// Imports a spreadsheet for the import-sheet mode. The format is taken from the file extension. Returns the paths of the written translation text files
+func importSheet(settings *execute.ProcessSettings, filePath string) ([]string, []string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	format := translate.SF_CSV
+	if strings.EqualFold(filepath.Ext(filePath), "."+execute.XLSX_Extension) {
+		format = translate.SF_XLSX
+	}
+	return settings.ImportSheet(f, format)
+}
+
+// Writes conversion warnings to stderr
+func printWarnings(warnings []string) {
+	for _, w := range warnings {
+		_, _ = fmt.Fprintln(os.Stderr, "Warning: "+w)
+	}
+}
+
+// Outputs the translation IDs that differ between 2 compiled dictionary files. Returns false if the indexes of existing translation IDs changed
+func diffDictionaries(settings *execute.ProcessSettings, oldFilePath, newFilePath string) bool {
+	diff, err := settings.DiffDictionaries(oldFilePath, newFilePath)
+	if err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+
+	//Output the changed translation IDs
+	for _, e := range diff.Added {
+		fmt.Printf("Added: %s.%s (#%d)\n", e.Namespace, e.TranslationID, e.NewIndex)
+	}
+	for _, e := range diff.Removed {
+		fmt.Printf("Removed: %s.%s (#%d)\n", e.Namespace, e.TranslationID, e.OldIndex)
+	}
+	for _, e := range diff.Reindexed {
+		fmt.Printf("Re-indexed: %s.%s (#%d → #%d)\n", e.Namespace, e.TranslationID, e.OldIndex, e.NewIndex)
+	}
+	fmt.Printf("%d added, %d removed, %d re-indexed\n", len(diff.Added), len(diff.Removed), len(diff.Reindexed))
+	if diff.HasIndexChanges() {
+		_, _ = fmt.Fprintln(os.Stderr, "The indexes of existing translation IDs changed, which breaks binaries built against the old dictionary")
+		return false
+	}
+	return true
+}
+
+// Outputs the pass/fail report of the compiled files. Returns if no problems were found
+func verifyCompiled(settings *execute.ProcessSettings) bool {
+	report, err := settings.Verify()
+	if err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+
+	//Outputs a check and its problems
+	outputCheck := func(name string, problems []translate.PackProblem) {
+		if len(problems) == 0 {
+			fmt.Printf("PASS %s\n", name)
+			return
+		}
+		fmt.Printf("FAIL %s\n", name)
+		for _, p := range problems {
+			fmt.Printf("   %s\n", p.Error())
+		}
+	}
+	outputCheck(fmt.Sprintf("Dictionary (%d namespaces, %d translations)", report.NumNamespaces, report.NumTranslations), report.DictionaryProblems)
+	outputCheck("Compiled output set", report.Problems)
+	for i, l := range report.Languages {
+		outputCheck(report.LanguageFiles[i], l.Problems)
+	}
+
+	if !report.OK() {
+		fmt.Println("Verification failed")
+		return false
+	}
+	fmt.Println("Verification passed")
+	return true
+}
+
+// Outputs the translation coverage of the languages for the stats mode. Returns false if there were errors, or if the coverage of any language is below minCoverage
+func coverageStats(settings *execute.ProcessSettings, languageIdentifiers []string, minCoverage float64, outputFormat string) bool {
+	coverages, list, err := settings.Coverage(languageIdentifiers...)
+
+	//Find the languages below the minimum coverage
+	var belowMin []string
+	for _, lc := range coverages {
+		if lc.Coverage < minCoverage {
+			belowMin = append(belowMin, lc.LanguageIdentifier)
+		}
+	}
+
+	//Output as JSON or YAML
+	if outputFormat != outputFormatText {
+		data := struct {
+			Error         *string
+			Languages     []execute.LanguageCoverage
+			BelowCoverage []string
+		}{nil, coverages, belowMin}
+		if err != nil {
+			errStr := err.Error()
+			data.Error = &errStr
+		}
+		outputStructured(data, outputFormat)
+		return err == nil && len(belowMin) == 0
+	}
+
+	//Output errors
+	if err != nil {
+		fmt.Println("Errors: " + err.Error())
+		for _, pf := range list {
+			if pf.Err != nil {
+				fmt.Printf("Lang “%s”: %s\n", pf.LangIdentifier, pf.Err.Error())
+			}
+		}
+		fmt.Println(strings.Repeat("-", 80))
+	}
+
+	//Output the coverage of each language and its namespaces
+	for _, lc := range coverages {
+		fmt.Printf("%s: %d/%d (%.1f%%), %d missing, %d extra\n", lc.LanguageIdentifier, lc.NumTranslated, lc.NumTranslations, lc.Coverage, lc.NumMissing, lc.NumExtra)
+		for _, nc := range lc.Namespaces {
+			fmt.Printf("   %s: %d/%d (%.1f%%), %d missing, %d extra\n", nc.Name, nc.NumTranslated, nc.NumTranslations, nc.Coverage, len(nc.Missing), nc.NumExtra)
+		}
+		for _, namespaceName := range lc.ExtraNamespaces {
+			fmt.Printf("   %s: Extra namespace\n", namespaceName)
+		}
+	}
+
+	//Fail on the languages below the minimum coverage
+	if len(belowMin) != 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Coverage is below %g%% for “%s”\n", minCoverage, strings.Join(belowMin, "”, “"))
+		return false
+	}
+	return err == nil
+}
+
+// Outputs the header, settings, and counts of a compiled translation file, and all of its translations if full
+func inspectCompiled(settings *execute.ProcessSettings, fileArg string, full bool) bool {
+	//Read the file, which is given as a language identifier or a file path
+	info, err := settings.InspectCompiledFile(fileArg)
+	if err != nil {
+		fmt.Println(err.Error())
+		return false
+	}
+
+	//Output the header
+	h := info.Header
+	hashNote, checksumNote := "", "No"
+	if info.HasDictionary {
+		hashNote = " (Matches the compiled dictionary)"
+	}
+	if h.HasChecksum {
+		checksumNote = "Yes"
+	}
+	fmt.Printf("File type: %s\n", h.FileType)
+	fmt.Printf("Format version: %d\n", h.FormatVersion)
+	fmt.Printf("File size: %d (Settings: %d, Translation strings: %d, Checksum: %s)\n", h.FileSize, h.SettingsSize, h.DataSize, checksumNote)
+	fmt.Printf("Rule size: %d\n", h.RuleSize)
+	fmt.Printf("Stored rules: %d\n", h.NumRules)
+	fmt.Printf("Dictionary hash: %s%s\n", h.DictionaryHash, hashNote)
+	if info.LangErr != nil {
+		fmt.Println(info.LangErr.Error())
+		return false
+	}
+
+	//Output the settings
+	lang := info.Lang
+	numberingSystem, cldrVersion := lang.NumberingSystem(), lang.CLDRVersion()
+	if len(numberingSystem) == 0 {
+		numberingSystem = "[Locale default]"
+	}
+	if len(cldrVersion) == 0 {
+		cldrVersion = "[Not recorded]"
+	}
+	fmt.Printf("Name: %s\n", lang.Name())
+	fmt.Printf("Language identifier: %s\n", lang.LanguageIdentifier())
+	fmt.Printf("Fallback: %s\n", lang.FallbackName())
+	fmt.Printf("Numbering system: %s\n", numberingSystem)
+	fmt.Printf("Calendar: %s\n", lang.Calendar())
+	fmt.Printf("Registers: %s\n", strings.Join(lang.Registers(), ", "))
+	fmt.Printf("Omitted namespaces: %s\n", strings.Join(lang.OmittedNamespaces(), ", "))
+	fmt.Printf("CLDR version: %s (This build: %s)\n", cldrVersion, translate.CLDRVersion)
+	if warning := lang.CLDRVersionWarning(); len(warning) != 0 {
+		printWarnings([]string{warning})
+	}
+
+	//Output the counts of the namespaces. Without the dictionary, the namespaces are not known, so the translations are output as 1 unnamed namespace
+	namespaces := []translate.NamespaceInfo{{Name: "[Unknown without the compiled dictionary]", NumTranslationIDs: lang.NumTranslations()}}
+	if info.HasDictionary {
+		namespaces = lang.Namespaces()
+	}
+	fmt.Printf("Translations: %d (Translated: %d)\n", lang.NumTranslations(), lang.NumTranslated())
+	fmt.Printf("Namespaces: %d\n", len(namespaces))
+	for _, ns := range namespaces {
+		lastIndex := ns.FirstIndex + translate.TransIndex(ns.NumTranslationIDs)
+		var numTranslated, numRules uint32
+		for index := ns.FirstIndex; index < lastIndex; index++ {
+			if n := lang.NumRules(index); n != 0 {
+				numTranslated++
+				numRules += n
+			}
+		}
+		fmt.Printf("  %s: %d translations (Translated: %d), %d rules\n", ns.Name, ns.NumTranslationIDs, numTranslated, numRules)
+
+		//Output the translations. Without the dictionary, they are named by their TransIndex
+		if !full {
+			continue
+		}
+		for index := ns.FirstIndex; index < lastIndex; index++ {
+			rules, err := lang.DecompileTranslation(index)
+			if err != nil {
+				fmt.Println(err.Error())
+				return false
+			} else if len(rules) == 0 {
+				continue
+			}
+			name := fmt.Sprintf("#%d", index)
+			if info.HasDictionary {
+				name, _ = lang.TranslationIDLookup(index)
+			}
+			fmt.Printf("    %s:\n", name)
+			for _, r := range rules {
+				fmt.Printf("      %s: %s\n", r.Rule, r.Text)
+			}
+		}
+	}
+	return true
+}
+
+// Imports an XLIFF file. Returns the path of the written translation text file
+func importXliff(settings *execute.ProcessSettings, filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	return settings.ImportXLIFF(f)
+}
+
+func outputDirData(ret execute.ProcessedFileList, err error, showTable, showProcessedFlags, showWarnings bool, outputFormat string) {
+	//Output as JSON or YAML
+	if outputFormat != outputFormatText {
+		outputDirDataStructured(ret, err, outputFormat)
+		return
+	}
+
 	//Output errors
 	if err != nil {
 		fmt.Println("Errors: " + err.Error())
@@ -322,22 +1011,18 @@ func outputDirData(ret execute.ProcessedFileList, err error, showTable, showProc
 		fmt.Println("Success")
 	}
 
-	//Print the flag table
+	//Print the flag table and the coverage of the languages
 	if len(ret) != 0 && showTable {
 		fmt.Println(strings.Join(ret.CreateFlagTable(), "\n"))
+		fmt.Println(strings.Join(ret.Coverage(), "\n"))
 	}
 
-	//Print the processed flags
+	//Print the processed flags, and the processing times and sizes
 	if len(ret) != 0 && showProcessedFlags {
 		for _, pf := range ret {
-			getFlags := make([]string, 0, len(execute.ProcessedFileFlagNames))
-			for _, f := range execute.ProcessedFileFlagNames {
-				if pf.Flags&f.Flag != 0 {
-					getFlags = append(getFlags, f.Name)
-				}
-			}
-			fmt.Printf("%s: %s\n", pf.LangIdentifier, strings.Join(getFlags, ", "))
+			fmt.Printf("%s: %s\n", pf.LangIdentifier, strings.Join(pf.Flags.Names(), ", "))
 		}
+		fmt.Println(strings.Join(ret.Stats(), "\n"))
 	}
 
 	//Print warnings
@@ -357,3 +1042,131 @@ func outputDirData(ret execute.ProcessedFileList, err error, showTable, showProc
 		}
 	}
 }
+
+// Returns an execute.ProcessSettings.Progress that draws a progress bar of the processed languages on stderr
+func progressBar() func(event execute.ProgressEvent) {
+	const barWidth, langIdentWidth = 40, 20
+	return func(event execute.ProgressEvent) {
+		if event.Type == execute.PE_FileWarnings {
+			return
+		}
+		filled := int(barWidth * event.NumFinished / event.NumFiles)
+		_, _ = fmt.Fprintf(os.Stderr, "\r[%s%s] %d/%d %-*s", strings.Repeat("#", filled), strings.Repeat("-", barWidth-filled), event.NumFinished, event.NumFiles, langIdentWidth, event.LangIdentifier)
+	}
+}
+
+// Outputs the files that a dry run would write, and why. Does nothing if not isDryRun
+func outputPlannedWrites(ret execute.ProcessedFileList, isDryRun bool) {
+	if !isDryRun {
+		return
+	}
+	langIdents := make([]string, 0, len(ret))
+	for langIdent := range ret {
+		langIdents = append(langIdents, langIdent)
+	}
+	sort.Strings(langIdents)
+
+	fmt.Println(strings.Repeat("-", 80))
+	numPlannedWrites := 0
+	for _, langIdent := range langIdents {
+		for _, pw := range ret[langIdent].PlannedWrites {
+			if numPlannedWrites++; numPlannedWrites == 1 {
+				fmt.Println("Dry run. Would write:")
+			}
+			fmt.Printf("Lang “%s”: %s (%s)\n", langIdent, pw.FilePath, pw.Reason)
+		}
+	}
+	if numPlannedWrites == 0 {
+		fmt.Println("Dry run. No files would be written")
+	}
+}
+
+// Outputs the processed languages as a JSON or YAML object. Error is null on success, and Files is the ProcessedFileList (see execute.ProcessedFile.MarshalJSON())
+func outputDirDataStructured(ret execute.ProcessedFileList, err error, outputFormat string) {
+	data := struct {
+		Error *string
+		Files execute.ProcessedFileList
+	}{nil, ret}
+	if err != nil {
+		errStr := err.Error()
+		data.Error = &errStr
+	}
+	outputStructured(data, outputFormat)
+}
+
+// Outputs the data to stdout as JSON, or as YAML with the same schema
+func outputStructured(data any, outputFormat string) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetIndent("", "\t")
+	err := e.Encode(data)
+	if err == nil {
+		if outputFormat == outputFormatYAML {
+			err = writeJSONAsYAML(os.Stdout, buf.Bytes())
+		} else {
+			_, err = os.Stdout.Write(buf.Bytes())
+		}
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Could not encode %s: %s\n", strings.ToUpper(outputFormat), err.Error())
+	}
+}
+
+// Writes JSON as YAML, keeping the order of the object members
+func writeJSONAsYAML(w io.Writer, jsonData []byte) error {
+	d := json.NewDecoder(bytes.NewReader(jsonData))
+	d.UseNumber()
+	var decodeValue func() (any, error)
+	decodeValue = func() (any, error) {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token {
+		case json.Delim('{'):
+			members := yaml.MapSlice{}
+			for d.More() {
+				key, err := d.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeValue()
+				if err != nil {
+					return nil, err
+				}
+				members = append(members, yaml.MapItem{Key: key, Value: value})
+			}
+			_, err = d.Token()
+			return members, err
+		case json.Delim('['):
+			items := []any{}
+			for d.More() {
+				item, err := decodeValue()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			_, err = d.Token()
+			return items, err
+		}
+		if n, ok := token.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				return i, nil
+			}
+			return n.Float64()
+		}
+		return token, nil
+	}
+
+	value, err := decodeValue()
+	if err != nil {
+		return err
+	}
+	yamlData, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(yamlData)
+	return err
+}
@@ -0,0 +1,162 @@
+//Build languages in memory instead of from translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"gopkg.in/yaml.v2"
+	"io"
+)
+
+// Builder constructs a language’s settings, namespaces, Translation IDs, variables, and rules in memory, for tooling, tests, and translations stored in databases. It holds the same content as a translation text file, and is loaded (and validated) the same way.
+//
+// Items are kept in the order they are first added, which is the order of the namespaces and Translation IDs in the dictionary (and their TransIndexes). Adding an item that already exists replaces its value. A Builder is not safe for concurrent use
+type Builder struct {
+	settings   yamlMapSlice
+	namespaces []*BuilderNamespace
+}
+
+// BuilderNamespace is a namespace of a Builder. See Builder.Namespace()
+type BuilderNamespace struct {
+	name         string
+	metadata     yamlMapSlice
+	translations []*BuilderTranslation
+}
+
+// BuilderTranslation is a Translation ID of a BuilderNamespace. See BuilderNamespace.Translation()
+type BuilderTranslation struct {
+	translationID string
+	props         yamlMapSlice //The rules and variables
+}
+
+// NewBuilder creates a Builder with the required settings. Other settings are added with Builder.Setting()
+func NewBuilder(languageIdentifier, languageName, missingPluralRule string) *Builder {
+	b := &Builder{}
+	return b.Setting("LanguageIdentifier", languageIdentifier).Setting("LanguageName", languageName).Setting("MissingPluralRule", missingPluralRule)
+}
+
+// Setting sets a setting of the language, like “FallbackLanguage” or “Registers” (see the Settings of translation text files). Only settings with string values can be set
+func (b *Builder) Setting(name, value string) *Builder {
+	setYamlMapSliceValue(&b.settings, name, value)
+	return b
+}
+
+// Namespace returns the namespace with the name, which is added if it does not exist
+func (b *Builder) Namespace(name string) *BuilderNamespace {
+	for _, n := range b.namespaces {
+		if n.name == name {
+			return n
+		}
+	}
+	n := &BuilderNamespace{name: name}
+	b.namespaces = append(b.namespaces, n)
+	return n
+}
+
+// Metadata sets a metadata property of the namespace (“Owner”, “Description”, “Review”, or any other name). Metadata is only read from the default language
+func (n *BuilderNamespace) Metadata(name, value string) *BuilderNamespace {
+	setYamlMapSliceValue(&n.metadata, name, value)
+	return n
+}
+
+// Translation returns the Translation ID of the namespace with the name, which is added if it does not exist
+func (n *BuilderNamespace) Translation(translationID string) *BuilderTranslation {
+	for _, t := range n.translations {
+		if t.translationID == translationID {
+			return t
+		}
+	}
+	t := &BuilderTranslation{translationID: translationID}
+	n.translations = append(n.translations, t)
+	return t
+}
+
+// Add adds (or replaces) a Translation ID with a single “^” rule, which is the same as a Translation ID with a string value in a translation text file
+func (n *BuilderNamespace) Add(translationID, text string) *BuilderNamespace {
+	t := n.Translation(translationID)
+	t.props = nil
+	t.Rule("^", text)
+	return n
+}
+
+// Rule sets a plurality rule of the Translation ID, like “=1” or “^” (or a register’s rule, like “@Formal^”)
+func (t *BuilderTranslation) Rule(rule, text string) *BuilderTranslation {
+	setYamlMapSliceValue(&t.props, rule, text)
+	return t
+}
+
+// Variable sets the type of a variable of the Translation ID, like “String” or “DateTime”
+func (t *BuilderTranslation) Variable(name, varType string) *BuilderTranslation {
+	setYamlMapSliceValue(&t.props, name, varType)
+	return t
+}
+
+// LoadDefault builds the default language, which creates the dictionary. The dictionary cannot already be loaded. This works the same as Dictionary.LoadDefaultText()
+func (b *Builder) LoadDefault(d *Dictionary, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+	//Check if the dictionary is already loaded
+	if d.dict != nil {
+		return nil, nil, errors.New("The dictionary was already loaded. This language can only be loaded as a non-default language")
+	}
+
+	//Load the language and store the dictionary
+	l, warn, err := loadTopItem(b.topItem(), nil, nil, nil, allowBigStrings, d.compileLimits)
+	if err != nil {
+		return nil, warn, err
+	}
+	d.dict = l.dict
+	l.fallback = l //Set self as the fallback
+	return l, warn, nil
+}
+
+// Load builds a non-default language. The default language or the dictionary must be loaded first. This works the same as Dictionary.LoadText()
+//
+// Note: Fallback languages still need to be assigned through Language.SetFallback()
+func (b *Builder) Load(d *Dictionary, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+	localDict := d.dict
+	if localDict == nil {
+		return nil, nil, errors.New(errDictionaryNotLoaded)
+	}
+	return loadTopItem(b.topItem(), nil, localDict, nil, allowBigStrings, d.compileLimits)
+}
+
+// WriteText writes the language as a translation text file in the canonical layout of the file type (see LanguageTextFile.Format()). TOML, i18next JSON, and custom format files cannot be written
+func (b *Builder) WriteText(w io.Writer, lf LanguageTextFile) error {
+	if !lf.CanBeWritten() {
+		return errors.New("TOML, i18next JSON, and custom format files cannot be written")
+	}
+	if buf, err := lf.formatTopItem(b.topItem()); err != nil {
+		return err
+	} else {
+		_, err = w.Write(buf)
+		return err
+	}
+}
+
+// Returns the full structure of the translation text file
+func (b *Builder) topItem() tpItem {
+	top := make(yamlMapSlice, 0, len(b.namespaces)+1)
+	setYamlMapSliceValue(&top, "Settings", append(yamlMapSlice(nil), b.settings...))
+	for _, n := range b.namespaces {
+		namespaceObj := make(yamlMapSlice, 0, len(n.translations)+1)
+		if len(n.metadata) != 0 {
+			setYamlMapSliceValue(&namespaceObj, namespaceMetadataName, append(yamlMapSlice(nil), n.metadata...))
+		}
+		for _, t := range n.translations {
+			setYamlMapSliceValue(&namespaceObj, t.translationID, append(yamlMapSlice(nil), t.props...))
+		}
+		setYamlMapSliceValue(&top, n.name, namespaceObj)
+	}
+	return yamlItem{Key: "TOP", Value: top}
+}
+
+// Sets the value of the key, which is added to the end if it does not exist
+func setYamlMapSliceValue(ms *yamlMapSlice, key string, value interface{}) {
+	for i, item := range *ms {
+		if item.Key == key {
+			(*ms)[i].Value = value
+			return
+		}
+	}
+	*ms = append(*ms, yaml.MapItem{Key: key, Value: value})
+}
@@ -0,0 +1,71 @@
+//Retrieving a translation from every loaded language
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Bundle is a set of loaded languages keyed to their language identifier, like the languages returned by load_compiled.LoadFromFS(). Their fallbacks must already be set. It is safe for concurrent use as long as it is not modified
+type Bundle map[string]*Language
+
+// GetAll retrieves a non-plural translation (see Language.Get()) in every language of the bundle, keyed to their language identifier. Languages without the translation get it from their fallback languages.
+//
+// Every language is still in the returned map when errors occur (with the string its Get() returned), and the error lists each language that errored
+func (b Bundle) GetAll(index TransIndex, args ...interface{}) (map[string]string, error) {
+	return b.getAllReal(index, -1, args)
+}
+
+// GetAllPlural retrieves a plural translation (see Language.GetPlural()) in every language of the bundle, keyed to their language identifier. Languages without the translation get it from their fallback languages.
+//
+// Every language is still in the returned map when errors occur (with the string its GetPlural() returned), and the error lists each language that errored
+func (b Bundle) GetAllPlural(index TransIndex, pluralCount uint, args ...interface{}) (map[string]string, error) {
+	return b.getAllReal(index, pluralCountToInt64(pluralCount), args)
+}
+
+// With returns a copy of the bundle whose languages format with the given overrides (see Language.With()). For example, WithRegister() requests every language in a register
+func (b Bundle) With(options ...GetOption) Bundle {
+	newBundle := make(Bundle, len(b))
+	for langIdent, l := range b {
+		if l != nil {
+			l = l.With(options...)
+		}
+		newBundle[langIdent] = l
+	}
+	return newBundle
+}
+
+// Lookup returns the language of the language identifier, which is first resolved through the aliases (Example: with {"no": "nb"}, “no” returns the “nb” language). Returns nil if the language is not in the bundle. The aliases may be nil
+func (b Bundle) Lookup(languageIdentifier string, aliases LanguageAliases) *Language {
+	return b[aliases.Resolve(languageIdentifier)]
+}
+
+// All GetAll...() functions call this
+func (b Bundle) getAllReal(index TransIndex, pluralCount int64, args []interface{}) (map[string]string, error) {
+	//Get the translation from each language
+	ret := make(map[string]string, len(b))
+	var errs []string
+	for langIdent, l := range b {
+		if l == nil {
+			errs = append(errs, fmt.Sprintf("Language “%s”: Is nil", langIdent))
+			ret[langIdent] = returnBlankStrOnErr
+			continue
+		}
+
+		str, err := l.getReal(index, pluralCount, 0, args)
+		ret[langIdent] = str
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("Language “%s”: %s", langIdent, err.Error()))
+		}
+	}
+
+	//Return the errors sorted by language
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		return ret, errors.New(strings.Join(errs, "\n"))
+	}
+	return ret, nil
+}
@@ -0,0 +1,216 @@
+//Read languages from a .gtrb bundle archive, which holds the compiled dictionary files and all the compiled languages
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Bundle archive (.gtrb) storage structures. The archive is:
+//   - The header
+//   - The files: The dictionary, the variable dictionary (size 0 if not included), then the languages (the default language first)
+//   - The size of each language identifier (1 byte each), followed by the language identifiers
+//   - The data of the files, which are stored as is (as .gtr or .gtr.gz files)
+type storeBundleHeader struct {
+	fileType     [3]byte //BTR
+	isCompressed uint8   //If the files are gzip compressed
+	numLanguages uint32
+	namesSize    uint32 //The size of the language identifiers
+}
+type storeBundleFile struct {
+	offset, size uint32
+}
+
+const (
+	size_storeBundleHeader = uint32(12)
+	size_storeBundleFile   = uint32(8)
+	numBundleDictFiles     = 2 //The dictionary and variable dictionary come before the languages
+)
+
+const softLimit_numBundleLanguages = 10_000
+
+// BundleArchive is an opened .gtrb bundle archive, which holds the compiled dictionary, the compiled variable dictionary, and all the compiled languages in a single file. Languages are only read from the archive when they are first requested.
+//
+// Each archive is loaded against its own dictionary (see NewDictionary()). The reader must stay open while the archive is used. All functions are concurrency safe
+type BundleArchive struct {
+	r                   io.ReaderAt
+	isCompressed        bool
+	dict                *Dictionary
+	defaultLanguage     *Language
+	languageIdentifiers []string                   //In the order they were packed
+	files               map[string]storeBundleFile //Keyed by language identifier
+	loadMutex           sync.Mutex
+	languages           Bundle //The languages that have been loaded
+}
+
+// LoadBundle opens a .gtrb bundle archive (see ProcessSettings.Pack() and SaveGTRB()). It loads the dictionary and the default language, and registers the variable dictionary to load lazily (see Dictionary.LoadDictionaryVarsLazily()). Encrypted languages are decrypted with the key given to LanguageFile.SetDecryptionKey()
+func LoadBundle(r io.ReaderAt) (*BundleArchive, error) {
+	//Read and confirm the header
+	var header storeBundleHeader
+	headerBytes := make([]byte, size_storeBundleHeader)
+	if _, err := r.ReadAt(headerBytes, 0); err != nil {
+		return nil, fmt.Errorf("Could not read header: %s", err.Error())
+	}
+	header.fromLittleEndian(headerBytes)
+	if b2s(header.fileType[0:3]) != "BTR" {
+		return nil, errors.New("Invalid file header")
+	} else if header.numLanguages == 0 {
+		return nil, errors.New("Bundle archive has no languages")
+	} else if header.numLanguages > softLimit_numBundleLanguages {
+		return nil, fmt.Errorf("Number of languages (%d) exceeds soft limit (%d)", header.numLanguages, softLimit_numBundleLanguages)
+	} else if header.namesSize > header.numLanguages*0xFF {
+		return nil, fmt.Errorf("Size of language identifiers (%d) exceeds their maximum size (%d)", header.namesSize, header.numLanguages*0xFF)
+	}
+
+	//Read the index
+	numFiles := header.numLanguages + numBundleDictFiles
+	indexBuff := make([]byte, size_storeBundleFile*numFiles+header.numLanguages+header.namesSize)
+	if _, err := r.ReadAt(indexBuff, int64(size_storeBundleHeader)); err != nil {
+		return nil, fmt.Errorf("Could not read index: %s", err.Error())
+	}
+	indexEnd := uint64(size_storeBundleHeader) + uint64(len(indexBuff))
+	files := make([]storeBundleFile, numFiles)
+	decodeStructs(files, indexBuff)
+	for i, f := range files {
+		if f.size != 0 && uint64(f.offset) < indexEnd {
+			return nil, fmt.Errorf("File #%d starts inside the index", i)
+		}
+	}
+
+	//Read the language identifiers
+	a := &BundleArchive{
+		r:                   r,
+		isCompressed:        header.isCompressed != 0,
+		dict:                &Dictionary{decryption: defaultDictionary.decryption}, //Encrypted languages use the package level decryption key
+		languageIdentifiers: make([]string, header.numLanguages),
+		files:               make(map[string]storeBundleFile, header.numLanguages),
+		languages:           make(Bundle, header.numLanguages),
+	}
+	nameSizes := indexBuff[size_storeBundleFile*numFiles : size_storeBundleFile*numFiles+header.numLanguages]
+	names := indexBuff[size_storeBundleFile*numFiles+header.numLanguages:]
+	var namePos uint32
+	for i, nameSize := range nameSizes {
+		if namePos+uint32(nameSize) > header.namesSize {
+			return nil, fmt.Errorf("Language identifier #%d exceeds the size of the language identifiers", i)
+		}
+		langIdent := string(names[namePos : namePos+uint32(nameSize)])
+		namePos += uint32(nameSize)
+		if _, ok := a.files[langIdent]; ok {
+			return nil, fmt.Errorf("Language “%s” is in the bundle archive more than once", langIdent)
+		}
+		a.languageIdentifiers[i] = langIdent
+		a.files[langIdent] = files[numBundleDictFiles+i]
+	}
+	if namePos != header.namesSize {
+		return nil, fmt.Errorf("Size of language identifiers (%d) did not reach the end (%d)", namePos, header.namesSize)
+	}
+
+	//Load the dictionary and register the variable dictionary
+	if err := a.dict.LoadDictionary(a.section(files[0]), a.isCompressed); err != nil {
+		return nil, fmt.Errorf("Translation dictionary error: %s", err.Error())
+	}
+	if varsFile := files[1]; varsFile.size != 0 {
+		if err := a.dict.LoadDictionaryVarsLazily(func() (io.ReadCloser, error) {
+			return io.NopCloser(a.section(varsFile)), nil
+		}, a.isCompressed); err != nil {
+			return nil, fmt.Errorf("Translation variable dictionary error: %s", err.Error())
+		}
+	}
+
+	//Load the default language
+	if l, err := a.dict.LoadDefault(a.section(a.files[a.languageIdentifiers[0]]), a.isCompressed); err != nil {
+		return nil, fmt.Errorf("Default language error: %s", err.Error())
+	} else {
+		a.defaultLanguage = l
+		a.languages[a.languageIdentifiers[0]] = l
+	}
+
+	return a, nil
+}
+
+// Dictionary returns the dictionary the archive’s languages are loaded against
+func (a *BundleArchive) Dictionary() *Dictionary {
+	return a.dict
+}
+
+// DefaultLanguage returns the default language
+func (a *BundleArchive) DefaultLanguage() *Language {
+	return a.defaultLanguage
+}
+
+// LanguageIdentifiers returns the identifiers of all the languages in the archive (including the default language), sorted
+func (a *BundleArchive) LanguageIdentifiers() []string {
+	ret := append([]string(nil), a.languageIdentifiers...)
+	sort.Strings(ret)
+	return ret
+}
+
+// Language returns the language of the language identifier, which (along with its fallbacks) is read from the archive the first time it is requested
+func (a *BundleArchive) Language(languageIdentifier string) (*Language, error) {
+	a.loadMutex.Lock()
+	defer a.loadMutex.Unlock()
+	return a.loadLanguage(languageIdentifier, nil)
+}
+
+// LoadAll reads every language that has not been loaded yet, and returns all the languages keyed by language identifier (including the default language)
+func (a *BundleArchive) LoadAll() (Bundle, error) {
+	a.loadMutex.Lock()
+	defer a.loadMutex.Unlock()
+
+	for _, langIdent := range a.languageIdentifiers {
+		if _, err := a.loadLanguage(langIdent, nil); err != nil {
+			return nil, err
+		}
+	}
+	ret := make(Bundle, len(a.languages))
+	for langIdent, l := range a.languages {
+		ret[langIdent] = l
+	}
+	return ret, nil
+}
+
+// Loads a language and its fallbacks (if not already loaded). chain is the languages whose fallbacks are being loaded. loadMutex must be locked
+func (a *BundleArchive) loadLanguage(langIdentifier string, chain []string) (*Language, error) {
+	//If already loaded or in the fallback chain
+	if l, ok := a.languages[langIdentifier]; ok {
+		return l, nil
+	}
+	for _, ident := range chain {
+		if ident == langIdentifier {
+			return nil, fmt.Errorf("Error loading “%s”: fallback loop detected", langIdentifier)
+		}
+	}
+
+	//Load the language
+	f, ok := a.files[langIdentifier]
+	if !ok {
+		return nil, fmt.Errorf("Language “%s” is not in the bundle archive", langIdentifier)
+	}
+	l, err := a.dict.Load(a.section(f), a.isCompressed)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading “%s”: %s", langIdentifier, err.Error())
+	}
+
+	//Load and set the fallback
+	fallbackLang := a.defaultLanguage
+	if len(l.FallbackName()) != 0 {
+		if fallbackLang, err = a.loadLanguage(l.FallbackName(), append(chain, langIdentifier)); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.SetFallback(fallbackLang); err != nil {
+		return nil, fmt.Errorf("Error setting fallback “%s” on “%s”: %s", fallbackLang.LanguageIdentifier(), langIdentifier, err.Error())
+	}
+
+	a.languages[langIdentifier] = l
+	return l, nil
+}
+
+// Returns a reader of a file in the archive
+func (a *BundleArchive) section(f storeBundleFile) *io.SectionReader {
+	return io.NewSectionReader(a.r, int64(f.offset), int64(f.size))
+}
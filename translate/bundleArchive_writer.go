@@ -0,0 +1,84 @@
+//Write .gtrb bundle archives
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// BundleArchiveFiles are the compiled files that are packed into a .gtrb bundle archive (see SaveGTRB()). The files are stored as is, so they must all be compressed (.gtr.gz) or all uncompressed (.gtr)
+type BundleArchiveFiles struct {
+	Dictionary                []byte            //The compiled dictionary file
+	VarDictionary             []byte            //The compiled variable dictionary file. If nil, the archive’s dictionary will not have its variables
+	DefaultLanguageIdentifier string            //Must be in Languages
+	Languages                 map[string][]byte //The compiled language files, keyed by language identifier
+}
+
+// SaveGTRB saves a .gtrb bundle archive, which holds the compiled dictionary files and all the compiled languages in a single file, indexed so each language can be read when it is first needed (see LoadBundle())
+func SaveGTRB(w io.Writer, files BundleArchiveFiles, isCompressed bool) error {
+	//Confirm the files
+	if len(files.Dictionary) == 0 {
+		return errors.New("The dictionary file is required")
+	} else if _, ok := files.Languages[files.DefaultLanguageIdentifier]; !ok {
+		return fmt.Errorf("Default language “%s” is not in the languages", files.DefaultLanguageIdentifier)
+	} else if len(files.Languages) > softLimit_numBundleLanguages {
+		return fmt.Errorf("Number of languages (%d) exceeds soft limit (%d)", len(files.Languages), softLimit_numBundleLanguages)
+	}
+
+	//Order the languages with the default language first
+	langIdents := make([]string, 0, len(files.Languages))
+	for langIdent := range files.Languages {
+		if len(langIdent) == 0 || len(langIdent) > math.MaxUint8 {
+			return fmt.Errorf("Language identifier “%s” must be 1 to %d bytes", langIdent, math.MaxUint8)
+		} else if langIdent != files.DefaultLanguageIdentifier {
+			langIdents = append(langIdents, langIdent)
+		}
+	}
+	sort.Strings(langIdents)
+	langIdents = append([]string{files.DefaultLanguageIdentifier}, langIdents...)
+
+	//Create the index
+	header := storeBundleHeader{fileType: [3]byte(s2b("BTR")), isCompressed: cond[uint8](isCompressed, 1, 0), numLanguages: ulen32(langIdents)}
+	nameSizes := make([]byte, len(langIdents))
+	var names []byte
+	for i, langIdent := range langIdents {
+		nameSizes[i] = uint8(len(langIdent))
+		names = append(names, langIdent...)
+	}
+	header.namesSize = ulen32(names)
+	fileData := append([][]byte{files.Dictionary, files.VarDictionary}, make([][]byte, len(langIdents))...)
+	for i, langIdent := range langIdents {
+		fileData[numBundleDictFiles+i] = files.Languages[langIdent]
+	}
+	storeFiles := make([]storeBundleFile, len(fileData))
+	offset := uint64(size_storeBundleHeader) + uint64(size_storeBundleFile)*uint64(len(storeFiles)) + uint64(len(nameSizes)) + uint64(len(names))
+	for i, data := range fileData {
+		if offset+uint64(len(data)) > math.MaxUint32 {
+			return errors.New("Filesize cannot be greater than 4GB")
+		}
+		storeFiles[i] = storeBundleFile{uint32(offset), ulen32(data)}
+		offset += uint64(len(data))
+	}
+
+	//Write out the parts of the file
+	if err := writeBytesToFile(w, encodeStruct(&header)); err != nil {
+		return err
+	} else if err := writeBytesToFile(w, encodeStructs(storeFiles)); err != nil {
+		return err
+	} else if err := writeBytesToFile(w, nameSizes); err != nil {
+		return err
+	} else if err := writeBytesToFile(w, names); err != nil {
+		return err
+	}
+	for _, data := range fileData {
+		if err := writeBytesToFile(w, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+//Warming up and health checking the languages of a bundle
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LanguageHealth is the load state of a language in a bundle (see Bundle.Health())
+type LanguageHealth struct {
+	Loaded          bool   //If the language is not nil
+	HasFallback     bool   //If the language’s fallback is set (or it is the default language, which is its own fallback)
+	WarmedUp        bool   //If the language’s formatters were created (see Bundle.Warmup())
+	NumTranslations uint32 //The number of translations in the language’s dictionary
+	CLDRWarning     string //See Language.CLDRVersionWarning()
+}
+
+// IsHealthy returns if translations can be retrieved from the language
+func (lh LanguageHealth) IsHealthy() bool {
+	return lh.Loaded && lh.HasFallback
+}
+
+// Warmup creates the structures of every language of the bundle that are otherwise created by their first requests (the number formatter and DateTime localizer), and loads the dictionary’s variables if they are loaded lazily (see Dictionary.LoadDictionaryVarsLazily()), so the first requests do not take the hit. It is meant to be called while a server is starting, but is safe to call while the languages are in use.
+//
+// If renderSamples is true then the first translation of every namespace is also rendered in every language, without arguments, to load the translations’ memory. Errors from the samples are ignored, and they are not counted or cached (see Language.RecordUsage() and Language.CacheRenders()).
+//
+// Warming up stops when the context is done, which is returned as the error. Otherwise, the error lists each language that could not be warmed up. The languages are warmed up in order of their language identifiers
+func (b Bundle) Warmup(ctx context.Context, renderSamples bool) error {
+	var errs []string
+	loadedVars := make(map[*languageDict]bool)
+	for _, langIdent := range b.sortedIdentifiers() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		//Create the formatters
+		l := b[langIdent]
+		if l == nil {
+			errs = append(errs, fmt.Sprintf("Language “%s”: Is nil", langIdent))
+			continue
+		}
+		if err := l.warmFormatters(); err != nil {
+			errs = append(errs, fmt.Sprintf("Language “%s”: %s", langIdent, err.Error()))
+		}
+
+		//Load the lazily loaded variables once per dictionary
+		if l.dict.lazyVars != nil && !loadedVars[l.dict] {
+			loadedVars[l.dict] = true
+			if err := l.dict.loadVars(); err != nil {
+				errs = append(errs, fmt.Sprintf("Language “%s”: %s", langIdent, err.Error()))
+			}
+		}
+
+		//Render the samples
+		if !renderSamples || l.fallback == nil {
+			continue
+		}
+		var startIndex TransIndex
+		for _, namespaceName := range l.dict.namespacesInOrder {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if numTranslations := TransIndex(len(l.dict.namespaces[namespaceName].ids)); numTranslations != 0 {
+				_, _ = l.appendRender(nil, startIndex, -1, 0, nil)
+				startIndex += numTranslations
+			}
+		}
+	}
+
+	//Return the errors
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Health returns the load state of every language of the bundle, keyed to their language identifier, for readiness probes. The error lists each language that is not healthy (see LanguageHealth.IsHealthy())
+func (b Bundle) Health() (map[string]LanguageHealth, error) {
+	ret := make(map[string]LanguageHealth, len(b))
+	var errs []string
+	for _, langIdent := range b.sortedIdentifiers() {
+		l := b[langIdent]
+		if l == nil {
+			ret[langIdent] = LanguageHealth{}
+			errs = append(errs, fmt.Sprintf("Language “%s”: Is nil", langIdent))
+			continue
+		}
+
+		lh := LanguageHealth{
+			Loaded:          true,
+			HasFallback:     l.fallback != nil,
+			WarmedUp:        l.formattersWarmedUp(),
+			NumTranslations: l.NumTranslations(),
+			CLDRWarning:     l.CLDRVersionWarning(),
+		}
+		ret[langIdent] = lh
+		if !lh.HasFallback {
+			errs = append(errs, fmt.Sprintf("Language “%s”: Fallback language was not set", langIdent))
+		}
+	}
+
+	if len(errs) != 0 {
+		return ret, errors.New(strings.Join(errs, "\n"))
+	}
+	return ret, nil
+}
+
+// Returns the language identifiers of the bundle in order
+func (b Bundle) sortedIdentifiers() []string {
+	langIdents := make([]string, 0, len(b))
+	for langIdent := range b {
+		langIdents = append(langIdents, langIdent)
+	}
+	sort.Strings(langIdents)
+	return langIdents
+}
@@ -0,0 +1,222 @@
+//Encode the structures of compiled files in the little-endian byte order they are stored in
+
+package translate
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// Compiled files are stored in little-endian byte order, and their structures are encoded field by field (see binaryStruct), with zeroed padding.
+//
+// The translation rules are the bulk of a language file, so when the memory layout of their structures matches the file format (on little-endian architectures where the alignment of their fields is the file format’s), they are read directly as their memory instead (the fast path). Otherwise, they are decoded one at a time
+var useDirectLayout = isLittleEndian &&
+	unsafe.Sizeof(storeTranslationRule16{}) == uintptr(size_storeTranslationRule16) &&
+	unsafe.Sizeof(storeTranslationRule32{}) == uintptr(size_storeTranslationRule32) &&
+	unsafe.Sizeof(storeTranslationRuleWide{}) == uintptr(size_storeTranslationRuleWide) &&
+	unsafe.Offsetof(storeTranslationRuleWide{}.rule)+unsafe.Offsetof(pluralRule{}.i0) == 8 &&
+	unsafe.Sizeof(storeTranslationRuleSlice{}) == uintptr(size_storeTranslationRuleSlice) &&
+	unsafe.Sizeof(storeTranslationIDSize{}) == uintptr(size_storeTranslationIDsSize) &&
+	unsafe.Sizeof(storeNamespace{}) == uintptr(size_storeNamespace)
+
+var isLittleEndian = func() bool {
+	v := uint16(1)
+	return any2b(&v)[0] == 1
+}()
+
+// binaryStruct : A compiled file structure that is encoded in little-endian byte order
+type binaryStruct interface {
+	binarySize() uint32        //The size of the structure in the file
+	putLittleEndian(b []byte)  //Encodes the structure into b, which is at least binarySize() bytes. Padding is zeroed
+	fromLittleEndian(b []byte) //Decodes the structure from b, which is at least binarySize() bytes
+}
+
+// Returns the structure encoded in little-endian byte order
+func encodeStruct[T any, PT interface {
+	*T
+	binaryStruct
+}](v *T) []byte {
+	b := make([]byte, PT(v).binarySize())
+	PT(v).putLittleEndian(b)
+	return b
+}
+
+// Returns the structures encoded in little-endian byte order
+func encodeStructs[T any, PT interface {
+	*T
+	binaryStruct
+}](data []T) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	size := PT(&data[0]).binarySize()
+	b := make([]byte, uint32(len(data))*size)
+	for i := range data {
+		PT(&data[i]).putLittleEndian(b[uint32(i)*size:])
+	}
+	return b
+}
+
+// Decodes structures from little-endian bytes, which hold len(data) structures
+func decodeStructs[T any, PT interface {
+	*T
+	binaryStruct
+}](data []T, b []byte) {
+	if len(data) == 0 {
+		return
+	}
+	size := PT(&data[0]).binarySize()
+	for i := range data {
+		PT(&data[i]).fromLittleEndian(b[uint32(i)*size:])
+	}
+}
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	size_storeHeader           = uint32(40)
+	size_storeDictHeader       = uint32(20)
+	size_storeStandaloneHeader = uint32(4)
+)
+
+func (v *storeHeader) binarySize() uint32 { return size_storeHeader }
+func (v *storeHeader) putLittleEndian(b []byte) {
+	_ = b[size_storeHeader-1]
+	copy(b[0:2], v.fileType[:])
+	b[2], b[3] = v.formatVersion, v.translationStringByteLength
+	binary.LittleEndian.PutUint32(b[4:], v.numRules)
+	binary.LittleEndian.PutUint32(b[8:], v.numTranslations)
+	binary.LittleEndian.PutUint32(b[12:], v.settingsSize)
+	binary.LittleEndian.PutUint32(b[16:], v.dataSize)
+	copy(b[20:40], v.hash[:])
+}
+func (v *storeHeader) fromLittleEndian(b []byte) {
+	_ = b[size_storeHeader-1]
+	copy(v.fileType[:], b[0:2])
+	v.formatVersion, v.translationStringByteLength = b[2], b[3]
+	v.numRules = binary.LittleEndian.Uint32(b[4:])
+	v.numTranslations = binary.LittleEndian.Uint32(b[8:])
+	v.settingsSize = binary.LittleEndian.Uint32(b[12:])
+	v.dataSize = binary.LittleEndian.Uint32(b[16:])
+	copy(v.hash[:], b[20:40])
+}
+
+func (v *storeDictHeader) binarySize() uint32 { return size_storeDictHeader }
+func (v *storeDictHeader) putLittleEndian(b []byte) {
+	_ = b[size_storeDictHeader-1]
+	copy(b[0:2], v.fileType[:])
+	b[2], b[3] = v.formatVersion, 0
+	binary.LittleEndian.PutUint32(b[4:], v.numTranslations)
+	binary.LittleEndian.PutUint32(b[8:], v.numNamespaces)
+	binary.LittleEndian.PutUint32(b[12:], v.idsSize)
+	binary.LittleEndian.PutUint32(b[16:], v.namespacesSize)
+}
+func (v *storeDictHeader) fromLittleEndian(b []byte) {
+	_ = b[size_storeDictHeader-1]
+	copy(v.fileType[:], b[0:2])
+	v.formatVersion = b[2]
+	v.numTranslations = binary.LittleEndian.Uint32(b[4:])
+	v.numNamespaces = binary.LittleEndian.Uint32(b[8:])
+	v.idsSize = binary.LittleEndian.Uint32(b[12:])
+	v.namespacesSize = binary.LittleEndian.Uint32(b[16:])
+}
+
+func (v *storeTranslationRule16) binarySize() uint32 { return size_storeTranslationRule16 }
+func (v *storeTranslationRule16) putLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRule16-1]
+	binary.LittleEndian.PutUint16(b, v.length)
+	b[2], b[3] = uint8(v.rule.op), v.rule.i0
+}
+func (v *storeTranslationRule16) fromLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRule16-1]
+	v.length = binary.LittleEndian.Uint16(b)
+	v.rule = pluralRule8{cmpOp(b[2]), b[3]}
+}
+
+func (v *storeTranslationRule32) binarySize() uint32 { return size_storeTranslationRule32 }
+func (v *storeTranslationRule32) putLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRule32-1]
+	binary.LittleEndian.PutUint32(b, v.length)
+	b[4], b[5], b[6], b[7] = uint8(v.rule.op), v.rule.i0, 0, 0
+}
+func (v *storeTranslationRule32) fromLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRule32-1]
+	v.length = binary.LittleEndian.Uint32(b)
+	v.rule = pluralRule8{cmpOp(b[4]), b[5]}
+}
+
+func (v *storeTranslationRuleWide) binarySize() uint32 { return size_storeTranslationRuleWide }
+func (v *storeTranslationRuleWide) putLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRuleWide-1]
+	binary.LittleEndian.PutUint32(b, v.length)
+	b[4], b[5], b[6], b[7] = uint8(v.rule.op), 0, 0, 0
+	binary.LittleEndian.PutUint32(b[8:], v.rule.i0)
+}
+func (v *storeTranslationRuleWide) fromLittleEndian(b []byte) {
+	_ = b[size_storeTranslationRuleWide-1]
+	v.length = binary.LittleEndian.Uint32(b)
+	v.rule = pluralRule{cmpOp(b[4]), binary.LittleEndian.Uint32(b[8:])}
+}
+
+func (v *storeTranslationRuleSlice) binarySize() uint32        { return size_storeTranslationRuleSlice }
+func (v *storeTranslationRuleSlice) putLittleEndian(b []byte)  { b[0] = v.length }
+func (v *storeTranslationRuleSlice) fromLittleEndian(b []byte) { v.length = b[0] }
+
+func (v *storeTranslationIDSize) binarySize() uint32 { return size_storeTranslationIDsSize }
+func (v *storeTranslationIDSize) putLittleEndian(b []byte) {
+	binary.LittleEndian.PutUint16(b, v.length)
+}
+func (v *storeTranslationIDSize) fromLittleEndian(b []byte) {
+	v.length = binary.LittleEndian.Uint16(b)
+}
+
+func (v *storeNamespace) binarySize() uint32 { return size_storeNamespace }
+func (v *storeNamespace) putLittleEndian(b []byte) {
+	_ = b[size_storeNamespace-1]
+	copy(b[0:3], v.length[:])
+	b[3] = v.nameSize
+}
+func (v *storeNamespace) fromLittleEndian(b []byte) {
+	_ = b[size_storeNamespace-1]
+	copy(v.length[:], b[0:3])
+	v.nameSize = b[3]
+}
+
+func (v *storeBundleHeader) binarySize() uint32 { return size_storeBundleHeader }
+func (v *storeBundleHeader) putLittleEndian(b []byte) {
+	_ = b[size_storeBundleHeader-1]
+	copy(b[0:3], v.fileType[:])
+	b[3] = v.isCompressed
+	binary.LittleEndian.PutUint32(b[4:], v.numLanguages)
+	binary.LittleEndian.PutUint32(b[8:], v.namesSize)
+}
+func (v *storeBundleHeader) fromLittleEndian(b []byte) {
+	_ = b[size_storeBundleHeader-1]
+	copy(v.fileType[:], b[0:3])
+	v.isCompressed = b[3]
+	v.numLanguages = binary.LittleEndian.Uint32(b[4:])
+	v.namesSize = binary.LittleEndian.Uint32(b[8:])
+}
+
+func (v *storeBundleFile) binarySize() uint32 { return size_storeBundleFile }
+func (v *storeBundleFile) putLittleEndian(b []byte) {
+	_ = b[size_storeBundleFile-1]
+	binary.LittleEndian.PutUint32(b, v.offset)
+	binary.LittleEndian.PutUint32(b[4:], v.size)
+}
+func (v *storeBundleFile) fromLittleEndian(b []byte) {
+	_ = b[size_storeBundleFile-1]
+	v.offset = binary.LittleEndian.Uint32(b)
+	v.size = binary.LittleEndian.Uint32(b[4:])
+}
+
+func (v *storeStandaloneHeader) binarySize() uint32 { return size_storeStandaloneHeader }
+func (v *storeStandaloneHeader) putLittleEndian(b []byte) {
+	_ = b[size_storeStandaloneHeader-1]
+	copy(b[0:3], v.fileType[:])
+	b[3] = v.numLanguages
+}
+func (v *storeStandaloneHeader) fromLittleEndian(b []byte) {
+	_ = b[size_storeStandaloneHeader-1]
+	copy(v.fileType[:], b[0:3])
+	v.numLanguages = b[3]
+}
@@ -0,0 +1,92 @@
+//Tests of the little-endian encoding of compiled file structures
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestByteOrderGoldenEncoding(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		encoded  []byte
+		expected []byte
+	}{
+		{"Rule16", encodeStruct(&storeTranslationRule16{0x0102, pluralRule8{cmpOp(5), 9}}), []byte{0x02, 0x01, 5, 9}},
+		{"Rule32", encodeStruct(&storeTranslationRule32{0x01020304, pluralRule8{cmpOp(5), 9}}), []byte{0x04, 0x03, 0x02, 0x01, 5, 9, 0, 0}},
+		{"RuleWide", encodeStruct(&storeTranslationRuleWide{0x01020304, pluralRule{cmpOp(5), 0x0A0B0C0D}}), []byte{0x04, 0x03, 0x02, 0x01, 5, 0, 0, 0, 0x0D, 0x0C, 0x0B, 0x0A}},
+		{"RuleSlice", encodeStruct(&storeTranslationRuleSlice{7}), []byte{7}},
+		{"IDSize", encodeStruct(&storeTranslationIDSize{0x0102}), []byte{0x02, 0x01}},
+		{"Namespace", encodeStruct(&storeNamespace{[3]byte{1, 2, 3}, 4}), []byte{1, 2, 3, 4}},
+		{"BundleFile", encodeStruct(&storeBundleFile{0x01020304, 0x05060708}), []byte{0x04, 0x03, 0x02, 0x01, 0x08, 0x07, 0x06, 0x05}},
+		{"BundleHeader", encodeStruct(&storeBundleHeader{[3]byte{'B', 'D', 'L'}, 1, 0x01020304, 0x05060708}), []byte{'B', 'D', 'L', 1, 0x04, 0x03, 0x02, 0x01, 0x08, 0x07, 0x06, 0x05}},
+		{"StandaloneHeader", encodeStruct(&storeStandaloneHeader{[3]byte{'S', 'T', 'A'}, 2}), []byte{'S', 'T', 'A', 2}},
+		{"DictHeader", encodeStruct(&storeDictHeader{[2]byte{'D', 'T'}, 3, 0x01020304, 0x05060708, 0x090A0B0C, 0x0D0E0F10}), []byte{'D', 'T', 3, 0, 0x04, 0x03, 0x02, 0x01, 0x08, 0x07, 0x06, 0x05, 0x0C, 0x0B, 0x0A, 0x09, 0x10, 0x0F, 0x0E, 0x0D}},
+	} {
+		if !bytes.Equal(test.encoded, test.expected) {
+			t.Errorf("%s: encoded as % X but expected % X", test.name, test.encoded, test.expected)
+		}
+	}
+
+	//The language header round trips
+	header := storeHeader{[2]byte{'L', 'G'}, 4, uint8(size_storeTranslationRuleWide), 0x01020304, 0x05060708, 0x090A0B0C, 0x0D0E0F10, [20]byte{1, 2, 3, 19: 20}}
+	encoded := encodeStruct(&header)
+	if len(encoded) != int(size_storeHeader) || encoded[3] != uint8(size_storeTranslationRuleWide) || !bytes.Equal(encoded[4:8], []byte{0x04, 0x03, 0x02, 0x01}) || encoded[39] != 20 {
+		t.Errorf("Header encoded as % X", encoded)
+	}
+	var decoded storeHeader
+	if decoded.fromLittleEndian(encoded); decoded != header {
+		t.Errorf("Header decoded as %+v but expected %+v", decoded, header)
+	}
+}
+
+// Compiled files must read the same whether their rules are read directly as memory or decoded one at a time
+func TestByteOrderDecodedLayout(t *testing.T) {
+	const head = "Settings:\n  LanguageName: English\n  LanguageIdentifier: en-US\n  MissingPluralRule: Missing\nNS:\n"
+	for _, test := range []struct {
+		name, yaml string
+		ruleSize   uint32
+	}{
+		{"Rule16", head + "  A:\n    =1: one\n    ^: many\n  B: b\n", size_storeTranslationRule16},
+		{"Rule32", head + "  A:\n    =1: one\n    ^: " + strings.Repeat("x", 70000) + "\n  B: b\n", size_storeTranslationRule32},
+		{"RuleWide", head + "  A:\n    =1: one\n    \">300\": many\n    ^: some\n  B: b\n", size_storeTranslationRuleWide},
+	} {
+		l, _, err := NewDictionary().LoadDefaultText(LF_YAML, strings.NewReader(test.yaml), true)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		var b bytes.Buffer
+		if err := l.SaveGTR(&b, false); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if header, err := ParseGTRHeader(b.Bytes()); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		} else if header.RuleSize != uint8(test.ruleSize) {
+			t.Errorf("%s: Stored with rules of %d bytes but expected %d", test.name, header.RuleSize, test.ruleSize)
+		}
+
+		//Read the file with both layouts
+		read := func(direct bool) *Language {
+			defer func(v bool) { useDirectLayout = v }(useDirectLayout)
+			useDirectLayout = direct
+			l, err := ParseGTR(b.Bytes())
+			if err != nil {
+				t.Fatalf("%s (direct=%v): %v", test.name, direct, err)
+			}
+			return l
+		}
+		decoded := read(false)
+		if !reflect.DeepEqual(decoded.rules, l.rules) || !reflect.DeepEqual(decoded.translations, l.translations) {
+			t.Errorf("%s: Decoded rules do not match the compiled language", test.name)
+		}
+		if useDirectLayout {
+			if direct := read(true); !reflect.DeepEqual(direct.rules, decoded.rules) || !reflect.DeepEqual(direct.translations, decoded.translations) {
+				t.Errorf("%s: Directly read rules do not match the decoded rules", test.name)
+			}
+		}
+	}
+}
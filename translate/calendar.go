@@ -0,0 +1,435 @@
+//Non-Gregorian calendar support for DateTime variables
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type calendarType uint8
+
+const (
+	calGregorian calendarType = iota
+	calBuddhist
+	calJapanese
+	calIslamic
+	calHebrew
+)
+
+// The names of the calendars as given in settings and DateTime specifiers (case-insensitive)
+var calendarNames = []string{"Gregorian", "Buddhist", "Japanese", "Islamic", "Hebrew"}
+
+// DateTime specifiers starting with this character begin with a calendar name (terminated by a space)
+const calendarSpecifierChar = '@'
+
+// Returns the calendar for a calendar name. A blank name returns the Gregorian calendar
+func getCalendarType(name string) (calendarType, error) {
+	if len(name) == 0 {
+		return calGregorian, nil
+	}
+	for i, calName := range calendarNames {
+		if strings.EqualFold(name, calName) {
+			return calendarType(i), nil
+		}
+	}
+	return calGregorian, errors.New("Invalid calendar: " + name + ". Valid calendars are: " + strings.Join(calendarNames, ", "))
+}
+
+// Splits the calendar from the start of a DateTime specifier. hasCalendar=false if one is not given
+func splitCalendarSpecifier(specifier string) (calendarName, format string, hasCalendar bool) {
+	if len(specifier) == 0 || specifier[0] != calendarSpecifierChar {
+		return returnBlankStrOnErr, specifier, false
+	}
+	if spaceLoc := strings.IndexByte(specifier, ' '); spaceLoc != -1 {
+		return specifier[1:spaceLoc], specifier[spaceLoc+1:], true
+	}
+	return specifier[1:], returnBlankStrOnErr, true
+}
+
+// Confirms the calendar at the start of a DateTime specifier (if given) is valid
+func checkCalendarSpecifier(specifier string) error {
+	if calendarName, _, hasCalendar := splitCalendarSpecifier(specifier); hasCalendar {
+		_, err := getCalendarType(calendarName)
+		return err
+	}
+	return nil
+}
+
+// A date in a calendar
+type calendarDate struct {
+	era        string //Only used by the Japanese calendar
+	year       int
+	month      int //1 based. For the Hebrew calendar this starts at Tishri
+	day        int
+	dayOfYear  int
+	monthName  string
+	monthShort string
+}
+
+// The time localizer’s formatting function (see lctime.Localizer)
+type strftimer interface {
+	Strftime(format string, t time.Time) string
+}
+
+//go:generate go run gen_lctimeLocales.go
+
+// The composite formats of a time localizer’s locale (see lctimeLocales). They are expanded before being passed to the time localizer, as they can contain calendar dependent specifiers, and specifiers that the time localizer does not support (like %Ey, %-d, and %k)
+type lctimeLocale struct {
+	date     string //%x
+	dateTime string //%c
+	time     string //%X
+	timeAMPM string //%r
+	hasAMPM  bool   //If %p has AM/PM designators
+}
+
+// The composite formats of locales that are not in lctimeLocales (lctime’s POSIX locale)
+var defaultLctimeLocale = lctimeLocale{"%m/%d/%y", "%a %b %e %H:%M:%S %Y", "%H:%M:%S", "%I:%M:%S %p", true}
+
+// Formats a time with strftime specifiers in the given calendar. The composite specifiers, calendar dependent specifiers, and localized time zone names are replaced before being passed to the time localizer. composites are the formats of the time localizer’s locale.
+//
+// Composite specifiers use the full year in non-Gregorian calendars, as a 2 digit year (like the “85” of the Hebrew year 5785) is ambiguous outside of the calendar it is normally used with
+func (l *Language) strftime(loc strftimer, composites lctimeLocale, cal calendarType, format string, t time.Time) string {
+	//Gregorian calendars without localized time zone names or locale composites do not need any extra processing
+	hasZoneNames := strings.Contains(format, "%EZ") || strings.Contains(format, "%OZ")
+	if cal == calGregorian && !hasZoneNames && !hasLocaleComposite(format) {
+		return loc.Strftime(format, t)
+	}
+
+	//Get the date in the calendar. If nil, the Gregorian calendar is used
+	base, _ := l.languageTag.Base()
+	var date *calendarDate
+	if cal != calGregorian {
+		date = toCalendarDate(cal, base.String(), t)
+	}
+
+	//Locales without composite formats use the defaults
+	if len(composites.date) == 0 {
+		composites = defaultLctimeLocale
+	}
+
+	//Replace the specifiers with their values
+	var out strings.Builder
+	var expand func(format string, depth int)
+	expand = func(format string, depth int) {
+		for i := 0; i < len(format); i++ {
+			if format[i] != '%' || i+1 >= len(format) {
+				out.WriteByte(format[i])
+				continue
+			}
+
+			//Handle localized time zone names
+			i++
+			if (format[i] == 'E' || format[i] == 'O') && i+1 < len(format) && format[i+1] == 'Z' {
+				out.WriteString(strings.Replace(localizedZoneName(base.String(), t, format[i] == 'O'), "%", "%%", -1))
+				i++
+				continue
+			}
+
+			//The E and O (alternative representation) modifiers are dropped as the calendar handles the representation. The “-” flag removes the padding of numbers
+			noPad := format[i] == '-'
+			if (format[i] == 'E' || format[i] == 'O' || noPad) && i+1 < len(format) {
+				i++
+			}
+			padNum := func(padFormat string, n int) string {
+				return cond(noPad, strconv.Itoa(n), fmt.Sprintf(padFormat, n))
+			}
+
+			//Handle the specifiers that do not depend on the calendar
+			var val string
+			var handled bool
+			switch format[i] {
+			case 'c', 'x', 'X', 'r', 'D', 'F', 'T', 'R':
+				//Composites can contain other composites (like a %r in a %c), which are only expanded a few levels deep so they cannot loop
+				if depth > 2 {
+					out.WriteByte('%')
+					out.WriteByte(format[i])
+					continue
+				}
+				switch format[i] {
+				case 'c':
+					expand(composites.dateTime, depth+1)
+				case 'x':
+					expand(composites.date, depth+1)
+				case 'X':
+					expand(composites.time, depth+1)
+				case 'r':
+					expand(composites.timeAMPM, depth+1)
+				case 'D':
+					expand("%m/%d/%y", depth+1)
+				case 'F':
+					expand("%Y-%m-%d", depth+1)
+				case 'T':
+					expand("%H:%M:%S", depth+1)
+				case 'R':
+					expand("%H:%M", depth+1)
+				}
+				continue
+			case 'k':
+				val, handled = padNum("%2d", t.Hour()), true
+			case 'l':
+				val, handled = padNum("%2d", (t.Hour()+11)%12+1), true
+			case 'P':
+				val, handled = strings.ToLower(loc.Strftime("%p", t)), true
+			}
+
+			//Handle the calendar dependent specifiers. The rest of the specifiers are left for the time localizer
+			if !handled && date != nil {
+				handled = true
+				switch format[i] {
+				case 'Y', 'G':
+					val = date.era + strconv.Itoa(date.year)
+				case 'y', 'g':
+					val = cond(depth > 0, date.era+strconv.Itoa(date.year), fmt.Sprintf("%02d", date.year%100))
+				case 'C':
+					val = cond(len(date.era) != 0, date.era, strconv.Itoa(date.year/100))
+				case 'b', 'h', 'B':
+					//Calendars that share the Gregorian months let the time localizer handle month names
+					val, handled = cond(format[i] == 'B', date.monthName, date.monthShort), len(date.monthName) != 0
+				case 'm':
+					val = padNum("%02d", date.month)
+				case 'd':
+					val = padNum("%02d", date.day)
+				case 'e':
+					val = padNum("%2d", date.day)
+				case 'j':
+					val = padNum("%03d", date.dayOfYear)
+				default:
+					handled = false
+				}
+			}
+			if !handled {
+				if !noPad {
+					out.WriteByte('%')
+					out.WriteByte(format[i])
+					continue
+				}
+
+				//The time localizer does not support the “-” flag, so its padding is removed from its value
+				if val = strings.TrimLeft(loc.Strftime("%"+format[i:i+1], t), "0 "); len(val) == 0 {
+					val = "0"
+				}
+			}
+			out.WriteString(strings.Replace(val, "%", "%%", -1))
+		}
+	}
+	expand(format, 0)
+
+	return loc.Strftime(out.String(), t)
+}
+
+// Returns if a strftime format has a specifier that uses a locale’s composite format (%c %x %X %r)
+func hasLocaleComposite(format string) bool {
+	for i := 0; i+1 < len(format); i++ {
+		if format[i] == '%' {
+			i++
+			if strings.IndexByte("cxXr", format[i]) != -1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Converts a time to a date in the given calendar. Returns nil if the date cannot be represented in the calendar
+func toCalendarDate(cal calendarType, baseLanguage string, t time.Time) *calendarDate {
+	year, month, day := t.Date()
+	switch cal {
+	case calBuddhist:
+		return &calendarDate{"", year + 543, int(month), day, t.YearDay(), returnBlankStrOnErr, returnBlankStrOnErr}
+	case calJapanese:
+		return toJapaneseDate(baseLanguage, t)
+	case calIslamic:
+		return toIslamicDate(baseLanguage, fixedFromGregorian(year, int(month), day))
+	case calHebrew:
+		return toHebrewDate(baseLanguage, fixedFromGregorian(year, int(month), day))
+	default:
+		return nil
+	}
+}
+
+// Returns the number of days since the start of the (proleptic) Gregorian calendar. Day 1 is January 1, year 1
+func fixedFromGregorian(year, month, day int) int {
+	y := year - 1
+	fixed := 365*y + floorDiv(y, 4) - floorDiv(y, 100) + floorDiv(y, 400) + floorDiv(367*month-362, 12) + day
+	if month > 2 {
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			fixed--
+		} else {
+			fixed -= 2
+		}
+	}
+	return fixed
+}
+
+// Division that rounds towards negative infinity
+func floorDiv(a, b int) int {
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		return a/b - 1
+	}
+	return a / b
+}
+
+// Fills in the month names, preferring the localized names if the base language has them
+func (d *calendarDate) setMonthNames(baseLanguage string, localizedNames map[string][]string, defaultNames []string, index int) *calendarDate {
+	names := defaultNames
+	if _names, ok := localizedNames[baseLanguage]; ok {
+		names = _names
+	}
+	d.monthName, d.monthShort = names[index], names[index]
+	return d
+}
+
+//----------------------------------Japanese------------------------------------
+
+var japaneseEras = []struct {
+	start          time.Time
+	name, nameJapn string
+}{
+	{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "Reiwa ", "令和"},
+	{time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), "Heisei ", "平成"},
+	{time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), "Shōwa ", "昭和"},
+	{time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC), "Taishō ", "大正"},
+	{time.Date(1868, 9, 8, 0, 0, 0, 0, time.UTC), "Meiji ", "明治"},
+}
+
+func toJapaneseDate(baseLanguage string, t time.Time) *calendarDate {
+	year, month, day := t.Date()
+	dateOnly := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	for _, era := range japaneseEras {
+		if !dateOnly.Before(era.start) {
+			return &calendarDate{
+				cond(baseLanguage == "ja", era.nameJapn, era.name),
+				year - era.start.Year() + 1, int(month), day, t.YearDay(), returnBlankStrOnErr, returnBlankStrOnErr,
+			}
+		}
+	}
+
+	//Dates before the Meiji era are left as Gregorian
+	return nil
+}
+
+//-----------------------------------Islamic------------------------------------
+
+var (
+	islamicMonthNames          = []string{"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II", "Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah"}
+	islamicMonthNamesLocalized = map[string][]string{
+		"ar": {"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة", "رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"},
+	}
+)
+
+const islamicEpoch = 227015 //July 16, 622 (Julian)
+
+// Uses the tabular (civil) Islamic calendar
+func fixedFromIslamic(year, month, day int) int {
+	return day + 29*(month-1) + floorDiv(6*month-1, 11) + (year-1)*354 + floorDiv(3+11*year, 30) + islamicEpoch - 1
+}
+
+func toIslamicDate(baseLanguage string, fixed int) *calendarDate {
+	if fixed < islamicEpoch {
+		return nil
+	}
+	year := floorDiv(30*(fixed-islamicEpoch)+10646, 10631)
+	month := floorDiv(11*(fixed-fixedFromIslamic(year, 1, 1))+330, 325)
+	if month > 12 {
+		month = 12
+	}
+	day := fixed - fixedFromIslamic(year, month, 1) + 1
+	d := &calendarDate{"", year, month, day, fixed - fixedFromIslamic(year, 1, 1) + 1, returnBlankStrOnErr, returnBlankStrOnErr}
+	return d.setMonthNames(baseLanguage, islamicMonthNamesLocalized, islamicMonthNames, month-1)
+}
+
+//------------------------------------Hebrew------------------------------------
+
+// Hebrew month names are indexed with Nisan as 1 (index 0 is unused). Index 12 is “Adar I” in leap years
+var (
+	hebrewMonthNames          = []string{"", "Nisan", "Iyar", "Sivan", "Tamuz", "Av", "Elul", "Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II", "Adar I"}
+	hebrewMonthNamesLocalized = map[string][]string{
+		"he": {"", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול", "תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר", "אדר ב׳", "אדר א׳"},
+	}
+)
+
+const (
+	hebrewEpoch  = -1373427 //Tishri 1, 1 AM
+	hebrewNisan  = 1
+	hebrewTishri = 7
+)
+
+func hebrewIsLeapYear(year int) bool {
+	return (7*year+1)%19 < 7
+}
+func hebrewLastMonth(year int) int {
+	return cond(hebrewIsLeapYear(year), 13, 12)
+}
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	day := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	return cond((3*(day+1))%7 < 3, day+1, day)
+}
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	if ny2-ny1 == 356 {
+		correction = 2
+	} else if ny1-ny0 == 382 {
+		correction = 1
+	}
+	return hebrewEpoch + ny1 + correction
+}
+func hebrewLastDayOfMonth(month, year int) int {
+	daysInYear := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13,
+		month == 12 && !hebrewIsLeapYear(year),
+		month == 8 && daysInYear != 355 && daysInYear != 385,
+		month == 9 && (daysInYear == 353 || daysInYear == 383):
+		return 29
+	default:
+		return 30
+	}
+}
+func fixedFromHebrew(year, month, day int) int {
+	fixed := hebrewNewYear(year) + day - 1
+	if month < hebrewTishri {
+		for m := hebrewTishri; m <= hebrewLastMonth(year); m++ {
+			fixed += hebrewLastDayOfMonth(m, year)
+		}
+		for m := hebrewNisan; m < month; m++ {
+			fixed += hebrewLastDayOfMonth(m, year)
+		}
+	} else {
+		for m := hebrewTishri; m < month; m++ {
+			fixed += hebrewLastDayOfMonth(m, year)
+		}
+	}
+	return fixed
+}
+
+func toHebrewDate(baseLanguage string, fixed int) *calendarDate {
+	if fixed < hebrewEpoch {
+		return nil
+	}
+
+	//Find the year
+	year := int(float64(fixed-hebrewEpoch)/(35975351.0/98496.0)) //Approximation that can be 1 year too small
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+
+	//Find the month and day
+	month := cond(fixed < fixedFromHebrew(year, hebrewNisan, 1), hebrewTishri, hebrewNisan)
+	for fixed > fixedFromHebrew(year, month, hebrewLastDayOfMonth(month, year)) {
+		month++
+	}
+	day := fixed - fixedFromHebrew(year, month, 1) + 1
+
+	//Months are numbered starting from Tishri
+	monthFromTishri := cond(month >= hebrewTishri, month-hebrewTishri+1, month+hebrewLastMonth(year)-hebrewTishri+1)
+	nameIndex := cond(month == 12 && hebrewIsLeapYear(year), 14, month)
+	d := &calendarDate{"", year, monthFromTishri, day, fixed - hebrewNewYear(year) + 1, returnBlankStrOnErr, returnBlankStrOnErr}
+	return d.setMonthNames(baseLanguage, hebrewMonthNamesLocalized, hebrewMonthNames, nameIndex)
+}
@@ -0,0 +1,69 @@
+//Tests of the composite DateTime specifiers in each calendar
+//go:build !gol10n_read_compiled_only && !gol10n_minimal
+
+package translate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns a translation of the time with the given DateTime specifiers, separated by “|”
+func renderCalendarTest(t *testing.T, languageIdentifier string, specifiers []string, tm time.Time) string {
+	vars := make([]string, len(specifiers))
+	for i, specifier := range specifiers {
+		vars[i] = "{{.T!" + specifier + "}}"
+	}
+	text := "Settings:\n  LanguageName: Test\n  LanguageIdentifier: " + languageIdentifier + "\n  MissingPluralRule: M\nNS:\n  Date:\n    T: DateTime\n    ^: \"" + strings.Join(vars, "|") + "\"\n"
+	l, _, err := ParseTranslationText([]byte(text))
+	if err != nil {
+		t.Fatalf("%s: %v", languageIdentifier, err)
+	}
+	str, err := l.Get(l.dict.namespaces["NS"].ids["Date"], tm)
+	if err != nil {
+		t.Fatalf("%s: %v", languageIdentifier, err)
+	}
+	return str
+}
+
+func TestCalendarCompositeFormats(t *testing.T) {
+	//Composites use the full year of non-Gregorian calendars, including where the locale’s format has a 2 digit year (like th-TH’s %Ey and he-IL’s %y)
+	tm := time.Date(2024, 10, 3, 14, 5, 6, 0, time.UTC)
+	for lang, expected := range map[string][]string{
+		"th-TH": {"พฤ.  3 ต.ค. 24, 14:05:06", "03/10/24", "พฤ.  3 ต.ค. 2567, 14:05:06", "03/10/2567", "พฤ.  3 ต.ค. Reiwa 6, 14:05:06", "03/10/Reiwa 6", "พฤ. 29 Rabiʻ I 1446, 14:05:06", "29/03/1446", "พฤ.  1 Tishri 5785, 14:05:06", "01/01/5785"},
+		"he-IL": {"UTC 14:05:06 2024 אוק 03 ה'", "03/10/24", "UTC 14:05:06 2567 אוק 03 ה'", "03/10/2567", "UTC 14:05:06 Reiwa 6 אוק 03 ה'", "03/10/Reiwa 6", "UTC 14:05:06 1446 Rabiʻ I 29 ה'", "29/03/1446", "UTC 14:05:06 5785 תשרי 01 ה'", "01/01/5785"},
+		"ar-SA": {"الخميس  3 تشرين الأول 2024 14:05:06", "الخميس  3 تشرين الأول 2024", "الخميس  3 تشرين الأول 2567 14:05:06", "الخميس  3 تشرين الأول 2567", "الخميس  3 تشرين الأول Reiwa 6 14:05:06", "الخميس  3 تشرين الأول Reiwa 6", "الخميس 29 ربيع الأول 1446 14:05:06", "الخميس 29 ربيع الأول 1446", "الخميس  1 Tishri 5785 14:05:06", "الخميس  1 Tishri 5785"},
+		"fr-FR": {"jeu. 03 oct. 2024 14:05:06 UTC", "03/10/2024", "jeu. 03 oct. 2567 14:05:06 UTC", "03/10/2567", "jeu. 03 oct. Reiwa 6 14:05:06 UTC", "03/10/Reiwa 6", "jeu. 29 Rabiʻ I 1446 14:05:06 UTC", "29/03/1446", "jeu. 01 Tishri 5785 14:05:06 UTC", "01/01/5785"},
+		"ja-JP": {"2024年10月03日 14時05分06秒", "2024年10月03日", "2567年10月03日 14時05分06秒", "2567年10月03日", "令和6年10月03日 14時05分06秒", "令和6年10月03日", "1446年03月29日 14時05分06秒", "1446年03月29日", "5785年01月01日 14時05分06秒", "5785年01月01日"},
+	} {
+		var specifiers []string
+		for _, calendarName := range calendarNames {
+			specifiers = append(specifiers, "@"+calendarName+" %c", "@"+calendarName+" %x")
+		}
+		got := strings.Split(renderCalendarTest(t, lang, specifiers, tm), "|")
+		for i, specifier := range specifiers {
+			if got[i] != expected[i] {
+				t.Errorf("%s %s = %q; expected %q", lang, specifier, got[i], expected[i])
+			}
+		}
+	}
+}
+
+func TestLocaleCompositeSpecifiers(t *testing.T) {
+	//Locale composites with specifiers the time localizer does not support (%-e, %k, %l, %P)
+	tm := time.Date(2024, 10, 3, 14, 5, 6, 0, time.UTC)
+	for _, test := range []struct {
+		lang       string
+		specifiers []string
+		expected   string
+	}{
+		{"en-GB", []string{"%r", "%X"}, " 2:05:06 pm UTC|14:05:06"},
+		{"bg-BG", []string{"%c", "@Hebrew %c"}, " 3.10.2024 (чт) 14,05,06 UTC| 1.01.5785 (чт) 14,05,06 UTC"},
+		{"nr-ZA", []string{"%c", "@Hebrew %c"}, "Ne 3 Okt 2024 14:05:06 UTC|Ne 1 Tishri 5785 14:05:06 UTC"},
+	} {
+		if got := renderCalendarTest(t, test.lang, test.specifiers, tm); got != test.expected {
+			t.Errorf("%s %q = %q; expected %q", test.lang, test.specifiers, got, test.expected)
+		}
+	}
+}
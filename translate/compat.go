@@ -20,6 +20,23 @@ func max[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 |
 	return maxV
 }
 
+func min[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64](args ...T) T {
+	if len(args) == 0 {
+		return 0
+	} else if len(args) == 1 {
+		return args[0]
+	}
+
+	minV := args[0]
+	for _, v := range args[1:] {
+		if v < minV {
+			minV = v
+		}
+	}
+
+	return minV
+}
+
 // Find if a list contains a value
 func arrayIn[T comparable](list []T, val T) bool {
 	for _, v := range list {
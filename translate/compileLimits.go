@@ -0,0 +1,91 @@
+//Limits on the resources used while compiling translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"unsafe"
+)
+
+// CompileLimitError is returned when compiling a translation text file exceeds CompileLimits.MaxMemory
+type CompileLimitError struct {
+	MaxMemory     uint64 //The limit that was exceeded
+	TranslationID string //The “Namespace.TranslationID” whose compile exceeded the limit
+}
+
+func (e *CompileLimitError) Error() string {
+	return fmt.Sprintf("%s: Compiled translations exceed the memory limit (%d bytes)", e.TranslationID, e.MaxMemory)
+}
+
+// SetCompileLimits sets the limits used when compiling translation text files into or against the dictionary (through Dictionary.LoadText(), Dictionary.LoadDefaultText(), and Builder). This must be called before the dictionary is used by other goroutines
+func (d *Dictionary) SetCompileLimits(limits CompileLimits) {
+	d.compileLimits = limits
+}
+
+// SetCompileLimits sets the limits used when compiling translation text files through the package level load functions (LanguageTextFile.Load(), LanguageTextFile.LoadDefault(), and LanguageTextFile.RecompileNamespaces()). See Dictionary.SetCompileLimits()
+func (ll LanguageFile) SetCompileLimits(limits CompileLimits) {
+	defaultDictionary.SetCompileLimits(limits)
+}
+
+// Tracks the resources used while compiling a translation text file
+type compileLimiter struct {
+	maxMemory              uint64
+	maxNamespaceGoroutines uint          //Stored in the dictionary created by the compile (see CompileLimits.MaxNamespaceGoroutines)
+	semaphore              chan struct{} //Holds a value for each Translation ID being compiled
+	memoryUsed             atomic.Uint64
+	exceeded               atomic.Pointer[CompileLimitError]
+}
+
+func newCompileLimiter(limits CompileLimits) *compileLimiter {
+	return &compileLimiter{maxMemory: limits.MaxMemory, maxNamespaceGoroutines: limits.MaxNamespaceGoroutines, semaphore: make(chan struct{}, maxGoroutinesOrDefault(limits.MaxGoroutines))}
+}
+
+// Returns the most goroutines to run at once, which is runtime.GOMAXPROCS(0) if not given
+func maxGoroutinesOrDefault(maxGoroutines uint) uint {
+	if maxGoroutines == 0 {
+		return uint(runtime.GOMAXPROCS(0))
+	}
+	return maxGoroutines
+}
+
+// Waits until another Translation ID can be compiled
+func (cl *compileLimiter) acquire() {
+	cl.semaphore <- struct{}{}
+}
+
+// Marks a Translation ID as done compiling
+func (cl *compileLimiter) release() {
+	<-cl.semaphore
+}
+
+// Adds a compiled translation’s strings and rules to the memory used. Returns false if the memory limit is exceeded
+func (cl *compileLimiter) addTranslation(translationID string, strs [][]byte, numRules int) bool {
+	if cl.maxMemory == 0 {
+		return true
+	}
+	numBytes := uint64(numRules) * uint64(unsafe.Sizeof(translationRule{}))
+	for _, str := range strs {
+		numBytes += uint64(len(str))
+	}
+	if cl.memoryUsed.Add(numBytes) <= cl.maxMemory {
+		return true
+	}
+	cl.exceeded.CompareAndSwap(nil, &CompileLimitError{cl.maxMemory, translationID})
+	return false
+}
+
+// Returns if the memory limit was exceeded
+func (cl *compileLimiter) hasExceeded() bool {
+	return cl.exceeded.Load() != nil
+}
+
+// Returns the error if the memory limit was exceeded
+func (cl *compileLimiter) err() error {
+	if e := cl.exceeded.Load(); e != nil {
+		return e
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+//Compile the registers (like formal and informal) of translations
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The maximum number of registers a language can have (the register number is stored in the top 5 bits of a marker rule’s op)
+const maxRegisters = 32
+
+// Parses the comma separated Settings.Registers. The first register is the default register
+func getRegisters(registersStr string) ([]string, error) {
+	registers := strings.Split(registersStr, ",")
+	for i, name := range registers {
+		name = strings.TrimSpace(name)
+		if !regexMatchVariableName.MatchString(name) {
+			return nil, fmt.Errorf("Register “%s” must only contain letters, digits, and underscores", name)
+		}
+		for _, prevName := range registers[0:i] {
+			if prevName == name {
+				return nil, fmt.Errorf("Register “%s” is given more than once", name)
+			}
+		}
+		registers[i] = name
+	}
+	if len(registers) > maxRegisters {
+		return nil, fmt.Errorf("Cannot have more than %d registers", maxRegisters)
+	}
+	return registers, nil
+}
+
+// Splits a register’s rule property name (“@Register” followed by an optional rule, which defaults to “^”) into the rule and the register number
+func splitRegisterRuleName(propName string, registers []string) (ruleName string, register uint8, err error) {
+	registerName, ruleName := propName[1:], "^"
+	if ruleLoc := strings.IndexAny(registerName, "^=<>~"); ruleLoc != -1 {
+		registerName, ruleName = strings.TrimSpace(registerName[0:ruleLoc]), registerName[ruleLoc:]
+	}
+
+	for i, name := range registers {
+		switch {
+		case name != registerName:
+		case i == 0:
+			return "", 0, fmt.Errorf("“%s” is the default register, whose rules are given without a register", registerName)
+		default:
+			return ruleName, uint8(i), nil
+		}
+	}
+	if len(registers) == 0 {
+		return "", 0, errors.New("Registers must be declared in Settings.Registers")
+	}
+	return "", 0, fmt.Errorf("Register “%s” is not in Settings.Registers", registerName)
+}
+
+// Reorders the compiled rules of a translation so the default register’s rules are first, followed by each register’s rules behind its marker rule (in register order). Returns false if there are register rules but no default register rules
+func groupRegisterRules(strs *[][]byte, rules *[]pluralRule, ruleRegisters []uint8) (hasDefaultRules bool) {
+	//If there are no register rules then there is nothing to do
+	maxRegister := uint8(0)
+	for _, register := range ruleRegisters {
+		maxRegister = max(maxRegister, register)
+	}
+	if maxRegister == 0 {
+		return true
+	}
+
+	//Add the rules in register order
+	newStrs, newRules := make([][]byte, 0, len(*strs)+int(maxRegister)), make([]pluralRule, 0, len(*rules)+int(maxRegister))
+	for register := uint8(0); register <= maxRegister; register++ {
+		isFirst := true
+		for i, ruleRegister := range ruleRegisters {
+			if ruleRegister != register {
+				continue
+			}
+			if isFirst && register != 0 {
+				newStrs, newRules = append(newStrs, nil), append(newRules, newRegisterMarker(register))
+			}
+			isFirst = false
+			newStrs, newRules = append(newStrs, (*strs)[i]), append(newRules, (*rules)[i])
+		}
+		if register == 0 {
+			hasDefaultRules = !isFirst
+		}
+	}
+
+	*strs, *rules = newStrs, newRules
+	return
+}
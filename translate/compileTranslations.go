@@ -5,13 +5,14 @@ package translate
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"regexp"
 	"strconv"
 	"strings"
-	"unicode"
-	"unicode/utf8"
+	"sync"
 )
 
 // These are filled in on first use
@@ -19,20 +20,18 @@ var variableTypeMap map[string]variableType
 var variableTypeMapReverse []string
 var regexMatchVariableName, regexReplaceVariables, regexVariableFlags, regexSpecialCharacters, regexMatchEmbeddedStaticVariable *regexp.Regexp
 
-// Note: Initializing this is not concurrency safe
-func initTextProcessing() {
-	if variableTypeMap != nil {
-		return
-	}
+var initTextProcessingOnce sync.Once
 
+// Initializes the variable type maps and regular expressions. This is concurrency safe
+func initTextProcessing() {
+	initTextProcessingOnce.Do(initTextProcessingReal)
+}
+func initTextProcessingReal() {
 	//Fill in variable type maps
-	variableTypeMapValues := []variableType{vtAnything, vtString, vtInteger, vtBinary, vtOctal, vtHexLower, vtHexUpper, vtScientific, vtFloating, vtBool, vtDateTime, vtCurrency, vtIntegerWithSymbols, vtFloatWithSymbols, vtStaticTranslation, vtVariableTranslation}
-	variableTypeMapNames := []string{"Anything", "String", "Integer", "Binary", "Octal", "HexLower", "HexUpper", "Scientific", "Floating", "Bool", "DateTime", "Currency", "IntegerWithSymbols", "FloatWithSymbols", "StaticTranslation", "VariableTranslation"}
-	variableTypeMap = make(map[string]variableType, len(variableTypeMapValues))
-	variableTypeMapReverse = make([]string, len(variableTypeMapValues))
-	for i, v := range variableTypeMapValues {
-		variableTypeMap[strings.ToUpper(variableTypeMapNames[i])] = v
-		variableTypeMapReverse[v] = variableTypeMapNames[i]
+	variableTypeMap = make(map[string]variableType, len(variableTypeNames))
+	variableTypeMapReverse = variableTypeNames[:]
+	for v, name := range variableTypeNames {
+		variableTypeMap[strings.ToUpper(name)] = variableType(v)
 	}
 
 	//Regular expressions
@@ -40,11 +39,14 @@ func initTextProcessing() {
 	regexReplaceVariables = regexp.MustCompile(`\{\{\.\s*([\pL\pN_]+)\s*(?:\|\s*(.*?))?\s*(?:!\s*(.*?))?\s*}}`)
 	regexVariableFlags = regexp.MustCompile(`^(-?)\s*(0?)\s*(\d{0,8})\s*(?:\.\s*(\d{1,8}))?\s*$`)
 	//goland:noinspection SpellCheckingInspection
-	regexSpecialCharacters = regexp.MustCompile(`(?i)\\(?:[abfnrtv\\]|x[0-9a-f]{2}|u[0-9a-f]{2,6})`)
+	regexSpecialCharacters = regexp.MustCompile(`\\(?:N\{[^}\n]*}|(?i:[abfnrtv\\]|x[0-9a-f]{2}|u[0-9a-f]{2,6})|.)`)
 	regexMatchEmbeddedStaticVariable = regexp.MustCompile(`\{\{\*\s*([\pL\pN_]+)\s*(?:\.\s*([\pL\pN_]+))?\s*}}`)
 }
 
-func addTranslationIDFromTextFile(props []string, namespaceName string, dict *languageDict, vars *translationIDNameAndVars, allowBigStrings bool) (errors []string, warnings []string, retStrings [][]byte, retPluralRules []pluralRule, retEmbeddedTIDs []TransIndex) {
+// The name of the variable that holds the plural count
+const pluralCountName = "PluralCount"
+
+func addTranslationIDFromTextFile(props []string, namespaceName string, dict *languageDict, vars *translationIDNameAndVars, escapes *escapePolicy, dateTimes *dateTimeSpecifierPolicy, hasSpellout bool, registers []string, allowBigStrings bool) (errors []string, warnings []string, retStrings [][]byte, retPluralRules []pluralRule, retEmbeddedTIDs []TransIndex) {
 	//Handle errors and warnings
 	addErrStr := func(err string, args ...interface{}) {
 		if len(args) != 0 {
@@ -63,34 +65,50 @@ func addTranslationIDFromTextFile(props []string, namespaceName string, dict *la
 		myType variableType
 	}
 	varProps := map[string]varProp{
-		"PluralCount": {0, vtIntegerWithSymbols}, //PluralCount is always variable #0
+		pluralCountName: {0, vtIntegerWithSymbols}, //PluralCount is always variable #0
 	}
 
 	//Plural Rules
 	type tempPluralRule struct {
-		value string
-		rule  pluralRule
+		value    string
+		rule     pluralRule
+		register uint8 //0 is the default register
 	}
 	myRules := make([]tempPluralRule, 0, 1)
 
 	{
 		//Process the properties
 		isDefaultLanguage := vars.vars == nil
-		tooManyPlural, numVars := false, 0 //Only show overflow errors once
+		tooManyPlural, numVars, hasPluralCountType := false, 0, false //Only show overflow errors once
 		for i := 0; i < len(props); i += 2 {
 			//Properties are stored in tuples
 			propName, propVal := props[i], props[i+1]
+			if len(propName) == 0 {
+				addErrStr("Property names cannot be blank")
+				continue
+			}
 
 			//Properties are determined by their first character
 			switch propName[0] {
 			//Ignore \ properties
 			case '\\':
 
-			//Parse as operator
-			case '^', '=', '<', '>', '~':
+			//Parse as operator (optionally for a register)
+			case '^', '=', '<', '>', '~', '@':
+				//Get the register
+				ruleName, register := propName, uint8(0)
+				if propName[0] == '@' {
+					if _ruleName, _register, err := splitRegisterRuleName(propName, registers); err != nil {
+						addErrStr("“%s”: %s", propName, err)
+						continue
+					} else {
+						ruleName, register = _ruleName, _register
+					}
+				}
+
 				//Get the rule
 				var rule pluralRule
-				if _rule, err := createPluralRule(propName); err != nil {
+				if _rule, err := createPluralRule(ruleName); err != nil {
 					addErrStr("“%s”: %s", propName, err)
 					continue
 				} else {
@@ -107,9 +125,22 @@ func addTranslationIDFromTextFile(props []string, namespaceName string, dict *la
 				}
 
 				//Store the rule for processing
-				myRules = append(myRules, tempPluralRule{propVal, rule})
+				myRules = append(myRules, tempPluralRule{propVal, rule, register})
 			//Parse as variable
 			default:
+				//PluralCount can have its type changed (to another integer type) for the Translation ID
+				if propName == pluralCountName {
+					if _varType, ok := variableTypeMap[strings.ToUpper(propVal)]; !ok || !pluralCountTypes[_varType] {
+						addErrStr("“%s” has an invalid variable type “%s”. It must be an integer type", propName, propVal)
+					} else if hasPluralCountType {
+						addErrStr("“%s” was declared more than once", propName)
+					} else {
+						varProps[propName] = varProp{0, _varType}
+						hasPluralCountType = true
+					}
+					continue
+				}
+
 				//Confirm there are not too many variables
 				numVars++
 				if numVars == 256 {
@@ -146,200 +177,195 @@ func addTranslationIDFromTextFile(props []string, namespaceName string, dict *la
 				if isDefaultLanguage {
 					vars.vars = append(vars.vars, translationIDVar{propName, varType})
 				} else if varIndex > len(vars.vars) {
-					addWarnStr("Variable #%d does not exist in the default language", varIndex)
+					addWarnStr("%s Variable #%d does not exist in the default language", WC_VariableMismatch, varIndex)
 				} else if defaultVar := vars.vars[varIndex-1]; defaultVar.name != propName || defaultVar.varType != varType {
-					addWarnStr("Variable #%d does not match the default language", varIndex)
+					addWarnStr("%s Variable #%d does not match the default language", WC_VariableMismatch, varIndex)
 				}
 			}
 		}
 
 		//Issue a warning if the number of variables does not match the default language
 		if !isDefaultLanguage && numVars != len(vars.vars) {
-			addWarnStr("Number of variables (%d) does not match the default language (%d)", numVars, len(vars.vars))
+			addWarnStr("%s Number of variables (%d) does not match the default language (%d)", WC_VariableMismatch, numVars, len(vars.vars))
 		}
 	}
 
-	//Process the found plural rules
-	for ruleNum, ruleVal := range myRules {
-		//Replace {{.VarName}} variables with binary format
-		var ruleErrors []string
-		varNum := 0
-		finalStr := regexReplaceVariables.ReplaceAllFunc(s2b(ruleVal.value), func(varVal []byte) []byte {
-			//Pull the regex group indexes
-			parts := regexReplaceVariables.FindSubmatchIndex(varVal)
-			varName := b2s(varVal[parts[2]:parts[3]])
-			var varFlags []byte
-			if parts[4] != -1 {
-				varFlags = varVal[parts[4]:parts[5]]
-			}
-
-			//Add an error to return
-			varNum++
-			addRuleErrStr := func(err string, args ...interface{}) {
-				ruleErrors = append(ruleErrors, fmt.Sprintf("Var #%d “%s”: "+err, append([]interface{}{varNum, varName}, args...)...))
-			}
-
-			//Get the variable info
-			var varInfo varProp
-			if _varInfo, ok := varProps[varName]; !ok {
-				addRuleErrStr("Unknown variable found")
-			} else {
-				varInfo = _varInfo
+	//Issue a warning if the language cannot spell out numbers, as its Spellout and Ordinal variables are written as plain numbers
+	if !hasSpellout {
+		for _, varName := range sortedKeys(varProps) {
+			if varType := varProps[varName].myType; varType == vtSpellout || varType == vtOrdinal {
+				addWarnStr("“%s”: The language has no %s rules, so the number is written as an IntegerWithSymbols", varName, variableTypeNames[varType])
 			}
+		}
+	}
 
-			//Get the flags
-			flags := regexVariableFlags.FindSubmatchIndex(varFlags)
-			if flags == nil {
-				addRuleErrStr("Flags “%s” are invalid", varFlags)
-				flags = []int{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1}
-			}
-			hasPadRight := flags[2] != flags[3]
-			hasPad0 := flags[4] != flags[5]
-			var optWidth, optPrecision string
-			if flags[6] != flags[7] {
-				optWidth = b2s(varFlags[flags[6]:flags[7]])
-			}
-			if flags[8] != flags[9] {
-				optPrecision = b2s(varFlags[flags[8]:flags[9]])
-			}
+	//Find the Template variable (see vtTemplate), which must be the only variable
+	templateVarIndex := -1
+	for varName, v := range varProps {
+		if v.myType != vtTemplate {
+			continue
+		} else if len(varProps) != 2 {
+			addErrStr("“%s”: A Template variable must be the only variable of the Translation ID", varName)
+		} else {
+			templateVarIndex = int(v.index)
+		}
+	}
 
-			//Build the output byte struct
-			outputRet := make([]byte, 3, 5)
-			flagsByte := uint8(varInfo.myType)
-			outputRet[0] = varReplacementChar
-			outputRet[1] = varInfo.index
+	//Process the found plural rules
+	for ruleNum, ruleVal := range myRules {
+		//Rules of Translation IDs with a Template variable are text/templates
+		var ruleErrors []string
+		var finalStr []byte
+		if templateVarIndex != -1 {
+			finalStr = compileTemplateRule(s2b(ruleVal.value), uint8(templateVarIndex), escapes, &ruleErrors)
+		} else {
+			//Replace {{.VarName}} variables with binary format
+			varNum := 0
+			finalStr = regexReplaceVariables.ReplaceAllFunc(s2b(ruleVal.value), func(varVal []byte) []byte {
+				//Pull the regex group indexes
+				parts := regexReplaceVariables.FindSubmatchIndex(varVal)
+				varName := b2s(varVal[parts[2]:parts[3]])
+				var varFlags []byte
+				if parts[4] != -1 {
+					varFlags = varVal[parts[4]:parts[5]]
+				}
 
-			//Process the flags
-			if hasPadRight {
-				flagsByte |= fmtPadRight
-			}
-			if hasPad0 {
-				flagsByte |= fmtPad0
-			}
-			checkWidth := func(val, varName string, flagByte uint8) {
-				if len(val) == 0 {
-					return
+				//Add an error to return
+				varNum++
+				addRuleErrStr := func(err string, args ...interface{}) {
+					ruleErrors = append(ruleErrors, fmt.Sprintf("Var #%d “%s”: "+err, append([]interface{}{varNum, varName}, args...)...))
 				}
 
-				if width, err := strconv.Atoi(val); err != nil {
-					addRuleErrStr("Has an invalid %s: %s", varName, val)
-				} else if width > 255 {
-					addRuleErrStr("The %s (%d) cannot be greater than 255", varName, width)
+				//Get the variable info
+				var varInfo varProp
+				if _varInfo, ok := varProps[varName]; !ok {
+					addRuleErrStr("Unknown variable found")
 				} else {
-					flagsByte |= flagByte
-					outputRet = append(outputRet, byte(width))
+					varInfo = _varInfo
 				}
-			}
-			checkWidth(optWidth, "width", fmtHasWidth)
-			checkWidth(optPrecision, "precision", fmtHasPrecision)
-			outputRet[2] = flagsByte
-
-			//For DateTimes, save the specifier after the colon
-			if varInfo.myType == vtDateTime {
-				//Confirm the specifier
-				if parts[6] == parts[7] {
-					addRuleErrStr("This variable type (%s) requires a specifier (a value after an exclamation mark)", variableTypeMapReverse[varInfo.myType])
-				} else if parts[7]-parts[6] > 255 {
-					addRuleErrStr("This variable type (%s) specifier cannot be more than 255 bytes", variableTypeMapReverse[varInfo.myType])
-				} else {
-					//Write the specifier length and string
-					outputRet = append(outputRet, byte(parts[7]-parts[6]))
-					outputRet = append(outputRet, varVal[parts[6]:parts[7]]...)
+
+				//Get the flags
+				flags := regexVariableFlags.FindSubmatchIndex(varFlags)
+				if flags == nil {
+					addRuleErrStr("Flags “%s” are invalid", varFlags)
+					flags = []int{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1}
+				}
+				hasPadRight := flags[2] != flags[3]
+				hasPad0 := flags[4] != flags[5]
+				var optWidth, optPrecision string
+				if flags[6] != flags[7] {
+					optWidth = b2s(varFlags[flags[6]:flags[7]])
+				}
+				if flags[8] != flags[9] {
+					optPrecision = b2s(varFlags[flags[8]:flags[9]])
 				}
-			}
 
-			return outputRet
-		})
+				//Build the output byte struct (extended types, and Currencies with options, are stored as DateTimes)
+				isExtended := varInfo.myType >= vtFirstExtended || (varInfo.myType == vtCurrency && parts[6] != parts[7])
+				outputRet := make([]byte, 3, 5)
+				flagsByte := uint8(cond(isExtended, vtDateTime, varInfo.myType))
+				outputRet[0] = varReplacementChar
+				outputRet[1] = varInfo.index
 
-		//Replace special characters
-		finalStr = regexSpecialCharacters.ReplaceAllFunc(finalStr, func(varVal []byte) []byte {
-			var newChar byte
-			switch bytes.ToLower(varVal[1:2])[0] {
-			case 'a':
-				newChar = '\a'
-			case 'b':
-				newChar = '\b'
-			case 'f':
-				newChar = '\f'
-			case 'n':
-				newChar = '\n'
-			case 'r':
-				newChar = '\r'
-			case 't':
-				newChar = '\t'
-			case 'v':
-				newChar = '\v'
-			case '\\':
-				newChar = '\\'
-			//Handle 2 digit hex character
-			case 'x':
-				newCharCode, _ := strconv.ParseInt(b2s(varVal[2:4]), 16, 0)
-				if newCharCode == varReplacementChar {
-					ruleErrors = append(ruleErrors, "Cannot use \\xFF as it is a special character in this library")
-					return nil
+				//Process the flags
+				if hasPadRight {
+					flagsByte |= fmtPadRight
+				}
+				if hasPad0 {
+					flagsByte |= fmtPad0
 				}
+				checkWidth := func(val, varName string, flagByte uint8) {
+					if len(val) == 0 {
+						return
+					}
 
-				return []byte{byte(newCharCode)}
-			//Handle 4 digit unicode character
-			case 'u':
-				//Confirm character is within the valid unicode range
-				newCharCode, _ := strconv.ParseInt(b2s(varVal[2:]), 16, 0)
-				if newCharCode > unicode.MaxRune {
-					ruleErrors = append(ruleErrors, "Invalid unicode character found. Is >unicode.MaxRune: 0x"+string(varVal[2:]))
-					return nil
+					if width, err := strconv.Atoi(val); err != nil {
+						addRuleErrStr("Has an invalid %s: %s", varName, val)
+					} else if width > 255 {
+						addRuleErrStr("The %s (%d) cannot be greater than 255", varName, width)
+					} else {
+						flagsByte |= flagByte
+						outputRet = append(outputRet, byte(width))
+					}
+				}
+				checkWidth(optWidth, "width", fmtHasWidth)
+				checkWidth(optPrecision, "precision", fmtHasPrecision)
+				outputRet[2] = flagsByte
+
+				//For DateTimes, save the specifier after the colon
+				if varInfo.myType == vtDateTime {
+					//Confirm the specifier
+					if parts[6] == parts[7] {
+						addRuleErrStr("This variable type (%s) requires a specifier (a value after an exclamation mark)", variableTypeMapReverse[varInfo.myType])
+					} else if parts[7]-parts[6] > 255 {
+						addRuleErrStr("This variable type (%s) specifier cannot be more than 255 bytes", variableTypeMapReverse[varInfo.myType])
+					} else if err := dateTimes.check(b2s(varVal[parts[6]:parts[7]])); err != nil {
+						addRuleErrStr("%s", err.Error())
+					} else {
+						//Write the specifier length and string
+						outputRet = append(outputRet, byte(parts[7]-parts[6]))
+						outputRet = append(outputRet, varVal[parts[6]:parts[7]]...)
+					}
 				}
 
-				//Confirm the unicode character is a valid code point
-				if !utf8.ValidRune(rune(newCharCode)) {
-					ruleErrors = append(ruleErrors, "Invalid unicode character found: 0x"+string(varVal[2:]))
-					return nil
+				//For extended types, save their information as the specifier
+				if isExtended {
+					var specifier string
+					if parts[6] != -1 {
+						specifier = b2s(varVal[parts[6]:parts[7]])
+					}
+					if compiledSpecifier, err := compileExtendedSpecifier(varInfo.myType, specifier); err != nil {
+						addRuleErrStr("%s", err.Error())
+					} else {
+						outputRet = append(outputRet, byte(len(compiledSpecifier)))
+						outputRet = append(outputRet, compiledSpecifier...)
+					}
 				}
 
-				//Return the valid character
-				return []byte(string(rune(newCharCode)))
-			default:
-				ruleErrors = append(ruleErrors, "Invalid escaped character found after slash: "+string(varVal[1:]))
-				return nil
-			}
+				return outputRet
+			})
+
+			//Replace special characters
+			finalStr = escapes.replace(finalStr, func(err string, args ...interface{}) {
+				ruleErrors = append(ruleErrors, fmt.Sprintf(err, args...))
+			})
+
+			//Replace static translations
+			finalStr = regexMatchEmbeddedStaticVariable.ReplaceAllFunc(finalStr, func(varVal []byte) []byte {
+				//If there is a second specifier then consider the first specifier the namespace
+				parts := regexMatchEmbeddedStaticVariable.FindSubmatchIndex(varVal)
+				myNamespaceName := namespaceName
+				translationID := b2s(varVal[parts[2]:parts[3]])
+				if parts[4] != parts[5] {
+					myNamespaceName = translationID
+					translationID = b2s(varVal[parts[4]:parts[5]])
+				}
 
-			return []byte{newChar}
-		})
-
-		//Replace static translations
-		finalStr = regexMatchEmbeddedStaticVariable.ReplaceAllFunc(finalStr, func(varVal []byte) []byte {
-			//If there is a second specifier then consider the first specifier the namespace
-			parts := regexMatchEmbeddedStaticVariable.FindSubmatchIndex(varVal)
-			myNamespaceName := namespaceName
-			translationID := b2s(varVal[parts[2]:parts[3]])
-			if parts[4] != parts[5] {
-				myNamespaceName = translationID
-				translationID = b2s(varVal[parts[4]:parts[5]])
-			}
+				//Lookup the index for the Translation ID
+				if n, ok := dict.namespaces[myNamespaceName]; !ok {
+					ruleErrors = append(ruleErrors, fmt.Sprintf("Invalid namespace for specifier %s.%s", myNamespaceName, translationID))
+				} else if translationIDIndex, ok := n.ids[translationID]; !ok {
+					ruleErrors = append(ruleErrors, fmt.Sprintf("Invalid Translation ID in namespace for specifier %s.%s", myNamespaceName, translationID))
+				} else {
+					//Store as a variable
+					ret := []byte{
+						varReplacementChar, 0, byte(vtStaticTranslation), //varReplacementChar, varIndex=0 (Unused), Type
+						0, 0, 0, 0, //4 byte Translation ID Index
+					}
+					binary.LittleEndian.PutUint32(ret[3:], uint32(translationIDIndex))
 
-			//Lookup the index for the Translation ID
-			if n, ok := dict.namespaces[myNamespaceName]; !ok {
-				ruleErrors = append(ruleErrors, fmt.Sprintf("Invalid namespace for specifier %s.%s", myNamespaceName, translationID))
-			} else if translationIDIndex, ok := n.ids[translationID]; !ok {
-				ruleErrors = append(ruleErrors, fmt.Sprintf("Invalid Translation ID in namespace for specifier %s.%s", myNamespaceName, translationID))
-			} else {
-				//Store as a variable
-				ret := []byte{
-					varReplacementChar, 0, byte(vtStaticTranslation), //varReplacementChar, varIndex=0 (Unused), Type
-					0, 0, 0, 0, //4 byte Translation ID Index
-				}
-				*(*TransIndex)(p2uint32p(&ret[3])) = translationIDIndex
+					//Add to embedded translation IDs if not already in it
+					if !arrayIn(retEmbeddedTIDs, translationIDIndex) {
+						retEmbeddedTIDs = append(retEmbeddedTIDs, translationIDIndex)
+					}
 
-				//Add to embedded translation IDs if not already in it
-				if !arrayIn(retEmbeddedTIDs, translationIDIndex) {
-					retEmbeddedTIDs = append(retEmbeddedTIDs, translationIDIndex)
+					return ret
 				}
 
-				return ret
-			}
-
-			//Return nothing on error
-			return nil
-		})
+				//Return nothing on error
+				return nil
+			})
+		}
 
 		//Propagate rule errors to parent
 		for _, err := range ruleErrors {
@@ -357,9 +383,31 @@ func addTranslationIDFromTextFile(props []string, namespaceName string, dict *la
 		retPluralRules = append(retPluralRules, ruleVal.rule)
 	}
 
+	//Move the rules of registers behind the default register’s rules
+	ruleRegisters := make([]uint8, len(myRules))
+	for i, r := range myRules {
+		ruleRegisters[i] = r.register
+	}
+	if hasDefaultRules := groupRegisterRules(&retStrings, &retPluralRules, ruleRegisters); !hasDefaultRules {
+		addErrStr("Must have rules that are not for a register (the default register’s rules)")
+	}
+
 	return
 }
 
+// Compiles the rule of a Translation ID with a Template variable (see vtTemplate). Special characters are replaced, and the template is parsed to confirm its syntax
+func compileTemplateRule(rule []byte, varIndex uint8, escapes *escapePolicy, ruleErrors *[]string) []byte {
+	src := escapes.replace(rule, func(err string, args ...interface{}) {
+		*ruleErrors = append(*ruleErrors, fmt.Sprintf(err, args...))
+	})
+	if _, err := parseTextTemplate(string(src)); err != nil {
+		*ruleErrors = append(*ruleErrors, "has an invalid template: "+err.Error())
+	}
+
+	//The variable placement is stored as a DateTime with an extended specifier
+	return append([]byte{varReplacementChar, varIndex, byte(vtDateTime), 2, varReplacementChar, byte(vtTemplate)}, src...)
+}
+
 func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []byte, dict *languageDict, namespaceName string) []byte {
 	//Consume 1 or more bytes
 	var outStr bytes.Buffer
@@ -444,7 +492,7 @@ func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []by
 				outStr.Write(err)
 			} else {
 				//Search the namespaces for the Translation ID
-				translationID := TransIndex(*p2uint32p(&_translationID[0]))
+				translationID := TransIndex(binary.LittleEndian.Uint32(_translationID))
 				staticTranslationName := "NOT_FOUND"
 				if nsName, translationIDName, ok := dict.translationIDLookup(translationID); ok {
 					if nsName != namespaceName {
@@ -463,6 +511,12 @@ func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []by
 			continue
 		}
 
+		//Templates do not have a variable insertion, and the rest of the string is the template
+		if startStrPos+5 <= startStrLen && variableType(startStr[startStrPos+1]&0xF) == vtDateTime && startStr[startStrPos+3] == varReplacementChar && variableType(startStr[startStrPos+4]) == vtTemplate {
+			startStrPos += 5
+			continue
+		}
+
 		//Write the variable insertion header
 		outStr.Write([]byte{'{', '{', '.'})
 
@@ -470,7 +524,7 @@ func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []by
 		if varIndex, err := consumeByte(); err != nil {
 			outStr.Write(err)
 		} else if varIndex == 0 {
-			outStr.WriteString("PluralCount")
+			outStr.WriteString(pluralCountName)
 		} else if uint(varIndex)-1 < ulen(tv.vars) {
 			outStr.WriteString(tv.vars[varIndex-1].name)
 		} else {
@@ -517,6 +571,11 @@ func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []by
 				outStr.Write(err)
 			} else if specifier, err := consumeBytes(uint(specifierLen)); err != nil {
 				outStr.Write(err)
+			} else if len(specifier) != 0 && specifier[0] == varReplacementChar {
+				if specifierStr := decompileExtendedSpecifier(specifier); len(specifierStr) != 0 {
+					outStr.WriteByte('!')
+					outStr.WriteString(specifierStr)
+				}
 			} else {
 				outStr.WriteByte('!')
 				outStr.Write(specifier)
@@ -529,3 +588,121 @@ func (tv *translationIDNameAndVars) getTranslationWithVarsAsString(startStr []by
 
 	return outStr.Bytes()
 }
+
+// Names of the spelloutCase options, indexed by spelloutCase
+var spelloutCaseNames = []string{"Lower", "Upper", "Title", "Sentence"}
+
+// Names of the spelloutOptions flags
+const (
+	spelloutOrdinalName  = "Ordinal"
+	spelloutFeminineName = "Feminine"
+)
+
+// Name of the Quote option flag
+const quoteAlternateName = "Alternate"
+
+// Name of the Currency option flag
+const currencyCashName = "Cash"
+
+// Compiles the specifier for an extended variable type (see vtFirstExtended), or a Currency with options. The specifier is what is given after the exclamation mark
+func compileExtendedSpecifier(varType variableType, specifier string) ([]byte, error) {
+	ret := []byte{varReplacementChar, byte(varType)}
+	switch varType {
+	case vtSpellout, vtOrdinal:
+		//Options are separated by spaces
+		capitalization, flags := scLower, byte(0)
+		for _, option := range strings.Fields(specifier) {
+			switch {
+			case strings.EqualFold(option, spelloutOrdinalName) && varType == vtSpellout:
+				flags |= soOrdinal
+			case strings.EqualFold(option, spelloutFeminineName):
+				flags |= soFeminine
+			default:
+				isCaseName := false
+				for i, name := range spelloutCaseNames {
+					if strings.EqualFold(option, name) && varType == vtSpellout {
+						capitalization, isCaseName = spelloutCase(i), true
+					}
+				}
+				if !isCaseName && varType == vtSpellout {
+					return nil, fmt.Errorf("Spellout specifier option “%s” must be one of: %s, %s, %s", option, strings.Join(spelloutCaseNames, ", "), spelloutOrdinalName, spelloutFeminineName)
+				} else if !isCaseName {
+					return nil, fmt.Errorf("Ordinal specifier option “%s” must be: %s", option, spelloutFeminineName)
+				}
+			}
+		}
+
+		//Spellout stores [capitalization, flags] and Ordinal stores [flags]. Trailing default values are not stored
+		if varType == vtOrdinal {
+			if flags != 0 {
+				ret = append(ret, flags)
+			}
+			return ret, nil
+		} else if flags != 0 {
+			return append(ret, byte(capitalization), flags), nil
+		} else if capitalization != scLower {
+			return append(ret, byte(capitalization)), nil
+		}
+		return ret, nil
+	case vtQuote:
+		//Quote stores [flags]. A default value is not stored
+		flags := byte(0)
+		for _, option := range strings.Fields(specifier) {
+			if !strings.EqualFold(option, quoteAlternateName) {
+				return nil, fmt.Errorf("Quote specifier option “%s” must be: %s", option, quoteAlternateName)
+			}
+			flags |= qoAlternate
+		}
+		if flags != 0 {
+			ret = append(ret, flags)
+		}
+		return ret, nil
+	case vtCurrency:
+		//Currency stores [flags]
+		flags := byte(0)
+		for _, option := range strings.Fields(specifier) {
+			if !strings.EqualFold(option, currencyCashName) {
+				return nil, fmt.Errorf("Currency specifier option “%s” must be: %s", option, currencyCashName)
+			}
+			flags |= coCash
+		}
+		return append(ret, flags), nil
+	default:
+		return nil, errors.New("Unknown extended variable type")
+	}
+}
+
+// Returns the text specifier (without the exclamation mark) for a compiled extended variable specifier
+func decompileExtendedSpecifier(compiled []byte) string {
+	if len(compiled) < 2 {
+		return "ERROR_BAD_EXTENDED_SPECIFIER"
+	}
+	if variableType(compiled[1]) == vtQuote {
+		if len(compiled) > 2 && compiled[2]&qoAlternate != 0 {
+			return quoteAlternateName
+		}
+		return ""
+	}
+	if variableType(compiled[1]) == vtCurrency {
+		if len(compiled) > 2 && compiled[2]&coCash != 0 {
+			return currencyCashName
+		}
+		return ""
+	}
+	opts, ok := getSpelloutOptions(variableType(compiled[1]), compiled[2:])
+	if !ok {
+		return "ERROR_BAD_EXTENDED_SPECIFIER"
+	}
+
+	var options []string
+	if opts.capitalization != scLower {
+		options = append(options, spelloutCaseNames[opts.capitalization])
+	}
+	if opts.isOrdinal && variableType(compiled[1]) == vtSpellout {
+		options = append(options, spelloutOrdinalName)
+	}
+	if opts.isFeminine {
+		options = append(options, spelloutFeminineName)
+	}
+	return strings.Join(options, " ")
+}
@@ -4,19 +4,24 @@ package translate
 
 import (
 	"bytes"
+	"crypto/cipher"
 	"crypto/sha1"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"golang.org/x/text/language"
-	"golang.org/x/text/message"
+	"hash/crc32"
 	"io"
 	"math"
+	"strings"
+	"sync"
 	"unsafe"
 )
 
 // Main storage structures
 type storeHeader struct {
-	fileType                    [3]byte //GTR
+	fileType                    [2]byte //GT
+	formatVersion               uint8   //See CompiledFormatVersion
 	translationStringByteLength uint8   //4 or 8 for storeTranslationRule16 or storeTranslationRule32
 	numRules, numTranslations   uint32
 	settingsSize, dataSize      uint32
@@ -24,20 +29,25 @@ type storeHeader struct {
 }
 type storeTranslationRule16 struct {
 	length uint16
-	rule   pluralRule
+	rule   pluralRule8
 }
 type storeTranslationRule32 struct {
 	length uint32
-	rule   pluralRule
+	rule   pluralRule8
 	//2 bytes unused
 }
+type storeTranslationRuleWide struct { //Only used when a rule’s number does not fit in a uint8
+	length uint32
+	rule   pluralRule
+}
 type storeTranslationRuleSlice struct {
 	length byte
 }
 
 // Dictionary file storage structures
 type storeDictHeader struct {
-	fileType                       [3]byte //DTR
+	fileType                       [2]byte //DT
+	formatVersion                  uint8   //See CompiledFormatVersion
 	numTranslations, numNamespaces uint32
 	idsSize, namespacesSize        uint32
 }
@@ -56,20 +66,26 @@ type getLength interface {
 
 func (v storeTranslationRule16) getLength() uint32    { return uint32(v.length) }
 func (v storeTranslationRule32) getLength() uint32    { return v.length }
+func (v storeTranslationRuleWide) getLength() uint32  { return v.length }
 func (v storeTranslationRuleSlice) getLength() uint32 { return uint32(v.length) }
 func (v storeTranslationIDSize) getLength() uint32    { return uint32(v.length) }
 func (v storeNamespace) getLength() uint32 {
-	return *p2uint32p(&v.length) & 0xFFFFFF
+	return uint32(v.length[0]) | uint32(v.length[1])<<8 | uint32(v.length[2])<<16
 }
 
 //goland:noinspection GoSnakeCaseUsage
 const (
 	//Struct sizes
-	size_storeTranslationRule16    = uint32(unsafe.Sizeof(storeTranslationRule16{}))
-	size_storeTranslationRule32    = uint32(unsafe.Sizeof(storeTranslationRule32{}))
-	size_storeTranslationRuleSlice = uint32(unsafe.Sizeof(storeTranslationRuleSlice{}))
-	size_storeTranslationIDsSize   = uint32(unsafe.Sizeof(storeTranslationIDSize{}))
-	size_storeNamespace            = uint32(unsafe.Sizeof(storeNamespace{}))
+	size_storeTranslationRule16    = uint32(4)
+	size_storeTranslationRule32    = uint32(8)
+	size_storeTranslationRuleWide  = uint32(12)
+	size_storeTranslationRuleSlice = uint32(1)
+	size_storeTranslationIDsSize   = uint32(2)
+	size_storeNamespace            = uint32(4)
+	size_checksum                  = uint32(unsafe.Sizeof(uint32(0)))
+
+	//The maximum size of the buffer that structs are read into, a chunk at a time (see readDataToStruct())
+	readChunkSize = 64 * 1024
 
 	//Soft limits
 	softLimit_numNamespaces       = 1_000
@@ -83,15 +99,31 @@ const (
 	ErrDictionaryDoesNotMatch = "Dictionary does not match"
 )
 
+// Returns if the translation string size is the size of one of the storeTranslationRule structs
+func (header storeHeader) hasValidTranslationStringByteLength() bool {
+	switch uint32(header.translationStringByteLength) {
+	case size_storeTranslationRule16, size_storeTranslationRule32, size_storeTranslationRuleWide:
+		return true
+	}
+	return false
+}
+
+// Returns if the language file ends with a checksum (see CompiledFormatVersion)
+func (header storeHeader) hasChecksum() bool {
+	return header.formatVersion >= checksumFormatVersion && header.formatVersion != legacyFormatVersionByte
+}
+
 // Get compiled (binary) file sizes
 func (header storeHeader) getCompiledFileSize() uint64 {
-	return uint64(unsafe.Sizeof(header)) +
+	return uint64(size_storeHeader) +
 		uint64(header.numRules)*uint64(header.translationStringByteLength) +
 		uint64(header.numTranslations)*uint64(size_storeTranslationRuleSlice) +
-		uint64(header.settingsSize) + uint64(header.dataSize)
+		uint64(header.settingsSize) + uint64(header.dataSize) +
+		cond[uint64](header.hasChecksum(), uint64(size_checksum), 0) +
+		cond[uint64](header.isEncrypted(), size_encryptionOverhead, 0)
 }
 func (header storeDictHeader) getCompiledFileSize() uint64 {
-	return uint64(unsafe.Sizeof(header)) +
+	return uint64(size_storeDictHeader) +
 		uint64(header.numTranslations)*uint64(size_storeTranslationIDsSize) +
 		uint64(header.numNamespaces)*uint64(size_storeNamespace) +
 		uint64(header.idsSize) + uint64(header.namespacesSize)
@@ -110,7 +142,7 @@ func (header storeHeader) checkSoftCaps() error {
 		{header.dataSize, softLimit_dataSize, "Data size"},
 	} {
 		if v.sizePointer > v.maxSize {
-			return errors.New(message.NewPrinter(language.English).Sprintf("%s cannot be larger than %d", v.varName, v.maxSize))
+			return errors.New(englishSprintf("%s cannot be larger than %d", v.varName, v.maxSize))
 		}
 	}
 
@@ -128,7 +160,7 @@ func (header storeDictHeader) checkSoftCaps() error {
 		{header.namespacesSize, softLimit_namespacesSize, "Namespaces Size"},
 	} {
 		if v.sizePointer > v.maxSize {
-			return errors.New(message.NewPrinter(language.English).Sprintf("%s cannot be larger than %d", v.varName, v.maxSize))
+			return errors.New(englishSprintf("%s cannot be larger than %d", v.varName, v.maxSize))
 		}
 	}
 
@@ -138,14 +170,14 @@ func (header storeDictHeader) checkSoftCaps() error {
 func init() {
 	//Make sure hard limits added together are under 4gb
 	if (storeHeader{
-		[3]byte{}, uint8(size_storeTranslationRule32), //Assumes 8 byte translation string sizes for safety
+		[2]byte{}, CompiledFormatVersion, uint8(size_storeTranslationRuleWide), //Assumes the largest translation string sizes for safety
 		softLimit_numTranslationRules, softLimit_numTranslations,
 		softLimit_settingsSize, softLimit_dataSize, [20]byte{},
 	}).getCompiledFileSize() > math.MaxUint32 {
 		panic("Translation soft limits could overflow to >4GB")
 	}
 	if (storeDictHeader{
-		[3]byte{}, softLimit_numTranslationRules, softLimit_numNamespaces,
+		[2]byte{}, 0, softLimit_numTranslationRules, softLimit_numNamespaces,
 		softLimit_idsSize, softLimit_namespacesSize,
 	}).getCompiledFileSize() > math.MaxUint32 {
 		panic("Translation soft limits could overflow dictionary to >4GB")
@@ -160,19 +192,8 @@ func (dict *languageDict) fromCompiledFile(r io.Reader) error {
 		prevBytesRead = numBytesRead
 		numBytesRead += ulen32(bytes)
 
-		if bytesRead, err := r.Read(bytes); err != nil {
-			if err == io.EOF {
-				//This is not actually an EOF error if bytesRead==len(bytes)
-				if bytesRead == len(bytes) {
-					hashOfFile.Write(bytes)
-					return nil
-				}
-
-				return errors.New("File ended early")
-			}
+		if err := readFull(r, bytes); err != nil {
 			return err
-		} else if bytesRead < len(bytes) {
-			return errors.New("File ended early")
 		}
 
 		hashOfFile.Write(bytes)
@@ -181,85 +202,106 @@ func (dict *languageDict) fromCompiledFile(r io.Reader) error {
 
 	//Handle returning errors
 	retErrStr := func(err string, Location uint32) error { return fmt.Errorf("@%d %s", Location, err) }
-	retErr := func(err error, Location uint32) error { return retErrStr(err.Error(), Location) }
+	retErr := func(err error, Location uint32) error { return fmt.Errorf("@%d %w", Location, err) }
 
 	//Confirm the header and its data
 	var header storeDictHeader
-	if err := readBytes(any2b(&header)); err != nil {
+	headerBytes := make([]byte, size_storeDictHeader)
+	if err := readBytes(headerBytes); err != nil {
 		return retErr(err, prevBytesRead)
 	}
-	if b2s(header.fileType[0:3]) != "DTR" {
-		return retErrStr("Invalid file header", 0)
+	header.fromLittleEndian(headerBytes)
+	if _, err := readFormatVersion(header.fileType, header.formatVersion, "DT"); err != nil {
+		return retErr(err, 0)
+	}
+
+	//The format version is not part of the hash, so it is hashed as a legacy header
+	{
+		hashHeader := header
+		hashHeader.formatVersion = legacyFormatVersionByte
+		hashOfFile.Reset()
+		hashOfFile.Write(encodeStruct(&hashHeader))
 	}
 	if err := header.checkSoftCaps(); err != nil {
 		return retErr(err, prevBytesRead)
 	}
 
 	//Create the final structure now that we have sizes
-	*dict = languageDict{make(map[string]*namespace, header.numNamespaces), make([]string, header.numNamespaces), nil, false}
+	*dict = languageDict{make(map[string]*namespace, header.numNamespaces), make([]string, header.numNamespaces), nil, false, sync.Mutex{}, nil, nil, 0}
 
-	//Make a temporary buffer of the largest size we need to read in all data
-	tempBuff := make([]byte, max(
-		size_storeTranslationIDsSize*header.numTranslations+header.idsSize,
-		size_storeNamespace*header.numNamespaces+header.namespacesSize,
-	))
-
-	//Read in translation ids
-	translationIDsList := make([]string, 0, header.numTranslations)
+	//Read in translation ids. Their sizes come first, followed by their strings
+	translationIDsList := make([]string, header.numTranslations)
 	{
-		startStrPos := size_storeTranslationIDsSize * header.numTranslations
+		//Read the start positions of the strings
+		idStarts := make([]uint32, header.numTranslations+1)
+		sectionStart := numBytesRead
 		if err, errOffset := readDataToStruct(
-			header.numTranslations, "translation IDs", tempBuff, header.idsSize, readBytes, false, header.idsSize,
-			func(pos uint32, readFrom *storeTranslationIDSize, accum uint32) {
-				translationIDsList = append(
-					translationIDsList,
-					string(tempBuff[startStrPos+accum:startStrPos+accum+uint32(readFrom.length)]),
-				)
+			header.numTranslations, "translation IDs", header.idsSize, readBytes, true,
+			func(pos uint32, _ *storeTranslationIDSize, accum uint32) {
+				idStarts[pos] = accum
 			},
 		); err != nil {
-			return retErr(err, prevBytesRead+errOffset)
+			return retErr(err, sectionStart+errOffset)
+		}
+
+		//Read the strings. The translation IDs share the buffer, which is not used for anything else
+		idsBuff := make([]byte, header.idsSize)
+		if err := readBytes(idsBuff); err != nil {
+			return retErr(err, prevBytesRead)
+		}
+		idsStr := b2s(idsBuff)
+		for i := range translationIDsList {
+			translationIDsList[i] = idsStr[idStarts[i]:idStarts[i+1]]
 		}
 	}
 
-	//Read in namespaces
+	//Read in namespaces. Their sizes come first, followed by their names
 	{
-		namePosStart := size_storeNamespace * header.numNamespaces
-		namePosAccum := uint32(0)
-		var nsLenErr error
+		//Read the sizes
+		namespaceSizes := make([]storeNamespace, header.numNamespaces)
+		sectionStart := numBytesRead
 		if err, errOffset := readDataToStruct(
-			header.numNamespaces, "translation ID offsets", tempBuff, header.numTranslations, readBytes, false, header.namespacesSize,
+			header.numNamespaces, "translation ID offsets", header.numTranslations, readBytes, false,
 			func(pos uint32, readFrom *storeNamespace, accum uint32) {
-				//Get the namespace name
-				if namePosAccum+uint32(readFrom.nameSize) > header.namespacesSize {
-					if nsLenErr == nil {
-						nsLenErr = retErrStr(fmt.Sprintf(
-							"Length of accumulated [%s] data read (%d) at index (%d) has exceeded given data length (%d)",
-							"namespace names", namePosAccum+uint32(readFrom.nameSize), pos, header.namespacesSize,
-						), prevBytesRead+pos*uint32(unsafe.Sizeof(*readFrom))+3)
-					}
-					return
-				}
-				namespaceName := string(tempBuff[namePosStart+namePosAccum : namePosStart+namePosAccum+uint32(readFrom.nameSize)])
-				namePosAccum += uint32(readFrom.nameSize)
-				dict.namespacesInOrder[pos] = namespaceName
-
-				//Create the namespace
-				translationsLength := readFrom.getLength()
-				myNamespace := namespace{
-					namespaceName, uint(pos),
-					make(translationIDs, translationsLength), nil,
-				}
-				dict.namespaces[namespaceName] = &myNamespace
-
-				//Copy in the translation IDs
-				for localIndex, translationID := range translationIDsList[accum : accum+translationsLength] {
-					myNamespace.ids[translationID] = TransIndex(accum + uint32(localIndex))
-				}
+				namespaceSizes[pos] = *readFrom
 			},
 		); err != nil {
-			return retErr(err, prevBytesRead+errOffset)
-		} else if nsLenErr != nil {
-			return nsLenErr
+			return retErr(err, sectionStart+errOffset)
+		}
+
+		//Read the names
+		names := make([]byte, header.namespacesSize)
+		if err := readBytes(names); err != nil {
+			return retErr(err, prevBytesRead)
+		}
+
+		//Create the namespaces
+		namePosAccum, accum := uint32(0), uint32(0)
+		for pos, readFrom := range namespaceSizes {
+			//Get the namespace name
+			if namePosAccum+uint32(readFrom.nameSize) > header.namespacesSize {
+				return retErrStr(fmt.Sprintf(
+					"Length of accumulated [%s] data read (%d) at index (%d) has exceeded given data length (%d)",
+					"namespace names", namePosAccum+uint32(readFrom.nameSize), pos, header.namespacesSize,
+				), sectionStart+uint32(pos)*size_storeNamespace+3)
+			}
+			namespaceName := string(names[namePosAccum : namePosAccum+uint32(readFrom.nameSize)])
+			namePosAccum += uint32(readFrom.nameSize)
+			dict.namespacesInOrder[pos] = namespaceName
+
+			//Create the namespace
+			translationsLength := readFrom.getLength()
+			myNamespace := namespace{
+				namespaceName, uint(pos),
+				make(translationIDs, translationsLength), nil, NamespaceMetadata{},
+			}
+			dict.namespaces[namespaceName] = &myNamespace
+
+			//Copy in the translation IDs
+			for localIndex, translationID := range translationIDsList[accum : accum+translationsLength] {
+				myNamespace.ids[translationID] = TransIndex(accum + uint32(localIndex))
+			}
+			accum += translationsLength
 		}
 	}
 
@@ -280,18 +322,7 @@ func (dict *languageDict) fromCompiledVarFile(r io.Reader) error {
 	var numBytesRead uint32 = 0
 	readBytes := func(bytes []byte) error {
 		numBytesRead += ulen32(bytes)
-
-		if bytesRead, err := r.Read(bytes); err != nil {
-			if err != io.EOF {
-				return err
-			} else if bytesRead != len(bytes) { //This is not actually an EOF error if bytesRead==len(bytes)
-				return errors.New("File ended early")
-			}
-		} else if bytesRead < len(bytes) {
-			return errors.New("File ended early")
-		}
-
-		return nil
+		return readFull(r, bytes)
 	}
 	readByte := func() (byte, error) {
 		var r [1]byte
@@ -301,13 +332,13 @@ func (dict *languageDict) fromCompiledVarFile(r io.Reader) error {
 		return r[0], nil
 	}
 
-	//Compiled var files are companions to the compiled dictionary files, so they will have no headers beyond the 3 byte file definition
+	//Compiled var files are companions to the compiled dictionary files, so they will have no headers beyond the 3 byte file definition (which ends with the format version)
 	{
 		var h [3]byte
 		if err := readBytes(h[:]); err != nil {
 			return errors.New("Could not read header")
-		} else if b2s(h[:]) != "VTR" {
-			return errors.New("Header invalid")
+		} else if _, err := readFormatVersion([2]byte(h[0:2]), h[2], "VT"); err != nil {
+			return err
 		}
 	}
 
@@ -350,51 +381,76 @@ func (dict *languageDict) fromCompiledVarFile(r io.Reader) error {
 		}
 	}
 
+	//Read in the namespace metadata. Older files do not have it, so the file can end before it
+	for nsIndex, namespaceName := range dict.namespacesInOrder {
+		//Read the number of properties
+		var numProps [1]byte
+		if nsIndex != 0 {
+			if err := readBytes(numProps[:]); err != nil {
+				return err
+			}
+		} else if _, err := io.ReadFull(r, numProps[:]); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		//Read the properties
+		n := dict.namespaces[namespaceName]
+		for i := byte(0); i < numProps[0]; i++ {
+			var lens [3]byte
+			if err := readBytes(lens[:]); err != nil {
+				return err
+			}
+			nameAndValue := make([]byte, uint(lens[0])+uint(lens[1])+uint(lens[2])<<8)
+			if err := readBytes(nameAndValue); err != nil {
+				return err
+			}
+			n.metadata.set(string(nameAndValue[:lens[0]]), string(nameAndValue[lens[0]:]))
+		}
+	}
+
 	//Return success
 	dict.hasVarsLoaded = true
 	return nil
 }
 
-func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
+// Reads a compiled language file. If stringsAt is given (see Dictionary.LoadAt()), it must be the file r is reading from the start of, and the strings are left in it instead of being read
+func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict, stringsAt io.ReaderAt, decryption cipher.AEAD) error {
 	//Handle reading the binary file
 	var numBytesRead, prevBytesRead uint32 = 0, 0
+	checksum := crc32.New(checksumTable)
 	readBytes := func(bytes []byte) error {
 		prevBytesRead = numBytesRead
 		numBytesRead += ulen32(bytes)
-
-		if bytesRead, err := r.Read(bytes); err != nil {
-			if err == io.EOF {
-				//This is not actually an EOF error if bytesRead==len(bytes)
-				if bytesRead == len(bytes) {
-					return nil
-				}
-
-				return errors.New("File ended early")
-			}
+		if err := readFull(r, bytes); err != nil {
 			return err
-		} else if bytesRead < len(bytes) {
-			return errors.New("File ended early")
 		}
-
+		checksum.Write(bytes)
 		return nil
 	}
 
 	//Handle returning errors
 	retErrStr := func(err string, Location uint32) error { return fmt.Errorf("@%d %s", Location, err) }
-	retErr := func(err error, Location uint32) error { return retErrStr(err.Error(), Location) }
+	retErr := func(err error, Location uint32) error { return fmt.Errorf("@%d %w", Location, err) }
 
 	//Confirm the header and its data
 	var header storeHeader
-	if err := readBytes(any2b(&header)); err != nil {
+	headerBytes := make([]byte, size_storeHeader)
+	if err := readBytes(headerBytes); err != nil {
+		return retErr(err, prevBytesRead)
+	}
+	header.fromLittleEndian(headerBytes)
+	if _, err := header.readFormatVersion(); err != nil {
 		return retErr(err, prevBytesRead)
-	} else if b2s(header.fileType[0:3]) != "GTR" {
-		return retErrStr("Invalid file header", prevBytesRead)
-	} else if header.translationStringByteLength != uint8(size_storeTranslationRule16) && header.translationStringByteLength != uint8(size_storeTranslationRule32) {
-		return retErrStr(fmt.Sprintf("Invalid translation string size (%d != (%d || %d))", header.translationStringByteLength, size_storeTranslationRule16, size_storeTranslationRule32), prevBytesRead+uint32(unsafe.Offsetof(header.translationStringByteLength)))
+	} else if header.isEncrypted() && decryption == nil {
+		return retErr(ErrMissingDecryptionKey, prevBytesRead)
+	} else if !header.hasValidTranslationStringByteLength() {
+		return retErrStr(fmt.Sprintf("Invalid translation string size (%d != (%d || %d || %d))", header.translationStringByteLength, size_storeTranslationRule16, size_storeTranslationRule32, size_storeTranslationRuleWide), prevBytesRead+3)
 	} else if err := header.checkSoftCaps(); err != nil {
 		return retErr(err, prevBytesRead)
 	} else if !bytes.Equal(header.hash[:], dict.hash) {
-		return retErrStr(ErrDictionaryDoesNotMatch, prevBytesRead+uint32(unsafe.Offsetof(header.hash)))
+		return retErr(ErrDictionaryMismatch, prevBytesRead+20)
 	}
 
 	//Make sure the number of translations matches the dictionary
@@ -410,6 +466,7 @@ func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
 
 	//Pull in the settings
 	var languageTag language.Tag
+	var calendar calendarType
 	var settingsValues []string
 	{
 		//Read in the settings section from the file
@@ -419,42 +476,15 @@ func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
 		}
 
 		//Process the settings
-		const numSettings = 4
-		const settingLenSize = uint(unsafe.Sizeof(uint16(0)))
-		settingsValues = make([]string, numSettings)
-		for i, byteLoc := uint(0), uint(0); i < numSettings; i++ {
-			//Get the settings string value
-			if byteLoc+settingLenSize > ulen(settingsStr) {
-				return retErrStr("invalid settings length", prevBytesRead+uint32(byteLoc))
-			}
-			strLen := uint(*(*uint16)(unsafe.Pointer(&settingsStr[byteLoc])))
-			byteLoc += settingLenSize
-			if byteLoc+strLen > ulen(settingsStr) {
-				return retErrStr("invalid string length", prevBytesRead+uint32(byteLoc))
-			}
-			settingsValues[i] = string(settingsStr[byteLoc : byteLoc+strLen])
-			byteLoc += strLen
-
-			switch i {
-			//Handle a language tag
-			case 1:
-				if _languageTag, err := language.Parse(settingsValues[i]); err != nil {
-					return retErrStr("Invalid language tag: "+settingsValues[i], prevBytesRead+uint32(byteLoc-strLen))
-				} else {
-					languageTag = _languageTag
-				}
-			//Make sure byteLoc matches len(settingsStr) on last iteration
-			case numSettings - 1:
-				if byteLoc != ulen(settingsStr) {
-					return retErrStr(fmt.Sprintf("Settings length not completely consumed (%d!=%d)", byteLoc, len(settingsStr)), prevBytesRead+uint32(byteLoc))
-				}
-			}
+		if _settingsValues, _languageTag, _calendar, err, errOffset := parseCompiledSettings(settingsStr); err != nil {
+			return retErr(err, prevBytesRead+errOffset)
+		} else {
+			settingsValues, languageTag, calendar = _settingsValues, _languageTag, _calendar
 		}
 	}
 
 	//Create the final structure now that we have sizes
 	*l = Language{
-		stringsData:        make([]byte, header.dataSize),
 		rules:              make([]translationRule, header.numRules+1),
 		translations:       make([]translationRuleSlice, header.numTranslations+1),
 		dict:               dict,
@@ -463,33 +493,53 @@ func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
 		fallbackName:       settingsValues[2],
 		missingPluralRule:  settingsValues[3],
 		languageTag:        languageTag,
+		numberingSystem:    settingsValues[4],
+		calendar:           calendar,
+		omittedNamespaces:  cond(len(settingsValues[6]) == 0, nil, strings.Split(settingsValues[6], ",")),
+		cldrVersion:        settingsValues[7],
+		formatters:         &languageFormatters{},
+		registers:          cond(len(settingsValues[8]) == 0, nil, strings.Split(settingsValues[8], ",")),
+		encrypted:          header.isEncrypted(),
+	}
+	if header.isEncrypted() { //Encrypted strings are always read into memory
+		stringsAt = nil
+	}
+	if stringsAt == nil {
+		l.stringsData = make([]byte, header.dataSize)
 	}
-
-	//Make a temporary buffer of the largest size we need to read in all data
-	tempBuff := make([]byte, max(
-		uint32(header.translationStringByteLength)*header.numRules,
-		size_storeTranslationRuleSlice*header.numTranslations,
-	))
 
 	//Read in translation rules
 	{
+		sectionStart := numBytesRead
 		var err error
 		var errOffset uint32
-		if header.translationStringByteLength == uint8(size_storeTranslationRule16) {
+		switch uint32(header.translationStringByteLength) {
+		case size_storeTranslationRule16:
 			err, errOffset = readDataToStruct(
-				header.numRules, "rules", tempBuff, header.dataSize, readBytes, true, 0,
+				header.numRules, "rules", header.dataSize, readBytes, true,
 				func(pos uint32, readFrom *storeTranslationRule16, accum uint32) {
 					if readFrom == nil {
 						l.rules[pos] = translationRule{accum, pluralRule{cmpAll, 0}}
 					} else {
-						l.rules[pos] = translationRule{accum, readFrom.rule}
+						l.rules[pos] = translationRule{accum, readFrom.rule.toRule()}
 					}
 				},
 			)
-		} else {
+		case size_storeTranslationRule32:
 			err, errOffset = readDataToStruct(
-				header.numRules, "rules", tempBuff, header.dataSize, readBytes, true, 0,
+				header.numRules, "rules", header.dataSize, readBytes, true,
 				func(pos uint32, readFrom *storeTranslationRule32, accum uint32) {
+					if readFrom == nil {
+						l.rules[pos] = translationRule{accum, pluralRule{cmpAll, 0}}
+					} else {
+						l.rules[pos] = translationRule{accum, readFrom.rule.toRule()}
+					}
+				},
+			)
+		default:
+			err, errOffset = readDataToStruct(
+				header.numRules, "rules", header.dataSize, readBytes, true,
+				func(pos uint32, readFrom *storeTranslationRuleWide, accum uint32) {
 					if readFrom == nil {
 						l.rules[pos] = translationRule{accum, pluralRule{cmpAll, 0}}
 					} else {
@@ -499,23 +549,61 @@ func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
 			)
 		}
 		if err != nil {
-			return retErr(err, prevBytesRead+errOffset)
+			return retErr(err, sectionStart+errOffset)
 		}
 	}
 
 	//Read in translation rule slices
-	if err, errOffset := readDataToStruct(
-		header.numTranslations, "rule slices", tempBuff, header.numRules, readBytes, true, 0,
-		func(pos uint32, readFrom *storeTranslationRuleSlice, accum uint32) {
-			l.translations[pos] = translationRuleSlice{accum}
-		},
-	); err != nil {
-		return retErr(err, prevBytesRead+errOffset)
+	{
+		sectionStart := numBytesRead
+		if err, errOffset := readDataToStruct(
+			header.numTranslations, "rule slices", header.numRules, readBytes, true,
+			func(pos uint32, readFrom *storeTranslationRuleSlice, accum uint32) {
+				l.translations[pos] = translationRuleSlice{accum}
+			},
+		); err != nil {
+			return retErr(err, sectionStart+errOffset)
+		}
 	}
 
-	//Pull in stringsData
-	if err := readBytes(l.stringsData); err != nil {
-		return retErr(err, prevBytesRead)
+	//Pull in stringsData, or confirm it is in the file if it is left there. Encrypted strings are decrypted after the checksum is confirmed
+	var sealedStrings []byte
+	var sealedStringsLoc uint32
+	if header.isEncrypted() {
+		sealedStrings = make([]byte, uint64(header.dataSize)+size_encryptionOverhead)
+		if err := readBytes(sealedStrings); err != nil {
+			return retErr(err, prevBytesRead)
+		}
+		sealedStringsLoc = prevBytesRead
+	} else if stringsAt == nil {
+		if err := readBytes(l.stringsData); err != nil {
+			return retErr(err, prevBytesRead)
+		}
+	} else {
+		l.stringsAt = io.NewSectionReader(stringsAt, int64(numBytesRead), int64(header.dataSize))
+		prevBytesRead = numBytesRead
+		numBytesRead += header.dataSize
+		if header.hasChecksum() { //The strings are only read for the checksum
+			if n, err := io.Copy(checksum, io.NewSectionReader(stringsAt, int64(prevBytesRead), int64(header.dataSize))); err != nil || n != int64(header.dataSize) {
+				return retErrStr("File ended early", prevBytesRead)
+			}
+		} else if header.dataSize != 0 {
+			if _, err := l.stringsAt.ReadAt(make([]byte, 1), int64(header.dataSize)-1); err != nil {
+				return retErrStr("File ended early", prevBytesRead)
+			}
+		}
+		r = io.NewSectionReader(stringsAt, int64(numBytesRead), math.MaxInt64) //Continue reading after the strings
+	}
+
+	//Confirm the checksum, which covers the rest of the file
+	if header.hasChecksum() {
+		expectedChecksum := checksum.Sum32()
+		var storedChecksum [size_checksum]byte
+		if err := readBytes(storedChecksum[:]); err != nil {
+			return retErr(err, prevBytesRead)
+		} else if binary.LittleEndian.Uint32(storedChecksum[:]) != expectedChecksum {
+			return retErrStr("Checksum does not match, so the file is corrupt", prevBytesRead)
+		}
 	}
 
 	//Make sure we are at the end of the file
@@ -523,42 +611,132 @@ func (l *Language) fromCompiledFile(r io.Reader, dict *languageDict) error {
 		return retErrStr(fmt.Sprintf("End of file not reached (%d!=%d)", numBytesRead, header.getCompiledFileSize()), numBytesRead)
 	}
 
+	//Decrypt the strings
+	if sealedStrings != nil {
+		if stringsData, err := decryption.Open(l.stringsData[:0], sealedStrings[:size_encryptionNonce], sealedStrings[size_encryptionNonce:], header.fileBytes()); err != nil || ulen32(stringsData) != header.dataSize {
+			return retErr(ErrWrongDecryptionKey, sealedStringsLoc)
+		}
+	}
+
 	//Return success
 	return nil
 }
 
+// Parses the settings section of a compiled language file. errOffset is the location of the error within the settings section
+func parseCompiledSettings(settingsStr []byte) (settingsValues []string, languageTag language.Tag, calendar calendarType, err error, errOffset uint32) {
+	const numSettings = 9
+	const minNumSettings = 4 //Files compiled before NumberingSystem and Calendar were added only have 4 settings. Older files may also be missing the omitted namespaces, CLDR version, and registers
+	const settingLenSize = uint(unsafe.Sizeof(uint16(0)))
+	settingsValues = make([]string, numSettings)
+	byteLoc := uint(0)
+	for i := uint(0); i < numSettings; i++ {
+		//Optional settings may not exist
+		if i >= minNumSettings && byteLoc == ulen(settingsStr) {
+			break
+		}
+
+		//Get the settings string value
+		if byteLoc+settingLenSize > ulen(settingsStr) {
+			return nil, languageTag, calendar, errors.New("invalid settings length"), uint32(byteLoc)
+		}
+		strLen := uint(binary.LittleEndian.Uint16(settingsStr[byteLoc:]))
+		byteLoc += settingLenSize
+		if byteLoc+strLen > ulen(settingsStr) {
+			return nil, languageTag, calendar, errors.New("invalid string length"), uint32(byteLoc)
+		}
+		settingsValues[i] = string(settingsStr[byteLoc : byteLoc+strLen])
+		byteLoc += strLen
+
+		switch i {
+		//Handle a language tag
+		case 1:
+			if _languageTag, err := language.Parse(settingsValues[i]); err != nil {
+				return nil, languageTag, calendar, errors.New("Invalid language tag: " + settingsValues[i]), uint32(byteLoc - strLen)
+			} else {
+				languageTag = _languageTag
+			}
+		//Handle the numbering system
+		case 4:
+			if err := checkNumberingSystem(settingsValues[i]); err != nil {
+				return nil, languageTag, calendar, err, uint32(byteLoc - strLen)
+			}
+		//Handle the calendar
+		case 5:
+			if _calendar, err := getCalendarType(settingsValues[i]); err != nil {
+				return nil, languageTag, calendar, err, uint32(byteLoc - strLen)
+			} else {
+				calendar = _calendar
+			}
+		}
+	}
+
+	//Make sure byteLoc matches len(settingsStr)
+	if byteLoc != ulen(settingsStr) {
+		return nil, languageTag, calendar, fmt.Errorf("Settings length not completely consumed (%d!=%d)", byteLoc, len(settingsStr)), uint32(byteLoc)
+	}
+
+	return settingsValues, languageTag, calendar, nil, 0
+}
+
 // Reads binary data into a slice and checks its data against known buffer lengths
 func readDataToStruct[
-	readType storeTranslationRule16 | storeTranslationRule32 | storeTranslationRuleSlice | storeTranslationIDSize | storeNamespace,
+	readType storeTranslationRule16 | storeTranslationRule32 | storeTranslationRuleWide | storeTranslationRuleSlice | storeTranslationIDSize | storeNamespace,
+	readTypePointer interface {
+		*readType
+		binaryStruct
+	},
 ](
 	numToReadIntoSlice uint32, readTypeName string, //Info for writing to slice
-	tempBuff []byte, expectedReadLen uint32, readBytes func([]byte) error, //Info for reading from buffer
-	hasExtraValAtEnd bool, extraDataToReadSize uint32, //Extra control variables
+	expectedReadLen uint32, readBytes func([]byte) error, //Info for reading
+	hasExtraValAtEnd bool, //Extra control variables
 	storeStruct func(pos uint32, readFrom *readType, accum uint32), //Callback to store the read data
 ) (Error error, ErrorLocationOffset uint32) {
-	//Read the bytes into the buffer
+	//Create the buffer that the structs are read into, a chunk at a time
 	var tempReadStruct readType
-	if err := readBytes(tempBuff[0 : uint32(unsafe.Sizeof(tempReadStruct))*numToReadIntoSlice+extraDataToReadSize]); err != nil {
-		return fmt.Errorf("Read error [%s] %s", readTypeName, err), 0
+	structSize := readTypePointer(&tempReadStruct).binarySize()
+	chunkLen := min(numToReadIntoSlice, max(readChunkSize/structSize, 1))
+	if chunkLen == 0 {
+		chunkLen = 1 //So the buffer can be converted into a typed slice
+	}
+	tempBuff := make([]byte, structSize*chunkLen)
+	var decoded []readType //The structs are decoded into this when they cannot be read directly (see useDirectLayout)
+	if !useDirectLayout {
+		decoded = make([]readType, chunkLen)
 	}
 
-	//Process the buffer, converted into a typed slice
 	var accum uint32 = 0
-	//goland:noinspection GoRedundantConversion
-	for i, v := range unsafe.Slice((*readType)(unsafe.Pointer(&tempBuff[0])), numToReadIntoSlice) {
-		//Confirm end position is within range
-		EndPos := accum + any(v).(getLength).getLength()
-		if EndPos > expectedReadLen {
-			return fmt.Errorf(
-					"Length of accumulated [%s] data read (%d) at index (%d) has exceeded given data length (%d)",
-					readTypeName, EndPos, i, expectedReadLen,
-				),
-				uint32(i) * uint32(unsafe.Sizeof(tempReadStruct))
+	for chunkStart := uint32(0); chunkStart < numToReadIntoSlice; chunkStart += chunkLen {
+		//Read the chunk into the buffer
+		numInChunk := min(chunkLen, numToReadIntoSlice-chunkStart)
+		if err := readBytes(tempBuff[0 : structSize*numInChunk]); err != nil {
+			return fmt.Errorf("Read error [%s] %s", readTypeName, err), chunkStart * structSize
 		}
 
-		//Store the struct
-		storeStruct(uint32(i), &v, accum)
-		accum = EndPos
+		//Process the buffer, converted into a typed slice
+		var chunk []readType
+		if useDirectLayout {
+			//goland:noinspection GoRedundantConversion
+			chunk = unsafe.Slice((*readType)(unsafe.Pointer(&tempBuff[0])), numInChunk)
+		} else {
+			chunk = decoded[0:numInChunk]
+			decodeStructs[readType, readTypePointer](chunk, tempBuff)
+		}
+		for localIndex, v := range chunk {
+			//Confirm end position is within range
+			i := chunkStart + uint32(localIndex)
+			EndPos := accum + any(v).(getLength).getLength()
+			if EndPos > expectedReadLen {
+				return fmt.Errorf(
+						"Length of accumulated [%s] data read (%d) at index (%d) has exceeded given data length (%d)",
+						readTypeName, EndPos, i, expectedReadLen,
+					),
+					i * structSize
+			}
+
+			//Store the struct
+			storeStruct(i, &v, accum)
+			accum = EndPos
+		}
 	}
 
 	//Make sure the expectedReadLen was properly reached
@@ -566,7 +744,7 @@ func readDataToStruct[
 		return fmt.Errorf(
 			"Length of accumulated [%s] data read (%d) did not reach the end (%d)",
 			readTypeName, accum, expectedReadLen,
-		), numToReadIntoSlice * uint32(unsafe.Sizeof(tempReadStruct))
+		), numToReadIntoSlice * structSize
 	}
 
 	//If there is an extra value at the end, store it
@@ -576,3 +754,12 @@ func readDataToStruct[
 
 	return nil, 0
 }
+
+// Reads exactly enough bytes to fill the buffer. Readers can legally return fewer bytes than requested from a single Read(), so this keeps reading until the buffer is full or the reader ends
+func readFull(r io.Reader, b []byte) error {
+	if _, err := io.ReadFull(r, b); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.New("File ended early")
+	} else {
+		return err
+	}
+}
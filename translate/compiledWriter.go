@@ -4,15 +4,18 @@
 package translate
 
 import (
+	"crypto/cipher"
+	"crypto/rand"
 	"crypto/sha1"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"hash"
+	"hash/crc32"
 	"io"
 	"math"
 	"os"
 	"strings"
-	"unsafe"
 )
 
 func (dict *languageDict) toCompiledFile(_w io.Writer) error {
@@ -35,8 +38,8 @@ func (dict *languageDict) toCompiledFile(_w io.Writer) error {
 				translationIDs[index] = name
 				translationIDSizes[index] = storeTranslationIDSize{uint16(len(name))}
 			}
-			*p2uint32p(&writeNamespaces[n.index]) = ulen32m(n.ids) & 0xFFFFFF //3 byte uint
-			writeNamespaces[n.index].nameSize = uint8(len(n.name))
+			numIDs := ulen32m(n.ids)
+			writeNamespaces[n.index] = storeNamespace{[3]byte{uint8(numIDs), uint8(numIDs >> 8), uint8(numIDs >> 16)}, uint8(len(n.name))} //3 byte uint
 		}
 		const joinWithNoSeparator = ""
 		namespaceNamesString = strings.Join(namespaceNames, joinWithNoSeparator)
@@ -45,7 +48,8 @@ func (dict *languageDict) toCompiledFile(_w io.Writer) error {
 
 	//Prepare the header for writing
 	header := storeDictHeader{
-		[3]byte(s2b("DTR")),
+		[2]byte(s2b("DT")),
+		CompiledFormatVersion,
 		uint32(numTranslations),
 		ulen32m(dict.namespaces),
 		ulen32s(translationIDsString),
@@ -64,14 +68,21 @@ func (dict *languageDict) toCompiledFile(_w io.Writer) error {
 	}
 
 	//Write out the parts of the file
-	w := &countedHashedWriter{countedWriter{0, _w}, sha1.New(), dict.hash == nil}
-	if err := writeDataToFile(w, &header, 1); err != nil { //Write the header
+	w := &countedHashedWriter{countedWriter{0, _w}, sha1.New(), false}
+	if err := writeDataToFile(w, &header); err != nil { //Write the header
 		return err
-	} else if err := writeDataToFile(w, &translationIDSizes[0], header.numTranslations); err != nil { //Write out the translation id sizes
+	}
+	if dict.hash == nil { //The format version is not part of the hash, so it is hashed as a legacy header
+		hashHeader := header
+		hashHeader.formatVersion = legacyFormatVersionByte
+		w.h.Write(encodeStruct(&hashHeader))
+		w.calculateHash = true
+	}
+	if err := writeSliceToFile(w, translationIDSizes); err != nil { //Write out the translation id sizes
 		return err
 	} else if err := writeBytesToFile(w, s2b(translationIDsString)); err != nil { //Write out the translation ids
 		return err
-	} else if err := writeDataToFile(w, &writeNamespaces[0], header.numNamespaces); err != nil { //Write out the namespaces
+	} else if err := writeSliceToFile(w, writeNamespaces); err != nil { //Write out the namespaces
 		return err
 	} else if err := writeBytesToFile(w, s2b(namespaceNamesString)); err != nil { //Write out the namespace names
 		return err
@@ -93,15 +104,16 @@ func (dict *languageDict) toCompiledFile(_w io.Writer) error {
 
 func (dict *languageDict) toCompiledVarFile(w io.Writer) error {
 	//Can only write out variables if we actually have them
-	if !dict.hasVarsLoaded {
+	if dict.loadVars() != nil {
 		return errors.New("Can only write variable dictionary if the given dictionary has the variables")
 	}
 
 	//Create this as a bytes buffer for simplicity
 	var b strings.Builder
 
-	//Compiled var files are companions to the compiled dictionary files, so they will have no headers beyond the 3 byte file definition
-	b.WriteString("VTR")
+	//Compiled var files are companions to the compiled dictionary files, so they will have no headers beyond the 3 byte file definition (which ends with the format version)
+	b.WriteString("VT")
+	b.WriteByte(CompiledFormatVersion)
 
 	//Loop through namespace and translations and output variables
 	for _, namespaceName := range dict.namespacesInOrder {
@@ -115,6 +127,18 @@ func (dict *languageDict) toCompiledVarFile(w io.Writer) error {
 		}
 	}
 
+	//Namespace metadata follows the variables. Each property is stored as: nameLen(uint8), valueLen(uint16), name, value
+	for _, namespaceName := range dict.namespacesInOrder {
+		props := dict.namespaces[namespaceName].metadata.getProperties()
+		b.WriteByte(uint8(len(props)))
+		for _, prop := range props {
+			b.WriteByte(uint8(len(prop[0])))
+			b.Write([]byte{uint8(len(prop[1])), uint8(len(prop[1]) >> 8)})
+			b.WriteString(prop[0])
+			b.WriteString(prop[1])
+		}
+	}
+
 	//Write out the result and return errors
 	if n, err := w.Write(s2b(b.String())); err != nil {
 		return fmt.Errorf("Failed to write %d bytes: %s", b.Len(), err.Error())
@@ -126,25 +150,17 @@ func (dict *languageDict) toCompiledVarFile(w io.Writer) error {
 	return nil
 }
 
-func (l *Language) toCompiledFile(_w io.Writer) error {
-	//Check if any translation strings are larger than 64k
-	translationStringByteLength := uint8(size_storeTranslationRule16)
-	for i, r := range l.rules {
-		if i < len(l.rules)-1 && l.rules[i+1].startPos-r.startPos > math.MaxUint16 {
-			translationStringByteLength = uint8(size_storeTranslationRule32)
-			break
-		}
-	}
-
+func (l *Language) toCompiledFile(_w io.Writer, encryption cipher.AEAD) error {
 	//Prepare the header for writing
 	settingsString := l.getSettingsAsString()
 	header := storeHeader{
-		[3]byte(s2b("GTR")),
-		translationStringByteLength,
+		[2]byte(s2b(cond(encryption != nil, "GE", "GT"))),
+		CompiledFormatVersion,
+		uint8(l.getTranslationStringByteLength()),
 		ulen32(l.rules) - 1,
 		l.NumTranslations(),
 		ulen32(settingsString),
-		ulen32(l.stringsData),
+		l.stringsSize(),
 		[20]byte(l.dict.hash),
 	}
 
@@ -159,31 +175,41 @@ func (l *Language) toCompiledFile(_w io.Writer) error {
 		}
 	}
 
-	//Write out the header and settings
-	w := &countedWriter{0, _w}
-	if err := writeDataToFile(w, &header, 1); err != nil {
+	//Write out the header and settings. Everything is included in the checksum
+	w := &countedHashedWriter{countedWriter{0, _w}, crc32.New(checksumTable), true}
+	if err := writeDataToFile(w, &header); err != nil {
 		return err
 	} else if err := writeBytesToFile(w, settingsString); err != nil {
 		return err
 	}
 
 	//Write out the rules
-	if header.translationStringByteLength == uint8(size_storeTranslationRule16) {
+	switch uint32(header.translationStringByteLength) {
+	case size_storeTranslationRule16:
 		writeRules := make([]storeTranslationRule16, header.numRules)
 		for i := uint(0); i < uint(header.numRules); i++ {
 			v := l.rules[i]
-			writeRules[i] = storeTranslationRule16{uint16(l.rules[i+1].startPos - v.startPos), v.rule}
+			writeRules[i] = storeTranslationRule16{uint16(l.rules[i+1].startPos - v.startPos), v.rule.to8()}
 		}
-		if err := writeDataToFile(w, &writeRules[0], header.numRules); err != nil {
+		if err := writeSliceToFile(w, writeRules); err != nil {
 			return err
 		}
-	} else {
+	case size_storeTranslationRule32:
 		writeRules := make([]storeTranslationRule32, header.numRules)
 		for i := uint(0); i < uint(header.numRules); i++ {
 			v := l.rules[i]
-			writeRules[i] = storeTranslationRule32{l.rules[i+1].startPos - v.startPos, v.rule}
+			writeRules[i] = storeTranslationRule32{l.rules[i+1].startPos - v.startPos, v.rule.to8()}
+		}
+		if err := writeSliceToFile(w, writeRules); err != nil {
+			return err
+		}
+	default:
+		writeRules := make([]storeTranslationRuleWide, header.numRules)
+		for i := uint(0); i < uint(header.numRules); i++ {
+			v := l.rules[i]
+			writeRules[i] = storeTranslationRuleWide{l.rules[i+1].startPos - v.startPos, v.rule}
 		}
-		if err := writeDataToFile(w, &writeRules[0], header.numRules); err != nil {
+		if err := writeSliceToFile(w, writeRules); err != nil {
 			return err
 		}
 	}
@@ -193,12 +219,26 @@ func (l *Language) toCompiledFile(_w io.Writer) error {
 	for i := uint(0); i < uint(header.numTranslations); i++ {
 		writeRuleSlices[i] = storeTranslationRuleSlice{uint8(l.translations[i+1].startIndex - l.translations[i].startIndex)}
 	}
-	if err := writeDataToFile(w, &writeRuleSlices[0], header.numTranslations); err != nil {
+	if err := writeSliceToFile(w, writeRuleSlices); err != nil {
 		return err
 	}
 
-	//Write stringsData
-	if err := writeBytesToFile(w, l.stringsData); err != nil {
+	//Write stringsData, which is sealed when encrypting
+	if stringsData, err := l.readStrings(0, l.stringsSize()); err != nil {
+		return err
+	} else if encryption != nil {
+		nonce := make([]byte, size_encryptionNonce, uint(size_encryptionOverhead)+uint(len(stringsData)))
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("Could not create encryption nonce: %s", err.Error())
+		} else if err := writeBytesToFile(w, encryption.Seal(nonce, nonce, stringsData, header.fileBytes())); err != nil {
+			return err
+		}
+	} else if err := writeBytesToFile(w, stringsData); err != nil {
+		return err
+	}
+
+	//Write out the checksum
+	if err := writeBytesToFile(w, binary.LittleEndian.AppendUint32(nil, w.h.(hash.Hash32).Sum32())); err != nil {
 		return err
 	}
 
@@ -210,12 +250,26 @@ func (l *Language) toCompiledFile(_w io.Writer) error {
 	return nil
 }
 
+// Returns the size of the smallest storeTranslationRule struct that can hold all of the language’s rules. storeTranslationRule32 is needed for translation strings larger than 64k, and storeTranslationRuleWide for rule numbers larger than 255
+func (l *Language) getTranslationStringByteLength() uint32 {
+	translationStringByteLength := size_storeTranslationRule16
+	for i, r := range l.rules {
+		if r.rule.isWide() {
+			return size_storeTranslationRuleWide
+		} else if i < len(l.rules)-1 && l.rules[i+1].startPos-r.startPos > math.MaxUint16 {
+			translationStringByteLength = size_storeTranslationRule32
+		}
+	}
+	return translationStringByteLength
+}
+
 func (l *Language) getSettingsAsString() []byte {
 	//Determine the total length
 	settingStrings := []string{
-		l.name, l.languageIdentifier, l.fallbackName, l.missingPluralRule,
+		l.name, l.languageIdentifier, l.fallbackName, l.missingPluralRule, l.numberingSystem, cond(l.calendar == calGregorian, returnBlankStrOnErr, calendarNames[l.calendar]),
+		strings.Join(l.omittedNamespaces, ","), l.cldrVersion, strings.Join(l.registers, ","),
 	}
-	totalSize := ulen(settingStrings) * uint(unsafe.Sizeof(uint16(0)))
+	totalSize := ulen(settingStrings) * 2
 	for _, s := range settingStrings {
 		totalSize += ulens(s)
 	}
@@ -223,8 +277,7 @@ func (l *Language) getSettingsAsString() []byte {
 	//Compile the string
 	str := make([]byte, 0, totalSize)
 	for _, s := range settingStrings {
-		strSize := uint16(len(s))
-		str = append(str, any2b(&strSize)...)
+		str = binary.LittleEndian.AppendUint16(str, uint16(len(s)))
 		str = append(str, s2b(s)...)
 	}
 
@@ -232,10 +285,35 @@ func (l *Language) getSettingsAsString() []byte {
 }
 
 // -----------------------Write structured data to the file----------------------
+// Writes a structure encoded in little-endian byte order (see binaryStruct)
 func writeDataToFile[
-	writeType storeHeader | storeDictHeader | storeTranslationRule16 | storeTranslationRule32 | storeTranslationRuleSlice | storeTranslationIDSize | storeNamespace,
-](w io.Writer, data *writeType, numToWrite uint32) error {
-	return writeBytesToFile(w, any2bLen(data, uint(numToWrite)))
+	writeType storeHeader | storeDictHeader,
+	writeTypePointer interface {
+		*writeType
+		binaryStruct
+	},
+](w io.Writer, data *writeType) error {
+	return writeBytesToFile(w, encodeStruct[writeType, writeTypePointer](data))
+}
+
+// Writes structures encoded in little-endian byte order (see binaryStruct), a chunk at a time
+func writeSliceToFile[
+	writeType storeTranslationRule16 | storeTranslationRule32 | storeTranslationRuleWide | storeTranslationRuleSlice | storeTranslationIDSize | storeNamespace,
+	writeTypePointer interface {
+		*writeType
+		binaryStruct
+	},
+](w io.Writer, data []writeType) error {
+	if len(data) == 0 {
+		return nil
+	}
+	chunkLen := max(readChunkSize/int(writeTypePointer(&data[0]).binarySize()), 1)
+	for chunkStart := 0; chunkStart < len(data); chunkStart += chunkLen {
+		if err := writeBytesToFile(w, encodeStructs[writeType, writeTypePointer](data[chunkStart:min(chunkStart+chunkLen, len(data))])); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 func writeBytesToFile(w io.Writer, b []byte) error {
 	if _, err := w.Write(b); err != nil {
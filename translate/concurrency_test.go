@@ -0,0 +1,166 @@
+//Tests of the concurrency contract of languages (run them with -race)
+//go:build !gol10n_read_compiled_only && !gol10n_minimal
+
+package translate
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const concurrencyTestDefaultYAML = `Settings:
+  LanguageName: English
+  LanguageIdentifier: en-US
+  MissingPluralRule: Missing
+NS:
+  Plain: plain
+  Count:
+    =1: "one {{.Num}}"
+    ^: "{{.PluralCount}} many {{.Num}}"
+    Num: FloatWithSymbols
+  When:
+    ^: "at {{.When!%c}}"
+    When: DateTime
+  OnlyDefault: from default
+`
+
+const concurrencyTestFrenchYAML = `Settings:
+  LanguageName: French
+  LanguageIdentifier: fr-FR
+  FallbackLanguage: en-US
+  MissingPluralRule: Manquant
+NS:
+  Plain: simple
+  Count:
+    =1: "un {{.Num}}"
+    ^: "{{.PluralCount}} plusieurs {{.Num}}"
+    Num: FloatWithSymbols
+  When:
+    ^: "à {{.When!%c}}"
+    When: DateTime
+`
+
+// The number of goroutines each test runs at once
+const concurrencyTestGoroutines = 32
+
+// Loads the default language and a French (France) language whose fallback is the default language, through their own dictionary
+func loadConcurrencyTestLanguages(t *testing.T) (en, fr *Language, indexes map[string]TransIndex) {
+	d := NewDictionary()
+	en, _, err := d.LoadDefaultText(LF_YAML, strings.NewReader(concurrencyTestDefaultYAML), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fr, _, err = d.LoadText(LF_YAML, strings.NewReader(concurrencyTestFrenchYAML), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := fr.SetFallback(en); err != nil {
+		t.Fatal(err)
+	}
+	indexes = make(map[string]TransIndex)
+	for name, index := range en.dict.namespaces["NS"].ids {
+		indexes[name] = index
+	}
+	return en, fr, indexes
+}
+
+// Runs f from many goroutines at once and waits for them to finish
+func runConcurrently(f func(goroutineIndex int)) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < concurrencyTestGoroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			f(i)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+}
+
+// Get functions (including through fallbacks) and the lazily created formatters they use
+func TestConcurrentGet(t *testing.T) {
+	en, fr, indexes := loadConcurrencyTestLanguages(t)
+	when := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	runConcurrently(func(i int) {
+		for _, l := range []*Language{en, fr} {
+			if _, err := l.Get(indexes["Plain"]); err != nil {
+				t.Error(err)
+			}
+			if _, err := l.GetPlural(indexes["Count"], uint(i), 1234.5); err != nil {
+				t.Error(err)
+			}
+			if _, err := l.Get(indexes["When"], when); err != nil {
+				t.Error(err)
+			}
+			_ = l.MessagePrinter().Sprintf("%d", i)
+			if _, err := l.TimeLocalizer(); err != nil {
+				t.Error(err)
+			}
+		}
+
+		//The French language falls back to the default language
+		if str, err := fr.Get(indexes["OnlyDefault"]); err != nil || str != "from default" {
+			t.Errorf("Fallback returned %q, %v", str, err)
+		}
+	})
+}
+
+// Languages created through With() share the formatters of their language
+func TestConcurrentWith(t *testing.T) {
+	en, fr, indexes := loadConcurrencyTestLanguages(t)
+	runConcurrently(func(i int) {
+		for _, l := range []*Language{en, fr} {
+			withL := l.With(WithStrictPluralCounts(i%2 == 0), WithCashRounding(i%3 == 0))
+			if _, err := withL.GetPlural(indexes["Count"], uint(i), float64(i)); err != nil {
+				t.Error(err)
+			}
+			_ = withL.MessagePrinter()
+		}
+	})
+}
+
+// Warming up a bundle while it is in use
+func TestConcurrentBundleWarmup(t *testing.T) {
+	en, fr, indexes := loadConcurrencyTestLanguages(t)
+	b := Bundle{"en-US": en, "fr-FR": fr}
+	runConcurrently(func(i int) {
+		if i%2 == 0 {
+			if err := b.Warmup(context.Background(), true); err != nil {
+				t.Error(err)
+			}
+		} else if _, err := b.GetAllPlural(indexes["Count"], uint(i), 1.5); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// Renders through a shared render cache, while its functions are called
+func TestConcurrentRenderCache(t *testing.T) {
+	en, fr, indexes := loadConcurrencyTestLanguages(t)
+	c := NewRenderCache(8)
+	cachedEn, cachedFr := en.With(), fr.With()
+	cachedEn.CacheRenders(c)
+	cachedFr.CacheRenders(c)
+	runConcurrently(func(i int) {
+		for j := 0; j < 20; j++ {
+			for _, l := range []*Language{cachedEn, cachedFr} {
+				if _, err := l.GetPlural(indexes["Count"], uint(j), float64(i%4)); err != nil {
+					t.Error(err)
+				}
+			}
+		}
+		switch i % 4 {
+		case 0:
+			_ = c.Stats()
+		case 1:
+			c.SetMaxEntries(4 + i%8)
+		case 2:
+			c.Clear()
+		}
+	})
+}
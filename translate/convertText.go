@@ -0,0 +1,36 @@
+//Convert translation text files between file types
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"io"
+)
+
+// Convert reads a translation text file and returns it written as another file type, in the canonical layout of that file type (see Format()). The Settings object (LanguageIdentifier, LanguageName, FallbackLanguage, MissingPluralRule, etc.) and the namespaces are carried over, and the result is confirmed to read back to the exact same content.
+//
+// Files of any type (including custom formats) can be converted. An i18next JSON file is restructured as it is when loaded, and its Settings.MessageFormat is set to i18next so its translations keep their meaning. TOML files are written with a table for each top level object (Settings and the namespaces), and inline tables for the Translation IDs. i18next JSON and custom format files cannot be written. Comments are not kept
+func (lf LanguageTextFile) Convert(r io.Reader, to LanguageTextFile) ([]byte, error) {
+	//Confirm the file types
+	if !to.CanBeWritten() && to != LF_TOML {
+		return nil, errors.New("Files can only be converted to YAML, JSON, or TOML")
+	}
+
+	//Read the file and confirm its settings
+	topItem, err := lf.readTopItem(r)
+	if err != nil {
+		return nil, err
+	}
+	if topObj, ok := topItem.getObject(); !ok {
+		return nil, errors.New("Top level item is not an object")
+	} else if settingsItem, ok := topObj.getValue("Settings"); !ok {
+		return nil, errors.New("Settings section is missing")
+	} else if settingsObj, ok := settingsItem.getObject(); !ok {
+		return nil, errors.New("Settings is not an object")
+	} else if _, err := getSetting(settingsObj, "LanguageIdentifier"); err != nil {
+		return nil, err
+	}
+
+	return to.formatTopItem(topItem)
+}
@@ -0,0 +1,89 @@
+//Tests of converting translation text files between file types
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"testing"
+)
+
+// A translation text file with all the settings that must survive a conversion, and keys and values that need quoting
+const convertTestYAML = `Settings:
+    LanguageName: Français
+    LanguageIdentifier: fr-CA
+    FallbackLanguage: fr-FR
+    MissingPluralRule: "Règle manquante"
+NS:
+    Plain: Bonjour
+    Books:
+        Num: Spellout
+        =1: "Un livre"
+        ">max": "Trop de livres"
+        ^: "{{.Num}} livres"
+    Dotted.Key: "Ligne 1\nLigne 2\t\"citée\""
+    Empty: {}
+Autre espace:
+    _Metadata:
+        Owner: équipe
+    Élément: "x = 1"
+`
+
+func TestConvertKeepsSettings(t *testing.T) {
+	//Convert through every file type and back to YAML
+	text := []byte(convertTestYAML)
+	from := LF_YAML
+	for _, to := range []LanguageTextFile{LF_JSON, LF_TOML, LF_YAML, LF_TOML, LF_JSON, LF_YAML} {
+		converted, err := from.Convert(bytes.NewReader(text), to)
+		if err != nil {
+			t.Fatalf("%d to %d: %v", from, to, err)
+		}
+
+		//Confirm the settings
+		topItem, err := to.readTopItem(bytes.NewReader(converted))
+		if err != nil {
+			t.Fatalf("%d: %v\n%s", to, err, converted)
+		}
+		topObj, _ := topItem.getObject()
+		settingsItem, _ := topObj.getValue("Settings")
+		settingsObj, _ := settingsItem.getObject()
+		for name, expected := range map[string]string{"LanguageName": "Français", "LanguageIdentifier": "fr-CA", "FallbackLanguage": "fr-FR", "MissingPluralRule": "Règle manquante"} {
+			if val, err := getSetting(settingsObj, name); err != nil || val != expected {
+				t.Errorf("%d: Settings.%s = %q, %v; expected %q", to, name, val, err, expected)
+			}
+		}
+
+		text, from = converted, to
+	}
+
+	//The round trip matches the canonical layout of the original
+	if formatted, err := LF_YAML.Format(bytes.NewReader([]byte(convertTestYAML))); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(formatted, text) {
+		t.Errorf("Round trip does not match the original:\n%s\nExpected:\n%s", text, formatted)
+	}
+}
+
+func TestConvertToTOML(t *testing.T) {
+	const expected = `[Settings]
+LanguageName = "Français"
+LanguageIdentifier = "fr-CA"
+FallbackLanguage = "fr-FR"
+MissingPluralRule = "Règle manquante"
+
+[NS]
+Plain = "Bonjour"
+Books = { Num = "Spellout", "=1" = "Un livre", ">max" = "Trop de livres", "^" = "{{.Num}} livres" }
+"Dotted.Key" = "Ligne 1\nLigne 2\t\"citée\""
+Empty = {}
+
+["Autre espace"]
+_Metadata = { Owner = "équipe" }
+"Élément" = "x = 1"
+`
+	if converted, err := LF_YAML.Convert(bytes.NewReader([]byte(convertTestYAML)), LF_TOML); err != nil {
+		t.Fatal(err)
+	} else if string(converted) != expected {
+		t.Errorf("Converted to:\n%s\nExpected:\n%s", converted, expected)
+	}
+}
@@ -0,0 +1,37 @@
+//Translation coverage of languages
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+// NamespaceCoverage is the translation coverage of a namespace in a language (see Language.NamespaceCoverage())
+type NamespaceCoverage struct {
+	Name            string
+	NumTranslations uint32
+	NumTranslated   uint32   //The number of translations the language has its own rules for, instead of getting them from its fallback languages
+	Missing         []string //The Translation IDs the language gets from its fallback languages, in TransIndex order
+}
+
+// NamespaceCoverage returns the translation coverage of each namespace in the dictionary, in order (see NumTranslated()). Omitted namespaces are included, with all of their Translation IDs missing
+func (l *Language) NamespaceCoverage() []NamespaceCoverage {
+	namespaces := l.Namespaces()
+	ret := make([]NamespaceCoverage, len(namespaces))
+	for i, info := range namespaces {
+		//Get the Translation IDs in TransIndex order
+		names := make([]string, info.NumTranslationIDs)
+		for name, index := range l.dict.namespaces[info.Name].ids {
+			names[index-info.FirstIndex] = name
+		}
+
+		//Count the translated Translation IDs
+		c := &ret[i]
+		c.Name, c.NumTranslations = info.Name, info.NumTranslationIDs
+		for j, name := range names {
+			if index := info.FirstIndex + TransIndex(j); l.translations[index+1].startIndex != l.translations[index].startIndex {
+				c.NumTranslated++
+			} else {
+				c.Missing = append(c.Missing, name)
+			}
+		}
+	}
+	return ret
+}
@@ -6,8 +6,12 @@ package translate
 import (
 	"errors"
 	"math"
+	"strings"
 )
 
+// The keyword of the overflow bucket rule (“>max”), which matches counts above the largest number a rule can compare against
+const pluralRuleOverflowKeyword = "max"
+
 func createPluralRule(s string) (pluralRule, error) {
 	//Consume whitespace
 	index := 0
@@ -53,21 +57,26 @@ func createPluralRule(s string) (pluralRule, error) {
 		consumeWhitespace()
 	}
 
+	//Handle the overflow bucket
+	if myOp == cmpGreater && strings.TrimRight(s[index:], " ") == pluralRuleOverflowKeyword {
+		return pluralRule{cmpGreater, math.MaxUint32}, nil
+	}
+
 	//Make sure the operator is followed by an appropriate number
-	const maxUint8Digits, base10Shift = 3, 10
-	getNum := func() (uint16, uint) {
-		numFound := uint16(0)
+	const maxUint32Digits, base10Shift = 10, 10
+	getNum := func() (uint64, uint) {
+		numFound := uint64(0)
 		digitNumsConsumed := uint(0)
-		for index < strLen && s[index] >= '0' && s[index] <= '9' && digitNumsConsumed < maxUint8Digits {
-			numFound = numFound*base10Shift + uint16(s[index]-'0')
+		for index < strLen && s[index] >= '0' && s[index] <= '9' && digitNumsConsumed < maxUint32Digits {
+			numFound = numFound*base10Shift + uint64(s[index]-'0')
 			digitNumsConsumed++
 			index++
 		}
 		return numFound, digitNumsConsumed
 	}
 	_numFound, _digitNumsConsumed := getNum()
-	if _digitNumsConsumed == 0 || _numFound > math.MaxUint8 {
-		return pluralRule{}, errors.New("Operator must be followed by a number between 0 and 255")
+	if _digitNumsConsumed == 0 || _numFound > math.MaxUint32 {
+		return pluralRule{}, errors.New("Operator must be followed by a number between 0 and 4294967295")
 	}
 
 	//Handle unary operators
@@ -76,7 +85,7 @@ func createPluralRule(s string) (pluralRule, error) {
 		if index < strLen {
 			return pluralRule{}, errors.New("Nothing can follow the number")
 		}
-		return pluralRule{myOp, uint8(_numFound)}, nil
+		return pluralRule{myOp, uint32(_numFound)}, nil
 	}
 
 	//Handle dual operators (cmpBetween)
@@ -87,9 +96,11 @@ func createPluralRule(s string) (pluralRule, error) {
 	consumeWhitespace()
 	_numFound2, _digitNumsConsumed2 := getNum()
 	const maxBetweenDiff, halfBetweenDiff = 64 - 1, 64 / 2
-	numFoundDiff := int(_numFound2) - int(_numFound)
+	numFoundDiff := int64(_numFound2) - int64(_numFound)
 	if _digitNumsConsumed2 == 0 || numFoundDiff < 0 || numFoundDiff > maxBetweenDiff {
 		return pluralRule{}, errors.New("The second number of the ~ operator must be followed by a number between 0-63 plus the first number")
+	} else if _numFound2 > math.MaxUint32 {
+		return pluralRule{}, errors.New("The second number of the ~ operator must be between 0 and 4294967295")
 	}
 
 	//Finish dual operators
@@ -101,5 +112,5 @@ func createPluralRule(s string) (pluralRule, error) {
 	if numFoundDiff >= halfBetweenDiff {
 		isAboveHalf = 1
 	}
-	return pluralRule{cmpOp(uint8(myOp) + isAboveHalf + (uint8(numFoundDiff)-halfBetweenDiff*isAboveHalf)<<3), uint8(_numFound)}, nil
+	return pluralRule{cmpOp(uint8(myOp) + isAboveHalf + (uint8(numFoundDiff)-halfBetweenDiff*isAboveHalf)<<3), uint32(_numFound)}, nil
 }
@@ -0,0 +1,45 @@
+//Tests of parsing plurality rules
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCreatePluralRuleBounds(t *testing.T) {
+	//Both numbers of rules must fit in a uint32
+	for _, rule := range []string{"=4294967296", ">4294967296", "~4294967296-4294967297", "~4294967290-4294967296", "~4294967295-4294967358"} {
+		if r, err := createPluralRule(rule); err == nil {
+			t.Errorf("%s: Returned %+v but expected an error", rule, r)
+		}
+	}
+
+	//The largest bounds match up to math.MaxUint32, and not above it
+	for _, test := range []struct {
+		rule              string
+		matches, notMatch []uint64
+	}{
+		{"=4294967295", []uint64{math.MaxUint32}, []uint64{math.MaxUint32 - 1, math.MaxUint32 + 1}},
+		{"~4294967290-4294967295", []uint64{math.MaxUint32 - 5, math.MaxUint32}, []uint64{math.MaxUint32 - 6, math.MaxUint32 + 1}},
+		{"~4294967232-4294967295", []uint64{math.MaxUint32 - 63, math.MaxUint32}, []uint64{math.MaxUint32 - 64, math.MaxUint32 + 1}},
+		{"~0-63", []uint64{0, 63}, []uint64{64}},
+	} {
+		r, err := createPluralRule(test.rule)
+		if err != nil {
+			t.Errorf("%s: %v", test.rule, err)
+			continue
+		}
+		for _, count := range test.matches {
+			if !r.cmp(count) {
+				t.Errorf("%s: Does not match %d", test.rule, count)
+			}
+		}
+		for _, count := range test.notMatch {
+			if r.cmp(count) {
+				t.Errorf("%s: Matches %d", test.rule, count)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+//Validate DateTime specifiers at compile time
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"fmt"
+	"github.com/klauspost/lctime"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// The strftime directives (the character after the %) that the time localizer renders
+const lctimeDirectives = "aAbBcCdDeFgGHIjmMnprRStTuUVwWxXyYzZ%"
+
+// Checks the DateTime specifiers of a language against its time localizer. Only used during compilation so it is not stored in the language
+type dateTimeSpecifierPolicy struct {
+	localeID string
+	calendar calendarType
+	locale   lctimeLocale //The composite formats of the locale
+	locErr   error        //If the locale has no date/time data, which is only an error if a DateTime variable is used
+}
+
+func newDateTimeSpecifierPolicy(tag language.Tag, cal calendarType) *dateTimeSpecifierPolicy {
+	p := dateTimeSpecifierPolicy{localeID: strings.Replace(tag.String(), "-", "_", -1), calendar: cal}
+	_, p.locErr = lctime.NewLocalizer(p.localeID)
+	p.locale = lctimeLocales[p.localeID]
+	return &p
+}
+
+// Confirms the calendar at the start of a DateTime specifier (if given) is valid, and that every strftime directive can be rendered in the language’s locale and the calendar
+func (p *dateTimeSpecifierPolicy) check(specifier string) error {
+	//Get the calendar and the format
+	if err := checkCalendarSpecifier(specifier); err != nil {
+		return err
+	}
+	cal, format := p.calendar, specifier
+	if calendarName, _format, hasCalendar := splitCalendarSpecifier(specifier); hasCalendar {
+		cal, _ = getCalendarType(calendarName)
+		format = _format
+	}
+
+	//The locale must have date/time data
+	if p.locErr != nil {
+		return fmt.Errorf("Locale “%s” has no date/time data: %s", p.localeID, p.locErr.Error())
+	}
+
+	//Check the directives
+	var badDirectives []string
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		if i+1 >= len(format) {
+			return fmt.Errorf("DateTime specifier “%s” ends with a lone %%. Use %%%% for a percent sign", specifier)
+		}
+
+		//Localized time zone names are handled for all calendars
+		i++
+		directive := "%" + format[i:i+1]
+		if (format[i] == 'E' || format[i] == 'O') && i+1 < len(format) {
+			if format[i+1] == 'Z' {
+				i++
+				continue
+			}
+
+			//Other alternative representation modifiers are only dropped for non-Gregorian calendars
+			directive += format[i+1 : i+2]
+			if cal != calGregorian {
+				i++
+			}
+		}
+
+		//Month names in the calendar are only replaced for non-Gregorian calendars
+		switch {
+		case format[i] == 'h' && cal != calGregorian:
+		case strings.IndexByte(lctimeDirectives, format[i]) == -1 || len(directive) == 3 && cal == calGregorian:
+			badDirectives = append(badDirectives, fmt.Sprintf("%s is not supported", directive))
+		case format[i] == 'p' && !p.locale.hasAMPM:
+			badDirectives = append(badDirectives, fmt.Sprintf("%s has no AM/PM designators in locale “%s”", directive, p.localeID))
+		case format[i] == 'r' && len(p.locale.timeAMPM) == 0,
+			format[i] == 'c' && len(p.locale.dateTime) == 0,
+			format[i] == 'x' && len(p.locale.date) == 0,
+			format[i] == 'X' && len(p.locale.time) == 0:
+			badDirectives = append(badDirectives, fmt.Sprintf("%s has no format in locale “%s”", directive, p.localeID))
+		}
+	}
+
+	if len(badDirectives) != 0 {
+		return fmt.Errorf("DateTime specifier “%s”: %s", specifier, strings.Join(badDirectives, ", "))
+	}
+	return nil
+}
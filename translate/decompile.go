@@ -0,0 +1,103 @@
+//Reconstruct translation text files from compiled languages
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"strings"
+)
+
+// Decompile reconstructs the language’s translation text file as a Builder, which can be written with Builder.WriteText(). This recovers the sources of compiled files, or audits what was shipped. The dictionary’s variables must be loaded (through the default language translation text file or the compiled variable dictionary).
+//
+// Translations the language gets from its fallback languages are left out, and omitted namespaces are given the “Omit” MissingNamespaces policy. Namespace metadata is only included for the default language. The rules are written the same as DecompileTranslation(), so settings that are applied when compiling (like EscapeSequences, CustomEscapes, MessageFormat, and UnicodeNormalization) are not recovered, and the text is written with the default escape sequences. Overridden translations (see OverlayLanguage) are not included
+func (l *Language) Decompile() (*Builder, error) {
+	//The variable names are required
+	if err := l.dict.loadVars(); err != nil {
+		return nil, err
+	} else if !l.dict.hasVarsLoaded {
+		return nil, errors.New("The dictionary’s variables must be loaded to decompile")
+	}
+
+	//Create the settings
+	b := NewBuilder(l.languageIdentifier, l.name, l.missingPluralRule)
+	if len(l.fallbackName) != 0 {
+		b.Setting("FallbackLanguage", l.fallbackName)
+	}
+	if l.calendar != calGregorian {
+		b.Setting("Calendar", l.Calendar())
+	}
+	if len(l.numberingSystem) != 0 {
+		b.Setting("NumberingSystem", l.numberingSystem)
+	}
+	if len(l.registers) != 0 {
+		b.Setting("Registers", strings.Join(l.registers, ", "))
+	}
+	if len(l.omittedNamespaces) != 0 {
+		var policies yamlMapSlice
+		for _, namespaceName := range l.omittedNamespaces {
+			setYamlMapSliceValue(&policies, namespaceName, "Omit")
+		}
+		setYamlMapSliceValue(&b.settings, "MissingNamespaces", policies)
+	}
+
+	//Add the namespaces
+	isDefaultLanguage := l.fallback == l
+	for _, info := range l.Namespaces() {
+		n := l.dict.namespaces[info.Name]
+		for i, tv := range n.idsInOrder {
+			//Translations from fallback languages are left out
+			index := info.FirstIndex + TransIndex(i)
+			rules, err := l.DecompileTranslation(index)
+			if err != nil {
+				return nil, err
+			} else if len(rules) == 0 {
+				continue
+			}
+
+			//Metadata is added before the first Translation ID
+			bn := b.Namespace(info.Name)
+			if isDefaultLanguage && len(bn.translations) == 0 {
+				for _, prop := range info.Metadata.getProperties() {
+					bn.Metadata(prop[0], prop[1])
+				}
+			}
+
+			//A single ^ rule without variables is a string
+			pluralCountType := l.pluralCountType(index)
+			if len(rules) == 1 && rules[0].Rule == "^" && len(tv.vars) == 0 && pluralCountType == vtIntegerWithSymbols {
+				bn.Add(tv.name, rules[0].Text)
+				continue
+			}
+
+			//Add the rules and variables
+			bt := bn.Translation(tv.name)
+			for _, r := range rules {
+				bt.Rule(r.Rule, r.Text)
+			}
+			for _, v := range tv.vars {
+				bt.Variable(v.name, variableTypeNames[v.varType])
+			}
+			if pluralCountType != vtIntegerWithSymbols {
+				bt.Variable(pluralCountName, variableTypeNames[pluralCountType])
+			}
+		}
+	}
+	return b, nil
+}
+
+// Returns the type the translation’s PluralCount was changed to (see pluralCountTypes) by reading its rules, or vtIntegerWithSymbols (the default) if no rule uses it
+func (l *Language) pluralCountType(index TransIndex) variableType {
+	for i := range l.translationRules(index) {
+		str, err := l.ruleString(l.translations[index].startIndex + uint32(i))
+		if err != nil {
+			continue
+		}
+		for _, v := range getUsedVariables(str) {
+			if v.index == 0 && pluralCountTypes[v.varType] {
+				return v.varType
+			}
+		}
+	}
+	return vtIntegerWithSymbols
+}
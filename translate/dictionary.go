@@ -0,0 +1,240 @@
+//Dictionaries that languages are loaded against
+
+package translate
+
+import (
+	"compress/gzip"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Dictionary holds the dictionary that languages are loaded against. Each Dictionary is independent, so multiple unrelated sets of translations (like for a plugin host and its plugins) can be loaded side by side. The zero value is an empty Dictionary that is ready to use.
+//
+// The package level load functions (LanguageBinaryFile.Load(), LanguageTextFile.LoadDefault(), etc.) use a package level Dictionary.
+type Dictionary struct {
+	dict          *languageDict
+	compileLimits CompileLimits //See SetCompileLimits()
+	decryption    cipher.AEAD   //Decrypts the translation strings of encrypted compiled language files. See SetDecryptionKey()
+}
+
+// CompileLimits caps the resources used while compiling a translation text file, so a pathological file cannot exhaust the process (see Dictionary.SetCompileLimits()). The zero value uses the defaults
+type CompileLimits struct {
+	MaxGoroutines uint   //The most Translation IDs of a file that are compiled at once, each in its own goroutine. If 0, runtime.GOMAXPROCS(0) is used
+	MaxMemory     uint64 //The most bytes of compiled translation strings and rules a file can produce, beyond which a *CompileLimitError is returned. If 0, there is no limit
+
+	//The most namespaces whose go dictionary files are created at once by the default language compiled with these limits (see Language.SaveGoDictionaries()), each in its own goroutine. If 0, runtime.GOMAXPROCS(0) is used
+	MaxNamespaceGoroutines uint
+}
+
+// The Dictionary used by the package level load functions
+var defaultDictionary Dictionary
+
+const errDictionaryNotLoaded = "The dictionary has not been loaded yet. You must first load the default language translation text file or the compiled dictionary"
+
+// NewDictionary creates an empty Dictionary
+func NewDictionary() *Dictionary {
+	return &Dictionary{}
+}
+
+// Dictionary returns the Dictionary the language was loaded against. Other languages can be loaded against it, and it can be saved without the language
+func (l *Language) Dictionary() *Dictionary {
+	return &Dictionary{dict: l.dict}
+}
+
+// HasVarsLoaded returns if the dictionary’s variables have been loaded (through the default language translation text file, or a compiled variable or combined dictionary file). Variables registered with LoadDictionaryVarsLazily() are loaded by this if they have not been yet
+func (d *Dictionary) HasVarsLoaded() bool {
+	return d.dict != nil && d.dict.loadVars() == nil
+}
+
+// IsLoaded returns if the dictionary has been loaded
+func (d *Dictionary) IsLoaded() bool {
+	return d.dict != nil
+}
+
+// Clear erases the dictionary so a new one can be loaded. Languages that have mismatched dictionaries are incompatible. Returns if the dictionary was already loaded
+func (d *Dictionary) Clear() bool {
+	hasDict := d.dict != nil
+	d.dict = nil
+	return hasDict
+}
+
+// Load loads a .gtr language file. The default language text file or the dictionary must be loaded first.
+//
+// Note: Fallback languages still need to be assigned through Language.SetFallback()
+func (d *Dictionary) Load(r io.Reader, isCompressed bool) (*Language, error) {
+	//Check if the dictionary is already loaded
+	localDict := d.dict
+	if localDict == nil {
+		return nil, errors.New(errDictionaryNotLoaded)
+	}
+
+	//Handle compressed files
+	if isCompressed {
+		if _r, err := gzip.NewReader(r); err != nil {
+			return nil, err
+		} else {
+			r = _r
+		}
+	}
+
+	//Read the file
+	var l Language
+	if err := l.fromCompiledFile(r, localDict, nil, d.decryption); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// LoadDefault loads a .gtr language file. This must be the default language. The dictionary must be loaded first.
+func (d *Dictionary) LoadDefault(r io.Reader, isCompressed bool) (*Language, error) {
+	if l, err := d.Load(r, isCompressed); err != nil {
+		return nil, err
+	} else {
+		l.fallback = l
+		return l, nil
+	}
+}
+
+// LoadDictionary loads a compiled dictionary file, which must be done before loading any compiled translation file or non-default-language translation text file. Returns an error if the dictionary is already loaded.
+func (d *Dictionary) LoadDictionary(r io.Reader, isCompressed bool) error {
+	//Throw an error if the dictionary is already loaded
+	if d.dict != nil {
+		return errors.New("Dictionary already loaded")
+	}
+
+	//Handle compressed files
+	if isCompressed {
+		if _r, err := gzip.NewReader(r); err != nil {
+			return err
+		} else {
+			r = _r
+		}
+	}
+
+	//Load and save the dictionary
+	var newDict languageDict
+	if err := newDict.fromCompiledFile(r); err != nil {
+		return err
+	}
+
+	//Not worrying about race conditions as dictionaries are not changed after being created and stored
+	d.dict = &newDict
+	return nil
+}
+
+// LoadCombinedDictionary loads a compiled combined dictionary file, which holds both the compiled dictionary and the compiled variable dictionary. The dictionary is not kept if its variables fail to load. Returns an error if the dictionary is already loaded.
+func (d *Dictionary) LoadCombinedDictionary(r io.Reader, isCompressed bool) error {
+	//Throw an error if the dictionary is already loaded
+	if d.dict != nil {
+		return errors.New("Dictionary already loaded")
+	}
+
+	//Handle compressed files
+	if isCompressed {
+		if _r, err := gzip.NewReader(r); err != nil {
+			return err
+		} else {
+			r = _r
+		}
+	}
+
+	//Load the dictionary and its variables, which directly follow it
+	var newDict languageDict
+	if err := newDict.fromCompiledFile(r); err != nil {
+		return err
+	} else if err := newDict.fromCompiledVarFile(r); err != nil {
+		return fmt.Errorf("Variable dictionary: %s", err.Error())
+	}
+
+	//Not worrying about race conditions as dictionaries are not changed after being created and stored
+	d.dict = &newDict
+	initTextProcessing()
+	return nil
+}
+
+// LoadDictionaryVars loads a compiled variable dictionary file. This is only used when processing non-default language text files and the compiled dictionary is being loaded.
+func (d *Dictionary) LoadDictionaryVars(r io.Reader, isCompressed bool) error {
+	//Make sure dictionary is already loaded
+	if d.dict == nil {
+		return errors.New(errDictionaryNotLoaded)
+	} else if d.dict.lazyVars != nil {
+		return errors.New("The dictionary’s variables are registered to load lazily")
+	}
+
+	//Handle compressed files
+	if isCompressed {
+		if _r, err := gzip.NewReader(r); err != nil {
+			return err
+		} else {
+			r = _r
+		}
+	}
+
+	//Process and return if error
+	if err := d.dict.fromCompiledVarFile(r); err != nil {
+		return err
+	}
+
+	//Return success
+	initTextProcessing()
+	return nil
+}
+
+// LoadDictionaryVarsLazily registers a compiled variable dictionary file to be loaded the first time the variables are needed, instead of when the dictionary is loaded. This keeps the variables out of memory in production until a debugging function needs them (like Language.TranslationIDLookup(), Language.Schema(), and the metadata of Language.Namespaces()).
+//
+// open is called (once) to open the file. Errors from loading the file are returned by the functions that need the variables. This must be called before the dictionary’s languages are used by other goroutines. Returns an error if the variables are already loaded or registered.
+func (d *Dictionary) LoadDictionaryVarsLazily(open func() (io.ReadCloser, error), isCompressed bool) error {
+	if d.dict == nil {
+		return errors.New(errDictionaryNotLoaded)
+	} else if d.dict.hasVarsLoaded || d.dict.lazyVars != nil {
+		return errors.New("The dictionary’s variables are already loaded or registered")
+	}
+	d.dict.lazyVars = &lazyVarsFile{open: open, isCompressed: isCompressed}
+	return nil
+}
+
+// A compiled variable dictionary file that is loaded when the variables are first needed (see Dictionary.LoadDictionaryVarsLazily())
+type lazyVarsFile struct {
+	once         sync.Once
+	open         func() (io.ReadCloser, error)
+	isCompressed bool
+	err          error
+}
+
+// Returns an error if the dictionary’s variables are not loaded. Variables registered with LoadDictionaryVarsLazily() are loaded on the first call
+func (dict *languageDict) loadVars() error {
+	if lv := dict.lazyVars; lv != nil {
+		lv.once.Do(func() {
+			lv.err = dict.loadLazyVars(lv)
+		})
+		if lv.err != nil {
+			return fmt.Errorf("Variable dictionary: %s", lv.err.Error())
+		}
+	}
+	if !dict.hasVarsLoaded {
+		return errors.New("The dictionary’s variables are not loaded")
+	}
+	return nil
+}
+
+// Opens and loads the lazily loaded variable dictionary file
+func (dict *languageDict) loadLazyVars(lv *lazyVarsFile) error {
+	f, err := lv.open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	var r io.Reader = f
+	if lv.isCompressed {
+		if _r, err := gzip.NewReader(f); err != nil {
+			return err
+		} else {
+			r = _r
+		}
+	}
+	return dict.fromCompiledVarFile(r)
+}
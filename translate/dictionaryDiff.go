@@ -0,0 +1,80 @@
+//Compare the Translation IDs of dictionaries
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"sort"
+)
+
+// DictionaryDiff is the differences between the Translation IDs of 2 dictionaries (see Dictionary.Diff()). The entries are in TransIndex order (the new index for Added, and the old index for the others)
+type DictionaryDiff struct {
+	Added     []DictionaryDiffEntry //Translation IDs only in the new dictionary
+	Removed   []DictionaryDiffEntry //Translation IDs only in the old dictionary
+	Reindexed []DictionaryDiffEntry //Translation IDs in both dictionaries whose TransIndex changed
+}
+
+// DictionaryDiffEntry is a Translation ID of a DictionaryDiff
+type DictionaryDiffEntry struct {
+	Namespace     string
+	TranslationID string
+	OldIndex      TransIndex //Not set for added Translation IDs
+	NewIndex      TransIndex //Not set for removed Translation IDs
+}
+
+// HasIndexChanges returns if any Translation IDs in both dictionaries changed TransIndex, which breaks binaries built with the old dictionary’s TransIndex constants
+func (dd DictionaryDiff) HasIndexChanges() bool {
+	return len(dd.Reindexed) != 0
+}
+
+// IsEmpty returns if the dictionaries have the same Translation IDs at the same TransIndexes
+func (dd DictionaryDiff) IsEmpty() bool {
+	return len(dd.Added) == 0 && len(dd.Removed) == 0 && len(dd.Reindexed) == 0
+}
+
+// Diff compares the Translation IDs of the dictionary (the old dictionary) to newDict. Both dictionaries must be loaded.
+//
+// TransIndexes are positional, so reordering, inserting, or removing Translation IDs changes the indexes of the Translation IDs after them. Binaries built against the old dictionary then get the wrong translations (see DictionaryDiff.HasIndexChanges())
+func (d *Dictionary) Diff(newDict *Dictionary) (DictionaryDiff, error) {
+	oldLD, newLD := d.dict, newDict.dict
+	if oldLD == nil || newLD == nil {
+		return DictionaryDiff{}, errors.New(errDictionaryNotLoaded)
+	}
+
+	//Compare the Translation IDs of the old dictionary to the new dictionary
+	var ret DictionaryDiff
+	for _, namespaceName := range oldLD.namespacesInOrder {
+		newNS := newLD.namespaces[namespaceName]
+		for translationID, oldIndex := range oldLD.namespaces[namespaceName].ids {
+			entry := DictionaryDiffEntry{namespaceName, translationID, oldIndex, 0}
+			if newNS == nil {
+				ret.Removed = append(ret.Removed, entry)
+			} else if newIndex, ok := newNS.ids[translationID]; !ok {
+				ret.Removed = append(ret.Removed, entry)
+			} else if newIndex != oldIndex {
+				entry.NewIndex = newIndex
+				ret.Reindexed = append(ret.Reindexed, entry)
+			}
+		}
+	}
+
+	//Find the Translation IDs only in the new dictionary
+	for _, namespaceName := range newLD.namespacesInOrder {
+		oldNS := oldLD.namespaces[namespaceName]
+		for translationID, newIndex := range newLD.namespaces[namespaceName].ids {
+			if oldNS != nil {
+				if _, ok := oldNS.ids[translationID]; ok {
+					continue
+				}
+			}
+			ret.Added = append(ret.Added, DictionaryDiffEntry{namespaceName, translationID, 0, newIndex})
+		}
+	}
+
+	//Sort by TransIndex
+	sort.Slice(ret.Added, func(a, b int) bool { return ret.Added[a].NewIndex < ret.Added[b].NewIndex })
+	sort.Slice(ret.Removed, func(a, b int) bool { return ret.Removed[a].OldIndex < ret.Removed[b].OldIndex })
+	sort.Slice(ret.Reindexed, func(a, b int) bool { return ret.Reindexed[a].OldIndex < ret.Reindexed[b].OldIndex })
+	return ret, nil
+}
@@ -0,0 +1,93 @@
+//Display cell widths of strings for padding variables (see WithDisplayWidths())
+
+package translate
+
+import (
+	"golang.org/x/text/width"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Writes the string truncated to the precision and padded to the width, both counted in display cells. A negative width or precision is not used
+func writeDisplayWidth(w *translationBuffer, str string, width, precision int, padRight, pad0 bool) {
+	//Truncate to the precision, keeping grapheme clusters whole
+	strWidth := 0
+	for pos := 0; pos < len(str); {
+		clusterLen, clusterWidth := nextGraphemeCluster(str[pos:])
+		if precision >= 0 && strWidth+clusterWidth > precision {
+			str = str[:pos]
+			break
+		}
+		strWidth += clusterWidth
+		pos += clusterLen
+	}
+
+	//Pad to the width
+	if strWidth >= width {
+		w.WriteString(str)
+		return
+	}
+	padChar := " "
+	if pad0 && !padRight {
+		padChar = "0"
+	}
+	if padRight {
+		w.WriteString(str)
+		w.WriteString(strings.Repeat(padChar, width-strWidth))
+	} else {
+		w.WriteString(strings.Repeat(padChar, width-strWidth))
+		w.WriteString(str)
+	}
+}
+
+// Returns the byte length and display cell width of the grapheme cluster at the start of the string.
+//
+// Clusters are approximated as a base rune followed by combining marks, variation selectors, emoji modifiers, tags, Hangul vowels/finals, and zero-width-joined runes. Regional indicators are paired into flags.
+func nextGraphemeCluster(str string) (length, cells int) {
+	base, length := utf8.DecodeRuneInString(str)
+	cells = runeCells(base)
+	isRegionalIndicator := func(r rune) bool { return r >= 0x1F1E6 && r <= 0x1F1FF }
+	for length < len(str) {
+		r, size := utf8.DecodeRuneInString(str[length:])
+		switch {
+		case r == 0x200D: //Zero width joiner, which also joins the following rune
+			length += size
+			if length < len(str) {
+				_, size = utf8.DecodeRuneInString(str[length:])
+				length += size
+			}
+			continue
+		case r == 0xFE0F: //Emoji presentation selector
+			if cells == 1 {
+				cells = 2
+			}
+		case r >= 0xFE00 && r <= 0xFE0E, //Other variation selectors
+			r >= 0x1F3FB && r <= 0x1F3FF, //Emoji skin tone modifiers
+			r >= 0xE0020 && r <= 0xE007F, //Tags
+			r >= 0x1160 && r <= 0x11FF,   //Hangul jamo vowels and finals
+			unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		case isRegionalIndicator(base) && isRegionalIndicator(r) && length == utf8.RuneLen(base):
+			cells = 2
+		default:
+			return
+		}
+		length += size
+	}
+	return
+}
+
+// Returns the display cell width of a single rune
+func runeCells(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0),
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,130 @@
+//Find duplicate keys in translation text files, which the parsers would otherwise silently keep or drop
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Returns an error listing the duplicate keys of a YAML file and their lines. yaml.MapSlice keeps duplicate keys, so the file is decoded again strictly to find them.
+//
+// Keys given by a merge (<<) cannot be overridden, as they are reported as duplicates
+func checkYamlDuplicateKeys(textStr []byte) error {
+	var v interface{}
+	var typeErr *yaml.TypeError
+	if err := yaml.UnmarshalStrict(textStr, &v); err == nil || !errors.As(err, &typeErr) {
+		return nil
+	}
+
+	duplicateRegex := regexp.MustCompile(`^line (\d+): key (.*) already set in map$`)
+	var duplicates []string
+	for _, msg := range typeErr.Errors {
+		if m := duplicateRegex.FindStringSubmatch(msg); m != nil {
+			key := m[2]
+			if unquotedKey, err := strconv.Unquote(key); err == nil {
+				key = unquotedKey
+			}
+			duplicates = append(duplicates, fmt.Sprintf("Duplicate key “%s” on line %s", key, m[1]))
+		}
+	}
+	if len(duplicates) != 0 {
+		return errors.New(strings.Join(duplicates, "\n"))
+	}
+	return nil
+}
+
+// Returns an error on the first key whose name is given more than once in its object. This catches keys of different types with the same name (like 1 and "1"), which checkYamlDuplicateKeys() does not
+func checkYamlDuplicateNames(ms yamlMapSlice, path string) error {
+	names := make(map[string]bool, len(ms))
+	for _, item := range ms {
+		name, _ := yamlValToStr(item.Key)
+		if names[name] {
+			return fmt.Errorf("Duplicate key “%s%s”", path, name)
+		}
+		names[name] = true
+
+		if v, ok := item.Value.(yamlMapSlice); ok {
+			if err := checkYamlDuplicateNames(v, path+name+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns an error listing the duplicate keys of a JSON file and their lines. fastjson keeps duplicate keys, so the file is tokenized again to find them. The file must already be valid JSON
+func checkJsonDuplicateKeys(textStr []byte) error {
+	//The state of each object and array being read
+	type container struct {
+		keys      map[string]bool //nil for arrays
+		path      string
+		expectKey bool
+		lastKey   string
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(textStr))
+	var stack []*container
+	var duplicates []string
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			break //The end of the file, or an error which the JSON parser already reports
+		}
+
+		//Handle keys
+		var parent *container
+		if len(stack) != 0 {
+			parent = stack[len(stack)-1]
+		}
+		if parent != nil && parent.keys != nil && parent.expectKey {
+			if key, ok := token.(string); ok {
+				if parent.keys[key] {
+					line := bytes.Count(textStr[:dec.InputOffset()], []byte{'\n'}) + 1
+					duplicates = append(duplicates, fmt.Sprintf("Duplicate key “%s%s” on line %d", parent.path, key, line))
+				}
+				parent.keys[key] = true
+				parent.expectKey, parent.lastKey = false, key
+				continue
+			}
+		}
+
+		//Handle values
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			newContainer := &container{}
+			if parent != nil && parent.keys != nil {
+				newContainer.path = parent.path + parent.lastKey + "."
+			} else if parent != nil {
+				newContainer.path = parent.path
+			}
+			if token == json.Delim('{') {
+				newContainer.keys, newContainer.expectKey = make(map[string]bool), true
+			}
+			stack = append(stack, newContainer)
+			continue
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+			if len(stack) != 0 {
+				parent = stack[len(stack)-1]
+			} else {
+				parent = nil
+			}
+		}
+		if parent != nil && parent.keys != nil {
+			parent.expectKey = true
+		}
+	}
+
+	if len(duplicates) != 0 {
+		return errors.New(strings.Join(duplicates, "\n"))
+	}
+	return nil
+}
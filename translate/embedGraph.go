@@ -0,0 +1,162 @@
+//Graph of the embedded translations of a language
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// EmbedGraph is the graph of a language’s embedded translations (see Language.EmbedGraph()), so the translations affected by changing a translation can be found
+type EmbedGraph struct {
+	LanguageIdentifier string
+	MaxDepth           int //The largest Depth of the nodes
+	Nodes              []EmbedGraphNode
+}
+
+// EmbedGraphNode is a translation in an EmbedGraph. Translations are named as “Namespace.TranslationID”
+type EmbedGraphNode struct {
+	Namespace            string
+	TranslationID        string
+	Embeds               []string //The embedded static translations ({{*TranslationID}}) of all of its rules, in order of first appearance
+	EmbeddedBy           []string //The translations that embed it as a static translation, in dictionary order
+	VariableTranslations []string //The names of its VariableTranslation variables, which can embed any translation when it is requested
+	Depth                int      //The number of nested embedded static translation levels below it. The Get() functions cannot go more than 100 levels deep
+}
+
+// EmbedGraphFormat is the file format written by EmbedGraph.Write()
+type EmbedGraphFormat uint8
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	EGF_DOT  EmbedGraphFormat = iota //Graphviz DOT. VariableTranslations are dashed edges to an “*” node
+	EGF_JSON                         //JSON of the EmbedGraph
+)
+
+// EmbedGraph returns the graph of the language’s embedded translations. Only translations that embed a translation (statically or through a VariableTranslation variable), or are embedded, are included, in dictionary order.
+//
+// Translations missing from the language are taken from its fallback languages, as the Get() functions do. The names of VariableTranslation variables are their variable numbers (like “#2”) if the dictionary’s variables are not loaded.
+func (l *Language) EmbedGraph() EmbedGraph {
+	//Get the names of the translations
+	names := make([][2]string, l.NumTranslations())
+	for nsName, n := range l.dict.namespaces {
+		for tidName, index := range n.ids {
+			if uint(index) < ulen(names) {
+				names[index] = [2]string{nsName, tidName}
+			}
+		}
+	}
+	varsLoaded := l.dict.loadVars() == nil
+
+	//Find the embedded translations of all translations
+	embeds := make([][]TransIndex, len(names))
+	embeddedBy := make([][]TransIndex, len(names))
+	varTranslations := make([][]string, len(names))
+	var startIndex TransIndex
+	for _, namespaceName := range l.dict.namespacesInOrder {
+		n := l.dict.namespaces[namespaceName]
+		for i, translationID := range n.idsInOrder {
+			index := startIndex + TransIndex(i)
+			fromLang := l.getTranslationLanguage(index)
+			if fromLang == nil {
+				continue
+			}
+			for ruleIndex := fromLang.translations[index].startIndex; ruleIndex < fromLang.translations[index+1].startIndex; ruleIndex++ {
+				str, _ := fromLang.ruleString(ruleIndex) //A string that cannot be read has no embeds
+				for _, v := range getUsedVariables(str) {
+					if v.varType == vtStaticTranslation && uint(v.embeddedIndex) < ulen(names) && !arrayIn(embeds[index], v.embeddedIndex) {
+						embeds[index] = append(embeds[index], v.embeddedIndex)
+						embeddedBy[v.embeddedIndex] = append(embeddedBy[v.embeddedIndex], index)
+					} else if v.varType == vtVariableTranslation {
+						varName := "#" + strconv.Itoa(int(v.index))
+						if varsLoaded && int(v.index) <= len(translationID.vars) {
+							varName = translationID.vars[v.index-1].name
+						}
+						if !arrayIn(varTranslations[index], varName) {
+							varTranslations[index] = append(varTranslations[index], varName)
+						}
+					}
+				}
+			}
+		}
+		startIndex += TransIndex(len(n.idsInOrder))
+	}
+
+	//Find the depths. Loops are errors when compiling, but are stopped here in case the compiled file is bad
+	depths := make([]int, len(names))
+	const depthUnknown, depthInProgress = -1, -2
+	for i := range depths {
+		depths[i] = depthUnknown
+	}
+	var getDepth func(index TransIndex) int
+	getDepth = func(index TransIndex) int {
+		switch depths[index] {
+		case depthInProgress:
+			return maxEmbeddedCount
+		case depthUnknown:
+			depths[index] = depthInProgress
+			depth := 0
+			for _, embeddedIndex := range embeds[index] {
+				depth = max(depth, getDepth(embeddedIndex)+1)
+			}
+			depths[index] = cond(depth > maxEmbeddedCount, maxEmbeddedCount, depth)
+		}
+		return depths[index]
+	}
+
+	//Create the nodes
+	toNames := func(indexes []TransIndex) []string {
+		ret := make([]string, len(indexes))
+		for i, index := range indexes {
+			ret[i] = names[index][0] + "." + names[index][1]
+		}
+		return ret
+	}
+	g := EmbedGraph{LanguageIdentifier: l.languageIdentifier}
+	for i := range names {
+		if len(embeds[i]) == 0 && len(embeddedBy[i]) == 0 && len(varTranslations[i]) == 0 {
+			continue
+		}
+		node := EmbedGraphNode{names[i][0], names[i][1], toNames(embeds[i]), toNames(embeddedBy[i]), cond(varTranslations[i] == nil, []string{}, varTranslations[i]), getDepth(TransIndex(i))}
+		g.MaxDepth = max(g.MaxDepth, node.Depth)
+		g.Nodes = append(g.Nodes, node)
+	}
+	return g
+}
+
+// Write writes the graph in the given format
+func (g EmbedGraph) Write(w io.Writer, format EmbedGraphFormat) error {
+	if format == EGF_JSON {
+		if g.Nodes == nil {
+			g.Nodes = []EmbedGraphNode{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(g)
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph " + strconv.Quote(g.LanguageIdentifier) + " {\n")
+	hasVariableTranslations := false
+	for _, node := range g.Nodes {
+		name := node.Namespace + "." + node.TranslationID
+		_, _ = fmt.Fprintf(&b, "\t%s [label=%s];\n", strconv.Quote(name), strconv.Quote(fmt.Sprintf("%s\n(Depth %d)", name, node.Depth)))
+		for _, embedded := range node.Embeds {
+			_, _ = fmt.Fprintf(&b, "\t%s -> %s;\n", strconv.Quote(name), strconv.Quote(embedded))
+		}
+		for _, varName := range node.VariableTranslations {
+			_, _ = fmt.Fprintf(&b, "\t%s -> \"*\" [style=dashed, label=%s];\n", strconv.Quote(name), strconv.Quote(varName))
+			hasVariableTranslations = true
+		}
+	}
+	if hasVariableTranslations {
+		b.WriteString("\t\"*\" [label=\"Any translation\", shape=box];\n")
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,69 @@
+//Encrypting the translation strings of compiled language files
+
+package translate
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+)
+
+// The translation strings of an encrypted compiled language file (whose file type is “GE”) are sealed with AES-GCM. They are preceded by the nonce and followed by the authentication tag, and the file’s header is the additional authenticated data. The header’s dataSize is the size of the decrypted strings
+//
+//goland:noinspection GoSnakeCaseUsage
+const (
+	size_encryptionNonce    = 12
+	size_encryptionTag      = 16
+	size_encryptionOverhead = size_encryptionNonce + size_encryptionTag
+)
+
+// Errors returned when loading encrypted language files
+const (
+	ErrNoDecryptionKey  = "The translation strings are encrypted, but no decryption key was given"
+	ErrDecryptionFailed = "The translation strings could not be decrypted, so the decryption key is wrong or the file is corrupt"
+)
+
+// Creates the AES-GCM cipher that translation strings are encrypted with
+func newStringsCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("Encryption key must be 16, 24, or 32 bytes (for AES-128, AES-192, or AES-256), but is %d bytes", len(key))
+	}
+	return cipher.NewGCM(block)
+}
+
+// SetDecryptionKey sets the AES key that the translation strings of encrypted compiled language files (see WithEncryptionKey()) are decrypted with when they are loaded against the dictionary. It must be 16, 24, or 32 bytes (for AES-128, AES-192, or AES-256), and is the same key the files were saved with. A nil key removes it. This must be called before the dictionary is used by other goroutines.
+//
+// Encrypted files are always read into memory, including through LoadAt()
+func (d *Dictionary) SetDecryptionKey(key []byte) error {
+	if key == nil {
+		d.decryption = nil
+		return nil
+	}
+	aead, err := newStringsCipher(key)
+	if err != nil {
+		return err
+	}
+	d.decryption = aead
+	return nil
+}
+
+// SetDecryptionKey sets the key that encrypted compiled language files are decrypted with through the package level load functions (LanguageBinaryFile.Load(), LanguageBinaryFile.LoadStandalone(), LoadBundle(), etc.). See Dictionary.SetDecryptionKey()
+func (ll LanguageFile) SetDecryptionKey(key []byte) error {
+	return defaultDictionary.SetDecryptionKey(key)
+}
+
+// IsEncrypted returns if the language was loaded from a compiled file whose translation strings are encrypted
+func (l *Language) IsEncrypted() bool {
+	return l.encrypted
+}
+
+// Returns if the language file’s translation strings are encrypted
+func (header storeHeader) isEncrypted() bool {
+	return b2s(header.fileType[:]) == "GE"
+}
+
+// Returns the header as it is stored in the file (little-endian), which authenticates the encrypted translation strings
+func (header storeHeader) fileBytes() []byte {
+	return encodeStruct(&header)
+}
@@ -0,0 +1,56 @@
+//Errors that can be checked with errors.Is() and errors.As()
+
+package translate
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sentinel errors that are wrapped by the errors returned when loading compiled files. Their messages are the same as the string constants they are made from
+var (
+	ErrDictionaryMismatch   = errors.New(ErrDictionaryDoesNotMatch) //The compiled language file was not compiled against the loaded dictionary
+	ErrMissingDecryptionKey = errors.New(ErrNoDecryptionKey)        //The translation strings are encrypted, but no decryption key was given
+	ErrWrongDecryptionKey   = errors.New(ErrDecryptionFailed)       //The translation strings could not be decrypted
+)
+
+// ParseError is a single error found while compiling a translation text file
+type ParseError struct {
+	Lang      string //The language identifier of the file. Empty if the errors stopped the language from being created (like errors in its settings or namespace names)
+	Namespace string //Empty if the error is not inside a namespace
+	ID        string //The Translation ID (or “_Metadata”). Empty if the error is not inside a Translation ID
+	Message   string //The full message, which already includes the “Namespace.TranslationID: ” prefix when it applies
+}
+
+func (e *ParseError) Error() string {
+	return e.Message
+}
+
+// ParseErrors holds all the errors found while compiling a translation text file, and is what the text load functions return for them. errors.As() can get it or its first *ParseError
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the *ParseErrors so errors.Is() and errors.As() can check each of them
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Returns the messages as ParseErrors that are not inside a namespace
+func toParseErrors(msgs []string) []*ParseError {
+	errs := make([]*ParseError, len(msgs))
+	for i, msg := range msgs {
+		errs[i] = &ParseError{Message: msg}
+	}
+	return errs
+}
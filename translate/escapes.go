@@ -0,0 +1,189 @@
+//Backslash escape sequence processing in translation strings
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The groups of escape sequences that can be turned on and off through Settings.EscapeSequences
+type escapeGroups uint8
+
+const (
+	egControl escapeGroups = 1 << iota //\a \b \f \n \r \t \v
+	egHex                              //\x##
+	egUnicode                          //\u#### \N{NAME}
+	egNone    escapeGroups = 0
+	egAll                  = egControl | egHex | egUnicode
+)
+
+// The names of the escape groups as given in Settings.EscapeSequences (case-insensitive)
+var escapeGroupNames = []struct {
+	name  string
+	group escapeGroups
+}{{"All", egAll}, {"None", egNone}, {"Control", egControl}, {"Hex", egHex}, {"Unicode", egUnicode}}
+
+// escapePolicy determines which escape sequences are processed in a language’s translation strings
+type escapePolicy struct {
+	groups escapeGroups
+	custom map[rune]string //Extra single character escapes and their replacements
+}
+
+// The policy used when the settings do not specify one
+var defaultEscapePolicy = escapePolicy{egAll, nil}
+
+// Parses Settings.EscapeSequences, which is a comma separated list of escape group names
+func getEscapeGroups(setting string) (escapeGroups, error) {
+	var groups escapeGroups
+	for _, name := range strings.Split(setting, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, eg := range escapeGroupNames {
+			if strings.EqualFold(name, eg.name) {
+				groups |= eg.group
+				found = true
+				break
+			}
+		}
+		if !found {
+			names := make([]string, len(escapeGroupNames))
+			for i, eg := range escapeGroupNames {
+				names[i] = eg.name
+			}
+			return egNone, errors.New("Invalid escape sequence group: " + name + ". Valid groups are: " + strings.Join(names, ", "))
+		}
+	}
+	return groups, nil
+}
+
+// Reads the Settings.CustomEscapes object. Keys must be a single character that is not a letter already used by a built-in escape sequence
+func getCustomEscapes(settingsObj tpMap) (map[rune]string, []string) {
+	customEscapesInterface, ok := settingsObj.getValue("CustomEscapes")
+	if !ok {
+		return nil, nil
+	}
+	customEscapesObj, ok := customEscapesInterface.getObject()
+	if !ok {
+		return nil, []string{"Settings.CustomEscapes must be an object"}
+	}
+
+	var errs []string
+	customEscapes := make(map[rune]string, customEscapesObj.getLength())
+	for _, item := range customEscapesObj.toOrdered() {
+		name := item.getName()
+		if r, size := utf8.DecodeRuneInString(name); size != len(name) || r == utf8.RuneError {
+			errs = append(errs, fmt.Sprintf("Settings.CustomEscapes.%s: Must be a single character", name))
+		} else if strings.ContainsRune("abfnrtvxu\\", unicode.ToLower(r)) {
+			errs = append(errs, fmt.Sprintf("Settings.CustomEscapes.%s: Cannot override a built-in escape sequence", name))
+		} else if val, ok := item.getString(); !ok {
+			errs = append(errs, fmt.Sprintf("Settings.CustomEscapes.%s: Must be a string", name))
+		} else if strings.IndexByte(val, varReplacementChar) != -1 {
+			errs = append(errs, fmt.Sprintf("Settings.CustomEscapes.%s: Cannot contain \\xFF as it is a special character in this library", name))
+		} else {
+			customEscapes[r] = val
+		}
+	}
+	return customEscapes, errs
+}
+
+// Replaces the escape sequences in a translation string. Backslashes that do not start an enabled escape sequence are kept as is.
+func (ep *escapePolicy) replace(str []byte, addErrStr func(err string, args ...interface{})) []byte {
+	//Nothing to do if all escape sequences are turned off
+	if ep.groups == egNone && len(ep.custom) == 0 {
+		return str
+	}
+
+	return regexSpecialCharacters.ReplaceAllFunc(str, func(varVal []byte) []byte {
+		escapeChar, _ := utf8.DecodeRune(varVal[1:])
+
+		//Handle custom escapes
+		if customVal, ok := ep.custom[escapeChar]; ok {
+			return []byte(customVal)
+		}
+
+		//Handle named unicode characters (\N must be checked before \n)
+		if escapeChar == 'N' && len(varVal) > 2 {
+			if ep.groups&egUnicode == 0 {
+				return varVal
+			}
+			name := b2s(varVal[3 : len(varVal)-1])
+			if r, err := lookupRuneName(name); err != nil {
+				addErrStr("%s: %s", err.Error(), name)
+				return nil
+			} else {
+				return []byte(string(r))
+			}
+		}
+
+		var newChar byte
+		switch unicode.ToLower(escapeChar) {
+		case 'a':
+			newChar = '\a'
+		case 'b':
+			newChar = '\b'
+		case 'f':
+			newChar = '\f'
+		case 'n':
+			newChar = '\n'
+		case 'r':
+			newChar = '\r'
+		case 't':
+			newChar = '\t'
+		case 'v':
+			newChar = '\v'
+		case '\\':
+			//A double backslash is always a single backslash unless all escape sequences are turned off
+			if ep.groups == egNone {
+				return varVal
+			}
+			return []byte{'\\'}
+		//Handle 2 digit hex character
+		case 'x':
+			if ep.groups&egHex == 0 || len(varVal) != 4 {
+				return varVal
+			}
+			newCharCode, _ := strconv.ParseInt(b2s(varVal[2:4]), 16, 0)
+			if newCharCode == varReplacementChar {
+				addErrStr("Cannot use \\xFF as it is a special character in this library")
+				return nil
+			}
+
+			return []byte{byte(newCharCode)}
+		//Handle 4 digit unicode character
+		case 'u':
+			if ep.groups&egUnicode == 0 || len(varVal) < 4 {
+				return varVal
+			}
+
+			//Confirm character is within the valid unicode range
+			newCharCode, _ := strconv.ParseInt(b2s(varVal[2:]), 16, 0)
+			if newCharCode > unicode.MaxRune {
+				addErrStr("Invalid unicode character found. Is >unicode.MaxRune: 0x%s", varVal[2:])
+				return nil
+			}
+
+			//Confirm the unicode character is a valid code point
+			if !utf8.ValidRune(rune(newCharCode)) {
+				addErrStr("Invalid unicode character found: 0x%s", varVal[2:])
+				return nil
+			}
+
+			//Return the valid character
+			return []byte(string(rune(newCharCode)))
+		default:
+			//Unknown escapes are kept as is
+			return varVal
+		}
+
+		if ep.groups&egControl == 0 {
+			return varVal
+		}
+		return []byte{newChar}
+	})
+}
@@ -0,0 +1,49 @@
+//Unicode character name lookups for \N{NAME} escape sequences
+//go:build !gol10n_read_compiled_only && !gol10n_minimal
+
+package translate
+
+import (
+	"errors"
+	"golang.org/x/text/unicode/runenames"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// The unicode character names (uppercase) that have been looked up, to their runes (or -1 if not found)
+var runeNameLookups sync.Map
+
+// The error returned for names that are not unicode character names
+var errInvalidRuneName = errors.New("Invalid unicode character name found")
+
+// Looks up a unicode character by its name (case-insensitive). Examples: “LATIN SMALL LETTER E WITH ACUTE”, “CJK UNIFIED IDEOGRAPH-4E00”
+func lookupRuneName(name string) (rune, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if len(name) == 0 || name[0] == '<' { //Runes without names have a description in angle brackets
+		return 0, errInvalidRuneName
+	} else if r, ok := runeNameLookups.Load(name); ok {
+		return r.(rune), cond(r.(rune) == -1, errInvalidRuneName, nil)
+	}
+
+	//CJK ideographs do not have individual names
+	const cjkPrefix = "CJK UNIFIED IDEOGRAPH-"
+	if strings.HasPrefix(name, cjkPrefix) {
+		if code, err := strconv.ParseUint(name[len(cjkPrefix):], 16, 32); err == nil && runenames.Name(rune(code)) == "<CJK Ideograph>" {
+			return rune(code), nil
+		}
+		return 0, errInvalidRuneName
+	}
+
+	//Search the names of the runes directly, as the name table is only indexed by rune
+	found := rune(-1)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if runenames.Name(r) == name {
+			found = r
+			break
+		}
+	}
+	runeNameLookups.Store(name, found)
+	return found, cond(found == -1, errInvalidRuneName, nil)
+}
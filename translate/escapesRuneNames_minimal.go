@@ -0,0 +1,13 @@
+//Unicode character name lookups for gol10n_minimal builds, which do not include the unicode character name table
+//go:build !gol10n_read_compiled_only && gol10n_minimal
+
+package translate
+
+import (
+	"errors"
+)
+
+// Unicode character names cannot be looked up in gol10n_minimal builds, so \N{NAME} escape sequences are always errors
+func lookupRuneName(string) (rune, error) {
+	return 0, errors.New("Unicode character names are not supported in gol10n_minimal builds")
+}
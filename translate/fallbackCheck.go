@@ -0,0 +1,229 @@
+//Compile time checks of variables used through fallback languages
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// A variable used in a compiled translation string
+type usedVariable struct {
+	index         uint8 //0 is PluralCount (and embedded static translations)
+	varType       variableType
+	embeddedIndex TransIndex //The Translation ID index of embedded static translations
+}
+
+// Returns the variables used in a compiled translation string. Embedded static translations are included as vtStaticTranslation with an index of 0
+func getUsedVariables(translation []byte) (ret []usedVariable) {
+	for i := 0; i < len(translation); {
+		//Find the next variable
+		if translation[i] != varReplacementChar {
+			i++
+			continue
+		}
+		if i+2 >= len(translation) {
+			return
+		}
+		v := usedVariable{translation[i+1], variableType(translation[i+2] & 0xF), 0}
+		typeFlags := translation[i+2]
+		i += 3
+
+		//Skip the width and precision
+		if typeFlags&fmtHasWidth != 0 {
+			i++
+		}
+		if typeFlags&fmtHasPrecision != 0 {
+			i++
+		}
+
+		switch v.varType {
+		//Skip the specifier, and pull the extended type from it
+		case vtDateTime:
+			if i >= len(translation) {
+				return
+			}
+			specifierLen := int(translation[i])
+			i++
+			if i+specifierLen > len(translation) {
+				return
+			}
+			if specifierLen >= 2 && translation[i] == varReplacementChar && int(translation[i+1]) < len(variableTypeMapReverse) {
+				v.varType = variableType(translation[i+1])
+			}
+			i += specifierLen
+		//Pull the Translation ID index
+		case vtStaticTranslation:
+			if i+4 > len(translation) {
+				return
+			}
+			v.embeddedIndex = TransIndex(binary.LittleEndian.Uint32(translation[i:]))
+			i += 4
+		}
+
+		ret = append(ret, v)
+	}
+	return
+}
+
+// CheckFallbackVariables returns warnings (starting with WC_VariableMismatch) for translations that are missing from the language and are taken from a non-default fallback language whose rules use variables that are not in the default language’s variables for the Translation ID (or have a different type). The generated Go signatures come from the default language, so these lookups would otherwise only fail at runtime.
+//
+// The fallback language must already be set, and the dictionary’s variables must be loaded (through the default language translation text file or the compiled variable dictionary). Returns nil otherwise.
+func (l *Language) CheckFallbackVariables() (warnings []string) {
+	if l.fallback == nil || l.fallback == l || l.dict.loadVars() != nil {
+		return nil
+	}
+
+	var startIndex TransIndex
+	for _, namespaceName := range l.dict.namespacesInOrder {
+		n := l.dict.namespaces[namespaceName]
+		for i, translationID := range n.idsInOrder {
+			//Find the language the translation comes from. Nothing to check if it is this language or the default language
+			index := startIndex + TransIndex(i)
+			fromLang := l.getTranslationLanguage(index)
+			if fromLang == nil || fromLang == l || fromLang.fallback == fromLang {
+				continue
+			}
+
+			//Check the variables used in all the rules against the default language
+			sliceIndex, sliceEnd := fromLang.translations[index].startIndex, fromLang.translations[index+1].startIndex
+			warned := make(map[uint8]bool)
+			for ruleIndex := sliceIndex; ruleIndex < sliceEnd; ruleIndex++ {
+				str, err := fromLang.ruleString(ruleIndex)
+				if err != nil {
+					warnings = append(warnings, fmt.Sprintf("%s %s.%s: Could not read the rules of “%s”: %s", WC_VariableMismatch, namespaceName, translationID.name, fromLang.languageIdentifier, err.Error()))
+					break
+				}
+				for _, v := range getUsedVariables(str) {
+					if v.index == 0 || warned[v.index] {
+						continue
+					} else if int(v.index) > len(translationID.vars) {
+						warnings = append(warnings, fmt.Sprintf("%s %s.%s: Falls back to “%s”, which uses variable #%d that does not exist in the default language", WC_VariableMismatch, namespaceName, translationID.name, fromLang.languageIdentifier, v.index))
+					} else if defaultVar := translationID.vars[v.index-1]; defaultVar.varType != v.varType {
+						warnings = append(warnings, fmt.Sprintf("%s %s.%s: Falls back to “%s”, which uses variable #%d as type %s instead of the default language’s “%s” type %s", WC_VariableMismatch, namespaceName, translationID.name, fromLang.languageIdentifier, v.index, variableTypeMapReverse[v.varType], defaultVar.name, variableTypeMapReverse[defaultVar.varType]))
+					} else {
+						continue
+					}
+					warned[v.index] = true
+				}
+			}
+		}
+		startIndex += TransIndex(len(n.idsInOrder))
+	}
+
+	return
+}
+
+// WC_BrokenEmbed is the warning code that starts the warnings of CheckEmbeddedTranslations(), so they can be told apart from other warnings
+const WC_BrokenEmbed = "[BrokenEmbed]"
+
+// CheckEmbeddedTranslations returns warnings (starting with WC_BrokenEmbed) for embedded static translations ({{*TranslationID}}) that would fail when requested through the language. This includes the translations it takes from its fallback languages. Embedded translations are looked up through the requesting language and its fallback languages, with the plural count of the translation they are embedded in, so a warning is given when:
+//   - The embedded translation has no rules in the language or its fallback languages
+//   - The translation it is embedded in has plurality rules, and the embedded translation (from the language it is taken from) has no rule for a plural count that it has a rule for in the default language
+//
+// Only the default register’s rules are checked. The fallback language must already be set. Returns nil for the default language
+func (l *Language) CheckEmbeddedTranslations() (warnings []string) {
+	if l.fallback == nil || l.fallback == l {
+		return nil
+	}
+
+	//Find the default language
+	defaultLang := l
+	for defaultLang.fallback != defaultLang {
+		if defaultLang = defaultLang.fallback; defaultLang == nil || defaultLang == l {
+			return nil
+		}
+	}
+
+	getName := func(index TransIndex) string {
+		nsName, translationIDName, _ := l.dict.translationIDLookup(index)
+		return nsName + "." + translationIDName
+	}
+	for index := TransIndex(0); uint32(index) < l.NumTranslations(); index++ {
+		//Find the language the translation comes from
+		fromLang := l.getTranslationLanguage(index)
+		if fromLang == nil {
+			continue
+		}
+
+		//The translation is plural if it has a rule other than a catch-all (which register markers also are)
+		rules := fromLang.translationRules(index)
+		isPlural := false
+		for _, r := range rules {
+			isPlural = isPlural || r.rule.getOp() != cmpAll
+		}
+
+		//Check the embedded translations of all the rules
+		checked := make(map[TransIndex]bool)
+		for ruleIndex := fromLang.translations[index].startIndex; ruleIndex < fromLang.translations[index+1].startIndex; ruleIndex++ {
+			str, err := fromLang.ruleString(ruleIndex)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s %s: Could not read the rules of “%s”: %s", WC_BrokenEmbed, getName(index), fromLang.languageIdentifier, err.Error()))
+				break
+			}
+			for _, v := range getUsedVariables(str) {
+				if v.varType != vtStaticTranslation || checked[v.embeddedIndex] {
+					continue
+				}
+				checked[v.embeddedIndex] = true
+
+				//Confirm the embedded translation exists and has compatible rules
+				if uint32(v.embeddedIndex) >= l.NumTranslations() {
+					warnings = append(warnings, fmt.Sprintf("%s %s: Embeds an invalid Translation ID index (%d)", WC_BrokenEmbed, getName(index), v.embeddedIndex))
+				} else if embedLang := l.getTranslationLanguage(v.embeddedIndex); embedLang == nil {
+					warnings = append(warnings, fmt.Sprintf("%s %s: Embeds “%s”, which has no rules in “%s” or its fallback languages", WC_BrokenEmbed, getName(index), getName(v.embeddedIndex), l.languageIdentifier))
+				} else if !isPlural || embedLang == defaultLang {
+					continue
+				} else if count := firstUnmatchedPluralCount(embedLang, v.embeddedIndex, defaultLang); count != -1 {
+					warnings = append(warnings, fmt.Sprintf("%s %s: Embeds “%s”, which has no rule in “%s” for the plural count %d that the default language has a rule for", WC_BrokenEmbed, getName(index), getName(v.embeddedIndex), embedLang.languageIdentifier, count))
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// Returns a plural count that the default language has a rule for in the translation, and the language does not, or -1 if there is none. The counts checked are the small numbers, and the boundaries of the rules’ comparisons
+func firstUnmatchedPluralCount(lang *Language, index TransIndex, defaultLang *Language) int64 {
+	rules, _, _ := lang.splitRegisterRules(lang.translationRules(index), "")
+	defaultRules, _, _ := defaultLang.splitRegisterRules(defaultLang.translationRules(index), "")
+
+	//Gather the counts to check
+	const numSmallCounts = 100
+	counts := make([]int64, 0, numSmallCounts+(len(rules)+len(defaultRules))*4)
+	for i := int64(0); i <= numSmallCounts; i++ {
+		counts = append(counts, i)
+	}
+	for _, r := range append(append([]translationRule(nil), rules...), defaultRules...) {
+		i0 := int64(r.rule.i0)
+		upper := i0 + int64(uint8(r.rule.op)>>3) + cond[int64](r.rule.getOp() == cmpBetweenExtraBit, 32, 0)
+		counts = append(counts, i0-1, i0+1, upper, upper+1)
+	}
+
+	//Find a count the default language matches and the language does not
+	for _, count := range counts {
+		if count >= 0 && count <= maxRulePluralCount && defaultLang.matchRule(defaultRules, count) != -1 && lang.matchRule(rules, count) == -1 {
+			return count
+		}
+	}
+	return -1
+}
+
+// Returns the rules of the translation in the language, without the extra rule
+func (l *Language) translationRules(index TransIndex) []translationRule {
+	return l.rules[l.translations[index].startIndex:l.translations[index+1].startIndex]
+}
+
+// Returns the [fallback] language that has rules for the translation, or nil if none do
+func (l *Language) getTranslationLanguage(index TransIndex) *Language {
+	var prevLang *Language
+	for curLang := l; curLang != prevLang && curLang != nil; curLang = curLang.fallback {
+		if curLang.translations[index+1].startIndex != curLang.translations[index].startIndex {
+			return curLang
+		}
+		prevLang = curLang
+	}
+	return nil
+}
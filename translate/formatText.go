@@ -0,0 +1,301 @@
+//Canonical formatting of translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io"
+	"strings"
+)
+
+// The number of spaces per indentation level in formatted YAML files
+const formatYamlIndent = 4
+
+// Format parses a translation text file and returns it re-emitted in a canonical layout of the same format. The result is confirmed to parse to the exact same content, so formatting never makes a semantic change. TOML, i18next JSON, and custom format files cannot be formatted.
+//
+// The canonical layout is:
+//   - The Settings object is moved to the top. All other keys keep their order, as the order of namespaces, Translation IDs, plural rules, and variables is meaningful
+//   - YAML files are indented with 4 spaces and have a blank line between top level sections. Keys and values are only quoted (with double quotes) when they cannot be read back as the same plain string
+//   - JSON files are indented with tabs and always have double-quoted values. There are no trailing commas
+//   - Non-string scalars (numbers, booleans, null) are written as the strings they are read as
+func (lf LanguageTextFile) Format(r io.Reader) ([]byte, error) {
+	//Parse the file
+	if !lf.CanBeWritten() {
+		return nil, errors.New("TOML, i18next JSON, and custom format files cannot be formatted")
+	}
+	topItem, err := lf.readTopItem(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := topItem.getObject(); !ok {
+		return nil, errors.New("Top level item is not an object")
+	}
+
+	return lf.formatTopItem(topItem)
+}
+
+// Writes the top level item in the canonical layout of the file type, and confirms it reads back to the same content
+func (lf LanguageTextFile) formatTopItem(topItem tpItem) ([]byte, error) {
+	//Write the file
+	var buf bytes.Buffer
+	var err error
+	reparseAs := lf
+	switch lf {
+	case LF_YAML, LF_YAML_StrictStrings:
+		err = formatYamlObject(&buf, topItem, 0)
+	case LF_TOML:
+		err = formatTomlFile(&buf, topItem)
+	default:
+		err = formatJsonObject(&buf, topItem, 0)
+		buf.WriteByte('\n')
+		reparseAs = LF_JSON
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	//Confirm the content did not change
+	if newTopItem, err := reparseAs.readTopItem(bytes.NewReader(buf.Bytes())); err != nil {
+		return nil, fmt.Errorf("Formatted file could not be read back: %s", err.Error())
+	} else if path, ok := formatItemsEqual(topItem, newTopItem, 0); !ok {
+		return nil, fmt.Errorf("Formatted file does not match the original at “%s”", path)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Returns the items of an object in canonical order (Settings is moved to the top of the top level object)
+func formatOrderedItems(obj tpMap, level int) []tpItem {
+	items := obj.toOrdered()
+	if level != 0 {
+		return items
+	}
+	for i, item := range items {
+		if item.getName() == "Settings" {
+			return append(append([]tpItem{item}, items[0:i]...), items[i+1:]...)
+		}
+	}
+	return items
+}
+
+// Returns if both items have the same content in canonical order. On mismatch, the path to the mismatched item is returned
+func formatItemsEqual(a, b tpItem, level int) (string, bool) {
+	aObj, aIsObj := a.getObject()
+	bObj, bIsObj := b.getObject()
+	if aIsObj != bIsObj {
+		return "", false
+	} else if !aIsObj {
+		aStr, aOK := a.getString()
+		bStr, bOK := b.getString()
+		return "", aOK && bOK && aStr == bStr
+	}
+
+	aItems, bItems := formatOrderedItems(aObj, level), formatOrderedItems(bObj, level)
+	if len(aItems) != len(bItems) {
+		return "", false
+	}
+	for i := range aItems {
+		name := aItems[i].getName()
+		if name != bItems[i].getName() {
+			return name, false
+		} else if path, ok := formatItemsEqual(aItems[i], bItems[i], level+1); !ok {
+			return strings.TrimSuffix(name+"."+path, "."), false
+		}
+	}
+	return "", true
+}
+
+// Writes an object’s members as YAML
+func formatYamlObject(buf *bytes.Buffer, item tpItem, level int) error {
+	obj, _ := item.getObject()
+	items := formatOrderedItems(obj, level)
+	if len(items) == 0 {
+		buf.WriteString("{}\n")
+		return nil
+	}
+
+	for i, child := range items {
+		//Top level sections are separated by a blank line
+		if level == 0 && i != 0 {
+			buf.WriteByte('\n')
+		}
+
+		//Write the key
+		buf.WriteString(strings.Repeat(" ", level*formatYamlIndent))
+		buf.WriteString(formatYamlScalar(child.getName(), true))
+		buf.WriteByte(':')
+
+		//Write the value
+		if childObj, ok := child.getObject(); ok {
+			if childObj.getLength() == 0 {
+				buf.WriteString(" {}\n")
+				continue
+			}
+			buf.WriteByte('\n')
+			if err := formatYamlObject(buf, child, level+1); err != nil {
+				return err
+			}
+		} else if str, ok := child.getString(); ok {
+			buf.WriteByte(' ')
+			buf.WriteString(formatYamlScalar(str, false))
+			buf.WriteByte('\n')
+		} else {
+			return fmt.Errorf("“%s” must be an object or a string", child.getName())
+		}
+	}
+	return nil
+}
+
+// Returns the string as a plain YAML scalar if it would be read back as the same string (and not as another scalar type), and double-quoted otherwise
+func formatYamlScalar(str string, isKey bool) string {
+	//Plain scalars cannot be empty, have surrounding whitespace, or span lines
+	if len(str) == 0 || strings.TrimSpace(str) != str || strings.ContainsAny(str, "\r\n\t") {
+		return formatQuoteString(str)
+	}
+
+	//Confirm the plain scalar is read back the same
+	var ms yamlMapSlice
+	if isKey {
+		if yaml.Unmarshal([]byte(str+": x"), &ms) == nil && len(ms) == 1 {
+			if key, ok := ms[0].Key.(string); ok && key == str {
+				return str
+			}
+		}
+	} else if yaml.Unmarshal([]byte("x: "+str), &ms) == nil && len(ms) == 1 {
+		if val, ok := ms[0].Value.(string); ok && val == str {
+			return str
+		}
+	}
+	return formatQuoteString(str)
+}
+
+// Writes an object as JSON
+func formatJsonObject(buf *bytes.Buffer, item tpItem, level int) error {
+	obj, _ := item.getObject()
+	items := formatOrderedItems(obj, level)
+	if len(items) == 0 {
+		buf.WriteString("{}")
+		return nil
+	}
+
+	buf.WriteString("{\n")
+	for i, child := range items {
+		//Write the key
+		buf.WriteString(strings.Repeat("\t", level+1))
+		buf.WriteString(formatQuoteString(child.getName()))
+		buf.WriteString(": ")
+
+		//Write the value
+		if _, ok := child.getObject(); ok {
+			if err := formatJsonObject(buf, child, level+1); err != nil {
+				return err
+			}
+		} else if str, ok := child.getString(); ok {
+			buf.WriteString(formatQuoteString(str))
+		} else {
+			return fmt.Errorf("“%s” must be an object or a string", child.getName())
+		}
+
+		if i != len(items)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(strings.Repeat("\t", level))
+	buf.WriteByte('}')
+	return nil
+}
+
+// Writes the top level object as TOML. Each top level object is a table, and the objects inside them are inline tables, as the order of a table’s keys (which is meaningful) cannot be kept when its scalars and sub-tables are mixed
+func formatTomlFile(buf *bytes.Buffer, item tpItem) error {
+	obj, _ := item.getObject()
+	for i, child := range formatOrderedItems(obj, 0) {
+		//Top level sections are separated by a blank line
+		if i != 0 {
+			buf.WriteByte('\n')
+		}
+
+		//Write the table
+		childObj, ok := child.getObject()
+		if !ok {
+			return fmt.Errorf("“%s” must be an object to be written to a TOML file", child.getName())
+		}
+		buf.WriteByte('[')
+		buf.WriteString(formatTomlKey(child.getName()))
+		buf.WriteString("]\n")
+		for _, member := range childObj.toOrdered() {
+			buf.WriteString(formatTomlKey(member.getName()))
+			buf.WriteString(" = ")
+			if err := formatTomlValue(buf, member); err != nil {
+				return err
+			}
+			buf.WriteByte('\n')
+		}
+	}
+	return nil
+}
+
+// Writes a value as a TOML string or inline table
+func formatTomlValue(buf *bytes.Buffer, item tpItem) error {
+	if obj, ok := item.getObject(); ok {
+		members := obj.toOrdered()
+		if len(members) == 0 {
+			buf.WriteString("{}")
+			return nil
+		}
+		buf.WriteString("{ ")
+		for i, member := range members {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(formatTomlKey(member.getName()))
+			buf.WriteString(" = ")
+			if err := formatTomlValue(buf, member); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(" }")
+	} else if str, ok := item.getString(); ok {
+		buf.WriteString(formatQuoteString(str))
+	} else {
+		return fmt.Errorf("“%s” must be an object or a string", item.getName())
+	}
+	return nil
+}
+
+// Returns the key as a bare TOML key if it only has the characters they allow, and double-quoted otherwise
+func formatTomlKey(key string) string {
+	if len(key) == 0 || strings.TrimLeft(key, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-") != "" {
+		return formatQuoteString(key)
+	}
+	return key
+}
+
+// Returns the string double-quoted with escapes that are valid in JSON, YAML, and TOML. Control characters and other non-printable characters are escaped
+func formatQuoteString(str string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, c := range str {
+		switch {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		case c == '\n':
+			sb.WriteString(`\n`)
+		case c == '\r':
+			sb.WriteString(`\r`)
+		case c == '\t':
+			sb.WriteString(`\t`)
+		case c < 0x20 || (c >= 0x7F && c <= 0x9F) || c == '\u2028' || c == '\u2029' || c == '\uFEFF':
+			_, _ = fmt.Fprintf(&sb, `\u%04X`, c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+//The format version of compiled files, and reading files from older versions
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+// CompiledFormatVersion is the format version written to the headers of compiled dictionary (.gtr), language (.gtr), and variable (.gtr) files.
+//
+// The compatibility policy is:
+//   - Files of every version up to CompiledFormatVersion can be loaded. Files of newer versions are refused with an error, as their layout cannot be known
+//   - Files from before versioning (which ended their file type with an “R”) are read as version 1
+//   - Files are always written as CompiledFormatVersion, so loading and saving a file upgrades it
+//   - The version is only increased when the layout of a compiled file changes, and the loader keeps reading each older layout
+//   - The version is not included in the dictionary hash, so languages stay matched to their dictionary when only the version changes
+//
+// Version history:
+//   - 1: The original (unversioned) format
+//   - 2: The 3rd header byte holds the format version
+//   - 3: Language files end with a CRC-32 (Castagnoli) checksum of the rest of the file, which is confirmed when they are loaded
+//   - 4: The translation strings of language files can be encrypted, which changes their file type to “GE” (see WithEncryptionKey())
+const CompiledFormatVersion = 4
+
+// The 3rd header byte of compiled files from before versioning
+const legacyFormatVersionByte = 'R'
+
+// The first format version whose language files end with a checksum
+const checksumFormatVersion = 3
+
+// The first format version whose language files can be encrypted
+const encryptionFormatVersion = 4
+
+// The table of the language file checksums
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Confirms the file type of a compiled file header and returns its format version
+func readFormatVersion(fileType [2]byte, versionByte uint8, expectedType string) (uint8, error) {
+	if b2s(fileType[:]) != expectedType {
+		return 0, errors.New("Invalid file header")
+	} else if versionByte == legacyFormatVersionByte {
+		return 1, nil
+	} else if versionByte < 2 || versionByte > CompiledFormatVersion {
+		return 0, fmt.Errorf("Unsupported compiled file format version (%d). The newest supported version is %d", versionByte, CompiledFormatVersion)
+	}
+	return versionByte, nil
+}
+
+// Confirms the file type of a compiled language file header (“GT”, or “GE” when its translation strings are encrypted) and returns its format version
+func (header storeHeader) readFormatVersion() (uint8, error) {
+	if !header.isEncrypted() {
+		return readFormatVersion(header.fileType, header.formatVersion, "GT")
+	} else if version, err := readFormatVersion(header.fileType, header.formatVersion, "GE"); err != nil {
+		return 0, err
+	} else if version < encryptionFormatVersion {
+		return 0, errors.New("Invalid file header")
+	} else {
+		return version, nil
+	}
+}
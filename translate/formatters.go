@@ -0,0 +1,161 @@
+//Locale formatters for numbers, DateTimes, currencies, and string comparisons
+//go:build !gol10n_minimal
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"github.com/klauspost/lctime"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/search"
+	"io"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// The locale’s formatters of a language. They are created when first needed, and are shared with the copies of the language (see Language.With()). If goroutines create the same formatter at the same time, the first one stored is kept
+type languageFormatters struct {
+	messagePrinter atomic.Pointer[message.Printer]
+	timeLocalizer  atomic.Pointer[lctime.Localizer]
+	searchMatcher  atomic.Pointer[search.Matcher] //Case and diacritic insensitive matcher for Contains() and EqualFold()
+}
+
+// MessagePrinter returns the MessagePrinter. It is safe for concurrent use
+func (l *Language) MessagePrinter() *message.Printer {
+	//Make sure the message printer already exists
+	if p := l.formatters.messagePrinter.Load(); p != nil {
+		return p
+	}
+	l.formatters.messagePrinter.CompareAndSwap(nil, message.NewPrinter(l.numberTag()))
+
+	return l.formatters.messagePrinter.Load()
+}
+
+// TimeLocalizer returns the TimeLocalizer. It is safe for concurrent use
+func (l *Language) TimeLocalizer() (*lctime.Localizer, error) {
+	//Make sure the time localizer already exists
+	if loc := l.formatters.timeLocalizer.Load(); loc != nil {
+		return loc, nil
+	}
+	if loc, err := lctime.NewLocalizer(l.timeLocaleID()); err != nil {
+		return nil, err
+	} else {
+		l.formatters.timeLocalizer.CompareAndSwap(nil, &loc)
+	}
+
+	return l.formatters.timeLocalizer.Load(), nil
+}
+
+// Returns the identifier of the time localizer’s locale
+func (l *Language) timeLocaleID() string {
+	return strings.Replace(l.languageTag.String(), "-", "_", -1)
+}
+
+// Collator returns a new collator for the language’s locale, for sorting user-visible strings. A new one is created on every call, as collators cannot be used concurrently
+func (l *Language) Collator() *collate.Collator {
+	return collate.New(l.languageTag)
+}
+
+// SortStrings sorts the strings in place in the order of the language’s locale
+func (l *Language) SortStrings(strs []string) {
+	l.Collator().SortStrings(strs)
+}
+
+// Contains returns if the substring is in the string, ignoring case and diacritics (accents) by the rules of the language’s locale. For example, “I” matches “ı” (and not “i”) in Turkish. Every string contains the empty string. Useful for filtering translated lists
+func (l *Language) Contains(s, substr string) bool {
+	//Like strings.Contains(), every string contains the empty string. The matcher finds no match for it
+	if substr == "" {
+		return true
+	}
+	start, _ := l.matcher().IndexString(s, substr)
+	return start != -1
+}
+
+// EqualFold returns if the strings are equal, ignoring case and diacritics (accents) by the rules of the language’s locale. For example, “Résumé” equals “resume”, and “I” equals “ı” (and not “i”) in Turkish
+func (l *Language) EqualFold(a, b string) bool {
+	return l.matcher().EqualString(a, b)
+}
+
+// Returns the search matcher used by Contains() and EqualFold()
+func (l *Language) matcher() *search.Matcher {
+	//Make sure the matcher already exists
+	if m := l.formatters.searchMatcher.Load(); m != nil {
+		return m
+	}
+	l.formatters.searchMatcher.CompareAndSwap(nil, search.New(l.languageTag, search.Loose))
+
+	return l.formatters.searchMatcher.Load()
+}
+
+// Creates the formatters that are otherwise created when first needed (see Bundle.Warmup())
+func (l *Language) warmFormatters() error {
+	l.MessagePrinter()
+	l.matcher()
+	if _, err := l.TimeLocalizer(); err != nil {
+		return fmt.Errorf("DateTime localizer: %s", err.Error())
+	}
+	return nil
+}
+
+// Returns if the formatters were all created
+func (l *Language) formattersWarmedUp() bool {
+	return l.formatters.messagePrinter.Load() != nil && l.formatters.timeLocalizer.Load() != nil && l.formatters.searchMatcher.Load() != nil
+}
+
+// Writes the formatted values with the number formatting of the language’s locale
+func (l *Language) fprintNumber(w io.Writer, format string, a ...interface{}) {
+	_, _ = l.MessagePrinter().Fprintf(w, format, a...)
+}
+
+// Returns the formatted values with the number formatting of the language’s locale
+func (l *Language) sprintNumber(format string, a ...interface{}) string {
+	return l.MessagePrinter().Sprintf(format, a...)
+}
+
+// Makes sure the time localizer exists, so formatDateTime() can be called
+func (l *Language) loadTimeLocalizer() error {
+	_, err := l.TimeLocalizer()
+	return err
+}
+
+// Formats a time with strftime specifiers in the calendar. loadTimeLocalizer() must be called first
+func (l *Language) formatDateTime(cal calendarType, format string, t time.Time) string {
+	return l.strftime(*l.formatters.timeLocalizer.Load(), lctimeLocales[l.timeLocaleID()], cal, format, t)
+}
+
+// Writes a Currency variable, which must be a currency.Amount
+func (l *Language) fprintCurrency(w io.Writer, printfFlags string, val interface{}, isCash bool) error {
+	if curVal, ok := val.(currency.Amount); !ok {
+		return errors.New("Variable require a golang.org/x/text/currency.Amount object")
+	} else {
+		l.fprintNumber(w, printfFlags+"d", l.currencyFormatter(curVal, isCash))
+		return nil
+	}
+}
+
+// Returns the currency amount formatted for the CurrencyDisplay. It is rounded to the currency’s minor units (like 2 decimal places for USD, 0 for JPY, and 3 for BHD), or for cash if isCash or WithCashRounding() are set
+func (l *Language) currencyFormatter(amount currency.Amount, isCash bool) interface{} {
+	var formatter currency.Formatter
+	switch l.currencyDisplay {
+	case CD_NarrowSymbol:
+		formatter = currency.NarrowSymbol
+	case CD_ISOCode:
+		formatter = currency.ISO
+	default:
+		formatter = currency.Symbol
+	}
+	if isCash || l.cashRounding {
+		formatter = formatter.Kind(currency.Cash)
+	}
+	return formatter(amount)
+}
+
+// Returns the formatted values with English number formatting
+func englishSprintf(format string, a ...interface{}) string {
+	return message.NewPrinter(language.English).Sprintf(format, a...)
+}
@@ -0,0 +1,71 @@
+//Locale formatters for gol10n_minimal builds, which drop the message printer, time localizer, collator, and currency support to shrink binaries
+//go:build gol10n_minimal
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The locale’s formatters of a language, which do not exist in gol10n_minimal builds
+type languageFormatters struct{}
+
+// SortStrings sorts the strings in place by their bytes. gol10n_minimal builds do not sort by the language’s locale
+func (l *Language) SortStrings(strs []string) {
+	sort.Strings(strs)
+}
+
+// Contains returns if the substring is in the string, ignoring case. gol10n_minimal builds do not use the rules of the language’s locale, and do not ignore diacritics (accents)
+func (l *Language) Contains(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// EqualFold returns if the strings are equal, ignoring case. gol10n_minimal builds do not use the rules of the language’s locale, and do not ignore diacritics (accents)
+func (l *Language) EqualFold(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// There are no formatters to create in gol10n_minimal builds
+func (l *Language) warmFormatters() error {
+	return nil
+}
+
+// There are no formatters to create in gol10n_minimal builds
+func (l *Language) formattersWarmedUp() bool {
+	return true
+}
+
+// Writes the formatted values without number localization
+func (l *Language) fprintNumber(w io.Writer, format string, a ...interface{}) {
+	_, _ = fmt.Fprintf(w, format, a...)
+}
+
+// Returns the formatted values without number localization
+func (l *Language) sprintNumber(format string, a ...interface{}) string {
+	return fmt.Sprintf(format, a...)
+}
+
+// DateTime variables cannot be formatted without the time localizer
+func (l *Language) loadTimeLocalizer() error {
+	return errors.New("DateTime variables are not available in gol10n_minimal builds")
+}
+
+// DateTime variables cannot be formatted without the time localizer
+func (l *Language) formatDateTime(_ calendarType, _ string, _ time.Time) string {
+	return ""
+}
+
+// Currency variables cannot be formatted without the currency package
+func (l *Language) fprintCurrency(_ io.Writer, _ string, _ interface{}, _ bool) error {
+	return errors.New("Currency variables are not available in gol10n_minimal builds")
+}
+
+// Returns the formatted values without number localization
+func englishSprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(format, a...)
+}
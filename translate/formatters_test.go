@@ -0,0 +1,45 @@
+//Tests of the locale aware string matching
+//go:build !gol10n_read_compiled_only && !gol10n_minimal
+
+package translate
+
+import "testing"
+
+// Returns a language with a single translation for the language identifier
+func loadMatchTestLanguage(t *testing.T, languageIdentifier string) *Language {
+	l, _, err := ParseTranslationText([]byte("Settings:\n  LanguageName: Test\n  LanguageIdentifier: " + languageIdentifier + "\n  MissingPluralRule: Missing\nNS:\n  A: a\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestContains(t *testing.T) {
+	en, tr := loadMatchTestLanguage(t, "en-US"), loadMatchTestLanguage(t, "tr-TR")
+	for _, test := range []struct {
+		l           *Language
+		s, substr   string
+		expected    bool
+		description string
+	}{
+		{en, "Résumé", "", true, "Empty substring"},
+		{en, "", "", true, "Empty string and substring"},
+		{en, "", "a", false, "Empty string"},
+		{en, "Résumé", "resume", true, "Diacritics are ignored"},
+		{en, "Istanbul", "istanbul", true, "Case is ignored in English"},
+		{en, "Istanbul", "ıstanbul", false, "Dotless i is a different letter in English"},
+		{tr, "ISPARTA", "ısparta", true, "I matches dotless ı in Turkish"},
+		{tr, "ISPARTA", "isparta", false, "I does not match dotted i in Turkish"},
+		{tr, "İzmir", "izmir", true, "Dotted İ matches dotted i in Turkish"},
+		{tr, "İzmir", "", true, "Empty substring in Turkish"},
+	} {
+		if actual := test.l.Contains(test.s, test.substr); actual != test.expected {
+			t.Errorf("%s: Contains(%q, %q) returned %v but expected %v", test.description, test.s, test.substr, actual, test.expected)
+		}
+	}
+
+	//EqualFold follows the same rules
+	if !tr.EqualFold("I", "ı") || tr.EqualFold("I", "i") || !en.EqualFold("I", "i") {
+		t.Error("EqualFold does not follow the Turkish dotless i rules")
+	}
+}
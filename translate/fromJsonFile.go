@@ -88,13 +88,15 @@ func fromJsonFile(textStr []byte, allowJSONTrailingComma bool) (jsonItem, error)
 		return jsonItem{}, errors.New("File is not utf8 valid")
 	}
 
-	//Remove trailing commas if requested
+	//Remove trailing commas if requested. The whitespace is kept so line numbers do not change
 	if allowJSONTrailingComma {
-		textStr = regexp.MustCompile(`,\s*?\n\s*}`).ReplaceAll(textStr, []byte{'}'})
+		textStr = regexp.MustCompile(`,(\s*?\n\s*})`).ReplaceAll(textStr, []byte("$1"))
 	}
 
 	if ret, err := (&fastjson.Parser{}).ParseBytes(textStr); err != nil {
 		return jsonItem{}, errors.New("Error parsing JSON File: " + err.Error())
+	} else if err := checkJsonDuplicateKeys(textStr); err != nil {
+		return jsonItem{}, err
 	} else {
 		return jsonItem{"TOP", ret}, nil
 	}
@@ -4,21 +4,48 @@
 package translate
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"golang.org/x/text/language"
 	"io"
 	"math"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 )
 
-func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStrings bool) (errors, warnings []string) {
+// Recompiles only some namespaces of the default language, copying the translations of the others from the current language. See LanguageTextFile.RecompileNamespaces()
+type partialCompile struct {
+	current    *Language
+	namespaces map[string]bool
+}
+
+// An error or warning from compiling a namespace’s Translation IDs, tagged with its position so the messages can be returned in a deterministic order
+type compileMessage struct {
+	namespaceIndex, translationIDIndex int //translationIDIndex is -1 for messages about the namespace itself
+	isError                            bool
+	translationID                      string //Empty for messages about the namespace itself
+	message                            string
+}
+
+// Sorts the messages by their namespace and then Translation ID. Messages at the same position keep the order they were added in
+func sortCompileMessages(msgs []compileMessage) []compileMessage {
+	sort.SliceStable(msgs, func(i, j int) bool {
+		if msgs[i].namespaceIndex != msgs[j].namespaceIndex {
+			return msgs[i].namespaceIndex < msgs[j].namespaceIndex
+		}
+		return msgs[i].translationIDIndex < msgs[j].translationIDIndex
+	})
+	return msgs
+}
+
+func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, partial *partialCompile, allowBigStrings bool, limiter *compileLimiter) (errors []*ParseError, warnings []string) {
 	//Handle errors and warnings
 	//Returns errors+warnings so call to this can be used as return in parent
-	addErrStr := func(err string) ([]string, []string) {
-		errors = append(errors, err)
+	addErrStr := func(err string) ([]*ParseError, []string) {
+		errors = append(errors, &ParseError{Message: err})
 
 		return errors, warnings
 	}
@@ -38,10 +65,25 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 		topObj = _topObj
 	}
 
+	//Normalize the Unicode of the file
+	if _topObj, normErrors, normWarnings := normalizeTextFile(topObj); len(normErrors) != 0 {
+		warnings = append(warnings, normWarnings...)
+		errors = append(errors, toParseErrors(normErrors)...)
+		return
+	} else {
+		warnings = append(warnings, normWarnings...)
+		topObj = _topObj
+	}
+
 	//Read the settings object
 	isDefaultLanguage := dict == nil
+	escapes := defaultEscapePolicy //Only used during compilation so it is not stored in the language
+	msgFormat := mfGol10n          //Only used during compilation so it is not stored in the language
+	var missingNamespaces missingNamespacePolicies
 	{
-		var langName, missingPluralRule, fallbackLanguage, langIdentStr string
+		var langName, missingPluralRule, fallbackLanguage, langIdentStr, numberingSystem string
+		var calendar calendarType
+		var registers []string
 		var langIdent language.Tag
 		if settingsObjInterface, ok := topObj.getValue("Settings"); !ok {
 			addErrStr("Could not find settings")
@@ -78,20 +120,80 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 			} else {
 				fallbackLanguage = _fallbackLanguage
 			}
+
+			//Handle the numbering system
+			if _numberingSystem, err := getSetting(settingsObj, "NumberingSystem"); err != nil {
+				//Ignore error on optional variables
+			} else if checkNumberingSystem(_numberingSystem) != nil {
+				addErrStr("Settings.NumberingSystem is not valid")
+			} else {
+				numberingSystem = _numberingSystem
+			}
+
+			//Handle the calendar
+			if _calendar, err := getSetting(settingsObj, "Calendar"); err != nil {
+				//Ignore error on optional variables
+			} else if calendar, err = getCalendarType(_calendar); err != nil {
+				addErrStr("Settings.Calendar is not valid")
+			}
+
+			//Handle the registers
+			if _registers, err := getSetting(settingsObj, "Registers"); err != nil {
+				//Ignore error on optional variables
+			} else if registers, err = getRegisters(_registers); err != nil {
+				addErrStr("Settings.Registers is not valid: " + err.Error())
+			}
+
+			//Handle the escape sequences
+			if _escapeSequences, err := getSetting(settingsObj, "EscapeSequences"); err != nil {
+				//Ignore error on optional variables
+			} else if escapes.groups, err = getEscapeGroups(_escapeSequences); err != nil {
+				addErrStr("Settings.EscapeSequences is not valid: " + err.Error())
+			}
+			if customEscapes, errs := getCustomEscapes(settingsObj); len(errs) != 0 {
+				errors = append(errors, toParseErrors(errs)...)
+			} else {
+				escapes.custom = customEscapes
+			}
+
+			//Handle the message format
+			if _messageFormat, err := getSetting(settingsObj, "MessageFormat"); err != nil {
+				//Ignore error on optional variables
+			} else if msgFormat, err = getMessageFormat(_messageFormat); err != nil {
+				addErrStr("Settings.MessageFormat is not valid: " + err.Error())
+			}
+
+			//Handle the missing namespace policies
+			if _missingNamespaces, errs := getMissingNamespacePolicies(settingsObj); len(errs) != 0 {
+				errors = append(errors, toParseErrors(errs)...)
+			} else {
+				missingNamespaces = _missingNamespaces
+			}
 		}
 
 		//If a default language does not exist then this is the default language and the dictionary needs to be created
 		if !isDefaultLanguage {
-			if !dict.hasVarsLoaded {
+			if dict.loadVars() != nil {
 				return addErrStr("Given dictionary must have been created through translation text file")
 			}
+			for _, namespaceName := range sortedKeys(missingNamespaces.namespaces) {
+				if _, ok := dict.namespaces[namespaceName]; !ok {
+					addWarnStr("Settings.MissingNamespaces.%s: Namespace does not exist", namespaceName)
+				}
+			}
 		} else {
-			numNamespaces := topObj.getLength() - 1
-			dict = &languageDict{make(map[string]*namespace, numNamespaces), make([]string, 0, numNamespaces), nil, true}
+			numNamespaces := max(topObj.getLength(), 1) - 1 //Settings could be missing
+			dict = &languageDict{make(map[string]*namespace, numNamespaces), make([]string, 0, numNamespaces), nil, true, sync.Mutex{}, nil, nil, limiter.maxNamespaceGoroutines}
 			if myErrors := dict.fromTextFile(topObj); len(myErrors) > 0 {
 				errors = append(errors, myErrors...)
 				return
 			}
+			if partial != nil {
+				if myErrors := dict.fromPartialCompile(partial); len(myErrors) > 0 {
+					errors = append(errors, myErrors...)
+					return
+				}
+			}
 		}
 
 		//Create the language for processing
@@ -104,8 +206,15 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 			fallbackName:       fallbackLanguage,
 			missingPluralRule:  missingPluralRule,
 			languageTag:        langIdent,
+			numberingSystem:    numberingSystem,
+			calendar:           calendar,
+			cldrVersion:        CLDRVersion,
+			formatters:         &languageFormatters{},
+			registers:          registers,
 		}
 	}
+	dateTimes := newDateTimeSpecifierPolicy(l.languageTag, l.calendar) //Only used during compilation so it is not stored in the language
+	hasSpellout := hasSpelloutRules(l.languageTag)
 
 	//Get the data from the namespaces
 	namespaceReturnData := make([]struct {
@@ -114,36 +223,51 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 		embeddedTIDs [][]TransIndex
 	}, len(l.dict.namespacesInOrder))
 	{
-		//Prepare to return errors and warnings from namespace go function
-		errChan := make(chan string, 5)
-		warnChan := make(chan string, 5)
-		goAddErrStr := func(err string, args ...interface{}) {
-			errChan <- fmt.Sprintf(err, args...)
-		}
-		goAddWarnStr := func(warn string, args ...interface{}) {
-			warnChan <- fmt.Sprintf(warn, args...)
+		//Prepare to return errors and warnings from the Translation ID go functions. They are tagged with their position so they can be returned in a deterministic order
+		var compileMessagesMutex sync.Mutex
+		var compileMessages []compileMessage
+		addCompileMessage := func(isError bool, namespaceIndex, translationIDIndex int, translationID, msg string, args ...interface{}) {
+			compileMessagesMutex.Lock()
+			defer compileMessagesMutex.Unlock()
+			compileMessages = append(compileMessages, compileMessage{namespaceIndex, translationIDIndex, isError, translationID, fmt.Sprintf(msg, args...)})
 		}
 
-		//Iterate over namespaces
+		//Iterate over namespaces. The Translation IDs are compiled in their own goroutines, at most limiter’s number at once
 		readNamespaces := topObj.toMap()
 		delete(readNamespaces, "Settings")
-		var waitForNamespaces sync.WaitGroup
-		for namespaceIndex, _namespaceName := range l.dict.namespacesInOrder {
+		var waitForTranslationIDs sync.WaitGroup
+		for _namespaceIndex, _namespaceName := range l.dict.namespacesInOrder {
 			//Create the namespace output structures
-			namespaceName := _namespaceName
+			namespaceIndex, namespaceName := _namespaceIndex, _namespaceName
 			myNamespaceReturnData := &namespaceReturnData[namespaceIndex]
 			idsInOrderPointer := &l.dict.namespaces[namespaceName].idsInOrder
 			myNamespaceReturnData.stringsData = make([][][]byte, len(*idsInOrderPointer))
 			myNamespaceReturnData.pluralRules = make([][]pluralRule, len(*idsInOrderPointer))
 			myNamespaceReturnData.embeddedTIDs = make([][]TransIndex, len(*idsInOrderPointer))
 
+			//Add errors and warnings at a Translation ID’s index. Messages about the namespace itself are at -1 (with an empty translationID for errors)
+			goAddErrStr := func(translationIDIndex int, translationID, err string, args ...interface{}) {
+				addCompileMessage(true, namespaceIndex, translationIDIndex, translationID, err, args...)
+			}
+			goAddWarnStr := func(translationIDIndex int, warn string, args ...interface{}) {
+				addCompileMessage(false, namespaceIndex, translationIDIndex, "", warn, args...)
+			}
+
 			//Get the list of translations from the namespace (and confirm the namespace name)
 			var readNamespace tpMap = nil
 			if getCurNamespace, ok := readNamespaces[_namespaceName]; !ok {
-				addWarnStr("Namespace “%s” not found in language file", _namespaceName)
+				//Handle the namespace through its missing namespace policy. In all cases its translations are left empty
+				switch missingNamespaces.get(_namespaceName) {
+				case mnpWarn:
+					goAddWarnStr(-1, "%s Namespace “%s” not found in language file", WC_MissingNamespace, _namespaceName)
+				case mnpError:
+					goAddErrStr(-1, "", "Namespace “%s” not found in language file", _namespaceName)
+				case mnpOmit:
+					l.omittedNamespaces = append(l.omittedNamespaces, _namespaceName)
+				}
 				continue
 			} else if curNamespaceSlice, ok := getCurNamespace.getObject(); !ok {
-				addWarnStr("Namespace “%s” could not be read", _namespaceName)
+				goAddWarnStr(-1, "Namespace “%s” could not be read", _namespaceName)
 				continue
 			} else {
 				readNamespace = curNamespaceSlice
@@ -152,118 +276,125 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 			//Delete from the read list so that we can make sure later that all the namespaces were used
 			delete(readNamespaces, _namespaceName)
 
-			//Run each namespace processing in its own goroutine
-			waitForNamespaces.Add(1)
-			go func() {
-				//Prepare to mark as done in namespace wait group
-				defer waitForNamespaces.Done()
-
-				//Prepare wait group for the Translation IDs
-				var waitForTranslationIDs sync.WaitGroup
-
-				//Process the translation IDs
-				readTranslations := readNamespace.toMap()
-				for _translationIDIndex, translationID := range *idsInOrderPointer {
-					//Get the value. If it does not exist then a translation with no rules will be written
-					var val tpItem = nil
-					if _val, ok := readTranslations[translationID.name]; ok {
-						val = _val
-
-						//Delete from the list so that we can make sure later that all the translations were used
-						delete(readTranslations, translationID.name)
-					} else if isDefaultLanguage {
-						goAddWarnStr("%s.%s: Default language is somehow missing namespace translation", namespaceName, translationID.name)
-						continue
-					} else if readNamespace != nil {
-						goAddWarnStr("%s.%s: Translation is missing from namespace", namespaceName, translationID.name)
-						continue
-					}
+			//Copy the translations of namespaces that are not being recompiled
+			if partial != nil && !partial.namespaces[namespaceName] {
+				if err := partial.current.copyNamespaceTranslations(l.dict.namespaces[namespaceName], myNamespaceReturnData.stringsData, myNamespaceReturnData.pluralRules); err != nil {
+					goAddErrStr(-1, "", "Namespace “%s” could not be copied from the current language: %s", namespaceName, err.Error())
+				}
+				continue
+			}
 
-					//Run each Translation ID processing in its own goroutine
-					waitForTranslationIDs.Add(1)
-					go func(translationIDIndex uint, translationIDName string) {
-						//Mark as done in wait group
-						defer waitForTranslationIDs.Done()
-
-						//Get the properties of the Translation ID
-						varProps := make([]string, 0, 2)
-						if strVal, ok := val.getString(); ok {
-							varProps = append(varProps, "^", strVal)
-						} else if mapVal, ok := val.getObject(); ok {
-							for _, mapItemVal := range mapVal.toOrdered() {
-								propName := mapItemVal.getName()
-								if propVal, ok := mapItemVal.getString(); !ok {
-									goAddErrStr("%s.%s.%s: Must be a string", namespaceName, translationIDName, propName)
-								} else {
-									varProps = append(varProps, propName, propVal)
-								}
+			//Process the translation IDs (metadata is only read from the default language when creating the dictionary)
+			readTranslations := readNamespace.toMap()
+			delete(readTranslations, namespaceMetadataName)
+			for _translationIDIndex, translationID := range *idsInOrderPointer {
+				//Get the value. If it does not exist then a translation with no rules will be written
+				var val tpItem = nil
+				if _val, ok := readTranslations[translationID.name]; ok {
+					val = _val
+
+					//Delete from the list so that we can make sure later that all the translations were used
+					delete(readTranslations, translationID.name)
+				} else if isDefaultLanguage {
+					goAddWarnStr(_translationIDIndex, "%s.%s: Default language is somehow missing namespace translation", namespaceName, translationID.name)
+					continue
+				} else if readNamespace != nil {
+					goAddWarnStr(_translationIDIndex, "%s %s.%s: Translation is missing from namespace", WC_MissingTranslation, namespaceName, translationID.name)
+					continue
+				}
+
+				//Run each Translation ID processing in its own goroutine once the limiter allows it. Stop if the memory limit was exceeded
+				limiter.acquire()
+				if limiter.hasExceeded() {
+					limiter.release()
+					break
+				}
+				waitForTranslationIDs.Add(1)
+				go func(translationIDIndex uint, translationIDName string) {
+					//Mark as done in wait group and limiter
+					defer waitForTranslationIDs.Done()
+					defer limiter.release()
+
+					//Get the properties of the Translation ID
+					varProps := make([]string, 0, 2)
+					if strVal, ok := val.getString(); ok {
+						varProps = append(varProps, "^", strVal)
+					} else if mapVal, ok := val.getObject(); ok {
+						for _, mapItemVal := range mapVal.toOrdered() {
+							propName := mapItemVal.getName()
+							if propVal, ok := mapItemVal.getString(); !ok {
+								goAddErrStr(int(translationIDIndex), translationIDName, "%s.%s.%s: Must be a string", namespaceName, translationIDName, propName)
+							} else {
+								varProps = append(varProps, propName, propVal)
 							}
-						} else {
-							goAddErrStr("%s.%s: Invalid type: Must be a string or dictionary", namespaceName, translationIDName)
-							return
 						}
+					} else {
+						goAddErrStr(int(translationIDIndex), translationIDName, "%s.%s: Invalid type: Must be a string or dictionary", namespaceName, translationIDName)
+						return
+					}
 
-						//Compile the translations and store its errors, warnings, strings, and rules
-						translationErrors, translationWarnings, retStrings, retPluralRules, retEmbeddedTIDs := addTranslationIDFromTextFile(varProps, namespaceName, l.dict, &(*idsInOrderPointer)[translationIDIndex], allowBigStrings)
-						myNamespaceReturnData.stringsData[translationIDIndex] = retStrings
-						myNamespaceReturnData.pluralRules[translationIDIndex] = retPluralRules
-						myNamespaceReturnData.embeddedTIDs[translationIDIndex] = retEmbeddedTIDs
-						for _, err := range translationErrors {
-							goAddErrStr("%s.%s: %s", namespaceName, translationIDName, err)
+					//Convert translations written in ICU MessageFormat or i18next format
+					if msgFormat != mfGol10n {
+						var formatWarnings []string
+						var err error
+						if msgFormat == mfICU {
+							varProps, formatWarnings, err = icuPropsToGol10n(varProps, isDefaultLanguage)
+						} else {
+							varProps, formatWarnings, err = i18nextPropsToGol10n(varProps, isDefaultLanguage, (*idsInOrderPointer)[translationIDIndex].vars)
 						}
-						for _, warn := range translationWarnings {
-							goAddWarnStr("%s.%s: %s", namespaceName, translationIDName, warn)
+						if err != nil {
+							goAddErrStr(int(translationIDIndex), translationIDName, "%s.%s: %s", namespaceName, translationIDName, err.Error())
+							return
 						}
-
-						//Add error if there are 0 rules
-						if len(retPluralRules) == 0 {
-							goAddErrStr("%s.%s: Translation has no rules", namespaceName, translationIDName)
+						for _, warn := range formatWarnings {
+							goAddWarnStr(int(translationIDIndex), "%s.%s: %s", namespaceName, translationIDName, warn)
 						}
-					}(uint(_translationIDIndex), translationID.name)
-				}
+					}
 
-				//Wait for Translation IDs go routines to complete
-				waitForTranslationIDs.Wait()
+					//Compile the translations and store its errors, warnings, strings, and rules
+					translationErrors, translationWarnings, retStrings, retPluralRules, retEmbeddedTIDs := addTranslationIDFromTextFile(varProps, namespaceName, l.dict, &(*idsInOrderPointer)[translationIDIndex], &escapes, dateTimes, hasSpellout, l.registers, allowBigStrings)
+					if !limiter.addTranslation(namespaceName+"."+translationIDName, retStrings, len(retPluralRules)) {
+						return
+					}
+					myNamespaceReturnData.stringsData[translationIDIndex] = retStrings
+					myNamespaceReturnData.pluralRules[translationIDIndex] = retPluralRules
+					myNamespaceReturnData.embeddedTIDs[translationIDIndex] = retEmbeddedTIDs
+					for _, err := range translationErrors {
+						goAddErrStr(int(translationIDIndex), translationIDName, "%s.%s: %s", namespaceName, translationIDName, err)
+					}
+					for _, warn := range translationWarnings {
+						goAddWarnStr(int(translationIDIndex), "%s", prefixWarning(namespaceName+"."+translationIDName, warn))
+					}
 
-				//Add warnings about extra translation IDs
-				for translationID := range readTranslations {
-					goAddWarnStr("%s.%s: Extra translation in namespace", namespaceName, translationID)
-				}
-			}()
-		}
+					//Add error if there are 0 rules
+					if len(retPluralRules) == 0 {
+						goAddErrStr(int(translationIDIndex), translationIDName, "%s.%s: Translation has no rules", namespaceName, translationIDName)
+					}
+				}(uint(_translationIDIndex), translationID.name)
+			}
 
-		//Add warnings about extra namespaces
-		for namespaceName := range readNamespaces {
-			addWarnStr("%s: Extra namespace", namespaceName)
+			//Add warnings about extra translation IDs after the namespace’s Translation IDs
+			for _, translationID := range sortedKeys(readTranslations) {
+				goAddWarnStr(len(*idsInOrderPointer), "%s %s.%s: Extra translation in namespace", WC_ExtraTranslation, namespaceName, translationID)
+			}
 		}
 
-		//Wait for namespace go routines to complete, and store errors and warnings
-		doneWithNamespaces := make(chan struct{})
-		go func() {
-			waitForNamespaces.Wait()
-			close(doneWithNamespaces)
-		}()
-		for continueLoop := true; continueLoop; {
-			select {
-			case err := <-errChan:
-				addErrStr(err)
-			case warn := <-warnChan:
-				addWarnStr(warn)
-			case <-doneWithNamespaces:
-				continueLoop = false
+		//Wait for the Translation IDs go routines to complete and store their errors and warnings in order. Nothing else is compiled if the memory limit was exceeded
+		waitForTranslationIDs.Wait()
+		for _, msg := range sortCompileMessages(compileMessages) {
+			if msg.isError {
+				errors = append(errors, &ParseError{Namespace: l.dict.namespacesInOrder[msg.namespaceIndex], ID: msg.translationID, Message: msg.message})
+			} else {
+				warnings = append(warnings, msg.message)
 			}
 		}
+		if limiter.hasExceeded() {
+			return
+		}
 
-		//Make sure error and warning channels are exhausted
-		for continueLoop := true; continueLoop; {
-			select {
-			case err := <-errChan:
-				addErrStr(err)
-			case warn := <-warnChan:
-				addWarnStr(warn)
-			default:
-				continueLoop = false
-			}
+		//Add warnings about extra namespaces
+		for _, namespaceName := range sortedKeys(readNamespaces) {
+			addWarnStr("%s %s: Extra namespace", WC_ExtraNamespace, namespaceName)
 		}
 	}
 
@@ -354,9 +485,14 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 			return nil
 		}
 
-		//Check each translation for a loop recursion
-		for curTID, listTID := range embeddedTIDs {
-			if _errList := recurseTIDs(curTID, nil, listTID); _errList != nil {
+		//Check each translation for a loop recursion, in order so the same loop is always reported
+		checkTIDs := make([]TransIndex, 0, len(embeddedTIDs))
+		for curTID := range embeddedTIDs {
+			checkTIDs = append(checkTIDs, curTID)
+		}
+		sort.Slice(checkTIDs, func(i, j int) bool { return checkTIDs[i] < checkTIDs[j] })
+		for _, curTID := range checkTIDs {
+			if _errList := recurseTIDs(curTID, nil, embeddedTIDs[curTID]); _errList != nil {
 				//Build the list of TID names that have loop recursion
 				names := make([]string, len(_errList))
 				for i, s := range _errList {
@@ -373,22 +509,13 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 		}
 	}
 
-	//Check if any of the translation strings require storeTranslationRule32
-	translationStringByteLength := size_storeTranslationRule16
-	for i := 0; i < len(l.rules)-1; i++ {
-		if l.rules[i+1].startPos-l.rules[i].startPos > math.MaxUint16 {
-			translationStringByteLength = size_storeTranslationRule32
-			break
-		}
-	}
-
 	//Check the soft caps
 	settingsStringLen := len(l.getSettingsAsString())
 	if err := checkFor32BitOverflow(len(l.rules), len(l.translations), settingsStringLen, len(l.stringsData)); err != nil {
 		return addErrStr(err.Error())
 	}
 	header := storeHeader{
-		[3]byte{}, uint8(translationStringByteLength),
+		[2]byte{}, CompiledFormatVersion, uint8(l.getTranslationStringByteLength()),
 		ulen32(l.rules) - 1, l.NumTranslations(),
 		uint32(settingsStringLen), ulen32(l.stringsData), [20]byte{},
 	}
@@ -404,14 +531,13 @@ func (l *Language) fromTextFile(topItem tpItem, dict *languageDict, allowBigStri
 	return
 }
 
-func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
-	//Handle errors
-	addErrStr := func(err string, args ...interface{}) {
+func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []*ParseError) {
+	//Handle errors. namespaceName and translationID are empty when the error is not inside them
+	addErrStr := func(namespaceName, translationID, err string, args ...interface{}) {
 		if len(args) != 0 {
-			errors = append(errors, fmt.Sprintf(err, args...))
-		} else {
-			errors = append(errors, err)
+			err = fmt.Sprintf(err, args...)
 		}
+		errors = append(errors, &ParseError{Namespace: namespaceName, ID: translationID, Message: err})
 	}
 
 	//Read the namespaces
@@ -424,23 +550,23 @@ func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
 		if namespaceName == "Settings" {
 			continue
 		} else if len(namespaceName) > math.MaxUint8 {
-			addErrStr("Namespace “%s” cannot be longer than 255 bytes", namespaceName)
+			addErrStr(namespaceName, "", "Namespace “%s” cannot be longer than 255 bytes", namespaceName)
 			continue
 		} else if !regexMatchNamespaceName.MatchString(namespaceName) {
-			addErrStr("Namespace “%s” can only contain alphanumeric and underscore characters", namespaceName)
+			addErrStr(namespaceName, "", "Namespace “%s” can only contain alphanumeric and underscore characters", namespaceName)
 			continue
 		} else if namespaceName[0] >= '0' && namespaceName[0] <= '9' {
-			addErrStr("Namespace “%s” cannot start with a digit", namespaceName)
+			addErrStr(namespaceName, "", "Namespace “%s” cannot start with a digit", namespaceName)
 			continue
 		} else if _, ok := dict.namespaces[namespaceName]; ok {
-			addErrStr("Namespace “%s” used more than once", namespaceName)
+			addErrStr(namespaceName, "", "Namespace “%s” used more than once", namespaceName)
 			continue
 		}
 
 		//Get the list of translation IDs
 		var idsList tpMap
 		if _translationIDs, ok := itemVal.getObject(); !ok {
-			addErrStr("Namespace “%s” is not a dictionary", namespaceName)
+			addErrStr(namespaceName, "", "Namespace “%s” is not a dictionary", namespaceName)
 			continue
 		} else {
 			idsList = _translationIDs
@@ -452,6 +578,7 @@ func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
 			ulenm(dict.namespaces),
 			make(translationIDs, idsList.getLength()),
 			make([]translationIDNameAndVars, 0, idsList.getLength()),
+			NamespaceMetadata{},
 		}
 		dict.namespaces[namespaceName] = &myNamespace
 		dict.namespacesInOrder = append(dict.namespacesInOrder, namespaceName)
@@ -461,14 +588,18 @@ func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
 		for _, val := range idsList.toOrdered() {
 			//Check the Translation ID
 			translationID := val.getName()
-			if len(translationID) > math.MaxUint16 {
-				addErrStr("%s.%s: Must be smaller than 64KB", namespaceName, translationID)
-			} else if translationID[0] < 'A' || translationID[0] > 'Z' {
-				addErrStr("%s.%s: Must start with an upper case character (A-Z)", namespaceName, translationID)
+			if translationID == namespaceMetadataName {
+				myNamespace.metadata = readNamespaceMetadata(val, namespaceName, func(err string, args ...interface{}) {
+					addErrStr(namespaceName, namespaceMetadataName, err, args...)
+				})
+			} else if len(translationID) > math.MaxUint16 {
+				addErrStr(namespaceName, translationID, "%s.%s: Must be smaller than 64KB", namespaceName, translationID)
+			} else if len(translationID) == 0 || translationID[0] < 'A' || translationID[0] > 'Z' {
+				addErrStr(namespaceName, translationID, "%s.%s: Must start with an upper case character (A-Z)", namespaceName, translationID)
 			} else if !regexMatchTranslationID.MatchString(translationID) {
-				addErrStr("%s.%s: Can only contain unicode letters, unicode numbers, and underscores", namespaceName, translationID)
+				addErrStr(namespaceName, translationID, "%s.%s: Can only contain unicode letters, unicode numbers, and underscores", namespaceName, translationID)
 			} else if _, ok := myNamespace.ids[translationID]; ok {
-				addErrStr("%s.%s: Used more than once", namespaceName, translationID)
+				addErrStr(namespaceName, translationID, "%s.%s: Used more than once", namespaceName, translationID)
 			} else {
 				//Store the index
 				myNamespace.ids[translationID] = TransIndex(numTranslations)
@@ -481,15 +612,15 @@ func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
 
 	//Check soft and hard caps
 	header := storeDictHeader{
-		[3]byte{}, uint32(numTranslations),
+		[2]byte{}, CompiledFormatVersion, uint32(numTranslations),
 		ulen32m(dict.namespaces), uint32(idsSize), uint32(namespacesSize),
 	}
 	if err := checkFor32BitOverflow(numTranslations, uint64(len(dict.namespaces)), namespacesSize, idsSize); err != nil {
-		addErrStr(err.Error())
+		addErrStr("", "", err.Error())
 	} else if err := header.checkSoftCaps(); err != nil {
-		addErrStr(err.Error())
+		addErrStr("", "", err.Error())
 	} else if header.getCompiledFileSize() > math.MaxUint32 {
-		addErrStr("Final dictionary file size cannot be larger than 4GB")
+		addErrStr("", "", "Final dictionary file size cannot be larger than 4GB")
 	} else {
 		//Get the dictionary hash
 		_ = dict.toCompiledFile(io.Discard)
@@ -498,6 +629,76 @@ func (dict *languageDict) fromTextFile(readNamespaces tpMap) (errors []string) {
 	return
 }
 
+// Makes sure the dictionary read from the text file has the same namespaces and Translation IDs as the current language’s, and copies the variables of the namespaces that are not being recompiled
+func (dict *languageDict) fromPartialCompile(partial *partialCompile) (errors []*ParseError) {
+	currentDict := partial.current.dict
+	if !bytes.Equal(dict.hash, currentDict.hash) {
+		return toParseErrors([]string{"The namespaces or Translation IDs changed, which shifts the indexes. A full compile is needed"})
+	}
+	for _, namespaceName := range sortedKeys(partial.namespaces) {
+		if _, ok := dict.namespaces[namespaceName]; !ok {
+			errors = append(errors, &ParseError{Namespace: namespaceName, Message: fmt.Sprintf("Namespace “%s” does not exist", namespaceName)})
+		}
+	}
+	for namespaceName, n := range dict.namespaces {
+		if !partial.namespaces[namespaceName] {
+			for i, tid := range currentDict.namespaces[namespaceName].idsInOrder {
+				n.idsInOrder[i].vars = tid.vars
+			}
+		}
+	}
+	return
+}
+
+// Copies the strings and rules of the namespace’s translations from the language
+func (l *Language) copyNamespaceTranslations(n *namespace, stringsData [][][]byte, pluralRules [][]pluralRule) error {
+	if len(n.idsInOrder) == 0 {
+		return nil
+	}
+	startTID := uint32(n.ids[n.idsInOrder[0].name])
+	for i := range n.idsInOrder {
+		startRule, endRule := l.translations[startTID+uint32(i)].startIndex, l.translations[startTID+uint32(i)+1].startIndex
+		for ruleIndex := startRule; ruleIndex < endRule; ruleIndex++ {
+			str, err := l.ruleString(ruleIndex)
+			if err != nil {
+				return err
+			}
+			stringsData[i] = append(stringsData[i], str)
+			pluralRules[i] = append(pluralRules[i], l.rules[ruleIndex].rule)
+		}
+	}
+	return nil
+}
+
+// The name of the object in a namespace that holds its metadata
+const namespaceMetadataName = "_Metadata"
+
+// Reads a namespace’s metadata object
+func readNamespaceMetadata(item tpItem, namespaceName string, addErrStr func(err string, args ...interface{})) (metadata NamespaceMetadata) {
+	metadataObj, ok := item.getObject()
+	if !ok {
+		addErrStr("%s.%s: Must be a dictionary", namespaceName, namespaceMetadataName)
+		return
+	}
+
+	for _, prop := range metadataObj.toOrdered() {
+		propName := prop.getName()
+		if propVal, ok := prop.getString(); !ok {
+			addErrStr("%s.%s.%s: Must be a string", namespaceName, namespaceMetadataName, propName)
+		} else if len(propName) > math.MaxUint8 {
+			addErrStr("%s.%s.%s: Name cannot be longer than 255 bytes", namespaceName, namespaceMetadataName, propName)
+		} else if len(propVal) > math.MaxUint16 {
+			addErrStr("%s.%s.%s: Must be smaller than 64KB", namespaceName, namespaceMetadataName, propName)
+		} else {
+			metadata.set(propName, propVal)
+		}
+	}
+	if len(metadata.getProperties()) > math.MaxUint8 {
+		addErrStr("%s.%s: Cannot have more than 255 properties", namespaceName, namespaceMetadataName)
+	}
+	return
+}
+
 // -------------------Interface to access text processing maps-------------------
 type tpMap interface {
 	getValue(string) (val tpItem, ok bool)
@@ -0,0 +1,131 @@
+//Convert from TOML files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// The TOML decoder returns unordered maps, so the items are rebuilt in document order from its list of keys
+type tomlMapSlice []tomlItem
+type tomlItem struct {
+	name  string
+	value interface{} //Either *tomlMapSlice or a scalar
+}
+
+func (ms *tomlMapSlice) getValue(paramName string) (val tpItem, ok bool) {
+	for _, v := range *ms {
+		if v.name == paramName {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (ms *tomlMapSlice) toMap() map[string]tpItem {
+	retVal := make(map[string]tpItem, len(*ms))
+	for _, v := range *ms {
+		retVal[v.name] = v
+	}
+	return retVal
+}
+
+func (ms *tomlMapSlice) toOrdered() []tpItem {
+	ret := make([]tpItem, len(*ms))
+	for i, v := range *ms {
+		ret[i] = v
+	}
+	return ret
+}
+
+func (ms *tomlMapSlice) getLength() uint {
+	return ulen(*ms)
+}
+
+func (i tomlItem) getName() string {
+	return i.name
+}
+
+func (i tomlItem) getObject() (val tpMap, ok bool) {
+	val, ok = i.value.(*tomlMapSlice)
+	return
+}
+
+func (i tomlItem) getString() (val string, ok bool) {
+	switch v := i.value.(type) {
+	case string:
+		return v, true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case time.Time:
+		return v.Format(time.RFC3339Nano), true
+	case fmt.Stringer: //toml.LocalDate, toml.LocalTime, and toml.LocalDatetime
+		return v.String(), true
+	default:
+		return returnBlankStrOnErr, false
+	}
+}
+
+func fromTomlFile(textStr []byte) (tomlItem, error) {
+	//Check for valid utf8
+	if !utf8.Valid(textStr) {
+		return tomlItem{}, errors.New("File is not utf8 valid")
+	}
+
+	//Decode the file
+	var raw map[string]interface{}
+	md, err := toml.Decode(b2s(textStr), &raw)
+	if err != nil {
+		return tomlItem{}, errors.New("Error parsing TOML File: " + err.Error())
+	}
+
+	//Rebuild the items in document order. Parent tables are created when first seen (including implicit tables from dotted keys)
+	root := &tomlMapSlice{}
+	for _, key := range md.Keys() {
+		curMS, curRaw := root, raw
+		for partIndex, part := range key {
+			//Get the raw value
+			rawVal := curRaw[part]
+			rawMap, isMap := rawVal.(map[string]interface{})
+			switch rawVal.(type) {
+			case []interface{}, []map[string]interface{}:
+				return tomlItem{}, fmt.Errorf("Error parsing TOML File: “%s” cannot be an array", strings.Join(key[0:partIndex+1], "."))
+			}
+
+			//Find or add the item
+			var item *tomlItem
+			for i := range *curMS {
+				if (*curMS)[i].name == part {
+					item = &(*curMS)[i]
+					break
+				}
+			}
+			if item == nil {
+				var newVal interface{} = rawVal
+				if isMap {
+					newVal = &tomlMapSlice{}
+				}
+				*curMS = append(*curMS, tomlItem{part, newVal})
+				item = &(*curMS)[len(*curMS)-1]
+			}
+
+			//Move to the child table
+			if isMap {
+				curMS, curRaw = item.value.(*tomlMapSlice), rawMap
+			}
+		}
+	}
+
+	return tomlItem{"TOP", root}, nil
+}
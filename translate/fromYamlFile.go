@@ -5,6 +5,7 @@ package translate
 
 import (
 	"errors"
+	"fmt"
 	"gopkg.in/yaml.v2"
 	"strconv"
 	"unicode/utf8"
@@ -88,7 +89,7 @@ func yamlValToStr(i interface{}) (val string, ok bool) {
 	}
 }
 
-func fromYamlFile(textStr []byte) (yamlItem, error) {
+func fromYamlFile(textStr []byte, strictStrings bool) (yamlItem, error) {
 	//Check for valid utf8
 	if !utf8.Valid(textStr) {
 		return yamlItem{}, errors.New("File is not utf8 valid")
@@ -99,5 +100,41 @@ func fromYamlFile(textStr []byte) (yamlItem, error) {
 		return yamlItem{}, errors.New("Error parsing YAML File: " + err.Error())
 	}
 
+	//Do not allow duplicate keys
+	if err := checkYamlDuplicateKeys(textStr); err != nil {
+		return yamlItem{}, err
+	} else if err := checkYamlDuplicateNames(ms, ""); err != nil {
+		return yamlItem{}, err
+	}
+
+	//Do not allow non-string scalars if requested
+	if strictStrings {
+		if err := checkYamlStrictStrings(ms, ""); err != nil {
+			return yamlItem{}, err
+		}
+	}
+
 	return yamlItem{Key: "TOP", Value: ms}, nil
 }
+
+// Returns an error on the first key or value that is not a string or an object (like unquoted numbers, booleans, and nulls)
+func checkYamlStrictStrings(ms yamlMapSlice, path string) error {
+	for _, item := range ms {
+		name, _ := yamlValToStr(item.Key)
+		if _, ok := item.Key.(string); !ok {
+			return fmt.Errorf("Key “%s%s” must be quoted, as strict strings are required", path, name)
+		}
+
+		switch v := item.Value.(type) {
+		case string:
+		case yamlMapSlice:
+			if err := checkYamlStrictStrings(v, path+name+"."); err != nil {
+				return err
+			}
+		default:
+			valStr, _ := yamlValToStr(v)
+			return fmt.Errorf("“%s%s” must be a quoted string (read as “%s”), as strict strings are required", path, name, valStr)
+		}
+	}
+	return nil
+}
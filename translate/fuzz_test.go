@@ -0,0 +1,126 @@
+//Fuzz targets for the in-memory parse entry points
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"testing"
+)
+
+// A small default language translation text file used as a fuzzing seed
+const fuzzSeedYAML = `Settings:
+  LanguageName: English
+  LanguageIdentifier: en-US
+  MissingPluralRule: M
+NS:
+  A:
+    =1: "one {{*B}}"
+    ^: "many {{*B}}"
+  B: "b {{1:Name}}"
+  C: "c"
+`
+
+// Returns the seed text file compiled into an uncompressed .gtr file
+func fuzzSeedGTR(t testing.TB) []byte {
+	l, _, err := ParseTranslationText([]byte(fuzzSeedYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := l.SaveGTR(&b, false); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// The largest input FuzzParseTranslationText parses. The YAML parser takes superlinear time on deeply nested and aliased input, so larger inputs only make the fuzzer report timeouts
+const fuzzMaxTextSize = 1 << 12
+
+func FuzzParseTranslationText(f *testing.F) {
+	f.Add([]byte(fuzzSeedYAML))
+	f.Add([]byte(`{"Settings":{"LanguageName":"English","LanguageIdentifier":"en-US","MissingPluralRule":"M"},"NS":{"A":"a","B":{"=1":"b1","^":"bN"}}}`))
+	f.Add([]byte(`NS: {"": x}`))
+	f.Add([]byte(`{"NS":{"":"x"}}`))
+	f.Add([]byte("A: \n  &0:"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		if len(b) > fuzzMaxTextSize {
+			t.Skip()
+		}
+		l, _, err := ParseTranslationText(b)
+		if (l == nil) == (err == nil) {
+			t.Fatalf("Exactly one of the language (%v) and the error (%v) must be returned", l != nil, err)
+		}
+	})
+}
+
+func FuzzParseGTR(f *testing.F) {
+	gtr := fuzzSeedGTR(f)
+	f.Add(gtr)
+	f.Add(gtr[:len(gtr)/2])
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, b []byte) {
+		l, err := ParseGTR(b)
+		if (l == nil) == (err == nil) {
+			t.Fatalf("Exactly one of the language (%v) and the error (%v) must be returned", l != nil, err)
+		}
+	})
+}
+
+// Returns the seed text file's dictionary as an uncompressed .gtr dictionary file
+func fuzzSeedGTRDict(t testing.TB) []byte {
+	l, _, err := ParseTranslationText([]byte(fuzzSeedYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := l.SaveGTRDict(&b, false); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func FuzzParseGTRDictionary(f *testing.F) {
+	dict := fuzzSeedGTRDict(f)
+	f.Add(dict)
+	f.Add(dict[:len(dict)/2])
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, b []byte) {
+		namespaces, err := ParseGTRDictionary(b)
+		if (namespaces == nil) == (err == nil) {
+			t.Fatalf("Exactly one of the namespaces (%v) and the error (%v) must be returned", namespaces != nil, err)
+		}
+	})
+}
+
+// Dictionaries whose Translation IDs are not unique must return an error instead of panicking or dropping translations
+func TestParseGTRDictionaryDuplicates(t *testing.T) {
+	dict := fuzzSeedGTRDict(t)
+	if namespaces, err := ParseGTRDictionary(dict); err != nil {
+		t.Fatal(err)
+	} else if ids := namespaces["NS"]; len(ids) != 3 || ids[0] != "A" || ids[1] != "B" || ids[2] != "C" {
+		t.Fatalf("Returned the Translation IDs %v but expected [A B C]", ids)
+	}
+
+	//Rename the Translation IDs (which are stored together as “ABC”)
+	pos := bytes.Index(dict, []byte("ABC"))
+	if pos == -1 {
+		t.Fatal("The Translation IDs were not found")
+	}
+	for _, ids := range []string{"AAC", "ABA", "CCC"} {
+		b := append([]byte(nil), dict...)
+		copy(b[pos:], ids)
+		if namespaces, err := ParseGTRDictionary(b); err == nil {
+			t.Errorf("%s: Returned %v but expected an error", ids, namespaces)
+		}
+	}
+}
+
+// Empty Translation IDs must return an error instead of panicking
+func TestParseTranslationTextEmptyTranslationID(t *testing.T) {
+	for _, text := range []string{"NS: {\"\": x}", `{"NS":{"":"x"}}`, "A: \n  &0:"} {
+		if _, _, err := ParseTranslationText([]byte(text)); err == nil {
+			t.Errorf("%q: Expected an error", text)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+//Generates lctimeLocales.go from the locales of github.com/klauspost/lctime. Run with “go generate” in this directory
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	//Get the locale files of lctime
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/klauspost/lctime").Output()
+	if err != nil {
+		return fmt.Errorf("Error finding module github.com/klauspost/lctime: %s", err.Error())
+	}
+	localeFiles, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(out)), "internal", "locales", "*.json"))
+	if err != nil {
+		return err
+	} else if len(localeFiles) == 0 {
+		return fmt.Errorf("No lctime locales were found")
+	}
+
+	//Write the composite formats of each locale (the glob is sorted)
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_lctimeLocales.go from the locales of github.com/klauspost/lctime. DO NOT EDIT.\n//go:build !gol10n_minimal || !gol10n_read_compiled_only\n\npackage translate\n\n")
+	b.WriteString("// The composite formats of each lctime locale (which lctime does not export), keyed by their locale identifier\nvar lctimeLocales = map[string]lctimeLocale{\n")
+	for _, f := range localeFiles {
+		var locale struct {
+			Date, DateTime, Time, TimeAMPM string
+			AMPM                           []string
+		}
+		if data, err := os.ReadFile(f); err != nil {
+			return err
+		} else if err := json.Unmarshal(data, &locale); err != nil {
+			return fmt.Errorf("%s: %s", f, err.Error())
+		}
+		hasAMPM := len(locale.AMPM) >= 2 && len(locale.AMPM[0]) != 0
+		fmt.Fprintf(&b, "\t%q: {%q, %q, %q, %q, %t},\n", strings.TrimSuffix(filepath.Base(f), ".json"), locale.Date, locale.DateTime, locale.Time, locale.TimeAMPM, hasAMPM)
+	}
+	b.WriteString("}\n")
+
+	//Format it through gofmt
+	cmd := exec.Command("gofmt", "-s")
+	cmd.Stdin, cmd.Stderr = &b, os.Stderr
+	formatted, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("lctimeLocales.go", formatted, 0o644)
+}
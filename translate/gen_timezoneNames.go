@@ -0,0 +1,273 @@
+//Generates timezoneNames.go from the CLDR data of golang.org/x/text/date. Run with “go generate” in this directory
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The metazone of each time zone (from CLDR’s metaZones.xml, as it is not included in golang.org/x/text). Only the zones’ current metazones are used. Keys with a “/” are time zones in zonesWithOwnNames
+var zoneToMetazone = map[string][]string{
+	"Africa_Central":    {"Africa/Blantyre", "Africa/Bujumbura", "Africa/Gaborone", "Africa/Harare", "Africa/Kigali", "Africa/Lubumbashi", "Africa/Lusaka", "Africa/Maputo"},
+	"Africa_Eastern":    {"Africa/Addis_Ababa", "Africa/Asmara", "Africa/Dar_es_Salaam", "Africa/Djibouti", "Africa/Kampala", "Africa/Mogadishu", "Africa/Nairobi", "Indian/Antananarivo", "Indian/Comoro", "Indian/Mayotte"},
+	"Africa_Southern":   {"Africa/Johannesburg", "Africa/Maseru", "Africa/Mbabane"},
+	"Africa_Western":    {"Africa/Bangui", "Africa/Brazzaville", "Africa/Douala", "Africa/Kinshasa", "Africa/Lagos", "Africa/Libreville", "Africa/Luanda", "Africa/Malabo", "Africa/Ndjamena", "Africa/Niamey", "Africa/Porto-Novo"},
+	"Alaska":            {"America/Anchorage", "America/Juneau", "America/Nome", "America/Sitka", "America/Yakutat", "US/Alaska"},
+	"America_Central":   {"America/Bahia_Banderas", "America/Belize", "America/Chicago", "America/Costa_Rica", "America/El_Salvador", "America/Guatemala", "America/Indiana/Knox", "America/Managua", "America/Matamoros", "America/Menominee", "America/Merida", "America/Mexico_City", "America/Monterrey", "America/Regina", "America/Tegucigalpa", "America/Winnipeg", "CST6CDT", "US/Central"},
+	"America_Eastern":   {"America/Cancun", "America/Cayman", "America/Detroit", "America/Indiana/Indianapolis", "America/Indianapolis", "America/Iqaluit", "America/Jamaica", "America/Kentucky/Louisville", "America/Louisville", "America/Nassau", "America/New_York", "America/Panama", "America/Port-au-Prince", "America/Toronto", "EST", "EST5EDT", "US/Eastern"},
+	"America_Mountain":  {"America/Boise", "America/Denver", "America/Edmonton", "America/Phoenix", "MST", "MST7MDT", "US/Arizona", "US/Mountain"},
+	"America_Pacific":   {"America/Los_Angeles", "America/Tijuana", "America/Vancouver", "PST8PDT", "US/Pacific"},
+	"Arabian":           {"Asia/Aden", "Asia/Baghdad", "Asia/Bahrain", "Asia/Kuwait", "Asia/Qatar", "Asia/Riyadh"},
+	"Argentina":         {"America/Argentina/Buenos_Aires", "America/Argentina/Cordoba", "America/Buenos_Aires", "America/Cordoba"},
+	"Atlantic":          {"America/Barbados", "America/Halifax", "America/Martinique", "America/Port_of_Spain", "America/Puerto_Rico", "America/Santo_Domingo", "Atlantic/Bermuda"},
+	"Australia_Central": {"Australia/Adelaide", "Australia/Darwin"},
+	"Australia_Eastern": {"Australia/Brisbane", "Australia/Hobart", "Australia/Melbourne", "Australia/Sydney"},
+	"Australia_Western": {"Australia/Perth"},
+	"Bangladesh":        {"Asia/Dhaka"},
+	"Brasilia":          {"America/Bahia", "America/Belem", "America/Fortaleza", "America/Recife", "America/Sao_Paulo"},
+	"Chile":             {"America/Santiago"},
+	"China":             {"Asia/Chongqing", "Asia/Shanghai", "PRC"},
+	"Colombia":          {"America/Bogota"},
+	"Europe_Central":    {"Africa/Algiers", "Africa/Ceuta", "Africa/Tunis", "CET", "Europe/Amsterdam", "Europe/Andorra", "Europe/Belgrade", "Europe/Berlin", "Europe/Bratislava", "Europe/Brussels", "Europe/Budapest", "Europe/Copenhagen", "Europe/Gibraltar", "Europe/Ljubljana", "Europe/Luxembourg", "Europe/Madrid", "Europe/Malta", "Europe/Monaco", "Europe/Oslo", "Europe/Paris", "Europe/Podgorica", "Europe/Prague", "Europe/Rome", "Europe/San_Marino", "Europe/Sarajevo", "Europe/Skopje", "Europe/Stockholm", "Europe/Tirane", "Europe/Vaduz", "Europe/Vatican", "Europe/Vienna", "Europe/Warsaw", "Europe/Zagreb", "Europe/Zurich", "MET"},
+	"Europe_Eastern":    {"Africa/Cairo", "Africa/Tripoli", "Asia/Beirut", "Asia/Nicosia", "EET", "Egypt", "Europe/Athens", "Europe/Bucharest", "Europe/Chisinau", "Europe/Helsinki", "Europe/Kaliningrad", "Europe/Kiev", "Europe/Kyiv", "Europe/Riga", "Europe/Sofia", "Europe/Tallinn", "Europe/Vilnius"},
+	"Europe_Western":    {"Atlantic/Canary", "Atlantic/Faroe", "Atlantic/Madeira", "Europe/Lisbon", "WET"},
+	"GMT":               {"Africa/Abidjan", "Africa/Accra", "Africa/Bamako", "Africa/Dakar", "Africa/Monrovia", "Atlantic/Reykjavik", "Etc/GMT", "Europe/Dublin", "Europe/London", "GMT"},
+	"Gulf":              {"Asia/Dubai", "Asia/Muscat"},
+	"Hawaii_Aleutian":   {"America/Adak", "Pacific/Honolulu", "US/Hawaii"},
+	"Hong_Kong":         {"Asia/Hong_Kong", "Hongkong"},
+	"India":             {"Asia/Calcutta", "Asia/Kolkata"},
+	"Indochina":         {"Asia/Bangkok", "Asia/Ho_Chi_Minh", "Asia/Phnom_Penh", "Asia/Saigon", "Asia/Vientiane"},
+	"Indonesia_Western": {"Asia/Jakarta", "Asia/Pontianak"},
+	"Iran":              {"Asia/Tehran", "Iran"},
+	"Israel":            {"Asia/Jerusalem", "Asia/Tel_Aviv", "Israel"},
+	"Japan":             {"Asia/Tokyo", "Japan"},
+	"Korea":             {"Asia/Seoul", "ROK"},
+	"Malaysia":          {"Asia/Kuala_Lumpur", "Asia/Kuching"},
+	"Moscow":            {"Europe/Moscow", "Europe/Simferopol", "W-SU"},
+	"New_Zealand":       {"NZ", "Pacific/Auckland"},
+	"Newfoundland":      {"America/St_Johns"},
+	"Pakistan":          {"Asia/Karachi"},
+	"Peru":              {"America/Lima"},
+	"Philippines":       {"Asia/Manila"},
+	"Singapore":         {"Asia/Singapore", "Singapore"},
+	"Taipei":            {"Asia/Taipei", "ROC"},
+	"Etc/UTC":           {"Etc/UCT", "UCT", "UTC", "Universal", "Zulu"}, //Aliases of a time zone with its own names
+	"Venezuela":         {"America/Caracas"},
+}
+
+// The time zones that CLDR gives their own names, which are used instead of their metazone’s names
+var zonesWithOwnNames = []string{"Etc/UTC", "Europe/Dublin", "Europe/London", "Pacific/Honolulu"}
+
+// The test that is run inside a copy of golang.org/x/text/date to read its unexported CLDR tree. %s: The generated Go declarations of the metazones, the zones with their own names, and the languages
+const extractTest = `package date
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/internal/language/compact"
+	"golang.org/x/text/language"
+)
+
+%s
+
+func TestGol10nTimeZoneNames(t *testing.T) {
+	var out strings.Builder
+	quote := func(s string) string { return strconv.Quote(s) }
+	out.WriteString("// The names of each zoneNameKeys entry per base language. Empty names are not in CLDR\nvar zoneNameTable = map[string][]zoneNames{\n")
+	var gmt strings.Builder
+	gmt.WriteString("// The localized GMT formats of each base language\nvar zoneGMTFormats = map[string]zoneGMTFormat{\n")
+	for _, lang := range gol10nLanguages {
+		id, _ := compact.RegionalID(compact.Tag(language.MustParse(lang)))
+		var names strings.Builder
+		hasNames := false
+		lookup := func(keyType, key uint16, tt timeType) string {
+			s := tree.Lookup(id, timeZoneNames, keyType, uint16(long), uint16(tt), key)
+			hasNames = hasNames || len(s) != 0
+			return s
+		}
+		for _, key := range gol10nKeys {
+			keyType, enumKey := uint16(metaZone), key
+			if strings.Contains(key, "/") {
+				keyType, enumKey = uint16(zone), key
+			}
+			v, ok := enumMap[enumKey]
+			if !ok {
+				t.Fatalf("%%s is not in the CLDR data", key)
+			}
+			fmt.Fprintf(&names, "\t\t{%%s, %%s, %%s},\n", quote(lookup(keyType, v, genericTime)), quote(lookup(keyType, v, standardTime)), quote(lookup(keyType, v, daylightTime)))
+		}
+		if hasNames {
+			fmt.Fprintf(&out, "\t%%s: {\n%%s\t},\n", quote(lang), names.String())
+		}
+		fmt.Fprintf(&gmt, "\t%%s: {%%s, %%s, %%s},\n", quote(lang), quote(tree.Lookup(id, timeZoneNames, zoneFormat, gmtFormat)), quote(tree.Lookup(id, timeZoneNames, zoneFormat, gmtZeroFormat)), quote(tree.Lookup(id, timeZoneNames, zoneFormat, hourFormat)))
+	}
+	out.WriteString("}\n\n")
+	gmt.WriteString("}\n")
+	if err := os.WriteFile(os.Getenv("GOL10N_OUTPUT"), []byte(out.String()+gmt.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+`
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	//Get the metazones and the time zones with their own names
+	var metazones []string
+	for metazone := range zoneToMetazone {
+		if !strings.Contains(metazone, "/") {
+			metazones = append(metazones, metazone)
+		}
+	}
+	sort.Strings(metazones)
+	keys := append(metazones, zonesWithOwnNames...)
+	keyIndexes := make(map[string]int, len(keys))
+	for i, key := range keys {
+		keyIndexes[key] = i
+	}
+
+	//Get the base languages of the locales that lctime supports
+	lctimeDir, err := moduleDir("github.com/klauspost/lctime")
+	if err != nil {
+		return err
+	}
+	localeFiles, err := filepath.Glob(filepath.Join(lctimeDir, "internal", "locales", "*.json"))
+	if err != nil {
+		return err
+	}
+	languageSet := make(map[string]bool)
+	for _, f := range localeFiles {
+		lang := strings.FieldsFunc(strings.TrimSuffix(filepath.Base(f), ".json"), func(r rune) bool { return r == '_' || r == '@' || r == '.' })[0]
+		if lang != "POSIX" && lang != "i18n" && lang != "iw" { //iw is canonicalized to he
+			languageSet[lang] = true
+		}
+	}
+	var languages []string
+	for lang := range languageSet {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	//Copy golang.org/x/text so the test can be added to its date package
+	textDir, err := moduleDir("golang.org/x/text")
+	if err != nil {
+		return err
+	}
+	tmpDir, err := os.MkdirTemp("", "gol10n-x-text-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	if err := copyDir(textDir, tmpDir); err != nil {
+		return err
+	}
+
+	//Run the test
+	decls := fmt.Sprintf("var gol10nKeys = %#v\n\nvar gol10nLanguages = %#v\n", keys, languages)
+	if err := os.WriteFile(filepath.Join(tmpDir, "date", "zz_gol10n_test.go"), []byte(fmt.Sprintf(extractTest, decls)), 0o644); err != nil {
+		return err
+	}
+	tablesFile := filepath.Join(tmpDir, "gol10n_tables.txt")
+	cmd := exec.Command("go", "test", "-count=1", "-run", "TestGol10nTimeZoneNames", "./date")
+	cmd.Dir, cmd.Stdout, cmd.Stderr = tmpDir, os.Stderr, os.Stderr
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOL10N_OUTPUT="+tablesFile)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("Error reading the CLDR data: %s", err.Error())
+	}
+	tables, err := os.ReadFile(tablesFile)
+	if err != nil {
+		return err
+	}
+
+	//Write the file
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_timezoneNames.go from the CLDR data of golang.org/x/text/date. DO NOT EDIT.\n//go:build !gol10n_minimal\n\npackage translate\n\n")
+	b.WriteString("// The CLDR metazones, and then the time zones with their own names, in the order of the zoneNames of zoneNameTable\nvar zoneNameKeys = []string{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "\t%q,\n", key)
+	}
+	b.WriteString("}\n\n// The index in zoneNameKeys of each time zone’s metazone (or the time zone it is an alias of)\nvar zoneToMetazone = map[string]uint8{\n")
+	var zones []string
+	zoneIndexes := make(map[string]int)
+	for metazone, metazoneZones := range zoneToMetazone {
+		index, ok := keyIndexes[metazone]
+		if !ok {
+			return fmt.Errorf("%s is not a metazone or in zonesWithOwnNames", metazone)
+		}
+		for _, z := range metazoneZones {
+			zones = append(zones, z)
+			zoneIndexes[z] = index
+		}
+	}
+	sort.Strings(zones)
+	for _, z := range zones {
+		fmt.Fprintf(&b, "\t%q: %d,\n", z, zoneIndexes[z])
+	}
+	b.WriteString("}\n\n// The index in zoneNameKeys of each time zone with its own names\nvar zoneOwnNames = map[string]uint8{\n")
+	for _, z := range zonesWithOwnNames {
+		fmt.Fprintf(&b, "\t%q: %d,\n", z, keyIndexes[z])
+	}
+	b.WriteString("}\n\n")
+	b.Write(tables)
+
+	//Format it through gofmt
+	cmd = exec.Command("gofmt", "-s")
+	cmd.Stdin, cmd.Stderr = &b, os.Stderr
+	formatted, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("timezoneNames.go", formatted, 0o644)
+}
+
+// Returns the directory of a module in the module cache
+func moduleDir(module string) (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module).Output()
+	if err != nil {
+		return "", fmt.Errorf("Error finding module %s: %s", module, err.Error())
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Copies a directory tree, with all files writable
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(src, path)
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, in)
+		return err
+	})
+}
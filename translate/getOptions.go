@@ -0,0 +1,111 @@
+//Per-call formatting overrides for the Get() functions
+
+package translate
+
+import (
+	"golang.org/x/text/language"
+	"time"
+)
+
+// GetOption overrides how a Language formats its variables. See Language.With()
+type GetOption func(l *Language)
+
+// CurrencyDisplay is how Currency variables are displayed
+type CurrencyDisplay uint8
+
+const (
+	CD_Symbol       CurrencyDisplay = iota //The currency symbol (“US$” in most locales). This is the default
+	CD_NarrowSymbol                        //The narrow currency symbol (“$”)
+	CD_ISOCode                             //The ISO 4217 currency code (“USD”)
+)
+
+// With returns a copy of the language that formats its variables with the given overrides. The language itself is not modified, so this can be called per request on a shared language.
+//
+// The copy shares all translations with the language, and is cheap to create. Its Get() functions work the same as the language’s, including fallbacks and embedded translations, which are formatted with the overrides.
+func (l *Language) With(options ...GetOption) *Language {
+	newLang := *l
+	if len(options) != 0 {
+		newLang.renderCache = nil //Renders are cached per language, and the copy formats differently
+	}
+	for _, option := range options {
+		option(&newLang)
+	}
+	return &newLang
+}
+
+// WithLocaleOverride formats numbers, DateTimes, Spellouts, and Ordinals (and collates and matches strings) in the locale of the tag instead of the language’s. The language’s translations, plurality rules, and calendar are unchanged. The language’s NumberingSystem is not used, but a numbering system can be given in the tag (“-u-nu-”)
+func WithLocaleOverride(tag language.Tag) GetOption {
+	return func(l *Language) {
+		l.languageTag = tag
+		l.numberingSystem = ""
+		l.formatters = &languageFormatters{}
+	}
+}
+
+// WithTimeZone converts DateTime variables to the location before they are formatted. A nil location keeps the time.Time values as they are given
+func WithTimeZone(loc *time.Location) GetOption {
+	return func(l *Language) {
+		l.timeZone = loc
+	}
+}
+
+// WithCurrencyDisplay sets how Currency variables are displayed
+func WithCurrencyDisplay(display CurrencyDisplay) GetOption {
+	return func(l *Language) {
+		l.currencyDisplay = display
+	}
+}
+
+// WithCashRounding rounds Currency variables to the smallest amount that can be paid in cash (like 0.05 for CHF) instead of to the currency’s minor units (like 0.01 for CHF and 1 for JPY). Currency variables with the Cash option are always rounded for cash
+func WithCashRounding(enabled bool) GetOption {
+	return func(l *Language) {
+		l.cashRounding = enabled
+	}
+}
+
+// WithStrictPluralCounts makes the Plural functions return an error for plural counts above 4294967295 (the largest number a plurality rule can compare against), instead of only matching the “^”, “>”, “>=”, and “>max” overflow bucket rules. This is meant for code (like billing) where a count that large is always a bug
+func WithStrictPluralCounts(enabled bool) GetOption {
+	return func(l *Language) {
+		l.strictPluralCounts = enabled
+	}
+}
+
+// WithDisplayWidths counts the width and precision of String and Anything variables in display cells instead of runes, so padded CJK and emoji values line up in monospaced output. East Asian wide characters and emoji take 2 cells, combining marks and other zero-width characters take none, and a precision never splits a grapheme cluster
+func WithDisplayWidths(enabled bool) GetOption {
+	return func(l *Language) {
+		l.displayWidths = enabled
+	}
+}
+
+// WithNamespaceAccess only allows translations to be requested from namespaces that allowed returns true for, so multi-tenant servers can keep tenants from rendering each other’s namespaces. Requests from other namespaces return an error.
+//
+// Embedded translations (static and through VariableTranslation variables) are also checked, so the namespaces that allowed namespaces embed must also be allowed. A nil function allows all namespaces.
+//
+// allowed is called once per namespace when the option is applied, not per request, so later changes to what it returns are not seen
+func WithNamespaceAccess(allowed func(namespace string) bool) GetOption {
+	return func(l *Language) {
+		if allowed == nil {
+			l.namespaceAccess = nil
+			return
+		}
+
+		//Resolve the allowed namespaces into their TransIndex ranges once, so requests only have to check a bit
+		numTranslations := l.NumTranslations()
+		l.namespaceAccess = make([]uint64, (numTranslations+63)/64)
+		startIndex := uint32(0)
+		for _, nsName := range l.dict.namespacesInOrder {
+			endIndex := startIndex + ulen32m(l.dict.namespaces[nsName].ids)
+			if allowed(nsName) {
+				for i := startIndex; i < endIndex && i < numTranslations; i++ {
+					l.namespaceAccess[i>>6] |= 1 << (i & 63)
+				}
+			}
+			startIndex = endIndex
+		}
+	}
+}
+
+// Bit flags for Currency options in compiled specifiers
+const (
+	coCash = 1 << 0
+)
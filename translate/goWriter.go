@@ -16,7 +16,8 @@ import (
 	"sync"
 )
 
-func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ error, numUpdated uint) {
+// Writes the go dictionary files whose contents changed. If dryRun, nothing is written, but the files that would be written are still returned
+func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string, dryRun bool) (_ error, numUpdated uint, updatedFiles []string) {
 	//Constants
 	const (
 		namespaceHashesJson      = "NamespaceHashes.json"
@@ -25,15 +26,15 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 
 	//Make sure this is the default language
 	if l.fallback == nil {
-		return errors.New("Language.SetFallback() was not called on this language"), 0
+		return errors.New("Language.SetFallback() was not called on this language"), 0, nil
 	}
 	if l.fallback != l {
-		return errors.New("Only the default language file can be used to write go dictionary files"), 0
+		return errors.New("Only the default language file can be used to write go dictionary files"), 0, nil
 	}
 
 	//Make sure the languages were read from text (not compiled) files
 	if !l.dict.hasVarsLoaded {
-		return errors.New("Can only compile to go dictionaries when default language was read from translation text files"), 0
+		return errors.New("Can only compile to go dictionaries when default language was read from translation text files"), 0, nil
 	}
 
 	//Make sure output directory has a trailing slash
@@ -46,28 +47,38 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 		GoDictHeader = GoDictHeader + "\n"
 	}
 
-	//Namespaces wait until we have read the hash file to write their output
+	//Get namespace hashes from namespaceHashesJson
 	numNamespaces := ulenm(l.dict.namespaces)
-	waitForHashes := make(chan bool)
-	savedHashes := make(map[string]string, numNamespaces) //This is read after the namespace loop
+	savedHashes := make(map[string]string, numNamespaces)
+	if getHashes, err := os.ReadFile(outputDirectory + namespaceHashesJson); err != nil {
+		//If an error occurs assume we have no hashes
+	} else if err := json.Unmarshal(getHashes, &savedHashes); err != nil {
+		//If an error occurs assume we have no hashes
+	}
 
-	//Compile and write the different namespace
+	//Compile and write the different namespace, at most maxGoWriters at once
 	changedNamespaceHashes := make([]string, numNamespaces) //Empty if none changed
-	namespaceErrors := make(chan string)
+	namespaceErrors := make([]string, numNamespaces)        //Empty if there was no error. Stored by index so the errors are returned in namespace order
 	waitForNamespaces := sync.WaitGroup{}
+	semaphore := make(chan struct{}, maxGoroutinesOrDefault(l.dict.maxGoWriters))
 	for _namespaceIndex := uint(0); _namespaceIndex < numNamespaces; _namespaceIndex++ {
 		waitForNamespaces.Add(1)
+		semaphore <- struct{}{}
 		go func(namespaceIndex uint) {
 			//Mark namespace as complete when function is done
-			defer waitForNamespaces.Done()
+			defer func() {
+				<-semaphore
+				waitForNamespaces.Done()
+			}()
 
 			//Add the header to the namespace file
 			namespaceName := l.dict.namespacesInOrder[namespaceIndex]
+			n := l.dict.namespaces[namespaceName]
 			builder := bytes.Buffer{}
+			n.metadata.writeGoPackageDoc(&builder, namespaceName)
 			_, _ = fmt.Fprintf(&builder, "package %s\n\nimport \"github.com/dakusan/gol10n/translate\"\n\n%sconst (\n", namespaceName, GoDictHeader)
 
 			//Write Translation IDs for this namespace
-			n := l.dict.namespaces[namespaceName]
 			if len(n.idsInOrder) > 0 {
 				n.createGoFileConstants(l, &builder, namespaceName)
 			}
@@ -81,29 +92,34 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 			hashSumString := hex.EncodeToString(hashSumBytes[:])
 
 			//If the hash has not changed then nothing left to do
-			<-waitForHashes
 			if savedHashes[namespaceName] == hashSumString {
 				return
 			}
 
+			//In a dry run, only store the changed hash
+			if dryRun {
+				changedNamespaceHashes[namespaceIndex] = hashSumString
+				return
+			}
+
 			//Create/confirm the directory
 			outDir := outputDirectory + namespaceName + "/"
 			if dirInfo, err := os.Stat(outDir); os.IsNotExist(err) {
 				if err := os.Mkdir(outDir, 0644); err != nil {
-					namespaceErrors <- fmt.Sprintf("Error creating namespace directory %s: %s", namespaceName, err.Error())
+					namespaceErrors[namespaceIndex] = fmt.Sprintf("Error creating namespace directory %s: %s", namespaceName, err.Error())
 					return
 				}
 			} else if err != nil {
-				namespaceErrors <- fmt.Sprintf("Error accessing namespace directory %s: %s", namespaceName, err.Error())
+				namespaceErrors[namespaceIndex] = fmt.Sprintf("Error accessing namespace directory %s: %s", namespaceName, err.Error())
 				return
 			} else if !dirInfo.IsDir() {
-				namespaceErrors <- fmt.Sprintf("Namespace directory %s: Is not a directory", namespaceName)
+				namespaceErrors[namespaceIndex] = fmt.Sprintf("Namespace directory %s: Is not a directory", namespaceName)
 				return
 			}
 
 			//Write the file
 			if err := os.WriteFile(outDir+translationsIDOutputFile, resultStr, 0644); err != nil {
-				namespaceErrors <- fmt.Sprintf("Error writing %s for %s: %s", translationsIDOutputFile, namespaceName, err.Error())
+				namespaceErrors[namespaceIndex] = fmt.Sprintf("Error writing %s for %s: %s", translationsIDOutputFile, namespaceName, err.Error())
 				return
 			}
 
@@ -112,37 +128,12 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 		}(_namespaceIndex)
 	}
 
-	//Get namespace hashes from namespaceHashesJson
-	if getHashes, err := os.ReadFile(outputDirectory + namespaceHashesJson); err != nil {
-		//If an error occurs assume we have no hashes
-	} else if err := json.Unmarshal(getHashes, &savedHashes); err != nil {
-		//If an error occurs assume we have no hashes
-	}
-	close(waitForHashes)
-
-	//Wait for errors or all namespaces to finish
-	doneWithNamespaces := make(chan struct{})
-	go func() {
-		waitForNamespaces.Wait()
-		close(doneWithNamespaces)
-	}()
+	//Wait for all namespaces to finish and gather their errors in order
+	waitForNamespaces.Wait()
 	var errs []string
-	for continueLoop := true; continueLoop; {
-		select {
-		case err := <-namespaceErrors:
-			errs = append(errs, err)
-		case <-doneWithNamespaces:
-			continueLoop = false
-		}
-	}
-
-	//Make sure error channels are exhausted
-	for continueLoop := true; continueLoop; {
-		select {
-		case err := <-namespaceErrors:
+	for _, err := range namespaceErrors {
+		if len(err) != 0 {
 			errs = append(errs, err)
-		default:
-			continueLoop = false
 		}
 	}
 
@@ -150,12 +141,15 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 	for index, namespaceName := range l.dict.namespacesInOrder {
 		if len(changedNamespaceHashes[index]) != 0 && changedNamespaceHashes[index] != savedHashes[namespaceName] {
 			numUpdated++
+			updatedFiles = append(updatedFiles, outputDirectory+namespaceName+"/"+translationsIDOutputFile)
 			savedHashes[namespaceName] = changedNamespaceHashes[index]
 		}
 	}
 
 	//If there are changed namespaces...
-	if numUpdated > 0 {
+	if numUpdated > 0 && dryRun {
+		updatedFiles = append(updatedFiles, outputDirectory+namespaceHashesJson)
+	} else if numUpdated > 0 {
 		//Write the new namespaceHashesJson file
 		file, err := os.Create(outputDirectory + namespaceHashesJson)
 		defer func() { _ = file.Close() }()
@@ -166,15 +160,46 @@ func (l *Language) toGoDictionaries(outputDirectory, GoDictHeader string) (_ err
 			newEncoder.SetIndent("", "\t")
 			if err := newEncoder.Encode(savedHashes); err != nil {
 				errs = append(errs, "Error encoding to hash file: "+err.Error())
+			} else {
+				updatedFiles = append(updatedFiles, outputDirectory+namespaceHashesJson)
 			}
 		}
 	}
 
 	//Return the errors or success
 	if len(errs) != 0 {
-		return errors.New(strings.Join(errs, "\n")), numUpdated
+		return errors.New(strings.Join(errs, "\n")), numUpdated, updatedFiles
 	} else {
-		return nil, numUpdated
+		return nil, numUpdated, updatedFiles
+	}
+}
+
+// Writes the namespace metadata as the package documentation (if there is any)
+func (nm NamespaceMetadata) writeGoPackageDoc(builder *bytes.Buffer, namespaceName string) {
+	if nm.IsEmpty() {
+		return
+	}
+
+	writeLines := func(str string) {
+		for _, line := range strings.Split(strings.TrimRight(str, "\n"), "\n") {
+			builder.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+		}
+	}
+	_, _ = fmt.Fprintf(builder, "// Package %s holds the Translation IDs of the %s namespace.\n", namespaceName, namespaceName)
+	if len(nm.Description) != 0 {
+		builder.WriteString("//\n")
+		writeLines(nm.Description)
+	}
+
+	isFirstProp := true
+	for _, prop := range nm.getProperties() {
+		if prop[0] == "Description" {
+			continue
+		} else if isFirstProp {
+			builder.WriteString("//\n")
+			isFirstProp = false
+		}
+		writeLines(prop[0] + ": " + prop[1])
 	}
 }
 
@@ -196,10 +221,8 @@ func (n *namespace) createGoFileConstants(l *Language, builder *bytes.Buffer, na
 
 		//Write the first translation rule as the comment
 		ruleIndex := l.translations[firstIndex+uint(index)].startIndex
-		builder.Write(translationIDAndVars.getTranslationWithVarsAsString(
-			l.stringsData[l.rules[ruleIndex].startPos:l.rules[ruleIndex+1].startPos],
-			l.dict, namespaceName,
-		))
+		str, _ := l.ruleString(ruleIndex) //A string that cannot be read is left blank
+		builder.Write(translationIDAndVars.getTranslationWithVarsAsString(str, l.dict, namespaceName))
 
 		//Write the variable names if available
 		if hasVariables {
@@ -0,0 +1,301 @@
+//i18next JSON files, and translations written in i18next format
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The plural suffixes of i18next keys. “plural” is from the i18next v3 JSON format, and the others are CLDR plural categories from v4
+var i18nextPluralSuffixes = []string{"zero", "one", "two", "few", "many", "other", "plural"}
+
+// Reads an i18next JSON file and restructures it as a translation text file whose Settings.MessageFormat is i18next. See LF_JSON_I18Next
+func readI18NextFile(textStr []byte) (topItem yamlItem, warnings []string, err error) {
+	//Read the JSON
+	var topObj tpMap
+	if jsonTop, err := fromJsonFile(textStr, false); err != nil {
+		return yamlItem{}, nil, err
+	} else if _topObj, ok := jsonTop.getObject(); !ok {
+		return yamlItem{}, nil, errors.New("Top level item is not an object")
+	} else {
+		topObj = _topObj
+	}
+
+	//Restructure the namespaces, and set the message format
+	top := make(yamlMapSlice, 0, topObj.getLength())
+	for _, item := range topObj.toOrdered() {
+		obj, isObj := item.getObject()
+		switch {
+		case !isObj: //Invalid items are reported when processing
+			top = append(top, toYamlMapItem(item))
+		case item.getName() == "Settings":
+			settings := make(yamlMapSlice, 0, obj.getLength()+1)
+			for _, settingItem := range obj.toOrdered() {
+				if settingItem.getName() != "MessageFormat" {
+					settings = append(settings, toYamlMapItem(settingItem))
+				}
+			}
+			top = append(top, yaml.MapItem{Key: "Settings", Value: append(settings, yaml.MapItem{Key: "MessageFormat", Value: "i18next"})})
+		default:
+			if ns, nsWarnings, err := readI18NextNamespace(item.getName(), obj); err != nil {
+				return yamlItem{}, nil, err
+			} else {
+				top = append(top, yaml.MapItem{Key: item.getName(), Value: ns})
+				warnings = append(warnings, nsWarnings...)
+			}
+		}
+	}
+
+	return yamlItem{Key: "TOP", Value: top}, warnings, nil
+}
+
+// Flattens the nested keys of an i18next namespace into Translation IDs, and groups keys with plural suffixes into the plural rules of their Translation ID
+func readI18NextNamespace(namespaceName string, obj tpMap) (ns yamlMapSlice, warnings []string, err error) {
+	//Gather the Translation IDs
+	type i18nextTID struct {
+		name, key string
+		text      *string    //The text of the key without a plural suffix
+		forms     []textProp //The texts of the plural suffixes
+	}
+	var tids []*i18nextTID
+	tidsByName := make(map[string]*i18nextTID)
+	var readObj func(obj tpMap, keyPrefix string) error
+	readObj = func(obj tpMap, keyPrefix string) error {
+		for _, item := range obj.toOrdered() {
+			//Namespace metadata is kept as is
+			key := keyPrefix + item.getName()
+			if key == namespaceMetadataName {
+				ns = append(ns, toYamlMapItem(item))
+				continue
+			}
+
+			//Nested keys are read recursively
+			if childObj, ok := item.getObject(); ok {
+				if err := readObj(childObj, key+"."); err != nil {
+					return err
+				}
+				continue
+			}
+			text, ok := item.getString()
+			if !ok {
+				return fmt.Errorf("%s.%s: Must be a string or object", namespaceName, key)
+			}
+
+			//Split off the plural suffix
+			baseKey, suffix := key, ""
+			if i := strings.LastIndexByte(key, '_'); i > len(keyPrefix) {
+				for _, s := range i18nextPluralSuffixes {
+					if key[i+1:] == s {
+						baseKey, suffix = key[:i], s
+					}
+				}
+			}
+
+			//Add to the Translation ID
+			tidName := i18nextTranslationID(baseKey)
+			tid, ok := tidsByName[tidName]
+			if !ok {
+				tid = &i18nextTID{name: tidName, key: baseKey}
+				tids = append(tids, tid)
+				tidsByName[tidName] = tid
+			} else if tid.key != baseKey {
+				return fmt.Errorf("%s: Keys “%s” and “%s” would both be Translation ID %s", namespaceName, tid.key, baseKey, tidName)
+			}
+			if len(suffix) == 0 {
+				tid.text = &text
+			} else {
+				tid.forms = append(tid.forms, textProp{suffix, text})
+			}
+		}
+		return nil
+	}
+	if err := readObj(obj, ""); err != nil {
+		return nil, nil, err
+	}
+
+	//Create the Translation IDs
+	for _, tid := range tids {
+		if len(tid.forms) == 0 {
+			ns = append(ns, yaml.MapItem{Key: tid.name, Value: *tid.text})
+			continue
+		}
+
+		//Convert the plural forms to plural rules
+		var rules []textProp
+		isV3 := hasTextProp(tid.forms, "plural")
+		addRule := func(rule, text, keyDesc string) {
+			if hasTextProp(rules, rule) {
+				warnings = append(warnings, fmt.Sprintf("%s.%s: %s is skipped, as its plural rule (%s) was already given", namespaceName, tid.name, keyDesc, rule))
+			} else {
+				rules = append(rules, textProp{rule, text})
+			}
+		}
+		for _, form := range tid.forms {
+			if form.name == "plural" {
+				addRule("^", form.value, "“_plural”")
+			} else if rule, ok := mobileQuantityToRule(form.name); ok {
+				addRule(rule, form.value, "“_"+form.name+"”")
+			} else {
+				warnings = append(warnings, fmt.Sprintf("%s.%s: The “_%s” plural form cannot be converted and is skipped", namespaceName, tid.name, form.name))
+			}
+		}
+
+		//The key without a suffix is the singular form in v3, and the fallback in v4
+		if tid.text != nil {
+			addRule(cond(isV3, "=1", "^"), *tid.text, "The key without a plural suffix")
+		}
+		mobileSortRules(rules)
+
+		tidObj := make(yamlMapSlice, len(rules))
+		for i, rule := range rules {
+			tidObj[i] = yaml.MapItem{Key: rule.name, Value: rule.value}
+		}
+		ns = append(ns, yaml.MapItem{Key: tid.name, Value: tidObj})
+	}
+
+	return ns, warnings, nil
+}
+
+// Returns the Translation ID of an i18next key. Each dot separated part of the key is capitalized, and they are joined with underscores. Characters that cannot be in Translation IDs become underscores
+func i18nextTranslationID(key string) string {
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		part = i18nextVariableName(part)
+		if r, size := utf8.DecodeRuneInString(part); size != 0 {
+			part = string(unicode.ToUpper(r)) + part[size:]
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "_")
+}
+
+// Returns the variable name of an i18next interpolation. Characters that cannot be in variable names (like the dots of nested objects) become underscores
+func i18nextVariableName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// Converts the properties (name/value pairs) of a Translation ID whose rules are written in i18next format to gol10n variables.
+//
+// Interpolations ({{name}}) become {{.Variable}}s, with “count” being PluralCount, and nesting ($t(key)) becomes embedded static translations. For the default language, interpolations that are not declared as variables are declared in order of first appearance. For other languages, the variables of the default language are declared.
+func i18nextPropsToGol10n(props []string, isDefaultLanguage bool, defaultVars []translationIDVar) (newProps, warnings []string, err error) {
+	//Split the rules from the other properties
+	var rules, others []textProp
+	declared := map[string]bool{pluralCountName: true}
+	for i := 0; i+1 < len(props); i += 2 {
+		switch name := props[i]; {
+		case len(name) != 0 && strings.IndexByte("^=<>~", name[0]) != -1:
+			rules = append(rules, textProp{name, props[i+1]})
+		default:
+			others = append(others, textProp{name, props[i+1]})
+			declared[name] = true
+		}
+	}
+
+	//Convert the rules
+	var args []textProp
+	addArg := func(name, varType string) {
+		for i, arg := range args {
+			if arg.name == name {
+				if arg.value == "Anything" {
+					args[i].value = varType
+				}
+				return
+			}
+		}
+		args = append(args, textProp{name, varType})
+	}
+	regexTokens := regexp.MustCompile(`\{\{-?\s*([^{}]*?)\s*}}|\$t\(([^()]*)\)`)
+	for i, rule := range rules {
+		var sb strings.Builder
+		lastPos := 0
+		for _, match := range regexTokens.FindAllStringSubmatchIndex(rule.value, -1) {
+			sb.WriteString(i18nextEscapeText(rule.value[lastPos:match[0]]))
+			lastPos = match[1]
+
+			//Nesting
+			if match[2] == -1 {
+				key, _, hasOptions := strings.Cut(rule.value[match[4]:match[5]], ",")
+				if hasOptions {
+					warnings = append(warnings, fmt.Sprintf("Rule “%s”: The options of $t(%s) are dropped", rule.name, strings.TrimSpace(key)))
+				}
+				if ns, nsKey, hasNS := strings.Cut(strings.TrimSpace(key), ":"); hasNS {
+					sb.WriteString("{{*" + ns + "." + i18nextTranslationID(nsKey) + "}}")
+				} else {
+					sb.WriteString("{{*" + i18nextTranslationID(ns) + "}}")
+				}
+				continue
+			}
+
+			//Interpolation
+			name, format, _ := strings.Cut(rule.value[match[2]:match[3]], ",")
+			if name = strings.TrimSpace(name); len(name) == 0 {
+				return nil, nil, fmt.Errorf("Rule “%s”: An interpolation is missing its name", rule.name)
+			} else if name == "count" {
+				name = pluralCountName
+			} else {
+				name = i18nextVariableName(name)
+			}
+			format, _, _ = strings.Cut(format, "(")
+			format = strings.TrimSpace(format)
+			varType, varOptions := "Anything", ""
+			switch strings.ToLower(format) {
+			case "":
+			case "number":
+				varType = "FloatWithSymbols"
+			case "datetime":
+				varType, varOptions = "DateTime", "!%x"
+			default:
+				warnings = append(warnings, fmt.Sprintf("Rule “%s”: The format “%s” of “%s” is dropped", rule.name, format, name))
+			}
+			if name == pluralCountName {
+				varOptions = ""
+			} else {
+				addArg(name, varType)
+			}
+			sb.WriteString("{{." + name + varOptions + "}}")
+		}
+		sb.WriteString(i18nextEscapeText(rule.value[lastPos:]))
+		rules[i].value = sb.String()
+	}
+
+	//Create the properties with the declared variables, then the undeclared variables, then the rules
+	for _, prop := range others {
+		newProps = append(newProps, prop.name, prop.value)
+	}
+	if isDefaultLanguage {
+		for _, arg := range args {
+			if !declared[arg.name] {
+				newProps = append(newProps, arg.name, arg.value)
+				declared[arg.name] = true
+			}
+		}
+	} else {
+		for _, v := range defaultVars {
+			if !declared[v.name] {
+				newProps = append(newProps, v.name, variableTypeMapReverse[v.varType])
+				declared[v.name] = true
+			}
+		}
+	}
+	for _, rule := range rules {
+		newProps = append(newProps, rule.name, rule.value)
+	}
+	return newProps, warnings, nil
+}
+
+// Escapes i18next text for a translation string. Backslashes are escaped, and “{{” is broken up so it does not start a variable
+func i18nextEscapeText(text string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(text, `\`, `\\`), "{{", `{\u007b`)
+}
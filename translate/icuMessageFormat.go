@@ -0,0 +1,102 @@
+//Translation text files written in ICU MessageFormat
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// The syntax that the plural rules of a translation text file are written in (Settings.MessageFormat)
+type messageFormat uint8
+
+const (
+	mfGol10n messageFormat = iota
+	mfICU
+	mfI18Next
+)
+
+// Returns the message format of a Settings.MessageFormat value
+func getMessageFormat(name string) (messageFormat, error) {
+	switch strings.ToLower(name) {
+	case "gol10n":
+		return mfGol10n, nil
+	case "icu":
+		return mfICU, nil
+	case "i18next":
+		return mfI18Next, nil
+	default:
+		return mfGol10n, errors.New("Must be gol10n, ICU, or i18next")
+	}
+}
+
+// Converts the properties (name/value pairs) of a Translation ID whose rules are written in ICU MessageFormat to gol10n plural rules and variables.
+//
+// A plural argument (which can have any name) becomes PluralCount and its selectors become plural rules, and must be in a lone ^ rule. Other arguments become {{.Variable}}s. For the default language, arguments that are not declared as variables are declared in order of first appearance, with their types taken from their ICU argument types.
+func icuPropsToGol10n(props []string, isDefaultLanguage bool) (newProps, warnings []string, err error) {
+	//Split the rules from the other properties
+	var rules, others []textProp
+	declared := map[string]bool{pluralCountName: true}
+	for i := 0; i+1 < len(props); i += 2 {
+		switch name := props[i]; {
+		case len(name) != 0 && strings.IndexByte("^=<>~", name[0]) != -1:
+			rules = append(rules, textProp{name, props[i+1]})
+		default:
+			others = append(others, textProp{name, props[i+1]})
+			declared[name] = true
+		}
+	}
+
+	//Parse the rules
+	var newRules, args []textProp
+	for _, rule := range rules {
+		p := icuParser{msg: rule.value, args: []textProp{}}
+		parsedRules, err := p.parseMessage()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Rule “%s”: %s", rule.name, err.Error())
+		} else if len(p.pluralName) != 0 && (len(rules) != 1 || rule.name != "^") {
+			return nil, nil, fmt.Errorf("Rule “%s”: A plural argument can only be used when the ^ rule is the only rule", rule.name)
+		}
+		for _, w := range p.warnings {
+			warnings = append(warnings, fmt.Sprintf("Rule “%s”: %s", rule.name, w))
+		}
+
+		//The plural argument is PluralCount
+		if len(p.pluralName) != 0 && p.pluralName != pluralCountName {
+			regexPluralName := regexp.MustCompile(`\{\{\.` + regexp.QuoteMeta(p.pluralName) + `([}!|])`)
+			for i := range parsedRules {
+				parsedRules[i].value = regexPluralName.ReplaceAllString(parsedRules[i].value, "{{."+pluralCountName+"$1")
+			}
+		}
+		if len(parsedRules) == 1 && parsedRules[0].name == "^" {
+			parsedRules[0].name = rule.name
+		}
+		newRules = append(newRules, parsedRules...)
+
+		for _, arg := range p.args {
+			if arg.name != p.pluralName {
+				args = append(args, arg)
+			}
+		}
+	}
+
+	//Create the properties with the declared variables, then the undeclared variables (default language only), then the rules
+	for _, prop := range others {
+		newProps = append(newProps, prop.name, prop.value)
+	}
+	if isDefaultLanguage {
+		for _, arg := range args {
+			if !declared[arg.name] {
+				newProps = append(newProps, arg.name, arg.value)
+				declared[arg.name] = true
+			}
+		}
+	}
+	for _, rule := range newRules {
+		newProps = append(newProps, rule.name, rule.value)
+	}
+	return newProps, warnings, nil
+}
@@ -0,0 +1,149 @@
+//Inspecting the contents of compiled language files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// GTRHeader is the header of a compiled language file (see ParseGTRHeader())
+type GTRHeader struct {
+	FileType        string //“GT”, or “GE” when the translation strings are encrypted
+	FormatVersion   uint8  //See CompiledFormatVersion
+	RuleSize        uint8  //The number of bytes each translation rule is stored in
+	NumRules        uint32 //The number of stored translation rules, including the markers that start the rules of registers
+	NumTranslations uint32
+	SettingsSize    uint32 //The number of bytes of the settings strings
+	DataSize        uint32 //The number of bytes of the translation strings (before they are encrypted)
+	DictionaryHash  string //The hex encoded hash of the dictionary the file was compiled against
+	HasChecksum     bool   //If the file ends with a CRC-32 checksum
+	FileSize        uint64 //The size of the uncompressed file given by the header
+}
+
+// DecompiledRule is a plurality rule of a translation written back in the syntax of translation text files (see Language.DecompileTranslation())
+type DecompiledRule struct {
+	Rule string //The plurality rule (Example: “=1”), which is prefixed with “@RegisterName” for the rules of registers
+	Text string //The translation string, with its variables written as {{.VariableName}} and its embedded static translations as {{*TranslationID}}
+}
+
+// ParseGTRHeader reads the header of an uncompressed .gtr language file from memory, without reading the rest of the file. The header is readable even when the translation strings are encrypted
+func ParseGTRHeader(b []byte) (GTRHeader, error) {
+	var header storeHeader
+	if len(b) < int(header.binarySize()) {
+		return GTRHeader{}, errors.New("File ended early")
+	}
+	header.fromLittleEndian(b)
+	formatVersion, err := header.readFormatVersion()
+	if err != nil {
+		return GTRHeader{}, err
+	}
+
+	return GTRHeader{
+		FileType:        string(header.fileType[:]),
+		FormatVersion:   formatVersion,
+		RuleSize:        header.translationStringByteLength,
+		NumRules:        header.numRules,
+		NumTranslations: header.numTranslations,
+		SettingsSize:    header.settingsSize,
+		DataSize:        header.dataSize,
+		DictionaryHash:  hex.EncodeToString(header.hash[:]),
+		HasChecksum:     header.hasChecksum(),
+		FileSize:        header.getCompiledFileSize(),
+	}, nil
+}
+
+// NumRules returns the number of plurality rules the language has for the translation (in all of its registers). Rules of its fallback languages are not counted, so this is 0 if the translation is not translated in the language
+func (l *Language) NumRules(index TransIndex) uint32 {
+	if uint32(index) >= l.NumTranslations() {
+		return 0
+	}
+	var n uint32
+	for _, r := range l.translationRules(index) {
+		if r.rule.getRegisterMarker() == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// DecompileTranslation returns the plurality rules the language has for the translation, written back in the syntax of translation text files, in the order they are stored (the default register’s rules first). Rules of its fallback languages are not included, so this is empty if the translation is not translated in the language. Useful for debugging compiled files.
+//
+// Variables are named from the dictionary’s variables. If they are not loaded (like for languages from ParseGTR()), variables are named by their position (Example: {{.Var1}}). The printf format specifiers and the specifiers after exclamation marks are kept, but the text is not exactly the original, as special characters are written escaped
+func (l *Language) DecompileTranslation(index TransIndex) ([]DecompiledRule, error) {
+	//Get the Translation ID and its variables
+	if uint32(index) >= l.NumTranslations() {
+		return nil, errors.New("Invalid TransIndex")
+	}
+	namespaceName, namespaceStartIndex, _ := l.dict.translationIDLookupNS(index)
+	var tv *translationIDNameAndVars
+	if l.dict.loadVars() == nil && l.dict.hasVarsLoaded {
+		tv = &l.dict.namespaces[namespaceName].idsInOrder[uint(index)-namespaceStartIndex]
+	}
+
+	//Write out the rules
+	rules := l.translationRules(index)
+	ret := make([]DecompiledRule, 0, len(rules))
+	registerName := ""
+	for i, r := range rules {
+		//Register markers start the rules of the next register
+		if marker := r.rule.getRegisterMarker(); marker != 0 {
+			registerName = "@" + cond(int(marker) < len(l.registers), l.registers[marker], "Register"+strconv.Itoa(int(marker)))
+			continue
+		}
+
+		//Write out the translation string
+		str, err := l.ruleString(l.translations[index].startIndex + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		ruleTV := tv
+		if ruleTV == nil {
+			ruleTV = positionalVarNames(str)
+		}
+		ret = append(ret, DecompiledRule{
+			registerName + r.rule.toText(len(registerName) != 0),
+			string(ruleTV.getTranslationWithVarsAsString(str, l.dict, namespaceName)),
+		})
+	}
+	return ret, nil
+}
+
+// Returns the variables of a compiled translation string named by their position (Var1, Var2, etc.)
+func positionalVarNames(translation []byte) *translationIDNameAndVars {
+	tv := &translationIDNameAndVars{}
+	for _, v := range getUsedVariables(translation) {
+		for len(tv.vars) < int(v.index) {
+			tv.vars = append(tv.vars, translationIDVar{"Var" + strconv.Itoa(len(tv.vars)+1), vtAnything})
+		}
+	}
+	return tv
+}
+
+// Returns the plurality rule in the syntax of translation text files. The Any rule is blank if isRegisterRule (as “@RegisterName” alone is an Any rule)
+func (pr pluralRule) toText(isRegisterRule bool) string {
+	i0 := strconv.FormatUint(uint64(pr.i0), 10)
+	switch pr.getOp() {
+	case cmpAll:
+		return cond(isRegisterRule, "", "^")
+	case cmpEquals:
+		return "=" + i0
+	case cmpLess:
+		return "<" + i0
+	case cmpLessEqual:
+		return "<=" + i0
+	case cmpGreater:
+		return ">" + cond(pr.i0 == maxRulePluralCount, pluralRuleOverflowKeyword, i0)
+	case cmpGreaterEqual:
+		return ">=" + i0
+	case cmpBetween:
+		return fmt.Sprintf("~%d-%d", pr.i0, uint64(pr.i0)+uint64(uint8(pr.op)>>3))
+	case cmpBetweenExtraBit:
+		return fmt.Sprintf("~%d-%d", pr.i0, uint64(pr.i0)+uint64(uint8(pr.op)>>3)+32)
+	default:
+		return "?"
+	}
+}
@@ -0,0 +1,764 @@
+//Shared functionality for exchanging translation text files with other formats
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v2"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ---------------------------Translation ID properties----------------------------
+type textProp struct {
+	name, value string
+}
+
+// Returns the plural rules and variables of a Translation ID. Properties starting with a “\” (and the rules of registers, starting with a “@”) are not included
+func readTranslationIDProps(item tpItem, path string) (rules, vars []textProp, err error) {
+	//A string is a single ^ rule
+	if str, ok := item.getString(); ok {
+		return []textProp{{"^", str}}, nil, nil
+	}
+	obj, ok := item.getObject()
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: Must be an object or a string", path)
+	}
+
+	for _, prop := range obj.toOrdered() {
+		name := prop.getName()
+		val, ok := prop.getString()
+		if !ok {
+			return nil, nil, fmt.Errorf("%s.%s: Must be a string", path, name)
+		}
+		switch {
+		case len(name) == 0 || name[0] == '\\' || name[0] == '@':
+		case strings.IndexByte("^=<>~", name[0]) != -1:
+			rules = append(rules, textProp{name, val})
+		default:
+			vars = append(vars, textProp{name, val})
+		}
+	}
+	return
+}
+
+// Returns the text of the matching rule. If not found, the ^ rule is used, and then the last rule
+func findRuleText(rules []textProp, ruleName string) string {
+	if len(rules) == 0 {
+		return ""
+	}
+	for _, findName := range []string{ruleName, "^"} {
+		for _, r := range rules {
+			if r.name == findName {
+				return r.value
+			}
+		}
+	}
+	return rules[len(rules)-1].value
+}
+
+// Returns an example value of a variable type, so translators can see what a variable is replaced with. Blank for unknown types
+func variableTypeExample(varType string) string {
+	initTextProcessing()
+	vt, ok := variableTypeMap[strings.ToUpper(varType)]
+	if !ok {
+		return ""
+	}
+	switch vt {
+	case vtString:
+		return "Text"
+	case vtInteger:
+		return "42"
+	case vtBinary:
+		return "101010"
+	case vtOctal:
+		return "52"
+	case vtHexLower:
+		return "2a"
+	case vtHexUpper:
+		return "2A"
+	case vtScientific:
+		return "1.234560e+03"
+	case vtFloating:
+		return "1234.560000"
+	case vtBool:
+		return "true"
+	case vtDateTime:
+		return "2006-01-02 15:04:05"
+	case vtCurrency:
+		return "$1,234.56"
+	case vtIntegerWithSymbols:
+		return "1,234"
+	case vtFloatWithSymbols:
+		return "1,234.56"
+	case vtVariableTranslation, vtStaticTranslation:
+		return "Another translation"
+	case vtSpellout:
+		return "forty-two"
+	case vtOrdinal:
+		return "42nd"
+	case vtQuote:
+		return "“Quoted text”"
+	default:
+		return "Any value"
+	}
+}
+
+// Returns the documentation of a variable for translators. Format: “Name (Type, e.g. Example)”
+func variableDoc(v textProp) string {
+	if example := variableTypeExample(v.value); len(example) != 0 {
+		return v.name + " (" + v.value + ", e.g. " + example + ")"
+	}
+	return v.name + " (" + v.value + ")"
+}
+
+// Returns a text file’s top level object and its language identifier
+func readTextFileTop(r io.Reader, lf LanguageTextFile) (topObj, settingsObj tpMap, langIdent string, err error) {
+	var topItem tpItem
+	if topItem, err = lf.readTopItem(r); err != nil {
+		return
+	}
+	var ok bool
+	if topObj, ok = topItem.getObject(); !ok {
+		err = errors.New("Top level item is not an object")
+		return
+	}
+	if settingsItem, ok := topObj.getValue("Settings"); !ok {
+		err = errors.New("Settings section is missing")
+	} else if settingsObj, ok = settingsItem.getObject(); !ok {
+		err = errors.New("Settings is not an object")
+	} else {
+		langIdent, err = getSetting(settingsObj, "LanguageIdentifier")
+	}
+	return
+}
+
+// Copies an object into a yamlMapSlice
+func toYamlMapSlice(obj tpMap) yamlMapSlice {
+	items := obj.toOrdered()
+	ret := make(yamlMapSlice, 0, len(items))
+	for _, item := range items {
+		ret = append(ret, toYamlMapItem(item))
+	}
+	return ret
+}
+
+// Copies an item into a yaml.MapItem
+func toYamlMapItem(item tpItem) yaml.MapItem {
+	if obj, ok := item.getObject(); ok {
+		return yaml.MapItem{Key: item.getName(), Value: toYamlMapSlice(obj)}
+	}
+	str, _ := item.getString()
+	return yaml.MapItem{Key: item.getName(), Value: str}
+}
+
+// ------------------------------Mobile resource files-----------------------------
+// Android and Apple resource files are keyed by “Namespace.TranslationID”. Their positional printf arguments (%1$s) are the Translation ID’s variables in the order of the default language. For plural Translation IDs, the first argument is PluralCount and the variables start at the second argument
+
+// A Translation ID read for exporting to a mobile resource file
+type mobileEntry struct {
+	key      string     //Namespace.TranslationID
+	rules    []textProp //The translations
+	vars     []textProp //The default language’s variables, not including PluralCount
+	isPlural bool       //If the default language has any rule other than a single ^ rule
+}
+
+// Plural rules for CLDR plural categories (in output order) that do not depend on the language. Android and Apple files are converted through the language’s categories instead (see mobilePlurals)
+var mobileQuantities = []textProp{{"zero", "=0"}, {"one", "=1"}, {"two", "=2"}, {"other", "^"}}
+
+// Returns the plural rule of a CLDR plural category, without checking the categories of the language
+func mobileQuantityToRule(quantity string) (string, bool) {
+	for _, q := range mobileQuantities {
+		if q.name == quantity {
+			return q.value, true
+		}
+	}
+	return "", false
+}
+
+// Sorts plural rules or CLDR plural categories into the order of mobileQuantities, so ^ (other) is always last
+func mobileSortRules(rules []textProp) {
+	order := func(name string) int {
+		for i, q := range mobileQuantities {
+			if q.value == name || q.name == name {
+				return i
+			}
+		}
+		return len(mobileQuantities)
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return order(rules[i].name) < order(rules[j].name) })
+}
+
+// The CLDR plural categories of a language, for converting between plural rules and the categories of Android and Apple files. Only integer counts are used, as those are what plural rules compare against
+type mobilePlurals struct {
+	languageIdentifier string
+	categories         [mobileNumPluralSamples]plural.Form //The category of each sampled count
+}
+
+// The counts sampled for the categories of a language (0 to this minus 1). This covers the repeating patterns of all CLDR plural rules of integers
+const mobileNumPluralSamples = 1000
+
+// The CLDR plural categories in output order, so other is always last
+var mobilePluralCategories = []struct {
+	name string
+	form plural.Form
+}{{"zero", plural.Zero}, {"one", plural.One}, {"two", plural.Two}, {"few", plural.Few}, {"many", plural.Many}, {"other", plural.Other}}
+
+// Returns the CLDR plural categories of the language
+func newMobilePlurals(languageIdentifier string) *mobilePlurals {
+	mp := &mobilePlurals{languageIdentifier: languageIdentifier}
+	langTag := language.Make(languageIdentifier)
+	for n := range mp.categories {
+		mp.categories[n] = plural.Cardinal.MatchPlural(langTag, n, 0, 0, 0, 0)
+	}
+	return mp
+}
+
+// Returns the CLDR plural category with the name
+func mobilePluralForm(name string) (plural.Form, bool) {
+	for _, c := range mobilePluralCategories {
+		if c.name == name {
+			return c.form, true
+		}
+	}
+	return 0, false
+}
+
+// Returns the name of the CLDR plural category
+func mobilePluralName(form plural.Form) string {
+	for _, c := range mobilePluralCategories {
+		if c.form == form {
+			return c.name
+		}
+	}
+	return ""
+}
+
+// Sorts CLDR plural categories into the order of mobilePluralCategories
+func mobileSortCategories(categories []textProp) {
+	order := func(name string) int {
+		for i, c := range mobilePluralCategories {
+			if c.name == name {
+				return i
+			}
+		}
+		return len(mobilePluralCategories)
+	}
+	sort.SliceStable(categories, func(i, j int) bool { return order(categories[i].name) < order(categories[j].name) })
+}
+
+// Returns the first sampled count that is in only one of the 2 sets of counts, or -1 if the sets are the same
+func mobileFirstDifference(a, b func(n int) bool) int {
+	for n := 0; n < mobileNumPluralSamples; n++ {
+		if a(n) != b(n) {
+			return n
+		}
+	}
+	return -1
+}
+
+// Converts the plural rules to the language’s CLDR plural categories (in output order). Rules are matched in order, so each rule only holds the counts that the rules before it do not. A rule is skipped with a warning if its counts are not all in a single category (other than the category of ^), or its category was already used. A warning is also given when the category holds counts that the rule does not, as those counts then use the rule’s translation
+func (mp *mobilePlurals) rulesToCategories(key string, rules []textProp, addWarning func(str string, args ...interface{})) (categories []textProp) {
+	var claimed [mobileNumPluralSamples]bool
+	used := make(map[plural.Form]bool)
+	for _, rule := range rules {
+		//Get the counts the rule holds
+		pr, err := createPluralRule(rule.name)
+		if err != nil {
+			addWarning("%s: Plural rule “%s” is invalid and is skipped", key, rule.name)
+			continue
+		}
+		holds := func(n int) bool { return !claimed[n] && pr.cmp(uint64(n)) }
+
+		//The ^ rule is the other category
+		var form plural.Form
+		if rule.name == "^" {
+			form = plural.Other
+		} else {
+			//Get the category of the counts
+			first := -1
+			for n := 0; n < mobileNumPluralSamples && first == -1; n++ {
+				if holds(n) {
+					first = n
+				}
+			}
+			if first == -1 {
+				addWarning("%s: Plural rule “%s” is never used as the rules before it hold all of its counts, so it is skipped", key, rule.name)
+				continue
+			}
+			form = mp.categories[first]
+			if n := mobileFirstDifference(holds, func(n int) bool { return holds(n) && mp.categories[n] == form }); n != -1 {
+				addWarning("%s: Plural rule “%s” holds counts in more than 1 plural category in “%s” (like %d and %d), so it is skipped", key, rule.name, mp.languageIdentifier, first, n)
+				continue
+			} else if form == plural.Other {
+				addWarning("%s: Plural rule “%s” holds counts of the “other” plural category in “%s”, which is the ^ rule, so it is skipped", key, rule.name, mp.languageIdentifier)
+				continue
+			} else if n := mobileFirstDifference(holds, func(n int) bool { return !claimed[n] && mp.categories[n] == form }); n != -1 {
+				addWarning("%s: Plural rule “%s” is exported as the “%s” plural category, which in “%s” also holds other counts (like %d) that then use its translation", key, rule.name, mobilePluralName(form), mp.languageIdentifier, n)
+			}
+		}
+
+		//Add the category
+		if used[form] {
+			addWarning("%s: Plural rule “%s” is the “%s” plural category in “%s”, which a previous rule already used, so it is skipped", key, rule.name, mobilePluralName(form), mp.languageIdentifier)
+			continue
+		}
+		used[form] = true
+		for n := range claimed {
+			claimed[n] = claimed[n] || pr.cmp(uint64(n))
+		}
+		categories = append(categories, textProp{mobilePluralName(form), rule.value})
+	}
+	mobileSortCategories(categories)
+	return
+}
+
+// Returns if the plural rule’s counts are all in a single CLDR plural category of the language (other than “other”), so it can be exported
+func (mp *mobilePlurals) hasRule(rule string) bool {
+	pr, err := createPluralRule(rule)
+	if err != nil {
+		return false
+	}
+	form := plural.Other
+	for n, f := range mp.categories {
+		if !pr.cmp(uint64(n)) {
+			continue
+		} else if f == plural.Other || (form != plural.Other && f != form) {
+			return false
+		}
+		form = f
+	}
+	return form != plural.Other
+}
+
+// Converts the CLDR plural categories to plural rules of the language (in the output order of the categories, so ^ is last). A category the language does not use is skipped with a warning. Categories that a single plural rule cannot hold (like “one” in “ru”, which holds 1, 21, 31, …) use the rule of their longest range of counts, with a warning that the other counts use the ^ rule
+func (mp *mobilePlurals) categoriesToRules(key string, categories []textProp, addWarning func(str string, args ...interface{})) (rules []textProp) {
+	mobileSortCategories(categories)
+	for _, c := range categories {
+		form, ok := mobilePluralForm(c.name)
+		if !ok {
+			addWarning("%s: Plural category “%s” has no matching plural rule and is skipped", key, c.name)
+			continue
+		} else if form == plural.Other {
+			rules = append(rules, textProp{"^", c.value})
+			continue
+		}
+		inCategory := func(n int) bool { return mp.categories[n] == form }
+
+		//Find the longest range of counts in the category
+		start, end, curStart := -1, -1, -1
+		for n := 0; n <= mobileNumPluralSamples; n++ {
+			if n < mobileNumPluralSamples && inCategory(n) {
+				if curStart == -1 {
+					curStart = n
+				}
+			} else if curStart != -1 {
+				if start == -1 || n-1-curStart > end-start {
+					start, end = curStart, n-1
+				}
+				curStart = -1
+			}
+		}
+		if start == -1 {
+			addWarning("%s: Plural category “%s” is not used by “%s” and is skipped", key, c.name, mp.languageIdentifier)
+			continue
+		}
+
+		//Create the rule of the range
+		var rule string
+		const maxBetweenDiff = 63
+		switch {
+		case start == end:
+			rule = "=" + strconv.Itoa(start)
+		case end == mobileNumPluralSamples-1:
+			rule = ">=" + strconv.Itoa(start)
+		case start == 0:
+			rule = "<=" + strconv.Itoa(end)
+		default:
+			rule = fmt.Sprintf("~%d-%d", start, min(end, start+maxBetweenDiff))
+		}
+
+		//Warn if the rule does not hold exactly the counts of the category
+		if pr, err := createPluralRule(rule); err != nil {
+			addWarning("%s: Plural category “%s” could not be converted to a plural rule and is skipped", key, c.name)
+			continue
+		} else if n := mobileFirstDifference(inCategory, func(n int) bool { return pr.cmp(uint64(n)) }); n != -1 {
+			addWarning("%s: Plural category “%s” in “%s” is imported as plural rule “%s”, which does not hold all of its counts (like %d), so those counts use the ^ rule", key, c.name, mp.languageIdentifier, rule, n)
+		}
+		rules = append(rules, textProp{rule, c.value})
+	}
+	return
+}
+
+// Returns the Translation IDs of the source (default language) file, with the translations from the target file. If the target file is nil, the source file’s translations are used. Translation IDs missing from the target file are not returned. Also returns the language identifier of the exported file
+func readMobileEntries(sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (entries []mobileEntry, languageIdentifier string, err error) {
+	//Read the files
+	initTextProcessing()
+	srcObj, _, languageIdentifier, err := readTextFileTop(sourceFile, sourceType)
+	if err != nil {
+		return nil, "", fmt.Errorf("Source file: %s", err.Error())
+	}
+	var trgObj tpMap
+	if targetFile != nil {
+		if trgObj, _, languageIdentifier, err = readTextFileTop(targetFile, targetType); err != nil {
+			return nil, "", fmt.Errorf("Target file: %s", err.Error())
+		}
+	}
+
+	//Get the Translation IDs
+	for _, nsItem := range srcObj.toOrdered() {
+		nsName := nsItem.getName()
+		if nsName == "Settings" {
+			continue
+		}
+		nsObj, ok := nsItem.getObject()
+		if !ok {
+			return nil, "", fmt.Errorf("Source file: Namespace “%s” is not an object", nsName)
+		}
+		var trgNsObj tpMap
+		if trgObj != nil {
+			if trgNsItem, ok := trgObj.getValue(nsName); ok {
+				trgNsObj, _ = trgNsItem.getObject()
+			}
+		}
+
+		for _, tidItem := range nsObj.toOrdered() {
+			//Get the source properties
+			tidName := tidItem.getName()
+			if tidName == namespaceMetadataName {
+				continue
+			}
+			key := nsName + "." + tidName
+			rules, vars, err := readTranslationIDProps(tidItem, key)
+			if err != nil {
+				return nil, "", fmt.Errorf("Source file: %s", err.Error())
+			}
+			entry := mobileEntry{key: key, rules: rules, isPlural: len(rules) != 1 || rules[0].name != "^"}
+			for _, v := range vars {
+				if v.name != pluralCountName {
+					entry.vars = append(entry.vars, v)
+				}
+			}
+
+			//Get the target translations
+			if trgObj != nil {
+				entry.rules = nil
+				if trgNsObj != nil {
+					if trgTidItem, ok := trgNsObj.getValue(tidName); ok {
+						if entry.rules, _, err = readTranslationIDProps(trgTidItem, key); err != nil {
+							return nil, "", fmt.Errorf("Target file: %s", err.Error())
+						}
+					}
+				}
+				if len(entry.rules) == 0 {
+					continue
+				}
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return
+}
+
+// Returns the rules of an entry for a mobile resource file. For plurals, the rules are converted to the language’s CLDR plural categories (see mobilePlurals.rulesToCategories()). Otherwise, a single ^ rule is returned
+func (entry mobileEntry) getMobileRules(plurals *mobilePlurals, addWarning func(str string, args ...interface{})) []textProp {
+	if !entry.isPlural {
+		if len(entry.rules) != 1 || entry.rules[0].name != "^" {
+			addWarning("%s: Only the ^ rule is used as the Translation ID is not plural in the default language", entry.key)
+		}
+		return []textProp{{"^", findRuleText(entry.rules, "^")}}
+	}
+	return plurals.rulesToCategories(entry.key, entry.rules, addWarning)
+}
+
+// Escapes the XML special characters for writing mobile resource files
+func mobileEscapeXML(str string, isAttribute bool) string {
+	str = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(str)
+	if isAttribute {
+		str = strings.ReplaceAll(str, `"`, "&quot;")
+	}
+	return str
+}
+
+// Converts {{.Variable}} to positional printf arguments (%1$s). Literal % characters become %%. The string verb is “s” for Android and “@” for Apple
+func (entry mobileEntry) toPositionalArgs(text, stringVerb string, addWarning func(str string, args ...interface{})) string {
+	var sb strings.Builder
+	lastPos := 0
+	for _, match := range regexReplaceVariables.FindAllStringSubmatchIndex(text, -1) {
+		//Write the text before the variable
+		sb.WriteString(strings.ReplaceAll(text[lastPos:match[0]], "%", "%%"))
+		lastPos = match[1]
+
+		//Get the argument position and type
+		varName, varFlags, varOptions := text[match[2]:match[3]], "", ""
+		if match[4] != -1 {
+			varFlags = strings.Join(strings.Fields(text[match[4]:match[5]]), "")
+		}
+		if match[6] != -1 {
+			varOptions = text[match[6]:match[7]]
+		}
+		argPos, varType := 0, ""
+		if varName == pluralCountName && entry.isPlural {
+			argPos, varType = 1, "Integer"
+		} else {
+			for i, v := range entry.vars {
+				if v.name == varName {
+					argPos, varType = i+1+cond(entry.isPlural, 1, 0), v.value
+					break
+				}
+			}
+		}
+		if argPos == 0 {
+			addWarning("%s: Variable “%s” cannot be converted and is kept as is", entry.key, varName)
+			sb.WriteString(strings.ReplaceAll(text[match[0]:match[1]], "%", "%%"))
+			continue
+		} else if len(varOptions) != 0 {
+			addWarning("%s: Variable “%s” options “!%s” are not supported and are dropped", entry.key, varName, varOptions)
+		}
+
+		//Write the argument
+		var verb string
+		switch variableTypeMap[strings.ToUpper(varType)] {
+		case vtInteger, vtIntegerWithSymbols, vtSpellout, vtOrdinal:
+			verb = "d"
+		case vtFloating, vtFloatWithSymbols, vtCurrency:
+			verb = "f"
+		case vtScientific:
+			verb = "e"
+		case vtOctal:
+			verb = "o"
+		case vtHexLower:
+			verb = "x"
+		case vtHexUpper:
+			verb = "X"
+		default:
+			verb = stringVerb
+		}
+		_, _ = fmt.Fprintf(&sb, "%%%d$%s%s", argPos, varFlags, verb)
+	}
+	sb.WriteString(strings.ReplaceAll(text[lastPos:], "%", "%%"))
+
+	if regexMatchEmbeddedStaticVariable.MatchString(text) {
+		addWarning("%s: Embedded translations cannot be converted and are kept as is", entry.key)
+	}
+	return sb.String()
+}
+
+// Matches printf arguments in mobile resource files. Groups: Position, Flags, Verb
+var regexMobileArgs = regexp.MustCompile(`%(?:(\d+)\$)?([-0-9.]*)(@|%|l{0,2}[diuoxXfeEgs])`)
+
+// Converts positional printf arguments (%1$s) to {{.Variable}}. %% becomes a literal %. Arguments without a position are numbered in order. Variable options (which mobile resource files cannot hold) are taken from varFormats
+func fromPositionalArgs(text, path string, vars []textProp, isPlural bool, varFormats map[string]varFormat) (string, error) {
+	var err error
+	nextPos := 1
+	ret := regexMobileArgs.ReplaceAllStringFunc(text, func(arg string) string {
+		parts := regexMobileArgs.FindStringSubmatch(arg)
+		if parts[3] == "%" {
+			return "%"
+		}
+
+		//Get the variable name
+		argPos := nextPos
+		if len(parts[1]) != 0 {
+			argPos, _ = strconv.Atoi(parts[1])
+		}
+		nextPos = argPos + 1
+		var varName string
+		if isPlural && argPos == 1 {
+			varName = pluralCountName
+		} else if varIndex := argPos - 1 - cond(isPlural, 1, 0); varIndex >= 0 && varIndex < len(vars) {
+			varName = vars[varIndex].name
+		} else if err == nil {
+			err = fmt.Errorf("%s: Argument “%s” does not match a variable", path, arg)
+			return arg
+		}
+
+		//Return the variable
+		ret := "{{." + varName
+		if len(parts[2]) != 0 {
+			ret += "|" + parts[2]
+		}
+		if format, ok := varFormats[varName]; ok && len(format.options) != 0 {
+			ret += "!" + format.options
+		}
+		return ret + "}}"
+	})
+	return ret, err
+}
+
+// The flags and options of a variable in the existing file, which are kept when importing if the format cannot hold them
+type varFormat struct {
+	flags, options string
+}
+
+// How the translations of a resource file format are converted when importing
+type mobileImportFormat struct {
+	key         func(nsName, tidName string) string                                                                      //Returns the resource key of a Translation ID
+	convertArgs func(text, path string, vars []textProp, isPlural bool, varFormats map[string]varFormat) (string, error) //Converts the arguments of a translation to {{.Variable}}
+	hasRule     func(rule string) bool                                                                                   //Returns if the format can hold a plural rule. The existing file’s rules that it cannot hold are kept
+}
+
+// Returns the import format of Android and Apple resource files, which are keyed by “Namespace.TranslationID” and have positional printf arguments. They can hold the plural rules that are exported as a CLDR plural category of the language
+func positionalArgsFormat(plurals *mobilePlurals) mobileImportFormat {
+	return mobileImportFormat{
+		key:         func(nsName, tidName string) string { return nsName + "." + tidName },
+		convertArgs: fromPositionalArgs,
+		hasRule:     plurals.hasRule,
+	}
+}
+
+// Creates the language text file from translations imported from a resource file, which are keyed and converted through the format.
+//
+// The Translation IDs are in the order of the source (default language) file, with its variables. Translation IDs not in the translations are taken from the existing file, which is the target file (if given), or the source file if importing the default language. Otherwise, they are left out. For imported Translation IDs, the existing file’s plural rules and variable formats that the format cannot hold are kept.
+func (lf LanguageTextFile) importMobileTranslations(translations map[string][]textProp, format mobileImportFormat, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, warnings []string, err error) {
+	//Read the files
+	if !lf.CanBeWritten() {
+		return nil, nil, errors.New("TOML, i18next JSON, and custom format files cannot be written")
+	}
+	initTextProcessing()
+	srcObj, srcSettings, srcLang, err := readTextFileTop(sourceFile, sourceType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Source file: %s", err.Error())
+	}
+	existingObj, existingSettings := tpMap(nil), tpMap(nil)
+	if targetFile != nil {
+		var trgLang string
+		if existingObj, existingSettings, trgLang, err = readTextFileTop(targetFile, targetType); err != nil {
+			return nil, nil, fmt.Errorf("Target file: %s", err.Error())
+		} else if trgLang != languageIdentifier {
+			return nil, nil, fmt.Errorf("Target file: Language identifier “%s” does not match “%s”", trgLang, languageIdentifier)
+		}
+	} else if languageIdentifier == srcLang {
+		existingObj, existingSettings = srcObj, srcSettings
+	}
+
+	//Create the Settings object
+	top := yamlMapSlice{{Key: "Settings", Value: yamlMapSlice{{Key: "LanguageIdentifier", Value: languageIdentifier}}}}
+	if existingSettings != nil {
+		top[0].Value = toYamlMapSlice(existingSettings)
+	}
+
+	//Add the namespaces
+	for _, nsItem := range srcObj.toOrdered() {
+		nsName := nsItem.getName()
+		nsObj, ok := nsItem.getObject()
+		if nsName == "Settings" || !ok {
+			continue
+		}
+		var existingNsObj tpMap
+		if existingObj != nil {
+			if existingNsItem, ok := existingObj.getValue(nsName); ok {
+				existingNsObj, _ = existingNsItem.getObject()
+			}
+		}
+
+		var nsMS yamlMapSlice
+		for _, tidItem := range nsObj.toOrdered() {
+			//Metadata is only kept when importing the default language
+			tidName := tidItem.getName()
+			key, path := format.key(nsName, tidName), nsName+"."+tidName
+			if tidName == namespaceMetadataName {
+				if languageIdentifier == srcLang {
+					nsMS = append(nsMS, toYamlMapItem(tidItem))
+				}
+				continue
+			}
+
+			//If not imported, use the existing Translation ID
+			var existingItem tpItem
+			if existingNsObj != nil {
+				existingItem, _ = existingNsObj.getValue(tidName)
+			}
+			rules, ok := translations[key]
+			delete(translations, key)
+			if !ok {
+				if existingItem != nil {
+					nsMS = append(nsMS, toYamlMapItem(existingItem))
+				}
+				continue
+			}
+
+			//Get the existing rules and variable formats
+			var existingRules []textProp
+			varFormats := make(map[string]varFormat)
+			if existingItem != nil {
+				if existingRules, _, err = readTranslationIDProps(existingItem, path); err != nil {
+					return nil, nil, fmt.Errorf("Existing file: %s", err.Error())
+				}
+				for _, rule := range existingRules {
+					for _, match := range regexReplaceVariables.FindAllStringSubmatch(rule.value, -1) {
+						if _, ok := varFormats[match[1]]; !ok && len(match[2])+len(match[3]) != 0 {
+							varFormats[match[1]] = varFormat{match[2], match[3]}
+						}
+					}
+				}
+			}
+
+			//Convert the arguments
+			srcRules, vars, err := readTranslationIDProps(tidItem, path)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Source file: %s", err.Error())
+			}
+			isPlural := len(srcRules) != 1 || srcRules[0].name != "^"
+			var namedVars []textProp
+			for _, v := range vars {
+				if v.name != pluralCountName {
+					namedVars = append(namedVars, v)
+				}
+			}
+			var tidMS yamlMapSlice
+			for _, rule := range rules {
+				//Keep the existing rules that cannot be imported before the ^ rule
+				if rule.name == "^" {
+					for _, existingRule := range existingRules {
+						if existingRule.name != "^" && !format.hasRule(existingRule.name) {
+							tidMS = append(tidMS, yaml.MapItem{Key: existingRule.name, Value: existingRule.value})
+						}
+					}
+				}
+
+				if text, err := format.convertArgs(rule.value, path, namedVars, isPlural, varFormats); err != nil {
+					return nil, nil, err
+				} else {
+					tidMS = append(tidMS, yaml.MapItem{Key: rule.name, Value: text})
+				}
+			}
+
+			//Add the Translation ID. A single ^ rule without variables is stored as a string
+			if len(tidMS) == 1 && tidMS[0].Key == "^" && len(vars) == 0 {
+				nsMS = append(nsMS, yaml.MapItem{Key: tidName, Value: tidMS[0].Value})
+				continue
+			}
+			for _, v := range vars {
+				tidMS = append(tidMS, yaml.MapItem{Key: v.name, Value: v.value})
+			}
+			nsMS = append(nsMS, yaml.MapItem{Key: tidName, Value: tidMS})
+		}
+		if len(nsMS) != 0 {
+			top = append(top, yaml.MapItem{Key: nsName, Value: nsMS})
+		}
+	}
+
+	//Warn about translations that are not in the source file
+	unknownKeys := make([]string, 0, len(translations))
+	for key := range translations {
+		unknownKeys = append(unknownKeys, key)
+	}
+	sort.Strings(unknownKeys)
+	for _, key := range unknownKeys {
+		warnings = append(warnings, fmt.Sprintf("“%s” is not in the default language and is skipped", key))
+	}
+
+	//Write the file
+	if fileText, err = lf.formatTopItem(yamlItem{Key: "TOP", Value: top}); err != nil {
+		return nil, nil, err
+	}
+	return fileText, warnings, nil
+}
@@ -0,0 +1,523 @@
+//Convert to and from Flutter ARB (Application Resource Bundle) files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The indentation used in written ARB files
+const arbIndent = "  "
+
+// The import format of ARB files, which are keyed by “Namespace_TranslationID” and have named ICU placeholders
+var arbFormat = mobileImportFormat{
+	key:         func(nsName, tidName string) string { return nsName + "_" + tidName },
+	convertArgs: fromICUPlaceholders,
+	hasRule: func(rule string) bool {
+		_, ok := arbRuleToSelector(rule)
+		return ok
+	},
+}
+
+// ExportARB writes a Flutter ARB file of the target language’s translations. If the target file is nil, the source file is exported instead, along with the “@” metadata of its resources’ placeholders, as Flutter requires them in the template ARB file.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The resource keys are “Namespace_TranslationID”. Variables become ICU placeholders ({Variable}), and Translation IDs with plural rules become ICU plural messages on the PluralCount placeholder. Translation IDs missing from the target file are left out so Flutter falls back to the template.
+//
+// Plural rules other than = and ^, variable flags and options, and embedded translations cannot be converted, and are returned as warnings.
+func ExportARB(w io.Writer, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (warnings []string, err error) {
+	//Read the Translation IDs
+	entries, languageIdentifier, err := readMobileEntries(sourceFile, sourceType, targetFile, targetType)
+	if err != nil {
+		return nil, err
+	}
+	addWarning := func(str string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(str, args...))
+	}
+
+	//Write the resources as compact JSON, which keeps their order
+	var buf bytes.Buffer
+	buf.WriteString(`{"@@locale":` + arbQuote(strings.ReplaceAll(languageIdentifier, "-", "_")))
+	for _, entry := range entries {
+		entry.key = strings.Replace(entry.key, ".", "_", 1)
+		message, isPlural := entry.toICUMessage(addWarning)
+		buf.WriteString("," + arbQuote(entry.key) + ":" + arbQuote(message))
+		if targetFile != nil {
+			continue
+		}
+
+		//Write the placeholders of the template
+		var placeholders []string
+		if isPlural {
+			placeholders = append(placeholders, arbQuote(pluralCountName)+`:{"type":"int"}`)
+		}
+		for _, v := range entry.vars {
+			dartType, format := arbPlaceholderType(v.value)
+			placeholder := arbQuote(v.name) + `:{"type":` + arbQuote(dartType)
+			if example := variableTypeExample(v.value); len(example) != 0 {
+				placeholder += `,"example":` + arbQuote(example)
+			}
+			if len(format) != 0 {
+				placeholder += `,"format":` + arbQuote(format)
+			}
+			placeholders = append(placeholders, placeholder+"}")
+		}
+		if len(placeholders) != 0 {
+			buf.WriteString("," + arbQuote("@"+entry.key) + `:{"placeholders":{` + strings.Join(placeholders, ",") + "}}")
+		}
+	}
+	buf.WriteString("}")
+
+	//Indent the JSON
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", arbIndent); err != nil {
+		return warnings, err
+	}
+	out.WriteByte('\n')
+	_, err = w.Write(out.Bytes())
+	return warnings, err
+}
+
+// ImportARB reads a Flutter ARB file and returns the language text file for the language identifier in the canonical layout of the file type (see Format()). See ExportARB() for the conversions.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The target file is the language’s existing text file (optional), whose Settings and missing Translation IDs are kept, along with the plural rules and variable flags and options that ARB files cannot hold. When importing the default language, the source file is used as the existing file.
+//
+// ICU plural messages become plural rules (=N stays =N, zero/one/two become =0/=1/=2, and other becomes ^). The few and many plural categories, and placeholder formats, are skipped. ICU select messages cannot be imported. Resource keys not in the default language are returned as warnings. TOML, i18next JSON, and custom format files cannot be written.
+func (lf LanguageTextFile) ImportARB(r io.Reader, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, warnings []string, err error) {
+	//Read the resources
+	var resources map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&resources); err != nil {
+		return nil, nil, errors.New("Error parsing ARB file: " + err.Error())
+	}
+	if locale, ok := resources["@@locale"].(string); ok && strings.ReplaceAll(locale, "_", "-") != languageIdentifier {
+		warnings = append(warnings, fmt.Sprintf("The ARB file’s locale “%s” does not match “%s”", locale, languageIdentifier))
+	}
+
+	//Get the translations. Keys starting with @ are metadata
+	translations := make(map[string][]textProp, len(resources))
+	for key, val := range resources {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		message, ok := val.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s: Must be a string", key)
+		}
+		rules, parseWarnings, err := parseICUMessage(message)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", key, err.Error())
+		}
+		for _, w := range parseWarnings {
+			warnings = append(warnings, key+": "+w)
+		}
+		if len(rules) != 0 {
+			translations[key] = rules
+		}
+	}
+	sort.Strings(warnings)
+
+	//Create the file
+	fileText, importWarnings, err := lf.importMobileTranslations(translations, arbFormat, languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	return fileText, append(warnings, importWarnings...), err
+}
+
+// Returns the JSON string of a string, without escaping HTML characters
+func arbQuote(str string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(str)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Returns the Dart type and format of an ARB placeholder for a variable type
+func arbPlaceholderType(varType string) (dartType, format string) {
+	switch variableTypeMap[strings.ToUpper(varType)] {
+	case vtString:
+		return "String", ""
+	case vtInteger, vtBinary, vtOctal, vtHexLower, vtHexUpper, vtSpellout, vtOrdinal:
+		return "int", ""
+	case vtIntegerWithSymbols:
+		return "int", "decimalPattern"
+	case vtScientific, vtFloating:
+		return "double", ""
+	case vtFloatWithSymbols:
+		return "double", "decimalPattern"
+	case vtCurrency:
+		return "num", "simpleCurrency"
+	case vtDateTime:
+		return "DateTime", "yMd"
+	default:
+		return "Object", ""
+	}
+}
+
+// Returns the ICU plural selector of a plural rule. Only = and ^ rules can be converted
+func arbRuleToSelector(rule string) (string, bool) {
+	rule = strings.Join(strings.Fields(rule), "")
+	if rule == "^" {
+		return "other", true
+	} else if len(rule) >= 2 && rule[0] == '=' {
+		if _, err := strconv.ParseUint(rule[1:], 10, 8); err == nil {
+			return rule, true
+		}
+	}
+	return "", false
+}
+
+// Returns the ICU message of an entry, and if it is a plural message. Translation IDs with only a ^ rule are not plural
+func (entry mobileEntry) toICUMessage(addWarning func(str string, args ...interface{})) (string, bool) {
+	if len(entry.rules) == 1 && entry.rules[0].name == "^" {
+		return entry.toICUText(entry.rules[0].value, false, addWarning), false
+	}
+
+	var sb strings.Builder
+	sb.WriteString("{" + pluralCountName + ", plural,")
+	hasOther := false
+	for _, rule := range entry.rules {
+		selector, ok := arbRuleToSelector(rule.name)
+		if !ok {
+			addWarning("%s: Plural rule “%s” has no matching ICU plural selector and is skipped", entry.key, rule.name)
+			continue
+		}
+		hasOther = hasOther || selector == "other"
+		sb.WriteString(" " + selector + "{" + entry.toICUText(rule.value, true, addWarning) + "}")
+	}
+	sb.WriteString("}")
+	if !hasOther {
+		addWarning("%s: ICU plural messages require an “other” (^) rule", entry.key)
+	}
+	return sb.String(), true
+}
+
+// Converts {{.Variable}} to ICU placeholders ({Variable}) and quotes the ICU special characters of the text around them
+func (entry mobileEntry) toICUText(text string, inPlural bool, addWarning func(str string, args ...interface{})) string {
+	var sb strings.Builder
+	lastPos := 0
+	for _, match := range regexReplaceVariables.FindAllStringSubmatchIndex(text, -1) {
+		sb.WriteString(icuQuote(text[lastPos:match[0]], inPlural, true))
+		lastPos = match[1]
+
+		varName := text[match[2]:match[3]]
+		if match[4] != -1 || match[6] != -1 {
+			addWarning("%s: Variable “%s” flags and options are not supported and are dropped", entry.key, varName)
+		}
+		sb.WriteString("{" + varName + "}")
+	}
+	sb.WriteString(icuQuote(text[lastPos:], inPlural, false))
+
+	if regexMatchEmbeddedStaticVariable.MatchString(text) {
+		addWarning("%s: Embedded translations cannot be converted and are kept as is", entry.key)
+	}
+	return sb.String()
+}
+
+// Quotes the ICU special characters ({, }, and # in plurals) with apostrophes. Apostrophes are only doubled where they would otherwise start a quote. If nextIsSpecial, the text is followed by a special character
+func icuQuote(str string, inPlural, nextIsSpecial bool) string {
+	isSpecial := func(c byte) bool { return c == '{' || c == '}' || (inPlural && c == '#') }
+	var sb strings.Builder
+	inQuote := false
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case isSpecial(c):
+			if !inQuote {
+				sb.WriteByte('\'')
+				inQuote = true
+			}
+			sb.WriteByte(c)
+		case c == '\'':
+			if inQuote || (i+1 == len(str) && nextIsSpecial) || (i+1 < len(str) && (str[i+1] == '\'' || isSpecial(str[i+1]))) {
+				sb.WriteString("''")
+			} else {
+				sb.WriteByte(c)
+			}
+		default:
+			if inQuote {
+				sb.WriteByte('\'')
+				inQuote = false
+			}
+			sb.WriteByte(c)
+		}
+	}
+	if inQuote {
+		sb.WriteByte('\'')
+	}
+	return sb.String()
+}
+
+// Matches a {{.Variable}} without flags or options
+var regexICUPlaceholder = regexp.MustCompile(`\{\{\.([\pL\pN_]+)}}`)
+
+// Adds the flags and options from varFormats to the {{.Variable}}s created by parseICUMessage(), and confirms they are variables of the Translation ID
+func fromICUPlaceholders(text, path string, vars []textProp, isPlural bool, varFormats map[string]varFormat) (string, error) {
+	var err error
+	ret := regexICUPlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		varName := placeholder[3 : len(placeholder)-2]
+		found := varName == pluralCountName
+		for _, v := range vars {
+			found = found || v.name == varName
+		}
+		if !found && err == nil {
+			err = fmt.Errorf("%s: Placeholder “%s” is not a variable", path, varName)
+		}
+
+		ret := "{{." + varName
+		if format, ok := varFormats[varName]; ok {
+			if len(format.flags) != 0 {
+				ret += "|" + format.flags
+			}
+			if len(format.options) != 0 {
+				ret += "!" + format.options
+			}
+		}
+		return ret + "}}"
+	})
+	return ret, err
+}
+
+// Reads an ICU message
+type icuParser struct {
+	msg      string
+	pos      int
+	warnings []string
+
+	//Used when translation text files are written in ICU MessageFormat (see icuPropsToGol10n()). If args is not nil, the plural argument can have any name, {{…}} is kept as is, and the variable types of the arguments are stored in order of first appearance
+	args       []textProp
+	pluralName string
+}
+
+// Parses an ICU message into plural rules with {{.Variable}} placeholders. A message without a plural argument is a single ^ rule. The text around a plural argument is added to all of its rules
+func parseICUMessage(msg string) (rules []textProp, warnings []string, err error) {
+	p := icuParser{msg: msg}
+	rules, err = p.parseMessage()
+	return rules, p.warnings, err
+}
+
+// Parses the message into plural rules. See parseICUMessage()
+func (p *icuParser) parseMessage() (rules []textProp, err error) {
+	var prefix, suffix string
+	var pluralRules []textProp
+	if prefix, pluralRules, err = p.parseText(false); err != nil {
+		return nil, err
+	} else if p.pos < len(p.msg) {
+		return nil, fmt.Errorf("Unmatched “}” at position %d", p.pos)
+	} else if pluralRules == nil {
+		return []textProp{{"^", prefix}}, nil
+	}
+
+	//Split the text around the plural argument
+	parts := strings.SplitN(prefix, "\x00", 2)
+	prefix, suffix = parts[0], parts[1]
+	for _, rule := range pluralRules {
+		rules = append(rules, textProp{rule.name, prefix + rule.value + suffix})
+	}
+	return rules, nil
+}
+
+// Stores the variable type of an argument if the parser is storing them, and it is the argument’s first appearance
+func (p *icuParser) addArg(name, varType string) {
+	if p.args == nil {
+		return
+	}
+	for _, arg := range p.args {
+		if arg.name == name {
+			return
+		}
+	}
+	p.args = append(p.args, textProp{name, varType})
+}
+
+// Parses text until an unmatched “}” (which is not consumed) or the end of the message. In plurals, # is PluralCount. Outside of plurals, the plural argument’s rules are returned, and its location in the text is marked with a \x00
+func (p *icuParser) parseText(inPlural bool) (text string, pluralRules []textProp, err error) {
+	isSpecial := func(c byte) bool { return c == '{' || c == '}' || (inPlural && c == '#') }
+	var sb strings.Builder
+	for p.pos < len(p.msg) {
+		switch c := p.msg[p.pos]; {
+		case c == '}':
+			return sb.String(), pluralRules, nil
+		case c == '#' && inPlural:
+			sb.WriteString("{{." + pluralCountName + "}}")
+			p.pos++
+		case c == '\'' && p.pos+1 < len(p.msg) && p.msg[p.pos+1] == '\'':
+			sb.WriteByte('\'')
+			p.pos += 2
+		case c == '\'' && p.pos+1 < len(p.msg) && isSpecial(p.msg[p.pos+1]):
+			//Quoted text runs until the next single apostrophe
+			for p.pos++; p.pos < len(p.msg); p.pos++ {
+				if p.msg[p.pos] != '\'' {
+					sb.WriteByte(p.msg[p.pos])
+				} else if p.pos+1 < len(p.msg) && p.msg[p.pos+1] == '\'' {
+					sb.WriteByte('\'')
+					p.pos++
+				} else {
+					p.pos++
+					break
+				}
+			}
+		case c == '{' && p.args != nil && strings.HasPrefix(p.msg[p.pos:], "{{"):
+			//gol10n variables and embedded translations are kept as is
+			end := strings.Index(p.msg[p.pos:], "}}")
+			if end == -1 {
+				return "", nil, fmt.Errorf("“{{” at position %d is missing its “}}”", p.pos)
+			}
+			sb.WriteString(p.msg[p.pos : p.pos+end+2])
+			p.pos += end + 2
+		case c == '{':
+			argText, argRules, err := p.parseArgument(inPlural || pluralRules != nil)
+			if err != nil {
+				return "", nil, err
+			} else if argRules != nil {
+				pluralRules = argRules
+				sb.WriteByte(0)
+			} else {
+				sb.WriteString(argText)
+			}
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	if inPlural {
+		return "", nil, errors.New("Plural selector is missing its “}”")
+	}
+	return sb.String(), pluralRules, nil
+}
+
+// Matches the start of an ICU argument. Groups: Name, Type
+var regexICUArgument = regexp.MustCompile(`^\{\s*([\pL\pN_]+)\s*(?:,\s*(\w+)\s*)?`)
+
+// Parses an argument starting at a “{”. Returns the text of a placeholder, or the rules of a plural argument
+func (p *icuParser) parseArgument(hasPlural bool) (text string, pluralRules []textProp, err error) {
+	//Read the name and type
+	match := regexICUArgument.FindStringSubmatch(p.msg[p.pos:])
+	if match == nil {
+		return "", nil, fmt.Errorf("Invalid argument at position %d", p.pos)
+	}
+	p.pos += len(match[0])
+	name, argType := match[1], match[2]
+	placeholder := "{{." + name + "}}"
+
+	switch argType {
+	case "":
+		if p.pos >= len(p.msg) || p.msg[p.pos] != '}' {
+			return "", nil, fmt.Errorf("Argument “%s” is missing its “}”", name)
+		}
+		p.pos++
+		p.addArg(name, "Anything")
+		return placeholder, nil, nil
+	case "plural":
+		if hasPlural {
+			return "", nil, errors.New("Only 1 plural argument is supported, and it cannot be nested")
+		} else if name != pluralCountName && p.args == nil {
+			return "", nil, fmt.Errorf("The plural argument must be “%s”", pluralCountName)
+		} else if p.pos >= len(p.msg) || p.msg[p.pos] != ',' {
+			return "", nil, errors.New("Plural argument is missing its selectors")
+		}
+		p.pos++
+		p.pluralName = name
+		rules, err := p.parsePlural()
+		return "", rules, err
+	case "number", "date", "time", "spellout", "ordinal":
+		//Get the style
+		end := strings.IndexByte(p.msg[p.pos:], '}')
+		if end == -1 {
+			return "", nil, fmt.Errorf("Argument “%s” is missing its “}”", name)
+		}
+		style := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(p.msg[p.pos:p.pos+end]), ","))
+		p.pos += end + 1
+
+		//Get the variable type of the argument. The style is not kept, except for the types it selects
+		varType, specifier := map[string]string{"number": "FloatWithSymbols", "date": "DateTime", "time": "DateTime", "spellout": "Spellout", "ordinal": "Ordinal"}[argType], ""
+		switch {
+		case argType == "number" && style == "integer":
+			varType, style = "IntegerWithSymbols", ""
+		case argType == "number" && style == "currency":
+			varType, style = "Currency", ""
+		case argType == "date" && p.args != nil:
+			specifier = "!%x"
+		case argType == "time" && p.args != nil:
+			specifier = "!%X"
+		case (argType == "spellout" || argType == "ordinal") && p.args == nil:
+			return "", nil, fmt.Errorf("Argument type “%s” is not supported", argType)
+		}
+		if len(style) != 0 {
+			p.warnings = append(p.warnings, fmt.Sprintf("Placeholder “%s” format “%s” is dropped", name, style))
+		}
+		p.addArg(name, varType)
+		return "{{." + name + specifier + "}}", nil, nil
+	default:
+		return "", nil, fmt.Errorf("Argument type “%s” is not supported", argType)
+	}
+}
+
+// Matches a plural selector. Groups: Selector
+var regexICUSelector = regexp.MustCompile(`^\s*(=\d+|[a-z]+)\s*\{`)
+
+// Matches a plural offset, which is not supported
+var regexICUOffset = regexp.MustCompile(`^\s*offset\s*:`)
+
+// Parses the selectors of a plural argument until its closing “}”
+func (p *icuParser) parsePlural() (rules []textProp, err error) {
+	if regexICUOffset.MatchString(p.msg[p.pos:]) {
+		return nil, errors.New("Plural offsets are not supported")
+	}
+
+	hasRule := make(map[string]bool)
+	for {
+		//Check for the end of the plural
+		p.pos += len(p.msg[p.pos:]) - len(strings.TrimLeft(p.msg[p.pos:], " \t\r\n"))
+		if p.pos >= len(p.msg) {
+			return nil, errors.New("Plural argument is missing its “}”")
+		} else if p.msg[p.pos] == '}' {
+			p.pos++
+			break
+		}
+
+		//Read the selector and its text
+		match := regexICUSelector.FindStringSubmatch(p.msg[p.pos:])
+		if match == nil {
+			return nil, fmt.Errorf("Invalid plural selector at position %d", p.pos)
+		}
+		p.pos += len(match[0])
+		text, _, err := p.parseText(true)
+		if err != nil {
+			return nil, err
+		}
+		p.pos++
+
+		//Convert the selector to a rule
+		var rule string
+		switch selector := match[1]; {
+		case selector[0] == '=':
+			if _, err := strconv.ParseUint(selector[1:], 10, 8); err != nil {
+				return nil, fmt.Errorf("Plural selector “%s” is out of range", selector)
+			}
+			rule = selector
+		case selector == "other":
+			rule = "^"
+		default:
+			if rule, _ = mobileQuantityToRule(selector); len(rule) == 0 {
+				p.warnings = append(p.warnings, fmt.Sprintf("Plural selector “%s” has no matching plural rule and is skipped", selector))
+				continue
+			}
+		}
+		if hasRule[rule] {
+			p.warnings = append(p.warnings, fmt.Sprintf("Plural selector “%s” is a duplicate of rule “%s” and is skipped", match[1], rule))
+			continue
+		}
+		hasRule[rule] = true
+		rules = append(rules, textProp{rule, text})
+	}
+
+	//The ^ rule must be last, as plural rules are matched in order
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].name != "^" && rules[j].name == "^" })
+	return rules, nil
+}
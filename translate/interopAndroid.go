@@ -0,0 +1,176 @@
+//Convert to and from Android strings.xml resource files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ---------------------------------strings.xml document--------------------------------
+type androidResources struct {
+	Strings []androidString  `xml:"string"`
+	Plurals []androidPlurals `xml:"plurals"`
+}
+
+type androidString struct {
+	Name         string `xml:"name,attr"`
+	Translatable string `xml:"translatable,attr"`
+	Inner        string `xml:",innerxml"`
+}
+
+type androidPlurals struct {
+	Name  string        `xml:"name,attr"`
+	Items []androidItem `xml:"item"`
+}
+
+type androidItem struct {
+	Quantity string `xml:"quantity,attr"`
+	Inner    string `xml:",innerxml"`
+}
+
+// The indentation used in written strings.xml files
+const androidIndent = "    "
+
+// ExportAndroidStrings writes an Android strings.xml resource file of the target language’s translations. If the target file is nil, the source file is exported instead.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The resource names are “Namespace.TranslationID”. Variables become positional printf arguments (see the documentation), and Translation IDs that are plural in the default language become <plurals>. Translation IDs missing from the target file are left out so Android falls back to the default resources.
+//
+// Plural rules become the CLDR plural categories of the language, where ^ is “other”. A rule whose counts are not all in one category of the language (like =0 in English, which is in “other”) is skipped, and a rule whose category also holds other counts (like =1 in Russian, whose “one” also holds 21) is used for all of them. Both are returned as warnings, as are variable options (after a “!”) and embedded translations, which cannot be converted.
+func ExportAndroidStrings(w io.Writer, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (warnings []string, err error) {
+	//Read the Translation IDs
+	entries, languageIdentifier, err := readMobileEntries(sourceFile, sourceType, targetFile, targetType)
+	if err != nil {
+		return nil, err
+	}
+	addWarning := func(str string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(str, args...))
+	}
+
+	//Write the resources
+	plurals := newMobilePlurals(languageIdentifier)
+	var buf bytes.Buffer
+	buf.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<resources>\n")
+	for _, entry := range entries {
+		rules := entry.getMobileRules(plurals, addWarning)
+		name := mobileEscapeXML(entry.key, true)
+		if !entry.isPlural {
+			_, _ = fmt.Fprintf(&buf, "%s<string name=\"%s\">%s</string>\n", androidIndent, name, androidEscape(entry.toPositionalArgs(rules[0].value, "s", addWarning)))
+			continue
+		} else if len(rules) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(&buf, "%s<plurals name=\"%s\">\n", androidIndent, name)
+		for _, rule := range rules {
+			_, _ = fmt.Fprintf(&buf, "%s<item quantity=\"%s\">%s</item>\n", androidIndent+androidIndent, rule.name, androidEscape(entry.toPositionalArgs(rule.value, "s", addWarning)))
+		}
+		_, _ = fmt.Fprintf(&buf, "%s</plurals>\n", androidIndent)
+	}
+	buf.WriteString("</resources>\n")
+
+	_, err = w.Write(buf.Bytes())
+	return warnings, err
+}
+
+// ImportAndroidStrings reads an Android strings.xml resource file and returns the language text file for the language identifier in the canonical layout of the file type (see Format()). See ExportAndroidStrings() for the conversions.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The target file is the language’s existing text file (optional), whose Settings and missing Translation IDs are kept. When importing the default language, the source file is used as the existing file.
+//
+// Strings that are not translatable are skipped. Plural categories become plural rules of the language. A category the language does not use is skipped, and a category that no single plural rule holds exactly (like “one” in Russian) becomes the rule of its longest range of counts. Both are returned as warnings. Resource names not in the default language are returned as warnings. TOML, i18next JSON, and custom format files cannot be written.
+func (lf LanguageTextFile) ImportAndroidStrings(r io.Reader, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, warnings []string, err error) {
+	//Read the resources
+	var res androidResources
+	if err := xml.NewDecoder(r).Decode(&res); err != nil {
+		return nil, nil, errors.New("Error parsing strings.xml file: " + err.Error())
+	}
+
+	//Get the translations
+	translations := make(map[string][]textProp, len(res.Strings)+len(res.Plurals))
+	for _, s := range res.Strings {
+		if s.Translatable == "false" {
+			continue
+		} else if text, err := androidUnescape(s.Inner); err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", s.Name, err.Error())
+		} else {
+			translations[s.Name] = []textProp{{"^", text}}
+		}
+	}
+	plurals := newMobilePlurals(languageIdentifier)
+	addWarning := func(str string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(str, args...))
+	}
+	for _, p := range res.Plurals {
+		var categories []textProp
+		for _, item := range p.Items {
+			if text, err := androidUnescape(item.Inner); err != nil {
+				return nil, nil, fmt.Errorf("%s: %s", p.Name, err.Error())
+			} else {
+				categories = append(categories, textProp{item.Quantity, text})
+			}
+		}
+		if rules := plurals.categoriesToRules(p.Name, categories, addWarning); len(rules) != 0 {
+			translations[p.Name] = rules
+		}
+	}
+
+	//Create the file
+	fileText, importWarnings, err := lf.importMobileTranslations(translations, positionalArgsFormat(plurals), languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	return fileText, append(warnings, importWarnings...), err
+}
+
+// Escapes a translation for strings.xml. Apostrophes and double quotes are escaped with a backslash, as are leading @ and ? characters
+func androidEscape(str string) string {
+	str = strings.NewReplacer(`'`, `\'`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(str)
+	if len(str) != 0 && (str[0] == '@' || str[0] == '?') {
+		str = `\` + str
+	}
+	return mobileEscapeXML(str, false)
+}
+
+// Returns the text of a strings.xml element’s inner XML. Markup (like <b>) is kept as is. Surrounding double quotes are removed, and the backslash escapes of apostrophes, double quotes, and leading @ and ? characters are removed
+func androidUnescape(innerXML string) (string, error) {
+	//Read the text and markup
+	var sb strings.Builder
+	d := xml.NewDecoder(strings.NewReader("<x>" + innerXML + "</x>"))
+	for depth := 0; ; {
+		t, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		switch v := t.(type) {
+		case xml.StartElement:
+			if depth++; depth == 1 {
+				continue
+			}
+			sb.WriteString("<" + v.Name.Local)
+			for _, attr := range v.Attr {
+				sb.WriteString(" " + attr.Name.Local + "=\"" + mobileEscapeXML(attr.Value, true) + "\"")
+			}
+			sb.WriteByte('>')
+		case xml.EndElement:
+			if depth--; depth != 0 {
+				sb.WriteString("</" + v.Name.Local + ">")
+			}
+		case xml.CharData:
+			sb.Write(v)
+		}
+	}
+
+	//Remove the escapes
+	str := sb.String()
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		str = str[1 : len(str)-1]
+	}
+	if strings.HasPrefix(str, `\@`) || strings.HasPrefix(str, `\?`) {
+		str = str[1:]
+	}
+	return strings.NewReplacer(`\\`, `\\`, `\'`, `'`, `\"`, `"`).Replace(str), nil
+}
@@ -0,0 +1,341 @@
+//Convert to and from Apple .strings and .stringsdict resource files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Keys of .stringsdict entries
+const (
+	appleFormatKey     = "NSStringLocalizedFormatKey"
+	appleSpecTypeKey   = "NSStringFormatSpecTypeKey"
+	appleValueTypeKey  = "NSStringFormatValueTypeKey"
+	applePluralRuleKey = "NSStringPluralRuleType"
+)
+
+// ExportAppleStrings writes an Apple .strings resource file (non-plural Translation IDs) and .stringsdict resource file (plural Translation IDs) of the target language’s translations. If the target file is nil, the source file is exported instead. Either writer can be nil to not write that file.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The keys are “Namespace.TranslationID”. Variables become positional printf arguments (see the documentation), and the plural variable of .stringsdict entries is PluralCount. Translation IDs missing from the target file are left out so the app falls back to the development language.
+//
+// Plural rules become the CLDR plural categories of the language, where ^ is “other”. A rule whose counts are not all in one category of the language (like =0 in English, which is in “other”) is skipped, and a rule whose category also holds other counts (like =1 in Russian, whose “one” also holds 21) is used for all of them. Both are returned as warnings, as are variable options (after a “!”) and embedded translations, which cannot be converted.
+func ExportAppleStrings(stringsW, stringsdictW io.Writer, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (warnings []string, err error) {
+	//Read the Translation IDs
+	entries, languageIdentifier, err := readMobileEntries(sourceFile, sourceType, targetFile, targetType)
+	if err != nil {
+		return nil, err
+	}
+	addWarning := func(str string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(str, args...))
+	}
+
+	//Create the files
+	plurals := newMobilePlurals(languageIdentifier)
+	var stringsBuf, dictBuf bytes.Buffer
+	dictBuf.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	dictBuf.WriteString("<!DOCTYPE plist PUBLIC \"-//Apple//DTD PLIST 1.0//EN\" \"http://www.apple.com/DTDs/PropertyList-1.0.dtd\">\n")
+	dictBuf.WriteString("<plist version=\"1.0\">\n<dict>\n")
+	writeDictString := func(indent int, key, value string) {
+		tabs := strings.Repeat("\t", indent)
+		_, _ = fmt.Fprintf(&dictBuf, "%s<key>%s</key>\n%s<string>%s</string>\n", tabs, mobileEscapeXML(key, false), tabs, mobileEscapeXML(value, false))
+	}
+	for _, entry := range entries {
+		rules := entry.getMobileRules(plurals, addWarning)
+		if !entry.isPlural {
+			_, _ = fmt.Fprintf(&stringsBuf, "%s = %s;\n", appleQuote(entry.key), appleQuote(entry.toPositionalArgs(rules[0].value, "@", addWarning)))
+			continue
+		} else if len(rules) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(&dictBuf, "\t<key>%s</key>\n\t<dict>\n", mobileEscapeXML(entry.key, false))
+		writeDictString(2, appleFormatKey, "%#@"+pluralCountName+"@")
+		_, _ = fmt.Fprintf(&dictBuf, "\t\t<key>%s</key>\n\t\t<dict>\n", pluralCountName)
+		writeDictString(3, appleSpecTypeKey, applePluralRuleKey)
+		writeDictString(3, appleValueTypeKey, "d")
+		for _, rule := range rules {
+			writeDictString(3, rule.name, entry.toPositionalArgs(rule.value, "@", addWarning))
+		}
+		dictBuf.WriteString("\t\t</dict>\n\t</dict>\n")
+	}
+	dictBuf.WriteString("</dict>\n</plist>\n")
+
+	//Write the files
+	if stringsW != nil {
+		if _, err := stringsW.Write(stringsBuf.Bytes()); err != nil {
+			return warnings, err
+		}
+	}
+	if stringsdictW != nil {
+		if _, err := stringsdictW.Write(dictBuf.Bytes()); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
+}
+
+// ImportAppleStrings reads Apple .strings and .stringsdict resource files and returns the language text file for the language identifier in the canonical layout of the file type (see Format()). Either file can be nil. See ExportAppleStrings() for the conversions.
+//
+// The source file is the default language, which gives the order of the Translation IDs and their variables. The target file is the language’s existing text file (optional), whose Settings and missing Translation IDs are kept. When importing the default language, the source file is used as the existing file.
+//
+// The resource files must be UTF-8. Plural categories become plural rules of the language. A category the language does not use is skipped, and a category that no single plural rule holds exactly (like “one” in Russian) becomes the rule of its longest range of counts. Both are returned as warnings. Keys not in the default language are returned as warnings. TOML, i18next JSON, and custom format files cannot be written.
+func (lf LanguageTextFile) ImportAppleStrings(stringsFile, stringsdictFile io.Reader, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, warnings []string, err error) {
+	//Read the .strings file
+	plurals := newMobilePlurals(languageIdentifier)
+	translations := make(map[string][]textProp)
+	if stringsFile != nil {
+		if b, err := io.ReadAll(stringsFile); err != nil {
+			return nil, nil, err
+		} else if pairs, err := parseAppleStrings(b); err != nil {
+			return nil, nil, errors.New("Error parsing .strings file: " + err.Error())
+		} else {
+			for _, p := range pairs {
+				translations[p.name] = []textProp{{"^", p.value}}
+			}
+		}
+	}
+
+	//Read the .stringsdict file
+	if stringsdictFile != nil {
+		dict, err := parseAppleStringsdict(stringsdictFile)
+		if err != nil {
+			return nil, nil, errors.New("Error parsing .stringsdict file: " + err.Error())
+		}
+		addWarning := func(str string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(str, args...))
+		}
+		for _, entry := range dict {
+			//Get the plural variable
+			entryDict, _ := entry.value.(plistDict)
+			formatKey, _ := entryDict.get(appleFormatKey).(string)
+			match := regexApplePluralFormat.FindStringSubmatch(formatKey)
+			if match == nil {
+				warnings = append(warnings, fmt.Sprintf("%s: %s must be a single plural variable and is skipped", entry.key, appleFormatKey))
+				continue
+			}
+			varDict, _ := entryDict.get(match[1]).(plistDict)
+
+			//Get the plural rules
+			var categories []textProp
+			for _, q := range varDict {
+				if q.key == appleSpecTypeKey || q.key == appleValueTypeKey {
+					continue
+				}
+				if text, isString := q.value.(string); !isString {
+					warnings = append(warnings, fmt.Sprintf("%s: Plural category “%s” must be a string and is skipped", entry.key, q.key))
+				} else {
+					categories = append(categories, textProp{q.key, text})
+				}
+			}
+			if rules := plurals.categoriesToRules(entry.key, categories, addWarning); len(rules) != 0 {
+				if _, ok := translations[entry.key]; ok {
+					warnings = append(warnings, fmt.Sprintf("%s: Is in both files, so the .stringsdict entry is used", entry.key))
+				}
+				translations[entry.key] = rules
+			}
+		}
+	}
+
+	//Create the file
+	fileText, importWarnings, err := lf.importMobileTranslations(translations, positionalArgsFormat(plurals), languageIdentifier, sourceFile, sourceType, targetFile, targetType)
+	return fileText, append(warnings, importWarnings...), err
+}
+
+// Matches a .stringsdict format key that is only a single variable. Group: The variable name
+var regexApplePluralFormat = regexp.MustCompile(`^%(?:1\$)?#@(\w+)@$`)
+
+// Returns the string double-quoted for a .strings file
+func appleQuote(str string) string {
+	return `"` + strings.NewReplacer(`"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(str) + `"`
+}
+
+// Parses the key/value pairs of a .strings file. Comments are skipped. The backslash escapes of double quotes are removed, and all other escapes are kept as is
+func parseAppleStrings(b []byte) (pairs []textProp, err error) {
+	if !utf8.Valid(b) {
+		return nil, errors.New("File is not utf8 valid")
+	}
+	str := strings.TrimPrefix(b2s(b), "\uFEFF")
+	pos, line := 0, 1
+
+	//Skips whitespace and comments
+	skip := func() error {
+		for pos < len(str) {
+			switch {
+			case str[pos] == '\n':
+				line++
+				pos++
+			case str[pos] == ' ' || str[pos] == '\t' || str[pos] == '\r':
+				pos++
+			case strings.HasPrefix(str[pos:], "//"):
+				for pos < len(str) && str[pos] != '\n' {
+					pos++
+				}
+			case strings.HasPrefix(str[pos:], "/*"):
+				end := strings.Index(str[pos+2:], "*/")
+				if end == -1 {
+					return fmt.Errorf("Line %d: Comment is not closed", line)
+				}
+				line += strings.Count(str[pos:pos+2+end], "\n")
+				pos += end + 4
+			default:
+				return nil
+			}
+		}
+		return nil
+	}
+
+	//Reads a double-quoted or unquoted string
+	readString := func() (string, error) {
+		if err := skip(); err != nil {
+			return "", err
+		} else if pos >= len(str) {
+			return "", fmt.Errorf("Line %d: Unexpected end of file", line)
+		} else if str[pos] != '"' {
+			start := pos
+			for pos < len(str) && strings.IndexByte(" \t\r\n=;", str[pos]) == -1 {
+				pos++
+			}
+			if start == pos {
+				return "", fmt.Errorf("Line %d: Expected a string", line)
+			}
+			return str[start:pos], nil
+		}
+
+		var sb strings.Builder
+		for pos++; pos < len(str); pos++ {
+			switch c := str[pos]; {
+			case c == '"':
+				pos++
+				return sb.String(), nil
+			case c == '\\' && pos+1 < len(str) && str[pos+1] == '"':
+				sb.WriteByte('"')
+				pos++
+			case c == '\\' && pos+1 < len(str):
+				sb.WriteString(str[pos : pos+2])
+				pos++
+			default:
+				if c == '\n' {
+					line++
+				}
+				sb.WriteByte(c)
+			}
+		}
+		return "", fmt.Errorf("Line %d: String is not closed", line)
+	}
+
+	//Reads an expected character
+	expect := func(c byte) error {
+		if err := skip(); err != nil {
+			return err
+		} else if pos >= len(str) || str[pos] != c {
+			return fmt.Errorf("Line %d: Expected “%c”", line, c)
+		}
+		pos++
+		return nil
+	}
+
+	//Read the pairs
+	for {
+		if err := skip(); err != nil {
+			return nil, err
+		} else if pos >= len(str) {
+			return pairs, nil
+		}
+		var p textProp
+		if p.name, err = readString(); err != nil {
+			return nil, err
+		} else if err = expect('='); err != nil {
+			return nil, err
+		} else if p.value, err = readString(); err != nil {
+			return nil, err
+		} else if err = expect(';'); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+}
+
+// ---------------------------------Property lists---------------------------------
+// A plist dictionary. Values are either a string or a plistDict. Other value types are read as empty strings
+type plistDict []plistEntry
+type plistEntry struct {
+	key   string
+	value interface{}
+}
+
+// Returns the value of the key, or nil if not found
+func (d plistDict) get(key string) interface{} {
+	for _, e := range d {
+		if e.key == key {
+			return e.value
+		}
+	}
+	return nil
+}
+
+// Parses the top level dictionary of a .stringsdict file
+func parseAppleStringsdict(r io.Reader) (plistDict, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		} else if start, ok := t.(xml.StartElement); ok && start.Name.Local == "dict" {
+			val, err := plistReadValue(d, start)
+			if err != nil {
+				return nil, err
+			}
+			return val.(plistDict), nil
+		}
+	}
+}
+
+// Reads a plist value from its start element
+func plistReadValue(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	//Non-dictionary values are read as their text
+	if start.Name.Local != "dict" {
+		var text string
+		if err := d.DecodeElement(&text, &start); err != nil {
+			return nil, err
+		} else if start.Name.Local != "string" {
+			return "", nil
+		}
+		return text, nil
+	}
+
+	//Read the dictionary’s key and value pairs
+	var dict plistDict
+	var key *string
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch v := t.(type) {
+		case xml.StartElement:
+			if v.Name.Local == "key" && key == nil {
+				key = new(string)
+				if err := d.DecodeElement(key, &v); err != nil {
+					return nil, err
+				}
+			} else if key == nil {
+				return nil, fmt.Errorf("Dictionary value <%s> does not have a key", v.Name.Local)
+			} else if val, err := plistReadValue(d, v); err != nil {
+				return nil, err
+			} else {
+				dict = append(dict, plistEntry{*key, val})
+				key = nil
+			}
+		case xml.EndElement:
+			return dict, nil
+		}
+	}
+}
@@ -0,0 +1,939 @@
+//Convert from Mozilla Fluent (FTL) files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FluentFile is a Fluent (FTL) file to import. Its messages and terms become the Translation IDs of the namespace
+type FluentFile struct {
+	Namespace string
+	File      io.Reader
+}
+
+// ImportFluent reads Fluent (FTL) files and returns the language text file for the language identifier in the canonical layout of the file type (see Format()). Each Fluent file becomes a namespace, which replaces the namespace in the existing file. Other namespaces of the existing file are kept.
+//
+// Message and term IDs become PascalCase Translation IDs (welcome-title becomes WelcomeTitle). Terms are prefixed with “Term_” (-brand-name becomes Term_BrandName), and attributes are added as their own Translation IDs suffixed with “_Attribute” (login-input.placeholder becomes LoginInput_Placeholder). Message and term references become embedded translations.
+//
+// Variables ($name) become {{.Variables}}, which are declared in order of first appearance. NUMBER() makes them FloatWithSymbols, and DATETIME() makes them DateTimes. A select expression on a number becomes plural rules on the PluralCount (zero/one/two become =0/=1/=2, numbers become =N, and the default variant becomes ^). Other select expressions use their default variant. Constructs that cannot be represented are returned as warnings.
+//
+// The source file is the default language (optional). For other languages, the variables of its Translation IDs are used so they match. The target file is the language’s existing text file (optional), whose Settings and other namespaces are kept. When importing the default language, the source file is used as the existing file. TOML, i18next JSON, and custom format files cannot be written.
+func (lf LanguageTextFile) ImportFluent(files []FluentFile, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, warnings []string, err error) {
+	//Read the text files
+	if !lf.CanBeWritten() {
+		return nil, nil, errors.New("TOML, i18next JSON, and custom format files cannot be written")
+	}
+	initTextProcessing()
+	var srcObj, srcSettings tpMap
+	var srcLang string
+	if sourceFile != nil {
+		if srcObj, srcSettings, srcLang, err = readTextFileTop(sourceFile, sourceType); err != nil {
+			return nil, nil, fmt.Errorf("Source file: %s", err.Error())
+		}
+	}
+	existingObj, existingSettings := tpMap(nil), tpMap(nil)
+	if targetFile != nil {
+		var trgLang string
+		if existingObj, existingSettings, trgLang, err = readTextFileTop(targetFile, targetType); err != nil {
+			return nil, nil, fmt.Errorf("Target file: %s", err.Error())
+		} else if trgLang != languageIdentifier {
+			return nil, nil, fmt.Errorf("Target file: Language identifier “%s” does not match “%s”", trgLang, languageIdentifier)
+		}
+	} else if srcObj != nil && languageIdentifier == srcLang {
+		existingObj, existingSettings = srcObj, srcSettings
+	}
+	useSourceVars := srcObj != nil && languageIdentifier != srcLang
+
+	//Parse the Fluent files, and get the Translation IDs of their messages, terms, and attributes
+	type fluentNamespace struct {
+		name    string
+		entries []fluentEntry
+	}
+	namespaces := make([]fluentNamespace, 0, len(files))
+	targets := make(map[string]fluentTarget)
+	existingTIDs := make(map[string]string) //Translation ID to namespace
+	regexMatchNamespaceName := regexp.MustCompile(`^\w+$`)
+	for _, f := range files {
+		if !regexMatchNamespaceName.MatchString(f.Namespace) {
+			return nil, nil, fmt.Errorf("“%s” is not a valid namespace name", f.Namespace)
+		}
+		for _, ns := range namespaces {
+			if ns.name == f.Namespace {
+				return nil, nil, fmt.Errorf("Namespace “%s” was given more than once", f.Namespace)
+			}
+		}
+		b, err := io.ReadAll(f.File)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: Error reading the file: %s", f.Namespace, err.Error())
+		}
+		entries, parseWarnings := parseFluent(string(b))
+		for _, w := range parseWarnings {
+			warnings = append(warnings, f.Namespace+": "+w)
+		}
+
+		//Skip entries whose Translation IDs were already taken
+		tidOwners := make(map[string]string)
+		addTarget := func(fluentID, tid string) bool {
+			if owner, ok := tidOwners[tid]; ok {
+				warnings = append(warnings, fmt.Sprintf("%s.%s: Its Translation ID “%s” is already used by “%s”, so it is skipped", f.Namespace, fluentID, tid, owner))
+				return false
+			}
+			tidOwners[tid] = fluentID
+			targets[fluentID] = fluentTarget{f.Namespace, tid}
+			return true
+		}
+		keptEntries := entries[:0]
+		for _, entry := range entries {
+			if entry.value != nil && !addTarget(entry.id, fluentTranslationID(entry.id, "")) {
+				continue
+			}
+			keptAttributes := entry.attributes[:0]
+			for _, attr := range entry.attributes {
+				if addTarget(entry.id+"."+attr.name, fluentTranslationID(entry.id, attr.name)) {
+					keptAttributes = append(keptAttributes, attr)
+				}
+			}
+			entry.attributes = keptAttributes
+			keptEntries = append(keptEntries, entry)
+		}
+		namespaces = append(namespaces, fluentNamespace{f.Namespace, keptEntries})
+	}
+
+	//References to Fluent IDs that are not in the files can use the Translation IDs of the source and existing files
+	for _, obj := range []tpMap{srcObj, existingObj} {
+		if obj == nil {
+			continue
+		}
+		for _, nsItem := range obj.toOrdered() {
+			if nsObj, ok := nsItem.getObject(); ok && nsItem.getName() != "Settings" {
+				for _, tidItem := range nsObj.toOrdered() {
+					if _, ok := existingTIDs[tidItem.getName()]; !ok {
+						existingTIDs[tidItem.getName()] = nsItem.getName()
+					}
+				}
+			}
+		}
+	}
+
+	//Create the Settings object
+	top := yamlMapSlice{{Key: "Settings", Value: yamlMapSlice{{Key: "LanguageIdentifier", Value: languageIdentifier}}}}
+	if existingSettings != nil {
+		top[0].Value = toYamlMapSlice(existingSettings)
+	}
+
+	//Convert the namespaces
+	convertedNamespaces := make(map[string]yamlMapSlice, len(namespaces))
+	for _, ns := range namespaces {
+		//Get the source and existing namespaces
+		var srcNsObj, existingNsObj tpMap
+		if useSourceVars {
+			if srcNsItem, ok := srcObj.getValue(ns.name); ok {
+				srcNsObj, _ = srcNsItem.getObject()
+			}
+		}
+		if existingObj != nil {
+			if existingNsItem, ok := existingObj.getValue(ns.name); ok {
+				existingNsObj, _ = existingNsItem.getObject()
+			}
+		}
+
+		//Keep the existing namespace metadata
+		var nsMS yamlMapSlice
+		if existingNsObj != nil {
+			if metadataItem, ok := existingNsObj.getValue(namespaceMetadataName); ok {
+				nsMS = append(nsMS, toYamlMapItem(metadataItem))
+			}
+		}
+
+		//Convert the messages, terms, and attributes
+		convertPattern := func(fluentID string, pattern []fluentElement) error {
+			tid := targets[fluentID].tid
+			c := fluentConverter{namespace: ns.name, targets: targets, existingTIDs: existingTIDs}
+			rules := c.convert(pattern)
+			for _, w := range c.warnings {
+				warnings = append(warnings, fmt.Sprintf("%s.%s: %s", ns.name, fluentID, w))
+			}
+
+			//Use the source file’s variables
+			vars := c.vars
+			if srcNsObj != nil {
+				if srcTidItem, ok := srcNsObj.getValue(tid); ok {
+					_, srcVars, err := readTranslationIDProps(srcTidItem, ns.name+"."+tid)
+					if err != nil {
+						return fmt.Errorf("Source file: %s", err.Error())
+					}
+					for _, v := range c.vars {
+						if !hasTextProp(srcVars, v.name) {
+							warnings = append(warnings, fmt.Sprintf("%s.%s: Variable “%s” is not in the default language", ns.name, fluentID, v.name))
+						}
+					}
+					vars = srcVars
+				}
+			}
+
+			//Add the Translation ID. A single ^ rule without variables is stored as a string
+			if len(rules) == 1 && len(vars) == 0 {
+				nsMS = append(nsMS, yaml.MapItem{Key: tid, Value: rules[0].value})
+				return nil
+			}
+			var tidMS yamlMapSlice
+			for _, prop := range append(rules, vars...) {
+				tidMS = append(tidMS, yaml.MapItem{Key: prop.name, Value: prop.value})
+			}
+			nsMS = append(nsMS, yaml.MapItem{Key: tid, Value: tidMS})
+			return nil
+		}
+		for _, entry := range ns.entries {
+			if entry.value != nil {
+				if err := convertPattern(entry.id, entry.value); err != nil {
+					return nil, nil, err
+				}
+			}
+			for _, attr := range entry.attributes {
+				if err := convertPattern(entry.id+"."+attr.name, attr.value); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+		convertedNamespaces[ns.name] = nsMS
+	}
+
+	//Keep the existing namespaces (replacing the imported ones in place), and then add the new namespaces
+	if existingObj != nil {
+		for _, nsItem := range existingObj.toOrdered() {
+			if nsName := nsItem.getName(); nsName == "Settings" {
+				continue
+			} else if nsMS, ok := convertedNamespaces[nsName]; ok {
+				top = append(top, yaml.MapItem{Key: nsName, Value: nsMS})
+				delete(convertedNamespaces, nsName)
+			} else {
+				top = append(top, toYamlMapItem(nsItem))
+			}
+		}
+	}
+	for _, ns := range namespaces {
+		if nsMS, ok := convertedNamespaces[ns.name]; ok {
+			top = append(top, yaml.MapItem{Key: ns.name, Value: nsMS})
+		}
+	}
+
+	//Write the file
+	if fileText, err = lf.formatTopItem(yamlItem{Key: "TOP", Value: top}); err != nil {
+		return nil, nil, err
+	}
+	return fileText, warnings, nil
+}
+
+// Returns if a property with the name exists
+func hasTextProp(props []textProp, name string) bool {
+	for _, prop := range props {
+		if prop.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------------Fluent conversion-----------------------------
+
+// The Translation ID that a Fluent message, term, or attribute is converted to
+type fluentTarget struct {
+	namespace string
+	tid       string
+}
+
+// Returns the Translation ID of a Fluent message or term ID, and an optional attribute name. See ImportFluent()
+func fluentTranslationID(fluentID, attribute string) string {
+	var sb strings.Builder
+	if strings.HasPrefix(fluentID, "-") {
+		sb.WriteString("Term_")
+		fluentID = fluentID[1:]
+	}
+	sb.WriteString(fluentPascalCase(fluentID))
+	if len(attribute) != 0 {
+		sb.WriteString("_" + fluentPascalCase(attribute))
+	}
+	return sb.String()
+}
+
+// Converts a kebab-case (or snake_case) Fluent identifier to PascalCase. Fluent identifiers are ASCII
+func fluentPascalCase(id string) string {
+	var sb strings.Builder
+	for _, part := range strings.FieldsFunc(id, func(r rune) bool { return r == '-' || r == '_' }) {
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+// Returns the variable name of a Fluent variable. Fluent variables can contain hyphens, which are not allowed in variable names
+func fluentVariableName(name string) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// Escapes Fluent text for a translation string. Backslashes are escaped, and “{{” is broken up so it does not start a variable
+func fluentEscapeText(text string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(text, `\`, `\\`), "{{", `{\u007b`)
+}
+
+// Converts the pattern of a Fluent message, term, or attribute into plural rules, and gathers its variables
+type fluentConverter struct {
+	namespace    string
+	targets      map[string]fluentTarget
+	existingTIDs map[string]string //The Translation IDs of the source and existing files, to their namespaces
+	vars         []textProp        //The variables in order of first appearance, not including the plural variable
+	pluralVar    string
+	warnings     []string
+}
+
+func (c *fluentConverter) addWarning(str string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(str, args...))
+}
+
+// Adds a variable if it is its first appearance. A variable first seen without a type (Anything) takes the type of a later appearance
+func (c *fluentConverter) addVar(name, varType string) {
+	for i, v := range c.vars {
+		if v.name == name {
+			if v.value == "Anything" {
+				c.vars[i].value = varType
+			}
+			return
+		}
+	}
+	c.vars = append(c.vars, textProp{name, varType})
+}
+
+// Returns the plural rules of a pattern. A pattern without a plural select expression is a single ^ rule. The text around a plural select expression is added to all of its rules
+func (c *fluentConverter) convert(pattern []fluentElement) []textProp {
+	text, pluralRules := c.convertElements(pattern, true)
+	if pluralRules == nil {
+		return []textProp{{"^", text}}
+	}
+
+	//The plural variable is the PluralCount
+	var rules []textProp
+	parts := strings.SplitN(text, "\x00", 2)
+	regexPluralVar := regexp.MustCompile(`\{\{\.` + regexp.QuoteMeta(c.pluralVar) + `([}!])`)
+	for _, rule := range pluralRules {
+		rules = append(rules, textProp{rule.name, regexPluralVar.ReplaceAllString(parts[0]+rule.value+parts[1], "{{."+pluralCountName+"$1")})
+	}
+	for i, v := range c.vars {
+		if v.name == c.pluralVar {
+			c.vars = append(c.vars[:i], c.vars[i+1:]...)
+			break
+		}
+	}
+	return rules
+}
+
+// Converts the elements of a pattern to text. If allowPlural, the rules of the first plural select expression are returned, and its location in the text is marked with a \x00
+func (c *fluentConverter) convertElements(elements []fluentElement, allowPlural bool) (text string, pluralRules []textProp) {
+	var sb strings.Builder
+	for _, el := range elements {
+		switch {
+		case el.expr == nil:
+			sb.WriteString(fluentEscapeText(el.text))
+		case el.expr.kind != fekSelect:
+			sb.WriteString(c.convertInline(el.expr))
+		default:
+			if allowPlural && pluralRules == nil {
+				if pluralRules = c.convertPlural(el.expr); pluralRules != nil {
+					sb.WriteByte(0)
+					continue
+				}
+			}
+
+			//Select expressions that are not plurals use their default variant
+			c.addWarning("The select expression on %s cannot be converted, so its default variant is used", el.expr.selector.describe())
+			for _, v := range el.expr.variants {
+				if v.isDefault {
+					variantText, _ := c.convertElements(v.value, false)
+					sb.WriteString(variantText)
+				}
+			}
+		}
+	}
+	return sb.String(), pluralRules
+}
+
+// Returns the plural rules of a select expression, or nil if it is not a select on a number with plural category or number keys
+func (c *fluentConverter) convertPlural(expr *fluentExpression) []textProp {
+	//The selector must be a variable (or NUMBER() of a variable)
+	selector := expr.selector
+	if selector.kind == fekFunction && selector.name == "NUMBER" && len(selector.args) == 1 {
+		selector = selector.args[0]
+	}
+	if selector.kind != fekVariable {
+		return nil
+	}
+
+	//The keys must be plural categories or numbers
+	for _, v := range expr.variants {
+		if n, err := strconv.ParseUint(v.key, 10, 8); err == nil && strconv.FormatUint(n, 10) == v.key {
+			continue
+		}
+		switch v.key {
+		case "zero", "one", "two", "few", "many", "other":
+		default:
+			return nil
+		}
+	}
+
+	//Convert the variants, with the default variant as the ^ rule
+	var rules []textProp
+	var defaultText string
+	for _, v := range expr.variants {
+		text, _ := c.convertElements(v.value, false)
+		rule := "=" + v.key
+		if v.isDefault {
+			defaultText = text
+			continue
+		} else if _, err := strconv.ParseUint(v.key, 10, 8); err == nil {
+		} else if mobileRule, ok := mobileQuantityToRule(v.key); ok && mobileRule != "^" {
+			rule = mobileRule
+		} else {
+			c.addWarning("Plural variant “%s” cannot be converted and is skipped", v.key)
+			continue
+		}
+		if hasTextProp(rules, rule) {
+			c.addWarning("Plural variant “%s” is the same rule as a previous variant (%s), so it is skipped", v.key, rule)
+			continue
+		}
+		rules = append(rules, textProp{rule, text})
+	}
+	c.pluralVar = fluentVariableName(selector.name)
+	return append(rules, textProp{"^", defaultText})
+}
+
+// Converts an expression that is not a select expression to text
+func (c *fluentConverter) convertInline(expr *fluentExpression) string {
+	switch expr.kind {
+	case fekString:
+		return fluentEscapeText(expr.name)
+	case fekNumber:
+		return expr.name
+	case fekVariable:
+		name := fluentVariableName(expr.name)
+		c.addVar(name, "Anything")
+		return "{{." + name + "}}"
+	case fekFunction:
+		//Only NUMBER() and DATETIME() of a variable can be converted
+		if (expr.name != "NUMBER" && expr.name != "DATETIME") || len(expr.args) != 1 || expr.args[0].kind != fekVariable {
+			c.addWarning("%s() cannot be converted, so only its first argument is used", expr.name)
+			if len(expr.args) == 0 {
+				return ""
+			}
+			return c.convertInline(expr.args[0])
+		}
+		if len(expr.namedArgs) != 0 {
+			c.addWarning("The options of %s() are dropped", expr.name)
+		}
+		name := fluentVariableName(expr.args[0].name)
+		if expr.name == "NUMBER" {
+			c.addVar(name, "FloatWithSymbols")
+			return "{{." + name + "}}"
+		}
+		c.addVar(name, "DateTime")
+		return "{{." + name + "!%x}}"
+	default: //Message and term references
+		if len(expr.args) != 0 || len(expr.namedArgs) != 0 {
+			c.addWarning("The arguments of %s are dropped", expr.describe())
+		}
+		fluentID := expr.name
+		if len(expr.attribute) != 0 {
+			fluentID += "." + expr.attribute
+		}
+		target, ok := c.targets[fluentID]
+		if !ok {
+			target.tid = fluentTranslationID(expr.name, expr.attribute)
+			target.namespace, ok = c.existingTIDs[target.tid]
+		}
+		if !ok {
+			c.addWarning("%s was not found, so it is kept as text", expr.describe())
+			return fluentEscapeText("{" + fluentID + "}")
+		} else if target.namespace == c.namespace {
+			return "{{*" + target.tid + "}}"
+		} else {
+			return "{{*" + target.namespace + "." + target.tid + "}}"
+		}
+	}
+}
+
+// -------------------------------Fluent parser--------------------------------
+
+// A Fluent message or term, whose ID starts with a “-” for terms
+type fluentEntry struct {
+	id         string
+	value      []fluentElement //nil if the message only has attributes
+	attributes []fluentAttribute
+}
+type fluentAttribute struct {
+	name  string
+	value []fluentElement
+}
+
+// An element of a pattern, which is either text or a placeable’s expression
+type fluentElement struct {
+	text   string
+	expr   *fluentExpression
+	indent int //If greater than 0, this is the indentation of a continuation line, which is removed after the pattern is read
+}
+
+// The kinds of Fluent expressions
+type fluentExprKind uint8
+
+const (
+	fekString   fluentExprKind = iota //"text"
+	fekNumber                         //5
+	fekVariable                       //$name
+	fekMessage                        //message or message.attribute
+	fekTerm                           //-term or -term.attribute, with optional arguments
+	fekFunction                       //FUNCTION(arguments)
+	fekSelect                         //selector -> variants
+)
+
+type fluentExpression struct {
+	kind      fluentExprKind
+	name      string //The value of literals, or the name of the variable, message, term (starting with a “-”), or function
+	attribute string
+	args      []*fluentExpression //Positional arguments of functions and terms
+	namedArgs []textProp          //Named arguments of functions and terms
+	selector  *fluentExpression
+	variants  []fluentVariant
+}
+type fluentVariant struct {
+	key       string
+	isDefault bool
+	value     []fluentElement
+}
+
+// Returns a description of an expression for warnings
+func (expr *fluentExpression) describe() string {
+	switch expr.kind {
+	case fekVariable:
+		return "“$" + expr.name + "”"
+	case fekMessage, fekTerm:
+		if len(expr.attribute) != 0 {
+			return "“" + expr.name + "." + expr.attribute + "”"
+		}
+		return "“" + expr.name + "”"
+	case fekFunction:
+		return "“" + expr.name + "()”"
+	default:
+		return "“" + expr.name + "”"
+	}
+}
+
+// Reads a Fluent file
+type fluentParser struct {
+	src string
+	pos int
+}
+
+// Parses the messages and terms of a Fluent file. Comments are skipped. Entries that cannot be parsed are skipped and returned as warnings
+func parseFluent(src string) (entries []fluentEntry, warnings []string) {
+	p := fluentParser{src: strings.ReplaceAll(strings.TrimPrefix(src, "\uFEFF"), "\r\n", "\n")}
+	isEntryStart := func(c byte) bool { return c == '-' || c == '#' || isFluentIdentifierStart(c) }
+	for p.pos < len(p.src) {
+		//Skip blank lines and comments
+		lineStart := p.pos
+		if len(strings.TrimSpace(p.restOfLine())) == 0 || p.src[p.pos] == '#' {
+			p.skipLine()
+			continue
+		}
+
+		//Parse the entry
+		var err error
+		if isEntryStart(p.src[p.pos]) {
+			var entry fluentEntry
+			if entry, err = p.parseEntry(); err == nil {
+				entries = append(entries, entry)
+				continue
+			}
+		} else {
+			err = p.errorf("Could not be parsed")
+		}
+
+		//Skip the lines of the entry that could not be parsed
+		warnings = append(warnings, err.Error()+", so it is skipped")
+		p.pos = lineStart
+		for p.skipLine(); p.pos < len(p.src) && !isEntryStart(p.src[p.pos]); {
+			p.skipLine()
+		}
+	}
+	return
+}
+
+func isFluentIdentifierStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+func isFluentIdentifierChar(c byte) bool {
+	return isFluentIdentifierStart(c) || (c >= '0' && c <= '9') || c == '_' || c == '-'
+}
+
+func (p *fluentParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *fluentParser) restOfLine() string {
+	if end := strings.IndexByte(p.src[p.pos:], '\n'); end != -1 {
+		return p.src[p.pos : p.pos+end]
+	}
+	return p.src[p.pos:]
+}
+
+// Moves to the start of the next line
+func (p *fluentParser) skipLine() {
+	p.pos += len(p.restOfLine())
+	if p.pos < len(p.src) {
+		p.pos++
+	}
+}
+
+// Skips spaces on the current line
+func (p *fluentParser) skipInline() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// Skips spaces and newlines
+func (p *fluentParser) skipBlank() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+func (p *fluentParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Line %d: %s", strings.Count(p.src[:p.pos], "\n")+1, fmt.Sprintf(format, args...))
+}
+
+func (p *fluentParser) parseIdentifier() string {
+	start := p.pos
+	if p.pos < len(p.src) && isFluentIdentifierStart(p.src[p.pos]) {
+		for p.pos++; p.pos < len(p.src) && isFluentIdentifierChar(p.src[p.pos]); p.pos++ {
+		}
+	}
+	return p.src[start:p.pos]
+}
+
+// Parses a message or term, and its attributes
+func (p *fluentParser) parseEntry() (entry fluentEntry, err error) {
+	//Read the ID
+	prefix := ""
+	if p.peek() == '-' {
+		prefix = "-"
+		p.pos++
+	}
+	if id := p.parseIdentifier(); len(id) == 0 {
+		return entry, p.errorf("Expected an identifier")
+	} else {
+		entry.id = prefix + id
+	}
+	p.skipInline()
+	if p.peek() != '=' {
+		return entry, p.errorf("Expected “=” after “%s”", entry.id)
+	}
+	p.pos++
+
+	//Read the value
+	if entry.value, err = p.parsePattern(); err != nil {
+		return
+	} else if p.peek() == '}' {
+		return entry, p.errorf("Unexpected “}”")
+	}
+
+	//Read the attributes, which are on indented lines starting with a “.”
+	for {
+		lineStart := p.pos
+		p.skipBlank()
+		if p.peek() != '.' || p.src[p.pos-1] != ' ' {
+			p.pos = lineStart
+			break
+		}
+		p.pos++
+		var attr fluentAttribute
+		if attr.name = p.parseIdentifier(); len(attr.name) == 0 {
+			return entry, p.errorf("Expected an attribute name")
+		}
+		p.skipInline()
+		if p.peek() != '=' {
+			return entry, p.errorf("Expected “=” after “.%s”", attr.name)
+		}
+		p.pos++
+		if attr.value, err = p.parsePattern(); err != nil {
+			return
+		} else if p.peek() == '}' {
+			return entry, p.errorf("Unexpected “}”")
+		} else if attr.value == nil {
+			return entry, p.errorf("Attribute “%s.%s” has no value", entry.id, attr.name)
+		}
+		entry.attributes = append(entry.attributes, attr)
+	}
+
+	if entry.value == nil && (len(prefix) != 0 || len(entry.attributes) == 0) {
+		return entry, p.errorf("“%s” has no value", entry.id)
+	}
+	return entry, nil
+}
+
+// Parses a pattern, which runs until the end of its line and continues on the following indented lines (that do not start with “[”, “*”, “.”, or “}”). A pattern also ends at a “}”, which is not consumed. Blank lines within the pattern are kept, the common indentation of its lines is removed, and trailing spaces are trimmed. Returns nil if the pattern is blank
+func (p *fluentParser) parsePattern() ([]fluentElement, error) {
+	var elements []fluentElement
+	p.skipInline()
+	for {
+		//Read the text and placeables until the end of the line
+		start := p.pos
+		for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '{' && p.src[p.pos] != '}' {
+			p.pos++
+		}
+		if p.pos > start {
+			elements = append(elements, fluentElement{text: p.src[start:p.pos]})
+		}
+		if p.peek() == '{' {
+			p.pos++
+			expr, err := p.parsePlaceable()
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, fluentElement{expr: expr})
+			continue
+		} else if p.peek() != '\n' {
+			break
+		}
+
+		//Check if the pattern continues on an indented line, after any blank lines
+		numNewlines, next, indent := 0, p.pos, 0
+		for next < len(p.src) && p.src[next] == '\n' {
+			numNewlines++
+			indentEnd := next + 1
+			for indentEnd < len(p.src) && p.src[indentEnd] == ' ' {
+				indentEnd++
+			}
+			next, indent = indentEnd, indentEnd-next-1
+		}
+		if indent == 0 || next >= len(p.src) || strings.IndexByte("[*.}", p.src[next]) != -1 {
+			break
+		}
+		if len(elements) != 0 {
+			elements = append(elements, fluentElement{text: strings.Repeat("\n", numNewlines)})
+		}
+		elements = append(elements, fluentElement{indent: indent})
+		p.pos = next
+	}
+
+	//Remove the common indentation
+	minIndent := -1
+	for _, el := range elements {
+		if el.indent > 0 && (minIndent == -1 || el.indent < minIndent) {
+			minIndent = el.indent
+		}
+	}
+	var ret []fluentElement
+	for _, el := range elements {
+		if el.indent > 0 {
+			el = fluentElement{text: strings.Repeat(" ", el.indent-minIndent)}
+		}
+		if el.expr == nil && len(ret) != 0 && ret[len(ret)-1].expr == nil {
+			ret[len(ret)-1].text += el.text
+		} else if el.expr != nil || len(el.text) != 0 {
+			ret = append(ret, el)
+		}
+	}
+
+	//Trim the trailing spaces
+	if len(ret) != 0 && ret[len(ret)-1].expr == nil {
+		if last := &ret[len(ret)-1]; strings.TrimRight(last.text, " \n") == "" {
+			ret = ret[:len(ret)-1]
+		} else {
+			last.text = strings.TrimRight(last.text, " \n")
+		}
+	}
+	return ret, nil
+}
+
+// Parses a placeable after its “{”, through its “}”
+func (p *fluentParser) parsePlaceable() (*fluentExpression, error) {
+	p.skipBlank()
+	expr, err := p.parseInlineExpression()
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+
+	//Parse a select expression
+	if strings.HasPrefix(p.src[p.pos:], "->") {
+		p.pos += 2
+		selectExpr := &fluentExpression{kind: fekSelect, selector: expr}
+		numDefaults := 0
+		for {
+			p.skipBlank()
+			var v fluentVariant
+			if p.peek() == '*' {
+				v.isDefault = true
+				numDefaults++
+				p.pos++
+			}
+			if p.peek() != '[' {
+				if v.isDefault {
+					return nil, p.errorf("Expected “[” after “*”")
+				}
+				break
+			}
+			p.pos++
+			p.skipBlank()
+			if v.key = p.parseIdentifier(); len(v.key) == 0 {
+				if v.key = regexFluentNumber.FindString(p.src[p.pos:]); len(v.key) == 0 {
+					return nil, p.errorf("Expected a variant key")
+				}
+				p.pos += len(v.key)
+			}
+			p.skipBlank()
+			if p.peek() != ']' {
+				return nil, p.errorf("Expected “]” after variant key “%s”", v.key)
+			}
+			p.pos++
+			if v.value, err = p.parsePattern(); err != nil {
+				return nil, err
+			}
+			selectExpr.variants = append(selectExpr.variants, v)
+		}
+		if len(selectExpr.variants) == 0 {
+			return nil, p.errorf("Select expression has no variants")
+		} else if numDefaults != 1 {
+			return nil, p.errorf("Select expression must have exactly 1 default variant")
+		}
+		expr = selectExpr
+		p.skipBlank()
+	}
+
+	if p.peek() != '}' {
+		return nil, p.errorf("Expected “}”")
+	}
+	p.pos++
+	return expr, nil
+}
+
+// Matches a Fluent number literal
+var regexFluentNumber = regexp.MustCompile(`^-?[0-9]+(?:\.[0-9]+)?`)
+
+// Parses a literal, reference, function call, or nested placeable
+func (p *fluentParser) parseInlineExpression() (*fluentExpression, error) {
+	switch c := p.peek(); {
+	case c == '"':
+		return p.parseStringLiteral()
+	case c == '{':
+		p.pos++
+		return p.parsePlaceable()
+	case c == '$':
+		p.pos++
+		if name := p.parseIdentifier(); len(name) != 0 {
+			return &fluentExpression{kind: fekVariable, name: name}, nil
+		}
+		return nil, p.errorf("Expected a variable name after “$”")
+	}
+	if num := regexFluentNumber.FindString(p.src[p.pos:]); len(num) != 0 {
+		p.pos += len(num)
+		return &fluentExpression{kind: fekNumber, name: num}, nil
+	}
+
+	//Parse a message or term reference, or a function call
+	expr := &fluentExpression{kind: fekMessage}
+	if p.peek() == '-' {
+		expr.kind, expr.name = fekTerm, "-"
+		p.pos++
+	}
+	id := p.parseIdentifier()
+	if len(id) == 0 {
+		return nil, p.errorf("Expected an expression")
+	}
+	expr.name += id
+	if p.peek() == '.' {
+		p.pos++
+		if expr.attribute = p.parseIdentifier(); len(expr.attribute) == 0 {
+			return nil, p.errorf("Expected an attribute name after “%s.”", expr.name)
+		}
+	}
+	if p.peek() == '(' && expr.kind == fekMessage && len(expr.attribute) == 0 {
+		expr.kind = fekFunction
+	} else if p.peek() != '(' || expr.kind != fekTerm {
+		return expr, nil
+	}
+
+	//Parse the arguments
+	for p.pos++; ; {
+		p.skipBlank()
+		if p.peek() == ')' {
+			p.pos++
+			return expr, nil
+		}
+		arg, err := p.parseInlineExpression()
+		if err != nil {
+			return nil, err
+		}
+		p.skipBlank()
+		if p.peek() == ':' && arg.kind == fekMessage && len(arg.attribute) == 0 {
+			p.pos++
+			p.skipBlank()
+			val, err := p.parseInlineExpression()
+			if err != nil {
+				return nil, err
+			} else if val.kind != fekString && val.kind != fekNumber {
+				return nil, p.errorf("Named argument “%s” must be a literal", arg.name)
+			}
+			expr.namedArgs = append(expr.namedArgs, textProp{arg.name, val.name})
+			p.skipBlank()
+		} else {
+			expr.args = append(expr.args, arg)
+		}
+		if p.peek() == ',' {
+			p.pos++
+		} else if p.peek() != ')' {
+			return nil, p.errorf("Expected “,” or “)”")
+		}
+	}
+}
+
+// Parses a string literal, which supports the \\, \", \uXXXX, and \UXXXXXX escape sequences
+func (p *fluentParser) parseStringLiteral() (*fluentExpression, error) {
+	var sb strings.Builder
+	for p.pos++; ; {
+		if p.pos >= len(p.src) || p.src[p.pos] == '\n' {
+			return nil, p.errorf("Unterminated string literal")
+		}
+		switch c := p.src[p.pos]; {
+		case c == '"':
+			p.pos++
+			return &fluentExpression{kind: fekString, name: sb.String()}, nil
+		case c != '\\':
+			sb.WriteByte(c)
+			p.pos++
+		case p.pos+1 < len(p.src) && (p.src[p.pos+1] == '\\' || p.src[p.pos+1] == '"'):
+			sb.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+		case p.pos+1 < len(p.src) && (p.src[p.pos+1] == 'u' || p.src[p.pos+1] == 'U'):
+			numDigits := cond(p.src[p.pos+1] == 'u', 4, 6)
+			if p.pos+2+numDigits > len(p.src) {
+				return nil, p.errorf("Invalid unicode escape sequence")
+			}
+			r, err := strconv.ParseUint(p.src[p.pos+2:p.pos+2+numDigits], 16, 32)
+			if err != nil {
+				return nil, p.errorf("Invalid unicode escape sequence")
+			}
+			sb.WriteRune(rune(r))
+			p.pos += 2 + numDigits
+		default:
+			return nil, p.errorf("Unknown escape sequence")
+		}
+	}
+}
@@ -10,6 +10,8 @@ Translations can be referenced in Go code either by an index, or a namespace and
 Referencing by index is the fastest, most efficient, and what this library was built for. Indexes are stored as constants in generated Go files by namespace.
 
 Translation data and rules are stored in optimized blobs similar to how Go’s native i18n package stores its data.
+
+Once a language’s fallback is set, it is safe for concurrent use by any number of goroutines. Loading the dictionary, setting fallbacks, and the other setters that say so must be done before the languages are shared. Languages (other than the default language) can be loaded concurrently once the dictionary is loaded.
 */
 package translate
 
@@ -17,16 +19,18 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"github.com/klauspost/lctime"
 	"golang.org/x/text/language"
-	"golang.org/x/text/message"
+	"io"
+	"math"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type translationRule struct {
 	startPos uint32 //Location in Language.stringsData. endPos is calculated by using the startPos of the next rule
 	rule     pluralRule
-	//2 extra bytes are available here
 }
 type translationRuleSlice struct {
 	startIndex uint32 //Location in Language.translationRule. endIndex is calculated by using the startIndex of the next rule
@@ -36,12 +40,17 @@ type languageDict struct {
 	namespacesInOrder []string
 	hash              []byte //A dictionary hash to make sure language files are compatible
 	hasVarsLoaded     bool   //If namespaces.idsInOrder is filled in
+	schemaMutex       sync.Mutex
+	schemas           []TranslationSchema //Cached by Language.Schema(). Built when first needed
+	lazyVars          *lazyVarsFile       //The variable dictionary file to load when the variables are first needed (see Dictionary.LoadDictionaryVarsLazily())
+	maxGoWriters      uint                //The most namespaces whose go dictionary files are created at once. See CompileLimits.MaxNamespaceGoroutines
 }
 type namespace struct {
 	name       string
 	index      uint
 	ids        translationIDs             //Translation ID index lookup
 	idsInOrder []translationIDNameAndVars //The Translation IDs in order for this namespace. This is only filled/used when reading from translation text files (or the variable dictionary file)
+	metadata   NamespaceMetadata          //This is only filled when reading from translation text files (or the variable dictionary file)
 }
 
 // Used for the goWriter and confirming variables in non-default text files
@@ -59,9 +68,10 @@ type TransIndex uint32
 
 type translationIDs map[string]TransIndex
 
-// Language is the primary structure for this library that holds all the namespaces and translations
+// Language is the primary structure for this library that holds all the namespaces and translations. Its getters are safe for concurrent use once its fallback is set
 type Language struct {
-	stringsData        []byte                 //All translation strings concatenated into a single array
+	stringsData        []byte                 //All translation strings concatenated into a single array. nil if stringsAt is set
+	stringsAt          *io.SectionReader      //The strings of languages loaded through LoadAt(), which are read from the file when needed instead of being held in stringsData
 	rules              []translationRule      //All translation rules concatenated into a single array. There is always 1 extra so the last endPos can be calculated
 	translations       []translationRuleSlice //Translation rules per translation. There is always 1 extra so the last endIndex can be calculated
 	dict               *languageDict          //This is the same value in all language objects
@@ -70,82 +80,174 @@ type Language struct {
 	fallbackName       string
 	missingPluralRule  string
 	languageIdentifier string
-	languageTag        language.Tag //Pulled from the languageIdentifier
-	messagePrinter     *message.Printer
-	timeLocalizer      *lctime.Localizer
+	languageTag        language.Tag        //Pulled from the languageIdentifier
+	numberingSystem    string              //Optional override of the locale’s default numbering system (BCP 47 “nu” type)
+	calendar           calendarType        //The calendar used for DateTimes
+	omittedNamespaces  []string            //Namespaces that were intentionally left out of the language (through Settings.MissingNamespaces)
+	cldrVersion        string              //The CLDR version of the locale data the language was compiled with. Blank if it was compiled before this was recorded
+	formatters         *languageFormatters //The locale’s formatters, which are created when first needed (see formatters.go). Shared with the copies of the language
+	timeZone           *time.Location      //DateTimes are converted to this location if given (see WithTimeZone())
+	currencyDisplay    CurrencyDisplay     //See WithCurrencyDisplay()
+	cashRounding       bool                //Currency variables are rounded for cash (see WithCashRounding())
+	strictPluralCounts bool                //Plural counts above maxRulePluralCount return an error (see WithStrictPluralCounts())
+	displayWidths      bool                //String and Anything widths and precisions are counted in display cells (see WithDisplayWidths())
+	usageCounts        []uint64            //Request counts per TransIndex, updated atomically. Only set while recording usage (see RecordUsage())
+	renderCache        *RenderCache        //Only set while caching renders (see CacheRenders())
+	registers          []string            //The registers of the translations (Settings.Registers). The first is the default register
+	register           string              //The register translations are requested in (see WithRegister())
+	overlay            *translationOverlay //Overridden translations that are used before the language’s own. Only set for OverlayLanguages (see NewOverlayLanguage())
+	namespaceAccess    []uint64            //A bitset of the TransIndexes whose namespaces translations can be requested from. Nil allows all namespaces (see WithNamespaceAccess())
+	encrypted          bool                //If it was loaded from a compiled file whose translation strings are encrypted (see IsEncrypted())
 }
 
+// CLDRVersion is the version of the CLDR (Unicode Common Locale Data Repository) locale data that this build formats numbers, dates, and plurals with. It is recorded in compiled language files
+const CLDRVersion = language.CLDRVersion
+
 const (
 	errNoPluralRuleMatches = "no plural rule matches"
 	maxEmbeddedCount       = 100
+	maxRulePluralCount     = math.MaxUint32 //The largest plural count a plurality rule can compare against
+	pluralCountWrapped     = math.MinInt64  //The pluralCount given to getReal() for Plural function counts above math.MaxInt64 (see pluralCountToInt64())
 )
 
 //-----------------------------Main Get() functions-----------------------------
 
 // All Get...() functions call this
 func (l *Language) getReal(index TransIndex, pluralCount int64, embeddedCount uint, args []interface{}) (string, error) {
+	b, err := l.appendReal(nil, index, pluralCount, embeddedCount, args)
+
+	//Return the final value. If cap-len>maxCapDiff then copy the string so cap=size
+	const maxCapDiff = 1024
+	if cap(b)-len(b) > maxCapDiff {
+		return string(b), err
+	}
+	return b2s(b), err
+}
+
+// All Get...() and AppendGet...() functions call this. The translation is appended to dst, which is returned unchanged on error (other than the missing plural rule)
+func (l *Language) appendReal(dst []byte, index TransIndex, pluralCount int64, embeddedCount uint, args []interface{}) ([]byte, error) {
 	//Confirm index is valid
 	if uint32(index) >= l.NumTranslations() {
-		return retErrWithStr(fmt.Errorf("Invalid index location: %d", index))
+		return dst, fmt.Errorf("Invalid index location: %d", index)
 	}
 
 	//If embeddedCount has exceeded maxEmbeddedCount return an error
 	if embeddedCount > maxEmbeddedCount {
-		return retErrWithStr(fmt.Errorf("Cannot have more than %d embedded translation levels", maxEmbeddedCount))
+		return dst, fmt.Errorf("Cannot have more than %d embedded translation levels", maxEmbeddedCount)
 	}
 
-	//Find the [fallback] language that has the translation
-	var curLang, prevLang *Language
-	var sliceIndex, sliceLength uint32
-	for curLang = l; curLang != prevLang && curLang != nil; curLang = curLang.fallback {
-		sliceIndex = curLang.translations[index].startIndex
-		sliceLength = curLang.translations[index+1].startIndex - sliceIndex
-		if sliceLength != 0 {
-			break
-		}
-		prevLang = curLang
+	//Confirm the namespace can be requested
+	if l.namespaceAccess != nil && l.namespaceAccess[index>>6]&(1<<(index&63)) == 0 {
+		namespaceName, _, _ := l.dict.translationIDLookupNS(index)
+		return dst, fmt.Errorf("Namespace “%s” cannot be accessed", namespaceName)
 	}
-	if curLang == nil {
-		return retErrWithStr(errors.New("Fallback language was not set"))
+
+	//Confirm the plural count is valid
+	if pluralCount == pluralCountWrapped {
+		return dst, errors.New("The plural count is above 9223372036854775807, which only happens when a negative number is converted to uint")
+	} else if l.strictPluralCounts && pluralCount > maxRulePluralCount {
+		return dst, fmt.Errorf("The plural count (%d) is above the largest number plurality rules can compare against (%d)", pluralCount, uint32(maxRulePluralCount))
 	}
-	if curLang == prevLang {
-		return retErrWithStr(errors.New("No rules found for translation"))
+
+	//Count the request. Embedded translations are not separate requests
+	if embeddedCount == 0 && l.usageCounts != nil {
+		atomic.AddUint64(&l.usageCounts[index], 1)
 	}
 
-	//If a non-plural function then the 0th rule will match if there is no cmpAll rule
-	matchingRuleIndex := int64(-1)
-	isPluralFunc := pluralCount >= 0
-	if !isPluralFunc {
-		matchingRuleIndex = int64(sliceIndex)
+	//Requests can be answered from the render cache. Embedded translations are part of their request
+	if embeddedCount == 0 && l.renderCache != nil {
+		return l.renderCache.appendRender(dst, l, index, pluralCount, args)
 	}
+	return l.appendRender(dst, index, pluralCount, embeddedCount, args)
+}
 
-	//Search for a matching rule
-	if !isPluralFunc {
-		for i, r := range curLang.rules[sliceIndex : sliceIndex+sliceLength] {
-			if r.rule.getOp() == cmpAll {
-				matchingRuleIndex = int64(sliceIndex) + int64(i)
-				break
-			}
-		}
+// Finds the matching rule of the translation and appends the processed translation to dst. The index must already be confirmed
+func (l *Language) appendRender(dst []byte, index TransIndex, pluralCount int64, embeddedCount uint, args []interface{}) ([]byte, error) {
+	//Find the overridden translation, or the [fallback] language that has the translation
+	curLang := l
+	var rules []translationRule //Includes the extra rule so the last endPos can be calculated
+	var stringsData []byte
+	var stringsLang *Language //The language the strings are read from. Overridden translations use stringsData instead
+	if override, ok := l.getOverride(index); ok {
+		rules, stringsData = override.rules, override.stringsData
 	} else {
-		for i, r := range curLang.rules[sliceIndex : sliceIndex+sliceLength] {
-			if r.rule.cmp(uint8(pluralCount)) {
-				matchingRuleIndex = int64(sliceIndex) + int64(i)
+		var prevLang *Language
+		var sliceIndex, sliceLength uint32
+		for curLang = l; curLang != prevLang && curLang != nil; curLang = curLang.fallback {
+			sliceIndex = curLang.translations[index].startIndex
+			sliceLength = curLang.translations[index+1].startIndex - sliceIndex
+			if sliceLength != 0 {
 				break
 			}
+			prevLang = curLang
+		}
+		if curLang == nil {
+			return dst, errors.New("Fallback language was not set")
 		}
+		if curLang == prevLang {
+			return dst, errors.New("No rules found for translation")
+		}
+		rules, stringsLang = curLang.rules[sliceIndex:sliceIndex+sliceLength+1], curLang
+	}
+
+	//Search for a matching rule in the requested register, and then the default register
+	defaultRules, registerRules, registerStart := curLang.splitRegisterRules(rules[:len(rules)-1], l.register)
+	matchingRuleIndex := -1
+	if registerRules != nil {
+		if i := curLang.matchRule(registerRules, pluralCount); i != -1 {
+			matchingRuleIndex = registerStart + i
+		}
+	}
+	if matchingRuleIndex == -1 {
+		matchingRuleIndex = curLang.matchRule(defaultRules, pluralCount)
 	}
 
 	//If there is not a matching rule then return error
 	if matchingRuleIndex == -1 {
-		return curLang.missingPluralRule, errors.New(errNoPluralRuleMatches)
+		return append(dst, curLang.missingPluralRule...), errors.New(errNoPluralRuleMatches)
+	}
+
+	//Get the translation’s string
+	var translation []byte
+	startPos, endPos := rules[matchingRuleIndex].startPos, rules[matchingRuleIndex+1].startPos
+	if stringsLang == nil {
+		translation = stringsData[startPos:endPos]
+	} else if _translation, err := stringsLang.readStrings(startPos, endPos); err != nil {
+		return dst, err
+	} else {
+		translation = _translation
 	}
 
 	//Process the translation
-	return l.processTranslation(
-		curLang.stringsData[curLang.rules[matchingRuleIndex].startPos:curLang.rules[matchingRuleIndex+1].startPos],
-		pluralCount, index, embeddedCount, args,
-	)
+	return l.appendTranslation(dst, translation, pluralCount, index, embeddedCount, args)
+}
+
+// Returns the index of the rule that matches the plural count, or -1 if none match
+func (l *Language) matchRule(rules []translationRule, pluralCount int64) int {
+	//If a non-plural function then the 0th rule will match if there is no cmpAll rule
+	if pluralCount < 0 {
+		for i, r := range rules {
+			if r.rule.getOp() == cmpAll {
+				return i
+			}
+		}
+		return cond(len(rules) != 0, 0, -1)
+	}
+
+	//A registered plural rule set chooses the rule. A negative index uses the normal rule matching
+	chosenRuleIndex := -1
+	if ruleSet := l.pluralRuleSet(); ruleSet != nil {
+		chosenRuleIndex = ruleSet(pluralCount)
+	}
+	if chosenRuleIndex >= 0 {
+		return cond(chosenRuleIndex < len(rules), chosenRuleIndex, -1) //An index past the last rule does not match
+	}
+	for i, r := range rules {
+		if r.rule.cmp(uint64(pluralCount)) {
+			return i
+		}
+	}
+	return -1
 }
 
 // All Get...Named...() functions call this
@@ -159,6 +261,13 @@ func (l *Language) getRealNamed(namespace, translationID string, pluralCount int
 	}
 }
 
+// Converts the pluralCount of the Plural functions for getReal(), where negative counts are for non-plural functions. Counts above math.MaxInt64 are negative numbers that were converted to uint (which wrap around), and become pluralCountWrapped, which getReal() returns an error for.
+//
+// Counts above maxRulePluralCount only match the “^”, “>”, “>=”, and “>max” overflow bucket rules, or return an error with WithStrictPluralCounts()
+func pluralCountToInt64(pluralCount uint) int64 {
+	return cond(uint64(pluralCount) > math.MaxInt64, int64(pluralCountWrapped), int64(pluralCount))
+}
+
 //------------------Wrappers for getReal() [and getRealNamed()]-----------------
 
 // Get retrieves a non-plural translation with a TransIndex.
@@ -172,7 +281,7 @@ func (l *Language) Get(index TransIndex, args ...interface{}) (string, error) {
 //
 // CurLang.MissingPluralRule is returned if a plurality rule match is not found.
 func (l *Language) GetPlural(index TransIndex, pluralCount uint, args ...interface{}) (string, error) {
-	return l.getReal(index, int64(pluralCount), 0, args)
+	return l.getReal(index, pluralCountToInt64(pluralCount), 0, args)
 }
 
 // MustGet retrieves a non-plural translation with a TransIndex. It returns a blank string when errored.
@@ -186,7 +295,7 @@ func (l *Language) MustGet(index TransIndex, args ...interface{}) string {
 //
 // CurLang.MissingPluralRule is returned if a plurality rule match is not found.
 func (l *Language) MustGetPlural(index TransIndex, pluralCount uint, args ...interface{}) string {
-	return twoToOne(l.getReal(index, int64(pluralCount), 0, args))
+	return twoToOne(l.getReal(index, pluralCountToInt64(pluralCount), 0, args))
 }
 
 // GetNamed retrieves a non-plural translation with a namespace and Translation ID.
@@ -200,7 +309,7 @@ func (l *Language) GetNamed(namespace, translationID string, args ...interface{}
 //
 // CurLang.MissingPluralRule is returned if a plurality rule match is not found.
 func (l *Language) GetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) (string, error) {
-	return l.getRealNamed(namespace, translationID, int64(pluralCount), args)
+	return l.getRealNamed(namespace, translationID, pluralCountToInt64(pluralCount), args)
 }
 
 // MustGetNamed retrieves a non-plural translation with a namespace and Translation ID. It returns a blank string when errored.
@@ -214,7 +323,64 @@ func (l *Language) MustGetNamed(namespace string, translationID string, args ...
 //
 // CurLang.MissingPluralRule is returned if a plurality rule match is not found.
 func (l *Language) MustGetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) string {
-	return twoToOne(l.getRealNamed(namespace, translationID, int64(pluralCount), args))
+	return twoToOne(l.getRealNamed(namespace, translationID, pluralCountToInt64(pluralCount), args))
+}
+
+//-----------------Wrappers for appendReal() (for reusing buffers)-----------------
+
+// AppendGet appends a non-plural translation with a TransIndex to dst and returns the extended buffer. This is Get() without allocating a string, so buffers can be reused in hot paths. dst is returned unchanged when errored.
+//
+// It uses either a “^” plurality rule if found, and the first plurality rule otherwise.
+func (l *Language) AppendGet(dst []byte, index TransIndex, args ...interface{}) ([]byte, error) {
+	return l.appendReal(dst, index, -1, 0, args)
+}
+
+// AppendGetPlural appends a plural translation with a TransIndex to dst and returns the extended buffer. This is GetPlural() without allocating a string, so buffers can be reused in hot paths. dst is otherwise returned unchanged when errored.
+//
+// CurLang.MissingPluralRule is appended if a plurality rule match is not found.
+func (l *Language) AppendGetPlural(dst []byte, index TransIndex, pluralCount uint, args ...interface{}) ([]byte, error) {
+	return l.appendReal(dst, index, pluralCountToInt64(pluralCount), 0, args)
+}
+
+// FGet writes a non-plural translation with a TransIndex to w, returning the number of bytes written. This is Get() without allocating a string, as translations are processed in pooled buffers. Nothing is written when errored.
+//
+// It uses either a “^” plurality rule if found, and the first plurality rule otherwise.
+func (l *Language) FGet(w io.Writer, index TransIndex, args ...interface{}) (int, error) {
+	return l.fGetReal(w, index, -1, args)
+}
+
+// FGetPlural writes a plural translation with a TransIndex to w, returning the number of bytes written. This is GetPlural() without allocating a string, as translations are processed in pooled buffers. Nothing else is written when errored.
+//
+// CurLang.MissingPluralRule is written if a plurality rule match is not found.
+func (l *Language) FGetPlural(w io.Writer, index TransIndex, pluralCount uint, args ...interface{}) (int, error) {
+	return l.fGetReal(w, index, pluralCountToInt64(pluralCount), args)
+}
+
+// Buffers for FGet...() functions. Buffers that grow past fGetMaxPooledCap are not returned to the pool
+var fGetBuffers = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+const fGetMaxPooledCap = 64 * 1024
+
+// All FGet...() functions call this
+func (l *Language) fGetReal(w io.Writer, index TransIndex, pluralCount int64, args []interface{}) (n int, err error) {
+	//Process the translation into a pooled buffer
+	buf := fGetBuffers.Get().(*[]byte)
+	*buf, err = l.appendReal((*buf)[:0], index, pluralCount, 0, args)
+	defer func() {
+		if cap(*buf) <= fGetMaxPooledCap {
+			fGetBuffers.Put(buf)
+		}
+	}()
+
+	//Write out the translation. The processing error takes precedence over a write error
+	if len(*buf) == 0 {
+		return 0, err
+	}
+	n, writeErr := w.Write(*buf)
+	if err != nil {
+		return n, err
+	}
+	return n, writeErr
 }
 
 //------------------------------------Getters-----------------------------------
@@ -224,6 +390,17 @@ func (l *Language) NumTranslations() uint32 {
 	return ulen32(l.translations) - 1
 }
 
+// NumTranslated returns the number of translations the language has its own rules for, instead of getting them from its fallback languages. The default language has all of its translations
+func (l *Language) NumTranslated() uint32 {
+	var n uint32
+	for i := 1; i < len(l.translations); i++ {
+		if l.translations[i].startIndex != l.translations[i-1].startIndex {
+			n++
+		}
+	}
+	return n
+}
+
 // Name returns the name of the language
 func (l *Language) Name() string {
 	return l.name
@@ -244,28 +421,61 @@ func (l *Language) FallbackName() string {
 	return l.fallbackName
 }
 
-// MessagePrinter returns the MessagePrinter
-func (l *Language) MessagePrinter() *message.Printer {
-	//Make sure the message printer already exists
-	if l.messagePrinter == nil {
-		l.messagePrinter = message.NewPrinter(l.languageTag)
-	}
+// NumberingSystem returns the numbering system override. If blank, the locale’s default is used
+func (l *Language) NumberingSystem() string {
+	return l.numberingSystem
+}
 
-	return l.messagePrinter
+// Calendar returns the name of the calendar used for DateTimes
+func (l *Language) Calendar() string {
+	return calendarNames[l.calendar]
 }
 
-// TimeLocalizer returns the TimeLocalizer
-func (l *Language) TimeLocalizer() (*lctime.Localizer, error) {
-	//Make sure the time localizer already exists
-	if l.timeLocalizer == nil {
-		if loc, err := lctime.NewLocalizer(strings.Replace(l.languageTag.String(), "-", "_", -1)); err != nil {
-			return nil, err
-		} else {
-			l.timeLocalizer = &loc
-		}
+// CLDRVersion returns the CLDR version of the locale data the language was compiled with. This is blank for compiled files from before the version was recorded
+func (l *Language) CLDRVersion() string {
+	return l.cldrVersion
+}
+
+// CLDRVersionWarning returns a warning if the language was compiled with a different major CLDR version than this build uses (see CLDRVersion), as numbers, dates, and plurals may then be formatted differently than when it was compiled. Returns blank if the versions match or the compiled version is unknown
+func (l *Language) CLDRVersionWarning() string {
+	compiledMajor, _, _ := strings.Cut(l.cldrVersion, ".")
+	runtimeMajor, _, _ := strings.Cut(CLDRVersion, ".")
+	if len(compiledMajor) == 0 || compiledMajor == runtimeMajor {
+		return ""
 	}
+	return fmt.Sprintf("Language “%s” was compiled with CLDR version %s, but this build uses CLDR version %s. Numbers, dates, and plurals may be formatted differently", l.languageIdentifier, l.cldrVersion, CLDRVersion)
+}
 
-	return l.timeLocalizer, nil
+// Returns the language tag used for formatting numbers, which includes the numbering system override
+func (l *Language) numberTag() language.Tag {
+	if len(l.numberingSystem) == 0 {
+		return l.languageTag
+	}
+
+	//The numbering system was already confirmed valid when the language was loaded
+	tag, _ := l.languageTag.SetTypeForKey("nu", l.numberingSystem)
+	return tag
+}
+
+// The numeric numbering systems from CLDR that can be given as a NumberingSystem
+var numberingSystems = map[string]struct{}{
+	"adlm": {}, "ahom": {}, "arab": {}, "arabext": {}, "bali": {}, "beng": {}, "bhks": {}, "brah": {}, "cakm": {}, "cham": {},
+	"deva": {}, "diak": {}, "fullwide": {}, "gong": {}, "gonm": {}, "gujr": {}, "guru": {}, "hanidec": {}, "hmng": {}, "hmnp": {},
+	"java": {}, "kali": {}, "khmr": {}, "knda": {}, "lana": {}, "lanatham": {}, "laoo": {}, "latn": {}, "lepc": {}, "limb": {},
+	"mathbold": {}, "mathdbl": {}, "mathmono": {}, "mathsanb": {}, "mathsans": {}, "mlym": {}, "modi": {}, "mong": {}, "mroo": {}, "mtei": {},
+	"mymr": {}, "mymrshan": {}, "mymrtlng": {}, "newa": {}, "nkoo": {}, "olck": {}, "orya": {}, "osma": {}, "rohg": {}, "saur": {},
+	"segment": {}, "shrd": {}, "sind": {}, "sinh": {}, "sora": {}, "sund": {}, "takr": {}, "talu": {}, "tamldec": {}, "telu": {},
+	"thai": {}, "tibt": {}, "tirh": {}, "vaii": {}, "wara": {}, "wcho": {},
+}
+
+// Confirms a numbering system is valid. A blank numbering system means to use the locale’s default
+func checkNumberingSystem(numberingSystem string) error {
+	if len(numberingSystem) == 0 {
+		return nil
+	} else if _, ok := numberingSystems[numberingSystem]; !ok {
+		return errors.New("Invalid numbering system: " + numberingSystem)
+	}
+	return nil
 }
 
 // Gives the language name in the debugger
@@ -293,7 +503,14 @@ func (l *Language) TranslationIDLookup(index TransIndex) (val string, ok bool) {
 // A language cannot have itself set as its fallback. That only occurs naturally for the default language.
 //
 // The fallback language being set must already have its fallback language set. This is required so fallback language loops cannot occur.
+//
+// This must be called before the language is used by other goroutines.
 func (l *Language) SetFallback(fallbackLanguage *Language) error {
+	return l.setFallbackReal(fallbackLanguage, l.fallbackName)
+}
+
+// SetFallback with the language’s fallback name given after resolving aliases
+func (l *Language) setFallbackReal(fallbackLanguage *Language, fallbackName string) error {
 	//Check for errors
 	if l.fallback != nil {
 		return errors.New("Fallback language already set")
@@ -305,8 +522,8 @@ func (l *Language) SetFallback(fallbackLanguage *Language) error {
 		return fmt.Errorf("Fallback language “%s” must already have its fallback language set", fallbackLanguage.languageIdentifier)
 	} else if l.dict != fallbackLanguage.dict && !bytes.Equal(l.dict.hash, fallbackLanguage.dict.hash) {
 		return errors.New("Dictionaries of the two languages do not match")
-	} else if l.fallbackName != fallbackLanguage.languageIdentifier {
-		if l.fallbackName != "" {
+	} else if fallbackName != fallbackLanguage.languageIdentifier {
+		if fallbackName != "" {
 			return fmt.Errorf("Fallback language identifier “%s” and parent language “%s” fallback language “%s” must match", fallbackLanguage.languageIdentifier, l.languageIdentifier, l.fallbackName)
 		} else if fallbackLanguage.fallback != fallbackLanguage {
 			return fmt.Errorf("Fallback language is not the default language")
@@ -0,0 +1,55 @@
+//Language identifier aliases
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/language"
+	"sort"
+	"strings"
+)
+
+// LanguageAliases maps deprecated or alternate language identifiers to the language identifiers that have files. Example: {"no": "nb", "iw": "he", "zh": "zh-Hans"}
+type LanguageAliases map[string]string
+
+// Resolve returns the language identifier that the given identifier is an alias of, or the given identifier if it is not an alias
+func (la LanguageAliases) Resolve(languageIdentifier string) string {
+	if target, ok := la[languageIdentifier]; ok {
+		return target
+	}
+	return languageIdentifier
+}
+
+// Check confirms all aliases and targets are valid language identifiers, and that no alias points to itself or to another alias
+func (la LanguageAliases) Check() error {
+	aliasNames := make([]string, 0, len(la))
+	for alias := range la {
+		aliasNames = append(aliasNames, alias)
+	}
+	sort.Strings(aliasNames)
+
+	var errs []string
+	for _, alias := range aliasNames {
+		target := la[alias]
+		if _, err := language.Parse(alias); err != nil {
+			errs = append(errs, fmt.Sprintf("Language alias “%s” is not a valid language identifier", alias))
+		} else if _, err := language.Parse(target); err != nil {
+			errs = append(errs, fmt.Sprintf("Language alias “%s” target “%s” is not a valid language identifier", alias, target))
+		} else if alias == target {
+			errs = append(errs, fmt.Sprintf("Language alias “%s” cannot point to itself", alias))
+		} else if _, ok := la[target]; ok {
+			errs = append(errs, fmt.Sprintf("Language alias “%s” target “%s” cannot also be an alias", alias, target))
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// SetFallbackWithAliases is the same as SetFallback, except the parent language’s “Settings.FallbackLanguage” may be an alias of the fallbackLanguage
+func (l *Language) SetFallbackWithAliases(fallbackLanguage *Language, aliases LanguageAliases) error {
+	return l.setFallbackReal(fallbackLanguage, aliases.Resolve(l.fallbackName))
+}
@@ -3,9 +3,12 @@
 package translate
 
 import (
-	"compress/gzip"
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
+	"sync"
 )
 
 // LanguageFile is the base type to load language files
@@ -22,45 +25,16 @@ const (
 	lf_DO_NOT_USE
 )
 
-// Holds a copy of the dictionary, which is static for all languages
-var remDict *languageDict
-
 // Load loads a .gtr language file. The default language text file or the dictionary must be loaded first.
 //
 // Note: Fallback languages still need to be assigned through Language.SetFallback()
 func (lf LanguageBinaryFile) Load(r io.Reader, isCompressed bool) (*Language, error) {
-	//Check if the dictionary is already loaded
-	localDict := remDict
-	hasDict := localDict != nil
-	if !hasDict {
-		return nil, errors.New("The dictionary has not been loaded yet. You must first call LanguageTextFile.LoadDefault() or LanguageBinaryFile.LoadDictionary()")
-	}
-
-	//Handle compressed files
-	if isCompressed {
-		if _r, err := gzip.NewReader(r); err != nil {
-			return nil, err
-		} else {
-			r = _r
-		}
-	}
-
-	//Read the file
-	var l Language
-	if err := l.fromCompiledFile(r, localDict); err != nil {
-		return nil, err
-	}
-	return &l, nil
+	return defaultDictionary.Load(r, isCompressed)
 }
 
 // LoadDefault loads a .gtr language file. This must be the default language. The dictionary must be loaded first.
 func (lf LanguageBinaryFile) LoadDefault(r io.Reader, isCompressed bool) (*Language, error) {
-	if l, err := lf.Load(r, isCompressed); err != nil {
-		return nil, err
-	} else {
-		l.fallback = l
-		return l, nil
-	}
+	return defaultDictionary.LoadDefault(r, isCompressed)
 }
 
 // LoadDictionary loads a compiled dictionary file, which must be done before loading any compiled translation file or non-default-language translation text file.
@@ -69,76 +43,126 @@ func (lf LanguageBinaryFile) LoadDefault(r io.Reader, isCompressed bool) (*Langu
 //
 // Returns ok=true if the dictionary was read successfully during this or a previous call to this function.
 func (lf LanguageBinaryFile) LoadDictionary(r io.Reader, isCompressed bool) (err error, ok bool) {
-	//Check if the dictionary is already loaded
-	hasDict := remDict != nil
-
 	//Confirm LanguageFile type
 	if lf != LF_GTR {
-		return errors.New("Only compiled dictionaries can be loaded through this function"), hasDict
+		return errors.New("Only compiled dictionaries can be loaded through this function"), defaultDictionary.IsLoaded()
 	}
 
-	//Throw an error if the dictionary is already loaded
-	if hasDict {
-		return errors.New("Dictionary already loaded"), hasDict
+	if err := defaultDictionary.LoadDictionary(r, isCompressed); err != nil {
+		return err, defaultDictionary.IsLoaded()
 	}
+	return nil, true
+}
 
-	//Handle compressed files
-	if isCompressed {
-		if _r, err := gzip.NewReader(r); err != nil {
-			return err, false
-		} else {
-			r = _r
-		}
+// LoadCombinedDictionary loads a compiled combined dictionary file, which holds both the compiled dictionary and the compiled variable dictionary. This can be used in place of LoadDictionary() followed by LoadDictionaryVars().
+//
+// Returns an error if the dictionary was not loaded during this call.
+//
+// Returns ok=true if the dictionary was read successfully during this or a previous call to this function.
+func (lf LanguageBinaryFile) LoadCombinedDictionary(r io.Reader, isCompressed bool) (err error, ok bool) {
+	//Confirm LanguageFile type
+	if lf != LF_GTR {
+		return errors.New("Only compiled dictionaries can be loaded through this function"), defaultDictionary.IsLoaded()
 	}
 
-	//Load and save the dictionary
-	var newDict languageDict
-	if err := newDict.fromCompiledFile(r); err != nil {
-		return err, false
+	if err := defaultDictionary.LoadCombinedDictionary(r, isCompressed); err != nil {
+		return err, defaultDictionary.IsLoaded()
 	}
-
-	//Lock the stored dictionary and write it
-	//Not worrying about race conditions as dictionaries are not changed after being created and stored
-	remDict = &newDict
-
-	//Return success
 	return nil, true
 }
 
 // LoadDictionaryVars loads a compiled variable dictionary file. This is only used when processing non-default language text files and the compiled dictionary is being loaded.
 func (lf LanguageBinaryFile) LoadDictionaryVars(r io.Reader, isCompressed bool) (err error) {
-	//Make sure dictionary is already loaded
-	if remDict == nil {
-		return errors.New("The dictionary has not been loaded yet. You must first call LanguageTextFile.LoadDefault() or LanguageBinaryFile.LoadDictionary()")
-	}
-
-	//Handle compressed files
-	if isCompressed {
-		if _r, err := gzip.NewReader(r); err != nil {
-			return err
-		} else {
-			r = _r
-		}
-	}
-
-	//Process and return if error
-	if err := remDict.fromCompiledVarFile(r); err != nil {
-		return err
-	}
+	return defaultDictionary.LoadDictionaryVars(r, isCompressed)
+}
 
-	//Return success
-	initTextProcessing()
-	return nil
+// LoadDictionaryVarsLazily registers a compiled variable dictionary file to be loaded the first time the variables are needed (see Dictionary.LoadDictionaryVarsLazily())
+func (lf LanguageBinaryFile) LoadDictionaryVarsLazily(open func() (io.ReadCloser, error), isCompressed bool) (err error) {
+	return defaultDictionary.LoadDictionaryVarsLazily(open, isCompressed)
 }
 
 // ClearCurrentDictionary erases the stored dictionary used for LanguageTextFile.Load() and LanguageBinaryFile.Load(). Languages that have mismatched dictionaries are incompatible. Returns if dictionary was already loaded
 func (ll LanguageFile) ClearCurrentDictionary() bool {
-	hasDict := remDict != nil
-	remDict = nil
-	return hasDict
+	return defaultDictionary.Clear()
 }
 
 // HasCurrentDictionary returns if there is a stored dictionary already loaded (for LanguageTextFile.Load() and LanguageBinaryFile.Load())
 func (ll LanguageFile) HasCurrentDictionary() bool {
-	return remDict != nil
+	return defaultDictionary.IsLoaded()
+}
+
+// ParseGTR parses an uncompressed .gtr language file from memory without needing its dictionary. This does not read or write the stored dictionary (or any other global state), so it is safe to use concurrently, like from fuzzers. Files whose translation strings are encrypted cannot be parsed.
+//
+// As the dictionary is not loaded, a placeholder dictionary is used which has a single namespace named “_” whose Translation IDs are named by their TransIndex. The returned language is its own fallback.
+func ParseGTR(b []byte) (*Language, error) {
+	//Read the header to create the placeholder dictionary
+	var header storeHeader
+	if len(b) < int(header.binarySize()) {
+		return nil, errors.New("File ended early")
+	}
+	header.fromLittleEndian(b)
+	if err := header.checkSoftCaps(); err != nil {
+		return nil, err
+	} else if header.getCompiledFileSize() != uint64(len(b)) {
+		return nil, fmt.Errorf("File size (%d) does not match the size given in its header (%d)", len(b), header.getCompiledFileSize())
+	}
+	ns := &namespace{"_", 0, make(translationIDs, header.numTranslations), nil, NamespaceMetadata{}}
+	for i := uint32(0); i < header.numTranslations; i++ {
+		ns.ids[strconv.FormatUint(uint64(i), 10)] = TransIndex(i)
+	}
+	dict := &languageDict{map[string]*namespace{ns.name: ns}, []string{ns.name}, header.hash[:], false, sync.Mutex{}, nil, nil, 0}
+
+	//Read the language
+	var l Language
+	if err := l.fromCompiledFile(bytes.NewReader(b), dict, nil, nil); err != nil {
+		return nil, err
+	}
+	l.fallback = &l
+	return &l, nil
+}
+
+// ParseGTRDictionary parses an uncompressed .gtr dictionary file from memory and returns its namespaces and their Translation IDs (in TransIndex order). This does not read or write the stored dictionary (or any other global state), so it is safe to use concurrently, like from fuzzers.
+func ParseGTRDictionary(b []byte) (namespaces map[string][]string, err error) {
+	//Make sure the data is the size given in the header before any allocations are made
+	var header storeDictHeader
+	if len(b) < int(header.binarySize()) {
+		return nil, errors.New("File ended early")
+	}
+	header.fromLittleEndian(b)
+	if header.getCompiledFileSize() != uint64(len(b)) {
+		return nil, fmt.Errorf("File size (%d) does not match the size given in its header (%d)", len(b), header.getCompiledFileSize())
+	}
+
+	//Read the dictionary
+	var dict languageDict
+	if err := dict.fromCompiledFile(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	//Duplicate namespace names overwrite each other
+	if len(dict.namespaces) != len(dict.namespacesInOrder) {
+		return nil, errors.New("Namespace names are not unique")
+	}
+
+	//Order the Translation IDs by their TransIndex. Each namespace’s indexes must be contiguous and unique, and follow the previous namespace’s, which duplicate Translation IDs (that overwrite each other) break
+	namespaces = make(map[string][]string, len(dict.namespaces))
+	startIndex := uint(0)
+	for _, name := range dict.namespacesInOrder {
+		n := dict.namespaces[name]
+		ids := make([]string, len(n.ids))
+		filled := make([]bool, len(n.ids))
+		for id, index := range n.ids {
+			pos := uint(index) - startIndex
+			if uint(index) < startIndex || pos >= uint(len(ids)) || filled[pos] {
+				return nil, fmt.Errorf("Namespace “%s” does not have contiguous and unique translation indexes", name)
+			}
+			ids[pos], filled[pos] = id, true
+		}
+		namespaces[name] = ids
+		startIndex += uint(len(ids))
+	}
+	if startIndex != uint(header.numTranslations) {
+		return nil, fmt.Errorf("The namespaces have %d unique Translation IDs but the header gives %d", startIndex, header.numTranslations)
+	}
+	return namespaces, nil
 }
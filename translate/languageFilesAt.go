@@ -0,0 +1,77 @@
+//Load compiled language files whose strings are left in the file (through an io.ReaderAt) instead of being copied into memory
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// LoadAt loads an uncompressed .gtr language file like Load(), except the translation strings are left in the file and only read when a translation is requested (which is useful for very large languages). The rules and settings are still read into memory.
+//
+// r can be an *os.File or a memory-mapped file. It must stay open (and unchanged) while the language is used, and must allow concurrent ReadAt() calls (as io.ReaderAt requires). Each request reads the string of its matching rule, so requests are slower than languages loaded through Load(). Read errors are returned by the Get...() functions. The strings are read through once while loading to confirm the file’s checksum. The strings of encrypted files are read into memory (see SetDecryptionKey())
+func (d *Dictionary) LoadAt(r io.ReaderAt) (*Language, error) {
+	//Check if the dictionary is already loaded
+	localDict := d.dict
+	if localDict == nil {
+		return nil, errors.New(errDictionaryNotLoaded)
+	}
+
+	//Read the file
+	var l Language
+	if err := l.fromCompiledFile(io.NewSectionReader(r, 0, math.MaxInt64), localDict, r, d.decryption); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// LoadDefaultAt loads an uncompressed .gtr language file through LoadAt(). This must be the default language. The dictionary must be loaded first.
+func (d *Dictionary) LoadDefaultAt(r io.ReaderAt) (*Language, error) {
+	if l, err := d.LoadAt(r); err != nil {
+		return nil, err
+	} else {
+		l.fallback = l
+		return l, nil
+	}
+}
+
+// LoadAt loads an uncompressed .gtr language file whose translation strings are left in the file. See Dictionary.LoadAt()
+func (lf LanguageBinaryFile) LoadAt(r io.ReaderAt) (*Language, error) {
+	return defaultDictionary.LoadAt(r)
+}
+
+// LoadDefaultAt loads an uncompressed .gtr language file whose translation strings are left in the file. This must be the default language. See Dictionary.LoadAt()
+func (lf LanguageBinaryFile) LoadDefaultAt(r io.ReaderAt) (*Language, error) {
+	return defaultDictionary.LoadDefaultAt(r)
+}
+
+// Returns the size of the language’s translation strings
+func (l *Language) stringsSize() uint32 {
+	if l.stringsAt != nil {
+		return uint32(l.stringsAt.Size())
+	}
+	return ulen32(l.stringsData)
+}
+
+// Returns the translation strings between the positions, which are read from the file for languages loaded through LoadAt()
+func (l *Language) readStrings(startPos, endPos uint32) ([]byte, error) {
+	if l.stringsAt == nil {
+		return l.stringsData[startPos:endPos], nil
+	}
+
+	str := make([]byte, endPos-startPos)
+	if len(str) == 0 {
+		return str, nil
+	}
+	if n, err := l.stringsAt.ReadAt(str, int64(startPos)); err != nil && !(err == io.EOF && n == len(str)) {
+		return nil, fmt.Errorf("Could not read translation strings: %s", err.Error())
+	}
+	return str, nil
+}
+
+// Returns the string of a rule
+func (l *Language) ruleString(ruleIndex uint32) ([]byte, error) {
+	return l.readStrings(l.rules[ruleIndex].startPos, l.rules[ruleIndex+1].startPos)
+}
@@ -4,9 +4,9 @@
 package translate
 
 import (
+	"bytes"
 	"errors"
 	"io"
-	"strings"
 )
 
 // LanguageTextFile is the interface to load translation text files
@@ -17,34 +17,49 @@ const (
 	LF_YAML = iota + LanguageTextFile(lf_DO_NOT_USE)
 	LF_JSON
 	LF_JSON_AllowTrailingComma
+	LF_YAML_StrictStrings //Errors on non-string scalars (like unquoted numbers and booleans) instead of converting them to strings
+	LF_TOML
+	LF_JSON_I18Next //i18next JSON, whose nested keys, plural suffixes, interpolations, and nesting are converted. Its Settings.MessageFormat is always i18next. These files cannot be written
+	//Custom formats are added with RegisterTextFormat()
 )
 
 // Load loads (yaml or json) a language text file. The default language or the dictionary must be loaded first. retLang is still returned when there are warnings but no errors.
 //
 // Note: Fallback languages still need to be assigned through Language.SetFallback()
 func (lf LanguageTextFile) Load(r io.Reader, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+	return defaultDictionary.LoadText(lf, r, allowBigStrings)
+}
+
+// LoadDefault loads (yaml or json) the default language text file (and the dictionary). This must be called before reading other languages (unless LanguageBinaryFile.LoadDictionary was already called). retLang is still returned when there are warnings but no errors.
+func (lf LanguageTextFile) LoadDefault(r io.Reader, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+	return defaultDictionary.LoadDefaultText(lf, r, allowBigStrings)
+}
+
+// LoadText loads (yaml or json) a language text file. The default language or the dictionary must be loaded first. retLang is still returned when there are warnings but no errors.
+//
+// Note: Fallback languages still need to be assigned through Language.SetFallback()
+func (d *Dictionary) LoadText(lf LanguageTextFile, r io.Reader, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
 	//Check if the dictionary is already loaded
-	localDict := remDict
-	hasDict := localDict != nil
-	if !hasDict {
-		return nil, nil, errors.New("The dictionary has not been loaded yet. You must first call LanguageTextFile.LoadDefault() or LanguageBinaryFile.LoadDictionary()")
+	localDict := d.dict
+	if localDict == nil {
+		return nil, nil, errors.New(errDictionaryNotLoaded)
 	}
 
 	//Load and return the language
-	return lf.loadReal(r, localDict, allowBigStrings)
+	return lf.loadReal(r, localDict, nil, allowBigStrings, d.compileLimits)
 }
 
-// LoadDefault loads (yaml or json) the default language text file (and the dictionary). This must be called before reading other languages (unless LanguageBinaryFile.LoadDictionary was already called). retLang is still returned when there are warnings but no errors.
-func (lf LanguageTextFile) LoadDefault(r io.Reader, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+// LoadDefaultText loads (yaml or json) the default language text file, which creates the dictionary. The dictionary cannot already be loaded. retLang is still returned when there are warnings but no errors.
+func (d *Dictionary) LoadDefaultText(lf LanguageTextFile, r io.Reader, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
 	//Check if the dictionary is already loaded
-	if remDict != nil {
-		return nil, nil, errors.New("The dictionary was already loaded. You can load this language through LanguageTextFile.Load()")
+	if d.dict != nil {
+		return nil, nil, errors.New("The dictionary was already loaded. This language can only be loaded as a non-default language")
 	}
 
 	//Load the language
 	var l *Language
 	var warn []string
-	if _l, _warn, err := lf.loadReal(r, nil, allowBigStrings); err != nil {
+	if _l, _warn, err := lf.loadReal(r, nil, nil, allowBigStrings, d.compileLimits); err != nil {
 		return nil, _warn, err
 	} else {
 		l, warn = _l, _warn
@@ -52,24 +67,102 @@ func (lf LanguageTextFile) LoadDefault(r io.Reader, allowBigStrings bool) (retLa
 
 	//Write the stored dictionary
 	//Not worrying about race conditions as dictionaries are not changed after being created and stored
-	remDict = l.dict
+	d.dict = l.dict
 
 	//Return success
 	l.fallback = l //Set self as the fallback
 	return l, warn, nil
 }
 
-func (lf LanguageTextFile) loadReal(r io.Reader, dict *languageDict, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+// ParseTranslationText parses a default language translation text file from memory. The format is JSON if the first non-whitespace character is “{”, and YAML otherwise. This does not read or write the stored dictionary (or any other global state), so it is safe to use concurrently, like from fuzzers.
+func ParseTranslationText(b []byte) (retLang *Language, retWarnings []string, retErrors error) {
+	lf := LF_YAML
+	if trimmed := bytes.TrimLeft(b, " \t\r\n"); len(trimmed) != 0 && trimmed[0] == '{' {
+		lf = LF_JSON
+	}
+
+	if l, warn, err := lf.loadReal(bytes.NewReader(b), nil, nil, false, CompileLimits{}); err != nil {
+		return nil, warn, err
+	} else {
+		l.fallback = l //Set self as the fallback
+		return l, warn, nil
+	}
+}
+
+// RecompileNamespaces compiles only the given namespaces of the default language text file, and copies the translations of the other namespaces from current, which is the default language’s last compile. This is much faster than a full compile when only a few namespaces are being edited.
+//
+// The namespaces and Translation IDs in the file must be unchanged from current so their indexes do not shift (which a full compile is needed for). current’s dictionary must have its variables loaded. Embedded translation loops are only checked from the compiled namespaces.
+//
+// The returned language has a new dictionary with the same hash, which is not stored as the package level dictionary. retLang is still returned when there are warnings but no errors.
+func (lf LanguageTextFile) RecompileNamespaces(r io.Reader, current *Language, namespaces []string, allowBigStrings bool) (retLang *Language, retWarnings []string, retErrors error) {
+	//Confirm the current language
+	if current == nil || current.dict == nil || current.dict.loadVars() != nil {
+		return nil, nil, errors.New("The current language’s dictionary must have its variables loaded")
+	} else if len(namespaces) == 0 {
+		return nil, nil, errors.New("No namespaces were given")
+	}
+
+	//Load the language
+	partial := &partialCompile{current, make(map[string]bool, len(namespaces))}
+	for _, namespaceName := range namespaces {
+		partial.namespaces[namespaceName] = true
+	}
+	if l, warn, err := lf.loadReal(r, nil, partial, allowBigStrings, defaultDictionary.compileLimits); err != nil {
+		return nil, warn, err
+	} else {
+		l.fallback = l //Set self as the fallback
+		return l, warn, nil
+	}
+}
+
+func (lf LanguageTextFile) loadReal(r io.Reader, dict *languageDict, partial *partialCompile, allowBigStrings bool, limits CompileLimits) (retLang *Language, retWarnings []string, retErrors error) {
 	//Load the full structure from the translation text file
 	var topItem tpItem
+	var readWarnings []string
+	if _topItem, _readWarnings, err := lf.readTopItemWithWarnings(r); err != nil {
+		return nil, nil, err
+	} else {
+		topItem, readWarnings = _topItem, _readWarnings
+	}
+
+	//Load and return the language
+	return loadTopItem(topItem, readWarnings, dict, partial, allowBigStrings, limits)
+}
+
+// Loads the language from the full structure of a translation text file. readWarnings are prepended to the returned warnings. Returns a *CompileLimitError if the limits are exceeded, and otherwise ParseErrors for the errors in the file
+func loadTopItem(topItem tpItem, readWarnings []string, dict *languageDict, partial *partialCompile, allowBigStrings bool, limits CompileLimits) (retLang *Language, retWarnings []string, retErrors error) {
+	var l Language
+	initTextProcessing()
+	limiter := newCompileLimiter(limits)
+	errs, warnings := l.fromTextFile(topItem, dict, partial, allowBigStrings, limiter)
+	warnings = append(readWarnings, warnings...)
+	if err := limiter.err(); err != nil {
+		return nil, warnings, err
+	} else if len(errs) > 0 {
+		for _, err := range errs {
+			err.Lang = l.languageIdentifier
+		}
+		return nil, warnings, ParseErrors(errs)
+	}
+	return &l, warnings, nil
+}
+
+// Reads the full structure from the translation text file
+func (lf LanguageTextFile) readTopItem(r io.Reader) (tpItem, error) {
+	topItem, _, err := lf.readTopItemWithWarnings(r)
+	return topItem, err
+}
+
+// Reads the full structure from the translation text file, with the warnings from restructuring it (only for i18next JSON files)
+func (lf LanguageTextFile) readTopItemWithWarnings(r io.Reader) (tpItem, []string, error) {
 	switch lf {
-	case LF_YAML:
+	case LF_YAML, LF_YAML_StrictStrings:
 		if b, err := io.ReadAll(r); err != nil {
 			return nil, nil, errors.New("Error reading the file: " + err.Error())
-		} else if y, err := fromYamlFile(b); err != nil {
+		} else if y, err := fromYamlFile(b, lf == LF_YAML_StrictStrings); err != nil {
 			return nil, nil, errors.New("Error reading the file: " + err.Error())
 		} else {
-			topItem = &y
+			return &y, nil, nil
 		}
 	case LF_JSON, LF_JSON_AllowTrailingComma:
 		if b, err := io.ReadAll(r); err != nil {
@@ -77,18 +170,31 @@ func (lf LanguageTextFile) loadReal(r io.Reader, dict *languageDict, allowBigStr
 		} else if y, err := fromJsonFile(b, lf == LF_JSON_AllowTrailingComma); err != nil {
 			return nil, nil, errors.New("Error reading the file: " + err.Error())
 		} else {
-			topItem = &y
+			return &y, nil, nil
+		}
+	case LF_TOML:
+		if b, err := io.ReadAll(r); err != nil {
+			return nil, nil, errors.New("Error reading the file: " + err.Error())
+		} else if y, err := fromTomlFile(b); err != nil {
+			return nil, nil, errors.New("Error reading the file: " + err.Error())
+		} else {
+			return &y, nil, nil
+		}
+	case LF_JSON_I18Next:
+		if b, err := io.ReadAll(r); err != nil {
+			return nil, nil, errors.New("Error reading the file: " + err.Error())
+		} else if y, warnings, err := readI18NextFile(b); err != nil {
+			return nil, nil, errors.New("Error reading the file: " + err.Error())
+		} else {
+			return &y, warnings, nil
 		}
 	default:
-		return nil, nil, errors.New("Invalid LanguageTextFile type given")
-	}
-
-	//Load and return the language
-	var l Language
-	initTextProcessing()
-	errs, warnings := l.fromTextFile(topItem, dict, allowBigStrings)
-	if len(errs) > 0 {
-		return nil, warnings, errors.New(strings.Join(errs, "\n"))
+		if decoder, ok := lf.customTextFormat(); !ok {
+			return nil, nil, errors.New("Invalid LanguageTextFile type given")
+		} else if y, err := readCustomTextFormat(decoder, r); err != nil {
+			return nil, nil, errors.New("Error reading the file: " + err.Error())
+		} else {
+			return y, nil, nil
+		}
 	}
-	return &l, warnings, nil
 }
@@ -4,42 +4,139 @@
 package translate
 
 import (
-	"compress/gzip"
+	"errors"
+	"fmt"
 	"io"
+	"math"
 )
 
-// SaveGTR saves a .gtr language file
-func (l *Language) SaveGTR(w io.Writer, isCompressed bool) error {
-	if isCompressed {
-		_w := gzip.NewWriter(w)
-		defer func() { _ = _w.Close() }()
-		w = _w
+// SaveGTR saves a .gtr language file. The compression level of compressed files can be set through WithCompressionLevel(), and the translation strings can be encrypted through WithEncryptionKey()
+func (l *Language) SaveGTR(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	encryption, err := getSaveOptions(options).encryption()
+	if err != nil {
+		return err
 	}
-	return l.toCompiledFile(w)
+	w, closeW, err := newSaveWriter(w, isCompressed, options)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+	return l.toCompiledFile(w, encryption)
 }
 
 // SaveGTRDict saves a .gtr dictionary file
-func (l *Language) SaveGTRDict(w io.Writer, isCompressed bool) error {
-	if isCompressed {
-		_w := gzip.NewWriter(w)
-		defer func() { _ = _w.Close() }()
-		w = _w
-	}
-	return l.dict.toCompiledFile(w)
+func (l *Language) SaveGTRDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	return l.Dictionary().SaveGTRDict(w, isCompressed, options...)
 }
 
 // SaveGTRVarsDict saves a .gtr variable dictionary file
-func (l *Language) SaveGTRVarsDict(w io.Writer, isCompressed bool) error {
-	if isCompressed {
-		_w := gzip.NewWriter(w)
-		defer func() { _ = _w.Close() }()
-		w = _w
+func (l *Language) SaveGTRVarsDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	return l.Dictionary().SaveGTRVarsDict(w, isCompressed, options...)
+}
+
+// SaveGTRCombinedDict saves a .gtr combined dictionary file, which is the dictionary file directly followed by the variable dictionary file
+func (l *Language) SaveGTRCombinedDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	return l.Dictionary().SaveGTRCombinedDict(w, isCompressed, options...)
+}
+
+// SaveGTRStandalone saves a standalone .gtr language file, which holds the dictionary, the language, and its fallback languages, so it can be loaded by itself through LanguageBinaryFile.LoadStandalone(). The language’s fallback must be set
+func (l *Language) SaveGTRStandalone(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	//Get the language and its fallback languages
+	var langs []*Language
+	for curLang := l; ; curLang = curLang.fallback {
+		if curLang == nil {
+			return fmt.Errorf("Fallback language was not set for “%s”", langs[len(langs)-1].LanguageIdentifier())
+		} else if len(langs) == math.MaxUint8 {
+			return fmt.Errorf("Number of fallback languages exceeds %d", math.MaxUint8-1)
+		}
+		langs = append(langs, curLang)
+		if curLang.fallback == curLang {
+			break
+		}
+	}
+
+	//Write the header, dictionary, and languages. The writer is wrapped so the parts do not truncate an os.File to their own sizes
+	encryption, err := getSaveOptions(options).encryption()
+	if err != nil {
+		return err
 	}
-	return l.dict.toCompiledVarFile(w)
+	w, closeW, err := newSaveWriter(w, isCompressed, options)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+	w = &countedWriter{w: w}
+	header := storeStandaloneHeader{fileType: [3]byte(s2b("STR")), numLanguages: uint8(len(langs))}
+	if err := writeBytesToFile(w, encodeStruct(&header)); err != nil {
+		return err
+	} else if err := l.dict.toCompiledFile(w); err != nil {
+		return err
+	}
+	for _, curLang := range langs {
+		if err := curLang.toCompiledFile(w, encryption); err != nil {
+			return fmt.Errorf("Language “%s”: %s", curLang.LanguageIdentifier(), err.Error())
+		}
+	}
+	return nil
+}
+
+// SaveGTRDict saves a .gtr dictionary file
+func (d *Dictionary) SaveGTRDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	if d.dict == nil {
+		return errors.New(errDictionaryNotLoaded)
+	}
+	w, closeW, err := newSaveWriter(w, isCompressed, options)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+	return d.dict.toCompiledFile(w)
+}
+
+// SaveGTRVarsDict saves a .gtr variable dictionary file. The dictionary’s variables must be loaded
+func (d *Dictionary) SaveGTRVarsDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	if d.dict == nil {
+		return errors.New(errDictionaryNotLoaded)
+	}
+	w, closeW, err := newSaveWriter(w, isCompressed, options)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+	return d.dict.toCompiledVarFile(w)
+}
+
+// SaveGTRCombinedDict saves a .gtr combined dictionary file, which is the dictionary file directly followed by the variable dictionary file. The dictionary’s variables must be loaded
+func (d *Dictionary) SaveGTRCombinedDict(w io.Writer, isCompressed bool, options ...SaveOption) error {
+	if d.dict == nil {
+		return errors.New(errDictionaryNotLoaded)
+	}
+	w, closeW, err := newSaveWriter(w, isCompressed, options)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+	if err := d.dict.toCompiledFile(w); err != nil {
+		return err
+	}
+	return d.dict.toCompiledVarFile(w)
 }
 
 // SaveGoDictionaries saves the *.go files from the language to $outputDirectory/$namespaceName/TranslationIDs.go.
 // The GoDictHeader is inserted just before the `const` declaration
 func (l *Language) SaveGoDictionaries(outputDirectory, GoDictHeader string) (err error, numUpdated uint) {
-	return l.toGoDictionaries(outputDirectory, GoDictHeader)
+	err, numUpdated, _ = l.toGoDictionaries(outputDirectory, GoDictHeader, false)
+	return
+}
+
+// SaveGoDictionaryFiles is the same as SaveGoDictionaries() but returns the paths of the files that were written (the changed namespace *.go files and the namespace hashes file)
+func (l *Language) SaveGoDictionaryFiles(outputDirectory, GoDictHeader string) (err error, updatedFiles []string) {
+	err, _, updatedFiles = l.toGoDictionaries(outputDirectory, GoDictHeader, false)
+	return
+}
+
+// PlanGoDictionaryFiles returns the paths of the files that SaveGoDictionaryFiles() would write, without writing anything
+func (l *Language) PlanGoDictionaryFiles(outputDirectory, GoDictHeader string) (err error, changedFiles []string) {
+	err, _, changedFiles = l.toGoDictionaries(outputDirectory, GoDictHeader, true)
+	return
 }
@@ -0,0 +1,273 @@
+// Code generated by gen_lctimeLocales.go from the locales of github.com/klauspost/lctime. DO NOT EDIT.
+//go:build !gol10n_minimal || !gol10n_read_compiled_only
+
+package translate
+
+// The composite formats of each lctime locale (which lctime does not export), keyed by their locale identifier
+var lctimeLocales = map[string]lctimeLocale{
+	"POSIX":            {"%m/%d/%y", "%a %b %e %H:%M:%S %Y", "%H:%M:%S", "%I:%M:%S %p", true},
+	"aa_DJ":            {"%d.%m.%Y", "%a %d %b %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"aa_ER":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"aa_ER@saaho":      {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"aa_ET":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"af_ZA":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", true},
+	"am_ET":            {"%d/%m/%Y", "%A፣ %B %e ቀን %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"an_ES":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ar_AE":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_BH":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_DZ":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_EG":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_IN":            {"%A %d %B %Y", "%A %d %B %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ar_IQ":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_JO":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_KW":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_LB":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_LY":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_MA":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_OM":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_QA":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_SA":            {"%A %e %B %Y", "%A %e %B %Y %k:%M:%S", "%k:%M:%S", "%k:%M:%S", false},
+	"ar_SD":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_SY":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_TN":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"ar_YE":            {"%d %b, %Y", "%d %b, %Y %Z %I:%M:%S %p", "%Z %I:%M:%S", "%Z %I:%M:%S %p", true},
+	"as_IN":            {"%e-%m-%Y", "%e %B, %Y %I.%M.%S %p %Z", "%I.%M.%S %p", "%I.%M.%S %p", true},
+	"ast_ES":           {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"az_AZ":            {"%d.%m.%Y", "%A, %d %B %Y %T", "%T", "", false},
+	"be_BY":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"be_BY@latin":      {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"bem_ZM":           {"%m/%d/%Y", "%a %d %b %Y %R %Z", "%T", "%I:%M:%S %p", true},
+	"ber_DZ":           {"%d.%m.%Y", "%A, %d %B %Y %T", "%T", "", false},
+	"ber_MA":           {"%d.%m.%Y", "%A, %d %B %Y %T", "%T", "", false},
+	"bg_BG":            {"%e.%m.%Y", "%x (%a) %X %Z", "%k,%M,%S", "%l,%M,%S", false},
+	"bho_IN":           {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"bn_BD":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"bn_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"bo_CN":            {"པསྱི་ལོ%yཟལ%mཚེས%d", "པསྱི་ལོ%yཟལ%mཚེས%dཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Iཀསར་མ%Mཀསར་ཆ%S %p", true},
+	"bo_IN":            {"པསྱི་ལོ%yཟལ%mཚེས%d", "པསྱི་ལོ%yཟལ%mཚེས%dཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Iཀསར་མ%Mཀསར་ཆ%S %p", true},
+	"br_FR":            {"%d.%m.%Y", "D'ar %A %d a viz %B %Y", "%T", "%Ie%M:%S %p", false},
+	"brx_IN":           {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"bs_BA":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"byn_ER":           {"%d/%m/%Y", "%A፡ %B %e ግርጋ %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"ca_AD":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ca_ES":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ca_FR":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ca_IT":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"crh_UA":           {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "%I:%M:%S %p", true},
+	"cs_CZ":            {"%-d.%-m.%Y", "%a %-d. %B %Y, %H:%M:%S %Z", "%H:%M:%S", "%I:%M:%S", false},
+	"csb_PL":           {"%Y-%m-%d", "%a %d %b %Y %T %Z", "%T", "", false},
+	"cv_RU":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"cy_GB":            {"%d.%m.%y", "Dydd %A %d mis %B %Y %T %Z", "%T", "%l:%M:%S %P %Z", true},
+	"da_DK":            {"%d-%m-%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_AT":            {"%Y-%m-%d", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_BE":            {"%Y-%m-%d", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_CH":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_DE":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_LI":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"de_LU":            {"%Y-%m-%d", "%a %d %b %Y %T %Z", "%T", "", false},
+	"dv_MV":            {"%d/%m/%Y", "%Z %H:%M:%S %Y %b %d %a", "%H:%M:%S", "%P %I:%M:%S", true},
+	"dz_BT":            {"པསྱི་ལོ%yཟལ%mཚེས%d", "པསྱི་ལོ%yཟལ%mཚེས%dཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Hཀསར་མ%Mཀསར་ཆ%S", "ཆུ་ཚོད%Iཀསར་མ%Mཀསར་ཆ%S %p", true},
+	"el_CY":            {"%d/%m/%Y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"el_GR":            {"%d/%m/%Y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"en_AG":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%l:%M:%S %P %Z", true},
+	"en_AU":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"en_BW":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"en_CA":            {"%y-%m-%d", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"en_DK":            {"%Y-%m-%d", "%Y-%m-%dT%T %Z", "%T", "", false},
+	"en_GB":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%l:%M:%S %P %Z", true},
+	"en_HK":            {"%A, %B %d, %Y", "%A, %B %d, %Y %p%I:%M:%S %Z", "%I:%M:%S %Z", "%p%I:%M:%S %Z", true},
+	"en_IE":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", true},
+	"en_IN":            {"%A %d %B %Y", "%A %d %B %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"en_NG":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"en_NZ":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"en_PH":            {"%A, %d %B, %Y", "%A, %d %B, %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"en_SG":            {"%d/%m/%Y", "%a %d %b %Y %r", "%T", "%I:%M:%S %p", true},
+	"en_US":            {"%m/%d/%Y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"en_ZA":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"en_ZM":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%l:%M:%S %P %Z", true},
+	"en_ZW":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"eo":               {"%Y-%m-%d", "%a %d %b %Y %T %z", "%T", "", false},
+	"eo_US":            {"%m/%d/%Y", "%a %d %b %Y %T %z", "%r", "%I:%M:%S %p", true},
+	"es_AR":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_BO":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_CL":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_CO":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"es_CR":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"es_CU":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_DO":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"es_EC":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_ES":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_GT":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_HN":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_MX":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_NI":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"es_PA":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_PE":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"es_PR":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_PY":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_SV":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_US":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_UY":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"es_VE":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"et_EE":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"eu_ES":            {"%a, %Y.eko %bren %da", "%y-%m-%d %T %Z", "%T", "", false},
+	"eu_FR":            {"%a, %Y.eko %bren %da", "%y-%m-%d %T %Z", "%T", "", false},
+	"fa_IR":            {"%Oy/%Om/%Od", "\u202b%A %Oe %B %Oy، %OH:%OM:%OS\u202c", "%OH:%OM:%OS", "", false},
+	"ff_SN":            {"%d/%m/%Y", "%a %d %b %Y %R %Z", "%R", "", true},
+	"fi_FI":            {"%d.%m.%Y", "%a %e. %Bta %Y %H.%M.%S", "%H.%M.%S", "", false},
+	"fil_PH":           {"%m/%d/%y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"fo_FO":            {"%d/%m-%Y", "%a. %d. %b. %Y %H:%M:%S %Z", "%T", "", false},
+	"fr_BE":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fr_CA":            {"%Y-%m-%d", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fr_CH":            {"%d. %m. %y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fr_FR":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fr_LU":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fur_IT":           {"%d. %m. %y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"fy_NL":            {"%d-%m-%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ga_IE":            {"%d.%m.%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"gd_GB":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"gez_ER":           {"%d/%m/%Y", "%A፥%B፡%e፡መዓልት፡%Y፡%r፡%Z", "%I:%M:%S", "%X፡%p", true},
+	"gez_ET":           {"%d/%m/%Y", "%A፥%B፡%e፡መዓልት፡%Y፡%r፡%Z", "%I:%M:%S", "%X፡%p", true},
+	"gl_ES":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"gu_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"gv_GB":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ha_NG":            {"%d/%m/%y", "ranar %A, %d ga %B cikin %r %Z", "%r", "%I:%M:%S %p", true},
+	"he_IL":            {"%d/%m/%y", "%Z %H:%M:%S %Y %b %d %a", "%H:%M:%S", "%I:%M:%S %P", true},
+	"hi_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"hne_IN":           {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"hr_HR":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"hsb_DE":           {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ht_HT":            {"%d/%m/%y", "%a %d %b %y %t %Z", "%t", "", false},
+	"hu_HU":            {"%Y-%m-%d", "%Y. %b. %e., %A, %H.%M.%S %Z", "%H.%M.%S", "%H.%M.%S", false},
+	"hy_AM":            {"%m/%d/%y", "%a %d %b %Y %r %Z", "%r", "", false},
+	"i18n":             {"%F", "%F %T", "%T", "", false},
+	"ia":               {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"id_ID":            {"%d/%m/%y", "%a %d %b %Y %r %Z", "%T", "", false},
+	"ig_NG":            {"%d/%m/%y", "%A, %d %B %Y %T %Z", "%r", "%I:%M:%S %p", true},
+	"ik_CA":            {"%d/%m/%y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"is_IS":            {"%a %e.%b %Y", "%a %e.%b %Y, %T %Z", "%T", "", true},
+	"it_CH":            {"%d. %m. %y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"it_IT":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"iu_CA":            {"%m/%d/%y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"iw_IL":            {"%d/%m/%y", "%Z %H:%M:%S %Y %b %d %a", "%H:%M:%S", "%I:%M:%S %P", true},
+	"ja_JP":            {"%Y年%m月%d日", "%Y年%m月%d日 %H時%M分%S秒", "%H時%M分%S秒", "%p%I時%M分%S秒", true},
+	"ka_GE":            {"%m/%d/%Y", "%Y წლის %d %B, %T %Z", "%T", "", false},
+	"kk_KZ":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"kl_GL":            {"%d %b %Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"km_KH":            {"%e %B %Y", "%A ថ្ងៃ %e ខែ %B ឆ្នាំ %Y, %H ម៉ោង m នាទី %S វិនាទី\u200b", "%H:%M:%S", "", true},
+	"kn_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ko_KR":            {"%Y년 %m월 %d일", "%x (%a) %r", "%H시 %M분 %S초", "%p %I시 %M분 %S초", true},
+	"kok_IN":           {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ks_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ks_IN@devanagari": {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ku_TR":            {"%d/%m/%Y", "%A %d %B %Y %T %Z", "%T", "", false},
+	"kw_GB":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ky_KG":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"lb_LU":            {"%d.%m.%Y", "%a %d. %b %Y %T", "%T", "", false},
+	"lg_UG":            {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"lij_IT":           {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"lo_LA":            {"%d/%m/%Ey", "%a %e %b %Ey, %H:%M:%S", "%H:%M:%S", "%I:%M:%S %p", true},
+	"lt_LT":            {"%Y.%m.%d", "%Y m. %B %d d. %T", "%T", "", false},
+	"lv_LV":            {"%Y.%m.%d.", "%A, %Y. gada %e. %B, plkst. %H un %M", "%T", "", false},
+	"mai_IN":           {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"mg_MG":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"mhr_RU":           {"%Y.%m.%d", "%A %Y %B %d %T", "%T", "", false},
+	"mi_NZ":            {"%d/%m/%y", "Te %A, te %d o %B, %Y %T %Z", "%T", "", false},
+	"mk_MK":            {"%d.%m.%Y", "%a, %d %b %Y %T %Z", "%T", "", false},
+	"ml_IN":            {"%A %d %B %Y", "%A %d %B %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"mn_MN":            {"%Y.%m.%d", "%Y %b %d, %a %T", "%T", "", false},
+	"mr_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ms_MY":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", false},
+	"mt_MT":            {"%A, %d ta %b, %Y", "%A, %d ta %b, %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", false},
+	"my_MM":            {"%OC%Oy %b %Od %A", "%OC%Oy %b %Od %A %OI:%OM:%OS %Op %Z", "%OI:%OM:%OS %p", "%OI:%OM:%OS %p", true},
+	"nan_TW@latin":     {"%F", "%Y %b %d (%a) %H:%M:%S %Z", "%r", "%I:%M:%S %p", true},
+	"nb_NO":            {"%d. %b %Y", "%a %d. %b %Y kl. %H.%M %z", "kl. %H.%M %z", "", false},
+	"ne_NP":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"nl_AW":            {"%d-%m-%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"nl_BE":            {"%d-%m-%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"nl_NL":            {"%d-%m-%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"nn_NO":            {"%d. %b %Y", "%a %d. %b %Y kl. %H.%M %z", "kl. %H.%M %z", "", false},
+	"nr_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"nso_ZA":           {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"oc_FR":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"om_ET":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"om_KE":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"or_IN":            {"%Od-%Om-%Oy", "%Oe %B %Oy %OI:%OM:%OS %p %Z", "%OI:%OM:%OS %p", "%OI:%OM:%OS %p", true},
+	"os_RU":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"pa_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"pa_PK":            {"%d/%m/%Y", "و %H:%M:%S %Z ت %d %B %Y", "%H:%M:%S", "%P %I:%M:%S", true},
+	"pap_AN":           {"%d-%m-%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"pl_PL":            {"%d.%m.%Y", "%a, %-d %b %Y, %T", "%T", "", false},
+	"ps_AF":            {"د %Y د %B %e", "%A د %Y د %B %e، %Z %H:%M:%S", "%H:%M:%S", "\u202b%I:%M:%S %p\u202c", true},
+	"pt_BR":            {"%d-%m-%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"pt_PT":            {"%d-%m-%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ro_RO":            {"%d.%m.%Y", "%a %d %b %Y %T %z", "%T", "", false},
+	"ru_RU":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"ru_UA":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"rw_RW":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"sa_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"sc_IT":            {"%d. %m. %y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"sd_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"sd_IN@devanagari": {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"sd_PK":            {"%d/%m/%y", "%A %d %B %Y، %H:%M:%S", "%H:%M:%S", "%I:%M:%S %p", true},
+	"se_NO":            {"%Y-%m-%d", "%a, %b %e. b. %Y %T %Z", "%T", "", false},
+	"shs_CA":           {"%d/%m/%y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"si_LK":            {"%Y-%m-%d", "%Y-%m-%d %H:%M:%S %z", "%H:%M:%S", "%p %I:%M:%S", true},
+	"sid_ET":           {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"sk_SK":            {"%d.%m.%Y", "%a %e. %B %Y, %H:%M:%S %Z", "%H:%M:%S", "%I:%M:%S", false},
+	"sl_SI":            {"%d. %m. %Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"so_DJ":            {"%d.%m.%Y", "%a %d %b %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"so_ET":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"so_KE":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"so_SO":            {"%d/%m/%Y", "%A, %B %e, %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"sq_AL":            {"%Y-%b-%d", "%Y-%b-%d %I.%M.%S.%p %Z", "%I.%M.%S. %Z", "%I.%M.%S.%p %Z", true},
+	"sq_MK":            {"%Y-%b-%d", "%Y-%b-%d %I.%M.%S.%p %Z", "%I.%M.%S. %Z", "%I.%M.%S.%p %Z", true},
+	"sr_ME":            {"%d.%m.%Y.", "%A, %d. %B %Y. %T %Z", "%T", "%T", false},
+	"sr_RS":            {"%d.%m.%Y.", "%A, %d. %B %Y. %T %Z", "%T", "%T", false},
+	"sr_RS@latin":      {"%d.%m.%Y.", "%A, %d. %B %Y. %T %Z", "%T", "%T", false},
+	"ss_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"st_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"sv_FI":            {"%d.%m.%Y", "%a %e. %B %Y %H.%M.%S", "%H.%M.%S", "", false},
+	"sv_SE":            {"%Y-%m-%d", "%a %e %b %Y %H:%M:%S", "%H:%M:%S", "", false},
+	"sw_KE":            {"%d/%m/%Y", "%e %B %Y %I:%M:%S %p %Z", "%I:%M:%S %p", "%I:%M:%S %p", true},
+	"sw_TZ":            {"%d/%m/%Y", "%e %B %Y %I:%M:%S %p %Z", "%I:%M:%S %p", "%I:%M:%S %p", true},
+	"ta_IN":            {"%A %d %B %Y", "%A %d %B %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ta_LK":            {"%A %d %B %Y", "%A %d %B %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"te_IN":            {"%B %d %A %Y", "%B %d %A %Y %p%I.%M.%S %Z", "%p%I.%M.%S %Z", "%p%I.%M.%S %Z", true},
+	"tg_TJ":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"th_TH":            {"%d/%m/%Ey", "%a %e %b %Ey, %H:%M:%S", "%H:%M:%S", "%I:%M:%S %p", true},
+	"ti_ER":            {"%d/%m/%Y", "%A፡ %B %e መዓልቲ %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"ti_ET":            {"%d/%m/%Y", "%A፣ %B %e መዓልቲ %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"tig_ER":           {"%d/%m/%Y", "%A፡ %B %e ዮም %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"tk_TM":            {"%d.%m.%Y", "%d.%m.%Y %T", "%T", "", false},
+	"tl_PH":            {"%m/%d/%y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p", true},
+	"tn_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"tr_CY":            {"%d-%m-%Y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"tr_TR":            {"%d-%m-%Y", "%a %d %b %Y %T %Z", "%T", "%I:%M:%S %p", true},
+	"ts_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"tt_RU":            {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "", false},
+	"tt_RU@iqtelif":    {"%d.%m.%Y", "%a %d %b %Y %T", "%T", "%I:%M:%S %p", true},
+	"ug_CN":            {"%a، %d-%m-%Y", "%a، %d-%m-%Y، %T", "%T", "", false},
+	"ug_CN@latin":      {"%a, %Y-%m-%d", "%a, %Y-%m-%d, %T", "%T", "", false},
+	"uk_UA":            {"%d.%m.%y", "%a, %d-%b-%Y %X %z", "%T", "", false},
+	"unm_US":           {"%d/%m/%y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"ur_IN":            {"%A %d %b %Y", "%A %d %b %Y %I:%M:%S %p %Z", "%I:%M:%S %Z", "%I:%M:%S %p %Z", true},
+	"ur_PK":            {"%d/%m/%Y", "و %H:%M:%S %Z ت %d %B %Y", "%H:%M:%S", "%P %I:%M:%S", true},
+	"uz_UZ":            {"%d/%m/%y", "%T, %d %B, %Y yil, %A", "%T", "", false},
+	"uz_UZ@cyrillic":   {"%d/%m/%y", "%T, %d %B, %Y йил, %A", "%T", "", false},
+	"ve_ZA":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"vi_VN":            {"%d/%m/%Y", "%A, %d %B Năm %Y %T %Z", "%T", "%I:%M %p", true},
+	"wa_BE":            {"%d/%m/%Y", "Li %A %d di %B %Y %T %Z", "%H:%M:%S", "%I:%M:%S %p", true},
+	"wae_CH":           {"%Y-%m-%d", "%a %d. %b %Y %T %Z", "%T", "", false},
+	"wal_ET":           {"%d/%m/%Y", "%A፣ %B %e ጋላሳ %Y %r %Z", "%I:%M:%S", "%X %p", true},
+	"wo_SN":            {"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+	"xh_ZA":            {"%d/%m/%Y", "%a %-e %b %Y %T %Z", "%T", "", false},
+	"yi_US":            {"%d/%m/%y", "%Z %H:%M:%S %Y %b %d %a", "%H:%M:%S", "%I:%M:%S %P", true},
+	"yo_NG":            {"%d/%m/%y", "ọjọ́ %A, %d oṣù %B ọdún %Y %T %Z", "%r", "%I:%M:%S %p", true},
+	"yue_HK":           {"%Y年%m月%d日 %A", "%Y年%m月%d日 %A %H點%M分%S秒", "%H點%M分%S秒", "%p%I點%M分%S秒", true},
+	"zh_CN":            {"%Y年%m月%d日", "%Y年%m月%d日 %A %H时%M分%S秒", "%H时%M分%S秒", "%p %I时%M分%S秒", true},
+	"zh_HK":            {"%Y年%m月%d日 %A", "%Y年%m月%d日 %A %H:%M:%S", "%I時%M分%S秒 %Z", "%p %I:%M:%S", true},
+	"zh_SG":            {"%Y年%m月%d日", "%Y年%m月%d日 %H时%M分%S秒 %Z", "%H时%M分%S秒 %Z", "", true},
+	"zh_TW":            {"%Y年%m月%d日", "%Y年%m月%d日 (%A) %H時%M分%S秒", "%H時%M分%S秒", "%p %I時%M分%S秒", true},
+	"zu_ZA":            {"%d/%m/%Y", "%a %d %b %Y %T %Z", "%T", "", false},
+}
@@ -0,0 +1,53 @@
+//Content hashes of translation text files for string freezes
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+)
+
+// The number of bytes of the sha256 sum that are kept in a content hash
+const translationHashSize = 8
+
+// TranslationHashes returns the content hash of every Translation ID in a translation text file, keyed by “Namespace.TranslationID”.
+//
+// The hash covers the plural rules and variables of the Translation ID, but not its “\” properties (like translator notes), or the formatting of the file.
+func TranslationHashes(r io.Reader, lf LanguageTextFile) (map[string]string, error) {
+	topObj, _, _, err := readTextFileTop(r, lf)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]string)
+	for _, nsItem := range topObj.toOrdered() {
+		nsName := nsItem.getName()
+		nsObj, ok := nsItem.getObject()
+		if nsName == "Settings" || !ok {
+			continue
+		}
+
+		for _, tidItem := range nsObj.toOrdered() {
+			tidName := tidItem.getName()
+			if tidName == namespaceMetadataName {
+				continue
+			}
+			path := nsName + "." + tidName
+			rules, vars, err := readTranslationIDProps(tidItem, path)
+			if err != nil {
+				return nil, err
+			}
+
+			//Hash the variables and then the rules, with each name and value terminated by a null
+			h := sha256.New()
+			for _, prop := range append(vars, rules...) {
+				_, _ = io.WriteString(h, prop.name+"\x00"+prop.value+"\x00")
+			}
+			hashes[path] = hex.EncodeToString(h.Sum(nil)[:translationHashSize])
+		}
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,79 @@
+//Policies for namespaces that are missing from non-default language translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// What happens when a namespace is missing from a non-default language translation text file
+type missingNamespacePolicy uint8
+
+const (
+	mnpWarn     missingNamespacePolicy = iota //Add a warning. Its translations fall back
+	mnpFallback                               //Its translations silently fall back
+	mnpError                                  //Add an error
+	mnpOmit                                   //Its translations silently fall back and the namespace is marked as omitted from the language
+)
+
+// The names of the policies as given in Settings.MissingNamespaces (case-insensitive)
+var missingNamespacePolicyNames = []string{"Warn", "Fallback", "Error", "Omit"}
+
+// The Settings.MissingNamespaces key that sets the policy for namespaces not otherwise given
+const missingNamespaceDefaultKey = "*"
+
+// missingNamespacePolicies holds the policies read from Settings.MissingNamespaces
+type missingNamespacePolicies struct {
+	defaultPolicy missingNamespacePolicy
+	namespaces    map[string]missingNamespacePolicy
+}
+
+// Returns the policy for a namespace
+func (mnp *missingNamespacePolicies) get(namespaceName string) missingNamespacePolicy {
+	if p, ok := mnp.namespaces[namespaceName]; ok {
+		return p
+	}
+	return mnp.defaultPolicy
+}
+
+// Reads the Settings.MissingNamespaces object, which is namespace names (or “*”) to their policies
+func getMissingNamespacePolicies(settingsObj tpMap) (missingNamespacePolicies, []string) {
+	var ret missingNamespacePolicies
+	policiesInterface, ok := settingsObj.getValue("MissingNamespaces")
+	if !ok {
+		return ret, nil
+	}
+	policiesObj, ok := policiesInterface.getObject()
+	if !ok {
+		return ret, []string{"Settings.MissingNamespaces must be an object"}
+	}
+
+	var errs []string
+	ret.namespaces = make(map[string]missingNamespacePolicy, policiesObj.getLength())
+	for _, item := range policiesObj.toOrdered() {
+		name := item.getName()
+		policyName, ok := item.getString()
+		if !ok {
+			errs = append(errs, fmt.Sprintf("Settings.MissingNamespaces.%s: Must be a string", name))
+			continue
+		}
+
+		policy, found := mnpWarn, false
+		for i, n := range missingNamespacePolicyNames {
+			if strings.EqualFold(strings.TrimSpace(policyName), n) {
+				policy, found = missingNamespacePolicy(i), true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("Settings.MissingNamespaces.%s: Invalid policy “%s”. Valid policies are: %s", name, policyName, strings.Join(missingNamespacePolicyNames, ", ")))
+		} else if name == missingNamespaceDefaultKey {
+			ret.defaultPolicy = policy
+		} else {
+			ret.namespaces[name] = policy
+		}
+	}
+	return ret, errs
+}
@@ -0,0 +1,89 @@
+//Tests of the conversion between plural rules and the CLDR plural categories of Android and Apple files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMobilePluralRulesToCategories(t *testing.T) {
+	for _, test := range []struct {
+		lang     string
+		rules    []textProp
+		expected []textProp
+		warnings int
+	}{
+		//=0 is in the “other” category in English, which Android and iOS would never select as “zero”
+		{"en", []textProp{{"=0", "none"}, {"=1", "one"}, {"^", "many"}}, []textProp{{"one", "one"}, {"other", "many"}}, 1},
+
+		//The “one” category in Russian also holds 21, 31, …
+		{"ru", []textProp{{"=1", "one"}, {"~2-4", "few"}, {"^", "many"}}, []textProp{{"one", "one"}, {"few", "few"}, {"other", "many"}}, 2},
+
+		//The “one” category in French also holds 0
+		{"fr", []textProp{{"<=1", "one"}, {"^", "many"}}, []textProp{{"one", "one"}, {"other", "many"}}, 0},
+		{"fr", []textProp{{"=1", "one"}, {"^", "many"}}, []textProp{{"one", "one"}, {"other", "many"}}, 1},
+
+		//A rule over more than 1 category
+		{"cs", []textProp{{"<=4", "few"}, {"^", "many"}}, []textProp{{"other", "many"}}, 1},
+	} {
+		var warnings []string
+		got := newMobilePlurals(test.lang).rulesToCategories("ID", test.rules, func(str string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(str, args...))
+		})
+		if !reflect.DeepEqual(got, test.expected) || len(warnings) != test.warnings {
+			t.Errorf("%s %v = %v with warnings %q; expected %v with %d warnings", test.lang, test.rules, got, warnings, test.expected, test.warnings)
+		}
+	}
+}
+
+func TestMobilePluralCategoriesToRules(t *testing.T) {
+	for _, test := range []struct {
+		lang       string
+		categories []textProp
+		expected   []textProp
+		warnings   int
+	}{
+		//English has no “zero” category
+		{"en", []textProp{{"other", "many"}, {"zero", "none"}, {"one", "one"}}, []textProp{{"=1", "one"}, {"^", "many"}}, 1},
+
+		//Russian categories that repeat every 10 counts
+		{"ru", []textProp{{"one", "one"}, {"few", "few"}, {"many", "many"}, {"other", "other"}}, []textProp{{"=1", "one"}, {"~2-4", "few"}, {"~5-20", "many"}, {"^", "other"}}, 3},
+
+		//The “one” category in French holds 0 and 1
+		{"fr", []textProp{{"one", "one"}, {"other", "many"}}, []textProp{{"<=1", "one"}, {"^", "many"}}, 0},
+
+		//Czech categories map to a single range
+		{"cs", []textProp{{"one", "one"}, {"few", "few"}, {"other", "many"}}, []textProp{{"=1", "one"}, {"~2-4", "few"}, {"^", "many"}}, 0},
+
+		//Unknown categories
+		{"en", []textProp{{"several", "x"}}, nil, 1},
+	} {
+		var warnings []string
+		got := newMobilePlurals(test.lang).categoriesToRules("ID", test.categories, func(str string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(str, args...))
+		})
+		if !reflect.DeepEqual(got, test.expected) || len(warnings) != test.warnings {
+			t.Errorf("%s %v = %v with warnings %q; expected %v with %d warnings", test.lang, test.categories, got, warnings, test.expected, test.warnings)
+		}
+	}
+}
+
+func TestMobilePluralsHasRule(t *testing.T) {
+	for _, test := range []struct {
+		lang, rule string
+		expected   bool
+	}{
+		{"en", "=1", true},
+		{"en", "=0", false},
+		{"en", "^", false},
+		{"ru", "~2-4", true},
+		{"cs", "<=4", false},
+	} {
+		if got := newMobilePlurals(test.lang).hasRule(test.rule); got != test.expected {
+			t.Errorf("%s %s = %v; expected %v", test.lang, test.rule, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+//Tests of restricting the namespaces translations can be requested from
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"strings"
+	"testing"
+)
+
+// Translations in namespaces that are allowed and denied
+const namespaceAccessTestYAML = `Settings:
+  LanguageName: English
+  LanguageIdentifier: en-US
+  MissingPluralRule: Missing
+Tenant1:
+  A: tenant 1 a
+  B: tenant 1 b
+Tenant2:
+  A: tenant 2 a
+  Secret: "embeds {{*Tenant1.B}}"
+Shared:
+  A: shared a
+`
+
+func TestNamespaceAccess(t *testing.T) {
+	l, _, err := ParseTranslationText([]byte(namespaceAccessTestYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := func(ns, id string) TransIndex { return l.dict.namespaces[ns].ids[id] }
+	restricted := l.With(WithNamespaceAccess(func(namespace string) bool { return namespace != "Tenant1" }))
+
+	//Allowed namespaces render
+	for _, test := range [][3]string{{"Tenant2", "A", "tenant 2 a"}, {"Shared", "A", "shared a"}} {
+		if s, err := restricted.Get(index(test[0], test[1])); err != nil || s != test[2] {
+			t.Errorf("%s.%s: Returned (%q, %v) but expected %q", test[0], test[1], s, err, test[2])
+		}
+	}
+
+	//Denied namespaces, and translations that embed them, return an error
+	for _, test := range [][2]string{{"Tenant1", "A"}, {"Tenant1", "B"}, {"Tenant2", "Secret"}} {
+		if s, err := restricted.Get(index(test[0], test[1])); err == nil || !strings.Contains(err.Error(), "“Tenant1” cannot be accessed") {
+			t.Errorf("%s.%s: Returned (%q, %v) but expected a denied namespace error", test[0], test[1], s, err)
+		}
+	}
+
+	//The option does not change the original language, and a nil function allows all namespaces
+	for _, lang := range []*Language{l, restricted.With(WithNamespaceAccess(nil))} {
+		if s, err := lang.Get(index("Tenant1", "A")); err != nil || s != "tenant 1 a" {
+			t.Errorf("Returned (%q, %v) but expected %q", s, err, "tenant 1 a")
+		}
+	}
+}
@@ -0,0 +1,109 @@
+//Namespace introspection
+
+package translate
+
+import "sort"
+
+// NamespaceInfo is information about a namespace in a language’s dictionary
+type NamespaceInfo struct {
+	Name              string
+	FirstIndex        TransIndex //The TransIndex of the namespace’s first Translation ID
+	NumTranslationIDs uint32
+	Metadata          NamespaceMetadata //Only filled in when the dictionary was created from a translation text file
+	IsOmitted         bool              //If the namespace was intentionally left out of the language (see Language.IsNamespaceOmitted)
+}
+
+// NamespaceMetadata holds the properties from a namespace’s “_Metadata” object in the default language’s translation text file
+type NamespaceMetadata struct {
+	Owner       string            //The team or person that owns the namespace
+	Description string            //What the namespace is used for
+	Review      string            //The review requirements for changes to the namespace
+	Other       map[string]string //Any other properties
+}
+
+// IsEmpty returns if no metadata properties were given
+func (nm NamespaceMetadata) IsEmpty() bool {
+	return len(nm.Owner) == 0 && len(nm.Description) == 0 && len(nm.Review) == 0 && len(nm.Other) == 0
+}
+
+// Sets a metadata property by its name
+func (nm *NamespaceMetadata) set(name, value string) {
+	switch name {
+	case "Owner":
+		nm.Owner = value
+	case "Description":
+		nm.Description = value
+	case "Review":
+		nm.Review = value
+	default:
+		if nm.Other == nil {
+			nm.Other = make(map[string]string)
+		}
+		nm.Other[name] = value
+	}
+}
+
+// Returns all metadata properties as name/value pairs in a consistent order (Owner, Description, Review, then the other properties sorted by name). Blank values are skipped
+func (nm NamespaceMetadata) getProperties() [][2]string {
+	otherNames := make([]string, 0, len(nm.Other))
+	for name := range nm.Other {
+		otherNames = append(otherNames, name)
+	}
+	sort.Strings(otherNames)
+
+	props := make([][2]string, 0, 3+len(otherNames))
+	for _, prop := range [][2]string{{"Owner", nm.Owner}, {"Description", nm.Description}, {"Review", nm.Review}} {
+		if len(prop[1]) != 0 {
+			props = append(props, prop)
+		}
+	}
+	for _, name := range otherNames {
+		if len(nm.Other[name]) != 0 {
+			props = append(props, [2]string{name, nm.Other[name]})
+		}
+	}
+	return props
+}
+
+// Namespaces returns the information of all namespaces in the dictionary, in order
+func (l *Language) Namespaces() []NamespaceInfo {
+	_ = l.dict.loadVars() //The metadata is loaded with the variables
+	ret := make([]NamespaceInfo, 0, len(l.dict.namespacesInOrder))
+	var startIndex uint32
+	for _, namespaceName := range l.dict.namespacesInOrder {
+		n := l.dict.namespaces[namespaceName]
+		ret = append(ret, NamespaceInfo{n.name, TransIndex(startIndex), ulen32m(n.ids), n.metadata, l.IsNamespaceOmitted(n.name)})
+		startIndex += ulen32m(n.ids)
+	}
+	return ret
+}
+
+// NamespaceInfo returns the information of a namespace in the dictionary
+func (l *Language) NamespaceInfo(namespaceName string) (NamespaceInfo, bool) {
+	for _, info := range l.Namespaces() {
+		if info.Name == namespaceName {
+			return info, true
+		}
+	}
+	return NamespaceInfo{}, false
+}
+
+// NamespaceOwner returns the owner from the metadata of the namespace that holds the Translation ID. This can be used to route translation issues to the owning team
+func (l *Language) NamespaceOwner(index TransIndex) (owner string, ok bool) {
+	_ = l.dict.loadVars() //The metadata is loaded with the variables
+	if namespaceName, _, ok := l.dict.translationIDLookupNS(index); !ok {
+		return returnBlankStrOnErr, false
+	} else {
+		return l.dict.namespaces[namespaceName].metadata.Owner, true
+	}
+}
+
+// IsNamespaceOmitted returns if the namespace was missing from the language’s translation text file and its “Settings.MissingNamespaces” policy was “Omit”. The namespace’s translations still fall back to the fallback language, so this can be used to hide features that are not translated into the language
+func (l *Language) IsNamespaceOmitted(namespaceName string) bool {
+	return arrayIn(l.omittedNamespaces, namespaceName)
+}
+
+// OmittedNamespaces returns the namespaces that were intentionally left out of the language (see IsNamespaceOmitted)
+func (l *Language) OmittedNamespaces() []string {
+	return append([]string(nil), l.omittedNamespaces...)
+}
@@ -0,0 +1,98 @@
+//Runtime translation overrides layered over a language
+
+package translate
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OverlayLanguage is a language whose translations can be overridden at runtime without recompiling, like for per customer wording (“Cart” vs “Basket”). Translations that are not overridden are taken from the base language (and its fallbacks).
+//
+// All of the Language functions can be called on it. The Get() functions (including through embedded translations and Language.With()) use the overrides, while the other functions (like Schema() and EmbedGraph()) only see the base language’s translations.
+type OverlayLanguage struct {
+	*Language //A copy of the base language that consults the overrides first
+	base      *Language
+	loadMutex sync.Mutex //Only 1 set of overrides can be stored at a time
+}
+
+// The overrides of an OverlayLanguage, which are shared with the copies of its language (see Language.With())
+type translationOverlay struct {
+	translations atomic.Pointer[map[TransIndex]overlayTranslation] //Replaced, not modified, when the overrides change so the Get() functions do not need to lock
+}
+
+// An overridden translation. Its strings and rules are stored the same way as in Language.stringsData and Language.rules
+type overlayTranslation struct {
+	stringsData []byte
+	rules       []translationRule //There is always 1 extra so the last endPos can be calculated
+}
+
+// NewOverlayLanguage creates an OverlayLanguage over the base language with no overrides. Overrides are added through OverlayLanguage.LoadPatch()
+//
+// The base language is not modified, and can be shared by many OverlayLanguages. The base language’s render cache is not used. If renders are cached for the OverlayLanguage (see Language.CacheRenders()), the cache must be cleared after its overrides change
+func NewOverlayLanguage(base *Language) *OverlayLanguage {
+	l := *base
+	l.renderCache = nil //The base language’s cached renders do not have the overrides
+	l.overlay = &translationOverlay{}
+	return &OverlayLanguage{Language: &l, base: base}
+}
+
+// Base returns the language that the overlay was created over
+func (o *OverlayLanguage) Base() *Language {
+	return o.base
+}
+
+// NumOverrides returns the number of overridden translations
+func (o *OverlayLanguage) NumOverrides() int {
+	if m := o.overlay.translations.Load(); m != nil {
+		return len(*m)
+	}
+	return 0
+}
+
+// IsOverridden returns if the translation at the index is overridden
+func (o *OverlayLanguage) IsOverridden(index TransIndex) bool {
+	_, ok := o.getOverride(index)
+	return ok
+}
+
+// RemoveOverrides removes the overrides of the translations at the indexes. If no indexes are given then all overrides are removed. This is concurrency safe
+func (o *OverlayLanguage) RemoveOverrides(indexes ...TransIndex) {
+	o.loadMutex.Lock()
+	defer o.loadMutex.Unlock()
+
+	if len(indexes) == 0 {
+		o.overlay.translations.Store(nil)
+		return
+	}
+	newOverrides := o.copyOverrides(0)
+	for _, index := range indexes {
+		delete(newOverrides, index)
+	}
+	o.overlay.translations.Store(&newOverrides)
+}
+
+// Returns a copy of the current overrides, with room for extra more
+func (o *OverlayLanguage) copyOverrides(extra int) map[TransIndex]overlayTranslation {
+	cur := o.overlay.translations.Load()
+	if cur == nil {
+		return make(map[TransIndex]overlayTranslation, extra)
+	}
+	newOverrides := make(map[TransIndex]overlayTranslation, len(*cur)+extra)
+	for index, t := range *cur {
+		newOverrides[index] = t
+	}
+	return newOverrides
+}
+
+// Returns the overridden translation at the index, if the language is an OverlayLanguage and the translation is overridden
+func (l *Language) getOverride(index TransIndex) (overlayTranslation, bool) {
+	if l.overlay == nil {
+		return overlayTranslation{}, false
+	} else if m := l.overlay.translations.Load(); m == nil {
+		return overlayTranslation{}, false
+	} else {
+		t, ok := (*m)[index]
+		return t, ok
+	}
+}
@@ -0,0 +1,132 @@
+//Load translation overrides from patch text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LoadPatch loads translation overrides from a patch text file (yaml or json) into the overlay. Overrides for translations that are already overridden replace them. No overrides are loaded if there are errors.
+//
+// A patch file has the same layout as a translation text file without its Settings: namespaces at the top level, each with the Translation IDs it overrides, written the same way as in a translation text file. The variables of a translation must match the default language’s. Escape sequences are processed with the default EscapeSequences, and translations are always in the gol10n message format.
+//
+// The dictionary’s variables must be loaded. Embedded static translations are not checked for looped recursion, which returns an error from the Get() functions instead. This is concurrency safe.
+func (o *OverlayLanguage) LoadPatch(lf LanguageTextFile, r io.Reader) (retWarnings []string, retErrors error) {
+	//Handle errors and warnings
+	var errs []string
+	addErrStr := func(err string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(err, args...))
+	}
+	addWarnStr := func(warn string, args ...interface{}) {
+		retWarnings = append(retWarnings, fmt.Sprintf(warn, args...))
+	}
+
+	//The translations are compiled with the dictionary’s variables
+	if err := o.dict.loadVars(); err != nil {
+		return nil, err
+	}
+
+	//Load the full structure from the patch file
+	var topObj tpMap
+	if topItem, readWarnings, err := lf.readTopItemWithWarnings(r); err != nil {
+		return nil, err
+	} else if _topObj, ok := topItem.getObject(); !ok {
+		return readWarnings, errors.New("Top level item is not an object")
+	} else if _topObj, normErrors, normWarnings := normalizeTextFile(_topObj); len(normErrors) != 0 {
+		return append(readWarnings, normWarnings...), errors.New(strings.Join(normErrors, "\n"))
+	} else {
+		retWarnings = append(readWarnings, normWarnings...)
+		topObj = _topObj
+	}
+
+	//Compile the overridden translations
+	initTextProcessing()
+	escapes := defaultEscapePolicy
+	dateTimes := newDateTimeSpecifierPolicy(o.languageTag, o.calendar)
+	overrides := make(map[TransIndex]overlayTranslation)
+	for _, namespaceItem := range topObj.toOrdered() {
+		//Get the namespace
+		namespaceName := namespaceItem.getName()
+		var n *namespace
+		var namespaceObj tpMap
+		if _n, ok := o.dict.namespaces[namespaceName]; !ok {
+			addErrStr("%s: Namespace does not exist", namespaceName)
+			continue
+		} else if _namespaceObj, ok := namespaceItem.getObject(); !ok {
+			addErrStr("%s: Invalid type: Must be a dictionary", namespaceName)
+			continue
+		} else {
+			n, namespaceObj = _n, _namespaceObj
+		}
+
+		for _, translationItem := range namespaceObj.toOrdered() {
+			//Get the Translation ID
+			translationIDName := translationItem.getName()
+			index, ok := n.ids[translationIDName]
+			if !ok {
+				addErrStr("%s.%s: Translation ID does not exist", namespaceName, translationIDName)
+				continue
+			}
+
+			//Get the properties of the Translation ID
+			props := make([]string, 0, 2)
+			if strVal, ok := translationItem.getString(); ok {
+				props = append(props, "^", strVal)
+			} else if mapVal, ok := translationItem.getObject(); ok {
+				for _, mapItemVal := range mapVal.toOrdered() {
+					if propVal, ok := mapItemVal.getString(); !ok {
+						addErrStr("%s.%s.%s: Must be a string", namespaceName, translationIDName, mapItemVal.getName())
+					} else {
+						props = append(props, mapItemVal.getName(), propVal)
+					}
+				}
+			} else {
+				addErrStr("%s.%s: Invalid type: Must be a string or dictionary", namespaceName, translationIDName)
+				continue
+			}
+
+			//Compile the translation. The variables are copied so the dictionary is not modified when the default language’s translation has none
+			defaultVars := n.idsInOrder[index-n.ids[n.idsInOrder[0].name]]
+			vars := defaultVars
+			translationErrors, translationWarnings, retStrings, retPluralRules, _ := addTranslationIDFromTextFile(props, namespaceName, o.dict, &vars, &escapes, dateTimes, hasSpelloutRules(o.languageTag), o.registers, false)
+			for _, err := range translationErrors {
+				addErrStr("%s.%s: %s", namespaceName, translationIDName, err)
+			}
+			for _, warn := range translationWarnings {
+				addWarnStr("%s", prefixWarning(namespaceName+"."+translationIDName, warn))
+			}
+			if len(retPluralRules) == 0 {
+				addErrStr("%s.%s: Translation has no rules", namespaceName, translationIDName)
+				continue
+			} else if defaultVars.vars == nil && vars.vars != nil {
+				addWarnStr("%s %s.%s: Number of variables (%d) does not match the default language (0)", WC_VariableMismatch, namespaceName, translationIDName, len(vars.vars))
+			}
+
+			//Store the strings and rules
+			t := overlayTranslation{rules: make([]translationRule, len(retPluralRules)+1)}
+			for i, rule := range retPluralRules {
+				t.stringsData = append(t.stringsData, retStrings[i]...)
+				t.rules[i].rule = rule
+				t.rules[i+1].startPos = ulen32(t.stringsData)
+			}
+			overrides[index] = t
+		}
+	}
+	if len(errs) != 0 {
+		return retWarnings, errors.New(strings.Join(errs, "\n"))
+	}
+
+	//Store the overrides with the current ones
+	o.loadMutex.Lock()
+	defer o.loadMutex.Unlock()
+	newOverrides := o.copyOverrides(len(overrides))
+	for index, t := range overrides {
+		newOverrides[index] = t
+	}
+	o.overlay.translations.Store(&newOverrides)
+	return retWarnings, nil
+}
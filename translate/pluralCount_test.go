@@ -0,0 +1,160 @@
+//Tests of the plural count overflow policy
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+// Translations with and without an overflow bucket rule (“>1” overflows into “many”, and “>max” into its own translation)
+const pluralCountTestYAML = `Settings:
+  LanguageName: English
+  LanguageIdentifier: en-US
+  MissingPluralRule: Missing
+NS:
+  Bucket:
+    =1: one
+    ">1": many
+  Exact:
+    =1: one
+    =2: two
+  Max:
+    =1: one
+    ">max": overflow
+    ^: many
+`
+
+// Returns the test language and the indexes of its translations
+func loadPluralCountTestLanguage(t *testing.T) (l *Language, bucket, exact TransIndex) {
+	if strconv.IntSize < 64 {
+		t.Skip("Plural counts above math.MaxUint32 require a 64 bit uint")
+	}
+	l, _, err := ParseTranslationText([]byte(pluralCountTestYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := l.dict.namespaces["NS"]
+	return l, n.ids["Bucket"], n.ids["Exact"]
+}
+
+// Returns the uint of a uint64 at runtime, so the tests still compile where uint is 32 bits
+func toUint(n uint64) uint {
+	return uint(n)
+}
+
+func TestPluralCountToInt64(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("Plural counts above math.MaxUint32 require a 64 bit uint")
+	}
+	minusOne := -1
+	for _, test := range []struct {
+		count    uint
+		expected int64
+	}{
+		{0, 0},
+		{1, 1},
+		{math.MaxUint32, math.MaxUint32},
+		{toUint(math.MaxUint32 + 1), math.MaxUint32 + 1},
+		{toUint(math.MaxInt64), math.MaxInt64},
+		{toUint(math.MaxInt64 + 1), pluralCountWrapped},
+		{uint(minusOne), pluralCountWrapped},
+	} {
+		if got := pluralCountToInt64(test.count); got != test.expected {
+			t.Errorf("pluralCountToInt64(%d) = %d, expected %d", test.count, got, test.expected)
+		}
+	}
+}
+
+func TestPluralCountAboveMaxUint32(t *testing.T) {
+	l, bucket, exact := loadPluralCountTestLanguage(t)
+	for _, count := range []uint{toUint(math.MaxUint32 + 1), toUint(math.MaxInt64)} {
+		//Only the overflow bucket rule matches
+		if str, err := l.GetPlural(bucket, count); err != nil || str != "many" {
+			t.Errorf("Bucket(%d) = %q, %v; expected “many”", count, str, err)
+		}
+
+		//Without an overflow bucket rule, the missing plural rule is returned with an error (the same as for counts no rule matches)
+		if str, err := l.GetPlural(exact, count); err == nil || str != "Missing" {
+			t.Errorf("Exact(%d) = %q, %v; expected “Missing” with an error", count, str, err)
+		}
+	}
+}
+
+func TestPluralCountWrappedNegative(t *testing.T) {
+	l, bucket, _ := loadPluralCountTestLanguage(t)
+	minusOne := -1
+	for _, count := range []uint{uint(minusOne), toUint(math.MaxInt64 + 1)} {
+		if str, err := l.GetPlural(bucket, count); err == nil {
+			t.Errorf("Bucket(%d) = %q; expected an error", count, str)
+		}
+		if str, err := l.With(WithStrictPluralCounts(true)).GetPlural(bucket, count); err == nil {
+			t.Errorf("Strict Bucket(%d) = %q; expected an error", count, str)
+		}
+	}
+}
+
+func TestStrictPluralCounts(t *testing.T) {
+	l, bucket, _ := loadPluralCountTestLanguage(t)
+	strict := l.With(WithStrictPluralCounts(true))
+
+	//Counts that plurality rules can compare against still work
+	for count, expected := range map[uint]string{1: "one", 2: "many", math.MaxUint32: "many"} {
+		if str, err := strict.GetPlural(bucket, count); err != nil || str != expected {
+			t.Errorf("Strict Bucket(%d) = %q, %v; expected “%s”", count, str, err, expected)
+		}
+	}
+
+	//Counts above them are errors
+	for _, count := range []uint{toUint(math.MaxUint32 + 1), toUint(math.MaxInt64)} {
+		if str, err := strict.GetPlural(bucket, count); err == nil {
+			t.Errorf("Strict Bucket(%d) = %q; expected an error", count, str)
+		}
+	}
+
+	//The original language is not strict
+	if _, err := l.GetPlural(bucket, toUint(math.MaxUint32+1)); err != nil {
+		t.Errorf("Non-strict language returned an error: %v", err)
+	}
+}
+
+func TestPluralCountOverflowBucketRule(t *testing.T) {
+	l, _, _ := loadPluralCountTestLanguage(t)
+	overflow := l.dict.namespaces["NS"].ids["Max"]
+	strict := l.With(WithStrictPluralCounts(true))
+	minusOne := -1
+	for _, test := range []struct {
+		count                          uint
+		expected, expectedStrict       string
+		expectError, expectStrictError bool
+	}{
+		{1, "one", "one", false, false},
+		{math.MaxUint32 - 1, "many", "many", false, false},
+		{math.MaxUint32, "many", "many", false, false}, //The largest count rules compare against is not above it
+		{toUint(math.MaxUint32 + 1), "overflow", "", false, true},
+		{toUint(math.MaxInt64), "overflow", "", false, true},
+		{toUint(math.MaxInt64 + 1), "", "", true, true}, //Wrapped counts never match
+		{uint(minusOne), "", "", true, true},
+	} {
+		for _, mode := range []struct {
+			name        string
+			l           *Language
+			expected    string
+			expectError bool
+		}{
+			{"", l, test.expected, test.expectError},
+			{"Strict ", strict, test.expectedStrict, test.expectStrictError},
+		} {
+			str, err := mode.l.GetPlural(overflow, test.count)
+			if mode.expectError {
+				if err == nil {
+					t.Errorf("%sMax(%d) = %q; expected an error", mode.name, test.count, str)
+				}
+			} else if err != nil || str != mode.expected {
+				t.Errorf("%sMax(%d) = %q, %v; expected “%s”", mode.name, test.count, str, err, mode.expected)
+			}
+		}
+	}
+}
@@ -2,9 +2,17 @@
 
 package translate
 
+import "math"
+
 type cmpOp uint8
 type pluralRule struct {
 	op cmpOp //For cmpBetween the top 5 bits (0-31) are added to i0 for the upper limit of the between comparison. cmpBetweenExtraBit gives (32-63)
+	i0 uint32
+}
+
+// The compiled file encoding of a pluralRule whose number fits in a uint8 (see storeTranslationRule16 and storeTranslationRule32)
+type pluralRule8 struct {
+	op cmpOp
 	i0 uint8
 }
 
@@ -12,6 +20,19 @@ func (pr pluralRule) getOp() cmpOp {
 	return cmpOp(uint8(pr.op) & 7)
 }
 
+// Returns if the rule’s number does not fit in a pluralRule8, which requires storeTranslationRuleWide
+func (pr pluralRule) isWide() bool {
+	return pr.i0 > math.MaxUint8
+}
+
+func (pr pluralRule) to8() pluralRule8 {
+	return pluralRule8{pr.op, uint8(pr.i0)}
+}
+
+func (pr pluralRule8) toRule() pluralRule {
+	return pluralRule{pr.op, uint32(pr.i0)}
+}
+
 const (
 	cmpAll cmpOp = iota
 	cmpEquals
@@ -24,24 +45,25 @@ const (
 	_ = 255
 )
 
-func (pr pluralRule) cmp(pluralCount uint8) bool {
+func (pr pluralRule) cmp(pluralCount uint64) bool {
+	i0 := uint64(pr.i0)
 	switch pr.getOp() {
 	case cmpAll:
 		return true
 	case cmpEquals:
-		return pluralCount == pr.i0
+		return pluralCount == i0
 	case cmpLess:
-		return pluralCount < pr.i0
+		return pluralCount < i0
 	case cmpLessEqual:
-		return pluralCount <= pr.i0
+		return pluralCount <= i0
 	case cmpGreater:
-		return pluralCount > pr.i0
+		return pluralCount > i0
 	case cmpGreaterEqual:
-		return pluralCount >= pr.i0
+		return pluralCount >= i0
 	case cmpBetween:
-		return pr.i0 <= pluralCount && (pr.i0+(uint8(pr.op)>>3)) >= pluralCount
+		return i0 <= pluralCount && i0+uint64(uint8(pr.op)>>3) >= pluralCount
 	case cmpBetweenExtraBit:
-		return pr.i0 <= pluralCount && (pr.i0+(uint8(pr.op)>>3))+32 >= pluralCount
+		return i0 <= pluralCount && i0+uint64(uint8(pr.op)>>3)+32 >= pluralCount
 	default:
 		return false
 	}
@@ -0,0 +1,53 @@
+//Runtime overrides of plurality rule matching
+
+package translate
+
+import (
+	"golang.org/x/text/language"
+	"sync"
+	"sync/atomic"
+)
+
+// PluralRuleSet chooses which plurality rule of a translation is used for a plural count. It returns the index of the rule in the order the rules are given in the translation. A negative index uses the normal rule matching, and an index past the translation’s last rule is the same as no rule matching
+type PluralRuleSet func(n int64) int
+
+// Registered plural rule sets keyed by canonical language tag. The map is replaced (never modified) on registration so lookups do not need to lock
+var (
+	pluralRuleSets      atomic.Value //map[string]PluralRuleSet
+	pluralRuleSetsMutex sync.Mutex
+)
+
+// RegisterPluralRuleSet overrides the plurality rule matching of the Plural functions for languages whose language identifier is the language tag, for applications with requirements that translation files cannot express. The rule set is called with the plural count and chooses which plurality rule is used (see PluralRuleSet).
+//
+// The override is used for a translation whenever it is found in a language with the tag, including through fallbacks. Non-plural functions are not affected. A nil rule set removes the override. This is safe to call while translations are being retrieved
+func RegisterPluralRuleSet(langTag language.Tag, ruleSet PluralRuleSet) {
+	pluralRuleSetsMutex.Lock()
+	defer pluralRuleSetsMutex.Unlock()
+
+	//Copy the current map with the change
+	oldSets, _ := pluralRuleSets.Load().(map[string]PluralRuleSet)
+	newSets := make(map[string]PluralRuleSet, len(oldSets)+1)
+	for tag, set := range oldSets {
+		newSets[tag] = set
+	}
+	if ruleSet == nil {
+		delete(newSets, langTag.String())
+	} else {
+		newSets[langTag.String()] = ruleSet
+	}
+	pluralRuleSets.Store(newSets)
+}
+
+// Returns the registered plural rule set for the language, or nil if there is none
+func (l *Language) pluralRuleSet() PluralRuleSet {
+	sets, _ := pluralRuleSets.Load().(map[string]PluralRuleSet)
+	if len(sets) == 0 {
+		return nil
+	}
+
+	//The language tag can be overridden through WithLocaleOverride(), so the language identifier is used
+	if set, ok := sets[l.languageIdentifier]; ok {
+		return set
+	}
+	return sets[language.Make(l.languageIdentifier).String()]
+}
@@ -4,8 +4,10 @@ package translate
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
-	"golang.org/x/text/currency"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -13,9 +15,9 @@ import (
 )
 
 // Variable types
-type variableType uint8 //4 bits
+type variableType uint8 //4 bits in translation strings (see vtFirstExtended)
 
-const ( //Note: Cannot have more than 16 types
+const ( //Note: Cannot have more than 16 non-extended types
 	//Anything (Passes as %v)
 	vtAnything variableType = iota
 
@@ -45,8 +47,37 @@ const ( //Note: Cannot have more than 16 types
 	//Recursive translations
 	vtStaticTranslation
 	vtVariableTranslation
+
+	//Extended types do not fit in the 4 type bits of translation strings. They are stored as vtDateTime with a specifier of: varReplacementChar, the extended type, and its options
+	//As varReplacementChar is not valid utf8, it can never start a real DateTime specifier
+	vtSpellout
+	vtOrdinal
+	vtQuote
+
+	//The rules of a Translation ID with a Template variable are text/templates executed with the variable as their data. Its variable placement starts each rule, and the rest of the rule is the template
+	vtTemplate
 )
 
+// The first extended variable type
+const vtFirstExtended = vtSpellout
+
+// The names of the variable types in translation text files
+var variableTypeNames = [...]string{
+	vtAnything: string(VT_Anything), vtString: string(VT_String),
+	vtInteger: string(VT_Integer), vtBinary: string(VT_Binary), vtOctal: string(VT_Octal), vtHexLower: string(VT_HexLower), vtHexUpper: string(VT_HexUpper),
+	vtScientific: string(VT_Scientific), vtFloating: string(VT_Floating),
+	vtBool:     string(VT_Bool),
+	vtDateTime: string(VT_DateTime), vtCurrency: string(VT_Currency), vtIntegerWithSymbols: string(VT_IntegerWithSymbols), vtFloatWithSymbols: string(VT_FloatWithSymbols),
+	vtStaticTranslation: string(VT_StaticTranslation), vtVariableTranslation: string(VT_VariableTranslation),
+	vtSpellout: string(VT_Spellout), vtOrdinal: string(VT_Ordinal), vtQuote: string(VT_Quote), vtTemplate: string(VT_Template),
+}
+
+// The types that PluralCount can be changed to
+var pluralCountTypes = map[variableType]bool{
+	vtAnything: true, vtInteger: true, vtBinary: true, vtOctal: true, vtHexLower: true, vtHexUpper: true,
+	vtIntegerWithSymbols: true, vtSpellout: true, vtOrdinal: true,
+}
+
 // Formatting flags
 const (
 	fmtHasWidth     = 1 << 4
@@ -59,15 +90,37 @@ const (
 	varReplacementChar = 0xFF //Chosen because this is normally an invalid character in UTF8
 )
 
-func (l *Language) processTranslation(translation []byte, pluralCount int64, translationIDIndex TransIndex, embeddedCount uint, args []interface{}) (string, error) {
-	//Create a buffer the same size as the current translation (in preparation for translations with no variables)
-	var newString strings.Builder
-	newString.Grow(len(translation))
+// The buffer translations are processed into. It appends to the caller’s buffer (see Language.AppendGet())
+type translationBuffer []byte
+
+func (b *translationBuffer) Write(p []byte) (int, error) {
+	*b = append(*b, p...)
+	return len(p), nil
+}
+func (b *translationBuffer) WriteString(s string) (int, error) {
+	*b = append(*b, s...)
+	return len(s), nil
+}
+
+// Makes sure there is room to append n more bytes
+func (b *translationBuffer) grow(n int) {
+	if cap(*b)-len(*b) < n {
+		newBuf := make([]byte, len(*b), len(*b)+n)
+		copy(newBuf, *b)
+		*b = newBuf
+	}
+}
+
+// Appends the processed translation to dst. On error, dst is returned unchanged
+func (l *Language) appendTranslation(dst []byte, translation []byte, pluralCount int64, translationIDIndex TransIndex, embeddedCount uint, args []interface{}) ([]byte, error) {
+	//Make room in the buffer for the current translation (in preparation for translations with no variables)
+	newString := translationBuffer(dst)
+	newString.grow(len(translation))
 
 	//Prepare to return errors
 	insertedVarNum := 1
-	varErr := func(err string, args ...interface{}) (string, error) {
-		return retErrWithStr(fmt.Errorf("Inserted variable placement #"+strconv.Itoa(insertedVarNum)+" is "+err, args...))
+	varErr := func(err string, args ...interface{}) ([]byte, error) {
+		return dst, fmt.Errorf("Inserted variable placement #"+strconv.Itoa(insertedVarNum)+" is "+err, args...)
 	}
 
 	//Consume a byte from []translation
@@ -110,7 +163,7 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		//Get the variable index number
 		var varNum uint
 		if b, err := consumeByte("missing variable number specifier"); err != nil {
-			return retErrWithStr(err)
+			return dst, err
 		} else {
 			varNum = uint(b)
 		}
@@ -121,7 +174,7 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		//Get the type+flags char
 		var typeFlags uint8
 		if b, err := consumeByte("missing typeFlags"); err != nil {
-			return retErrWithStr(err)
+			return dst, err
 		} else {
 			typeFlags = b
 		}
@@ -136,25 +189,28 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		}
 
 		//Set the width and precision (if given)
+		width, precision := -1, -1
 		if typeFlags&fmtHasWidth != 0 {
 			if b, err := consumeByte("missing width"); err != nil {
-				return retErrWithStr(err)
+				return dst, err
 			} else if b != 0 {
 				printfFlags += strconv.FormatUint(uint64(b), 10)
+				width = int(b)
 			}
 		}
 		if typeFlags&fmtHasPrecision != 0 {
 			if b, err := consumeByte("missing precision"); err != nil {
-				return retErrWithStr(err)
+				return dst, err
 			} else {
 				printfFlags += "." + strconv.FormatUint(uint64(b), 10)
+				precision = int(b)
 			}
 		}
 
 		//Get the value for the variable
 		var val interface{}
 		if varNum == 0 {
-			val = uint32(pluralCount)
+			val = cond(pluralCount < 0, uint64(math.MaxUint32), uint64(pluralCount))
 		} else {
 			val = args[varNum-1]
 		}
@@ -166,7 +222,11 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 
 		//If a non-special type, use sprintf to add it to our string
 		if vt != '-' {
-			_, _ = fmt.Fprintf(&newString, printfFlags+string(vt), val)
+			if l.displayWidths && (vt == 's' || vt == 'v') && (width != -1 || precision != -1) {
+				writeDisplayWidth(&newString, fmt.Sprintf("%"+string(vt), val), width, precision, typeFlags&fmtPadRight != 0, typeFlags&fmtPad0 != 0)
+			} else {
+				_, _ = fmt.Fprintf(&newString, printfFlags+string(vt), val)
+			}
 			insertedVarNum++
 			continue
 		}
@@ -182,28 +242,55 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 			return val, nil
 		}
 
-		//Handle date/times
+		//Handle date/times (and extended types)
 		if variableType(typeFlags&0xF) == vtDateTime {
-			//Make sure the time localizer already exists
-			if _, err := l.TimeLocalizer(); err != nil {
-				return varErr("date/time. Error localizing: " + err.Error())
-			}
-
 			//Get the specifier string
 			var specifierStr string
 			if specifierLen, err := consumeByte("missing DateTime.specifierLen"); err != nil {
-				return retErrWithStr(err)
+				return dst, err
 			} else if _specifierStr, err := consumeBytes(uint(specifierLen), "missing DateTime.specifier"); err != nil {
-				return retErrWithStr(err)
+				return dst, err
 			} else {
 				specifierStr = b2s(_specifierStr)
 			}
 
+			//Handle extended types. Templates are the rest of the translation
+			if len(specifierStr) >= 2 && specifierStr[0] == varReplacementChar && variableType(specifierStr[1]) == vtTemplate {
+				if err := executeTextTemplate(&newString, b2s(translation[translationIndex:]), val); err != nil {
+					return varErr("template. %s", err.Error())
+				}
+				break
+			} else if len(specifierStr) >= 2 && specifierStr[0] == varReplacementChar {
+				if err := l.processExtendedVariable(&newString, variableType(specifierStr[1]), s2b(specifierStr[2:]), printfFlags, val); err != nil {
+					return varErr("%s", err.Error())
+				}
+				insertedVarNum++
+				continue
+			}
+
+			//Make sure the time localizer already exists
+			if err := l.loadTimeLocalizer(); err != nil {
+				return varErr("date/time. Error localizing: " + err.Error())
+			}
+
+			//Get the calendar, which can be overwritten by the specifier
+			cal := l.calendar
+			if calendarName, format, hasCalendar := splitCalendarSpecifier(specifierStr); hasCalendar {
+				if _cal, err := getCalendarType(calendarName); err != nil {
+					return varErr("date/time. %s", err.Error())
+				} else {
+					cal, specifierStr = _cal, format
+				}
+			}
+
 			//Localize the time
 			if t, ok := val.(time.Time); !ok {
 				return varErr("date/time. Variable require a time.Time object")
 			} else {
-				newString.WriteString((*l.timeLocalizer).Strftime(specifierStr, t))
+				if l.timeZone != nil {
+					t = t.In(l.timeZone)
+				}
+				newString.WriteString(l.formatDateTime(cal, specifierStr, t))
 				insertedVarNum++
 				continue
 			}
@@ -213,12 +300,11 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		var printerType byte
 		switch variableType(typeFlags & 0xF) {
 		case vtCurrency:
-			if curVal, ok := val.(currency.Amount); !ok {
-				return varErr("currency. Variable require a golang.org/x/text/currency.Amount object")
-			} else {
-				val = currency.Symbol(curVal)
-				printerType = 'd'
+			if err := l.fprintCurrency(&newString, printfFlags, val, false); err != nil {
+				return varErr("currency. %s", err.Error())
 			}
+			insertedVarNum++
+			continue
 		case vtIntegerWithSymbols:
 			printerType = 'd'
 		case vtFloatWithSymbols:
@@ -228,7 +314,7 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		}
 		if printerType != 0 {
 			//Localize the number
-			_, _ = l.MessagePrinter().Fprintf(&newString, printfFlags+string(printerType), val)
+			l.fprintNumber(&newString, printfFlags+string(printerType), val)
 			insertedVarNum++
 			continue
 		}
@@ -239,9 +325,9 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 		case vtStaticTranslation:
 			//Get the newTranslationIDIndex
 			if b, err := consumeBytes(4, "missing static translation index"); err != nil {
-				return retErrWithStr(err)
+				return dst, err
 			} else {
-				newTranslationIDIndex = TransIndex(*p2uint32p(&b[0]))
+				newTranslationIDIndex = TransIndex(binary.LittleEndian.Uint32(b))
 				if uint32(newTranslationIDIndex) >= l.NumTranslations() {
 					return varErr("static translation with invalid index")
 				}
@@ -290,14 +376,14 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 					newTranslationIDIndex = _v
 				}
 			default:
-				return varErr("variable translation with invalid type “%s” (must be TransIndex or string)", reflect.TypeOf(val).Name())
+				return varErr("variable translation with invalid type “%T” (must be TransIndex or string)", val)
 			}
 		default:
 			return varErr("unknown variable type")
 		}
 
 		//Add the translation from the index
-		if embeddedStr, err := l.getReal(newTranslationIDIndex, pluralCount, embeddedCount+1, nil); err != nil {
+		if embedded, err := l.appendReal(newString, newTranslationIDIndex, pluralCount, embeddedCount+1, nil); err != nil {
 			return varErr(
 				"variable translation “%s”->“%s”:\n%s",
 				twoToOne(l.TranslationIDLookup(translationIDIndex)),
@@ -305,17 +391,84 @@ func (l *Language) processTranslation(translation []byte, pluralCount int64, tra
 				err.Error(),
 			)
 		} else {
-			newString.WriteString(embeddedStr)
+			newString = embedded
 			insertedVarNum++
 		}
 	}
 
-	//Return the final value. If cap-len>maxCapDiff then copy the string so cap=size
-	const maxCapDiff = 1024
-	if newString.Cap()-newString.Len() > maxCapDiff {
-		return strings.Clone(newString.String()), nil
+	return newString, nil
+}
+
+// Writes out an extended variable type (see vtFirstExtended), or a Currency with options
+func (l *Language) processExtendedVariable(w *translationBuffer, varType variableType, options []byte, printfFlags string, val interface{}) error {
+	switch varType {
+	case vtSpellout, vtOrdinal:
+		//Get the options
+		opts, ok := getSpelloutOptions(varType, options)
+		typeName := cond(varType == vtSpellout, "spellout", "ordinal")
+		if !ok {
+			return errors.New(typeName + ". Invalid options")
+		}
+
+		//Get the integer value
+		var n int64
+		switch v := val.(type) {
+		case int, int8, int16, int32, int64:
+			n = reflect.ValueOf(v).Int()
+		case uint, uint8, uint16, uint32, uint64:
+			if u := reflect.ValueOf(v).Uint(); u > math.MaxInt64 {
+				return errors.New(typeName + ". Variable is too large")
+			} else {
+				n = int64(u)
+			}
+		default:
+			return errors.New(typeName + ". Variable requires an integer")
+		}
+
+		//Spell out the number or add the ordinal suffix. If the language is not supported, fall back to IntegerWithSymbols
+		if varType == vtSpellout {
+			if str, ok := spellNumber(l.languageTag, n, opts); ok {
+				_, _ = fmt.Fprintf(w, printfFlags+"s", str)
+				return nil
+			}
+		} else if suffix, ok := ordinalSuffix(l.languageTag, n, opts.isFeminine); ok {
+			_, _ = fmt.Fprintf(w, printfFlags+"s", l.sprintNumber("%d", n)+suffix)
+			return nil
+		}
+		l.fprintNumber(w, printfFlags+"d", n)
+		return nil
+	case vtQuote:
+		//The precision applies to the value, and the width to the quoted value
+		valueFlags, quotedFlags := "%", printfFlags
+		if dotLoc := strings.IndexByte(printfFlags, '.'); dotLoc != -1 {
+			valueFlags, quotedFlags = "%"+printfFlags[dotLoc:], printfFlags[:dotLoc]
+		}
+		isAlternate := len(options) > 0 && options[0]&qoAlternate != 0
+		_, _ = fmt.Fprintf(w, quotedFlags+"s", l.Quote(fmt.Sprintf(valueFlags+"v", val), isAlternate))
+		return nil
+	case vtCurrency:
+		if err := l.fprintCurrency(w, printfFlags, val, len(options) > 0 && options[0]&coCash != 0); err != nil {
+			return errors.New("currency. " + err.Error())
+		}
+		return nil
+	default:
+		return errors.New("unknown extended variable type")
+	}
+}
+
+// Reads the compiled options of a Spellout ([capitalization, flags]) or Ordinal ([flags]) variable. Missing options are defaults
+func getSpelloutOptions(varType variableType, options []byte) (opts spelloutOptions, ok bool) {
+	if varType == vtSpellout && len(options) > 0 {
+		if opts.capitalization = spelloutCase(options[0]); opts.capitalization > scSentence {
+			return opts, false
+		}
+		options = options[1:]
+	}
+	if len(options) > 0 {
+		opts.isOrdinal = options[0]&soOrdinal != 0
+		opts.isFeminine = options[0]&soFeminine != 0
 	}
-	return newString.String(), nil
+	return opts, true
 }
 
 // As this is only used for debugging purposes, this is not optimized and has to search through all of a namespace’s translations to find a match (only when read from a compiled file).
@@ -326,8 +479,8 @@ func (dict *languageDict) translationIDLookup(index TransIndex) (namespaceName s
 		return returnBlankStrOnErr, returnBlankStrOnErr, false
 	}
 
-	//If dict.hasVarsLoaded then this info can be looked up quickly
-	if dict.hasVarsLoaded {
+	//If the variables are loaded then this info can be looked up quickly
+	if dict.loadVars() == nil {
 		return nsName, dict.namespaces[nsName].idsInOrder[uint(index)-nsStartIndex].name, true
 	}
 
@@ -0,0 +1,87 @@
+//Locale specific quotation marks
+
+package translate
+
+import (
+	"golang.org/x/text/language"
+)
+
+// The quotation mark delimiters of a locale (from CLDR)
+type quotationMarks struct {
+	start, end                   string
+	alternateStart, alternateEnd string //Used for quotes nested inside quotes
+}
+
+// Bit flags for the Quote variable type in compiled specifiers
+const (
+	qoAlternate = 1 << 0
+)
+
+// The quotation marks used when a locale is not found in quotationMarksByLocale
+var defaultQuotationMarks = quotationMarks{"“", "”", "‘", "’"}
+
+// Quotation marks keyed by locale. Lookups go from most to least specific: “base-region”, “base-script”, and then “base”
+var quotationMarksByLocale = map[string]quotationMarks{
+	"ar":      {"”", "“", "’", "‘"},
+	"bg":      {"„", "“", "„", "“"},
+	"ca":      {"«", "»", "“", "”"},
+	"cs":      {"„", "“", "‚", "‘"},
+	"da":      {"“", "”", "‘", "’"},
+	"de":      {"„", "“", "‚", "‘"},
+	"de-CH":   {"«", "»", "‹", "›"},
+	"de-LI":   {"«", "»", "‹", "›"},
+	"el":      {"«", "»", "“", "”"},
+	"en":      {"“", "”", "‘", "’"},
+	"es":      {"«", "»", "“", "”"},
+	"et":      {"„", "“", "‚", "‘"},
+	"fi":      {"”", "”", "’", "’"},
+	"fr":      {"«", "»", "«", "»"},
+	"fr-CH":   {"«", "»", "‹", "›"},
+	"he":      {"”", "”", "’", "’"},
+	"hr":      {"„", "“", "‚", "‘"},
+	"hu":      {"„", "”", "»", "«"},
+	"it":      {"«", "»", "“", "”"},
+	"it-CH":   {"«", "»", "‹", "›"},
+	"ja":      {"「", "」", "『", "』"},
+	"ko":      {"“", "”", "‘", "’"},
+	"lt":      {"„", "“", "„", "“"},
+	"nb":      {"«", "»", "‘", "’"},
+	"nl":      {"‘", "’", "“", "”"},
+	"nn":      {"«", "»", "‘", "’"},
+	"no":      {"«", "»", "‘", "’"},
+	"pl":      {"„", "”", "«", "»"},
+	"pt":      {"“", "”", "‘", "’"},
+	"pt-PT":   {"«", "»", "“", "”"},
+	"ro":      {"„", "”", "«", "»"},
+	"ru":      {"«", "»", "„", "“"},
+	"sk":      {"„", "“", "‚", "‘"},
+	"sl":      {"„", "“", "‚", "‘"},
+	"sr":      {"„", "“", "‘", "’"},
+	"sv":      {"”", "”", "’", "’"},
+	"tr":      {"“", "”", "‘", "’"},
+	"uk":      {"«", "»", "„", "“"},
+	"zh":      {"“", "”", "‘", "’"},
+	"zh-Hant": {"「", "」", "『", "』"},
+}
+
+// Returns the quotation marks of the locale of the tag
+func getQuotationMarks(tag language.Tag) quotationMarks {
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	region, _ := tag.Region()
+	for _, key := range []string{base.String() + "-" + region.String(), base.String() + "-" + script.String(), base.String()} {
+		if marks, ok := quotationMarksByLocale[key]; ok {
+			return marks
+		}
+	}
+	return defaultQuotationMarks
+}
+
+// Quote wraps the string in the quotation marks of the language’s locale (Example: „str“ in German and «str» in French). The alternate quotation marks are for quotes nested inside quotes (Example: ‚str‘ in German). Locales without known quotation marks use “str” and ‘str’
+func (l *Language) Quote(str string, isAlternate bool) string {
+	marks := getQuotationMarks(l.languageTag)
+	if isAlternate {
+		return marks.alternateStart + str + marks.alternateEnd
+	}
+	return marks.start + str + marks.end
+}
@@ -0,0 +1,68 @@
+//Registers (like formal and informal) of translations
+
+package translate
+
+// A register’s rules in a translation are behind a marker rule, which is a cmpAll rule with the register number in the top 5 bits of its op. The default register’s rules (register #0) come first and do not have a marker
+func newRegisterMarker(register uint8) pluralRule {
+	return pluralRule{cmpAll | cmpOp(register<<3), 0}
+}
+
+// Returns the register number of a marker rule, or 0 if the rule is not a marker
+func (pr pluralRule) getRegisterMarker() uint8 {
+	if pr.getOp() != cmpAll {
+		return 0
+	}
+	return uint8(pr.op) >> 3
+}
+
+// WithRegister requests translations in the register (like “Informal”), for languages that declare it in Settings.Registers. Translations without texts for the register, and languages without the register, use the default register’s texts. A blank name uses the default register
+func WithRegister(name string) GetOption {
+	return func(l *Language) {
+		l.register = name
+	}
+}
+
+// Registers returns the registers declared by the language (Settings.Registers). The first is the default register. Nil if none were declared
+func (l *Language) Registers() []string {
+	return append([]string(nil), l.registers...)
+}
+
+// Register returns the register translations are requested in (see WithRegister()). Blank for the default register
+func (l *Language) Register() string {
+	return l.register
+}
+
+// Returns the rules of a translation in the default register, and the rules in the register (if the translation has them). registerStart is the index of the register’s rules in the translation’s rules
+func (l *Language) splitRegisterRules(rules []translationRule, registerName string) (defaultRules, registerRules []translationRule, registerStart int) {
+	//If the language does not have registers then all rules are the default register’s
+	if len(l.registers) < 2 {
+		return rules, nil, 0
+	}
+
+	//Get the register number
+	register := uint8(0)
+	for i, name := range l.registers[1:] {
+		if name == registerName {
+			register = uint8(i + 1)
+		}
+	}
+
+	//Split the rules at the markers
+	defaultRules = rules
+	for i, r := range rules {
+		switch marker := r.rule.getRegisterMarker(); {
+		case marker == 0:
+		case registerRules != nil:
+			return defaultRules, registerRules[0 : i-registerStart], registerStart
+		default:
+			if len(defaultRules) == len(rules) {
+				defaultRules = rules[0:i]
+			}
+			if marker == register {
+				registerStart = i + 1
+				registerRules = rules[registerStart:]
+			}
+		}
+	}
+	return
+}
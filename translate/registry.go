@@ -0,0 +1,111 @@
+//Choosing a loaded language for a request’s Accept-Language header
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/language"
+	"sort"
+)
+
+// Registry holds the loaded languages and picks the best one for the languages a user accepts (like an HTTP request’s Accept-Language header). It is safe for concurrent use
+type Registry struct {
+	languages       []*Language //The default language is first. Indexed the same as the matcher’s tags, so languages with aliases are in it more than once
+	defaultLanguage *Language
+	matcher         language.Matcher
+}
+
+// NewRegistry creates a Registry from the languages (like the map load_compiled.LoadFromFS() returns) and the language to use when none of them are accepted. The default language does not need to be in the bundle. Languages that are in the bundle more than once (like under aliases) are only matched once
+func NewRegistry(languages Bundle, defaultLanguage *Language) (*Registry, error) {
+	return NewRegistryWithAliases(languages, defaultLanguage, nil)
+}
+
+// NewRegistryWithAliases is the same as NewRegistry, except the aliases of the languages are also matched (Example: with {"iw": "he"}, an accepted “iw” matches the “he” language). Aliases whose language is not in the registry are ignored. The aliases may be nil
+func NewRegistryWithAliases(languages Bundle, defaultLanguage *Language, aliases LanguageAliases) (*Registry, error) {
+	if defaultLanguage == nil {
+		return nil, errors.New("The default language is nil")
+	}
+
+	//Get the languages in identifier order (after the default language), so matching is deterministic
+	langIdents := make([]string, 0, len(languages))
+	for langIdent := range languages {
+		langIdents = append(langIdents, langIdent)
+	}
+	sort.Strings(langIdents)
+	r := &Registry{languages: []*Language{defaultLanguage}, defaultLanguage: defaultLanguage}
+	for _, langIdent := range langIdents {
+		if l := languages[langIdent]; l != nil && !arrayIn(r.languages, l) {
+			r.languages = append(r.languages, l)
+		}
+	}
+
+	//Get the tags of the languages
+	tags := make([]language.Tag, len(r.languages))
+	for i, l := range r.languages {
+		tags[i] = l.LanguageTag()
+	}
+
+	//Add the aliases of the languages, in alias order
+	aliasNames := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		aliasNames = append(aliasNames, alias)
+	}
+	sort.Strings(aliasNames)
+	numLanguages := len(r.languages)
+	for _, alias := range aliasNames {
+		aliasTag, err := language.Parse(alias)
+		if err != nil {
+			return nil, fmt.Errorf("Language alias “%s” is not a valid language identifier", alias)
+		}
+		for _, l := range r.languages[:numLanguages] {
+			if l.LanguageIdentifier() == aliases[alias] {
+				r.languages = append(r.languages, l)
+				tags = append(tags, aliasTag)
+				break
+			}
+		}
+	}
+
+	//Create the matcher. The first tag is used when nothing matches
+	r.matcher = language.NewMatcher(tags)
+	return r, nil
+}
+
+// Match returns the language that best matches the Accept-Language header value (Example: “fr-CH, fr;q=0.9, en;q=0.8”). Languages are tried in order of their quality values, and a regional language falls back to a language of its base (Example: “fr-CA” matches “fr-FR”). The default language is returned if nothing matches or the header is invalid. Example: registry.Match(httpRequest.Header.Get("Accept-Language"))
+func (r *Registry) Match(acceptLanguageHeader string) *Language {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguageHeader)
+	if err != nil {
+		return r.defaultLanguage
+	}
+	return r.MatchTags(tags...)
+}
+
+// MatchTags returns the language that best matches the tags, which are in order of preference. The default language is returned if nothing matches
+func (r *Registry) MatchTags(tags ...language.Tag) *Language {
+	l, _ := r.MatchTagsConfidence(tags...)
+	return l
+}
+
+// MatchTagsConfidence is the same as MatchTags(), and also returns how confident the match is. The confidence is language.No when the default language is returned because nothing matched
+func (r *Registry) MatchTagsConfidence(tags ...language.Tag) (*Language, language.Confidence) {
+	_, index, confidence := r.matcher.Match(tags...)
+	if confidence == language.No {
+		return r.defaultLanguage, language.No
+	}
+	return r.languages[index], confidence
+}
+
+// Default returns the language used when nothing matches
+func (r *Registry) Default() *Language {
+	return r.defaultLanguage
+}
+
+// Languages returns the registry’s languages keyed to their language identifier, including the default language
+func (r *Registry) Languages() Bundle {
+	b := make(Bundle, len(r.languages))
+	for _, l := range r.languages {
+		b[l.LanguageIdentifier()] = l
+	}
+	return b
+}
@@ -0,0 +1,165 @@
+//Cache rendered translations of frequent requests
+
+package translate
+
+import (
+	"container/list"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// RenderCache is an LRU cache of rendered translations, so identical requests (with identical arguments) do not need to be processed again. Languages are added with Language.CacheRenders(). It is safe for concurrent use.
+//
+// Only requests whose arguments are all strings, bools, integers, floats, or TransIndexes are cached. Requests that error are not cached.
+type RenderCache struct {
+	mutex      sync.Mutex
+	maxEntries int
+	entries    map[renderCacheKey]*list.Element
+	lru        *list.List //Most recently used at the front. Values are *renderCacheEntry
+	stats      RenderCacheStats
+}
+
+// RenderCacheStats are the statistics of a RenderCache. See RenderCache.Stats()
+type RenderCacheStats struct {
+	Entries     int    //The number of cached translations
+	MaxEntries  int    //The maximum number of cached translations
+	Hits        uint64 //Requests answered from the cache
+	Misses      uint64 //Cacheable requests that were processed (and then cached if they did not error)
+	Evictions   uint64 //Cached translations that were removed to make room for newer ones
+	Uncacheable uint64 //Requests with arguments that cannot be cached
+}
+
+// Identifies a request. The arguments are encoded by appendRenderCacheArg()
+type renderCacheKey struct {
+	lang        *Language
+	index       TransIndex
+	pluralCount int64
+	args        string
+}
+type renderCacheEntry struct {
+	key   renderCacheKey
+	value string
+}
+
+// NewRenderCache creates an empty RenderCache that holds up to maxEntries rendered translations
+func NewRenderCache(maxEntries int) *RenderCache {
+	return &RenderCache{
+		maxEntries: maxEntries,
+		entries:    make(map[renderCacheKey]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// CacheRenders caches the language’s rendered translations in the cache. A cache can be shared between languages. Languages created from it afterward with With() (and options) do not use the cache, as they format differently. Embedded translations are rendered as part of their parent translation and are not cached separately.
+//
+// The cache must be cleared after anything that changes how translations render (like RegisterPluralRuleSet() or SetFallback()). A nil cache stops caching. This must not be called while the language is in use by other goroutines.
+func (l *Language) CacheRenders(c *RenderCache) {
+	l.renderCache = c
+}
+
+// Stats returns the cache’s statistics
+func (c *RenderCache) Stats() RenderCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	stats := c.stats
+	stats.Entries, stats.MaxEntries = c.lru.Len(), c.maxEntries
+	return stats
+}
+
+// SetMaxEntries changes the maximum number of cached translations. The least recently used translations are evicted if there are too many. 0 stops caching
+func (c *RenderCache) SetMaxEntries(maxEntries int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.maxEntries = maxEntries
+	c.evict()
+}
+
+// Clear removes all cached translations. The statistics are kept
+func (c *RenderCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = make(map[renderCacheKey]*list.Element)
+	c.lru.Init()
+}
+
+// Removes the least recently used entries until the cache fits. The mutex must be locked
+func (c *RenderCache) evict() {
+	for c.lru.Len() > 0 && c.lru.Len() > c.maxEntries {
+		elem := c.lru.Back()
+		delete(c.entries, c.lru.Remove(elem).(*renderCacheEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+// Appends the rendered translation to dst from the cache, or renders and caches it
+func (c *RenderCache) appendRender(dst []byte, l *Language, index TransIndex, pluralCount int64, args []interface{}) ([]byte, error) {
+	//Build the key
+	key := renderCacheKey{lang: l, index: index, pluralCount: pluralCount}
+	if len(args) != 0 {
+		var encodedArgs []byte
+		for _, arg := range args {
+			var ok bool
+			if encodedArgs, ok = appendRenderCacheArg(encodedArgs, arg); !ok {
+				c.mutex.Lock()
+				c.stats.Uncacheable++
+				c.mutex.Unlock()
+				return l.appendRender(dst, index, pluralCount, 0, args)
+			}
+		}
+		key.args = string(encodedArgs)
+	}
+
+	//Return the cached translation if found
+	c.mutex.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.stats.Hits++
+		value := elem.Value.(*renderCacheEntry).value
+		c.mutex.Unlock()
+		return append(dst, value...), nil
+	}
+	c.stats.Misses++
+	c.mutex.Unlock()
+
+	//Render the translation and cache it
+	startLen := len(dst)
+	dst, err := l.appendRender(dst, index, pluralCount, 0, args)
+	if err != nil {
+		return dst, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 {
+		c.entries[key] = c.lru.PushFront(&renderCacheEntry{key, string(dst[startLen:])})
+		c.evict()
+	}
+	return dst, nil
+}
+
+// The tag of TransIndex arguments in render cache keys. It is above all reflect.Kind values, so a TransIndex is not keyed the same as an integer of its kind
+const renderCacheTransIndexTag = byte(reflect.UnsafePointer) + 1
+
+// Appends the encoded argument (its kind, or renderCacheTransIndexTag, and value) to the key. ok=false if the argument’s type cannot be cached
+func appendRenderCacheArg(key []byte, arg interface{}) (_ []byte, ok bool) {
+	switch v := arg.(type) {
+	case TransIndex:
+		return binary.AppendUvarint(append(key, renderCacheTransIndexTag), uint64(v)), true
+	case string:
+		key = binary.AppendUvarint(append(key, byte(reflect.String)), uint64(len(v)))
+		return append(key, v...), true
+	case bool:
+		return append(key, byte(reflect.Bool), cond(v, byte(1), byte(0))), true
+	case int, int8, int16, int32, int64:
+		return binary.AppendVarint(append(key, byte(reflect.TypeOf(v).Kind())), reflect.ValueOf(v).Int()), true
+	case uint, uint8, uint16, uint32, uint64:
+		return binary.AppendUvarint(append(key, byte(reflect.TypeOf(v).Kind())), reflect.ValueOf(v).Uint()), true
+	case float32:
+		return binary.AppendUvarint(append(key, byte(reflect.Float32)), uint64(math.Float32bits(v))), true
+	case float64:
+		return binary.AppendUvarint(append(key, byte(reflect.Float64)), math.Float64bits(v)), true
+	default:
+		return key, false
+	}
+}
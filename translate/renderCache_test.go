@@ -0,0 +1,25 @@
+//Tests of the render cache keys
+
+package translate
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Arguments of different types with the same value must have different keys
+func TestRenderCacheArgKeys(t *testing.T) {
+	args := []interface{}{TransIndex(5), uint32(5), uint16(5), uint(5), int(5), "\x05"}
+	keys := make([][]byte, len(args))
+	for i, arg := range args {
+		var ok bool
+		if keys[i], ok = appendRenderCacheArg(nil, arg); !ok {
+			t.Fatalf("%T cannot be cached", arg)
+		}
+		for j := 0; j < i; j++ {
+			if bytes.Equal(keys[i], keys[j]) {
+				t.Errorf("%T and %T have the same key", args[i], args[j])
+			}
+		}
+	}
+}
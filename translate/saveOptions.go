@@ -0,0 +1,62 @@
+//Options for saving compiled files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"compress/gzip"
+	"crypto/cipher"
+	"io"
+)
+
+// SaveOption changes how compiled files are saved. See the Save*() functions
+type SaveOption func(so *saveOptions)
+
+type saveOptions struct {
+	compressionLevel int
+	encryptionKey    []byte
+}
+
+// WithCompressionLevel sets the gzip compression level of compressed files, from gzip.BestSpeed (1) to gzip.BestCompression (9). The default is gzip.DefaultCompression. It has no effect on files that are not compressed
+func WithCompressionLevel(level int) SaveOption {
+	return func(so *saveOptions) {
+		so.compressionLevel = level
+	}
+}
+
+// WithEncryptionKey encrypts the translation strings of saved language files with AES-GCM, so their contents cannot be read without the key. The key must be 16, 24, or 32 bytes (for AES-128, AES-192, or AES-256), and is given to Dictionary.SetDecryptionKey() to load the files. The header, settings, and rules are not encrypted. It has no effect on dictionary files, which do not hold translation strings
+func WithEncryptionKey(key []byte) SaveOption {
+	return func(so *saveOptions) {
+		so.encryptionKey = key
+	}
+}
+
+// Returns the options with their defaults filled in
+func getSaveOptions(options []SaveOption) saveOptions {
+	so := saveOptions{compressionLevel: gzip.DefaultCompression}
+	for _, option := range options {
+		option(&so)
+	}
+	return so
+}
+
+// Returns the cipher that language files’ translation strings are encrypted with, or nil if they are not encrypted
+func (so saveOptions) encryption() (cipher.AEAD, error) {
+	if so.encryptionKey == nil {
+		return nil, nil
+	}
+	return newStringsCipher(so.encryptionKey)
+}
+
+// Returns the writer to save a file to, which gzip compresses at the options’ compression level if isCompressed. The returned function must be called after the file is written
+func newSaveWriter(w io.Writer, isCompressed bool, options []SaveOption) (io.Writer, func(), error) {
+	if !isCompressed {
+		return w, func() {}, nil
+	}
+
+	if gw, err := gzip.NewWriterLevel(w, getSaveOptions(options).compressionLevel); err != nil {
+		return nil, nil, err
+	} else {
+		return gw, func() { _ = gw.Close() }, nil
+	}
+}
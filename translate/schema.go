@@ -0,0 +1,115 @@
+//Argument schemas of translations
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TranslationSchema is the ordered list of variables a translation takes as arguments, so frameworks can validate arguments (or build forms for them) before calling the Get() functions
+type TranslationSchema struct {
+	Index         TransIndex
+	Namespace     string
+	TranslationID string
+	Variables     []SchemaVariable //In the order they are passed to the Get() functions
+}
+
+// SchemaVariable is a variable of a TranslationSchema
+type SchemaVariable struct {
+	Name string
+	Type string //The variable type name from the translation text file (like “Currency” or “DateTime”)
+}
+
+// Schema returns the argument schema of the Translation ID. The dictionary’s variables must be loaded or registered to load lazily (see Dictionary.HasVarsLoaded()).
+//
+// The schemas of all Translation IDs are built on the first call and cached in the dictionary, so they are shared between its languages. The returned Variables must not be modified
+func (l *Language) Schema(index TransIndex) (TranslationSchema, error) {
+	if err := l.dict.loadVars(); err != nil {
+		return TranslationSchema{}, err
+	}
+
+	//Build the schemas if they are not already cached
+	l.dict.schemaMutex.Lock()
+	if l.dict.schemas == nil {
+		l.dict.schemas = l.dict.buildSchemas()
+	}
+	schemas := l.dict.schemas
+	l.dict.schemaMutex.Unlock()
+
+	if int(index) >= len(schemas) {
+		return TranslationSchema{}, fmt.Errorf("Invalid index location: %d", index)
+	}
+	return schemas[index], nil
+}
+
+// SchemaNamed is the same as Schema() with a namespace and Translation ID
+func (l *Language) SchemaNamed(namespace, translationID string) (TranslationSchema, error) {
+	if n, ok := l.dict.namespaces[namespace]; !ok {
+		return TranslationSchema{}, errors.New("Invalid namespace")
+	} else if index, ok := n.ids[translationID]; !ok {
+		return TranslationSchema{}, errors.New("Invalid Translation ID")
+	} else {
+		return l.Schema(index)
+	}
+}
+
+// Returns the schemas of all Translation IDs in TransIndex order. The variables must be loaded
+func (dict *languageDict) buildSchemas() []TranslationSchema {
+	var schemas []TranslationSchema
+	for _, namespaceName := range dict.namespacesInOrder {
+		for _, translationID := range dict.namespaces[namespaceName].idsInOrder {
+			variables := make([]SchemaVariable, len(translationID.vars))
+			for i, v := range translationID.vars {
+				variables[i] = SchemaVariable{v.name, variableTypeNames[v.varType]}
+			}
+			schemas = append(schemas, TranslationSchema{TransIndex(len(schemas)), namespaceName, translationID.name, variables})
+		}
+	}
+	return schemas
+}
+
+// Args returns the values of the map in the order of the schema’s variables, to pass to the Get() functions. It errors if any variables are missing from the map, or the map has names that are not variables. The types of the values are checked by the Get() functions
+func (s TranslationSchema) Args(values map[string]interface{}) ([]interface{}, error) {
+	args := make([]interface{}, len(s.Variables))
+	var missing []string
+	for i, v := range s.Variables {
+		if val, ok := values[v.Name]; ok {
+			args[i] = val
+		} else {
+			missing = append(missing, v.Name)
+		}
+	}
+
+	var unknown []string
+	for name := range values {
+		if !s.HasVariable(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	var errs []string
+	if len(missing) != 0 {
+		errs = append(errs, "Missing variables: "+strings.Join(missing, ", "))
+	}
+	if len(unknown) != 0 {
+		errs = append(errs, "Unknown variables: "+strings.Join(unknown, ", "))
+	}
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("%s.%s: %s", s.Namespace, s.TranslationID, strings.Join(errs, "; "))
+	}
+	return args, nil
+}
+
+// HasVariable returns if the schema has a variable with the name
+func (s TranslationSchema) HasVariable(name string) bool {
+	for _, v := range s.Variables {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
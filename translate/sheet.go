@@ -0,0 +1,244 @@
+//Export translation text files as spreadsheets for translators
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SheetFormat is the file format written by ExportSheet()
+type SheetFormat uint8
+
+const (
+	SF_CSV  SheetFormat = iota //UTF-8 CSV (with a byte order mark so spreadsheet programs detect the encoding)
+	SF_XLSX                    //Office Open XML spreadsheet
+)
+
+// SheetLanguage is a language’s translation text file for ExportSheet()
+type SheetLanguage struct {
+	File io.Reader
+	Type LanguageTextFile
+}
+
+// A row of the spreadsheet
+type sheetRow struct {
+	cells        []string
+	untranslated []bool //Per cell
+}
+
+// ExportSheet writes a spreadsheet of the translations of the languages, for translators that do not work with translation text files. The first language must be the default language, which gives the order of the Translation IDs.
+//
+// The columns are: Namespace, Translation ID, Rule, Variables (their names, types, and example values), a column per language, and Untranslated. There is a row per Translation ID, or a row per plural rule for Translation IDs that have plural rules (the rules of all languages are included). The Rule column is blank for Translation IDs that only have a ^ rule.
+//
+// A language’s cells are blank if it does not have the Translation ID, and the language is listed in the Untranslated column. In XLSX files, these cells are also highlighted.
+func ExportSheet(w io.Writer, format SheetFormat, languages []SheetLanguage) error {
+	//Read the files
+	if len(languages) == 0 {
+		return errors.New("The default language is required")
+	}
+	langObjs := make([]tpMap, len(languages))
+	header := []string{"Namespace", "Translation ID", "Rule", "Variables"}
+	for i, lang := range languages {
+		var langIdent string
+		var err error
+		if langObjs[i], _, langIdent, err = readTextFileTop(lang.File, lang.Type); err != nil {
+			return fmt.Errorf("Language #%d: %s", i+1, err.Error())
+		}
+		header = append(header, langIdent)
+	}
+	header = append(header, "Untranslated")
+
+	//Create the rows from the default language’s Translation IDs
+	rows := []sheetRow{{cells: header, untranslated: make([]bool, len(header))}}
+	for _, nsItem := range langObjs[0].toOrdered() {
+		nsName := nsItem.getName()
+		nsObj, ok := nsItem.getObject()
+		if nsName == "Settings" || !ok {
+			continue
+		}
+
+		for _, tidItem := range nsObj.toOrdered() {
+			tidName := tidItem.getName()
+			if tidName == namespaceMetadataName {
+				continue
+			}
+			path := nsName + "." + tidName
+
+			//Get the rules of each language. A nil rules list means the Translation ID is missing
+			var vars []textProp
+			langRules := make([][]textProp, len(languages))
+			for i, langObj := range langObjs {
+				item, ok := tpItem(tidItem), i == 0
+				if i != 0 {
+					if langNsItem, _ok := langObj.getValue(nsName); _ok {
+						if langNsObj, _ok := langNsItem.getObject(); _ok {
+							item, ok = langNsObj.getValue(tidName)
+						}
+					}
+				}
+				if !ok {
+					continue
+				}
+				rules, _vars, err := readTranslationIDProps(item, path)
+				if err != nil {
+					return fmt.Errorf("%s: %s", header[4+i], err.Error())
+				} else if i == 0 {
+					vars = _vars
+				}
+				langRules[i] = cond(rules == nil, []textProp{}, rules)
+			}
+
+			//Get the rules of all languages, in order of first appearance
+			var ruleNames []string
+			for _, rules := range langRules {
+				for _, rule := range rules {
+					found := false
+					for _, ruleName := range ruleNames {
+						found = found || ruleName == rule.name
+					}
+					if !found {
+						ruleNames = append(ruleNames, rule.name)
+					}
+				}
+			}
+
+			//Get the variables and untranslated languages
+			varNames := make([]string, 0, len(vars))
+			for _, v := range vars {
+				varNames = append(varNames, variableDoc(v))
+			}
+			var untranslated []string
+			for i, rules := range langRules {
+				if rules == nil {
+					untranslated = append(untranslated, header[4+i])
+				}
+			}
+
+			//Add a row per rule
+			for _, ruleName := range ruleNames {
+				row := sheetRow{
+					cells:        []string{nsName, tidName, cond(len(ruleNames) == 1 && ruleName == "^", "", ruleName), strings.Join(varNames, ", ")},
+					untranslated: make([]bool, len(header)),
+				}
+				for i, rules := range langRules {
+					text := ""
+					for _, rule := range rules {
+						if rule.name == ruleName {
+							text = rule.value
+						}
+					}
+					row.cells = append(row.cells, text)
+					row.untranslated[4+i] = rules == nil
+				}
+				row.cells = append(row.cells, strings.Join(untranslated, ", "))
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	//Write the spreadsheet
+	if format == SF_XLSX {
+		return writeXLSX(w, rows)
+	}
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	for _, row := range rows {
+		if err := cw.Write(row.cells); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// The static parts of a written XLSX file
+//
+//goland:noinspection HttpUrlsUsage
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Translations" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`
+	xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts><fills count="3"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill><fill><patternFill patternType="solid"><fgColor rgb="FFFFEB9C"/><bgColor indexed="64"/></patternFill></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/><xf numFmtId="0" fontId="0" fillId="2" borderId="0" xfId="0" applyFill="1"/></cellXfs></styleSheet>`
+)
+
+// Cell styles of xlsxStyles
+const (
+	xlsxStyleHeader       = 1
+	xlsxStyleUntranslated = 2
+)
+
+// Writes the rows as an XLSX file with a single worksheet. The first row is the header, which is bold and frozen
+func writeXLSX(w io.Writer, rows []sheetRow) error {
+	//Create the worksheet
+	var sheet strings.Builder
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews><sheetData>`)
+	for rowIndex, row := range rows {
+		rowNum := strconv.Itoa(rowIndex + 1)
+		sheet.WriteString(`<row r="` + rowNum + `">`)
+		for colIndex, cell := range row.cells {
+			style := 0
+			if rowIndex == 0 {
+				style = xlsxStyleHeader
+			} else if row.untranslated[colIndex] {
+				style = xlsxStyleUntranslated
+			}
+			sheet.WriteString(`<c r="` + xlsxColumnName(colIndex) + rowNum + `"`)
+			if style != 0 {
+				sheet.WriteString(` s="` + strconv.Itoa(style) + `"`)
+			}
+			if len(cell) == 0 {
+				sheet.WriteString(`/>`)
+				continue
+			}
+			sheet.WriteString(` t="inlineStr"><is><t xml:space="preserve">`)
+			_ = xml.EscapeText(&sheet, []byte(cell))
+			sheet.WriteString(`</t></is></c>`)
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	//Write the package
+	zw := zip.NewWriter(w)
+	for _, file := range []struct{ name, contents string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+		{"xl/worksheets/sheet1.xml", sheet.String()},
+	} {
+		if fw, err := zw.Create(file.name); err != nil {
+			return err
+		} else if _, err := io.WriteString(fw, file.contents); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// Returns the spreadsheet column name (A, B, …, Z, AA, …) of a 0 based column index
+func xlsxColumnName(colIndex int) string {
+	name := ""
+	for colIndex++; colIndex > 0; colIndex = (colIndex - 1) / 26 {
+		name = string(rune('A'+(colIndex-1)%26)) + name
+	}
+	return name
+}
@@ -0,0 +1,459 @@
+//Import spreadsheets from translators back into translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ImportedSheet is a spreadsheet written by ExportSheet() that was read through ReadSheet()
+type ImportedSheet struct {
+	languages []string //The language identifiers of the language columns. The first is the default language
+	rows      []importedSheetRow
+}
+
+// A row of an ImportedSheet
+type importedSheetRow struct {
+	nsName, tidName, rule string
+	texts                 []string //Per language column
+	untranslated          []string //The languages that did not have the Translation ID when the sheet was exported
+}
+
+// Languages returns the language identifiers of the language columns of the spreadsheet. The first is the default language
+func (sheet *ImportedSheet) Languages() []string {
+	return append([]string(nil), sheet.languages...)
+}
+
+// ReadSheet reads a spreadsheet written by ExportSheet() (which may have since been edited by translators). The columns are found through the header row, so they can be reordered, and columns that are not language columns can be added. Only the first worksheet of XLSX files is read
+func ReadSheet(r io.Reader, format SheetFormat) (*ImportedSheet, error) {
+	//Read the cells
+	var cells [][]string
+	var err error
+	if format == SF_XLSX {
+		cells, err = readXLSX(r)
+	} else {
+		cReader := csv.NewReader(r)
+		cReader.FieldsPerRecord = -1
+		if cells, err = cReader.ReadAll(); err == nil && len(cells) != 0 && len(cells[0]) != 0 {
+			cells[0][0] = strings.TrimPrefix(cells[0][0], "\uFEFF")
+		}
+	}
+	if err != nil {
+		return nil, errors.New("Error parsing spreadsheet: " + err.Error())
+	} else if len(cells) == 0 {
+		return nil, errors.New("The spreadsheet is empty")
+	}
+
+	//Find the columns from the header
+	const notFound = -1
+	nsCol, tidCol, ruleCol, untranslatedCol := notFound, notFound, notFound, notFound
+	var langCols []int
+	sheet := &ImportedSheet{}
+	for i, name := range cells[0] {
+		switch name = strings.TrimSpace(name); name {
+		case "Namespace":
+			nsCol = i
+		case "Translation ID":
+			tidCol = i
+		case "Rule":
+			ruleCol = i
+		case "Untranslated":
+			untranslatedCol = i
+		case "Variables", "":
+		default:
+			langCols = append(langCols, i)
+			sheet.languages = append(sheet.languages, name)
+		}
+	}
+	if nsCol == notFound || tidCol == notFound || ruleCol == notFound || untranslatedCol == notFound {
+		return nil, errors.New("The header row must have “Namespace”, “Translation ID”, “Rule”, and “Untranslated” columns")
+	} else if len(langCols) == 0 {
+		return nil, errors.New("The header row does not have any language columns")
+	}
+
+	//Read the rows
+	getCell := func(row []string, col int) string {
+		if col < len(row) {
+			return row[col]
+		}
+		return ""
+	}
+	for _, cellRow := range cells[1:] {
+		row := importedSheetRow{
+			nsName:  strings.TrimSpace(getCell(cellRow, nsCol)),
+			tidName: strings.TrimSpace(getCell(cellRow, tidCol)),
+			rule:    strings.TrimSpace(getCell(cellRow, ruleCol)),
+		}
+		if len(row.nsName) == 0 || len(row.tidName) == 0 {
+			continue
+		}
+		if len(row.rule) == 0 {
+			row.rule = "^"
+		}
+		for _, col := range langCols {
+			row.texts = append(row.texts, getCell(cellRow, col))
+		}
+		for _, langIdent := range strings.Split(getCell(cellRow, untranslatedCol), ",") {
+			if langIdent = strings.TrimSpace(langIdent); len(langIdent) != 0 {
+				row.untranslated = append(row.untranslated, langIdent)
+			}
+		}
+		sheet.rows = append(sheet.rows, row)
+	}
+
+	return sheet, nil
+}
+
+// The import format of spreadsheets, which are keyed by “Namespace.TranslationID” and already hold gol10n translation strings
+var sheetImportFormat = mobileImportFormat{
+	key: func(nsName, tidName string) string { return nsName + "." + tidName },
+	convertArgs: func(text, _ string, _ []textProp, _ bool, _ map[string]varFormat) (string, error) {
+		return text, nil
+	},
+	hasRule: func(string) bool { return true },
+}
+
+// ImportSheet creates the language text file from the language’s column of a spreadsheet (see ReadSheet()). The default language’s column cannot be imported.
+//
+// The Translation IDs are in the order of the source (default language) file, with its variables. Translation IDs with blank cells for the language are taken from the target file (if given). Translation IDs that cannot be safely imported are kept as they are in the target file, and returned as conflicts. Conflicts are when:
+//   - The Translation ID is not in the default language
+//   - The default language’s text in the spreadsheet does not match the source file (it changed since the spreadsheet was exported)
+//   - The language did not have the Translation ID when the spreadsheet was exported, but the target file now has a different translation
+//   - The translation uses a variable that the default language does not have
+func (lf LanguageTextFile) ImportSheet(sheet *ImportedSheet, languageIdentifier string, sourceFile io.Reader, sourceType LanguageTextFile, targetFile io.Reader, targetType LanguageTextFile) (fileText []byte, conflicts []string, err error) {
+	//Get the language’s column
+	langCol := -1
+	for i, langIdent := range sheet.languages {
+		if langIdent == languageIdentifier {
+			langCol = i
+		}
+	}
+	if langCol == -1 {
+		return nil, nil, fmt.Errorf("The spreadsheet does not have a “%s” column", languageIdentifier)
+	} else if langCol == 0 {
+		return nil, nil, errors.New("The default language’s column cannot be imported")
+	}
+
+	//Read the files. They are also read again when the file is created
+	sourceData, err := io.ReadAll(sourceFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	srcObj, _, _, err := readTextFileTop(bytes.NewReader(sourceData), sourceType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Source file: %s", err.Error())
+	}
+	var targetData []byte
+	var existingObj tpMap
+	if targetFile != nil {
+		if targetData, err = io.ReadAll(targetFile); err != nil {
+			return nil, nil, err
+		} else if existingObj, _, _, err = readTextFileTop(bytes.NewReader(targetData), targetType); err != nil {
+			return nil, nil, fmt.Errorf("Target file: %s", err.Error())
+		}
+	}
+	getTranslationID := func(obj tpMap, nsName, tidName string) tpItem {
+		if obj == nil {
+			return nil
+		} else if nsItem, ok := obj.getValue(nsName); !ok {
+			return nil
+		} else if nsObj, ok := nsItem.getObject(); !ok {
+			return nil
+		} else if tidItem, ok := nsObj.getValue(tidName); !ok {
+			return nil
+		} else {
+			return tidItem
+		}
+	}
+
+	//Group the rows by Translation ID, in order of first appearance
+	var keys []string
+	rowsByKey := make(map[string][]importedSheetRow)
+	for _, row := range sheet.rows {
+		key := sheetImportFormat.key(row.nsName, row.tidName)
+		if _, ok := rowsByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		rowsByKey[key] = append(rowsByKey[key], row)
+	}
+
+	//Get the translations that can be imported
+	initTextProcessing()
+	translations := make(map[string][]textProp)
+	for _, key := range keys {
+		rows := rowsByKey[key]
+		nsName, tidName := rows[0].nsName, rows[0].tidName
+
+		//Get the default and imported rules from the spreadsheet
+		var sheetSrcRules, rules []textProp
+		wasUntranslated := false
+		for _, row := range rows {
+			if len(row.texts[0]) != 0 {
+				sheetSrcRules = append(sheetSrcRules, textProp{row.rule, row.texts[0]})
+			}
+			if len(row.texts[langCol]) != 0 {
+				rules = append(rules, textProp{row.rule, row.texts[langCol]})
+			}
+			for _, langIdent := range row.untranslated {
+				wasUntranslated = wasUntranslated || langIdent == languageIdentifier
+			}
+		}
+		if len(rules) == 0 {
+			continue
+		}
+
+		//Skip translations that did not change
+		var existingRules []textProp
+		if existingItem := getTranslationID(existingObj, nsName, tidName); existingItem != nil {
+			if existingRules, _, err = readTranslationIDProps(existingItem, key); err != nil {
+				return nil, nil, fmt.Errorf("Target file: %s", err.Error())
+			}
+		}
+		if textPropsEqual(existingRules, rules) {
+			continue
+		}
+
+		//Compare against the default language
+		srcItem := getTranslationID(srcObj, nsName, tidName)
+		if srcItem == nil {
+			conflicts = append(conflicts, fmt.Sprintf("%s: Not in the default language", key))
+			continue
+		}
+		srcRules, vars, err := readTranslationIDProps(srcItem, key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Source file: %s", err.Error())
+		} else if !textPropsEqual(srcRules, sheetSrcRules) {
+			conflicts = append(conflicts, fmt.Sprintf("%s: Changed in the default language since the spreadsheet was exported", key))
+			continue
+		}
+
+		//The language’s file was translated by someone else after the export
+		if wasUntranslated && existingRules != nil {
+			conflicts = append(conflicts, fmt.Sprintf("%s: Translated in the language file since the spreadsheet was exported", key))
+			continue
+		}
+
+		//Make sure only the default language’s variables are used
+		varNames := map[string]bool{pluralCountName: true}
+		for _, v := range vars {
+			varNames[v.name] = true
+		}
+		var unknownVars []string
+		for _, rule := range rules {
+			for _, match := range regexReplaceVariables.FindAllStringSubmatch(rule.value, -1) {
+				if !varNames[match[1]] {
+					unknownVars = append(unknownVars, match[1])
+				}
+			}
+		}
+		if len(unknownVars) != 0 {
+			conflicts = append(conflicts, fmt.Sprintf("%s: Variables not in the default language: %s", key, strings.Join(unknownVars, ", ")))
+			continue
+		}
+
+		translations[key] = orderImportedRules(rules, existingRules)
+	}
+
+	//Create the file
+	var targetReader io.Reader
+	if targetFile != nil {
+		targetReader = bytes.NewReader(targetData)
+	}
+	fileText, _, err = lf.importMobileTranslations(translations, sheetImportFormat, languageIdentifier, bytes.NewReader(sourceData), sourceType, targetReader, targetType)
+	return fileText, conflicts, err
+}
+
+// Returns if 2 lists of properties have the same names and values, in any order
+func textPropsEqual(a, b []textProp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	values := make(map[string]string, len(a))
+	for _, prop := range a {
+		values[prop.name] = prop.value
+	}
+	for _, prop := range b {
+		if value, ok := values[prop.name]; !ok || value != prop.value {
+			return false
+		}
+	}
+	return true
+}
+
+// Orders imported rules by the existing rules’ order, followed by the new rules. The ^ rule is always last
+func orderImportedRules(rules, existingRules []textProp) []textProp {
+	ordered := make([]textProp, 0, len(rules))
+	isAdded := make(map[string]bool, len(rules))
+	for _, list := range [][]textProp{existingRules, rules} {
+		for _, existingRule := range list {
+			for _, rule := range rules {
+				if rule.name == existingRule.name && rule.name != "^" && !isAdded[rule.name] {
+					ordered = append(ordered, rule)
+					isAdded[rule.name] = true
+				}
+			}
+		}
+	}
+	for _, rule := range rules {
+		if rule.name == "^" {
+			ordered = append(ordered, rule)
+		}
+	}
+	return ordered
+}
+
+// The parts of XLSX files that are read
+type (
+	xlsxRichText struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	}
+	xlsxWorkbookFile struct {
+		Sheets []struct {
+			RelationshipID string `xml:"id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	xlsxRelationshipsFile struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	xlsxSharedStringsFile struct {
+		Items []xlsxRichText `xml:"si"`
+	}
+	xlsxWorksheetFile struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string       `xml:"r,attr"`
+				Type   string       `xml:"t,attr"`
+				Value  string       `xml:"v"`
+				Inline xlsxRichText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+)
+
+// Returns the text of a rich text element, which is either a single text or a list of runs
+func (rt xlsxRichText) String() string {
+	text := rt.Text
+	for _, run := range rt.Runs {
+		text += run.Text
+	}
+	return text
+}
+
+// Reads the cells of the first worksheet of an XLSX file
+func readXLSX(r io.Reader) ([][]string, error) {
+	//Open the package
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	readPart := func(name string, v interface{}, required bool) error {
+		f, err := zr.Open(name)
+		if err != nil {
+			if required {
+				return fmt.Errorf("Could not open “%s”: %s", name, err.Error())
+			}
+			return nil
+		}
+		defer func() { _ = f.Close() }()
+		if err := xml.NewDecoder(f).Decode(v); err != nil {
+			return fmt.Errorf("Could not parse “%s”: %s", name, err.Error())
+		}
+		return nil
+	}
+
+	//Find the first worksheet
+	var workbook xlsxWorkbookFile
+	var workbookRels xlsxRelationshipsFile
+	if err := readPart("xl/workbook.xml", &workbook, true); err != nil {
+		return nil, err
+	} else if err := readPart("xl/_rels/workbook.xml.rels", &workbookRels, true); err != nil {
+		return nil, err
+	} else if len(workbook.Sheets) == 0 {
+		return nil, errors.New("The workbook does not have any worksheets")
+	}
+	sheetPath := ""
+	for _, rel := range workbookRels.Relationships {
+		if rel.ID == workbook.Sheets[0].RelationshipID {
+			if strings.HasPrefix(rel.Target, "/") {
+				sheetPath = rel.Target[1:]
+			} else {
+				sheetPath = path.Join("xl", rel.Target)
+			}
+		}
+	}
+	if len(sheetPath) == 0 {
+		return nil, errors.New("The first worksheet was not found")
+	}
+
+	//Read the shared strings and the worksheet
+	var sharedStrings xlsxSharedStringsFile
+	var worksheet xlsxWorksheetFile
+	if err := readPart("xl/sharedStrings.xml", &sharedStrings, false); err != nil {
+		return nil, err
+	} else if err := readPart(sheetPath, &worksheet, true); err != nil {
+		return nil, err
+	}
+
+	//Get the cell values
+	cells := make([][]string, 0, len(worksheet.Rows))
+	for _, row := range worksheet.Rows {
+		var cellRow []string
+		for _, cell := range row.Cells {
+			//Get the column from the cell reference, or the next column if there is none
+			colIndex := len(cellRow)
+			if len(cell.Ref) != 0 {
+				colIndex = xlsxColumnIndex(cell.Ref)
+			}
+			for len(cellRow) <= colIndex {
+				cellRow = append(cellRow, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				if index, err := strconv.Atoi(cell.Value); err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("Cell %s: Invalid shared string “%s”", cell.Ref, cell.Value)
+				} else {
+					cellRow[colIndex] = sharedStrings.Items[index].String()
+				}
+			case "inlineStr":
+				cellRow[colIndex] = cell.Inline.String()
+			default:
+				cellRow[colIndex] = cell.Value
+			}
+		}
+		cells = append(cells, cellRow)
+	}
+	return cells, nil
+}
+
+// Returns the 0 based column index of a cell reference (A1, B1, …, AA1, …)
+func xlsxColumnIndex(ref string) int {
+	index := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		index = index*26 + int(c-'A'+1)
+	}
+	return index - 1
+}
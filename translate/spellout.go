@@ -0,0 +1,510 @@
+//Spell out numbers as cardinal or ordinal words, and write ordinal numbers
+
+package translate
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// Capitalization options for spelled out numbers
+type spelloutCase uint8
+
+const (
+	scLower spelloutCase = iota
+	scUpper
+	scTitle
+	scSentence
+)
+
+// Options for spelled out numbers and ordinals
+type spelloutOptions struct {
+	capitalization spelloutCase
+	isOrdinal      bool //Spell out as an ordinal (first) instead of a cardinal (one)
+	isFeminine     bool //Use the feminine form of ordinals (only for languages that have one)
+}
+
+// Bit flags for spelloutOptions in compiled specifiers
+const (
+	soOrdinal  = 1 << 0
+	soFeminine = 1 << 1
+)
+
+// Holds the spellout functions per base language
+var spelloutLanguages = map[string]struct {
+	negative      string
+	spell         func(n uint64) string
+	spellOrdinal  func(n uint64, isFeminine bool) (string, bool) //ok=false if the number is not supported
+	ordinalSuffix func(n uint64, isFeminine bool) string
+}{
+	"en": {"minus", spellEnglish, spellEnglishOrdinal, englishOrdinalSuffix},
+	"fr": {"moins", spellFrench, spellFrenchOrdinal, frenchOrdinalSuffix},
+	"de": {"minus", spellGerman, spellGermanOrdinal, germanOrdinalSuffix},
+	"es": {"menos", spellSpanish, spellSpanishOrdinal, spanishOrdinalSuffix},
+}
+
+// Returns if the language of the tag has spellout rules (see spelloutLanguages)
+func hasSpelloutRules(tag language.Tag) bool {
+	base, _ := tag.Base()
+	_, ok := spelloutLanguages[base.String()]
+	return ok
+}
+
+// Returns the number spelled out as words in the language of the tag. ok=false if the language (or an ordinal of the number) is not supported
+func spellNumber(tag language.Tag, n int64, opts spelloutOptions) (str string, ok bool) {
+	//Find the language
+	base, _ := tag.Base()
+	spellLang, ok := spelloutLanguages[base.String()]
+	if !ok {
+		return returnBlankStrOnErr, false
+	}
+
+	//Spell out the number
+	switch {
+	case opts.isOrdinal && n < 0:
+		return returnBlankStrOnErr, false
+	case opts.isOrdinal:
+		if str, ok = spellLang.spellOrdinal(uint64(n), opts.isFeminine); !ok {
+			return returnBlankStrOnErr, false
+		}
+	case n < 0:
+		str = spellLang.negative + " " + spellLang.spell(uint64(-(n + 1))+1)
+	default:
+		str = spellLang.spell(uint64(n))
+	}
+
+	//Handle capitalization
+	switch opts.capitalization {
+	case scUpper:
+		str = cases.Upper(tag).String(str)
+	case scTitle:
+		str = cases.Title(tag).String(str)
+	case scSentence:
+		firstLetter := []rune(str)[0:1]
+		str = cases.Upper(tag).String(string(firstLetter)) + str[len(string(firstLetter)):]
+	}
+
+	return str, true
+}
+
+// Returns the suffix written after the digits of an ordinal number (like “st” in “1st”) in the language of the tag. ok=false if the language is not supported
+func ordinalSuffix(tag language.Tag, n int64, isFeminine bool) (suffix string, ok bool) {
+	base, _ := tag.Base()
+	if spellLang, ok := spelloutLanguages[base.String()]; !ok {
+		return returnBlankStrOnErr, false
+	} else if n < 0 {
+		return spellLang.ordinalSuffix(uint64(-(n + 1))+1, isFeminine), true
+	} else {
+		return spellLang.ordinalSuffix(uint64(n), isFeminine), true
+	}
+}
+
+//-----------------------------------English------------------------------------
+
+var (
+	englishOnes  = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScale = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+func spellEnglish(n uint64) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+
+	under1000 := func(n uint64) string {
+		var parts []string
+		if n >= 100 {
+			parts = append(parts, englishOnes[n/100]+" hundred")
+			n %= 100
+		}
+		if n >= 20 {
+			if n%10 != 0 {
+				parts = append(parts, englishTens[n/10]+"-"+englishOnes[n%10])
+			} else {
+				parts = append(parts, englishTens[n/10])
+			}
+		} else if n > 0 {
+			parts = append(parts, englishOnes[n])
+		}
+		return strings.Join(parts, " ")
+	}
+
+	var parts []string
+	for scale := len(englishScale) - 1; scale >= 0; scale-- {
+		scaleVal := pow1000(scale)
+		if group := n / scaleVal % 1000; group != 0 {
+			parts = append(parts, strings.TrimSpace(under1000(group)+" "+englishScale[scale]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Irregular English ordinal words
+var englishOrdinals = map[string]string{"one": "first", "two": "second", "three": "third", "five": "fifth", "eight": "eighth", "nine": "ninth", "twelve": "twelfth"}
+
+func spellEnglishOrdinal(n uint64, _ bool) (string, bool) {
+	//Only the last word changes
+	str := spellEnglish(n)
+	lastWordStart := strings.LastIndexAny(str, " -") + 1
+	lastWord := str[lastWordStart:]
+	switch {
+	case englishOrdinals[lastWord] != "":
+		lastWord = englishOrdinals[lastWord]
+	case strings.HasSuffix(lastWord, "y"):
+		lastWord = lastWord[:len(lastWord)-1] + "ieth"
+	default:
+		lastWord += "th"
+	}
+	return str[:lastWordStart] + lastWord, true
+}
+
+func englishOrdinalSuffix(n uint64, _ bool) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+//------------------------------------French------------------------------------
+
+var (
+	frenchOnes  = []string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix", "onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+	frenchTens  = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+	frenchScale = []string{"", "mille", "million", "milliard", "billion", "billiard", "trillion"}
+)
+
+func spellFrench(n uint64) string {
+	if n == 0 {
+		return frenchOnes[0]
+	}
+
+	//isFinal determines if “vingts” and “cents” can take their plural form (not followed by another number or “mille”)
+	under100 := func(n uint64, isFinal bool) string {
+		switch {
+		case n < 20:
+			return frenchOnes[n]
+		case n < 70:
+			switch n % 10 {
+			case 0:
+				return frenchTens[n/10]
+			case 1:
+				return frenchTens[n/10] + "-et-un"
+			default:
+				return frenchTens[n/10] + "-" + frenchOnes[n%10]
+			}
+		case n < 80:
+			if n == 71 {
+				return "soixante-et-onze"
+			}
+			return "soixante-" + frenchOnes[n-60]
+		case n == 80:
+			if isFinal {
+				return "quatre-vingts"
+			}
+			return "quatre-vingt"
+		default:
+			return "quatre-vingt-" + frenchOnes[n-80]
+		}
+	}
+	under1000 := func(n uint64, isFinal bool) string {
+		hundreds, rest := n/100, n%100
+		var str string
+		switch {
+		case hundreds == 0:
+			return under100(rest, isFinal)
+		case hundreds == 1:
+			str = "cent"
+		case rest == 0 && isFinal:
+			str = frenchOnes[hundreds] + " cents"
+		default:
+			str = frenchOnes[hundreds] + " cent"
+		}
+		if rest != 0 {
+			str += " " + under100(rest, isFinal)
+		}
+		return str
+	}
+
+	var parts []string
+	for scale := len(frenchScale) - 1; scale >= 0; scale-- {
+		group := n / pow1000(scale) % 1000
+		switch {
+		case group == 0:
+		case scale == 0:
+			parts = append(parts, under1000(group, true))
+		case scale == 1 && group == 1:
+			parts = append(parts, "mille")
+		case scale == 1:
+			parts = append(parts, under1000(group, false)+" mille")
+		case group == 1:
+			parts = append(parts, "un "+frenchScale[scale])
+		default:
+			parts = append(parts, under1000(group, true)+" "+frenchScale[scale]+"s")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func spellFrenchOrdinal(n uint64, isFeminine bool) (string, bool) {
+	if n == 1 {
+		return cond(isFeminine, "première", "premier"), true
+	}
+
+	//Plurals lose their “s” (except in numbers that always end in an “s”), and the final vowel is replaced by “ième”
+	str := spellFrench(n)
+	if strings.HasSuffix(str, "s") && !strings.HasSuffix(str, "trois") && !strings.HasSuffix(str, "six") {
+		str = str[:len(str)-1]
+	}
+	switch {
+	case strings.HasSuffix(str, "e"):
+		str = str[:len(str)-1]
+	case strings.HasSuffix(str, "cinq"):
+		str += "u"
+	case strings.HasSuffix(str, "neuf"):
+		str = str[:len(str)-1] + "v"
+	}
+	return str + "ième", true
+}
+
+func frenchOrdinalSuffix(n uint64, isFeminine bool) string {
+	if n == 1 {
+		return cond(isFeminine, "re", "er")
+	}
+	return "e"
+}
+
+//------------------------------------German------------------------------------
+
+var (
+	germanOnes  = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	germanTens  = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	germanScale = []struct{ singular, plural string }{{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"}, {"Billiarde", "Billiarden"}, {"Trillion", "Trillionen"}}
+)
+
+func spellGerman(n uint64) string {
+	if n == 0 {
+		return germanOnes[0]
+	}
+
+	//isFinal determines if a trailing 1 is written as “eins” instead of “ein”
+	under1000 := func(n uint64, isFinal bool) string {
+		var str string
+		if n >= 100 {
+			str = cond(n/100 == 1, "ein", germanOnes[n/100]) + "hundert"
+			n %= 100
+		}
+		switch {
+		case n == 0:
+		case n == 1:
+			str += cond(isFinal, "eins", "ein")
+		case n < 20:
+			str += germanOnes[n]
+		case n%10 == 0:
+			str += germanTens[n/10]
+		default:
+			str += cond(n%10 == 1, "ein", germanOnes[n%10]) + "und" + germanTens[n/10]
+		}
+		return str
+	}
+
+	var parts []string
+	for scale := len(germanScale) - 1; scale >= 2; scale-- {
+		if group := n / pow1000(scale) % 1000; group == 1 {
+			parts = append(parts, "eine "+germanScale[scale].singular)
+		} else if group != 0 {
+			parts = append(parts, under1000(group, true)+" "+germanScale[scale].plural)
+		}
+	}
+
+	//Numbers below 1 million are written as a single word
+	var lastPart string
+	if thousands := n / 1000 % 1000; thousands != 0 {
+		lastPart = under1000(thousands, false) + "tausend"
+	}
+	if rest := n % 1000; rest != 0 {
+		lastPart += under1000(rest, true)
+	}
+	if len(lastPart) != 0 {
+		parts = append(parts, lastPart)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func spellGermanOrdinal(n uint64, _ bool) (string, bool) {
+	if n == 0 {
+		return "nullte", true
+	}
+	str := spellGerman(n)
+
+	//Whole millions and above are written as a single lowercase word. Example: zweimillionste
+	if n%1_000_000 == 0 {
+		str = strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(str, "eine "), " ", ""))
+		for _, pluralSuffix := range []string{"en", "e"} {
+			if strings.HasSuffix(str, "ion"+pluralSuffix) || strings.HasSuffix(str, "iard"+pluralSuffix) {
+				str = str[:len(str)-len(pluralSuffix)]
+				break
+			}
+		}
+		return str + "ste", true
+	}
+
+	//Numbers ending in 1-19 take “te” (with some irregular forms), and all others take “ste”
+	for _, irregular := range []struct{ cardinal, ordinal string }{{"eins", "erste"}, {"drei", "dritte"}, {"sieben", "siebte"}, {"acht", "achte"}} {
+		if strings.HasSuffix(str, irregular.cardinal) {
+			return str[:len(str)-len(irregular.cardinal)] + irregular.ordinal, true
+		}
+	}
+	if rest := n % 100; rest >= 1 && rest < 20 {
+		return str + "te", true
+	}
+	return str + "ste", true
+}
+
+func germanOrdinalSuffix(uint64, bool) string {
+	return "."
+}
+
+//------------------------------------Spanish-----------------------------------
+
+var (
+	spanishOnes     = []string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve", "veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"}
+	spanishTens     = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	spanishHundreds = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+	spanishScale    = []struct{ singular, plural string }{{}, {"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"}}
+)
+
+func spellSpanish(n uint64) string {
+	if n == 0 {
+		return spanishOnes[0]
+	}
+
+	//isApocope determines if a trailing “uno” is shortened to “un” (when followed by a noun like “mil” or “millones”)
+	under1000 := func(n uint64, isApocope bool) string {
+		var parts []string
+		if n == 100 {
+			return "cien"
+		} else if n > 100 {
+			parts = append(parts, spanishHundreds[n/100])
+			n %= 100
+		}
+		switch {
+		case n == 0:
+		case n < 30:
+			parts = append(parts, spanishOnes[n])
+		case n%10 == 0:
+			parts = append(parts, spanishTens[n/10])
+		default:
+			parts = append(parts, spanishTens[n/10]+" y "+spanishOnes[n%10])
+		}
+
+		str := strings.Join(parts, " ")
+		if isApocope {
+			if strings.HasSuffix(str, "veintiuno") {
+				str = str[:len(str)-len("veintiuno")] + "veintiún"
+			} else if strings.HasSuffix(str, "uno") {
+				str = str[:len(str)-1]
+			}
+		}
+		return str
+	}
+	under1000000 := func(n uint64, isApocope bool) string {
+		thousands, rest := n/1000, n%1000
+		var parts []string
+		if thousands == 1 {
+			parts = append(parts, "mil")
+		} else if thousands != 0 {
+			parts = append(parts, under1000(thousands, true)+" mil")
+		}
+		if rest != 0 {
+			parts = append(parts, under1000(rest, isApocope))
+		}
+		return strings.Join(parts, " ")
+	}
+
+	//Spanish uses the long scale (groups of 1,000,000)
+	var parts []string
+	for scale := len(spanishScale) - 1; scale >= 0; scale-- {
+		scaleVal := pow1000(scale * 2)
+		group := n / scaleVal % 1_000_000
+		switch {
+		case group == 0:
+		case scale == 0:
+			parts = append(parts, under1000000(group, false))
+		case group == 1:
+			parts = append(parts, "un "+spanishScale[scale].singular)
+		default:
+			parts = append(parts, under1000000(group, true)+" "+spanishScale[scale].plural)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+var (
+	spanishOrdinalOnes     = []string{"", "primero", "segundo", "tercero", "cuarto", "quinto", "sexto", "séptimo", "octavo", "noveno", "décimo", "undécimo", "duodécimo", "decimotercero", "decimocuarto", "decimoquinto", "decimosexto", "decimoséptimo", "decimoctavo", "decimonoveno"}
+	spanishOrdinalTens     = []string{"", "", "vigésimo", "trigésimo", "cuadragésimo", "quincuagésimo", "sexagésimo", "septuagésimo", "octogésimo", "nonagésimo"}
+	spanishOrdinalHundreds = []string{"", "centésimo", "ducentésimo", "tricentésimo", "cuadringentésimo", "quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "noningentésimo"}
+)
+
+// Spanish ordinals are only supported below 1 million
+func spellSpanishOrdinal(n uint64, isFeminine bool) (string, bool) {
+	if n == 0 || n >= 1_000_000 {
+		return returnBlankStrOnErr, false
+	}
+
+	var parts []string
+	if thousands := n / 1000; thousands == 1 {
+		parts = append(parts, "milésimo")
+	} else if thousands != 0 {
+		//The multiplier is joined to “milésimo” with its trailing “uno” shortened. Example: dosmilésimo
+		cardinal := strings.ReplaceAll(spellSpanish(thousands), " ", "")
+		if strings.HasSuffix(cardinal, "uno") {
+			cardinal = cardinal[:len(cardinal)-1]
+		}
+		parts = append(parts, cardinal+"milésimo")
+	}
+	if hundreds := n / 100 % 10; hundreds != 0 {
+		parts = append(parts, spanishOrdinalHundreds[hundreds])
+	}
+	if rest := n % 100; rest >= 20 {
+		parts = append(parts, spanishOrdinalTens[rest/10])
+		if rest%10 != 0 {
+			parts = append(parts, spanishOrdinalOnes[rest%10])
+		}
+	} else if rest != 0 {
+		parts = append(parts, spanishOrdinalOnes[rest])
+	}
+
+	//The feminine form replaces the final “o” of every word with an “a”
+	if isFeminine {
+		for i, part := range parts {
+			parts[i] = part[:len(part)-1] + "a"
+		}
+	}
+	return strings.Join(parts, " "), true
+}
+
+func spanishOrdinalSuffix(_ uint64, isFeminine bool) string {
+	return cond(isFeminine, ".ª", ".º")
+}
+
+//------------------------------------Helpers-----------------------------------
+
+// Returns 1000^exp
+func pow1000(exp int) uint64 {
+	ret := uint64(1)
+	for i := 0; i < exp; i++ {
+		ret *= 1000
+	}
+	return ret
+}
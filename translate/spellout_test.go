@@ -0,0 +1,98 @@
+//Tests of spelled out and ordinal numbers
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSpellNumber(t *testing.T) {
+	for _, test := range []struct {
+		lang                        string
+		n                           int64
+		cardinal, ordinal, feminine string
+	}{
+		{"en", 0, "zero", "zeroth", "zeroth"},
+		{"en", 12, "twelve", "twelfth", "twelfth"},
+		{"en", 21, "twenty-one", "twenty-first", "twenty-first"},
+		{"en", 101, "one hundred one", "one hundred first", "one hundred first"},
+		{"en", 2000000, "two million", "two millionth", "two millionth"},
+		{"en", -5, "minus five", "", ""},
+
+		//French 70-99 count in twenties, “et” joins the ones of 21-71, and “vingts” and “cents” are only plural at the end
+		{"fr", 1, "un", "premier", "première"},
+		{"fr", 21, "vingt-et-un", "vingt-et-unième", "vingt-et-unième"},
+		{"fr", 71, "soixante-et-onze", "soixante-et-onzième", "soixante-et-onzième"},
+		{"fr", 80, "quatre-vingts", "quatre-vingtième", "quatre-vingtième"},
+		{"fr", 81, "quatre-vingt-un", "quatre-vingt-unième", "quatre-vingt-unième"},
+		{"fr", 91, "quatre-vingt-onze", "quatre-vingt-onzième", "quatre-vingt-onzième"},
+		{"fr", 200, "deux cents", "deux centième", "deux centième"},
+		{"fr", 201, "deux cent un", "deux cent unième", "deux cent unième"},
+		{"fr", 80000, "quatre-vingt mille", "quatre-vingt millième", "quatre-vingt millième"},
+		{"fr", 2000000, "deux millions", "deux millionième", "deux millionième"},
+
+		//German ones come before the tens, and “eins” is only used at the end
+		{"de", 1, "eins", "erste", "erste"},
+		{"de", 7, "sieben", "siebte", "siebte"},
+		{"de", 21, "einundzwanzig", "einundzwanzigste", "einundzwanzigste"},
+		{"de", 101, "einhunderteins", "einhunderterste", "einhunderterste"},
+		{"de", 1000, "eintausend", "eintausendste", "eintausendste"},
+		{"de", 2000000, "zwei Millionen", "zweimillionste", "zweimillionste"},
+
+		//Spanish
+		{"es", 1, "uno", "primero", "primera"},
+		{"es", 16, "dieciséis", "decimosexto", "decimosexta"},
+		{"es", 21, "veintiuno", "vigésimo primero", "vigésima primera"},
+		{"es", 100, "cien", "centésimo", "centésima"},
+		{"es", 101, "ciento uno", "centésimo primero", "centésima primera"},
+		{"es", 1000000, "un millón", "", ""},
+	} {
+		tag := language.MustParse(test.lang)
+		for _, c := range []struct {
+			opts     spelloutOptions
+			expected string
+		}{
+			{spelloutOptions{}, test.cardinal},
+			{spelloutOptions{isOrdinal: true}, test.ordinal},
+			{spelloutOptions{isOrdinal: true, isFeminine: true}, test.feminine},
+		} {
+			//An empty expected string is an unsupported number
+			if str, ok := spellNumber(tag, test.n, c.opts); str != c.expected || ok != (len(c.expected) != 0) {
+				t.Errorf("%s %d %+v = %q, %v; expected %q", test.lang, test.n, c.opts, str, ok, c.expected)
+			}
+		}
+	}
+}
+
+func TestSpellNumberCapitalization(t *testing.T) {
+	for opts, expected := range map[spelloutOptions]string{
+		{capitalization: scUpper}:    "VINGT-ET-UN",
+		{capitalization: scTitle}:    "Vingt-Et-Un",
+		{capitalization: scSentence}: "Vingt-et-un",
+	} {
+		if str, _ := spellNumber(language.French, 21, opts); str != expected {
+			t.Errorf("%+v = %q; expected %q", opts, str, expected)
+		}
+	}
+}
+
+// Languages without spellout rules are written as plain numbers, with a compile warning
+func TestSpelloutUnsupportedLanguage(t *testing.T) {
+	const text = "Settings:\n  LanguageName: Test\n  LanguageIdentifier: %s\n  MissingPluralRule: M\nNS:\n  Count:\n    Num: Spellout\n    ^: \"{{.Num}}\"\n"
+	for lang, expectWarning := range map[string]bool{"en-US": false, "fr-FR": false, "he-IL": true} {
+		l, warnings, err := ParseTranslationText([]byte(strings.Replace(text, "%s", lang, 1)))
+		if err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+		if hasWarning := len(warnings) == 1 && strings.Contains(warnings[0], "no Spellout rules"); hasWarning != expectWarning || (!expectWarning && len(warnings) != 0) {
+			t.Errorf("%s: Warnings %q", lang, warnings)
+		}
+		if str, err := l.Get(l.dict.namespaces["NS"].ids["Count"], 21); err != nil || (expectWarning && str != "21") {
+			t.Errorf("%s = %q, %v", lang, str, err)
+		}
+	}
+}
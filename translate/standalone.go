@@ -0,0 +1,76 @@
+//Load standalone compiled language files, which hold their dictionary and fallback languages
+
+package translate
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Standalone compiled language file storage structures. The file is:
+//   - The header
+//   - The compiled dictionary file
+//   - The compiled language files: The language, then each of its fallback languages in order (the default language last)
+//
+// When compressed, the whole file is gzip compressed, not its parts
+type storeStandaloneHeader struct {
+	fileType     [3]byte //STR
+	numLanguages uint8   //The language and its fallback languages
+}
+
+// LoadStandalone loads a standalone .gtr language file (see Language.SaveGTRStandalone()), which holds its own dictionary and fallback languages, so nothing else needs to be loaded first.
+//
+// This does not read or write the stored dictionary. The language is loaded against its own dictionary (see NewDictionary()), and its fallback languages are already set. Encrypted languages are decrypted with the key given to LanguageFile.SetDecryptionKey()
+func (lf LanguageBinaryFile) LoadStandalone(r io.Reader, isCompressed bool) (*Language, error) {
+	//Handle compressed files
+	if isCompressed {
+		if _r, err := gzip.NewReader(r); err != nil {
+			return nil, err
+		} else {
+			r = _r
+		}
+	}
+
+	//Confirm the header
+	var header storeStandaloneHeader
+	headerBytes := make([]byte, size_storeStandaloneHeader)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, fmt.Errorf("Could not read header: %s", err.Error())
+	}
+	header.fromLittleEndian(headerBytes)
+	if b2s(header.fileType[0:3]) != "STR" {
+		return nil, errors.New("Invalid file header")
+	} else if header.numLanguages == 0 {
+		return nil, errors.New("Standalone file has no languages")
+	}
+
+	//Load the dictionary. Encrypted languages use the package level decryption key
+	d := NewDictionary()
+	d.decryption = defaultDictionary.decryption
+	if err := d.LoadDictionary(r, false); err != nil {
+		return nil, fmt.Errorf("Translation dictionary error: %s", err.Error())
+	}
+
+	//Load the languages
+	langs := make([]*Language, header.numLanguages)
+	for i := range langs {
+		l, err := d.Load(r, false)
+		if err != nil {
+			return nil, fmt.Errorf("Language #%d error: %s", i, err.Error())
+		}
+		langs[i] = l
+	}
+
+	//Set the fallbacks, starting from the default language
+	defaultLang := langs[len(langs)-1]
+	defaultLang.fallback = defaultLang
+	for i := len(langs) - 2; i >= 0; i-- {
+		if err := langs[i].SetFallback(langs[i+1]); err != nil {
+			return nil, fmt.Errorf("Error setting fallback “%s” on “%s”: %s", langs[i+1].LanguageIdentifier(), langs[i].LanguageIdentifier(), err.Error())
+		}
+	}
+
+	return langs[0], nil
+}
@@ -0,0 +1,90 @@
+//Using translations in html/template templates
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"reflect"
+)
+
+// GetHTML retrieves a non-plural translation (see Get()) as HTML that html/template outputs without escaping. The translation’s text is trusted as HTML (so it can contain markup like “<b>”), while string and fmt.Stringer arguments are HTML escaped before they are inserted. template.HTML arguments are inserted as they are.
+//
+// Only use this for translations whose texts are meant to hold markup. Other argument types are formatted as they are, so they must not output markup
+func (l *Language) GetHTML(index TransIndex, args ...interface{}) (template.HTML, error) {
+	str, err := l.getReal(index, -1, 0, escapeHTMLArgs(args))
+	return template.HTML(str), err
+}
+
+// GetPluralHTML retrieves a plural translation (see GetPlural()) as HTML. See GetHTML()
+func (l *Language) GetPluralHTML(index TransIndex, pluralCount uint, args ...interface{}) (template.HTML, error) {
+	str, err := l.getReal(index, pluralCountToInt64(pluralCount), 0, escapeHTMLArgs(args))
+	return template.HTML(str), err
+}
+
+// Returns a copy of the arguments with the strings (and fmt.Stringers) HTML escaped, and template.HTMLs converted to strings
+func escapeHTMLArgs(args []interface{}) []interface{} {
+	newArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case template.HTML:
+			newArgs[i] = string(v)
+		case string:
+			newArgs[i] = template.HTMLEscapeString(v)
+		case fmt.Stringer:
+			newArgs[i] = template.HTMLEscapeString(v.String())
+		default:
+			newArgs[i] = arg
+		}
+	}
+	return newArgs
+}
+
+// FuncMap returns the template functions of the language, to give to html/template’s (or text/template’s) Template.Funcs(). Example: {{T .Index .Name}} or {{TPlural .Index .Count .Count}}
+//   - T, TPlural, and TNamed: Get(), GetPlural(), and GetNamed(). They return strings, so html/template escapes them
+//   - THTML and TPluralHTML: GetHTML() and GetPluralHTML(), which are not escaped by html/template. Only use them for translations whose texts are meant to hold markup
+//
+// The plural counts can be any integer type. Errors stop the template’s execution
+func (l *Language) FuncMap() map[string]interface{} {
+	return map[string]interface{}{
+		"T": l.Get,
+		"TPlural": func(index TransIndex, pluralCount interface{}, args ...interface{}) (string, error) {
+			if count, err := templatePluralCount(pluralCount); err != nil {
+				return returnBlankStrOnErr, err
+			} else {
+				return l.GetPlural(index, count, args...)
+			}
+		},
+		"TNamed": l.GetNamed,
+		"THTML":  l.GetHTML,
+		"TPluralHTML": func(index TransIndex, pluralCount interface{}, args ...interface{}) (template.HTML, error) {
+			if count, err := templatePluralCount(pluralCount); err != nil {
+				return returnBlankStrOnErr, err
+			} else {
+				return l.GetPluralHTML(index, count, args...)
+			}
+		},
+	}
+}
+
+// FuncMap returns the template functions of the default language (see Language.FuncMap()). Templates can be parsed with these, and then executed per request with the functions of the request’s language (Example: template.Must(tmpl.Clone()).Funcs(lang.FuncMap()))
+func (r *Registry) FuncMap() map[string]interface{} {
+	return r.defaultLanguage.FuncMap()
+}
+
+// Converts a plural count given in a template (which can be any integer type) to a uint
+func templatePluralCount(pluralCount interface{}) (uint, error) {
+	v := reflect.ValueOf(pluralCount)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, errors.New("The plural count cannot be negative")
+		}
+		return uint(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uint(v.Uint()), nil
+	default:
+		return 0, fmt.Errorf("The plural count must be an integer, not %T", pluralCount)
+	}
+}
@@ -0,0 +1,176 @@
+//Registering custom translation text file formats
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// TextItem is an item of a translation text file read by a custom format (see RegisterTextFormat()). An object holds its items in Children (in document order), and a string holds its value in Value. Items of an object must have unique names
+type TextItem struct {
+	Name     string
+	Value    string
+	Children []TextItem
+	IsObject bool
+}
+
+// TextFormatDecoder reads a translation text file of a custom format and returns its top level item, which must be an object. It is laid out like a YAML or JSON translation text file (Settings, then the namespaces)
+type TextFormatDecoder func(r io.Reader) (TextItem, error)
+
+// The extensions of the built-in file types, which cannot be registered
+var builtinTextFormatExtensions = []string{"yaml", "json", "toml"}
+
+// The registered custom formats. A format’s LanguageTextFile is lf_firstCustomTextFormat + its index
+var customTextFormats struct {
+	sync.RWMutex
+	extensions []string
+	decoders   []TextFormatDecoder
+}
+
+//goland:noinspection GoSnakeCaseUsage
+const lf_firstCustomTextFormat = LF_JSON_I18Next + 1
+
+// RegisterTextFormat adds a custom translation text file format, read by the decoder, and returns its LanguageTextFile. The extension is lowercase and without a dot (Example: “ini”). It cannot be the extension of a built-in file type or an already registered format. The execute package reads files with the extension in its Directory mode (and the other modes that read translation text files).
+//
+// Custom format files can be loaded, converted to YAML or JSON, and exported, but cannot be written. It is safe for concurrent use
+func RegisterTextFormat(extension string, decoder TextFormatDecoder) (LanguageTextFile, error) {
+	//Confirm the parameters
+	if decoder == nil {
+		return 0, errors.New("The decoder is nil")
+	} else if len(extension) == 0 || extension != strings.ToLower(extension) || strings.ContainsAny(extension, "./\\") {
+		return 0, fmt.Errorf("Extension “%s” must be lowercase and not blank, and cannot contain dots or slashes", extension)
+	} else if arrayIn(builtinTextFormatExtensions, extension) {
+		return 0, fmt.Errorf("Extension “%s” is used by a built-in file type", extension)
+	}
+
+	//Add the format
+	customTextFormats.Lock()
+	defer customTextFormats.Unlock()
+	if arrayIn(customTextFormats.extensions, extension) {
+		return 0, fmt.Errorf("Extension “%s” is already registered", extension)
+	}
+	customTextFormats.extensions = append(customTextFormats.extensions, extension)
+	customTextFormats.decoders = append(customTextFormats.decoders, decoder)
+	return lf_firstCustomTextFormat + LanguageTextFile(len(customTextFormats.decoders)-1), nil
+}
+
+// TextFormatByExtension returns the LanguageTextFile of a registered custom format (see RegisterTextFormat())
+func TextFormatByExtension(extension string) (LanguageTextFile, bool) {
+	customTextFormats.RLock()
+	defer customTextFormats.RUnlock()
+	for i, ext := range customTextFormats.extensions {
+		if ext == extension {
+			return lf_firstCustomTextFormat + LanguageTextFile(i), true
+		}
+	}
+	return 0, false
+}
+
+// TextFormatExtensions returns the extensions of the registered custom formats, in the order they were registered
+func TextFormatExtensions() []string {
+	customTextFormats.RLock()
+	defer customTextFormats.RUnlock()
+	return append([]string(nil), customTextFormats.extensions...)
+}
+
+// CanBeWritten returns if files of the type can be written (formatted, converted to, imported into, etc). TOML, i18next JSON, and custom format files cannot be written
+func (lf LanguageTextFile) CanBeWritten() bool {
+	return lf >= LF_YAML && lf < LF_TOML
+}
+
+// Returns the decoder of a registered custom format
+func (lf LanguageTextFile) customTextFormat() (TextFormatDecoder, bool) {
+	customTextFormats.RLock()
+	defer customTextFormats.RUnlock()
+	if lf < lf_firstCustomTextFormat || int(lf-lf_firstCustomTextFormat) >= len(customTextFormats.decoders) {
+		return nil, false
+	}
+	return customTextFormats.decoders[lf-lf_firstCustomTextFormat], true
+}
+
+// Reads a custom format file and confirms its objects do not have duplicate names
+func readCustomTextFormat(decoder TextFormatDecoder, r io.Reader) (TextItem, error) {
+	item, err := decoder(r)
+	if err != nil {
+		return TextItem{}, err
+	}
+	var duplicates []string
+	item.findDuplicateNames("", &duplicates)
+	if len(duplicates) != 0 {
+		return TextItem{}, errors.New(strings.Join(duplicates, "\n"))
+	}
+	return item, nil
+}
+
+// Adds the paths of the duplicate item names under the item
+func (i TextItem) findDuplicateNames(path string, duplicates *[]string) {
+	if !i.IsObject {
+		return
+	}
+	seen := make(map[string]struct{}, len(i.Children))
+	for _, child := range i.Children {
+		childPath := cond(len(path) == 0, child.Name, path+"."+child.Name)
+		if _, ok := seen[child.Name]; ok {
+			*duplicates = append(*duplicates, fmt.Sprintf("Duplicate key “%s”", childPath))
+		} else {
+			seen[child.Name] = struct{}{}
+		}
+		child.findDuplicateNames(childPath, duplicates)
+	}
+}
+
+// ---------------------Interface to access text processing maps--------------------
+type textItemMap []TextItem
+
+func (m *textItemMap) getValue(paramName string) (val tpItem, ok bool) {
+	for _, v := range *m {
+		if v.Name == paramName {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (m *textItemMap) toMap() map[string]tpItem {
+	retVal := make(map[string]tpItem, len(*m))
+	for _, v := range *m {
+		retVal[v.Name] = v
+	}
+	return retVal
+}
+
+func (m *textItemMap) toOrdered() []tpItem {
+	ret := make([]tpItem, len(*m))
+	for i, v := range *m {
+		ret[i] = v
+	}
+	return ret
+}
+
+func (m *textItemMap) getLength() uint {
+	return ulen(*m)
+}
+
+func (i TextItem) getName() string {
+	return i.Name
+}
+
+func (i TextItem) getObject() (val tpMap, ok bool) {
+	if !i.IsObject {
+		return nil, false
+	}
+	m := textItemMap(i.Children)
+	return &m, true
+}
+
+func (i TextItem) getString() (val string, ok bool) {
+	if i.IsObject {
+		return returnBlankStrOnErr, false
+	}
+	return i.Value, true
+}
@@ -0,0 +1,34 @@
+//Translations that are executed as text/templates
+
+package translate
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// Parsed text/templates, keyed by their source. Sources are the same between dictionaries, so they share the cache
+var textTemplateCache sync.Map
+
+// Parses the source of a Template rule (see vtTemplate). Missing map keys are errors so they are never output as “<no value>”
+func parseTextTemplate(src string) (*template.Template, error) {
+	return template.New("").Option("missingkey=error").Parse(src)
+}
+
+// Executes the source of a Template rule with the data. Sources are only parsed the first time they are executed
+func executeTextTemplate(w io.Writer, src string, data interface{}) error {
+	//Get the parsed template. The source can point into the translation’s memory, so it is copied before it is kept
+	cached, ok := textTemplateCache.Load(src)
+	if !ok {
+		src = strings.Clone(src)
+		if tmpl, err := parseTextTemplate(src); err != nil {
+			return err
+		} else {
+			cached, _ = textTemplateCache.LoadOrStore(src, tmpl)
+		}
+	}
+
+	return cached.(*template.Template).Execute(w, data)
+}
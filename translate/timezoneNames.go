@@ -0,0 +1,5668 @@
+// Code generated by gen_timezoneNames.go from the CLDR data of golang.org/x/text/date. DO NOT EDIT.
+//go:build !gol10n_minimal
+
+package translate
+
+// The CLDR metazones, and then the time zones with their own names, in the order of the zoneNames of zoneNameTable
+var zoneNameKeys = []string{
+	"Africa_Central",
+	"Africa_Eastern",
+	"Africa_Southern",
+	"Africa_Western",
+	"Alaska",
+	"America_Central",
+	"America_Eastern",
+	"America_Mountain",
+	"America_Pacific",
+	"Arabian",
+	"Argentina",
+	"Atlantic",
+	"Australia_Central",
+	"Australia_Eastern",
+	"Australia_Western",
+	"Bangladesh",
+	"Brasilia",
+	"Chile",
+	"China",
+	"Colombia",
+	"Europe_Central",
+	"Europe_Eastern",
+	"Europe_Western",
+	"GMT",
+	"Gulf",
+	"Hawaii_Aleutian",
+	"Hong_Kong",
+	"India",
+	"Indochina",
+	"Indonesia_Western",
+	"Iran",
+	"Israel",
+	"Japan",
+	"Korea",
+	"Malaysia",
+	"Moscow",
+	"New_Zealand",
+	"Newfoundland",
+	"Pakistan",
+	"Peru",
+	"Philippines",
+	"Singapore",
+	"Taipei",
+	"Venezuela",
+	"Etc/UTC",
+	"Europe/Dublin",
+	"Europe/London",
+	"Pacific/Honolulu",
+}
+
+// The index in zoneNameKeys of each time zone’s metazone (or the time zone it is an alias of)
+var zoneToMetazone = map[string]uint8{
+	"Africa/Abidjan":                 23,
+	"Africa/Accra":                   23,
+	"Africa/Addis_Ababa":             1,
+	"Africa/Algiers":                 20,
+	"Africa/Asmara":                  1,
+	"Africa/Bamako":                  23,
+	"Africa/Bangui":                  3,
+	"Africa/Blantyre":                0,
+	"Africa/Brazzaville":             3,
+	"Africa/Bujumbura":               0,
+	"Africa/Cairo":                   21,
+	"Africa/Ceuta":                   20,
+	"Africa/Dakar":                   23,
+	"Africa/Dar_es_Salaam":           1,
+	"Africa/Djibouti":                1,
+	"Africa/Douala":                  3,
+	"Africa/Gaborone":                0,
+	"Africa/Harare":                  0,
+	"Africa/Johannesburg":            2,
+	"Africa/Kampala":                 1,
+	"Africa/Kigali":                  0,
+	"Africa/Kinshasa":                3,
+	"Africa/Lagos":                   3,
+	"Africa/Libreville":              3,
+	"Africa/Luanda":                  3,
+	"Africa/Lubumbashi":              0,
+	"Africa/Lusaka":                  0,
+	"Africa/Malabo":                  3,
+	"Africa/Maputo":                  0,
+	"Africa/Maseru":                  2,
+	"Africa/Mbabane":                 2,
+	"Africa/Mogadishu":               1,
+	"Africa/Monrovia":                23,
+	"Africa/Nairobi":                 1,
+	"Africa/Ndjamena":                3,
+	"Africa/Niamey":                  3,
+	"Africa/Porto-Novo":              3,
+	"Africa/Tripoli":                 21,
+	"Africa/Tunis":                   20,
+	"America/Adak":                   25,
+	"America/Anchorage":              4,
+	"America/Argentina/Buenos_Aires": 10,
+	"America/Argentina/Cordoba":      10,
+	"America/Bahia":                  16,
+	"America/Bahia_Banderas":         5,
+	"America/Barbados":               11,
+	"America/Belem":                  16,
+	"America/Belize":                 5,
+	"America/Bogota":                 19,
+	"America/Boise":                  7,
+	"America/Buenos_Aires":           10,
+	"America/Cancun":                 6,
+	"America/Caracas":                43,
+	"America/Cayman":                 6,
+	"America/Chicago":                5,
+	"America/Cordoba":                10,
+	"America/Costa_Rica":             5,
+	"America/Denver":                 7,
+	"America/Detroit":                6,
+	"America/Edmonton":               7,
+	"America/El_Salvador":            5,
+	"America/Fortaleza":              16,
+	"America/Guatemala":              5,
+	"America/Halifax":                11,
+	"America/Indiana/Indianapolis":   6,
+	"America/Indiana/Knox":           5,
+	"America/Indianapolis":           6,
+	"America/Iqaluit":                6,
+	"America/Jamaica":                6,
+	"America/Juneau":                 4,
+	"America/Kentucky/Louisville":    6,
+	"America/Lima":                   39,
+	"America/Los_Angeles":            8,
+	"America/Louisville":             6,
+	"America/Managua":                5,
+	"America/Martinique":             11,
+	"America/Matamoros":              5,
+	"America/Menominee":              5,
+	"America/Merida":                 5,
+	"America/Mexico_City":            5,
+	"America/Monterrey":              5,
+	"America/Nassau":                 6,
+	"America/New_York":               6,
+	"America/Nome":                   4,
+	"America/Panama":                 6,
+	"America/Phoenix":                7,
+	"America/Port-au-Prince":         6,
+	"America/Port_of_Spain":          11,
+	"America/Puerto_Rico":            11,
+	"America/Recife":                 16,
+	"America/Regina":                 5,
+	"America/Santiago":               17,
+	"America/Santo_Domingo":          11,
+	"America/Sao_Paulo":              16,
+	"America/Sitka":                  4,
+	"America/St_Johns":               37,
+	"America/Tegucigalpa":            5,
+	"America/Tijuana":                8,
+	"America/Toronto":                6,
+	"America/Vancouver":              8,
+	"America/Winnipeg":               5,
+	"America/Yakutat":                4,
+	"Asia/Aden":                      9,
+	"Asia/Baghdad":                   9,
+	"Asia/Bahrain":                   9,
+	"Asia/Bangkok":                   28,
+	"Asia/Beirut":                    21,
+	"Asia/Calcutta":                  27,
+	"Asia/Chongqing":                 18,
+	"Asia/Dhaka":                     15,
+	"Asia/Dubai":                     24,
+	"Asia/Ho_Chi_Minh":               28,
+	"Asia/Hong_Kong":                 26,
+	"Asia/Jakarta":                   29,
+	"Asia/Jerusalem":                 31,
+	"Asia/Karachi":                   38,
+	"Asia/Kolkata":                   27,
+	"Asia/Kuala_Lumpur":              34,
+	"Asia/Kuching":                   34,
+	"Asia/Kuwait":                    9,
+	"Asia/Manila":                    40,
+	"Asia/Muscat":                    24,
+	"Asia/Nicosia":                   21,
+	"Asia/Phnom_Penh":                28,
+	"Asia/Pontianak":                 29,
+	"Asia/Qatar":                     9,
+	"Asia/Riyadh":                    9,
+	"Asia/Saigon":                    28,
+	"Asia/Seoul":                     33,
+	"Asia/Shanghai":                  18,
+	"Asia/Singapore":                 41,
+	"Asia/Taipei":                    42,
+	"Asia/Tehran":                    30,
+	"Asia/Tel_Aviv":                  31,
+	"Asia/Tokyo":                     32,
+	"Asia/Vientiane":                 28,
+	"Atlantic/Bermuda":               11,
+	"Atlantic/Canary":                22,
+	"Atlantic/Faroe":                 22,
+	"Atlantic/Madeira":               22,
+	"Atlantic/Reykjavik":             23,
+	"Australia/Adelaide":             12,
+	"Australia/Brisbane":             13,
+	"Australia/Darwin":               12,
+	"Australia/Hobart":               13,
+	"Australia/Melbourne":            13,
+	"Australia/Perth":                14,
+	"Australia/Sydney":               13,
+	"CET":                            20,
+	"CST6CDT":                        5,
+	"EET":                            21,
+	"EST":                            6,
+	"EST5EDT":                        6,
+	"Egypt":                          21,
+	"Etc/GMT":                        23,
+	"Etc/UCT":                        44,
+	"Europe/Amsterdam":               20,
+	"Europe/Andorra":                 20,
+	"Europe/Athens":                  21,
+	"Europe/Belgrade":                20,
+	"Europe/Berlin":                  20,
+	"Europe/Bratislava":              20,
+	"Europe/Brussels":                20,
+	"Europe/Bucharest":               21,
+	"Europe/Budapest":                20,
+	"Europe/Chisinau":                21,
+	"Europe/Copenhagen":              20,
+	"Europe/Dublin":                  23,
+	"Europe/Gibraltar":               20,
+	"Europe/Helsinki":                21,
+	"Europe/Kaliningrad":             21,
+	"Europe/Kiev":                    21,
+	"Europe/Kyiv":                    21,
+	"Europe/Lisbon":                  22,
+	"Europe/Ljubljana":               20,
+	"Europe/London":                  23,
+	"Europe/Luxembourg":              20,
+	"Europe/Madrid":                  20,
+	"Europe/Malta":                   20,
+	"Europe/Monaco":                  20,
+	"Europe/Moscow":                  35,
+	"Europe/Oslo":                    20,
+	"Europe/Paris":                   20,
+	"Europe/Podgorica":               20,
+	"Europe/Prague":                  20,
+	"Europe/Riga":                    21,
+	"Europe/Rome":                    20,
+	"Europe/San_Marino":              20,
+	"Europe/Sarajevo":                20,
+	"Europe/Simferopol":              35,
+	"Europe/Skopje":                  20,
+	"Europe/Sofia":                   21,
+	"Europe/Stockholm":               20,
+	"Europe/Tallinn":                 21,
+	"Europe/Tirane":                  20,
+	"Europe/Vaduz":                   20,
+	"Europe/Vatican":                 20,
+	"Europe/Vienna":                  20,
+	"Europe/Vilnius":                 21,
+	"Europe/Warsaw":                  20,
+	"Europe/Zagreb":                  20,
+	"Europe/Zurich":                  20,
+	"GMT":                            23,
+	"Hongkong":                       26,
+	"Indian/Antananarivo":            1,
+	"Indian/Comoro":                  1,
+	"Indian/Mayotte":                 1,
+	"Iran":                           30,
+	"Israel":                         31,
+	"Japan":                          32,
+	"MET":                            20,
+	"MST":                            7,
+	"MST7MDT":                        7,
+	"NZ":                             36,
+	"PRC":                            18,
+	"PST8PDT":                        8,
+	"Pacific/Auckland":               36,
+	"Pacific/Honolulu":               25,
+	"ROC":                            42,
+	"ROK":                            33,
+	"Singapore":                      41,
+	"UCT":                            44,
+	"US/Alaska":                      4,
+	"US/Arizona":                     7,
+	"US/Central":                     5,
+	"US/Eastern":                     6,
+	"US/Hawaii":                      25,
+	"US/Mountain":                    7,
+	"US/Pacific":                     8,
+	"UTC":                            44,
+	"Universal":                      44,
+	"W-SU":                           35,
+	"WET":                            22,
+	"Zulu":                           44,
+}
+
+// The index in zoneNameKeys of each time zone with its own names
+var zoneOwnNames = map[string]uint8{
+	"Etc/UTC":          44,
+	"Europe/Dublin":    45,
+	"Europe/London":    46,
+	"Pacific/Honolulu": 47,
+}
+
+// The names of each zoneNameKeys entry per base language. Empty names are not in CLDR
+var zoneNameTable = map[string][]zoneNames{
+	"af": {
+		{"", "Sentraal-Afrika-tyd", ""},
+		{"", "Oos-Afrika-tyd", ""},
+		{"", "Suid-Afrika-standaardtyd", ""},
+		{"Wes-Afrika-tyd", "Wes-Afrika-standaardtyd", "Wes-Afrika-somertyd"},
+		{"Alaska-tyd", "Alaska-standaardtyd", "Alaska-dagligtyd"},
+		{"Noord-Amerikaanse sentrale tyd", "Noord-Amerikaanse sentrale standaardtyd", "Noord-Amerikaanse sentrale dagligtyd"},
+		{"Noord-Amerikaanse oostelike tyd", "Noord-Amerikaanse oostelike standaardtyd", "Noord-Amerikaanse oostelike dagligtyd"},
+		{"Noord-Amerikaanse bergtyd", "Noord-Amerikaanse berg-standaardtyd", "Noord-Amerikaanse berg-dagligtyd"},
+		{"Pasifiese tyd", "Pasifiese standaardtyd", "Pasifiese dagligtyd"},
+		{"Arabiese tyd", "Arabiese standaardtyd", "Arabiese dagligtyd"},
+		{"Argentinië-tyd", "Argentinië-standaardtyd", "Argentinië-somertyd"},
+		{"Atlantiese tyd", "Atlantiese standaardtyd", "Atlantiese dagligtyd"},
+		{"Sentraal-Australiese tyd", "Sentraal-Australiese standaardtyd", "Sentraal-Australiese dagligtyd"},
+		{"Oostelike Australiese tyd", "Oostelike Australiese standaardtyd", "Oostelike Australiese dagligtyd"},
+		{"Westelike Australiese tyd", "Westelike Australiese standaardtyd", "Westelike Australiese dagligtyd"},
+		{"Bangladesj-tyd", "Bangladesj-standaardtyd", "Bangladesj-somertyd"},
+		{"Brasilia-tyd", "Brasilia-standaardtyd", "Brasilia-somertyd"},
+		{"Chili-tyd", "Chili-standaardtyd", "Chili-somertyd"},
+		{"China-tyd", "China-standaardtyd", "China-dagligtyd"},
+		{"Colombië-tyd", "Colombië-standaardtyd", "Colombië-somertyd"},
+		{"Sentraal-Europese tyd", "Sentraal-Europese standaardtyd", "Sentraal-Europese somertyd"},
+		{"Oos-Europese tyd", "Oos-Europese standaardtyd", "Oos-Europese somertyd"},
+		{"Wes-Europese tyd", "Wes-Europese standaardtyd", "Wes-Europese somertyd"},
+		{"", "Greenwich-tyd", ""},
+		{"", "Persiese Golf-standaardtyd", ""},
+		{"Hawaii-Aleoete-tyd", "Hawaii-Aleoete-standaardtyd", "Hawaii-Aleoete-dagligtyd"},
+		{"Hongkong-tyd", "Hongkong-standaardtyd", "Hongkong-somertyd"},
+		{"", "Indië-standaardtyd", ""},
+		{"", "Indosjina-tyd", ""},
+		{"", "Wes-Indonesië-tyd", ""},
+		{"Iran-tyd", "Iran-standaardtyd", "Iran-dagligtyd"},
+		{"Israel-tyd", "Israel-standaardtyd", "Israel-dagligtyd"},
+		{"Japan-tyd", "Japan-standaardtyd", "Japan-dagligtyd"},
+		{"Koreaanse tyd", "Koreaanse standaardtyd", "Koreaanse dagligtyd"},
+		{"", "Maleisië-tyd", ""},
+		{"Moskou-tyd", "Moskou-standaardtyd", "Moskou-somertyd"},
+		{"Nieu-Seeland-tyd", "Nieu-Seeland-standaardtyd", "Nieu-Seeland-dagligtyd"},
+		{"Newfoundland-tyd", "Newfoundland-standaardtyd", "Newfoundland-dagligtyd"},
+		{"Pakistan-tyd", "Pakistan-standaardtyd", "Pakistan-somertyd"},
+		{"Peru-tyd", "Peru-standaardtyd", "Peru-somertyd"},
+		{"Filippynse tyd", "Filippynse standaardtyd", "Filippynse somertyd"},
+		{"", "Singapoer-standaardtyd", ""},
+		{"Taipei-tyd", "Taipei-standaardtyd", "Taipei-dagligtyd"},
+		{"", "Venezuela-tyd", ""},
+		{"", "Gekoördineerde universele tyd", ""},
+		{"", "", "Ierse standaardtyd"},
+		{"", "", "Britse somertyd"},
+		{"", "", ""},
+	},
+	"am": {
+		{"", "የመካከለኛው አፍሪካ ሰዓት", ""},
+		{"", "የምስራቅ አፍሪካ ሰዓት", ""},
+		{"", "የደቡብ አፍሪካ መደበኛ ሰዓት", ""},
+		{"የምዕራብ አፍሪካ ሰዓት", "የምዕራብ አፍሪካ መደበኛ ሰዓት", "የምዕራብ አፍሪካ ክረምት ሰዓት"},
+		{"የአላስካ ሰዓት አቆጣጠር", "የአላስካ መደበኛ የሰዓት አቆጣጠር", "የአላስካ የቀን ሰዓት አቆጣጠር"},
+		{"የመካከለኛ ሰዓት አቆጣጠር", "የመካከለኛ መደበኛ ሰዓት አቆጣጠር", "የመካከለኛ የቀን ሰዓት አቆጣጠር"},
+		{"የምዕራባዊ ሰዓት አቆጣጠር", "የምዕራባዊ መደበኛ የሰዓት አቆጣጠር", "የምዕራባዊ የቀን ሰዓት አቆጣጠር"},
+		{"የተራራ የሰዓት አቆጣጠር", "የተራራ መደበኛ የሰዓት አቆጣጠር", "የተራራ የቀንሰዓት አቆጣጠር"},
+		{"የፓስፊክ ሰዓት አቆጣጠር", "የፓስፊክ መደበኛ ሰዓት አቆጣጠር", "የፓስፊክ የቀን ሰዓት አቆጣጠር"},
+		{"የዓረቢያ ሰዓት", "የዓረቢያ መደበኛ ሰዓት", "የዓረቢያ የቀን ብርሃን ሰዓት"},
+		{"የአርጀንቲና የሰዓት አቆጣጠር", "የአርጀንቲና መደበኛ ሰዓት አቆጣጠር", "የአርጀንቲና የበጋ ሰዓት አቆጣጠር"},
+		{"የአትላንቲክ የሰዓት አቆጣጠር", "የአትላንቲክ መደበኛ የሰዓት አቆጣጠር", "የአትላንቲክ የቀን ሰዓት አቆጣጠር"},
+		{"የመካከለኛው አውስትራሊያ ሰዓት አቆጣጠር", "የአውስትራሊያ መካከለኛ መደበኛ የሰዓት አቆጣጠር", "የአውስትራሊያ መካከለኛ የቀን ሰዓት አቆጣጠር"},
+		{"የምዕራባዊ አውስትራሊያ የሰዓት አቆጣጠር", "የአውስትራሊያ ምዕራባዊ መደበኛ የሰዓት አቆጣጠር", "የአውስትራሊያ ምዕራባዊ የቀን ሰዓት አቆጣጠር"},
+		{"የምስራቃዊ አውስትራሊያ ሰዓት አቆጣጠር", "የአውስትራሊያ ምስራቃዊ መደበኛ ሰዓት አቆጣጠር", "የአውስትራሊያ ምስራቃዊ የቀን ሰዓት አቆጣጠር"},
+		{"የባንግላዴሽ ሰዓት", "የባንግላዴሽ መደበኛ ሰዓት", "የባንግላዴሽ ክረምት ሰዓት"},
+		{"የብራዚላዊ ሰዓት አቆጣጠር", "የብራሲሊያ መደበኛ ሰዓት አቆጣጠር", "የብራዚላ የበጋ ሰዓት አቆጣጠር"},
+		{"የቺሊ ሰዓት", "የቺሊ መደበኛ ሰዓት", "የቺሊ ክረምት ሰዓት"},
+		{"የቻይና ሰዓት", "የቻይና መደበኛ ሰዓት", "የቻይና የቀን ብርሃን ሰዓት"},
+		{"የኮሎምቢያ ሰዓት", "የኮሎምቢያ መደበኛ ሰዓት", "የኮሎምቢያ ክረምት ሰዓት"},
+		{"የመካከለኛው አውሮፓ ሰዓት", "የመካከለኛው አውሮፓ መደበኛ ሰዓት", "የመካከለኛው አውሮፓ ክረምት ሰዓት"},
+		{"የምስራቃዊ አውሮፓ ሰዓት", "የምስራቃዊ አውሮፓ መደበኛ ሰዓት", "የምስራቃዊ አውሮፓ ክረምት ሰዓት"},
+		{"የምዕራባዊ አውሮፓ ሰዓት", "የምዕራባዊ አውሮፓ መደበኛ ሰዓት", "የምዕራባዊ አውሮፓ ክረምት ሰዓት"},
+		{"", "ግሪንዊች ማዕከላዊ ሰዓት", ""},
+		{"", "የባህረሰላጤ መደበኛ ሰዓት", ""},
+		{"የሃዋይ አሌኡት ሰዓት አቆጣጠር", "የሃዋይ አሌኡት መደበኛ ሰዓት አቆጣጠር", "የሃዋይ አሌኡት የቀን ሰዓት አቆጣጠር"},
+		{"የሆንግ ኮንግ ሰዓት", "የሆንግ ኮንግ መደበኛ ሰዓት", "የሆንግ ኮንግ ክረምት ሰዓት"},
+		{"", "የህንድ መደበኛ ሰዓት", ""},
+		{"", "የኢንዶቻይና ሰዓት", ""},
+		{"", "የምዕራባዊ ኢንዶኔዢያ ሰዓት", ""},
+		{"የኢራን ሰዓት", "የኢራን መደበኛ ሰዓት", "የኢራን የቀን ብርሃን ሰዓት"},
+		{"የእስራኤል ሰዓት", "የእስራኤል መደበኛ ሰዓት", "የእስራኤል የቀን ብርሃን ሰዓት"},
+		{"የጃፓን ሰዓት", "የጃፓን መደበኛ ሰዓት", "የጃፓን የቀን ብርሃን ሰዓት"},
+		{"የኮሪያ ሰዓት", "የኮሪያ መደበኛ ሰዓት", "የኮሪያ የቀን ብርሃን ሰዓት"},
+		{"", "የማሌይዢያ ሰዓት", ""},
+		{"የሞስኮ ሰዓት አቆጣጠር", "የሞስኮ መደበኛ ሰዓት አቆጣጠር", "የሞስኮ የበጋ ሰዓት አቆጣጠር"},
+		{"የኒው ዚላንድ ሰዓት", "የኒው ዚላንድ መደበኛ ሰዓት", "የኒው ዚላንድ የቀን ብርሃን ሰዓት"},
+		{"የኒውፋውንድላንድ የሰዓት አቆጣጠር", "የኒውፋውንድላንድ መደበኛ የሰዓት አቆጣጠር", "የኒውፋውንድላንድ የቀን የሰዓት አቆጣጠር"},
+		{"የፓኪስታን ሰዓት", "የፓኪስታን መደበኛ ሰዓት", "የፓኪስታን ክረምት ሰዓት"},
+		{"የፔሩ ሰዓት", "የፔሩ መደበኛ ሰዓት", "የፔሩ ክረምት ሰዓት"},
+		{"የፊሊፒን ሰዓት", "የፊሊፒን መደበኛ ሰዓት", "የፊሊፒን ክረምት ሰዓት"},
+		{"", "የሲንጋፒር መደበኛ ሰዓት", ""},
+		{"የታይፔይ ሰዓት", "የታይፔይ መደበኛ ሰዓት", "የታይፔይ የቀን ብርሃን ሰዓት"},
+		{"", "የቬኔዝዌላ ሰዓት", ""},
+		{"", "የተቀነባበረ ሁለገብ ሰዓት", ""},
+		{"", "", "የአይሪሽ መደበኛ ሰዓት አቆጣጠር"},
+		{"", "", "የብሪትሽ የበጋ ሰዓት አቆጣጠር"},
+		{"", "", ""},
+	},
+	"ar": {
+		{"", "توقيت وسط أفريقيا", ""},
+		{"", "توقيت شرق أفريقيا", ""},
+		{"", "توقيت جنوب أفريقيا", ""},
+		{"توقيت غرب أفريقيا", "توقيت غرب أفريقيا الرسمي", "توقيت غرب أفريقيا الصيفي"},
+		{"توقيت ألاسكا", "التوقيت الرسمي لألاسكا", "توقيت ألاسكا الصيفي"},
+		{"التوقيت المركزي لأمريكا الشمالية", "التوقيت الرسمي المركزي لأمريكا الشمالية", "التوقيت الصيفي المركزي لأمريكا الشمالية"},
+		{"التوقيت الشرقي لأمريكا الشمالية", "التوقيت الرسمي الشرقي لأمريكا الشمالية", "التوقيت الصيفي الشرقي لأمريكا الشمالية"},
+		{"التوقيت الجبلي لأمريكا الشمالية", "التوقيت الجبلي الرسمي لأمريكا الشمالية", "التوقيت الجبلي الصيفي لأمريكا الشمالية"},
+		{"توقيت المحيط الهادي", "توقيت المحيط الهادي الرسمي", "توقيت المحيط الهادي الصيفي"},
+		{"التوقيت العربي", "التوقيت العربي الرسمي", "التوقيت العربي الصيفي"},
+		{"توقيت الأرجنتين", "توقيت الأرجنتين الرسمي", "توقيت الأرجنتين الصيفي"},
+		{"توقيت الأطلسي", "التوقيت الرسمي الأطلسي", "التوقيت الصيفي الأطلسي"},
+		{"توقيت وسط أستراليا", "توقيت وسط أستراليا الرسمي", "توقيت وسط أستراليا الصيفي"},
+		{"توقيت شرق أستراليا", "توقيت شرق أستراليا الرسمي", "توقيت شرق أستراليا الصيفي"},
+		{"توقيت غرب أستراليا", "توقيت غرب أستراليا الرسمي", "توقيت غرب أستراليا الصيفي"},
+		{"توقيت بنغلاديش", "توقيت بنغلاديش الرسمي", "توقيت بنغلاديش الصيفي"},
+		{"توقيت برازيليا", "توقيت برازيليا الرسمي", "توقيت برازيليا الصيفي"},
+		{"توقيت شيلي", "توقيت شيلي الرسمي", "توقيت شيلي الصيفي"},
+		{"توقيت الصين", "توقيت الصين الرسمي", "توقيت الصين الصيفي"},
+		{"توقيت كولومبيا", "توقيت كولومبيا الرسمي", "توقيت كولومبيا الصيفي"},
+		{"توقيت وسط أوروبا", "توقيت وسط أوروبا الرسمي", "توقيت وسط أوروبا الصيفي"},
+		{"توقيت شرق أوروبا", "توقيت شرق أوروبا الرسمي", "توقيت شرق أوروبا الصيفي"},
+		{"توقيت غرب أوروبا", "توقيت غرب أوروبا الرسمي", "توقيت غرب أوروبا الصيفي"},
+		{"", "توقيت غرينتش", ""},
+		{"", "توقيت الخليج", ""},
+		{"توقيت هاواي ألوتيان", "توقيت هاواي ألوتيان الرسمي", "توقيت هاواي ألوتيان الصيفي"},
+		{"توقيت هونغ كونغ", "توقيت هونغ كونغ الرسمي", "توقيت هونغ كونغ الصيفي"},
+		{"", "توقيت الهند", ""},
+		{"", "توقيت الهند الصينية", ""},
+		{"", "توقيت غرب إندونيسيا", ""},
+		{"توقيت إيران", "توقيت إيران الرسمي", "توقيت إيران الصيفي"},
+		{"توقيت إسرائيل", "توقيت إسرائيل الرسمي", "توقيت إسرائيل الصيفي"},
+		{"توقيت اليابان", "توقيت اليابان الرسمي", "توقيت اليابان الصيفي"},
+		{"توقيت كوريا", "توقيت كوريا الرسمي", "توقيت كوريا الصيفي"},
+		{"", "توقيت ماليزيا", ""},
+		{"توقيت موسكو", "توقيت موسكو الرسمي", "توقيت موسكو الصيفي"},
+		{"توقيت نيوزيلندا", "توقيت نيوزيلندا الرسمي", "توقيت نيوزيلندا الصيفي"},
+		{"توقيت نيوفاوندلاند", "توقيت نيوفاوندلاند الرسمي", "توقيت نيوفاوندلاند الصيفي"},
+		{"توقيت باكستان", "توقيت باكستان الرسمي", "توقيت باكستان الصيفي"},
+		{"توقيت بيرو", "توقيت بيرو الرسمي", "توقيت بيرو الصيفي"},
+		{"توقيت الفيلبين", "توقيت الفيلبين الرسمي", "توقيت الفيلبين الصيفي"},
+		{"", "توقيت سنغافورة", ""},
+		{"توقيت تايبيه", "توقيت تايبيه الرسمي", "توقيت تايبيه الصيفي"},
+		{"", "توقيت فنزويلا", ""},
+		{"", "التوقيت العالمي المنسق", ""},
+		{"", "", "توقيت أيرلندا الرسمي"},
+		{"", "", "توقيت بريطانيا الصيفي"},
+		{"", "", ""},
+	},
+	"as": {
+		{"", "মধ্য আফ্রিকা সময়", ""},
+		{"", "পূর্ব আফ্রিকা সময়", ""},
+		{"", "দক্ষিণ আফ্রিকা মান সময়", ""},
+		{"পশ্চিম আফ্রিকা সময়", "পশ্চিম আফ্রিকার মান সময়", "পশ্চিম আফ্রিকার গ্রীষ্মকালীন সময়"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"আরবীয় সময়", "আরবীয় মান সময়", "আরবীয় দিনের আলো"},
+		{"আৰ্জেণ্টিনা সময়", "আৰ্জেণ্টিনা মান সময়", "আৰ্জেণ্টিনা গ্ৰীষ্ম সময়"},
+		{"", "", ""},
+		{"কেন্দ্রীয় অস্ট্রেলিয়া সময়", "অস্ট্রেলিয়ান কেন্দ্রীয় স্ট্যান্ডার্ড টাইম", "অস্ট্রেলিয়ান কেন্দ্রীয় দিবালোক সময়"},
+		{"পূর্ব অস্ট্রেলিয়া সময়", "অস্ট্রেলিয়ান ইস্টার্ন স্ট্যান্ডার্ড টাইম", "অস্ট্রেলিয়ান পূর্ব দিবালোক সময়"},
+		{"ওয়েস্টার্ন অস্ট্রেলিয়া টাইম", "অস্ট্রেলিয়ান ওয়েস্টার্ন স্ট্যান্ডার্ড টাইম", "অস্ট্রেলিয়ান ওয়েস্টার্ন ডেলাইট টাইম"},
+		{"বাংলাদেশ সময়", "বাংলাদেশ স্ট্যান্ডার্ড টাইম", "বাংলাদেশ গ্রীষ্মকালীন সময়"},
+		{"ব্ৰাছিলিয়া সময়", "ব্ৰাছিলিয়া মান সময়", "ব্ৰাছিলিয়া গ্ৰীষ্ম সময়"},
+		{"চিলি সময়", "চিলি স্ট্যান্ডার্ড টাইম", "চিলি গ্রীষ্মকালীন সময়"},
+		{"চীন সময়", "চীন স্ট্যান্ডার্ড টাইম", "চীন ডেইলাইট টাইম"},
+		{"কলম্বিয়া সময়", "কলম্বিয়া মান সময়", "কলম্বিয়া গ্ৰীষ্ম সময়"},
+		{"কেন্দ্রীয় ইউরোপীয় সময়", "কেন্দ্রীয় ইউরোপীয় স্ট্যান্ডার্ড টাইম", "মধ্য ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"পূর্ব ইউরোপীয় সময়", "পূর্ব ইউরোপীয় মান সময়", "পূর্ব ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"পশ্চিম ইউরোপীয় সময়", "পশ্চিম ইউরোপীয় মান সময়", "পশ্চিম ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"", "মক্কার সময়", ""},
+		{"", "উপসাগরীয় স্ট্যান্ডার্ড টাইম", ""},
+		{"", "", ""},
+		{"হংকং সময়", "হংকং স্ট্যান্ডার্ড টাইম", "হংকং গ্রীষ্মকালীন সময়"},
+		{"", "ভাৰতীয় সময়", ""},
+		{"", "ইন্দোচীনা টাইম", ""},
+		{"", "ওয়েস্টার্ন ইন্দোনেশিয়া সময়", ""},
+		{"ইরান সময়", "ইরান স্ট্যান্ডার্ড টাইম", "ইরান দিবালোক সময়"},
+		{"ইস্রায়েল সময়", "ইস্রায়েল স্ট্যান্ডার্ড টাইম", "ইস্রায়েল দিবালোক সময়"},
+		{"জাপান সময়", "জাপান স্ট্যান্ডার্ড টাইম", "জাপান দিনের হালকা সময়"},
+		{"কোরিয়ান সময়", "কোরিয়ান স্ট্যান্ডার্ড টাইম", "কোরিয়ান দিনের আলো"},
+		{"", "মালয়েশিয়া সময়", ""},
+		{"মস্কো সময়", "মস্কো স্ট্যান্ডার্ড টাইম", "মস্কো গ্রীষ্মকালীন সময়"},
+		{"নিউজিল্যান্ড সময়", "নিউজিল্যান্ড স্ট্যান্ডার্ড টাইম", "নিউজিল্যান্ড ডেলাইট টাইম"},
+		{"", "", ""},
+		{"পাকিস্তান টাইম", "পাকিস্তান স্ট্যান্ডার্ড টাইম", "পাকিস্তান গ্রীষ্মকালীন সময়"},
+		{"পেরু সময়", "পেরু মান সময়", "পেরু গ্রীষ্মকালীন সময়"},
+		{"ফিলিপাইন টাইম", "ফিলিপাইন স্ট্যান্ডার্ড টাইম", "ফিলিপাইন গ্রীষ্মকালীন সময়"},
+		{"", "সিঙ্গাপুর স্ট্যান্ডার্ড টাইম", ""},
+		{"তাইপে সময়", "তাইপে স্ট্যান্ডার্ড টাইম", "তাইপে দিনের হালকা সময়"},
+		{"", "ভেনিজুয়েলা সময়", ""},
+		{"", "সমন্বিত সাৰ্বজনীন সময়", ""},
+		{"", "", "আইৰিচ মান সময়"},
+		{"", "", "ব্ৰিটিচ গ্ৰীষ্মকালীন সময়"},
+		{"", "", ""},
+	},
+	"ast": {
+		{"", "Hora d’África central", ""},
+		{"", "Hora d’África del este", ""},
+		{"", "Hora de Sudáfrica", ""},
+		{"Hora d’África del oeste", "Hora estándar d’África del oeste", "Hora braniega d’África del oeste"},
+		{"Hora d’Alaska", "Hora estándar d’Alaska", "Hora braniega d’Alaska"},
+		{"Hora central norteamericana", "Hora estándar central norteamericana", "Hora braniega central norteamericana"},
+		{"Hora del este norteamericanu", "Hora estándar del este norteamericanu", "Hora braniega del este norteamericanu"},
+		{"Hora de les montañes norteamericanes", "Hora estándar de les montañes norteamericanes", "Hora braniega de les montañes norteamericanes"},
+		{"Hora del Pacíficu norteamericanu", "Hora estándar del Pacíficu norteamericanu", "Hora braniega del Pacíficu norteamericanu"},
+		{"Hora d’Arabia", "Hora estándar d’Arabia", "Hora braniega d’Arabia"},
+		{"Hora d’Arxentina", "Hora estándar d’Arxentina", "Hora braniega d’Arxentina"},
+		{"Hora del Atlánticu", "Hora estándar del Atlánticu", "Hora braniega del Atlánticu"},
+		{"Hora d’Australia central", "Hora estándar d’Australia central", "Hora braniega d’Australia central"},
+		{"Hora d’Australia del este", "Hora estándar d’Australia del este", "Hora braniega d’Australia del este"},
+		{"Hora d’Australia del oeste", "Hora estándar d’Australia del oeste", "Hora braniega d’Australia del oeste"},
+		{"Hora de Bangladex", "Hora estándar de Bangladex", "Hora braniega de Bangladex"},
+		{"Hora de Brasilia", "Hora estándar de Brasilia", "Hora braniega de Brasilia"},
+		{"Hora de Chile", "Hora estándar de Chile", "Hora braniega de Chile"},
+		{"Hora de China", "Hora estándar de China", "Hora braniega de China"},
+		{"Hora de Colombia", "Hora estándar de Colombia", "Hora braniega de Colombia"},
+		{"Hora d’Europa Central", "Hora estándar d’Europa Central", "Hora braniega d’Europa Central"},
+		{"Hora d’Europa del Este", "Hora estándar d’Europa del Este", "Hora braniega d’Europa del Este"},
+		{"Hora d’Europa Occidental", "Hora estándar d’Europa Occidental", "Hora braniega d’Europa Occidental"},
+		{"", "Hora media de Greenwich", ""},
+		{"", "Hora estándar del Golfu", ""},
+		{"Hora de Hawaii-Aleutianes", "Hora estándar de Hawaii-Aleutianes", "Hora braniega de Hawaii-Aleutianes"},
+		{"Hora de Ḥong Kong", "Hora estándar de Ḥong Kong", "Hora braniega de Ḥong Kong"},
+		{"", "Hora estándar de la India", ""},
+		{"", "Hora d’Indochina", ""},
+		{"", "Hora d’Indonesia del oeste", ""},
+		{"Hora d’Irán", "Hora estándar d’Irán", "Hora braniega d’Irán"},
+		{"Hora d’Israel", "Hora estándar d’Israel", "Hora braniega d’Israel"},
+		{"Hora de Xapón", "Hora estándar de Xapón", "Hora braniega de Xapón"},
+		{"Hora de Corea", "Hora estándar de Corea", "Hora braniega de Corea"},
+		{"", "Hora de Malasia", ""},
+		{"Hora de Moscú", "Hora estándar de Moscú", "Hora braniega de Moscú"},
+		{"Hora de Nueva Zelanda", "Hora estándar de Nueva Zelanda", "Hora braniega de Nueva Zelanda"},
+		{"Hora de Newfoundland", "Hora estándar de Newfoundland", "Hora braniega de Newfoundland"},
+		{"Hora del Paquistán", "Hora estándar del Paquistán", "Hora braniega del Paquistán"},
+		{"Hora del Perú", "Hora estándar del Perú", "Hora braniega del Perú"},
+		{"Hora de Filipines", "Hora estándar de Filipines", "Hora de branu de Filipines"},
+		{"", "Hora estándar de Singapur", ""},
+		{"Hora de Taipéi", "Hora estándar de Taipéi", "Hora braniega de Taipéi"},
+		{"", "Hora de Venezuela", ""},
+		{"", "Hora coordinada universal", ""},
+		{"", "", "Hora estándar irlandesa"},
+		{"", "", "Hora braniega británica"},
+		{"", "", ""},
+	},
+	"az": {
+		{"", "Mərkəzi Afrika Vaxtı", ""},
+		{"", "Şərqi Afrika Vaxtı", ""},
+		{"", "Cənubi Afrika Vaxtı", ""},
+		{"Qərbi Afrika Vaxtı", "Qərbi Afrika Standart Vaxtı", "Qərbi Afrika Yay Vaxtı"},
+		{"Alyaska Vaxtı", "Alyaska Standart Vaxtı", "Alyaska Yay Vaxtı"},
+		{"Şimali Mərkəzi Amerika Vaxtı", "Şimali Mərkəzi Amerika Standart Vaxtı", "Şimali Mərkəzi Amerika Yay Vaxtı"},
+		{"Şimali Şərqi Amerika Vaxtı", "Şimali Şərqi Amerika Standart Vaxtı", "Şimali Şərqi Amerika Yay Vaxtı"},
+		{"Şimali Dağlıq Amerika Vaxtı", "Şimali Dağlıq Amerika Standart Vaxtı", "Şimali Dağlıq Amerika Yay Vaxtı"},
+		{"Şimali Amerika Sakit Okean Vaxtı", "Şimali Amerika Sakit Okean Standart Vaxtı", "Şimali Amerika Sakit Okean Yay Vaxtı"},
+		{"Ərəbistan Vaxtı", "Ərəbistan Standart Vaxtı", "Ərəbistan Yay Vaxtı"},
+		{"Argentina Vaxtı", "Argentina Standart Vaxtı", "Argentina Yay Vaxtı"},
+		{"Atlantik Vaxt", "Atlantik Standart Vaxt", "Atlantik Yay Vaxtı"},
+		{"Mərkəzi Avstraliya Vaxtı", "Mərkəzi Avstraliya Standart Vaxtı", "Mərkəzi Avstraliya Yay Vaxtı"},
+		{"Şərqi Avstraliya Vaxtı", "Şərqi Avstraliya Standart Vaxtı", "Şərqi Avstraliya Yay Vaxtı"},
+		{"Qərbi Avstraliya Vaxtı", "Qərbi Avstraliya Standart Vaxtı", "Qərbi Avstraliya Yay Vaxtı"},
+		{"Banqladeş Vaxtı", "Banqladeş Standart Vaxtı", "Banqladeş Yay Vaxtı"},
+		{"Braziliya Vaxtı", "Braziliya Standart Vaxtı", "Braziliya Yay Vaxtı"},
+		{"Çili Vaxtı", "Çili Standart Vaxtı", "Çili Yay Vaxtı"},
+		{"Çin Vaxtı", "Çin Standart Vaxtı", "Çin Yay Vaxtı"},
+		{"Kolumbiya Vaxtı", "Kolumbiya Standart Vaxtı", "Kolumbiya Yay Vaxtı"},
+		{"Mərkəzi Avropa Vaxtı", "Mərkəzi Avropa Standart Vaxtı", "Mərkəzi Avropa Yay Vaxtı"},
+		{"Şərqi Avropa Vaxtı", "Şərqi Avropa Standart Vaxtı", "Şərqi Avropa Yay Vaxtı"},
+		{"Qərbi Avropa Vaxtı", "Qərbi Avropa Standart Vaxtı", "Qərbi Avropa Yay Vaxtı"},
+		{"", "Qrinviç Orta Vaxtı", ""},
+		{"", "Körfəz Vaxtı", ""},
+		{"Havay-Aleut Vaxtı", "Havay-Aleut Standart Vaxtı", "Havay-Aleut Yay Vaxtı"},
+		{"Honq Konq Vaxtı", "Honq Konq Standart Vaxtı", "Honq Konq Yay Vaxtı"},
+		{"", "Hindistan Vaxtı", ""},
+		{"", "Hindçin Vaxtı", ""},
+		{"", "Qərbi İndoneziya Vaxtı", ""},
+		{"İran Vaxtı", "İran Standart Vaxtı", "İran Yay Vaxtı"},
+		{"İsrail Vaxtı", "İsrail Standart Vaxtı", "İsrail Yay Vaxtı"},
+		{"Yaponiya Vaxtı", "Yaponiya Standart Vaxtı", "Yaponiya Yay Vaxtı"},
+		{"Koreya Vaxtı", "Koreya Standart Vaxtı", "Koreya Yay Vaxtı"},
+		{"", "Malayziya Vaxtı", ""},
+		{"Moskva Vaxtı", "Moskva Standart Vaxtı", "Moskva Yay vaxtı"},
+		{"Yeni Zelandiya Vaxtı", "Yeni Zelandiya Standart Vaxtı", "Yeni Zelandiya Yay Vaxtı"},
+		{"Nyufaundlend Vaxtı", "Nyufaundlend Standart Vaxtı", "Nyufaundlend Yay Vaxtı"},
+		{"Pakistan Vaxtı", "Pakistan Standart vaxtı", "Pakistan Yay Vaxtı"},
+		{"Peru Vaxtı", "Peru Standart Vaxtı", "Peru Yay Vaxtı"},
+		{"Filippin Vaxtı", "Filippin Standart Vaxtı", "Filippin Yay Vaxtı"},
+		{"", "Sinqapur Vaxtı", ""},
+		{"Taybey Vaxtı", "Taybey Standart Vaxtı", "Taybey Yay Vaxtı"},
+		{"", "Venesuela Vaxtı", ""},
+		{"", "Koordinasiya edilmiş ümumdünya vaxtı", ""},
+		{"", "", "İrlandiya Yay Vaxtı"},
+		{"", "", "Britaniya Yay Vaxtı"},
+		{"", "", ""},
+	},
+	"be": {
+		{"", "Цэнтральнаафрыканскі час", ""},
+		{"", "Усходнеафрыканскі час", ""},
+		{"", "Паўднёваафрыканскі час", ""},
+		{"Заходнеафрыканскі час", "Заходнеафрыканскі стандартны час", "Заходнеафрыканскі летні час"},
+		{"Час Аляскі", "Стандартны час Аляскі", "Летні час Аляскі"},
+		{"Паўночнаамерыканскі цэнтральны час", "Паўночнаамерыканскі цэнтральны стандартны час", "Паўночнаамерыканскі цэнтральны летні час"},
+		{"Паўночнаамерыканскі ўсходні час", "Паўночнаамерыканскі ўсходні стандартны час", "Паўночнаамерыканскі ўсходні летні час"},
+		{"Паўночнаамерыканскі горны час", "Паўночнаамерыканскі горны стандартны час", "Паўночнаамерыканскі горны летні час"},
+		{"Ціхаакіянскі час", "Ціхаакіянскі стандартны час", "Ціхаакіянскі летні час"},
+		{"Час Саудаўскай Аравіі", "Стандартны час Саудаўскай Аравіі", "Летні час Саудаўскай Аравіі"},
+		{"Аргенцінскі час", "Аргенцінскі стандартны час", "Аргенцінскі летні час"},
+		{"Атлантычны час", "Атлантычны стандартны час", "Атлантычны летні час"},
+		{"Час цэнтральнай Аўстраліі", "Стандартны час цэнтральнай Аўстраліі", "Летні час цэнтральнай Аўстраліі"},
+		{"Час усходняй Аўстраліі", "Стандартны час усходняй Аўстраліі", "Летні час усходняй Аўстраліі"},
+		{"Час заходняй Аўстраліі", "Стандартны час заходняй Аўстраліі", "Летні час заходняй Аўстраліі"},
+		{"Час Бангладэш", "Стандартны час Бангладэш", "Летні час Бангладэш"},
+		{"Бразільскі час", "Бразільскі стандартны час", "Бразільскі летні час"},
+		{"Чылійскі час", "Чылійскі стандартны час", "Чылійскі летні час"},
+		{"Час Кітая", "Стандартны час Кітая", "Летні час Кітая"},
+		{"Калумбійскі час", "Калумбійскі стандартны час", "Калумбійскі летні час"},
+		{"Цэнтральнаеўрапейскі час", "Цэнтральнаеўрапейскі стандартны час", "Цэнтральнаеўрапейскі летні час"},
+		{"Усходнееўрапейскі час", "Усходнееўрапейскі стандартны час", "Усходнееўрапейскі летні час"},
+		{"Заходнееўрапейскі час", "Заходнееўрапейскі стандартны час", "Заходнееўрапейскі летні час"},
+		{"", "Час па Грынвічы", ""},
+		{"", "Час Персідскага заліва", ""},
+		{"Гавайска-Алеуцкі час", "Гавайска-Алеуцкі стандартны час", "Гавайска-Алеуцкі летні час"},
+		{"Час Ганконга", "Стандартны час Ганконга", "Летні час Ганконга"},
+		{"", "Час Індыі", ""},
+		{"", "Індакітайскі час", ""},
+		{"", "Заходнеінданезійскі час", ""},
+		{"Іранскі час", "Іранскі стандартны час", "Іранскі летні час"},
+		{"Ізраільскі час", "Ізраільскі стандартны час", "Ізраільскі летні час"},
+		{"Час Японіі", "Стандартны час Японіі", "Летні час Японіі"},
+		{"Час Карэі", "Стандартны час Карэі", "Летні час Карэі"},
+		{"", "Час Малайзіі", ""},
+		{"Маскоўскі час", "Маскоўскі стандартны час", "Маскоўскі летні час"},
+		{"Час Новай Зеландыі", "Стандартны час Новай Зеландыі", "Летні час Новай Зеландыі"},
+		{"Ньюфаўндлендскі час", "Ньюфаўндлендскі стандартны час", "Ньюфаўндлендскі летні час"},
+		{"Пакістанскі час", "Пакістанскі стандартны час", "Пакістанскі летні час"},
+		{"Перуанскі час", "Перуанскі стандартны час", "Перуанскі летні час"},
+		{"Філіпінскі час", "Філіпінскі стандартны час", "Філіпінскі летні час"},
+		{"", "Сінгапурскі час", ""},
+		{"Час Тайбэя", "Стандартны час Тайбэя", "Летні час Тайбэя"},
+		{"", "Венесуэльскі час", ""},
+		{"", "Універсальны каардынаваны час", ""},
+		{"", "", "Ірландскі стандартны час"},
+		{"", "", "Брытанскі летні час"},
+		{"", "", ""},
+	},
+	"bg": {
+		{"", "Централноафриканско време", ""},
+		{"", "Източноафриканско време", ""},
+		{"", "Южноафриканско време", ""},
+		{"Западноафриканско време", "Западноафриканско стандартно време", "Западноафриканско лятно часово време"},
+		{"Аляска", "Аляска – стандартно време", "Аляска – лятно часово време"},
+		{"Северноамериканско централно време", "Северноамериканско централно стандартно време", "Северноамериканско централно лятно часово време"},
+		{"Северноамериканско източно време", "Северноамериканско източно стандартно време", "Северноамериканско източно лятно часово време"},
+		{"Северноамериканско планинско време", "Северноамериканско планинско стандартно време", "Северноамериканско планинско лятно часово време"},
+		{"Северноамериканско тихоокеанско време", "Северноамериканско тихоокеанско стандартно време", "Северноамериканско тихоокеанско лятно часово време"},
+		{"Арабско време", "Арабско стандартно време", "Арабско лятно часово време"},
+		{"Аржентинско време", "Аржентинско стандартно време", "Аржентинско лятно часово време"},
+		{"Северноамериканско атлантическо време", "Северноамериканско атлантическо стандартно време", "Северноамериканско атлантическо лятно часово време"},
+		{"Централноавстралийско време", "Централноавстралийско стандартно време", "Централноавстралийско лятно часово време"},
+		{"Източноавстралийско време", "Източноавстралийско стандартно време", "Източноавстралийско лятно часово време"},
+		{"Западноавстралийско време", "Западноавстралийско стандартно време", "Западноавстралийско лятно часово време"},
+		{"Бангладешко време", "Бангладешко стандартно време", "Бангладешко лятно часово време"},
+		{"Бразилско време", "Бразилско стандартно време", "Бразилско лятно часово време"},
+		{"Чилийско време", "Чилийско стандартно време", "Чилийско лятно часово време"},
+		{"Китайско време", "Китайско стандартно време", "Китайско лятно часово време"},
+		{"Колумбийско време", "Колумбийско стандартно време", "Колумбийско лятно часово време"},
+		{"Централноевропейско време", "Централноевропейско стандартно време", "Централноевропейско лятно часово време"},
+		{"Източноевропейско време", "Източноевропейско стандартно време", "Източноевропейско лятно часово време"},
+		{"Западноевропейско време", "Западноевропейско стандартно време", "Западноевропейско лятно време"},
+		{"", "Средно гринуичко време", ""},
+		{"", "Персийски залив", ""},
+		{"Хавайско-алеутско време", "Хавайско-алеутско стандартно време", "Хавайско-алеутско лятно часово време"},
+		{"Хонконгско време", "Хонконгско стандартно време", "Хонконгско лятно часово време"},
+		{"", "Индийско време", ""},
+		{"", "Индокитайско време", ""},
+		{"", "Западноиндонезийско време", ""},
+		{"Иранско време", "Иранско стандартно време", "Иранско лятно часово време"},
+		{"Израелско време", "Израелско стандартно време", "Израелско лятно часово време"},
+		{"Японско време", "Японско стандартно време", "Японско лятно часово време"},
+		{"Корейско време", "Корейско стандартно време", "Корейско лятно часово време"},
+		{"", "Малайзийско време", ""},
+		{"Московско време", "Московско стандартно време", "Московско лятно часово време"},
+		{"Новозеландско време", "Новозеландско стандартно време", "Новозеландско лятно часово време"},
+		{"Нюфаундлендско време", "Нюфаундлендско стандартно време", "Нюфаундлендско лятно часово време"},
+		{"Пакистанско време", "Пакистанско стандартно време", "Пакистанско лятно часово време"},
+		{"Перуанско време", "Перуанско стандартно време", "Перуанско лятно часово време"},
+		{"Филипинско време", "Филипинско стандартно време", "Филипинско лятно часово време"},
+		{"", "Сингапурско време", ""},
+		{"Тайпе", "Тайпе – стандартно време", "Тайпе – лятно часово време"},
+		{"", "Венецуелско време", ""},
+		{"", "Координирано универсално време", ""},
+		{"", "", "Ирландско стандартно време"},
+		{"", "", "Британско лятно часово време"},
+		{"", "", ""},
+	},
+	"bn": {
+		{"", "মধ্য আফ্রিকা সময়", ""},
+		{"", "পূর্ব আফ্রিকা সময়", ""},
+		{"", "দক্ষিণ আফ্রিকা মানক সময়", ""},
+		{"পশ্চিম আফ্রিকা সময়", "পশ্চিম আফ্রিকা মানক সময়", "পশ্চিম আফ্রিকা গ্রীষ্মকালীন সময়"},
+		{"আলাস্কা সময়", "আলাস্কা মানক সময়", "আলাস্কা দিবালোক সময়"},
+		{"কেন্দ্রীয় সময়", "কেন্দ্রীয় মানক সময়", "কেন্দ্রীয় দিবালোক সময়"},
+		{"পূর্বাঞ্চলীয় সময়", "পূর্বাঞ্চলের প্রমাণ সময়", "পূর্বাঞ্চলের দিবালোক সময়"},
+		{"পার্বত্য অঞ্চলের সময়", "পার্বত্য অঞ্চলের প্রমাণ সময়", "পার্বত্য অঞ্চলের দিনের সময়"},
+		{"প্রশান্ত মহাসাগরীয় অঞ্চলের সময়", "প্রশান্ত মহাসাগরীয় অঞ্চলের মানক সময়", "প্রশান্ত মহাসাগরীয় অঞ্চলের দিনের সময়"},
+		{"আরবি সময়", "আরবি মানক সময়", "আরবি দিবালোক সময়"},
+		{"আর্জেন্টিনা সময়", "আর্জেন্টিনা মানক সময়", "আর্জেন্টিনা গ্রীষ্মকালীন সময়"},
+		{"অতলান্তিকের সময়", "অতলান্তিক মানক সময়", "অতলান্তিক দিবালোক সময়"},
+		{"কেন্দ্রীয় অস্ট্রেলীয় সময়", "অস্ট্রেলীয় কেন্দ্রীয় মানক সময়", "অস্ট্রেলীয় কেন্দ্রীয় দিবালোক সময়"},
+		{"পূর্ব অস্ট্রেলীয় সময়", "অস্ট্রেলীয় পূর্ব মানক সময়", "অস্ট্রেলীয় পূর্ব দিবালোক সময়"},
+		{"পশ্চিমি অস্ট্রেলীয় সময়", "অস্ট্রেলীয় পশ্চিমি মানক সময়", "অস্ট্রেলীয় পশ্চিমি দিবালোক সময়"},
+		{"বাংলাদেশ সময়", "বাংলাদেশ মানক সময়", "বাংলাদেশ গ্রীষ্মকালীন সময়"},
+		{"ব্রাসিলিয়া সময়", "ব্রাসিলিয়া মানক সময়", "ব্রাসিলিয়া গ্রীষ্মকালীন সময়"},
+		{"চিলি সময়", "চিলি মানক সময়", "চিলি গ্রীষ্মকালীন সময়"},
+		{"চীন সময়", "চীন মানক সময়", "চীন দিবালোক সময়"},
+		{"কোলোম্বিয়া সময়", "কোলোম্বিয়া মানক সময়", "কোলোম্বিয়া গ্রীষ্মকালীন সময়"},
+		{"মধ্য ইউরোপীয় সময়", "মধ্য ইউরোপীয় মানক সময়", "মধ্য ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"পূর্ব ইউরোপীয় সময়", "পূর্ব ইউরোপীয় মানক সময়", "পূর্ব ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"পশ্চিম ইউরোপীয় সময়", "পশ্চিম ইউরোপীয় মানক সময়", "পশ্চিম ইউরোপীয় গ্রীষ্মকালীন সময়"},
+		{"", "গ্রীনিচ মিন টাইম", ""},
+		{"", "উপসাগরীয় মানক সময়", ""},
+		{"হাওয়াই অ্যালিউটিয়ান সময়", "হাওয়াই-আলেউত মানক সময়", "হাওয়াই-আলেউত দিবালোক সময়"},
+		{"হং কং সময়", "হং কং মানক সময়", "হং কং গ্রীষ্মকালীন সময়"},
+		{"", "ভারতীয় মানক সময়", ""},
+		{"", "ইন্দোচীন সময়", ""},
+		{"", "পশ্চিমী ইন্দোনেশিয়া সময়", ""},
+		{"ইরান সময়", "ইরান মানক সময়", "ইরান দিবালোক সময়"},
+		{"ইজরায়েল সময়", "ইজরায়েল মানক সময়", "ইজরায়েল দিবালোক সময়"},
+		{"জাপান সময়", "জাপান মানক সময়", "জাপান দিবালোক সময়"},
+		{"কোরিয়ান সময়", "কোরিয়ান মানক সময়", "কোরিয়ান দিবালোক সময়"},
+		{"", "মালয়েশিয়া সময়", ""},
+		{"মস্কো সময়", "মস্কো মানক সময়", "মস্কো গ্রীষ্মকালীন সময়"},
+		{"নিউজিল্যান্ড সময়", "নিউজিল্যান্ড মানক সময়", "নিউজিল্যান্ড দিবালোক সময়"},
+		{"নিউফাউন্ডল্যান্ড সময়", "নিউফাউন্ডল্যান্ড মানক সময়", "নিউফাউন্ডল্যান্ড দিবালোক সময়"},
+		{"পাকিস্তান সময়", "পাকিস্তান মানক সময়", "পাকিস্তান গ্রীষ্মকালীন সময়"},
+		{"পেরু সময়", "পেরু মানক সময়", "পেরু গ্রীষ্মকালীন সময়"},
+		{"ফিলিপাইন সময়", "ফিলিপাইন মানক সময়", "ফিলিপাইন গ্রীষ্মকালীন সময়"},
+		{"", "সিঙ্গাপুর মানক সময়", ""},
+		{"তাইপেই সময়", "তাইপেই মানক সময়", "তাইপেই দিবালোক সময়"},
+		{"", "ভেনেজুয়েলা সময়", ""},
+		{"", "স্থানাংকিত আন্তর্জাতিক সময়", ""},
+		{"", "", "আইরিশ মানক সময়"},
+		{"", "", "ব্রিটিশ গ্রীষ্মকালীন সময়"},
+		{"", "", ""},
+	},
+	"br": {
+		{"", "eur Kreizafrika", ""},
+		{"", "eur Afrika ar Reter", ""},
+		{"", "eur cʼhoañv Suafrika", ""},
+		{"eur Afrika ar Cʼhornôg", "eur cʼhoañv Afrika ar Cʼhornôg", "eur hañv Afrika ar Cʼhornôg"},
+		{"eur Alaska", "eur cʼhoañv Alaska", "eur hañv Alaska"},
+		{"", "", ""},
+		{"eur ar Reter", "eur cʼhoañv ar Reter", "eur hañv ar Reter"},
+		{"eur ar Menezioù", "eur cʼhoañv ar Menezioù", "eur hañv ar Menezioù"},
+		{"", "", ""},
+		{"eur Arabia", "eur cʼhoañv Arabia", "eur hañv Arabia"},
+		{"eur Arcʼhantina", "eur cʼhoañv Arcʼhantina", "eur hañv Arcʼhantina"},
+		{"", "", ""},
+		{"eur Kreizaostralia", "eur cʼhoañv Kreizaostralia", "eur hañv Kreizaostralia"},
+		{"eur Aostralia ar Reter", "eur cʼhoañv Aostralia ar Reter", "eur hañv Aostralia ar Reter"},
+		{"eur Aostralia ar Cʼhornôg", "eur cʼhoañv Aostralia ar Cʼhornôg", "eur hañv Aostralia ar Cʼhornôg"},
+		{"eur Bangladesh", "eur cʼhoañv Bangladesh", "eur hañv Bangladesh"},
+		{"eur Brasília", "eur cʼhoañv Brasília", "eur hañv Brasília"},
+		{"eur Chile", "eur cʼhoañv Chile", "eur hañv Chile"},
+		{"eur Sina", "eur cʼhoañv Sina", "eur hañv Sina"},
+		{"eur Kolombia", "eur cʼhoañv Kolombia", "eur hañv Kolombia"},
+		{"eur Kreizeuropa", "eur cʼhoañv Kreizeuropa", "eur hañv Kreizeuropa"},
+		{"eur Europa ar Reter", "eur cʼhoañv Europa ar Reter", "eur hañv Europa ar Reter"},
+		{"eur Europa ar Cʼhornôg", "eur cʼhoañv Europa ar Cʼhornôg", "eur hañv Europa ar Cʼhornôg"},
+		{"", "Amzer keitat Greenwich (AKG)", ""},
+		{"", "eur cʼhoañv ar Pleg-mor Arab-ha-Pers", ""},
+		{"", "", ""},
+		{"eur Hong Kong", "eur cʼhoañv Hong Kong", "eur hañv Hong Kong"},
+		{"", "eur cʼhoañv India", ""},
+		{"", "eur Indez-Sina", ""},
+		{"", "eur Indonezia ar Cʼhornôg", ""},
+		{"eur Iran", "eur cʼhoañv Iran", "eur hañv Iran"},
+		{"eur Israel", "eur cʼhoañv Israel", "eur hañv Israel"},
+		{"eur Japan", "eur cʼhoañv Japan", "eur hañv Japan"},
+		{"eur Korea", "eur cʼhoañv Korea", "eur hañv Korea"},
+		{"", "eur Malaysia", ""},
+		{"eur Moskov", "eur cʼhoañv Moskov", "eur hañv Moskov"},
+		{"eur Zeland-Nevez", "eur cʼhoañv Zeland-Nevez", "eur hañv Zeland-Nevez"},
+		{"eur Newfoundland", "eur cʼhoañv Newfoundland", "eur hañv Newfoundland"},
+		{"eur Pakistan", "eur cʼhoañv Pakistan", "eur hañv Pakistan"},
+		{"eur Perou", "eur cʼhoañv Perou", "eur hañv Perou"},
+		{"eur ar Filipinez", "eur cʼhoañv ar Filipinez", "eur hañv ar Filipinez"},
+		{"", "eur cʼhoañv Singapour", ""},
+		{"eur Taipei", "eur cʼhoañv Taipei", "eur hañv Taipei"},
+		{"", "eur Venezuela", ""},
+		{"", "", ""},
+		{"", "", "eur cʼhoañv Iwerzhon"},
+		{"", "", "eur hañv Breizh-Veur"},
+		{"", "", ""},
+	},
+	"brx": {
+		{"", "मध्य अफ्रीका स्टैंडर्ड टाईम", ""},
+		{"", "पूर्वी अफ्रीका स्टैंडर्ड टाईम", ""},
+		{"", "दक्षिण अफ्रीका स्टैंडर्ड टाईम", ""},
+		{"पश्चीम अफ्रीका टाईम", "पश्चीम अफ्रीका स्टैंडर्ड टाईम", "पश्चीम अफ्रीका समर टाईम"},
+		{"अलास्का टाईम", "अलास्का स्टैंडर्ड टाईम", "अलास्का डेलाईट टाईम"},
+		{"सैंट्रल अमरिका टाईम", "सैंट्रल अमरिका स्टैंडर्ड टाईम", "सैंट्रल अमरिका डेलाईट टाईम"},
+		{"ईस्टर्न अमरिका टाईम", "ईस्टर्न अमरिका स्टैंडर्ड टाईम", "ईस्टर्न अमरिका डेलाईट टाईम"},
+		{"अमरिका माऊन्टन टाईम", "अमरिका माऊन्टन स्टैंडर्ड टाईम", "अमरिका माऊन्टन डेलाईट टाईम"},
+		{"पैसीफीक टाईम", "पैसीफीक स्टैंडर्ड टाईम", "पैसीफीक डेलाईट टाईम"},
+		{"अरबी टाईम", "अरबी स्टैंडर्ड टाईम", "अरबी डेलाईट टाईम"},
+		{"अर्जनटिना टाईम", "अर्जनटिना स्टैंडर्ड टाईम", "अर्जण्टिना समर टाईम"},
+		{"अटलांटीक टाईम", "अटलांटीक स्टैंडर्ड टाईम", "अटलांटीक डेलाईट टाईम"},
+		{"ओस्ट्रेलिया टाईम", "मध्य ओस्ट्रेलिया स्टैंडर्ड टाईम", "मध्य ओस्ट्रेलिया डेलाईट टाईम"},
+		{"पूर्वी ओस्ट्रेलिया टाईम", "पूर्वी ओस्ट्रेलिया स्टैंडर्ड टाईम", "पूर्वी ओस्ट्रेलिया डेलाईट टाईम"},
+		{"दक्षिण ओस्ट्रेलिया टाईम", "दक्षिण ओस्ट्रेलिया स्टैंडर्ड टाईम", "दक्षिण ओस्ट्रेलिया डेलाईट टाईम"},
+		{"बांगलादेश टाईम", "बांगलादेश स्टैंडर्ड टाईम", "बांगलादेश समर टाईम"},
+		{"ब्राज़ीलिया टाईम", "ब्राज़ीलिया स्टैंडर्ड टाईम", "ब्राज़ीलिया समर टाईम"},
+		{"चीली टाईम", "चीली स्टैंडर्ड टाईम", "चीली समर टाईम"},
+		{"चाईना टाईम", "चाईना स्टैंडर्ड टाईम", "चाईना डेलाईट टाईम"},
+		{"कोलंबिया टाईम", "कोलंबिया स्टैंडर्ड टाईम", "कोलंबिया समर टाईम"},
+		{"मध्य यूरोप टाईम", "मध्य यूरोप स्टैंडर्ड टाईम", "मध्य यूरोप समर टाईम"},
+		{"ईस्टर्न यूरोप टाईम", "ईस्टर्न यूरोप स्टैंडर्ड टाईम", "ईस्टर्न यूरोप समर टाईम"},
+		{"वेस्टर्न यूरोप टाईम", "वेस्टर्न यूरोप स्टैंडर्ड टाईम", "वेस्टर्न यूरोप समर टाईम"},
+		{"", "ग्रीनीच स्टैंडर्ड टाईम", ""},
+		{"", "गल्फ़ स्टैंडर्ड टाईम", ""},
+		{"हवाई आलटन टाईम", "हवाई आलटन स्टैंडर्ड टाईम", "हवाई आलटन डेलाईट टाईम"},
+		{"हाँगकॉंग टाईम", "हाँगकॉंग स्टैंडर्ड टाईम", "हाँगकॉंग समर टाईम"},
+		{"", "भारतीय स्टैंडर्ड टाईम", ""},
+		{"", "ईंडो चइना स्टैंडर्ड टाईम", ""},
+		{"", "वेस्टर्न ईंडोनीशिया स्टैंडर्ड टाईम", ""},
+		{"ईरान टाईम", "ईरान स्टैंडर्ड टाईम", "ईरान डेलाईट टाईम"},
+		{"ईस्राइल टाईम", "ईस्राइल स्टैंडर्ड टाईम", "ईस्राइल डेलाईट टाईम"},
+		{"जपान टाईम", "जपान स्टैंडर्ड टाईम", "जपान डेलाईट टाईम"},
+		{"कोरिया टाईम", "कोरिया स्टैंडर्ड टाईम", "कोरिया डेलाईट टाईम"},
+		{"", "मलेशिया स्टैंडर्ड टाईम", ""},
+		{"मॉस्को टाईम", "मॉस्को स्टैंडर्ड टाईम", "मॉस्को समर टाईम"},
+		{"न्युज़ीलैण्ड टाईम", "न्युज़ीलैण्ड स्टैंडर्ड टाईम", "न्युज़ीलैण्ड डेलाईट टाईम"},
+		{"न्युफाऊंडलैण्ड टाईम", "न्युफाऊंडलैण्ड स्टैंडर्ड टाईम", "न्युफाऊंडलैण्ड डेलाईट टाईम"},
+		{"पाकिस्तान टाईम", "पाकिस्तान स्टैंडर्ड टाईम", "पाकिस्तान समर टाईम"},
+		{"पेरु टाईम", "पेरु स्टैंडर्ड टाईम", "पेरु समर टाईम"},
+		{"फीलीपीन्स टाईम", "फीलीपीन्स स्टैंडर्ड टाईम", "फीलीपीन्स समर टाईम"},
+		{"", "सींगापुर स्टैंडर्ड टाईम", ""},
+		{"", "", ""},
+		{"", "वेनेज़ुएला स्टैंडर्ड टाईम", ""},
+		{"", "", ""},
+		{"", "", "आईरीश समर टाईम"},
+		{"", "", "ब्रीटीश समर टाईम"},
+		{"", "", ""},
+	},
+	"bs": {
+		{"", "Centralnoafričko vrijeme", ""},
+		{"", "Istočnoafričko vrijeme", ""},
+		{"", "Južnoafričko standardno vrijeme", ""},
+		{"Zapadnoafričko vrijeme", "Zapadnoafričko standardno vrijeme", "Zapadnoafričko ljetno vrijeme"},
+		{"Aljaskansko vrijeme", "Aljaskansko standardno vrijeme", "Aljaskansko ljetno vrijeme"},
+		{"Sjevernoameričko centralno vrijeme", "Sjevernoameričko centralno standardno vrijeme", "Sjevernoameričko centralno ljetno vrijeme"},
+		{"Sjevernoameričko istočno vrijeme", "Sjevernoameričko istočno standardno vrijeme", "Sjevernoameričko istočno ljetno vrijeme"},
+		{"Sjevernoameričko planinsko vrijeme", "Sjevernoameričko planinsko standardno vrijeme", "Sjevernoameričko planinsko ljetno vrijeme"},
+		{"Sjevernoameričko pacifičko vrijeme", "Sjevernoameričko pacifičko standardno vrijeme", "Sjevernoameričko pacifičko ljetno vrijeme"},
+		{"Arabijsko vrijeme", "Arabijsko standardno vrijeme", "Arabijsko ljetno vrijeme"},
+		{"Argentinsko vrijeme", "Argentinsko standardno vrijeme", "Argentinsko ljetno vrijeme"},
+		{"Sjevernoameričko atlantsko vrijeme", "Sjevernoameričko atlantsko standardno vrijeme", "Sjevernoameričko atlantsko ljetno vrijeme"},
+		{"Centralnoaustralijsko vrijeme", "Centralnoaustralijsko standardno vrijeme", "Centralnoaustralijsko ljetno vrijeme"},
+		{"Istočnoaustralijsko vrijeme", "Istočnoaustralijsko standardno vrijeme", "Istočnoaustralijsko ljetno vrijeme"},
+		{"Zapadnoaustralijsko vrijeme", "Zapadnoaustralijsko standardno vrijeme", "Zapadnoaustralijsko ljetno vrijeme"},
+		{"Bangladeško vrijeme", "Bangladeško standardno vrijeme", "Bangladeško ljetno vrijeme"},
+		{"Brazilijsko vrijeme", "Brazilijsko standardno vrijeme", "Brazilijsko ljetno vrijeme"},
+		{"Čileansko vrijeme", "Čileansko standardno vrijeme", "Čileansko ljetno vrijeme"},
+		{"Kinesko vrijeme", "Kinesko standardno vrijeme", "Kinesko ljetno vrijeme"},
+		{"Kolumbijsko vrijeme", "Kolumbijsko standardno vrijeme", "Kolumbijsko ljetno vrijeme"},
+		{"Centralnoevropsko vrijeme", "Centralnoevropsko standardno vrijeme", "Centralnoevropsko ljetno vrijeme"},
+		{"Istočnoevropsko vrijeme", "Istočnoevropsko standardno vrijeme", "Istočnoevropsko ljetno vrijeme"},
+		{"Zapadnoevropsko vrijeme", "Zapadnoevropsko standardno vrijeme", "Zapadnoevropsko ljetno vrijeme"},
+		{"", "Griničko vrijeme", ""},
+		{"", "Zalivsko standardno vrijeme", ""},
+		{"Havajsko-aleućansko vrijeme", "Havajsko-aleućansko standardno vrijeme", "Havajsko-aleućansko ljetno vrijeme"},
+		{"Hongkonško vrijeme", "Hongkonško standardno vrijeme", "Hongkonško ljetno vrijeme"},
+		{"", "Indijsko standardno vrijeme", ""},
+		{"", "Indokinesko vrijeme", ""},
+		{"", "Zapadnoindonezijsko vrijeme", ""},
+		{"Iransko vrijeme", "Iransko standardno vrijeme", "Iransko ljetno vrijeme"},
+		{"Izraelsko vrijeme", "Izraelsko standardno vrijeme", "Izraelsko ljetno vrijeme"},
+		{"Japansko vrijeme", "Japansko standardno vrijeme", "Japansko ljetno vrijeme"},
+		{"Korejsko vrijeme", "Korejsko standardno vrijeme", "Korejsko ljetno vrijeme"},
+		{"", "Malezijsko vrijeme", ""},
+		{"Moskovsko vrijeme", "Moskovsko standardno vrijeme", "Moskovsko ljetno vrijeme"},
+		{"Novozelandsko vrijeme", "Novozelandsko standardno vrijeme", "Novozelandsko ljetno vrijeme"},
+		{"Njufaundlendsko vrijeme", "Njufaundlendsko standardno vrijeme", "Njufaundlendsko ljetno vrijeme"},
+		{"Pakistansko vrijeme", "Pakistansko standardno vrijeme", "Pakistansko ljetno vrijeme"},
+		{"Peruansko vrijeme", "Peruansko standardno vrijeme", "Peruansko ljetno vrijeme"},
+		{"Filipinsko vrijeme", "Filipinsko standardno vrijeme", "Filipinsko ljetno vrijeme"},
+		{"", "Singapursko standardno vrijeme", ""},
+		{"Tajpejsko vrijeme", "Tajpejsko standardno vrijeme", "Tajpejsko ljetno vrijeme"},
+		{"", "Venecuelansko vrijeme", ""},
+		{"", "Koordinirano svjetsko vrijeme", ""},
+		{"", "", "Irsko standardno vrijeme"},
+		{"", "", "Britansko ljetno vrijeme"},
+		{"", "", ""},
+	},
+	"ca": {
+		{"", "Hora de l’Àfrica Central", ""},
+		{"", "Hora de l’Àfrica Oriental", ""},
+		{"", "Hora estàndard del sud de l’Àfrica", ""},
+		{"Hora de l’Àfrica Occidental", "Hora estàndard de l’Àfrica Occidental", "Hora d’estiu de l’Àfrica Occidental"},
+		{"Hora d’Alaska", "Hora estàndard d’Alaska", "Hora d’estiu d’Alaska"},
+		{"Hora central d’Amèrica del Nord", "Hora estàndard central d’Amèrica del Nord", "Hora d’estiu central d’Amèrica del Nord"},
+		{"Hora oriental d’Amèrica del Nord", "Hora estàndard oriental d’Amèrica del Nord", "Hora d’estiu oriental d’Amèrica del Nord"},
+		{"Hora de muntanya d’Amèrica del Nord", "Hora estàndard de muntanya d’Amèrica del Nord", "Hora d’estiu de muntanya d’Amèrica del Nord"},
+		{"Hora del Pacífic", "Hora estàndard del Pacífic", "Hora d’estiu del Pacífic"},
+		{"Hora àrab", "Hora estàndard àrab", "Hora d’estiu àrab"},
+		{"Hora de l’Argentina", "Hora estàndard de l’Argentina", "Hora d’estiu de l’Argentina"},
+		{"Hora de l’Atlàntic", "Hora estàndard de l’Atlàntic", "Hora d’estiu de l’Atlàntic"},
+		{"Hora d’Austràlia Central", "Hora estàndard d’Austràlia Central", "Hora d’estiu d’Austràlia Central"},
+		{"Hora d’Austràlia Oriental", "Hora estàndard d’Austràlia Oriental", "Hora d’estiu d’Austràlia Oriental"},
+		{"Hora d’Austràlia Occidental", "Hora estàndard d’Austràlia Occidental", "Hora d’estiu d’Austràlia Occidental"},
+		{"Hora de Bangla Desh", "Hora estàndard de Bangla Desh", "Hora d’estiu de Bangla Desh"},
+		{"Hora de Brasília", "Hora estàndard de Brasília", "Hora d’estiu de Brasília"},
+		{"Hora de Xile", "Hora estàndard de Xile", "Hora d’estiu de Xile"},
+		{"Hora de la Xina", "Hora estàndard de la Xina", "Hora d’estiu de la Xina"},
+		{"Hora de Colòmbia", "Hora estàndard de Colòmbia", "Hora d’estiu de Colòmbia"},
+		{"Hora del Centre d’Europa", "Hora estàndard del Centre d’Europa", "Hora d’estiu del Centre d’Europa"},
+		{"Hora de l’Est d’Europa", "Hora estàndard de l’Est d’Europa", "Hora d’estiu de l’Est d’Europa"},
+		{"Hora de l’Oest d’Europa", "Hora estàndard de l’Oest d’Europa", "Hora d’estiu de l’Oest d’Europa"},
+		{"", "Hora del Meridià de Greenwich", ""},
+		{"", "Hora del Golf", ""},
+		{"Hora de Hawaii-Aleutianes", "Hora estàndard de Hawaii-Aleutianes", "Hora d’estiu de Hawaii-Aleutianes"},
+		{"Hora de Hong Kong", "Hora estàndard de Hong Kong", "Hora d’estiu de Hong Kong"},
+		{"", "Hora estàndard de l’Índia", ""},
+		{"", "Hora d’Indoxina", ""},
+		{"", "Hora de l’oest d’Indonèsia", ""},
+		{"Hora d’Iran", "Hora estàndard d’Iran", "Hora d’estiu d’Iran"},
+		{"Hora d’Israel", "Hora estàndard d’Israel", "Hora d’estiu d’Israel"},
+		{"Hora del Japó", "Hora estàndard del Japó", "Hora d’estiu del Japó"},
+		{"Hora de Corea", "Hora estàndard de Corea", "Hora d’estiu de Corea"},
+		{"", "Hora de Malàisia", ""},
+		{"Hora de Moscou", "Hora estàndard de Moscou", "Hora d’estiu de Moscou"},
+		{"Hora de Nova Zelanda", "Hora estàndard de Nova Zelanda", "Hora d’estiu de Nova Zelanda"},
+		{"Hora de Terranova", "Hora estàndard de Terranova", "Hora d’estiu de Terranova"},
+		{"Hora del Pakistan", "Hora estàndard del Pakistan", "Hora d’estiu del Pakistan"},
+		{"Hora del Perú", "Hora estàndard del Perú", "Hora d’estiu del Perú"},
+		{"Hora de Filipines", "Hora estàndard de Filipines", "Hora d’estiu de Filipines"},
+		{"", "Hora de Singapur", ""},
+		{"Hora de Taipei", "Hora estàndard de Taipei", "Hora d’estiu de Taipei"},
+		{"", "Hora de Veneçuela", ""},
+		{"", "Temps universal coordinat", ""},
+		{"", "", "Hora estàndard d’Irlanda"},
+		{"", "", "Hora d’estiu britànica"},
+		{"", "", ""},
+	},
+	"cs": {
+		{"", "Středoafrický čas", ""},
+		{"", "Východoafrický čas", ""},
+		{"", "Jihoafrický čas", ""},
+		{"Západoafrický čas", "Západoafrický standardní čas", "Západoafrický letní čas"},
+		{"Aljašský čas", "Aljašský standardní čas", "Aljašský letní čas"},
+		{"Severoamerický centrální čas", "Severoamerický centrální standardní čas", "Severoamerický centrální letní čas"},
+		{"Severoamerický východní čas", "Severoamerický východní standardní čas", "Severoamerický východní letní čas"},
+		{"Severoamerický horský čas", "Severoamerický horský standardní čas", "Severoamerický horský letní čas"},
+		{"Severoamerický pacifický čas", "Severoamerický pacifický standardní čas", "Severoamerický pacifický letní čas"},
+		{"Arabský čas", "Arabský standardní čas", "Arabský letní čas"},
+		{"Argentinský čas", "Argentinský standardní čas", "Argentinský letní čas"},
+		{"Atlantický čas", "Atlantický standardní čas", "Atlantický letní čas"},
+		{"Středoaustralský čas", "Středoaustralský standardní čas", "Středoaustralský letní čas"},
+		{"Východoaustralský čas", "Východoaustralský standardní čas", "Východoaustralský letní čas"},
+		{"Západoaustralský čas", "Západoaustralský standardní čas", "Západoaustralský letní čas"},
+		{"Bangladéšský čas", "Bangladéšský standardní čas", "Bangladéšský letní čas"},
+		{"Brasilijský čas", "Brasilijský standardní čas", "Brasilijský letní čas"},
+		{"Chilský čas", "Chilský standardní čas", "Chilský letní čas"},
+		{"Čínský čas", "Čínský standardní čas", "Čínský letní čas"},
+		{"Kolumbijský čas", "Kolumbijský standardní čas", "Kolumbijský letní čas"},
+		{"Středoevropský čas", "Středoevropský standardní čas", "Středoevropský letní čas"},
+		{"Východoevropský čas", "Východoevropský standardní čas", "Východoevropský letní čas"},
+		{"Západoevropský čas", "Západoevropský standardní čas", "Západoevropský letní čas"},
+		{"", "Greenwichský střední čas", ""},
+		{"", "Standardní čas Perského zálivu", ""},
+		{"Havajsko-aleutský čas", "Havajsko-aleutský standardní čas", "Havajsko-aleutský letní čas"},
+		{"Hongkongský čas", "Hongkongský standardní čas", "Hongkongský letní čas"},
+		{"", "Indický čas", ""},
+		{"", "Indočínský čas", ""},
+		{"", "Západoindonéský čas", ""},
+		{"Íránský čas", "Íránský standardní čas", "Íránský letní čas"},
+		{"Izraelský čas", "Izraelský standardní čas", "Izraelský letní čas"},
+		{"Japonský čas", "Japonský standardní čas", "Japonský letní čas"},
+		{"Korejský čas", "Korejský standardní čas", "Korejský letní čas"},
+		{"", "Malajský čas", ""},
+		{"Moskevský čas", "Moskevský standardní čas", "Moskevský letní čas"},
+		{"Novozélandský čas", "Novozélandský standardní čas", "Novozélandský letní čas"},
+		{"Newfoundlandský čas", "Newfoundlandský standardní čas", "Newfoundlandský letní čas"},
+		{"Pákistánský čas", "Pákistánský standardní čas", "Pákistánský letní čas"},
+		{"Peruánský čas", "Peruánský standardní čas", "Peruánský letní čas"},
+		{"Filipínský čas", "Filipínský standardní čas", "Filipínský letní čas"},
+		{"", "Singapurský čas", ""},
+		{"Tchajpejský čas", "Tchajpejský standardní čas", "Tchajpejský letní čas"},
+		{"", "Venezuelský čas", ""},
+		{"", "Koordinovaný světový čas", ""},
+		{"", "", "Irský letní čas"},
+		{"", "", "Britský letní čas"},
+		{"", "", ""},
+	},
+	"cy": {
+		{"", "Amser Canolbarth Affrica", ""},
+		{"", "Amser Dwyrain Affrica", ""},
+		{"", "Amser Safonol De Affrica", ""},
+		{"Amser Gorllewin Affrica", "Amser Safonol Gorllewin Affrica", "Amser Haf Gorllewin Affrica"},
+		{"Amser Alaska", "Amser Safonol Alaska", "Amser Haf Alaska"},
+		{"Amser Canolbarth Gogledd America", "Amser Safonol Canolbarth Gogledd America", "Amser Haf Canolbarth Gogledd America"},
+		{"Amser Dwyrain Gogledd America", "Amser Safonol Dwyrain Gogledd America", "Amser Haf Dwyrain Gogledd America"},
+		{"Amser Mynyddoedd Gogledd America", "Amser Safonol Mynyddoedd Gogledd America", "Amser Haf Mynyddoedd Gogledd America"},
+		{"Amser Cefnfor Tawel Gogledd America", "Amser Safonol Cefnfor Tawel Gogledd America", "Amser Haf Cefnfor Tawel Gogledd America"},
+		{"Amser Arabaidd", "Amser Safonol Arabaidd", "Amser Haf Arabaidd"},
+		{"Amser yr Ariannin", "Amser Safonol Ariannin", "Amser Haf Ariannin"},
+		{"Amser Cefnfor yr Iwerydd", "Amser Safonol Cefnfor yr Iwerydd", "Amser Haf Cefnfor yr Iwerydd"},
+		{"Amser Canolbarth Awstralia", "Amser Safonol Canolbarth Awstralia", "Amser Haf Canolbarth Awstralia"},
+		{"Amser Dwyrain Awstralia", "Amser Safonol Dwyrain Awstralia", "Amser Haf Dwyrain Awstralia"},
+		{"Amser Gorllewin Awstralia", "Amser Safonol Gorllewin Awstralia", "Amser Haf Gorllewin Awstralia"},
+		{"Amser Bangladesh", "Amser Safonol Bangladesh", "Amser Haf Bangladesh"},
+		{"Amser Brasília", "Amser Safonol Brasília", "Amser Haf Brasília"},
+		{"Amser Chile", "Amser Safonol Chile", "Amser Haf Chile"},
+		{"Amser Tsieina", "Amser Safonol Tsieina", "Amser Haf Tsieina"},
+		{"Amser Colombia", "Amser Safonol Colombia", "Amser Haf Colombia"},
+		{"Amser Canolbarth Ewrop", "Amser Safonol Canolbarth Ewrop", "Amser Haf Canolbarth Ewrop"},
+		{"Amser Dwyrain Ewrop", "Amser Safonol Dwyrain Ewrop", "Amser Haf Dwyrain Ewrop"},
+		{"Amser Gorllewin Ewrop", "Amser Safonol Gorllewin Ewrop", "Amser Haf Gorllewin Ewrop"},
+		{"", "Amser Safonol Greenwich", ""},
+		{"", "Amser Safonol y Gwlff", ""},
+		{"Amser Hawaii-Aleutian", "Amser Safonol Hawaii-Aleutian", "Amser Haf Hawaii-Aleutian"},
+		{"Amser Hong Kong", "Amser Safonol Hong Kong", "Amser Haf Hong Kong"},
+		{"", "Amser India", ""},
+		{"", "Amser Indo-Tsieina", ""},
+		{"", "Amser Gorllewin Indonesia", ""},
+		{"Amser Iran", "Amser Safonol Iran", "Amser Haf Iran"},
+		{"Amser Israel", "Amser Safonol Israel", "Amser Haf Israel"},
+		{"Amser Siapan", "Amser Safonol Siapan", "Amser Haf Siapan"},
+		{"Amser Corea", "Amser Safonol Corea", "Amser Haf Corea"},
+		{"", "Amser Malaysia", ""},
+		{"Amser Moscfa", "Amser Safonol Moscfa", "Amser Haf Moscfa"},
+		{"Amser Seland Newydd", "Amser Safonol Seland Newydd", "Amser Haf Seland Newydd"},
+		{"Amser Newfoundland", "Amser Safonol Newfoundland", "Amser Haf Newfoundland"},
+		{"Amser Pakistan", "Amser Safonol Pakistan", "Amser Haf Pakistan"},
+		{"Amser Periw", "Amser Safonol Periw", "Amser Haf Periw"},
+		{"Amser Pilipinas", "Amser Safonol Pilipinas", "Amser Haf Pilipinas"},
+		{"", "Amser Singapore", ""},
+		{"Amser Taipei", "Amser Safonol Taipei", "Amser Haf Taipei"},
+		{"", "Amser Venezuela", ""},
+		{"", "Amser Cyffredniol Cydlynol", ""},
+		{"", "", "Amser Safonol Iwerddon"},
+		{"", "", "Amser Haf Prydain"},
+		{"", "", ""},
+	},
+	"da": {
+		{"", "Centralafrikansk tid", ""},
+		{"", "Østafrikansk tid", ""},
+		{"", "Sydafrikansk tid", ""},
+		{"Vestafrikansk tid", "Vestafrikansk normaltid", "Vestafrikansk sommertid"},
+		{"Alaska-tid", "Alaska-normaltid", "Alaska-sommertid"},
+		{"Central-tid", "Central-normaltid", "Central-sommertid"},
+		{"Eastern-tid", "Eastern-normaltid", "Eastern-sommertid"},
+		{"Mountain-tid", "Mountain-normaltid", "Mountain-sommertid"},
+		{"Pacific-tid", "Pacific-normaltid", "Pacific-sommertid"},
+		{"Arabisk tid", "Arabisk normaltid", "Arabisk sommertid"},
+		{"Argentisk tid", "Argentinsk normaltid", "Argentinsk sommertid"},
+		{"Atlantic-tid", "Atlantic-normaltid", "Atlantic-sommertid"},
+		{"Centralaustralsk tid", "Centralaustralsk normaltid", "Centralaustralsk sommertid"},
+		{"Østaustralsk tid", "Østaustralsk normaltid", "Østaustralsk sommertid"},
+		{"Vestaustralsk tid", "Vestaustralsk normaltid", "Vestaustralsk sommertid"},
+		{"Bangladesh-tid", "Bangladesh-normaltid", "Bangladesh-sommertid"},
+		{"Brasiliansk tid", "Brasiliansk normaltid", "Brasiliansk sommertid"},
+		{"Chilensk tid", "Chilensk normaltid", "Chilensk sommertid"},
+		{"Kinesisk tid", "Kinesisk normaltid", "Kinesisk sommertid"},
+		{"Colombiansk tid", "Colombiansk normaltid", "Colombiansk sommertid"},
+		{"Centraleuropæisk tid", "Centraleuropæisk normaltid", "Centraleuropæisk sommertid"},
+		{"Østeuropæisk tid", "Østeuropæisk normaltid", "Østeuropæisk sommertid"},
+		{"Vesteuropæisk tid", "Vesteuropæisk normaltid", "Vesteuropæisk sommertid"},
+		{"", "GMT", ""},
+		{"", "Golflandene-normaltid", ""},
+		{"Hawaii-Aleutian-tid", "Hawaii-Aleutian-normaltid", "Hawaii-Aleutian-sommertid"},
+		{"Hongkong-tid", "Hongkong-normaltid", "Hongkong-sommertid"},
+		{"", "Indien-normaltid", ""},
+		{"", "Indokina-tid", ""},
+		{"", "Vestindonesisk tid", ""},
+		{"Iran-tid", "Iran-normaltid", "Iran-sommertid"},
+		{"Israelsk tid", "Israelsk normaltid", "Israelsk sommertid"},
+		{"Japansk tid", "Japansk normaltid", "Japansk sommertid"},
+		{"Koreansk tid", "Koreansk normaltid", "Koreansk sommertid"},
+		{"", "Malaysia-tid", ""},
+		{"Moskva-tid", "Moskva-normaltid", "Moskva-sommertid"},
+		{"New Zealand-tid", "New Zealand-normaltid", "New Zealand-sommertid"},
+		{"Newfoundlandsk tid", "Newfoundlandsk normaltid", "Newfoundlandsk sommertid"},
+		{"Pakistan-tid", "Pakistan-normaltid", "Pakistan-sommertid"},
+		{"Peruviansk tid", "Peruviansk normaltid", "Peruviansk sommertid"},
+		{"Filippinsk tid", "Filippinerne-normaltid", "Filippinerne-sommertid"},
+		{"", "Singapore-tid", ""},
+		{"Taipei-tid", "Taipei-normaltid", "Taipei-sommertid"},
+		{"", "Venezuelansk tid", ""},
+		{"", "Koordineret universaltid", ""},
+		{"", "", "Irsk normaltid"},
+		{"", "", "Britisk sommertid"},
+		{"", "", ""},
+	},
+	"de": {
+		{"", "Zentralafrikanische Zeit", ""},
+		{"", "Ostafrikanische Zeit", ""},
+		{"", "Südafrikanische Zeit", ""},
+		{"Westafrikanische Zeit", "Westafrikanische Normalzeit", "Westafrikanische Sommerzeit"},
+		{"Alaska-Zeit", "Alaska-Normalzeit", "Alaska-Sommerzeit"},
+		{"Nordamerikanische Inlandzeit", "Nordamerikanische Inland-Normalzeit", "Nordamerikanische Inland-Sommerzeit"},
+		{"Nordamerikanische Ostküstenzeit", "Nordamerikanische Ostküsten-Normalzeit", "Nordamerikanische Ostküsten-Sommerzeit"},
+		{"Rocky-Mountain-Zeit", "Rocky Mountain-Normalzeit", "Rocky-Mountain-Sommerzeit"},
+		{"Nordamerikanische Westküstenzeit", "Nordamerikanische Westküsten-Normalzeit", "Nordamerikanische Westküsten-Sommerzeit"},
+		{"Arabische Zeit", "Arabische Normalzeit", "Arabische Sommerzeit"},
+		{"Argentinische Zeit", "Argentinische Normalzeit", "Argentinische Sommerzeit"},
+		{"Atlantik-Zeit", "Atlantik-Normalzeit", "Atlantik-Sommerzeit"},
+		{"Zentralaustralische Zeit", "Zentralaustralische Normalzeit", "Zentralaustralische Sommerzeit"},
+		{"Ostaustralische Zeit", "Ostaustralische Normalzeit", "Ostaustralische Sommerzeit"},
+		{"Westaustralische Zeit", "Westaustralische Normalzeit", "Westaustralische Sommerzeit"},
+		{"Bangladesch-Zeit", "Bangladesch-Normalzeit", "Bangladesch-Sommerzeit"},
+		{"Brasília-Zeit", "Brasília-Normalzeit", "Brasília-Sommerzeit"},
+		{"Chilenische Zeit", "Chilenische Normalzeit", "Chilenische Sommerzeit"},
+		{"Chinesische Zeit", "Chinesische Normalzeit", "Chinesische Sommerzeit"},
+		{"Kolumbianische Zeit", "Kolumbianische Normalzeit", "Kolumbianische Sommerzeit"},
+		{"Mitteleuropäische Zeit", "Mitteleuropäische Normalzeit", "Mitteleuropäische Sommerzeit"},
+		{"Osteuropäische Zeit", "Osteuropäische Normalzeit", "Osteuropäische Sommerzeit"},
+		{"Westeuropäische Zeit", "Westeuropäische Normalzeit", "Westeuropäische Sommerzeit"},
+		{"", "Mittlere Greenwich-Zeit", ""},
+		{"", "Golf-Zeit", ""},
+		{"Hawaii-Aleuten-Zeit", "Hawaii-Aleuten-Normalzeit", "Hawaii-Aleuten-Sommerzeit"},
+		{"Hongkong-Zeit", "Hongkong-Normalzeit", "Hongkong-Sommerzeit"},
+		{"", "Indische Zeit", ""},
+		{"", "Indochina-Zeit", ""},
+		{"", "Westindonesische Zeit", ""},
+		{"Iranische Zeit", "Iranische Normalzeit", "Iranische Sommerzeit"},
+		{"Israelische Zeit", "Israelische Normalzeit", "Israelische Sommerzeit"},
+		{"Japanische Zeit", "Japanische Normalzeit", "Japanische Sommerzeit"},
+		{"Koreanische Zeit", "Koreanische Normalzeit", "Koreanische Sommerzeit"},
+		{"", "Malaysische Zeit", ""},
+		{"Moskauer Zeit", "Moskauer Normalzeit", "Moskauer Sommerzeit"},
+		{"Neuseeland-Zeit", "Neuseeland-Normalzeit", "Neuseeland-Sommerzeit"},
+		{"Neufundland-Zeit", "Neufundland-Normalzeit", "Neufundland-Sommerzeit"},
+		{"Pakistanische Zeit", "Pakistanische Normalzeit", "Pakistanische Sommerzeit"},
+		{"Peruanische Zeit", "Peruanische Normalzeit", "Peruanische Sommerzeit"},
+		{"Philippinische Zeit", "Philippinische Normalzeit", "Philippinische Sommerzeit"},
+		{"", "Singapur-Zeit", ""},
+		{"Taipeh-Zeit", "Taipeh-Normalzeit", "Taipeh-Sommerzeit"},
+		{"", "Venezuela-Zeit", ""},
+		{"", "Koordinierte Weltzeit", ""},
+		{"", "", "Irische Sommerzeit"},
+		{"", "", "Britische Sommerzeit"},
+		{"", "", ""},
+	},
+	"dz": {
+		{"", "དབུས་ཕྱོགས་ཨཕ་རི་ཀཱ་ཆུ་ཚོད", ""},
+		{"", "ཤར་ཕྱོགས་ཨཕ་རི་ཀཱ་ཆུ་ཚོད", ""},
+		{"", "ལྷོ་ཕྱོགས་ཨཕ་རི་ཀཱ་ཆུ་ཚོད", ""},
+		{"ནུབ་ཕྱོགས་ཨཕ་རི་ཀཱ་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཨཕ་རི་ཀཱ་ཚད་ལྡན་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཨཕ་རི་ཀཱ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ཨ་ལསི་ཀ་ཆུ་ཚོད", "ཨ་ལསི་ཀ་ཚད་ལྡན་ཆུ་ཚོད", "ཨ་ལསི་ཀ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"བྱང་ཨ་མི་རི་ཀ་དབུས་ཕྱོགས་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་དབུས་ཕྱོགས་ཚད་ལྡན་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་དབུས་ཕྱོགས་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"བྱང་ཨ་མི་རི་ཀ་ཤར་ཕྱོགས་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་ཤར་ཕྱོགས་ཚད་ལྡན་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་ཤར་ཕྱོགས་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"བྱང་ཨ་མི་རི་ཀ་མའུ་ཊེན་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་མའུ་ཊེན་ཚད་ལྡན་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་མའུ་ཊེན་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"བྱང་ཨ་མི་རི་ཀ་པེ་སི་ཕིག་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་པེ་སི་ཕིག་ཚད་ལྡན་ཆུ་ཚོད", "བྱང་ཨ་མི་རི་ཀ་པེ་སི་ཕིག་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཨ་རེ་བྷི་ཡན་ཆུ་ཚོད", "ཨ་རེ་བྷི་ཡན་ཚད་ལྡན་ཆུ་ཚོད", "ཨ་རེ་བྷི་ཡན་སྲུང་ཆུ་ཚོད"},
+		{"ཨར་ཇེན་ཊི་ན་ཆུ་ཚོད", "ཨར་ཇེན་ཊི་ན་ཚད་ལྡན་ཆུ་ཚོད", "ཨར་ཇེན་ཊི་ན་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ཨེཊ་ལེན་ཊིཀ་ཆུ་ཚོད", "ཨེཊ་ལེན་ཊིཀ་ཚད་ལྡན་ཆུ་ཚོད", "ཨེཊ་ལེན་ཊིཀ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"དབུས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཆུ་ཚོད", "དབུས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "དབུས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཤར་ཕྱོགས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཆུ་ཚོད", "ཤར་ཕྱོགས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "ཤར་ཕྱོགས་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ནུབ་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཨཱོས་ཊྲེལ་ལི་ཡ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"བངྒ་ལ་དེཤ་ཆུ་ཚོད", "བངྒ་ལ་དེཤ་ཚད་ལྡན་ཆུ་ཚོད", "བངྒ་ལ་དེཤ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"བྲ་ཛི་ལི་ཡ་ཆུ་ཚོད", "བྲ་ཛི་ལི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "བྲ་ཛི་ལི་ཡ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ཅི་ལི་ཆུ་ཚོད", "ཅི་ལི་ཚད་ལྡན་ཆུ་ཚོད", "ཅི་ལི་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"རྒྱ་ནག་ཆུ་ཚོད", "རྒྱ་ནག་ཚད་ལྡན་ཆུ་ཚོད", "རྒྱ་ནག་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཀོ་ལོམ་བྷི་ཡ་ཆུ་ཚོད", "ཀོ་ལོམ་བྷི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "ཀོ་ལོམ་བྷི་ཡ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"དབུས་ཕྱོགས་ཡུ་རོ་པེན་ཆུ་ཚོད", "དབུས་ཕྱོགས་ཡུ་རོ་པེན་ཚད་ལྡན་ཆུ་ཚོད", "དབུས་ཕྱོགས་ཡུ་རོ་པེན་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ཤར་ཕྱོགས་ཡུ་རོ་པེན་ཆུ་ཚོད", "ཤར་ཕྱོགས་ཡུ་རོ་པེན་ཚད་ལྡན་ཆུ་ཚོད", "ཤར་ཕྱོགས་ཡུ་རོ་པེན་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ནུབ་ཕྱོགས་ཡུ་རོ་པེན་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཡུ་རོ་པེན་ཚད་ལྡན་ཆུ་ཚོད", "ནུབ་ཕྱོགས་ཡུ་རོ་པེན་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"", "གིརིན་ཝིཆ་ལུ་ཡོད་པའི་ཆུ་ཚོད", ""},
+		{"", "གཱལཕི་ཆུ་ཚོད", ""},
+		{"ཧ་ཝའི་-ཨེ་ལིའུ་ཤེན་ཆུ་ཚོད", "ཧ་ཝའི་-ཨེ་ལིའུ་ཤེན་ཚད་ལྡན་ཆུ་ཚོད", "ཧ་ཝའི་-ཨེ་ལིའུ་ཤེན་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"", "", ""},
+		{"", "རྒྱ་གར་ཆུ་ཚོད", ""},
+		{"", "ཨིན་ཌོ་ཅཱའི་ན་ཆུ་ཚོད", ""},
+		{"", "ནུབ་ཕྱོགས་ཨིན་ཌོ་ནེ་ཤི་ཡ་ཆུ་ཚོད", ""},
+		{"ཨི་རཱན་ཆུ་ཚོད", "ཨི་རཱན་ཚད་ལྡན་ཆུ་ཚོད", "ཨི་རཱན་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཨིས་རེལ་ཆུ་ཚོད", "ཨིས་རེལ་ཚད་ལྡན་ཆུ་ཚོད", "ཨིས་རེལ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཇ་པཱན་ཆུ་ཚོད", "ཇ་པཱན་ཚད་ལྡན་ཆུ་ཚོད", "ཇ་པཱན་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ཀོ་རི་ཡ་ཆུ་ཚོད", "ཀོ་རི་ཡ་ཚད་ལྡན་ཆུ་ཚོད", "ཀོ་རི་ཡ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"", "", ""},
+		{"མཽས་ཀོ་ཆུ་ཚོད", "མཽས་ཀོ་ཚད་ལྡན་ཆུ་ཚོད", "མཽས་ཀོ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"ནིའུ་ཛི་ལེནཌ་ཆུ་ཚོད", "ནིའུ་ཛི་ལེནཌ་ཚད་ལྡན་ཆུ་ཚོད", "ནིའུ་ཛི་ལེནཌ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"ནིའུ་ཕའུནཌ་ལེནཌ་ཆུ་ཚོད", "ནིའུ་ཕའུནཌ་ལེནཌ་ཚད་ལྡན་ཆུ་ཚོད", "ནིའུ་ཕའུནཌ་ལེནཌ་ཉིན་སྲུང་ཆུ་ཚོད"},
+		{"པ་ཀི་ས྄ཏཱན་ཆུ་ཚོད", "པ་ཀི་ས྄ཏཱན་ཚད་ལྡན་ཆུ་ཚོད", "པ་ཀི་ས྄ཏཱན་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"པ་རུ་ཆུ་ཚོད", "པ་རུ་ཚད་ལྡན་ཆུ་ཚོད", "པ་རུ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "བེ་ནི་ཛུ་ཝེ་ལ་ཆུ་ཚོད", ""},
+		{"", "", ""},
+		{"", "", "ཨཱ་ཡརིཤ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"", "", "བྲཱི་ཊིཤ་བྱཱར་དུས་ཆུ་ཚོད"},
+		{"", "", ""},
+	},
+	"el": {
+		{"", "Ώρα Κεντρικής Αφρικής", ""},
+		{"", "Ώρα Ανατολικής Αφρικής", ""},
+		{"", "Χειμερινή ώρα Νότιας Αφρικής", ""},
+		{"Ώρα Δυτικής Αφρικής", "Χειμερινή ώρα Δυτικής Αφρικής", "Θερινή ώρα Δυτικής Αφρικής"},
+		{"Ώρα Αλάσκας", "Χειμερινή ώρα Αλάσκας", "Θερινή ώρα Αλάσκας"},
+		{"Κεντρική ώρα Βόρειας Αμερικής", "Κεντρική χειμερινή ώρα Βόρειας Αμερικής", "Κεντρική θερινή ώρα Βόρειας Αμερικής"},
+		{"Ανατολική ώρα Βόρειας Αμερικής", "Ανατολική χειμερινή ώρα Βόρειας Αμερικής", "Ανατολική θερινή ώρα Βόρειας Αμερικής"},
+		{"Ορεινή ώρα Βόρειας Αμερικής", "Ορεινή χειμερινή ώρα Βόρειας Αμερικής", "Ορεινή θερινή ώρα Βόρειας Αμερικής"},
+		{"Ώρα Ειρηνικού", "Χειμερινή ώρα Ειρηνικού", "Θερινή ώρα Ειρηνικού"},
+		{"Αραβική ώρα", "Αραβική χειμερινή ώρα", "Αραβική θερινή ώρα"},
+		{"Ώρα Αργεντινής", "Χειμερινή ώρα Αργεντινής", "Θερινή ώρα Αργεντινής"},
+		{"Ώρα Ατλαντικού", "Χειμερινή ώρα Ατλαντικού", "Θερινή ώρα Ατλαντικού"},
+		{"Ώρα Κεντρικής Αυστραλίας", "Χειμερινή ώρα Κεντρικής Αυστραλίας", "Θερινή ώρα Κεντρικής Αυστραλίας"},
+		{"Ώρα Ανατολικής Αυστραλίας", "Χειμερινή ώρα Ανατολικής Αυστραλίας", "Θερινή ώρα Ανατολικής Αυστραλίας"},
+		{"Ώρα Δυτικής Αυστραλίας", "Χειμερινή ώρα Δυτικής Αυστραλίας", "Θερινή ώρα Δυτικής Αυστραλίας"},
+		{"Ώρα Μπανγκλαντές", "Χειμερινή ώρα Μπανγκλαντές", "Θερινή ώρα Μπανγκλαντές"},
+		{"Ώρα Μπραζίλιας", "Χειμερινή ώρα Μπραζίλιας", "Θερινή ώρα Μπραζίλιας"},
+		{"Ώρα Χιλής", "Χειμερινή ώρα Χιλής", "Θερινή ώρα Χιλής"},
+		{"Ώρα Κίνας", "Χειμερινή ώρα Κίνας", "Θερινή ώρα Κίνας"},
+		{"Ώρα Κολομβίας", "Χειμερινή ώρα Κολομβίας", "Θερινή ώρα Κολομβίας"},
+		{"Ώρα Κεντρικής Ευρώπης", "Χειμερινή ώρα Κεντρικής Ευρώπης", "Θερινή ώρα Κεντρικής Ευρώπης"},
+		{"Ώρα Ανατολικής Ευρώπης", "Χειμερινή ώρα Ανατολικής Ευρώπης", "Θερινή ώρα Ανατολικής Ευρώπης"},
+		{"Ώρα Δυτικής Ευρώπης", "Χειμερινή ώρα Δυτικής Ευρώπης", "Θερινή ώρα Δυτικής Ευρώπης"},
+		{"", "Μέση ώρα Γκρίνουιτς", ""},
+		{"", "Ώρα Κόλπου", ""},
+		{"Ώρα Χαβάης-Αλεούτιων Νήσων", "Χειμερινή ώρα Χαβάης-Αλεούτιων Νήσων", "Θερινή ώρα Χαβάης-Αλεούτιων Νήσων"},
+		{"Ώρα Χονγκ Κονγκ", "Χειμερινή ώρα Χονγκ Κονγκ", "Θερινή ώρα Χονγκ Κονγκ"},
+		{"", "Ώρα Ινδίας", ""},
+		{"", "Ώρα Ινδοκίνας", ""},
+		{"", "Ώρα Δυτικής Ινδονησίας", ""},
+		{"Ώρα Ιράν", "Χειμερινή ώρα Ιράν", "Θερινή ώρα Ιράν"},
+		{"Ώρα Ισραήλ", "Χειμερινή ώρα Ισραήλ", "Θερινή ώρα Ισραήλ"},
+		{"Ώρα Ιαπωνίας", "Χειμερινή ώρα Ιαπωνίας", "Θερινή ώρα Ιαπωνίας"},
+		{"Ώρα Κορέας", "Χειμερινή ώρα Κορέας", "Θερινή ώρα Κορέας"},
+		{"", "Ώρα Μαλαισίας", ""},
+		{"Ώρα Μόσχας", "Χειμερινή ώρα Μόσχας", "Θερινή ώρα Μόσχας"},
+		{"Ώρα Νέας Ζηλανδίας", "Χειμερινή ώρα Νέας Ζηλανδίας", "Θερινή ώρα Νέας Ζηλανδίας"},
+		{"Ώρα Νέας Γης", "Χειμερινή ώρα Νέας Γης", "Θερινή ώρα Νέας Γης"},
+		{"Ώρα Πακιστάν", "Χειμερινή ώρα Πακιστάν", "Θερινή ώρα Πακιστάν"},
+		{"Ώρα Περού", "Χειμερινή ώρα Περού", "Θερινή ώρα Περού"},
+		{"Ώρα Φιλιππινών", "Χειμερινή ώρα Φιλιππινών", "Θερινή ώρα Φιλιππινών"},
+		{"", "Ώρα Σιγκαπούρης", ""},
+		{"Ώρα Ταϊπέι", "Χειμερινή ώρα Ταϊπέι", "Θερινή ώρα Ταϊπέι"},
+		{"", "Ώρα Βενεζουέλας", ""},
+		{"", "Συντονισμένη Παγκόσμια Ώρα", ""},
+		{"", "", "Χειμερινή ώρα Ιρλανδίας"},
+		{"", "", "Θερινή ώρα Βρετανίας"},
+		{"", "", ""},
+	},
+	"en": {
+		{"", "Central Africa Time", ""},
+		{"", "East Africa Time", ""},
+		{"", "South Africa Standard Time", ""},
+		{"West Africa Time", "West Africa Standard Time", "West Africa Summer Time"},
+		{"Alaska Time", "Alaska Standard Time", "Alaska Daylight Time"},
+		{"Central Time", "Central Standard Time", "Central Daylight Time"},
+		{"Eastern Time", "Eastern Standard Time", "Eastern Daylight Time"},
+		{"Mountain Time", "Mountain Standard Time", "Mountain Daylight Time"},
+		{"Pacific Time", "Pacific Standard Time", "Pacific Daylight Time"},
+		{"Arabian Time", "Arabian Standard Time", "Arabian Daylight Time"},
+		{"Argentina Time", "Argentina Standard Time", "Argentina Summer Time"},
+		{"Atlantic Time", "Atlantic Standard Time", "Atlantic Daylight Time"},
+		{"Central Australia Time", "Australian Central Standard Time", "Australian Central Daylight Time"},
+		{"Eastern Australia Time", "Australian Eastern Standard Time", "Australian Eastern Daylight Time"},
+		{"Western Australia Time", "Australian Western Standard Time", "Australian Western Daylight Time"},
+		{"Bangladesh Time", "Bangladesh Standard Time", "Bangladesh Summer Time"},
+		{"Brasilia Time", "Brasilia Standard Time", "Brasilia Summer Time"},
+		{"Chile Time", "Chile Standard Time", "Chile Summer Time"},
+		{"China Time", "China Standard Time", "China Daylight Time"},
+		{"Colombia Time", "Colombia Standard Time", "Colombia Summer Time"},
+		{"Central European Time", "Central European Standard Time", "Central European Summer Time"},
+		{"Eastern European Time", "Eastern European Standard Time", "Eastern European Summer Time"},
+		{"Western European Time", "Western European Standard Time", "Western European Summer Time"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Gulf Standard Time", ""},
+		{"Hawaii-Aleutian Time", "Hawaii-Aleutian Standard Time", "Hawaii-Aleutian Daylight Time"},
+		{"Hong Kong Time", "Hong Kong Standard Time", "Hong Kong Summer Time"},
+		{"", "India Standard Time", ""},
+		{"", "Indochina Time", ""},
+		{"", "Western Indonesia Time", ""},
+		{"Iran Time", "Iran Standard Time", "Iran Daylight Time"},
+		{"Israel Time", "Israel Standard Time", "Israel Daylight Time"},
+		{"Japan Time", "Japan Standard Time", "Japan Daylight Time"},
+		{"Korean Time", "Korean Standard Time", "Korean Daylight Time"},
+		{"", "Malaysia Time", ""},
+		{"Moscow Time", "Moscow Standard Time", "Moscow Summer Time"},
+		{"New Zealand Time", "New Zealand Standard Time", "New Zealand Daylight Time"},
+		{"Newfoundland Time", "Newfoundland Standard Time", "Newfoundland Daylight Time"},
+		{"Pakistan Time", "Pakistan Standard Time", "Pakistan Summer Time"},
+		{"Peru Time", "Peru Standard Time", "Peru Summer Time"},
+		{"Philippine Time", "Philippine Standard Time", "Philippine Summer Time"},
+		{"", "Singapore Standard Time", ""},
+		{"Taipei Time", "Taipei Standard Time", "Taipei Daylight Time"},
+		{"", "Venezuela Time", ""},
+		{"", "Coordinated Universal Time", ""},
+		{"", "", "Irish Standard Time"},
+		{"", "", "British Summer Time"},
+		{"", "", ""},
+	},
+	"eo": {
+		{"", "centra afrika tempo", ""},
+		{"", "orienta afrika tempo", ""},
+		{"", "suda afrika tempo", ""},
+		{"okcidenta afrika tempo", "okcidenta afrika norma tempo", "okcidenta afrika somera tempo"},
+		{"", "", ""},
+		{"centra nord-amerika tempo", "centra nord-amerika norma tempo", "centra nord-amerika somera tempo"},
+		{"orienta nord-amerika tempo", "orienta nord-amerika norma tempo", "orienta nord-amerika somera tempo"},
+		{"monta nord-amerika tempo", "monta nord-amerika norma tempo", "monta nord-amerika somera tempo"},
+		{"pacifika nord-amerika tempo", "pacifika nord-amerika norma tempo", "pacifika nord-amerika somera tempo"},
+		{"araba tempo", "araba norma tempo", "araba somera tempo"},
+		{"", "", ""},
+		{"atlantika nord-amerika tempo", "atlantika nord-amerika norma tempo", "atlantika nord-amerika somera tempo"},
+		{"centra aŭstralia tempo", "centra aŭstralia norma tempo", "centra aŭstralia somera tempo"},
+		{"orienta aŭstralia tempo", "orienta aŭstralia norma tempo", "orienta aŭstralia somera tempo"},
+		{"okcidenta aŭstralia tempo", "okcidenta aŭstralia norma tempo", "okcidenta aŭstralia somera tempo"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"ĉina tempo", "ĉina norma tempo", "ĉina somera tempo"},
+		{"", "", ""},
+		{"centra eŭropa tempo", "centra eŭropa norma tempo", "centra eŭropa somera tempo"},
+		{"orienta eŭropa tempo", "orienta eŭropa norma tempo", "orienta eŭropa somera tempo"},
+		{"okcidenta eŭropa tempo", "okcidenta eŭropa norma tempo", "okcidenta eŭropa somera tempo"},
+		{"", "universala tempo kunordigita", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "barata tempo", ""},
+		{"", "hindoĉina tempo", ""},
+		{"", "okcidenta indonezia tempo", ""},
+		{"", "", ""},
+		{"israela tempo", "israela norma tempo", "israela somera tempo"},
+		{"japana tempo", "japana norma tempo", "japana somera tempo"},
+		{"korea tempo", "korea norma tempo", "korea somera tempo"},
+		{"", "", ""},
+		{"moskva tempo", "moskva norma tempo", "moskva somera tempo"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"es": {
+		{"", "hora de África central", ""},
+		{"", "hora de África oriental", ""},
+		{"", "hora de Sudáfrica", ""},
+		{"hora de África occidental", "hora estándar de África occidental", "hora de verano de África occidental"},
+		{"hora de Alaska", "hora estándar de Alaska", "hora de verano de Alaska"},
+		{"hora central", "hora estándar central", "hora de verano central"},
+		{"hora oriental", "hora estándar oriental", "hora de verano oriental"},
+		{"hora de las Montañas Rocosas", "hora estándar de las Montañas Rocosas", "hora de verano de las Montañas Rocosas"},
+		{"hora del Pacífico", "hora estándar del Pacífico", "hora de verano del Pacífico"},
+		{"hora de Arabia", "hora estándar de Arabia", "hora de verano de Arabia"},
+		{"hora de Argentina", "hora estándar de Argentina", "hora de verano de Argentina"},
+		{"hora del Atlántico", "hora estándar del Atlántico", "hora de verano del Atlántico"},
+		{"hora de Australia central", "hora estándar de Australia central", "hora de verano de Australia central"},
+		{"hora de Australia oriental", "hora estándar de Australia oriental", "hora de verano de Australia oriental"},
+		{"hora de Australia occidental", "hora estándar de Australia occidental", "hora de verano de Australia occidental"},
+		{"hora de Bangladés", "hora estándar de Bangladés", "hora de verano de Bangladés"},
+		{"hora de Brasilia", "hora estándar de Brasilia", "hora de verano de Brasilia"},
+		{"hora de Chile", "hora estándar de Chile", "hora de verano de Chile"},
+		{"hora de China", "hora estándar de China", "hora de verano de China"},
+		{"hora de Colombia", "hora estándar de Colombia", "hora de verano de Colombia"},
+		{"hora de Europa central", "hora estándar de Europa central", "hora de verano de Europa central"},
+		{"hora de Europa oriental", "hora estándar de Europa oriental", "hora de verano de Europa oriental"},
+		{"hora de Europa occidental", "hora estándar de Europa occidental", "hora de verano de Europa occidental"},
+		{"", "hora del meridiano de Greenwich", ""},
+		{"", "hora estándar del Golfo", ""},
+		{"hora de Hawái-Aleutianas", "hora estándar de Hawái-Aleutianas", "hora de verano de Hawái-Aleutianas"},
+		{"hora de Hong Kong", "hora estándar de Hong Kong", "hora de verano de Hong Kong"},
+		{"", "hora estándar de la India", ""},
+		{"", "hora de Indochina", ""},
+		{"", "hora de Indonesia occidental", ""},
+		{"hora de Irán", "hora estándar de Irán", "hora de verano de Irán"},
+		{"hora de Israel", "hora estándar de Israel", "hora de verano de Israel"},
+		{"hora de Japón", "hora estándar de Japón", "hora de verano de Japón"},
+		{"hora de Corea", "hora estándar de Corea", "hora de verano de Corea"},
+		{"", "hora de Malasia", ""},
+		{"hora de Moscú", "hora estándar de Moscú", "hora de verano de Moscú"},
+		{"hora de Nueva Zelanda", "hora estándar de Nueva Zelanda", "hora de verano de Nueva Zelanda"},
+		{"hora de Terranova", "hora estándar de Terranova", "hora de verano de Terranova"},
+		{"hora de Pakistán", "hora estándar de Pakistán", "hora de verano de Pakistán"},
+		{"hora de Perú", "hora estándar de Perú", "hora de verano de Perú"},
+		{"hora de Filipinas", "hora estándar de Filipinas", "hora de verano de Filipinas"},
+		{"", "hora de Singapur", ""},
+		{"hora de Taipéi", "hora estándar de Taipéi", "hora de verano de Taipéi"},
+		{"", "hora de Venezuela", ""},
+		{"", "tiempo universal coordinado", ""},
+		{"", "", "hora de verano de Irlanda"},
+		{"", "", "hora de verano británica"},
+		{"", "", ""},
+	},
+	"et": {
+		{"", "Kesk-Aafrika aeg", ""},
+		{"", "Ida-Aafrika aeg", ""},
+		{"", "Lõuna-Aafrika standardaeg", ""},
+		{"Lääne-Aafrika aeg", "Lääne-Aafrika standardaeg", "Lääne-Aafrika suveaeg"},
+		{"Alaska aeg", "Alaska standardaeg", "Alaska suveaeg"},
+		{"Kesk-Ameerika aeg", "Kesk-Ameerika standardaeg", "Kesk-Ameerika suveaeg"},
+		{"Idaranniku aeg", "Idaranniku standardaeg", "Idaranniku suveaeg"},
+		{"Mäestikuvööndi aeg", "Mäestikuvööndi standardaeg", "Mäestikuvööndi suveaeg"},
+		{"Vaikse ookeani aeg", "Vaikse ookeani standardaeg", "Vaikse ookeani suveaeg"},
+		{"Araabia aeg", "Araabia standardaeg", "Araabia suveaeg"},
+		{"Argentina aeg", "Argentina standardaeg", "Argentina suveaeg"},
+		{"Atlandi aeg", "Atlandi standardaeg", "Atlandi suveaeg"},
+		{"Kesk-Austraalia aeg", "Kesk-Austraalia standardaeg", "Kesk-Austraalia suveaeg"},
+		{"Ida-Austraalia aeg", "Ida-Austraalia standardaeg", "Ida-Austraalia suveaeg"},
+		{"Lääne-Austraalia aeg", "Lääne-Austraalia standardaeg", "Lääne-Austraalia suveaeg"},
+		{"Bangladeshi aeg", "Bangladeshi standardaeg", "Bangladeshi suveaeg"},
+		{"Brasiilia aeg", "Brasiilia standardaeg", "Brasiilia suveaeg"},
+		{"Tšiili aeg", "Tšiili standardaeg", "Tšiili suveaeg"},
+		{"Hiina aeg", "Hiina standardaeg", "Hiina suveaeg"},
+		{"Colombia aeg", "Colombia standardaeg", "Colombia suveaeg"},
+		{"Kesk-Euroopa aeg", "Kesk-Euroopa standardaeg", "Kesk-Euroopa suveaeg"},
+		{"Ida-Euroopa aeg", "Ida-Euroopa standardaeg", "Ida-Euroopa suveaeg"},
+		{"Lääne-Euroopa aeg", "Lääne-Euroopa standardaeg", "Lääne-Euroopa suveaeg"},
+		{"", "Greenwichi aeg", ""},
+		{"", "Pärsia lahe standardaeg", ""},
+		{"Hawaii-Aleuudi aeg", "Hawaii-Aleuudi standardaeg", "Hawaii-Aleuudi suveaeg"},
+		{"Hongkongi aeg", "Hongkongi standardaeg", "Hongkongi suveaeg"},
+		{"", "India aeg", ""},
+		{"", "Indohiina aeg", ""},
+		{"", "Lääne-Indoneesia aeg", ""},
+		{"Iraani aeg", "Iraani standardaeg", "Iraani suveaeg"},
+		{"Iisraeli aeg", "Iisraeli standardaeg", "Iisraeli suveaeg"},
+		{"Jaapani aeg", "Jaapani standardaeg", "Jaapani suveaeg"},
+		{"Korea aeg", "Korea standardaeg", "Korea suveaeg"},
+		{"", "Malaisia \u200b\u200baeg", ""},
+		{"Moskva aeg", "Moskva standardaeg", "Moskva suveaeg"},
+		{"Uus-Meremaa aeg", "Uus-Meremaa standardaeg", "Uus-Meremaa suveaeg"},
+		{"Newfoundlandi aeg", "Newfoundlandi standardaeg", "Newfoundlandi suveaeg"},
+		{"Pakistani aeg", "Pakistani standardaeg", "Pakistani suveaeg"},
+		{"Peruu aeg", "Peruu standardaeg", "Peruu suveaeg"},
+		{"Filipiini aeg", "Filipiini standardaeg", "Filipiini suveaeg"},
+		{"", "Singapuri standardaeg", ""},
+		{"Taipei aeg", "Taipei standardaeg", "Taipei suveaeg"},
+		{"", "Venezuela aeg", ""},
+		{"", "Koordineeritud maailmaaeg", ""},
+		{"", "", "Iiri suveaeg"},
+		{"", "", "Briti suveaeg"},
+		{"", "", ""},
+	},
+	"eu": {
+		{"", "Afrikako erdialdeko ordua", ""},
+		{"", "Afrikako ekialdeko ordua", ""},
+		{"", "Afrikako hegoaldeko ordua", ""},
+		{"Afrikako mendebaldeko ordua", "Afrikako mendebaldeko ordu estandarra", "Afrikako mendebaldeko udako ordua"},
+		{"Alaskako ordua", "Alaskako ordu estandarra", "Alaskako udako ordua"},
+		{"Ipar Amerikako erdialdeko ordua", "Ipar Amerikako erdialdeko ordu estandarra", "Ipar Amerikako erdialdeko udako ordua"},
+		{"Ipar Amerikako ekialdeko ordua", "Ipar Amerikako ekialdeko ordu estandarra", "Ipar Amerikako ekialdeko udako ordua"},
+		{"Ipar Amerikako mendialdeko ordua", "Ipar Amerikako mendialdeko ordu estandarra", "Ipar Amerikako mendialdeko udako ordua"},
+		{"Ipar Amerikako Pazifikoko ordua", "Ipar Amerikako Pazifikoko ordu estandarra", "Ipar Amerikako Pazifikoko udako ordua"},
+		{"Arabiako ordua", "Arabiako ordu estandarra", "Arabiako udako ordua"},
+		{"Argentinako ordua", "Argentinako ordu estandarra", "Argentinako udako ordua"},
+		{"Ipar Amerikako Atlantikoko ordua", "Ipar Amerikako Atlantikoko ordu estandarra", "Ipar Amerikako Atlantikoko udako ordua"},
+		{"Australiako erdialdeko ordua", "Australiako erdialdeko ordu estandarra", "Australiako erdialdeko udako ordua"},
+		{"Australiako ekialdeko ordua", "Australiako ekialdeko ordu estandarra", "Australiako ekialdeko udako ordua"},
+		{"Australiako mendebaldeko ordua", "Australiako mendebaldeko ordu estandarra", "Australiako mendebaldeko udako ordua"},
+		{"Bangladesheko ordua", "Bangladesheko ordu estandarra", "Bangladesheko udako ordua"},
+		{"Brasiliako ordua", "Brasiliako ordu estandarra", "Brasiliako udako ordua"},
+		{"Txileko ordua", "Txileko ordu estandarra", "Txileko udako ordua"},
+		{"Txinako ordua", "Txinako ordu estandarra", "Txinako udako ordua"},
+		{"Kolonbiako ordua", "Kolonbiako ordu estandarra", "Kolonbiako udako ordua"},
+		{"Europako erdialdeko ordua", "Europako erdialdeko ordu estandarra", "Europako erdialdeko udako ordua"},
+		{"Europako ekialdeko ordua", "Europako ekialdeko ordu estandarra", "Europako ekialdeko udako ordua"},
+		{"Europako mendebaldeko ordua", "Europako mendebaldeko ordu estandarra", "Europako mendebaldeko udako ordua"},
+		{"", "Greenwichko meridianoaren ordua", ""},
+		{"", "Golkoko ordu estandarra", ""},
+		{"Hawaii-Aleutiar uharteetako ordua", "Hawaii-Aleutiar uharteetako ordu estandarra", "Hawaii-Aleutiar uharteetako udako ordua"},
+		{"Hong Kongo ordua", "Hong Kongo ordu estandarra", "Hong Kongo udako ordua"},
+		{"", "Indiako ordua", ""},
+		{"", "Indotxinako ordua", ""},
+		{"", "Indonesiako mendebaldeko ordua", ""},
+		{"Irango ordua", "Irango ordu estandarra", "Irango udako ordua"},
+		{"Israelgo ordua", "Israelgo ordu estandarra", "Israelgo udako ordua"},
+		{"Japoniako ordua", "Japoniako ordu estandarra", "Japoniako udako ordua"},
+		{"Koreako ordua", "Koreako ordu estandarra", "Koreako udako ordua"},
+		{"", "Malaysiako ordua", ""},
+		{"Moskuko ordua", "Moskuko ordu estandarra", "Moskuko udako ordua"},
+		{"Zeelanda Berriko ordua", "Zeelanda Berriko ordu estandarra", "Zeelanda Berriko udako ordua"},
+		{"Ternuako ordua", "Ternuako ordu estandarra", "Ternuako udako ordua"},
+		{"Pakistango ordua", "Pakistango ordu estandarra", "Pakistango udako ordua"},
+		{"Peruko ordua", "Peruko ordu estandarra", "Peruko udako ordua"},
+		{"Filipinetako ordua", "Filipinetako ordu estandarra", "Filipinetako udako ordua"},
+		{"", "Singapurreko ordu estandarra", ""},
+		{"Taipeiko ordua", "Taipeiko ordu estandarra", "Taipeiko udako ordua"},
+		{"", "Venezuelako ordua", ""},
+		{"", "Ordu Unibertsal Koordinatua", ""},
+		{"", "", "Irlandako ordu estandarra"},
+		{"", "", "Londresko udako ordua"},
+		{"", "", ""},
+	},
+	"fa": {
+		{"", "وقت مرکز افریقا", ""},
+		{"", "وقت شرق افریقا", ""},
+		{"", "وقت عادی جنوب افریقا", ""},
+		{"وقت غرب افریقا", "وقت عادی غرب افریقا", "وقت تابستانی غرب افریقا"},
+		{"وقت آلاسکا", "وقت عادی آلاسکا", "وقت تابستانی آلاسکا"},
+		{"وقت مرکز امریکا", "وقت عادی مرکز امریکا", "وقت تابستانی مرکز امریکا"},
+		{"وقت شرق امریکا", "وقت عادی شرق امریکا", "وقت تابستانی شرق امریکا"},
+		{"وقت کوهستانی امریکا", "وقت عادی کوهستانی امریکا", "وقت تابستانی کوهستانی امریکا"},
+		{"وقت غرب امریکا", "وقت عادی غرب امریکا", "وقت تابستانی غرب امریکا"},
+		{"وقت عربستان", "وقت عادی عربستان", "وقت تابستانی عربستان"},
+		{"وقت آرژانتین", "وقت عادی آرژانتین", "وقت تابستانی آرژانتین"},
+		{"وقت آتلانتیک", "وقت عادی آتلانتیک", "وقت تابستانی آتلانتیک"},
+		{"وقت مرکز استرالیا", "وقت عادی مرکز استرالیا", "وقت تابستانی مرکز استرالیا"},
+		{"وقت شرق استرالیا", "وقت عادی شرق استرالیا", "وقت تابستانی شرق استرالیا"},
+		{"وقت غرب استرالیا", "وقت عادی غرب استرالیا", "وقت تابستانی غرب استرالیا"},
+		{"وقت بنگلادش", "وقت عادی بنگلادش", "وقت تابستانی بنگلادش"},
+		{"وقت برازیلیا", "وقت عادی برازیلیا", "وقت تابستانی برازیلیا"},
+		{"وقت شیلی", "وقت عادی شیلی", "وقت تابستانی شیلی"},
+		{"وقت چین", "وقت عادی چین", "وقت تابستانی چین"},
+		{"وقت کلمبیا", "وقت عادی کلمبیا", "وقت تابستانی کلمبیا"},
+		{"وقت مرکز اروپا", "وقت عادی مرکز اروپا", "وقت تابستانی مرکز اروپا"},
+		{"وقت شرق اروپا", "وقت عادی شرق اروپا", "وقت تابستانی شرق اروپا"},
+		{"وقت غرب اروپا", "وقت عادی غرب اروپا", "وقت تابستانی غرب اروپا"},
+		{"", "وقت گرینویچ", ""},
+		{"", "وقت عادی خلیج فارس", ""},
+		{"وقت هاوایی‐الوشن", "وقت عادی هاوایی‐الوشن", "وقت تابستانی هاوایی‐الوشن"},
+		{"وقت هنگ\u200cکنگ", "وقت عادی هنگ\u200cکنگ", "وقت تابستانی هنگ\u200cکنگ"},
+		{"", "وقت هند", ""},
+		{"", "وقت هندوچین", ""},
+		{"", "وقت غرب اندونزی", ""},
+		{"وقت ایران", "وقت عادی ایران", "وقت تابستانی ایران"},
+		{"وقت اسرائیل", "وقت عادی اسرائیل", "وقت تابستانی اسرائیل"},
+		{"وقت ژاپن", "وقت عادی ژاپن", "وقت تابستانی ژاپن"},
+		{"وقت کره", "وقت عادی کره", "وقت تابستانی کره"},
+		{"", "وقت مالزی", ""},
+		{"وقت مسکو", "وقت عادی مسکو", "وقت تابستانی مسکو"},
+		{"وقت زلاند نو", "وقت عادی زلاند نو", "وقت تابستانی زلاند نو"},
+		{"وقت نیوفاندلند", "وقت عادی نیوفاندلند", "وقت تابستانی نیوفاندلند"},
+		{"وقت پاکستان", "وقت عادی پاکستان", "وقت تابستانی پاکستان"},
+		{"وقت پرو", "وقت عادی پرو", "وقت تابستانی پرو"},
+		{"وقت فیلیپین", "وقت عادی فیلیپین", "وقت تابستانی فیلیپین"},
+		{"", "وقت سنگاپور", ""},
+		{"وقت تایپه", "وقت عادی تایپه", "وقت تابستانی تایپه"},
+		{"", "وقت ونزوئلا", ""},
+		{"", "زمان هماهنگ جهانی", ""},
+		{"", "", "وقت عادی ایرلند"},
+		{"", "", "وقت تابستانی بریتانیا"},
+		{"", "", ""},
+	},
+	"fi": {
+		{"", "Keski-Afrikan aika", ""},
+		{"", "Itä-Afrikan aika", ""},
+		{"", "Etelä-Afrikan aika", ""},
+		{"Länsi-Afrikan aika", "Länsi-Afrikan normaaliaika", "Länsi-Afrikan kesäaika"},
+		{"Alaskan aika", "Alaskan normaaliaika", "Alaskan kesäaika"},
+		{"Yhdysvaltain keskinen aika", "Yhdysvaltain keskinen normaaliaika", "Yhdysvaltain keskinen kesäaika"},
+		{"Yhdysvaltain itäinen aika", "Yhdysvaltain itäinen normaaliaika", "Yhdysvaltain itäinen kesäaika"},
+		{"Kalliovuorten aika", "Kalliovuorten normaaliaika", "Kalliovuorten kesäaika"},
+		{"Yhdysvaltain Tyynenmeren aika", "Yhdysvaltain Tyynenmeren normaaliaika", "Yhdysvaltain Tyynenmeren kesäaika"},
+		{"Saudi-Arabian aika", "Saudi-Arabian normaaliaika", "Saudi-Arabian kesäaika"},
+		{"Argentiinan aika", "Argentiinan normaaliaika", "Argentiinan kesäaika"},
+		{"Kanadan Atlantin aika", "Kanadan Atlantin normaaliaika", "Kanadan Atlantin kesäaika"},
+		{"Keski-Australian aika", "Keski-Australian normaaliaika", "Keski-Australian kesäaika"},
+		{"Itä-Australian aika", "Itä-Australian normaaliaika", "Itä-Australian kesäaika"},
+		{"Länsi-Australian aika", "Länsi-Australian normaaliaika", "Länsi-Australian kesäaika"},
+		{"Bangladeshin aika", "Bangladeshin normaaliaika", "Bangladeshin kesäaika"},
+		{"Brasilian aika", "Brasilian normaaliaika", "Brasilian kesäaika"},
+		{"Chilen aika", "Chilen normaaliaika", "Chilen kesäaika"},
+		{"Kiinan aika", "Kiinan normaaliaika", "Kiinan kesäaika"},
+		{"Kolumbian aika", "Kolumbian normaaliaika", "Kolumbian kesäaika"},
+		{"Keski-Euroopan aika", "Keski-Euroopan normaaliaika", "Keski-Euroopan kesäaika"},
+		{"Itä-Euroopan aika", "Itä-Euroopan normaaliaika", "Itä-Euroopan kesäaika"},
+		{"Länsi-Euroopan aika", "Länsi-Euroopan normaaliaika", "Länsi-Euroopan kesäaika"},
+		{"", "Greenwichin normaaliaika", ""},
+		{"", "Arabiemiirikuntien normaaliaika", ""},
+		{"Havaijin-Aleuttien aika", "Havaijin-Aleuttien normaaliaika", "Havaijin-Aleuttien kesäaika"},
+		{"Hongkongin aika", "Hongkongin normaaliaika", "Hongkongin kesäaika"},
+		{"", "Intian aika", ""},
+		{"", "Indokiinan aika", ""},
+		{"", "Länsi-Indonesian aika", ""},
+		{"Iranin aika", "Iranin normaaliaika", "Iranin kesäaika"},
+		{"Israelin aika", "Israelin normaaliaika", "Israelin kesäaika"},
+		{"Japanin aika", "Japanin normaaliaika", "Japanin kesäaika"},
+		{"Korean aika", "Korean normaaliaika", "Korean kesäaika"},
+		{"", "Malesian aika", ""},
+		{"Moskovan aika", "Moskovan normaaliaika", "Moskovan kesäaika"},
+		{"Uuden-Seelannin aika", "Uuden-Seelannin normaaliaika", "Uuden-Seelannin kesäaika"},
+		{"Newfoundlandin aika", "Newfoundlandin normaaliaika", "Newfoundlandin kesäaika"},
+		{"Pakistanin aika", "Pakistanin normaaliaika", "Pakistanin kesäaika"},
+		{"Perun aika", "Perun normaaliaika", "Perun kesäaika"},
+		{"Filippiinien aika", "Filippiinien normaaliaika", "Filippiinien kesäaika"},
+		{"", "Singaporen aika", ""},
+		{"Taipein aika", "Taipein normaaliaika", "Taipein kesäaika"},
+		{"", "Venezuelan aika", ""},
+		{"", "UTC-yleisaika", ""},
+		{"", "", "Irlannin kesäaika"},
+		{"", "", "Britannian kesäaika"},
+		{"", "", ""},
+	},
+	"fil": {
+		{"", "Oras sa Gitnang Africa", ""},
+		{"", "Oras sa Silangang Africa", ""},
+		{"", "Oras sa Timog Africa", ""},
+		{"Oras sa Kanlurang Africa", "Standard na Oras sa Kanlurang Africa", "Oras sa Tag-init ng Kanlurang Africa"},
+		{"Oras sa Alaska", "Standard na Oras sa Alaska", "Daylight Time sa Alaska"},
+		{"Sentral na Oras", "Sentral na Karaniwang Oras", "Sentral na Daylight Time"},
+		{"Eastern Time", "Eastern na Standard na Oras", "Eastern Daylight Time"},
+		{"Oras sa Bundok", "Standard na Oras sa Bundok", "Daylight Time sa Bundok"},
+		{"Oras sa Pasipiko", "Standard na Oras sa Pasipiko", "Daylight Time sa Pasipiko"},
+		{"Oras sa Arabia", "Standard na Oras sa Arabia", "Daylight Time sa Arabia"},
+		{"Oras sa Argentina", "Standard na Oras sa Argentina", "Oras sa Tag-init ng Argentina"},
+		{"Oras sa Atlantiko", "Standard na Oras sa Atlantiko", "Daylight Time sa Atlantiko"},
+		{"Oras sa Gitnang Australya", "Standard na Oras sa Gitnang Australya", "Daylight Time sa Gitnang Australya"},
+		{"Oras sa Silangang Australya", "Standard na Oras sa Silangang Australya", "Daylight Time sa Silangang Australya"},
+		{"Oras sa Kanlurang Australya", "Standard na Oras sa Kanlurang Australya", "Daylight Time sa Kanlurang Australya"},
+		{"Oras sa Bangladesh", "Standard na Oras sa Bangladesh", "Oras sa Tag-init ng Bangladesh"},
+		{"Oras sa Brasilia", "Standard na Oras sa Brasilia", "Oras sa Tag-init ng Brasilia"},
+		{"Oras sa Chile", "Standard na Oras sa Chile", "Oras sa Tag-init ng Chile"},
+		{"Oras sa China", "Standard na Oras sa China", "Daylight Time sa China"},
+		{"Oras sa Colombia", "Standard na Oras sa Colombia", "Oras sa Tag-init ng Colombia"},
+		{"Oras sa Gitnang Europe", "Standard na Oras sa Gitnang Europe", "Oras sa Tag-init ng Gitnang Europe"},
+		{"Oras sa Silangang Europe", "Standard na Oras sa Silangang Europe", "Oras sa Tag-init ng Silangang Europe"},
+		{"Oras sa Kanlurang Europe", "Standard na Oras sa Kanlurang Europe", "Oras sa Tag-init ng Kanlurang Europe"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Oras sa Gulf", ""},
+		{"Oras sa Hawaii-Aleutian", "Standard na Oras sa Hawaii-Aleutian", "Oras sa Tag-init ng Hawaii-Aleutian"},
+		{"Oras sa Hong Kong", "Standard na Oras sa Hong Kong", "Oras sa Tag-init ng Hong Kong"},
+		{"", "Standard na Oras sa Bhutan", ""},
+		{"", "Oras sa Indochina", ""},
+		{"", "Oras sa Kanlurang Indonesia", ""},
+		{"Oras sa Iran", "Standard na Oras sa Iran", "Daylight Time sa Iran"},
+		{"Oras sa Israel", "Standard na Oras sa Israel", "Daylight Time sa Israel"},
+		{"Oras sa Japan", "Standard na Oras sa Japan", "Daylight Time sa Japan"},
+		{"Oras sa Korea", "Standard na Oras sa Korea", "Daylight Time sa Korea"},
+		{"", "Oras sa Malaysia", ""},
+		{"Oras sa Moscow", "Standard na Oras sa Moscow", "Oras sa Tag-init ng Moscow"},
+		{"Oras sa New Zealand", "Standard na Oras sa New Zealand", "Daylight Time sa New Zealand"},
+		{"Oras sa Newfoundland", "Standard na Oras sa Newfoundland", "Daylight Time sa Newfoundland"},
+		{"Oras sa Pakistan", "Standard na Oras sa Pakistan", "Oras sa Tag-init ng Pakistan"},
+		{"Oras sa Peru", "Standard na Oras sa Peru", "Oras sa Tag-init ng Peru"},
+		{"Oras sa Pilipinas", "Standard na Oras sa Pilipinas", "Oras sa Tag-init ng Pilipinas"},
+		{"", "Standard na Oras sa Singapore", ""},
+		{"Oras sa Taipei", "Standard na Oras sa Taipei", "Daylight Time sa Taipei"},
+		{"", "Oras sa Venezuela", ""},
+		{"", "Coordinated Universal Time", ""},
+		{"", "", "Standard na Oras sa Ireland"},
+		{"", "", "Oras sa Tag-init ng Britain"},
+		{"", "", ""},
+	},
+	"fo": {
+		{"", "Miðafrika tíð", ""},
+		{"", "Eysturafrika tíð", ""},
+		{"", "Suðurafrika vanlig tíð", ""},
+		{"Vesturafrika tíð", "Vesturafrika vanlig tíð", "Vesturafrika summartíð"},
+		{"Alaska tíð", "Alaska vanlig tíð", "Alaska summartíð"},
+		{"Central tíð", "Central vanlig tíð", "Central summartíð"},
+		{"Eastern tíð", "Eastern vanlig tíð", "Eastern summartíð"},
+		{"Mountain tíð", "Mountain vanlig tíð", "Mountain summartíð"},
+		{"Pacific tíð", "Pacific vanlig tíð", "Pacific summartíð"},
+		{"Arabisk tíð", "Arabisk vanlig tíð", "Arabisk summartíð"},
+		{"Argentina tíð", "Argentina vanlig tíð", "Argentina summartíð"},
+		{"Atlantic tíð", "Atlantic vanlig tíð", "Atlantic summartíð"},
+		{"mið Avstralia tíð", "mið Avstralia vanlig tíð", "mið Avstralia summartíð"},
+		{"eystur Avstralia tíð", "eystur Avstralia vanlig tíð", "eystur Avstralia summartíð"},
+		{"vestur Avstralia tíð", "vestur Avstralia vanlig tíð", "vestur Avstralia summartíð"},
+		{"Bangladesj tíð", "Bangladesj vanlig tíð", "Bangladesj summartíð"},
+		{"Brasilia tíð", "Brasilia vanlig tíð", "Brasilia summartíð"},
+		{"Kili tíð", "Kili vanlig tíð", "Kili summartíð"},
+		{"Kina tíð", "Kina vanlig tíð", "Kina summartíð"},
+		{"Kolombia tíð", "Kolombia vanlig tíð", "Kolombia summartíð"},
+		{"Miðevropa tíð", "Miðevropa vanlig tíð", "Miðevropa summartíð"},
+		{"Eysturevropa tíð", "Eysturevropa vanlig tíð", "Eysturevropa summartíð"},
+		{"Vesturevropa tíð", "Vesturevropa vanlig tíð", "Vesturevropa summartíð"},
+		{"", "Greenwich Mean tíð", ""},
+		{"", "Gulf vanlig tíð", ""},
+		{"Hawaii-Aleutian tíð", "Hawaii-Aleutian vanlig tíð", "Hawaii-Aleutian summartíð"},
+		{"Hong Kong tíð", "Hong Kong vanlig tíð", "Hong Kong summartíð"},
+		{"", "India tíð", ""},
+		{"", "Indokina tíð", ""},
+		{"", "Vestur Indonesia tíð", ""},
+		{"Iran tíð", "Iran vanlig tíð", "Iran summartíð"},
+		{"Ísrael tíð", "Ísrael vanlig tíð", "Ísrael summartíð"},
+		{"Japan tíð", "Japan vanlig tíð", "Japan summartíð"},
+		{"Korea tíð", "Korea vanlig tíð", "Korea summartíð"},
+		{"", "Malaisia tíð", ""},
+		{"Moskva tíð", "Moskva vanlig tíð", "Moskva summartíð"},
+		{"Nýsæland tíð", "Nýsæland vanlig tíð", "Nýsæland summartíð"},
+		{"Newfoundland tíð", "Newfoundland vanlig tíð", "Newfoundland summartíð"},
+		{"Pakistan tíð", "Pakistan vanlig tíð", "Pakistan summartíð"},
+		{"Peru tíð", "Peru vanlig tíð", "Peru summartíð"},
+		{"Filipsoyggjar tíð", "Filipsoyggjar vanlig tíð", "Filipsoyggjar summartíð"},
+		{"", "Singapor tíð", ""},
+		{"Taipei tíð", "Taipei vanlig tíð", "Taipei summartíð"},
+		{"", "Venesuela tíð", ""},
+		{"", "Samskipað heimstíð", ""},
+		{"", "", "Írsk vanlig tíð"},
+		{"", "", "Stóra Bretland summartíð"},
+		{"", "", ""},
+	},
+	"fr": {
+		{"", "heure normale d’Afrique centrale", ""},
+		{"", "heure normale d’Afrique de l’Est", ""},
+		{"", "heure normale d’Afrique méridionale", ""},
+		{"heure d’Afrique de l’Ouest", "heure normale d’Afrique de l’Ouest", "heure d’été d’Afrique de l’Ouest"},
+		{"heure de l’Alaska", "heure normale de l’Alaska", "heure d’été de l’Alaska"},
+		{"heure du centre nord-américain", "heure normale du centre nord-américain", "heure d’été du Centre"},
+		{"heure de l’Est nord-américain", "heure normale de l’Est nord-américain", "heure d’été de l’Est"},
+		{"heure des Rocheuses", "heure normale des Rocheuses", "heure d’été des Rocheuses"},
+		{"heure du Pacifique nord-américain", "heure normale du Pacifique nord-américain", "heure d’été du Pacifique"},
+		{"heure de l’Arabie", "heure normale de l’Arabie", "heure d’été de l’Arabie"},
+		{"heure de l’Argentine", "heure normale d’Argentine", "heure d’été de l’Argentine"},
+		{"heure de l’Atlantique", "heure normale de l’Atlantique", "heure d’été de l’Atlantique"},
+		{"heure du centre de l’Australie", "heure normale du centre de l’Australie", "heure d’été du centre de l’Australie"},
+		{"heure de l’Est de l’Australie", "heure normale de l’Est de l’Australie", "heure d’été de l’Est de l’Australie"},
+		{"heure de l’Ouest de l’Australie", "heure normale de l’Ouest de l’Australie", "heure d’été de l’Ouest de l’Australie"},
+		{"heure du Bangladesh", "heure normale du Bangladesh", "heure d’été du Bangladesh"},
+		{"heure de Brasilia", "heure normale de Brasilia", "heure d’été de Brasilia"},
+		{"heure du Chili", "heure normale du Chili", "heure d’été du Chili"},
+		{"heure de la Chine", "heure normale de la Chine", "heure d’été de Chine"},
+		{"heure de Colombie", "heure normale de Colombie", "heure d’été de Colombie"},
+		{"heure d’Europe centrale", "heure normale d’Europe centrale", "heure d’été d’Europe centrale"},
+		{"heure d’Europe de l’Est", "heure normale d’Europe de l’Est", "heure d’été d’Europe de l’Est"},
+		{"heure d’Europe de l’Ouest", "heure normale d’Europe de l’Ouest", "heure d’été d’Europe de l’Ouest"},
+		{"", "heure moyenne de Greenwich", ""},
+		{"", "heure du Golfe", ""},
+		{"heure d’Hawaii - Aléoutiennes", "heure normale d’Hawaii - Aléoutiennes", "heure d’été d’Hawaii - Aléoutiennes"},
+		{"heure de Hong Kong", "heure normale de Hong Kong", "heure d’été de Hong Kong"},
+		{"", "heure de l’Inde", ""},
+		{"", "heure d’Indochine", ""},
+		{"", "heure de l’Ouest indonésien", ""},
+		{"heure de l’Iran", "heure normale d’Iran", "heure d’été d’Iran"},
+		{"heure d’Israël", "heure normale d’Israël", "heure d’été d’Israël"},
+		{"heure du Japon", "heure normale du Japon", "heure d’été du Japon"},
+		{"heure de la Corée", "heure normale de la Corée", "heure d’été de Corée"},
+		{"", "heure de la Malaisie", ""},
+		{"heure de Moscou", "heure normale de Moscou", "heure d’été de Moscou"},
+		{"heure de la Nouvelle-Zélande", "heure normale de la Nouvelle-Zélande", "heure d’été de la Nouvelle-Zélande"},
+		{"heure de Terre-Neuve", "heure normale de Terre-Neuve", "heure d’été de Terre-Neuve"},
+		{"heure du Pakistan", "heure normale du Pakistan", "heure d’été du Pakistan"},
+		{"heure du Pérou", "heure normale du Pérou", "heure d’été du Pérou"},
+		{"heure des Philippines", "heure normale des Philippines", "heure d’été des Philippines"},
+		{"", "heure de Singapour", ""},
+		{"heure de Taipei", "heure normale de Taipei", "heure d’été de Taipei"},
+		{"", "heure du Venezuela", ""},
+		{"", "Temps universel coordonné", ""},
+		{"", "", "heure d’été irlandaise"},
+		{"", "", "heure d’été britannique"},
+		{"", "", ""},
+	},
+	"fur": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Ore de Europe centrâl", "Ore standard de Europe centrâl", "Ore estive de Europe centrâl"},
+		{"Ore de Europe orientâl", "Ore standard de Europe orientâl", "Ore estive de Europe orientâl"},
+		{"Ore de Europe ocidentâl", "Ore standard de Europe ocidentâl", "Ore estive de Europe ocidentâl"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Ore di Mosche", "Ore standard di Mosche", "Ore estive di Mosche"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"fy": {
+		{"", "Sintraal-Afrikaanske tiid", ""},
+		{"", "East-Afrikaanske tiid", ""},
+		{"", "Sûd-Afrikaanske tiid", ""},
+		{"West-Afrikaanske tiid", "West-Afrikaanske standerttiid", "West-Afrikaanske simmertiid"},
+		{"Alaska-tiid", "Alaska-standerttiid", "Alaska-simmertiid"},
+		{"Central-tiid", "Central-standerttiid", "Central-simmertiid"},
+		{"Eastern-tiid", "Eastern-standerttiid", "Eastern-simmertiid"},
+		{"Mountain-tiid", "Mountain-standerttiid", "Mountain-simmertiid"},
+		{"Pasifik-tiid", "Pasifik-standerttiid", "Pasifik-simmertiid"},
+		{"Arabyske tiid", "Arabyske standerttiid", "Arabyske simmertiid"},
+		{"Argentynske tiid", "Argentynske standerttiid", "Argentynske simmertiid"},
+		{"Atlantic-tiid", "Atlantic-standerttiid", "Atlantic-simmertiid"},
+		{"Midden-Australyske tiid", "Midden-Australyske standerttiid", "Midden-Australyske simmertiid"},
+		{"East-Australyske tiid", "East-Australyske standerttiid", "East-Australyske simmertiid"},
+		{"West-Australyske tiid", "West-Australyske standerttiid", "West-Australyske simmertiid"},
+		{"Bengalese tiid", "Bengalese standerttiid", "Bengalese simmertiid"},
+		{"Brazyljaanske tiid", "Brazyljaanske standerttiid", "Brazyljaanske simmertiid"},
+		{"Sileenske tiid", "Sileenske standerttiid", "Sileenske simmertiid"},
+		{"Sineeske tiid", "Sineeske standerttiid", "Sineeske simmertiid"},
+		{"Kolombiaanske tiid", "Kolombiaanske standerttiid", "Kolombiaanske simmertiid"},
+		{"Midden-Europeeske tiid", "Midden-Europeeske standerttiid", "Midden-Europeeske simmertiid"},
+		{"East-Europeeske tiid", "East-Europeeske standerttiid", "East-Europeeske simmertiid"},
+		{"West-Europeeske tiid", "West-Europeeske standerttiid", "West-Europeeske simmertiid"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Golf standerttiid", ""},
+		{"Hawaii-Aleoetyske tiid", "Hawaii-Aleoetyske standerttiid", "Hawaii-Aleoetyske simmertiid"},
+		{"Hongkongse tiid", "Hongkongse standerttiid", "Hongkongse simmertiid"},
+		{"", "Yndiaaske tiid", ""},
+		{"", "Yndochinese tiid", ""},
+		{"", "West-Yndonezyske tiid", ""},
+		{"Iraanske tiid", "Iraanske standerttiid", "Iraanske simmertiid"},
+		{"Israëlyske tiid", "Israëlyske standerttiid", "Israëlyske simmertiid"},
+		{"Japanske tiid", "Japanske standerttiid", "Japanske simmertiid"},
+		{"Koreaanske tiid", "Koreaanske standerttiid", "Koreaanske simmertiid"},
+		{"", "Maleisyske tiid", ""},
+		{"Moskou-tiid", "Moskou-standerttiid", "Moskou-simmertiid"},
+		{"Nij-Seelânske tiid", "Nij-Seelânske standerttiid", "Nij-Seelânske simmertiid"},
+		{"Newfoundlânske-tiid", "Newfoundlânske-standerttiid", "Newfoundlânske-simmertiid"},
+		{"Pakistaanske tiid", "Pakistaanske standerttiid", "Pakistaanske simmertiid"},
+		{"Peruaanske tiid", "Peruaanske standerttiid", "Peruaanske simmertiid"},
+		{"Filipijnse tiid", "Filipijnse standerttiid", "Filipijnse simmertiid"},
+		{"", "Singaporese standerttiid", ""},
+		{"Taipei tiid", "Taipei standerttiid", "Taipei simmertiid"},
+		{"", "Fenezolaanske tiid", ""},
+		{"", "", ""},
+		{"", "", "Ierse simmertiid"},
+		{"", "", "Britse simmertiid"},
+		{"", "", ""},
+	},
+	"ga": {
+		{"", "Am Lár na hAfraice", ""},
+		{"", "Am Oirthear na hAfraice", ""},
+		{"", "Am Caighdeánach na hAfraice Theas", ""},
+		{"Am Iarthar na hAfraice", "Am Caighdeánach Iarthar na hAfraice", "Am Samhraidh Iarthar na hAfraice"},
+		{"Am Alasca", "Am Caighdeánach Alasca", "Am Samhraidh Alasca"},
+		{"Am Lárnach", "Am Caighdeánach Lárnach", "Am Samhraidh Lárnach"},
+		{"Am an Oirthir", "Am Caighdeánach an Oirthir", "Am Samhraidh an Oirthir"},
+		{"Am na Sléibhte", "Am Caighdeánach na Sléibhte", "Am Samhraidh na Sléibhte"},
+		{"Am an Aigéin Chiúin", "Am Caighdeánach an Aigéin Chiúin", "Am Samhraidh an Aigéin Chiúin"},
+		{"Am na hAraibe", "Am Caighdeánach na hAraibe", "Am Samhraidh na hAraibe"},
+		{"Am na hAirgintíne", "Am Caighdeánach na hAirgintíne", "Am Samhraidh na hAirgintíne"},
+		{"Am an Atlantaigh", "Am Caighdeánach an Atlantaigh", "Am Samhraidh an Atlantaigh"},
+		{"Am Lár na hAstráile", "Am Caighdeánach Lár na hAstráile", "Am Samhraidh Lár na hAstráile"},
+		{"Am Oirthear na hAstráile", "Am Caighdeánach Oirthear na hAstráile", "Am Samhraidh Oirthear na hAstráile"},
+		{"Am Iarthar na hAstráile", "Am Caighdeánach Iarthar na hAstráile", "Am Samhraidh Iarthar na hAstráile"},
+		{"Am na Banglaidéise", "Am Caighdeánach na Banglaidéise", "Am Samhraidh na Banglaidéise"},
+		{"Am Bhrasília", "Am Caighdeánach Bhrasília", "Am Samhraidh Bhrasília"},
+		{"Am na Sile", "Am Caighdeánach na Sile", "Am Samhraidh na Sile"},
+		{"Am na Síne", "Am Caighdeánach na Síne", "Am Samhraidh na Síne"},
+		{"Am na Colóime", "Am Caighdeánach na Colóime", "Am Samhraidh na Colóime"},
+		{"Am Lár na hEorpa", "Am Caighdeánach Lár na hEorpa", "Am Samhraidh Lár na hEorpa"},
+		{"Am Oirthear na hEorpa", "Am Caighdeánach Oirthear na hEorpa", "Am Samhraidh Oirthear na hEorpa"},
+		{"Am Iarthar na hEorpa", "Am Caighdeánach Iarthar na hEorpa", "Am Samhraidh Iarthar na hEorpa"},
+		{"", "Meán-Am Greenwich", ""},
+		{"", "Am Caighdeánach na Murascaille", ""},
+		{"Am Haváí-Ailiúit", "Am Caighdeánach Haváí-Ailiúit", "Am Samhraidh Haváí-Ailiúit"},
+		{"Am Hong Cong", "Am Caighdeánach Hong Cong", "Am Samhraidh Hong Cong"},
+		{"", "Am Caighdeánach na hIndia", ""},
+		{"", "Am na hInd-Síne", ""},
+		{"", "Am Iarthar na hIndinéise", ""},
+		{"Am na hIaráine", "Am Caighdeánach na hIaráine", "Am Samhraidh na hIaráine"},
+		{"Am Iosrael", "Am Caighdeánach Iosrael", "Am Samhraidh Iosrael"},
+		{"Am na Seapáine", "Am Caighdeánach na Seapáine", "Am Samhraidh na Seapáine"},
+		{"Am na Cóiré", "Am Caighdeánach na Cóiré", "Am Samhraidh na Cóiré"},
+		{"", "Am na Malaeisia", ""},
+		{"Am Mhoscó", "Am Caighdeánach Mhoscó", "Am Samhraidh Mhoscó"},
+		{"Am na Nua-Shéalainne", "Am Caighdeánach na Nua-Shéalainne", "Am Samhraidh na Nua-Shéalainne"},
+		{"Am Thalamh an Éisc", "Am Caighdeánach Thalamh an Éisc", "Am Samhraidh Thalamh an Éisc"},
+		{"Am na Pacastáine", "Am Caighdeánach na Pacastáine", "Am Samhraidh na Pacastáine"},
+		{"Am Pheiriú", "Am Caighdeánach Pheiriú", "Am Samhraidh Pheiriú"},
+		{"Am na nOileán Filipíneach", "Am Caighdeánach na nOileán Filipíneach", "Am Samhraidh na nOileán Filipíneach"},
+		{"", "Am Caighdeánach Shingeapór", ""},
+		{"Am Thaipei", "Am Caighdeánach Thaipei", "Am Samhraidh Thaipei"},
+		{"", "Am Veiniséala", ""},
+		{"", "Am Uilíoch Lárnach", ""},
+		{"", "", "Am Caighdéanach na hÉireann"},
+		{"", "", "Am Samhraidh na Breataine"},
+		{"", "", ""},
+	},
+	"gd": {
+		{"", "Àm Meadhan Afraga", ""},
+		{"", "Àm Afraga an Ear", ""},
+		{"", "Àm Afraga a Deas", ""},
+		{"Àm Afraga an Iar", "Bun-àm Afraga an Iar", "Tìde Samhraidh Afraga an Iar"},
+		{"Àm Alaska", "Bun-àm Alaska", "Tìde samhraidh Alaska"},
+		{"Àm Meadhan Aimeireaga a Tuath", "Bun-àm Meadhan Aimeireaga a Tuath", "Tìde samhraidh Meadhan Aimeireaga a Tuath"},
+		{"Àm Aimeireaga a Tuath an Ear", "Bun-àm Aimeireaga a Tuath an Ear", "Tìde samhraidh Aimeireaga a Tuath an Ear"},
+		{"Àm Monadh Aimeireaga a Tuath", "Bun-àm Monadh Aimeireaga a Tuath", "Tìde samhraidh Monadh Aimeireaga a Tuath"},
+		{"Àm a’ Chuain Sèimh", "Bun-àm a’ Chuain Sèimh", "Tìde samhraidh a’ Chuain Sèimh"},
+		{"Àm Arabach", "Bun-àm Arabach", "Tìde samhraidh Arabach"},
+		{"Àm na h-Argantaine", "Bun-àm na h-Argantaine", "Tìde samhraidh na h-Argantaine"},
+		{"Àm a’ Chuain Siar", "Bun-àm a’ Chuain Siar", "Tìde samhraidh a’ Chuain Siar"},
+		{"Àm Meadhan Astràilia", "Bun-àm Meadhan Astràilia", "Tìde samhraidh Meadhan Astràilia"},
+		{"Àm Astràilia an Ear", "Bun-àm Astràilia an Ear", "Tìde samhraidh Astràilia an Ear"},
+		{"Àm Astràilia an Iar", "Bun-àm Astràilia an Iar", "Tìde samhraidh Astràilia an Iar"},
+		{"Àm Bangladais", "Bun-àm Bangladais", "Tìde samhraidh Bangladais"},
+		{"Àm Bhrasilia", "Bun-àm Bhrasilia", "Tìde samhraidh Bhrasilia"},
+		{"Àm na Sile", "Bun-àm na Sile", "Tìde samhraidh na Sile"},
+		{"Àm na Sìne", "Bun-àm na Sìne", "Tìde samhraidh na Sìne"},
+		{"Àm Coloimbia", "Bun-àm Coloimbia", "Tìde samhraidh Coloimbia"},
+		{"Àm Meadhan na Roinn-Eòrpa", "Bun-àm Meadhan na Roinn-Eòrpa", "Tìde samhraidh Meadhan na Roinn-Eòrpa"},
+		{"Àm na Roinn-Eòrpa an Ear", "Bun-àm na Roinn-Eòrpa an Ear", "Tìde samhraidh na Roinn-Eòrpa an Ear"},
+		{"Àm na Roinn-Eòrpa an Iar", "Bun-àm na Roinn-Eòrpa an Iar", "Tìde samhraidh na Roinn-Eòrpa an Iar"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Àm a’ Chamais", ""},
+		{"Àm nan Eileanan Hawai’i ’s Aleutach", "Bun-àm nan Eileanan Hawai’i ’s Aleutach", "Tìde Samhraidh nan Eileanan Hawai’i ’s Aleutach"},
+		{"Àm Hong Kong", "Bun-àm Hong Kong", "Tìde samhraidh Hong Kong"},
+		{"", "Àm nan Innseachan", ""},
+		{"", "Àm Sìn-Innseanach", ""},
+		{"", "Àm nan Innd-Innse an Iar", ""},
+		{"Àm Ioràin", "Bun-àm Ioràin", "Tìde samhraidh Ioràin"},
+		{"Àm Iosrael", "Bun-àm Iosrael", "Tìde samhraidh Iosrael"},
+		{"Àm na Seapaine", "Bun-àm na Seapaine", "Tìde samhraidh na Seapaine"},
+		{"Àm Choirèa", "Bun-àm Choirèa", "Tìde samhraidh Choirèa"},
+		{"", "Àm Mhalaidhsea", ""},
+		{"Àm Mhosgo", "Bun-àm Mhosgo", "Tìde samhraidh Mhosgo"},
+		{"Àm Shealainn Nuaidh", "Bun-àm Shealainn Nuaidh", "Tìde samhraidh Shealainn Nuaidh"},
+		{"Àm Talamh an Èisg", "Bun-àm Talamh an Èisg", "Tìde samhraidh Talamh an Èisg"},
+		{"Àm Pagastàin", "Bun-àm Pagastàin", "Tìde samhraidh Pagastàin"},
+		{"Àm Pearù", "Bun-àm Pearù", "Tìde samhraidh Pearù"},
+		{"Àm nan Eilean Filipineach", "Bun-àm nan Eilean Filipineach", "Tìde samhraidh nan Eilean Filipineach"},
+		{"", "Àm Singeapòr", ""},
+		{"Àm Taipei", "Bun-àm Taipei", "Tìde samhraidh Taipei"},
+		{"", "Àm na Bheiniseala", ""},
+		{"", "Àm Uile-choitcheann Co-òrdanaichte", ""},
+		{"", "", "Bun-àm na h-Èireann"},
+		{"", "", "Tìde samhraidh Bhreatainn"},
+		{"", "", ""},
+	},
+	"gl": {
+		{"", "Horario de África Central", ""},
+		{"", "Horario de África Oriental", ""},
+		{"", "Horario estándar de África do Sur", ""},
+		{"Horario de África Occidental", "Horario estándar de África Occidental", "Horario de verán de África Occidental"},
+		{"Horario de Alasca", "Horario estándar de Alasca", "Horario de verán de Alasca"},
+		{"Horario central, Norteamérica", "Horario estándar central, Norteamérica", "Horario de verán central, Norteamérica"},
+		{"Horario do leste, Norteamérica", "Horario estándar do leste, Norteamérica", "Horario de verán do leste, Norteamérica"},
+		{"Horario da montaña, Norteamérica", "Horario estándar da montaña, Norteamérica", "Horario de verán da montaña, Norteamérica"},
+		{"Horario do Pacífico, Norteamérica", "Horario estándar do Pacífico, Norteamérica", "Horario de verán do Pacífico, Norteamérica"},
+		{"Horario árabe", "Horario estándar árabe", "Horario de verán árabe"},
+		{"Horario da Arxentina", "Horario estándar da Arxentina", "Horario de verán da Arxentina"},
+		{"Horario do Atlántico", "Horario estándar do Atlántico", "Horario de verán do Atlántico"},
+		{"Horario de Australia Central", "Horario estándar de Australia Central", "Horario de verán de Australia Central"},
+		{"Horario de Australia Oriental", "Horario estándar de Australia Oriental", "Horario de verán de Australia Oriental"},
+		{"Horario de Australia Occidental", "Horario estándar de Australia Occidental", "Horario de verán de Australia Occidental"},
+		{"Horario de Bangladesh", "Horario estándar de Bangladesh", "Horario de verán de Bangladesh"},
+		{"Horario de Brasilia", "Horario estándar de Brasilia", "Horario de verán de Brasilia"},
+		{"Horario de Chile", "Horario estándar de Chile", "Horario de verán de Chile"},
+		{"Horario da China", "Horario estándar da China", "Horario de verán da China"},
+		{"Horario de Colombia", "Horario estándar de Colombia", "Horario de verán de Colombia"},
+		{"Horario de Europa Central", "Horario estándar de Europa Central", "Horario de verán de Europa Central"},
+		{"Horario de Europa Oriental", "Horario estándar de Europa Oriental", "Horario de verán de Europa Oriental"},
+		{"Horario de Europa Occidental", "Horario estándar de Europa Occidental", "Horario de verán de Europa Occidental"},
+		{"", "Horario do meridiano de Greenwich", ""},
+		{"", "Horario estándar do Golfo", ""},
+		{"Horario de Hawai-Aleutiano", "Horario estándar de Hawai-Aleutiano", "Horario de verán de Hawai-Aleutiano"},
+		{"Horario de Hong Kong", "Horario estándar de Hong Kong", "Horario de verán de Hong Kong"},
+		{"", "Horario estándar da India", ""},
+		{"", "Horario de Indochina", ""},
+		{"", "Horario de Indonesia Occidental", ""},
+		{"Horario de Irán", "Horario estándar de Irán", "Horario de verán de Irán"},
+		{"Horario de Israel", "Horario estándar de Israel", "Horario de verán de Israel"},
+		{"Horario do Xapón", "Horario estándar do Xapón", "Horario de verán do Xapón"},
+		{"Horario de Corea", "Horario estándar de Corea", "Horario de verán de Corea"},
+		{"", "Horario de Malaisia", ""},
+		{"Horario de Moscova", "Horario estándar de Moscova", "Horario de verán de Moscova"},
+		{"Horario de Nova Zelandia", "Horario estándar de Nova Zelandia", "Horario de verán de Nova Zelandia"},
+		{"Horario de Terranova", "Horario estándar de Terranova", "Horario de verán de Terranova"},
+		{"Horario de Paquistán", "Horario estándar de Paquistán", "Horario de verán de Paquistán"},
+		{"Horario do Perú", "Horario estándar do Perú", "Horario de verán do Perú"},
+		{"Horario de Filipinas", "Horario estándar de Filipinas", "Horario de verán de Filipinas"},
+		{"", "Horario estándar de Singapur", ""},
+		{"Horario de Taipei", "Horario estándar de Taipei", "Horario de verán de Taipei"},
+		{"", "Horario de Venezuela", ""},
+		{"", "Horario universal coordinado", ""},
+		{"", "", "Horario estándar irlandés"},
+		{"", "", "Horario de verán británico"},
+		{"", "", ""},
+	},
+	"gu": {
+		{"", "મધ્ય આફ્રિકા સમય", ""},
+		{"", "પૂર્વ આફ્રિકા સમય", ""},
+		{"", "દક્ષિણ આફ્રિકા માનક સમય", ""},
+		{"પશ્ચિમ આફ્રિકા સમય", "પશ્ચિમ આફ્રિકા માનક સમય", "પશ્ચિમ આફ્રિકા ગ્રીષ્મ સમય"},
+		{"અલાસ્કા સમય", "અલાસ્કા માનક સમય", "અલાસ્કા દિવસ સમય"},
+		{"ઉત્તર અમેરિકન કેન્દ્રીય સમય", "ઉત્તર અમેરિકન કેન્દ્રીય માનક સમય", "ઉત્તર અમેરિકન કેન્દ્રીય દિવસ સમય"},
+		{"ઉત્તર અમેરિકન પૂર્વી સમય", "ઉત્તર અમેરિકન પૂર્વી માનક સમય", "ઉત્તર અમેરિકન પૂર્વી દિવસ સમય"},
+		{"ઉત્તર અમેરિકન માઉન્ટન સમય", "ઉત્તર અમેરિકન માઉન્ટન માનક સમય", "ઉત્તર અમેરિકન માઉન્ટન દિવસ સમય"},
+		{"ઉત્તર અમેરિકન પેસિફિક સમય", "ઉત્તર અમેરિકન પેસિફિક માનક સમય", "ઉત્તર અમેરિકન પેસિફિક દિવસ સમય"},
+		{"અરેબિયન સમય", "અરેબિયન માનક સમય", "અરેબિયન દિવસ સમય"},
+		{"આર્જેન્ટીના સમય", "આર્જેન્ટીના માનક સમય", "આર્જેન્ટીના ગ્રીષ્મ સમય"},
+		{"એટલાન્ટિક સમય", "એટલાન્ટિક માનક સમય", "એટલાન્ટિક દિવસ સમય"},
+		{"કેન્દ્રીય ઓસ્ટ્રેલિયન સમય", "ઓસ્ટ્રેલિયન કેન્દ્રીય માનક સમય", "ઓસ્ટ્રેલિયન કેન્દ્રીય દિવસ સમય"},
+		{"પૂર્વીય ઓસ્ટ્રેલિયા સમય", "ઓસ્ટ્રેલિયન પૂર્વીય માનક સમય", "ઓસ્ટ્રેલિયન પૂર્વીય દિવસ સમય"},
+		{"પશ્ચિમી ઓસ્ટ્રેલિયા સમય", "ઓસ્ટ્રેલિયન પશ્ચિમી માનક સમય", "ઓસ્ટ્રેલિયન પશ્ચિમી દિવસ સમય"},
+		{"બાંગ્લાદેશ સમય", "બાંગ્લાદેશ માનક સમય", "બાંગ્લાદેશ ગ્રીષ્મ સમય"},
+		{"બ્રાઝિલિયા સમય", "બ્રાઝિલિયા માનક સમય", "બ્રાઝિલિયા ગ્રીષ્મ સમય"},
+		{"ચિલી સમય", "ચિલી માનક સમય", "ચિલી ગ્રીષ્મ સમય"},
+		{"ચીન સમય", "ચીન માનક સમય", "ચીન દિવસ સમય"},
+		{"કોલંબિયા સમય", "કોલંબિયા માનક સમય", "કોલંબિયા ગ્રીષ્મ સમય"},
+		{"મધ્ય યુરોપિયન સમય", "મધ્ય યુરોપિયન માનક સમય", "મધ્ય યુરોપિયન ગ્રીષ્મ સમય"},
+		{"પૂર્વી યુરોપિયન સમય", "પૂર્વી યુરોપિયન માનક સમય", "પૂર્વી યુરોપિયન ગ્રીષ્મ સમય"},
+		{"પશ્ચિમી યુરોપિયન સમય", "પશ્ચિમી યુરોપિયન માનક સમય", "પશ્ચિમી યુરોપિયન ગ્રીષ્મ સમય"},
+		{"", "ગ્રીનવિચ મધ્યમ સમય", ""},
+		{"", "ગલ્ફ માનક સમય", ""},
+		{"હવાઈ-એલ્યુશિઅન સમય", "હવાઇ-એલ્યુશિઅન માનક સમય", "હવાઇ-એલ્યુશિઅન દિવસ સમય"},
+		{"હોંગ કોંગ સમય", "હોંગ કોંગ માનક સમય", "હોંગ કોંગ ગ્રીષ્મ સમય"},
+		{"", "ભારતીય માનક સમય", ""},
+		{"", "ઇન્ડોચાઇના સમય", ""},
+		{"", "પશ્ચિમી ઇન્ડોનેશિયા સમય", ""},
+		{"ઈરાન સમય", "ઈરાન માનક સમય", "ઈરાન દિવસ સમય"},
+		{"ઇઝરાઇલ સમય", "ઇઝરાઇલ માનક સમય", "ઇઝરાઇલ દિવસ સમય"},
+		{"જાપાન સમય", "જાપાન માનક સમય", "જાપાન દિવસ સમય"},
+		{"કોરિયન સમય", "કોરિયન માનક સમય", "કોરિયન દિવસ સમય"},
+		{"", "મલેશિયા સમય", ""},
+		{"મોસ્કો સમય", "મોસ્કો માનક સમય", "મોસ્કો ગ્રીષ્મ સમય"},
+		{"ન્યુઝીલેન્ડ સમય", "ન્યુઝીલેન્ડ માનક સમય", "ન્યુઝીલેન્ડ દિવસ સમય"},
+		{"ન્યૂફાઉન્ડલેન્ડ સમય", "ન્યૂફાઉન્ડલેન્ડ માનક સમય", "ન્યૂફાઉન્ડલેન્ડ દિવસ સમય"},
+		{"પાકિસ્તાન સમય", "પાકિસ્તાન માનક સમય", "પાકિસ્તાન ગ્રીષ્મ સમય"},
+		{"પેરુ સમય", "પેરુ માનક સમય", "પેરુ ગ્રીષ્મ સમય"},
+		{"ફિલિપાઇન સમય", "ફિલિપાઇન માનક સમય", "ફિલિપાઇન ગ્રીષ્મ સમય"},
+		{"", "સિંગાપુર માનક સમય", ""},
+		{"તાઇપેઇ સમય", "તાઇપેઇ માનક સમય", "તાઇપેઇ દિવસ સમય"},
+		{"", "વેનેઝુએલા સમય", ""},
+		{"", "સંકલિત યુનિવર્સલ સમય", ""},
+		{"", "", "આઈરિશ માનક સમય"},
+		{"", "", "બ્રિટિશ ગ્રીષ્મ સમય"},
+		{"", "", ""},
+	},
+	"he": {
+		{"", "שעון מרכז אפריקה", ""},
+		{"", "שעון מזרח אפריקה", ""},
+		{"", "שעון דרום אפריקה", ""},
+		{"שעון מערב אפריקה", "שעון מערב אפריקה (חורף)", "שעון מערב אפריקה (קיץ)"},
+		{"שעון אלסקה", "שעון אלסקה (חורף)", "שעון אלסקה (קיץ)"},
+		{"שעון מרכז ארה״ב", "שעון מרכז ארה״ב (חורף)", "שעון מרכז ארה״ב (קיץ)"},
+		{"שעון החוף המזרחי", "שעון החוף המזרחי (חורף)", "שעון החוף המזרחי (קיץ)"},
+		{"שעון אזור ההרים בארה״ב", "שעון אזור ההרים בארה״ב (חורף)", "שעון אזור ההרים בארה״ב (קיץ)"},
+		{"שעון מערב ארה״ב", "שעון מערב ארה״ב (חורף)", "שעון מערב ארה״ב (קיץ)"},
+		{"שעון חצי האי ערב", "שעון חצי האי ערב (חורף)", "שעון חצי האי ערב (קיץ)"},
+		{"שעון ארגנטינה", "שעון ארגנטינה (חורף)", "שעון ארגנטינה (קיץ)"},
+		{"שעון האוקיינוס האטלנטי", "שעון האוקיינוס האטלנטי (חורף)", "שעון האוקיינוס האטלנטי (קיץ)"},
+		{"שעון מרכז אוסטרליה", "שעון מרכז אוסטרליה (חורף)", "שעון מרכז אוסטרליה (קיץ)"},
+		{"שעון מזרח אוסטרליה", "שעון מזרח אוסטרליה (חורף)", "שעון מזרח אוסטרליה (קיץ)"},
+		{"שעון מערב אוסטרליה", "שעון מערב אוסטרליה (חורף)", "שעון מערב אוסטרליה (קיץ)"},
+		{"שעון בנגלדש", "שעון בנגלדש (חורף)", "שעון בנגלדש (קיץ)"},
+		{"שעון ברזיליה", "שעון ברזיליה (חורף)", "שעון ברזיליה (קיץ)"},
+		{"שעון צ׳ילה", "שעון צ׳ילה (חורף)", "שעון צ׳ילה (קיץ)"},
+		{"שעון סין", "שעון סין (חורף)", "שעון סין (קיץ)"},
+		{"שעון קולומביה", "שעון קולומביה (חורף)", "שעון קולומביה (קיץ)"},
+		{"שעון מרכז אירופה", "שעון מרכז אירופה (חורף)", "שעון מרכז אירופה (קיץ)"},
+		{"שעון מזרח אירופה", "שעון מזרח אירופה (חורף)", "שעון מזרח אירופה (קיץ)"},
+		{"שעון מערב אירופה", "שעון מערב אירופה (חורף)", "שעון מערב אירופה (קיץ)"},
+		{"", "שעון גריניץ׳\u200f", ""},
+		{"", "שעון מדינות המפרץ", ""},
+		{"שעון האיים האלאוטיים הוואי", "שעון האיים האלאוטיים הוואי (חורף)", "שעון האיים האלאוטיים הוואי (קיץ)"},
+		{"שעון הונג קונג", "שעון הונג קונג (חורף)", "שעון הונג קונג (קיץ)"},
+		{"", "שעון הודו", ""},
+		{"", "שעון הודו-סין", ""},
+		{"", "שעון מערב אינדונזיה", ""},
+		{"שעון איראן", "שעון איראן (חורף)", "שעון איראן (קיץ)"},
+		{"שעון ישראל", "שעון ישראל (חורף)", "שעון ישראל (קיץ)"},
+		{"שעון יפן", "שעון יפן (חורף)", "שעון יפן (קיץ)"},
+		{"שעון קוריאה", "שעון קוריאה (חורף)", "שעון קוריאה (קיץ)"},
+		{"", "שעון מלזיה", ""},
+		{"שעון מוסקבה", "שעון מוסקבה (חורף)", "שעון מוסקבה (קיץ)"},
+		{"שעון ניו זילנד", "שעון ניו זילנד (חורף)", "שעון ניו זילנד (קיץ)"},
+		{"שעון ניופאונדלנד", "שעון ניופאונדלנד (חורף)", "שעון ניופאונדלנד (קיץ)"},
+		{"שעון פקיסטן", "שעון פקיסטן (חורף)", "שעון פקיסטן (קיץ)"},
+		{"שעון פרו", "שעון פרו (חורף)", "שעון פרו (קיץ)"},
+		{"שעון הפיליפינים", "שעון הפיליפינים (חורף)", "שעון הפיליפינים (קיץ)"},
+		{"", "שעון סינגפור", ""},
+		{"שעון טאיפיי", "שעון טאיפיי (חורף)", "שעון טאיפיי (קיץ)"},
+		{"", "שעון ונצואלה", ""},
+		{"", "זמן אוניברסלי מתואם", ""},
+		{"", "", "שעון קיץ אירלנד"},
+		{"", "", "שעון קיץ בריטניה"},
+		{"", "", ""},
+	},
+	"hi": {
+		{"", "मध्य अफ़्रीका समय", ""},
+		{"", "पूर्वी अफ़्रीका समय", ""},
+		{"", "दक्षिण अफ़्रीका मानक समय", ""},
+		{"पश्चिम अफ़्रीका समय", "पश्चिम अफ़्रीका मानक समय", "पश्चिम अफ़्रीका ग्रीष्मकालीन समय"},
+		{"अलास्का समय", "अलास्\u200dका मानक समय", "अलास्\u200dका डेलाइट समय"},
+		{"उत्तरी अमेरिकी केंद्रीय समय", "उत्तरी अमेरिकी केंद्रीय मानक समय", "उत्तरी अमेरिकी केंद्रीय डेलाइट समय"},
+		{"उत्तरी अमेरिकी पूर्वी समय", "उत्तरी अमेरिकी पूर्वी मानक समय", "उत्तरी अमेरिकी पूर्वी डेलाइट समय"},
+		{"उत्तरी अमेरिकी माउंटेन समय", "उत्तरी अमेरिकी माउंटेन मानक समय", "उत्तरी अमेरिकी माउंटेन डेलाइट समय"},
+		{"उत्तरी अमेरिकी प्रशांत समय", "उत्तरी अमेरिकी प्रशांत मानक समय", "उत्तरी अमेरिकी प्रशांत डेलाइट समय"},
+		{"अरब समय", "अरब मानक समय", "अरब डेलाइट समय"},
+		{"अर्जेंटीना समय", "अर्जेंटीना मानक समय", "अर्जेंटीना ग्रीष्मकालीन समय"},
+		{"अटलांटिक समय", "अटलांटिक मानक समय", "अटलांटिक डेलाइट समय"},
+		{"मध्य ऑस्ट्रेलियाई समय", "ऑस्\u200dट्रेलियाई केंद्रीय मानक समय", "ऑस्\u200dट्रेलियाई केंद्रीय डेलाइट समय"},
+		{"पूर्वी ऑस्ट्रेलिया समय", "ऑस्\u200dट्रेलियाई पूर्वी मानक समय", "ऑस्\u200dट्रेलियाई पूर्वी डेलाइट समय"},
+		{"पश्चिमी ऑस्ट्रेलिया समय", "ऑस्ट्रेलियाई पश्चिमी मानक समय", "ऑस्ट्रेलियाई पश्चिमी डेलाइट समय"},
+		{"बांग्लादेश समय", "बांग्लादेश मानक समय", "बांग्लादेश ग्रीष्मकालीन समय"},
+		{"ब्राज़ीलिया समय", "ब्राज़ीलिया मानक समय", "ब्राज़ीलिया ग्रीष्मकालीन समय"},
+		{"चिली समय", "चिली मानक समय", "चिली ग्रीष्मकालीन समय"},
+		{"चीन समय", "चीन मानक समय", "चीन डेलाइट समय"},
+		{"कोलंबिया समय", "कोलंबिया मानक समय", "कोलंबिया ग्रीष्मकालीन समय"},
+		{"मध्य यूरोपीय समय", "मध्य यूरोपीय मानक समय", "मध्\u200dय यूरोपीय ग्रीष्\u200dमकालीन समय"},
+		{"पूर्वी यूरोपीय समय", "पूर्वी यूरोपीय मानक समय", "पूर्वी यूरोपीय ग्रीष्मकालीन समय"},
+		{"पश्चिमी यूरोपीय समय", "पश्चिमी यूरोपीय मानक समय", "पश्चिमी यूरोपीय ग्रीष्\u200dमकालीन समय"},
+		{"", "ग्रीनविच मीन टाइम", ""},
+		{"", "खाड़ी मानक समय", ""},
+		{"हवाई–आल्यूशन समय", "हवाई–आल्यूशन मानक समय", "हवाई–आल्यूशन डेलाइट समय"},
+		{"हाँग काँग समय", "हाँग काँग मानक समय", "हाँग काँग ग्रीष्मकालीन समय"},
+		{"", "भारतीय मानक समय", ""},
+		{"", "इंडोचाइना समय", ""},
+		{"", "पश्चिमी इंडोनेशिया समय", ""},
+		{"ईरान समय", "ईरान मानक समय", "ईरान डेलाइट समय"},
+		{"इज़राइल समय", "इज़राइल मानक समय", "इज़राइल डेलाइट समय"},
+		{"जापान समय", "जापान मानक समय", "जापान डेलाइट समय"},
+		{"कोरियाई समय", "कोरियाई मानक समय", "कोरियाई डेलाइट समय"},
+		{"", "मलेशिया समय", ""},
+		{"मॉस्को समय", "मॉस्को मानक समय", "मॉस्को ग्रीष्मकालीन समय"},
+		{"न्यूज़ीलैंड समय", "न्यूज़ीलैंड मानक समय", "न्यूज़ीलैंड डेलाइट समय"},
+		{"न्यूफ़ाउंडलैंड समय", "न्यूफ़ाउंडलैंड मानक समय", "न्यूफ़ाउंडलैंड डेलाइट समय"},
+		{"पाकिस्तान समय", "पाकिस्तान मानक समय", "पाकिस्तान ग्रीष्मकालीन समय"},
+		{"पेरू समय", "पेरू मानक समय", "पेरू ग्रीष्मकालीन समय"},
+		{"फ़िलिपीन समय", "फ़िलिपीन मानक समय", "फ़िलिपीन ग्रीष्मकालीन समय"},
+		{"", "सिंगापुर समय", ""},
+		{"ताइपे समय", "ताइपे मानक समय", "ताइपे डेलाइट समय"},
+		{"", "वेनेज़ुएला समय", ""},
+		{"", "समन्वित वैश्विक समय", ""},
+		{"", "", "आइरिश मानक समय"},
+		{"", "", "ब्रिटिश ग्रीष्मकालीन समय"},
+		{"", "", ""},
+	},
+	"hr": {
+		{"", "srednjoafričko vrijeme", ""},
+		{"", "istočnoafričko vrijeme", ""},
+		{"", "južnoafričko vrijeme", ""},
+		{"zapadnoafričko vrijeme", "zapadnoafričko standardno vrijeme", "zapadnoafričko ljetno vrijeme"},
+		{"aljaško vrijeme", "aljaško standardno vrijeme", "aljaško ljetno vrijeme"},
+		{"središnje vrijeme", "središnje standardno vrijeme", "središnje ljetno vrijeme"},
+		{"istočno vrijeme", "istočno standardno vrijeme", "istočno ljetno vrijeme"},
+		{"planinsko vrijeme", "planinsko standardno vrijeme", "planinsko ljetno vrijeme"},
+		{"pacifičko vrijeme", "pacifičko standardno vrijeme", "pacifičko ljetno vrijeme"},
+		{"arapsko vrijeme", "arapsko standardno vrijeme", "arapsko ljetno vrijeme"},
+		{"argentinsko vrijeme", "argentinsko standardno vrijeme", "argentinsko ljetno vrijeme"},
+		{"atlantsko vrijeme", "atlantsko standardno vrijeme", "atlantsko ljetno vrijeme"},
+		{"srednjoaustralsko vrijeme", "srednjoaustralsko standardno vrijeme", "srednjoaustralsko ljetno vrijeme"},
+		{"istočnoaustralsko vrijeme", "istočnoaustralsko standardno vrijeme", "istočnoaustralsko ljetno vrijeme"},
+		{"zapadnoaustralsko vrijeme", "zapadnoaustralsko standardno vrijeme", "zapadnoaustralsko ljetno vrijeme"},
+		{"bangladeško vrijeme", "bangladeško standardno vrijeme", "bangladeško ljetno vrijeme"},
+		{"brazilijsko vrijeme", "brazilijsko standardno vrijeme", "brazilijsko ljetno vrijeme"},
+		{"čileansko vrijeme", "čileansko standardno vrijeme", "čileansko ljetno vrijeme"},
+		{"kinesko vrijeme", "kinesko standardno vrijeme", "kinesko ljetno vrijeme"},
+		{"kolumbijsko vrijeme", "kolumbijsko standardno vrijeme", "kolumbijsko ljetno vrijeme"},
+		{"srednjoeuropsko vrijeme", "srednjoeuropsko standardno vrijeme", "srednjoeuropsko ljetno vrijeme"},
+		{"istočnoeuropsko vrijeme", "istočnoeuropsko standardno vrijeme", "istočnoeuropsko ljetno vrijeme"},
+		{"zapadnoeuropsko vrijeme", "zapadnoeuropsko standardno vrijeme", "zapadnoeuropsko ljetno vrijeme"},
+		{"", "univerzalno vrijeme", ""},
+		{"", "zaljevsko standardno vrijeme", ""},
+		{"havajsko-aleutsko vrijeme", "havajsko-aleutsko standardno vrijeme", "havajsko-aleutsko ljetno vrijeme"},
+		{"hongkonško vrijeme", "hongkonško standardno vrijeme", "hongkonško ljetno vrijeme"},
+		{"", "indijsko vrijeme", ""},
+		{"", "indokinesko vrijeme", ""},
+		{"", "zapadnoindonezijsko vrijeme", ""},
+		{"iransko vrijeme", "iransko standardno vrijeme", "iransko ljetno vrijeme"},
+		{"izraelsko vrijeme", "izraelsko standardno vrijeme", "izraelsko ljetno vrijeme"},
+		{"japansko vrijeme", "japansko standardno vrijeme", "japansko ljetno vrijeme"},
+		{"korejsko vrijeme", "korejsko standardno vrijeme", "korejsko ljetno vrijeme"},
+		{"", "malezijsko vrijeme", ""},
+		{"moskovsko vrijeme", "moskovsko standardno vrijeme", "moskovsko ljetno vrijeme"},
+		{"novozelandsko vrijeme", "novozelandsko standardno vrijeme", "novozelandsko ljetno vrijeme"},
+		{"newfoundlandsko vrijeme", "newfoundlandsko standardno vrijeme", "newfoundlandsko ljetno vrijeme"},
+		{"pakistansko vrijeme", "pakistansko standardno vrijeme", "pakistansko ljetno vrijeme"},
+		{"peruansko vrijeme", "peruansko standardno vrijeme", "peruansko ljetno vrijeme"},
+		{"filipinsko vrijeme", "filipinsko standardno vrijeme", "filipinsko ljetno vrijeme"},
+		{"", "singapursko vrijeme", ""},
+		{"tajpeško vrijeme", "tajpeško standardno vrijeme", "tajpeško ljetno vrijeme"},
+		{"", "venezuelsko vrijeme", ""},
+		{"", "koordinirano svjetsko vrijeme", ""},
+		{"", "", "irsko standardno vrijeme"},
+		{"", "", "britansko ljetno vrijeme"},
+		{"", "", ""},
+	},
+	"hsb": {
+		{"", "centralnoafriski čas", ""},
+		{"", "wuchodoafriski čas", ""},
+		{"", "južnoafriski čas", ""},
+		{"zapadoafriski čas", "zapadoafriski standardny čas", "zapadoafriski lětni čas"},
+		{"alaskaski čas", "alaskaski standardny čas", "alaskaski lětni čas"},
+		{"sewjeroameriski centralny čas", "sewjeroameriski centralny standardny čas", "sewjeroameriski centralny lětni čas"},
+		{"sewjeroameriski wuchodny čas", "sewjeroameriski wuchodny standardny čas", "sewjeroameriski wuchodny lětni čas"},
+		{"sewjeroameriski hórski čas", "sewjeroameriski hórski standardny čas", "sewjeroameriski hórski lětni čas"},
+		{"sewjeroameriski pacifiski čas", "sewjeroameriski pacifiski standardny čas", "sewjeroameriski pacifiski lětni čas"},
+		{"arabski čas", "arabski standardny čas", "arabski lětni čas"},
+		{"argentinski čas", "argentinski standardny čas", "argentinski lětni čas"},
+		{"atlantiski čas", "atlantiski standardny čas", "atlantiski lětni čas"},
+		{"srjedźoawstralski čas", "srjedźoawstralski standardny čas", "srjedźoawstralski lětni čas"},
+		{"wuchodoawstralski čas", "wuchodoawstralski standardny čas", "wuchodoawstralski lětni čas"},
+		{"zapadoawstralski čas", "zapadoawstralski standardny čas", "zapadoawstralski lětni čas"},
+		{"bangladešski čas", "bangladešski standardny čas", "bangladešski lětni čas"},
+		{"Brasiliski čas", "Brasiliski standardny čas", "Brasiliski lětni čas"},
+		{"chilski čas", "chilski standardny čas", "chilski lětni čas"},
+		{"chinski čas", "chinski standardny čas", "chinski lětni čas"},
+		{"kolumbiski čas", "kolumbiski standardny čas", "kolumbiski lětni čas"},
+		{"srjedźoeuropski čas", "srjedźoeuropski standardny čas", "srjedźoeuropski lětni čas"},
+		{"wuchodoeuropski čas", "wuchodoeuropski standardny čas", "wuchodoeuropski lětni čas"},
+		{"zapadoeuropski čas", "zapadoeuropski standardny čas", "zapadoeuropski lětni čas"},
+		{"", "Greenwichski čas", ""},
+		{"", "čas Persiskeho golfa", ""},
+		{"hawaiisko-aleutski čas", "hawaiisko-aleutski standardny čas", "hawaiisko-aleutski lětni čas"},
+		{"Hongkongski čas", "Hongkongski standardny čas", "Hongkongski lětni čas"},
+		{"", "indiski čas", ""},
+		{"", "indochinski čas", ""},
+		{"", "zapadoindoneski čas", ""},
+		{"iranski čas", "iranski standardny čas", "iranski lětni čas"},
+		{"israelski čas", "israelski standardny čas", "israelski lětni čas"},
+		{"japanski čas", "japanski standardny čas", "japanski lětni čas"},
+		{"korejski čas", "korejski standardny čas", "korejski lětni čas"},
+		{"", "malajziski čas", ""},
+		{"Moskowski čas", "Moskowski standardny čas", "Moskowski lětni čas"},
+		{"nowoseelandski čas", "nowoseelandski standardny čas", "nowoseelandski lětni čas"},
+		{"nowofundlandski čas", "nowofundlandski standardny čas", "nowofundlandski lětni čas"},
+		{"pakistanski čas", "pakistanski standardny čas", "pakistanski lětni čas"},
+		{"peruski čas", "peruski standardny čas", "peruski lětni čas"},
+		{"filipinski čas", "filipinski standardny čas", "filipinski lětni čas"},
+		{"", "Singapurski čas", ""},
+		{"Taipehski čas", "Taipehski standardny čas", "Taipehski lětni čas"},
+		{"", "venezuelski čas", ""},
+		{"", "", ""},
+		{"", "", "Irski lětni čas"},
+		{"", "", "Britiski lětni čas"},
+		{"", "", ""},
+	},
+	"hu": {
+		{"", "közép-afrikai téli idő", ""},
+		{"", "kelet-afrikai téli idő", ""},
+		{"", "dél-afrikai téli idő", ""},
+		{"nyugat-afrikai időzóna", "nyugat-afrikai téli idő", "nyugat-afrikai nyári idő"},
+		{"alaszkai idő", "alaszkai zónaidő", "alaszkai nyári idő"},
+		{"középső államokbeli idő", "középső államokbeli zónaidő", "középső államokbeli nyári idő"},
+		{"keleti államokbeli idő", "keleti államokbeli zónaidő", "keleti államokbeli nyári idő"},
+		{"hegyvidéki idő", "hegyvidéki zónaidő", "hegyvidéki nyári idő"},
+		{"csendes-óceáni idő", "csendes-óceáni zónaidő", "csendes-óceáni nyári idő"},
+		{"arab idő", "arab téli idő", "arab nyári idő"},
+		{"argentínai idő", "argentínai téli idő", "argentínai nyári idő"},
+		{"atlanti-óceáni idő", "atlanti-óceáni zónaidő", "atlanti-óceáni nyári idő"},
+		{"közép-ausztráliai idő", "közép-ausztráliai téli idő", "közép-ausztráliai nyári idő"},
+		{"kelet-ausztráliai idő", "kelet-ausztráliai téli idő", "kelet-ausztráliai nyári idő"},
+		{"nyugat-ausztráliai idő", "nyugat-ausztráliai téli idő", "nyugat-ausztráliai nyári idő"},
+		{"bangladesi idő", "bangladesi téli idő", "bangladesi nyári idő"},
+		{"brazíliai idő", "brazíliai téli idő", "brazíliai nyári idő"},
+		{"chilei időzóna", "chilei téli idő", "chilei nyári idő"},
+		{"kínai idő", "kínai téli idő", "kínai nyári idő"},
+		{"kolumbiai idő", "kolumbiai téli idő", "kolumbiai nyári idő"},
+		{"közép-európai időzóna", "közép-európai téli idő", "közép-európai nyári idő"},
+		{"kelet-európai időzóna", "kelet-európai téli idő", "kelet-európai nyári idő"},
+		{"nyugat-európai időzóna", "nyugat-európai téli idő", "nyugat-európai nyári idő"},
+		{"", "greenwichi középidő, téli idő", ""},
+		{"", "öbölbeli téli idő", ""},
+		{"hawaii-aleuti időzóna", "hawaii-aleuti téli idő", "hawaii-aleuti nyári idő"},
+		{"hongkongi időzóna", "hongkongi téli idő", "hongkongi nyári idő"},
+		{"", "indiai téli idő", ""},
+		{"", "indokínai idő", ""},
+		{"", "nyugat-indonéziai téli idő", ""},
+		{"iráni idő", "iráni téli idő", "iráni nyári idő"},
+		{"izraeli idő", "izraeli téli idő", "izraeli nyári idő"},
+		{"japán idő", "japán téli idő", "japán nyári idő"},
+		{"koreai idő", "koreai téli idő", "koreai nyári idő"},
+		{"", "malajziai idő", ""},
+		{"moszkvai idő", "moszkvai téli idő", "moszkvai nyári idő"},
+		{"új-zélandi idő", "új-zélandi téli idő", "új-zélandi nyári idő"},
+		{"új-fundlandi idő", "új-fundlandi zónaidő", "új-fundlandi nyári idő"},
+		{"pakisztáni idő", "pakisztáni téli idő", "pakisztáni nyári idő"},
+		{"perui idő", "perui téli idő", "perui nyári idő"},
+		{"fülöp-szigeteki idő", "fülöp-szigeteki téli idő", "fülöp-szigeteki nyári idő"},
+		{"", "szingapúri téli idő", ""},
+		{"taipei idő", "taipei téli idő", "taipei nyári idő"},
+		{"", "venezuelai idő", ""},
+		{"", "egyezményes koordinált világidő", ""},
+		{"", "", "ír nyári idő"},
+		{"", "", "brit nyári idő"},
+		{"", "", ""},
+	},
+	"hy": {
+		{"", "Կենտրոնական Աֆրիկայի ժամանակ", ""},
+		{"", "Արևելյան Աֆրիկայի ժամանակ", ""},
+		{"", "Հարավային Աֆրիկայի ժամանակ", ""},
+		{"Արևմտյան Աֆրիկայի ժամանակ", "Արևմտյան Աֆրիկայի ստանդարտ ժամանակ", "Արևմտյան Աֆրիկայի ամառային ժամանակ"},
+		{"Ալյասկայի ժամանակ", "Ալյասկայի ստանդարտ ժամանակ", "Ալյասկայի ամառային ժամանակ"},
+		{"Կենտրոնական Ամերիկայի ժամանակ", "Կենտրոնական Ամերիկայի ստանդարտ ժամանակ", "Կենտրոնական Ամերիկայի ամառային ժամանակ"},
+		{"Արևելյան Ամերիկայի ժամանակ", "Արևելյան Ամերիկայի ստանդարտ ժամանակ", "Արևելյան Ամերիկայի ամառային ժամանակ"},
+		{"Լեռնային ժամանակ (ԱՄՆ)", "Լեռնային ստանդարտ ժամանակ (ԱՄՆ)", "Լեռնային ամառային ժամանակ (ԱՄՆ)"},
+		{"Խաղաղօվկիանոսյան ժամանակ", "Խաղաղօվկիանոսյան ստանդարտ ժամանակ", "Խաղաղօվկիանոսյան ամառային ժամանակ"},
+		{"Սաուդյան Արաբիայի ժամանակ", "Սաուդյան Արաբիայի ստանդարտ ժամանակ", "Սաուդյան Արաբիայի ամառային ժամանակ"},
+		{"Արգենտինայի ժամանակ", "Արգենտինայի ստնադարտ ժամանակ", "Արգենտինայի ամառային ժամանակ"},
+		{"Ատլանտյան ժամանակ", "Ատլանտյան ստանդարտ ժամանակ", "Ատլանտյան ամառային ժամանակ"},
+		{"Կենտրոնական Ավստրալիայի ժամանակ", "Կենտրոնական Ավստրալիայի ստանդարտ ժամանակ", "Կենտրոնական Ավստրալիայի ամառային ժամանակ"},
+		{"Արևելյան Ավստրալիայի ժամանակ", "Արևելյան Ավստրալիայի ստանդարտ ժամանակ", "Արևելյան Ավստրալիայի ամառային ժամանակ"},
+		{"Արևմտյան Ավստրալիայի ժամանակ", "Արևմտյան Ավստրալիայի ստանդարտ ժամանակ", "Արևմտյան Ավստրալիայի ամառային ժամանակ"},
+		{"Բանգլադեշի ժամանակ", "Բանգլադեշի ստանդարտ ժամանակ", "Բանգլադեշի ամառային ժամանակ"},
+		{"Բրազիլիայի ժամանակ", "Բրազիլիայի ստանդարտ ժամանակ", "Բրազիլիայի ամառային ժամանակ"},
+		{"Չիլիի ժամանակ", "Չիլիի ստանդարտ ժամանակ", "Չիլիի ամառային ժամանակ"},
+		{"Չինաստանի ժամանակ", "Չինաստանի ստանդարտ ժամանակ", "Չինաստանի ամառային ժամանակ"},
+		{"Կոլումբիայի ժամանակ", "Կոլումբիայի ստանդարտ ժամանակ", "Կոլումբիայի ամառային ժամանակ"},
+		{"Կենտրոնական Եվրոպայի ժամանակ", "Կենտրոնական Եվրոպայի ստանդարտ ժամանակ", "Կենտրոնական Եվրոպայի ամառային ժամանակ"},
+		{"Արևելյան Եվրոպայի ժամանակ", "Արևելյան Եվրոպայի ստանդարտ ժամանակ", "Արևելյան Եվրոպայի ամառային ժամանակ"},
+		{"Արևմտյան Եվրոպայի ժամանակ", "Արևմտյան Եվրոպայի ստանդարտ ժամանակ", "Արևմտյան Եվրոպայի ամառային ժամանակ"},
+		{"", "Գրինվիչի ժամանակ", ""},
+		{"", "Պարսից ծոցի ստանդարտ ժամանակ", ""},
+		{"Հավայան-ալեության ժամանակ", "Հավայան-ալեության ստանդարտ ժամանակ", "Հավայան-ալեության ամառային ժամանակ"},
+		{"Հոնկոնգի ժամանակ", "Հոնկոնգի ստանդարտ ժամանակ", "Հոնկոնգի ամառային ժամանակ"},
+		{"", "Հնդկաստանի ստանդարտ ժամանակ", ""},
+		{"", "Հնդկաչինական ժամանակ", ""},
+		{"", "Արևմտյան Ինդոնեզիայի ժամանակ", ""},
+		{"Իրանի ժամանակ", "Իրանի ստանդարտ ժամանակ", "Իրանի ամառային ժամանակ"},
+		{"Իսրայելի ժամանակ", "Իսրայելի ստանդարտ ժամանակ", "Իսրայելի ամառային ժամանակ"},
+		{"Ճապոնիայի ժամանակ", "Ճապոնիայի ստանդարտ ժամանակ", "Ճապոնիայի ամառային ժամանակ"},
+		{"Կորեայի ժամանակ", "Կորեայի ստանդարտ ժամանակ", "Կորեայի ամառային ժամանակ"},
+		{"", "Մալայզիայի ժամանակ", ""},
+		{"Մոսկվայի ժամանակ", "Մոսկվայի ստանդարտ ժամանակ", "Մոսկվայի ամառային ժամանակ"},
+		{"Նոր Զելանդիայի ժամանակ", "Նոր Զելանդիայի ստանդարտ ժամանակ", "Նոր Զելանդիայի ամառային ժամանակ"},
+		{"Նյուֆաունդլենդի ժամանակ", "Նյուֆաունդլենդի ստանդարտ ժամանակ", "Նյուֆաունդլենդի ամառային ժամանակ"},
+		{"Պակիստանի ժամանակ", "Պակիստանի ստանդարտ ժամանակ", "Պակիստանի ամառային ժամանակ"},
+		{"Պերուի ժամանակ", "Պերուի ստանդարտ ժամանակ", "Պերուի ամառային ժամանակ"},
+		{"Ֆիլիպինների ժամանակ", "Ֆիլիպինների ստանդարտ ժամանակ", "Ֆիլիպինների ամառային ժամանակ"},
+		{"", "Սինգապուրի ժամանակ", ""},
+		{"Թայպեյի ժամանակ", "Թայպեյի ստանդարտ ժամանակ", "Թայպեյի ամառային ժամանակ"},
+		{"", "Վենեսուելայի ժամանակ", ""},
+		{"", "Համաշխարհային կոորդինացված ժամանակ", ""},
+		{"", "", "Իռլանդական ստանդարտ ժամանակ"},
+		{"", "", "Բրիտանական ամառային ժամանակ"},
+		{"", "", ""},
+	},
+	"id": {
+		{"", "Waktu Afrika Tengah", ""},
+		{"", "Waktu Afrika Timur", ""},
+		{"", "Waktu Standar Afrika Selatan", ""},
+		{"Waktu Afrika Barat", "Waktu Standar Afrika Barat", "Waktu Musim Panas Afrika Barat"},
+		{"Waktu Alaska", "Waktu Standar Alaska", "Waktu Musim Panas Alaska"},
+		{"Waktu Tengah", "Waktu Standar Tengah", "Waktu Musim Panas Tengah"},
+		{"Waktu Timur", "Waktu Standar Timur", "Waktu Musim Panas Timur"},
+		{"Waktu Pegunungan", "Waktu Standar Pegunungan", "Waktu Musim Panas Pegunungan"},
+		{"Waktu Pasifik", "Waktu Standar Pasifik", "Waktu Musim Panas Pasifik"},
+		{"Waktu Arab", "Waktu Standar Arab", "Waktu Musim Panas Arab"},
+		{"Waktu Argentina", "Waktu Standar Argentina", "Waktu Musim Panas Argentina"},
+		{"Waktu Atlantik", "Waktu Standar Atlantik", "Waktu Musim Panas Atlantik"},
+		{"Waktu Tengah Australia", "Waktu Standar Tengah Australia", "Waktu Musim Panas Tengah Australia"},
+		{"Waktu Timur Australia", "Waktu Standar Timur Australia", "Waktu Musim Panas Timur Australia"},
+		{"Waktu Barat Australia", "Waktu Standar Barat Australia", "Waktu Musim Panas Barat Australia"},
+		{"Waktu Bangladesh", "Waktu Standar Bangladesh", "Waktu Musim Panas Bangladesh"},
+		{"Waktu Brasil", "Waktu Standar Brasil", "Waktu Musim Panas Brasil"},
+		{"Waktu Cile", "Waktu Standar Cile", "Waktu Musim Panas Cile"},
+		{"Waktu Tiongkok", "Waktu Standar Tiongkok", "Waktu Musim Panas Tiongkok"},
+		{"Waktu Kolombia", "Waktu Standar Kolombia", "Waktu Musim Panas Kolombia"},
+		{"Waktu Eropa Tengah", "Waktu Standar Eropa Tengah", "Waktu Musim Panas Eropa Tengah"},
+		{"Waktu Eropa Timur", "Waktu Standar Eropa Timur", "Waktu Musim Panas Eropa Timur"},
+		{"Waktu Eropa Barat", "Waktu Standar Eropa Barat", "Waktu Musim Panas Eropa Barat"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Waktu Standar Teluk", ""},
+		{"Waktu Hawaii-Aleutian", "Waktu Standar Hawaii-Aleutian", "Waktu Musim Panas Hawaii-Aleutian"},
+		{"Waktu Hong Kong", "Waktu Standar Hong Kong", "Waktu Musim Panas Hong Kong"},
+		{"", "Waktu India", ""},
+		{"", "Waktu Indochina", ""},
+		{"", "Waktu Indonesia Barat", ""},
+		{"Waktu Iran", "Waktu Standar Iran", "Waktu Musim Panas Iran"},
+		{"Waktu Israel", "Waktu Standar Israel", "Waktu Musim Panas Israel"},
+		{"Waktu Jepang", "Waktu Standar Jepang", "Waktu Musim Panas Jepang"},
+		{"Waktu Korea", "Waktu Standar Korea", "Waktu Musim Panas Korea"},
+		{"", "Waktu Malaysia", ""},
+		{"Waktu Moskwa", "Waktu Standar Moskwa", "Waktu Musim Panas Moskwa"},
+		{"Waktu Selandia Baru", "Waktu Standar Selandia Baru", "Waktu Musim Panas Selandia Baru"},
+		{"Waktu Newfoundland", "Waktu Standar Newfoundland", "Waktu Musim Panas Newfoundland"},
+		{"Waktu Pakistan", "Waktu Standar Pakistan", "Waktu Musim Panas Pakistan"},
+		{"Waktu Peru", "Waktu Standar Peru", "Waktu Musim Panas Peru"},
+		{"Waktu Filipina", "Waktu Standar Filipina", "Waktu Musim Panas Filipina"},
+		{"", "Waktu Standar Singapura", ""},
+		{"Waktu Taipei", "Waktu Standar Taipei", "Waktu Musim Panas Taipei"},
+		{"", "Waktu Venezuela", ""},
+		{"", "Waktu Universal Terkoordinasi", ""},
+		{"", "", "Waktu Standar Irlandia"},
+		{"", "", "Waktu Musim Panas Inggris"},
+		{"", "", ""},
+	},
+	"is": {
+		{"", "Mið-Afríkutími", ""},
+		{"", "Austur-Afríkutími", ""},
+		{"", "Suður-Afríkutími", ""},
+		{"Vestur-Afríkutími", "Staðaltími í Vestur-Afríku", "Sumartími í Vestur-Afríku"},
+		{"Tími í Alaska", "Staðaltími í Alaska", "Sumartími í Alaska"},
+		{"Tími í miðhluta Bandaríkjanna og Kanada", "Staðaltími í miðhluta Bandaríkjanna og Kanada", "Sumartími í miðhluta Bandaríkjanna og Kanada"},
+		{"Tími í austurhluta Bandaríkjanna og Kanada", "Staðaltími í austurhluta Bandaríkjanna og Kanada", "Sumartími í austurhluta Bandaríkjanna og Kanada"},
+		{"Tími í Klettafjöllum", "Staðaltími í Klettafjöllum", "Sumartími í Klettafjöllum"},
+		{"Tími á Kyrrahafssvæðinu", "Staðaltími á Kyrrahafssvæðinu", "Sumartími á Kyrrahafssvæðinu"},
+		{"Arabíutími", "Staðaltími í Arabíu", "Sumartími í Arabíu"},
+		{"Argentínutími", "Staðaltími í Argentínu", "Sumartími í Argentínu"},
+		{"Tími á Atlantshafssvæðinu", "Staðaltími á Atlantshafssvæðinu", "Sumartími á Atlantshafssvæðinu"},
+		{"Tími í Mið-Ástralíu", "Staðaltími í Mið-Ástralíu", "Sumartími í Mið-Ástralíu"},
+		{"Tími í Austur-Ástralíu", "Staðaltími í Austur-Ástralíu", "Sumartími í Austur-Ástralíu"},
+		{"Tími í Vestur-Ástralíu", "Staðaltími í Vestur-Ástralíu", "Sumartími í Vestur-Ástralíu"},
+		{"Bangladess-tími", "Staðaltími í Bangladess", "Sumartími í Bangladess"},
+		{"Brasilíutími", "Staðaltími í Brasilíu", "Sumartími í Brasilíu"},
+		{"Síletími", "Staðaltími í Síle", "Sumartími í Síle"},
+		{"Kínatími", "Staðaltími í Kína", "Sumartími í Kína"},
+		{"Kólumbíutími", "Staðaltími í Kólumbíu", "Sumartími í Kólumbíu"},
+		{"Mið-Evróputími", "Staðaltími í Mið-Evrópu", "Sumartími í Mið-Evrópu"},
+		{"Austur-Evróputími", "Staðaltími í Austur-Evrópu", "Sumartími í Austur-Evrópu"},
+		{"Vestur-Evróputími", "Staðaltími í Vestur-Evrópu", "Sumartími í Vestur-Evrópu"},
+		{"", "Greenwich-staðaltími", ""},
+		{"", "Staðaltími við Persaflóa", ""},
+		{"Tími á Havaí og Aleúta", "Staðaltími á Havaí og Aleúta", "Sumartími á Havaí og Aleúta"},
+		{"Hong Kong-tími", "Staðaltími í Hong Kong", "Sumartími í Hong Kong"},
+		{"", "Indlandstími", ""},
+		{"", "Indókínatími", ""},
+		{"", "Vestur-Indónesíutími", ""},
+		{"Íranstími", "Staðaltími í Íran", "Sumartími í Íran"},
+		{"Ísraelstími", "Staðaltími í Ísrael", "Sumartími í Ísrael"},
+		{"Japanstími", "Staðaltími í Japan", "Sumartími í Japan"},
+		{"Kóreutími", "Staðaltími í Kóreu", "Sumartími í Kóreu"},
+		{"", "Malasíutími", ""},
+		{"Moskvutími", "Staðaltími í Moskvu", "Sumartími í Moskvu"},
+		{"Tími á Nýja-Sjálandi", "Staðaltími á Nýja-Sjálandi", "Sumartími á Nýja-Sjálandi"},
+		{"Tími á Nýfundnalandi", "Staðaltími á Nýfundnalandi", "Sumartími á Nýfundnalandi"},
+		{"Pakistantími", "Staðaltími í Pakistan", "Sumartími í Pakistan"},
+		{"Perútími", "Staðaltími í Perú", "Sumartími í Perú"},
+		{"Filippseyjatími", "Staðaltími á Filippseyjum", "Sumartími á Filippseyjum"},
+		{"", "Singapúrtími", ""},
+		{"Taipei-tími", "Staðaltími í Taipei", "Sumartími í Taipei"},
+		{"", "Venesúelatími", ""},
+		{"", "Samræmdur alþjóðlegur tími", ""},
+		{"", "", "Sumartími á Írlandi"},
+		{"", "", "Sumartími í Bretlandi"},
+		{"", "", ""},
+	},
+	"it": {
+		{"", "Ora dell’Africa centrale", ""},
+		{"", "Ora dell’Africa orientale", ""},
+		{"", "Ora dell’Africa meridionale", ""},
+		{"Ora dell’Africa occidentale", "Ora standard dell’Africa occidentale", "Ora legale dell’Africa occidentale"},
+		{"Ora dell’Alaska", "Ora standard dell’Alaska", "Ora legale dell’Alaska"},
+		{"Ora centrale USA", "Ora standard centrale USA", "Ora legale centrale USA"},
+		{"Ora orientale USA", "Ora standard orientale USA", "Ora legale orientale USA"},
+		{"Ora Montagne Rocciose USA", "Ora standard Montagne Rocciose USA", "Ora legale Montagne Rocciose USA"},
+		{"Ora del Pacifico USA", "Ora standard del Pacifico USA", "Ora legale del Pacifico USA"},
+		{"Ora araba", "Ora standard araba", "Ora legale araba"},
+		{"Ora dell’Argentina", "Ora standard dell’Argentina", "Ora legale dell’Argentina"},
+		{"Ora dell’Atlantico", "Ora standard dell’Atlantico", "Ora legale dell’Atlantico"},
+		{"Ora dell’Australia centrale", "Ora standard dell’Australia centrale", "Ora legale dell’Australia centrale"},
+		{"Ora dell’Australia orientale", "Ora standard dell’Australia orientale", "Ora legale dell’Australia orientale"},
+		{"Ora dell’Australia occidentale", "Ora standard dell’Australia occidentale", "Ora legale dell’Australia occidentale"},
+		{"Ora del Bangladesh", "Ora standard del Bangladesh", "Ora legale del Bangladesh"},
+		{"Ora di Brasilia", "Ora standard di Brasilia", "Ora legale di Brasilia"},
+		{"Ora del Cile", "Ora standard del Cile", "Ora legale del Cile"},
+		{"Ora della Cina", "Ora standard della Cina", "Ora legale della Cina"},
+		{"Ora della Colombia", "Ora standard della Colombia", "Ora legale della Colombia"},
+		{"Ora dell’Europa centrale", "Ora standard dell’Europa centrale", "Ora legale dell’Europa centrale"},
+		{"Ora dell’Europa orientale", "Ora standard dell’Europa orientale", "Ora legale dell’Europa orientale"},
+		{"Ora dell’Europa occidentale", "Ora standard dell’Europa occidentale", "Ora legale dell’Europa occidentale"},
+		{"", "Ora del meridiano di Greenwich", ""},
+		{"", "Ora del Golfo", ""},
+		{"Ora delle isole Hawaii-Aleutine", "Ora standard delle Isole Hawaii-Aleutine", "Ora legale delle Isole Hawaii-Aleutine"},
+		{"Ora di Hong Kong", "Ora standard di Hong Kong", "Ora legale di Hong Kong"},
+		{"", "Ora standard dell’India", ""},
+		{"", "Ora dell’Indocina", ""},
+		{"", "Ora dell’Indonesia occidentale", ""},
+		{"Ora dell’Iran", "Ora standard dell’Iran", "Ora legale dell’Iran"},
+		{"Ora di Israele", "Ora standard di Israele", "Ora legale di Israele"},
+		{"Ora del Giappone", "Ora standard del Giappone", "Ora legale del Giappone"},
+		{"Ora coreana", "Ora standard coreana", "Ora legale coreana"},
+		{"", "Ora della Malesia", ""},
+		{"Ora di Mosca", "Ora standard di Mosca", "Ora legale di Mosca"},
+		{"Ora della Nuova Zelanda", "Ora standard della Nuova Zelanda", "Ora legale della Nuova Zelanda"},
+		{"Ora di Terranova", "Ora standard di Terranova", "Ora legale di Terranova"},
+		{"Ora del Pakistan", "Ora standard del Pakistan", "Ora legale del Pakistan"},
+		{"Ora del Perù", "Ora standard del Perù", "Ora legale del Perù"},
+		{"Ora delle Filippine", "Ora standard delle Filippine", "Ora legale delle Filippine"},
+		{"", "Ora di Singapore", ""},
+		{"Ora di Taipei", "Ora standard di Taipei", "Ora legale di Taipei"},
+		{"", "Ora del Venezuela", ""},
+		{"", "Tempo coordinato universale", ""},
+		{"", "", "Ora legale dell’Irlanda"},
+		{"", "", "Ora legale del Regno Unito"},
+		{"", "", ""},
+	},
+	"ja": {
+		{"", "中央アフリカ時間", ""},
+		{"", "東アフリカ時間", ""},
+		{"", "南アフリカ標準時", ""},
+		{"西アフリカ時間", "西アフリカ標準時", "西アフリカ夏時間"},
+		{"アラスカ時間", "アラスカ標準時", "アラスカ夏時間"},
+		{"アメリカ中部時間", "アメリカ中部標準時", "アメリカ中部夏時間"},
+		{"アメリカ東部時間", "アメリカ東部標準時", "アメリカ東部夏時間"},
+		{"アメリカ山地時間", "アメリカ山地標準時", "アメリカ山地夏時間"},
+		{"アメリカ太平洋時間", "アメリカ太平洋標準時", "アメリカ太平洋夏時間"},
+		{"アラビア時間", "アラビア標準時", "アラビア夏時間"},
+		{"アルゼンチン時間", "アルゼンチン標準時", "アルゼンチン夏時間"},
+		{"大西洋時間", "大西洋標準時", "大西洋夏時間"},
+		{"オーストラリア中部時間", "オーストラリア中部標準時", "オーストラリア中部夏時間"},
+		{"オーストラリア東部時間", "オーストラリア東部標準時", "オーストラリア東部夏時間"},
+		{"オーストラリア西部時間", "オーストラリア西部標準時", "オーストラリア西部夏時間"},
+		{"バングラデシュ時間", "バングラデシュ標準時", "バングラデシュ夏時間"},
+		{"ブラジリア時間", "ブラジリア標準時", "ブラジリア夏時間"},
+		{"チリ時間", "チリ標準時", "チリ夏時間"},
+		{"中国時間", "中国標準時", "中国夏時間"},
+		{"コロンビア時間", "コロンビア標準時", "コロンビア夏時間"},
+		{"中央ヨーロッパ時間", "中央ヨーロッパ標準時", "中央ヨーロッパ夏時間"},
+		{"東ヨーロッパ時間", "東ヨーロッパ標準時", "東ヨーロッパ夏時間"},
+		{"西ヨーロッパ時間", "西ヨーロッパ標準時", "西ヨーロッパ夏時間"},
+		{"", "グリニッジ標準時", ""},
+		{"", "湾岸標準時", ""},
+		{"ハワイ・アリューシャン時間", "ハワイ・アリューシャン標準時", "ハワイ・アリューシャン夏時間"},
+		{"香港時間", "香港標準時", "香港夏時間"},
+		{"", "インド標準時", ""},
+		{"", "インドシナ時間", ""},
+		{"", "インドネシア西部時間", ""},
+		{"イラン時間", "イラン標準時", "イラン夏時間"},
+		{"イスラエル時間", "イスラエル標準時", "イスラエル夏時間"},
+		{"日本時間", "日本標準時", "日本夏時間"},
+		{"韓国時間", "韓国標準時", "韓国夏時間"},
+		{"", "マレーシア時間", ""},
+		{"モスクワ時間", "モスクワ標準時", "モスクワ夏時間"},
+		{"ニュージーランド時間", "ニュージーランド標準時", "ニュージーランド夏時間"},
+		{"ニューファンドランド時間", "ニューファンドランド標準時", "ニューファンドランド夏時間"},
+		{"パキスタン時間", "パキスタン標準時", "パキスタン夏時間"},
+		{"ペルー時間", "ペルー標準時", "ペルー夏時間"},
+		{"フィリピン時間", "フィリピン標準時", "フィリピン夏時間"},
+		{"", "シンガポール標準時", ""},
+		{"台北時間", "台北標準時", "台北夏時間"},
+		{"", "ベネズエラ時間", ""},
+		{"", "協定世界時", ""},
+		{"", "", "アイルランド標準時"},
+		{"", "", "英国夏時間"},
+		{"", "", ""},
+	},
+	"ka": {
+		{"", "ცენტრალური აფრიკის დრო", ""},
+		{"", "აღმოსავლეთ აფრიკის დრო", ""},
+		{"", "სამხრეთ აფრიკის დრო", ""},
+		{"დასავლეთ აფრიკის დრო", "დასავლეთ აფრიკის სტანდარტული დრო", "დასავლეთ აფრიკის ზაფხულის დრო"},
+		{"ალასკის დრო", "ალასკის სტანდარტული დრო", "ალასკის ზაფხულის დრო"},
+		{"ჩრდილოეთ ამერიკის ცენტრალური დრო", "ჩრდილოეთ ამერიკის ცენტრალური სტანდარტული დრო", "ჩრდილოეთ ამერიკის ცენტრალური ზაფხულის დრო"},
+		{"ჩრდილოეთ ამერიკის აღმოსავლეთის დრო", "ჩრდილოეთ ამერიკის აღმოსავლეთის სტანდარტული დრო", "ჩრდილოეთ ამერიკის აღმოსავლეთის ზაფხულის დრო"},
+		{"ჩრდილოეთ ამერიკის მაუნთინის დრო", "ჩრდილოეთ ამერიკის მაუნთინის სტანდარტული დრო", "ჩრდილოეთ ამერიკის მაუნთინის ზაფხულის დრო"},
+		{"ჩრდილოეთ ამერიკის წყნარი ოკეანის დრო", "ჩრდილოეთ ამერიკის წყნარი ოკეანის სტანდარტული დრო", "ჩრდილოეთ ამერიკის წყნარი ოკეანის ზაფხულის დრო"},
+		{"არაბეთის დრო", "არაბეთის სტანდარტული დრო", "არაბეთის ზაფხულის დრო"},
+		{"არგენტინის დრო", "არგენტინის სტანდარტული დრო", "არგენტინის ზაფხულის დრო"},
+		{"ატლანტიკის ოკეანის დრო", "ატლანტიკის ოკეანის სტანდარტული დრო", "ატლანტიკის ოკეანის ზაფხულის დრო"},
+		{"ცენტრალური ავსტრალიის დრო", "ავსტრალიის ცენტრალური სტანდარტული დრო", "ავსტრალიის ცენტრალური ზაფხულის დრო"},
+		{"აღმოსავლეთ ავსტრალიის დრო", "აღმოსავლეთ ავსტრალიის სტანდარტული დრო", "აღმოსავლეთ ავსტრალიის ზაფხულის დრო"},
+		{"დასავლეთ ავსტრალიის დრო", "დასავლეთ ავსტრალიის სტანდარტული დრო", "დასავლეთ ავსტრალიის ზაფხულის დრო"},
+		{"ბანგლადეშის დრო", "ბანგლადეშის სტანდარტული დრო", "ბანგლადეშის ზაფხულის დრო"},
+		{"ბრაზილიის დრო", "ბრაზილიის სტანდარტული დრო", "ბრაზილიის ზაფხულის დრო"},
+		{"ჩილეს დრო", "ჩილეს სტანდარტული დრო", "ჩილეს ზაფხულის დრო"},
+		{"ჩინეთის დრო", "ჩინეთის სტანდარტული დრო", "ჩინეთის დროის სარტყელი"},
+		{"კოლუმბიის დრო", "კოლუმბიის სტანდარტული დრო", "კოლუმბიის ზაფხულის დრო"},
+		{"ცენტრალური ევროპის დრო", "ცენტრალური ევროპის სტანდარტული დრო", "ცენტრალური ევროპის ზაფხულის დრო"},
+		{"აღმოსავლეთ ევროპის დრო", "აღმოსავლეთ ევროპის სტანდარტული დრო", "აღმოსავლეთ ევროპის ზაფხულის დრო"},
+		{"დასავლეთ ევროპის დრო", "დასავლეთ ევროპის სტანდარტული დრო", "დასავლეთ ევროპის ზაფხულის დრო"},
+		{"", "გრინვიჩის საშუალო დრო", ""},
+		{"", "სპარსეთის ყურის სტანდარტული დრო", ""},
+		{"ჰავაისა და ალეუტის დრო", "ჰავაისა და ალეუტის სტანდარტული დრო", "ჰავაისა და ალეუტის ზაფხულის დრო"},
+		{"ჰონკონგის დრო", "ჰონკონგის სტანდარტული დრო", "ჰონკონგის ზაფხულის დრო"},
+		{"", "ინდოეთის დრო", ""},
+		{"", "ინდოჩინეთის დრო", ""},
+		{"", "დასავლეთ ინდონეზიის დრო", ""},
+		{"ირანის დრო", "ირანის სტანდარტული დრო", "ირანის დროის სარტყელი"},
+		{"ისრაელის დრო", "ისრაელის სტანდარტული დრო", "ისრაელის ზაფხულის დრო"},
+		{"იაპონიის დრო", "იაპონიის სტანდარტული დრო", "იაპონიის ზაფხულის დრო"},
+		{"კორეის დრო", "კორეის სტანდარტული დრო", "კორეის ზაფხულის დრო"},
+		{"", "მალაიზიის დრო", ""},
+		{"მოსკოვის დრო", "მოსკოვის სტანდარტული დრო", "მოსკოვის ზაფხულის დრო"},
+		{"ახალი ზელანდიის დრო", "ახალი ზელანდიის სტანდარტული დრო", "ახალი ზელანდიის ზაფხულის დრო"},
+		{"ნიუფაუნდლენდის დრო", "ნიუფაუნდლენდის სტანდარტული დრო", "ნიუფაუნდლენდის ზაფხულის დრო"},
+		{"პაკისტანის დრო", "პაკისტანის სტანდარტული დრო", "პაკისტანის ზაფხულის დრო"},
+		{"პერუს დრო", "პერუს სტანდარტული დრო", "პერუს ზაფხულის დრო"},
+		{"ფილიპინების დრო", "ფილიპინების სტანდარტული დრო", "ფილიპინების ზაფხულის დრო"},
+		{"", "სინგაპურის დრო", ""},
+		{"ტაიბეის დრო", "ტაიბეის სტანდარტული დრო", "ტაიბეის ზაფხულის დრო"},
+		{"", "ვენესუელის დრო", ""},
+		{"", "მსოფლიო კოორდინირებული დრო", ""},
+		{"", "", "ირლანდიის სტანდარტული დრო"},
+		{"", "", "ბრიტანეთის ზაფხულის დრო"},
+		{"", "", ""},
+	},
+	"kk": {
+		{"", "Орталық Африка уақыты", ""},
+		{"", "Шығыс Африка уақыты", ""},
+		{"", "Оңтүстік Африка уақыты", ""},
+		{"Батыс Африка уақыты", "Батыс Африка стандартты уақыты", "Батыс Африка жазғы уақыты"},
+		{"Аляска уақыты", "Аляска стандартты уақыты", "Аляска жазғы уақыты"},
+		{"Солтүстік Америка орталық уақыты", "Солтүстік Америка стандартты орталық уақыты", "Солтүстік Америка жазғы орталық уақыты"},
+		{"Солтүстік Америка шығыс уақыты", "Солтүстік Америка стандартты шығыс уақыты", "Солтүстік Америка жазғы шығыс уақыты"},
+		{"Солтүстік Америка тау уақыты", "Солтүстік Америка стандартты тау уақыты", "Солтүстік Америка жазғы тау уақыты"},
+		{"Солтүстік Америка Тынық мұхиты уақыты", "Солтүстік Америка стандартты Тынық мұхиты уақыты", "Солтүстік Америка жазғы Тынық мұхиты уақыты"},
+		{"Сауд Арабиясы уақыты", "Сауд Арабиясы стандартты уақыты", "Сауд Арабиясы жазғы уақыты"},
+		{"Аргентина уақыты", "Аргентина стандартты уақыты", "Аргентина жазғы уақыты"},
+		{"Атлантика уақыты", "Атлантика стандартты уақыты", "Атлантика жазғы уақыты"},
+		{"Австралия орталық уақыты", "Австралия стандартты орталық уақыты", "Австралия жазғы орталық уақыты"},
+		{"Австралия шығыс уақыты", "Австралия стандартты шығыс уақыты", "Австралия жазғы шығыс уақыты"},
+		{"Австралия батыс уақыты", "Австралия стандартты батыс уақыты", "Австралия жазғы батыс уақыты"},
+		{"Бангладеш уақыты", "Бангладеш стандартты уақыты", "Бангладеш жазғы уақыты"},
+		{"Бразилия уақыты", "Бразилия стандартты уақыты", "Бразилия жазғы уақыты"},
+		{"Чили уақыты", "Чили стандартты уақыты", "Чили жазғы уақыты"},
+		{"Қытай уақыты", "Қытай стандартты уақыты", "Қытай жазғы уақыты"},
+		{"Колумбия уақыты", "Колумбия стандартты уақыты", "Колумбия жазғы уақыты"},
+		{"Орталық Еуропа уақыты", "Орталық Еуропа стандартты уақыты", "Орталық Еуропа жазғы уақыты"},
+		{"Шығыс Еуропа уақыты", "Шығыс Еуропа стандартты уақыты", "Шығыс Еуропа жазғы уақыты"},
+		{"Батыс Еуропа уақыты", "Батыс Еуропа стандартты уақыты", "Батыс Еуропа жазғы уақыты"},
+		{"", "Гринвич уақыты", ""},
+		{"", "Парсы шығанағы уақыты", ""},
+		{"Гавай және Алеут аралдары уақыты", "Гавай және Алеут аралдары стандартты уақыты", "Гавай және Алеут аралдары жазғы уақыты"},
+		{"Гонконг уақыты", "Гонконг стандартты уақыты", "Гонконг жазғы уақыты"},
+		{"", "Үндістан стандартты уақыты", ""},
+		{"", "Үндіқытай уақыты", ""},
+		{"", "Батыс Индонезия уақыты", ""},
+		{"Иран уақыты", "Иран стандартты уақыты", "Иран жазғы уақыты"},
+		{"Израиль уақыты", "Израиль стандартты уақыты", "Израиль жазғы уақыты"},
+		{"Жапония уақыты", "Жапония стандартты уақыты", "Жапония жазғы уақыты"},
+		{"Корея уақыты", "Корея стандартты уақыты", "Корея жазғы уақыты"},
+		{"", "Малайзия уақыты", ""},
+		{"Мәскеу уақыты", "Мәскеу стандартты уақыты", "Мәскеу жазғы уақыты"},
+		{"Жаңа Зеландия уақыты", "Жаңа Зеландия стандартты уақыты", "Жаңа Зеландия жазғы уақыты"},
+		{"Ньюфаундленд уақыты", "Ньюфаундленд стандартты уақыты", "Ньюфаундленд жазғы уақыты"},
+		{"Пәкістан уақыты", "Пәкістан стандартты уақыты", "Пәкістан жазғы уақыты"},
+		{"Перу уақыты", "Перу стандартты уақыты", "Перу жазғы уақыты"},
+		{"Филиппин аралдары уақыты", "Филиппин аралдары стандартты уақыты", "Филиппин аралдары жазғы уақыты"},
+		{"", "Сингапур стандартты уақыты", ""},
+		{"Тайбэй уақыты", "Тайбэй стандартты уақыты", "Тайбэй жазғы уақыты"},
+		{"", "Венесуэла уақыты", ""},
+		{"", "Дүниежүзілік үйлестірілген уақыт", ""},
+		{"", "", "Ирландия жазғы уақыты"},
+		{"", "", "Ұлыбритания жазғы уақыты"},
+		{"", "", ""},
+	},
+	"km": {
+		{"", "ម៉ោង\u200bនៅ\u200bអាហ្វ្រិក\u200bកណ្ដាល", ""},
+		{"", "ម៉ោង\u200bនៅ\u200bអាហ្វ្រិក\u200bខាង\u200bកើត", ""},
+		{"", "ម៉ោង\u200bនៅ\u200bអាហ្វ្រិក\u200bខាង\u200bត្បូង", ""},
+		{"ម៉ោង\u200bនៅ\u200bអាហ្វ្រិក\u200bខាង\u200bលិច", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអាហ្វ្រិក\u200bខាង\u200bលិច", "ម៉ោងនៅ\u200bអាហ្វ្រិក\u200b\u200b\u200bខាងលិច\u200b\u200bនារដូវ\u200bក្ដៅ\u200b"},
+		{"ម៉ោង\u200bនៅ\u200bអាឡាស្កា", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអាឡាស្កា", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200b\u200bអាឡាស្កា"},
+		{"ម៉ោង\u200b\u200bនៅ\u200bទ្វីបអាមេរិក\u200bខាង\u200bជើងភាគកណ្តាល", "ម៉ោង\u200b\u200bស្តង់ដារនៅ\u200bទ្វីបអាមេរិក\u200bខាង\u200bជើងភាគកណ្តាល", "ម៉ោង\u200b\u200bពេលថ្ងៃនៅ\u200bទ្វីបអាមេរិក\u200bខាង\u200bជើងភាគកណ្តាល"},
+		{"ម៉ោងនៅទ្វីបអាមរិកខាងជើងភាគខាងកើត", "ម៉ោងស្តង់ដារនៅទ្វីបអាមេរិកខាងជើងភាគខាងកើត", "ម៉ោងពេលថ្ងៃនៅទ្វីបអាមេរិកខាងជើងភាគខាងកើត"},
+		{"ម៉ោង\u200bនៅតំបន់ភ្នំនៃទ្វីប\u200bអាមេរិក\u200b\u200b\u200bខាង\u200bជើង", "ម៉ោងស្តង់ដារ\u200bនៅតំបន់ភ្នំនៃទ្វីប\u200bអាមេរិក\u200b\u200b\u200bខាង\u200bជើង", "ម៉ោង\u200bពេលថ្ងៃនៅតំបន់ភ្នំនៃទ្វីប\u200bអាមេរិក\u200b\u200b\u200bខាង\u200bជើង"},
+		{"ម៉ោង\u200b\u200bនៅទ្វីប\u200bអាមរិក\u200bខាង\u200bជើងភាគខាងលិច", "ម៉ោងស្តង់ដារ\u200b\u200b\u200bនៅទ្វីប\u200bអាមរិក\u200bខាង\u200bជើងភាគខាងលិច", "ម៉ោង\u200b\u200bពេលថ្ងៃនៅទ្វីប\u200bអាមរិក\u200bខាង\u200bជើងភាគខាងលិច"},
+		{"ម៉ោង\u200bនៅ\u200bអារ៉ាប់", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអារ៉ាប់", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអារ៉ាប់"},
+		{"ម៉ោង\u200bនៅ\u200bអាហ្សង់ទីន", "ម៉ោងស្តង់ដារ\u200bនៅ\u200bអាហ្សង់ទីន", "ម៉ោង\u200bនៅ\u200bអាហ្សង់ទីននារដូវក្តៅ"},
+		{"ម៉ោង\u200bនៅ\u200bអាត្លង់ទិក", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអាត្លង់ទិក", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអាត្លង់ទិក"},
+		{"ម៉ោង\u200bនៅអូស្ត្រាលី\u200bកណ្ដាល", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអូស្ត្រាលី\u200bកណ្ដាល", "ម៉ោង\u200bពេលថ្ងៃ\u200b\u200b\u200b\u200bនៅ\u200bអូស្ត្រាលី\u200bកណ្ដាល"},
+		{"ម៉ោង\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bកើត", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bកើត", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bកើត"},
+		{"ម៉ោង\u200b\u200b\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bលិច", "ម៉ោង\u200b\u200bស្តង់ដារ\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bលិច", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអូស្ត្រាលី\u200bខាង\u200bលិច"},
+		{"ម៉ោង\u200bនៅ\u200bបង់ក្លាដែស", "ម៉ោង\u200bស្ដង់ដារ\u200bនៅ\u200bបង់ក្លាដែស", "ម៉ោង\u200b\u200bនៅ\u200bបង់ក្លាដែសនារដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bប្រាស៊ីលីយ៉ា", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bប្រាស៊ីលីយ៉ា", "ម៉ោង\u200bនៅ\u200bប្រាស៊ីលីយ៉ានា\u200b\u200bរដូវ\u200bក្ដៅ"},
+		{"ម៉ោងនៅស៊ីលី", "ម៉ោងស្តង់ដារនៅស៊ីលី", "ម៉ោងនៅស៊ីលីនារដូវក្តៅ"},
+		{"ម៉ោង\u200bនៅ\u200bចិន", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bចិន", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bចិន"},
+		{"ម៉ោង\u200bនៅ\u200bកូឡុំប៊ី", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bកូឡុំប៊ី", "ម៉ោង\u200bនៅ\u200bកូឡុំប៊ីនា\u200bរដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200bកណ្ដាល", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអឺរ៉ុប\u200bកណ្ដាល", "ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200bកណ្ដាលនា\u200bរដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200b\u200bខាង\u200bកើត\u200b", "ម៉ោង\u200bស្តង់ដារ\u200b\u200bនៅ\u200bអឺរ៉ុប\u200b\u200bខាង\u200bកើត\u200b", "ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200b\u200bខាង\u200bកើត\u200bនា\u200bរដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200bខាង\u200bលិច", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអឺរ៉ុប\u200bខាង\u200bលិច", "ម៉ោង\u200bនៅ\u200bអឺរ៉ុប\u200bខាង\u200bលិចនារដូវ\u200bក្ដៅ\u200b"},
+		{"", "ម៉ោងនៅគ្រីនវិច", ""},
+		{"", "ម៉ោង\u200bស្តង់ដា\u200bនៅ\u200bកាល់", ""},
+		{"ម៉ោង\u200b\u200bនៅ\u200bហាវៃ-អាល់ដ្យូសិន", "ម៉ោង\u200bស្តង់ដារ\u200b\u200bនៅ\u200bហាវៃ-អាល់ដ្យូសិន", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bហាវៃ-អាល់ដ្យូសិន"},
+		{"ម៉ោង\u200bនៅ\u200bហុងកុង", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bហុងកុង", "ម៉ោងនៅ\u200bហុងកុងនា\u200bរដូវ\u200bក្ដៅ\u200b"},
+		{"", "ម៉ោង\u200bស្តង់ដារនៅ\u200bឥណ្ឌា", ""},
+		{"", "ម៉ោង\u200bនៅ\u200bឥណ្ឌូចិន", ""},
+		{"", "ម៉ោង\u200bនៅ\u200bឥណ្ឌូណេស៊ី\u200b\u200bខាង\u200bលិច", ""},
+		{"ម៉ោង\u200bនៅ\u200bអ៊ីរ៉ង់", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអ៊ីរ៉ង់", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអ៊ីរ៉ង់"},
+		{"ម៉ោង\u200bនៅ\u200bអ៊ីស្រាអែល", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bអ៊ីស្រាអែល", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bអ៊ីស្រាអែល"},
+		{"ម៉ោង\u200bនៅ\u200bជប៉ុន", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bជប៉ុន", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅជប៉ុន"},
+		{"ម៉ោង\u200bនៅ\u200bកូរ៉េ", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bកូរ៉េ", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bកូរ៉េ"},
+		{"", "ម៉ោង\u200bនៅ\u200bម៉ាឡេស៊ី", ""},
+		{"ម៉ោង\u200bនៅ\u200bមូស្គូ", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bមូស្គូ", "ម៉ោង\u200bនៅ\u200bមូស្គូ\u200bនារដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bនូវែលសេឡង់", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bនូវែលសេឡង់", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bនូវែលសេឡង់"},
+		{"ម៉ោង\u200b\u200bនៅញូវហ្វោនឡែន", "ម៉ោង\u200b\u200bស្តង់ដារ\u200b\u200bនៅ\u200bញូវហ្វោនឡែន", "ម៉ោង\u200bពេលថ្ងៃ\u200bនៅ\u200bញូវហ្វោនឡែន"},
+		{"ម៉ោង\u200bនៅ\u200bប៉ាគីស្ថាន", "ម៉ោង\u200bស្ដង់ដារ\u200bនៅ\u200bប៉ាគីស្ថាន", "ម៉ោងនៅ\u200bប៉ាគីស្ថាននា\u200bរដូវ\u200bក្ដៅ\u200b"},
+		{"ម៉ោង\u200bនៅ\u200bប៉េរូ", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bប៉េរូ", "ម៉ោង\u200b\u200bនៅ\u200bប៉េរូនារដូវ\u200bក្ដៅ"},
+		{"ម៉ោង\u200bនៅ\u200bហ្វីលីពីន", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bហ្វីលីពីន", "ម៉ោង\u200b\u200bនៅ\u200bហ្វីលីពីននា\u200bរដូវ\u200bក្ដៅ"},
+		{"", "ម៉ោង\u200bនៅ\u200bសិង្ហបូរី", ""},
+		{"ម៉ោង\u200bនៅ\u200bតៃប៉ិ", "ម៉ោង\u200bស្តង់ដារ\u200bនៅ\u200bតៃប៉ិ", "ម៉ោង\u200bពេល\u200bថ្ងៃ\u200bនៅ\u200bតៃប៉ិ"},
+		{"", "ម៉ោង\u200bនៅ\u200bវ៉េណេស៊ុយអេឡា", ""},
+		{"", "ម៉ោងសកលដែលមានការសម្រួល", ""},
+		{"", "", "ម៉ោង\u200bរដូវ\u200bក្ដៅ\u200bនៅ\u200bអៀរឡង់\u200b"},
+		{"", "", "ម៉ោង\u200bរដូវ\u200bក្ដៅ\u200b\u200bនៅ\u200bចក្រភព\u200bអង់គ្លេស"},
+		{"", "", ""},
+	},
+	"kn": {
+		{"", "ಮಧ್ಯ ಆಫ್ರಿಕಾ ಸಮಯ", ""},
+		{"", "ಪೂರ್ವ ಆಫ್ರಿಕಾ ಸಮಯ", ""},
+		{"", "ದಕ್ಷಿಣ ಆಫ್ರಿಕಾ ಪ್ರಮಾಣಿತ ಸಮಯ", ""},
+		{"ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾ ಸಮಯ", "ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪಶ್ಚಿಮ ಆಫ್ರಿಕಾ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಅಲಾಸ್ಕಾ ಸಮಯ", "ಅಲಸ್ಕಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "\u200cಅಲಾಸ್ಕಾ ಹಗಲು ಸಮಯ"},
+		{"ಉತ್ತರ ಅಮೆರಿಕದ ಕೇಂದ್ರ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಕೇಂದ್ರ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಕೇಂದ್ರೀಯ ದಿನದ ಸಮಯ"},
+		{"ಉತ್ತರ ಅಮೆರಿಕದ ಪೂರ್ವದ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪೂರ್ವದ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪೂರ್ವದ ದಿನದ ಸಮಯ"},
+		{"ಉತ್ತರ ಅಮೆರಿಕದ ಪರ್ವತ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪರ್ವತ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪರ್ವತ ದಿನದ ಸಮಯ"},
+		{"ಉತ್ತರ ಅಮೆರಿಕದ ಪೆಸಿಫಿಕ್ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪೆಸಿಫಿಕ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಉತ್ತರ ಅಮೆರಿಕದ ಪೆಸಿಫಿಕ್ ದಿನದ ಸಮಯ"},
+		{"ಅರೇಬಿಯನ್ ಸಮಯ", "ಅರೇಬಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಅರೇಬಿಯನ್ ಹಗಲು ಸಮಯ"},
+		{"ಅರ್ಜೆಂಟಿನಾ ಸಮಯ", "ಅರ್ಜೆಂಟೀನಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಅರ್ಜೆಂಟಿನಾ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಅಟ್ಲಾಂಟಿಕ್ ಸಮಯ", "ಅಟ್ಲಾಂಟಿಕ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಅಟ್ಲಾಂಟಿಕ್ ದಿನದ ಸಮಯ"},
+		{"ಕೇಂದ್ರ ಆಸ್ಟ್ರೇಲಿಯಾ ಸಮಯ", "ಆಸ್ಟ್ರೇಲಿಯಾದ ಕೇಂದ್ರ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಆಸ್ಟ್ರೇಲಿಯಾದ ಕೇಂದ್ರ ಹಗಲು ಸಮಯ"},
+		{"ಪೂರ್ವ ಆಸ್ಟ್ರೇಲಿಯಾ ಸಮಯ", "ಆಸ್ಟ್ರೇಲಿಯಾದ ಪೂರ್ವ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪೂರ್ವ ಆಸ್ಟ್ರೇಲಿಯಾದ ಹಗಲು ಸಮಯ"},
+		{"ಪಶ್ಚಿಮ ಆಸ್ಟ್ರೇಲಿಯಾ ಸಮಯ", "ಆಸ್ಟ್ರೇಲಿಯಾದ ಪಶ್ಚಿಮ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಆಸ್ಟ್ರೇಲಿಯಾದ ಪಶ್ಚಿಮ ಹಗಲು ಸಮಯ"},
+		{"ಬಾಂಗ್ಲಾದೇಶ ಸಮಯ", "ಬಾಂಗ್ಲಾದೇಶ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಬಾಂಗ್ಲಾದೇಶ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಬ್ರೆಸಿಲಿಯಾದ ಸಮಯ", "ಬ್ರೆಸಿಲಿಯಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಬ್ರೆಸಿಲಿಯಾ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಚಿಲಿ ಸಮಯ", "ಚಿಲಿ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಚಿಲಿ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಚೀನಾ ಸಮಯ", "ಚೀನಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಚೀನಾ ಹಗಲು ಸಮಯ"},
+		{"ಕೊಲಂಬಿಯಾ ಸಮಯ", "ಕೊಲಂಬಿಯಾ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಕೊಲಂಬಿಯಾ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಮಧ್ಯ ಯುರೋಪಿಯನ್ ಸಮಯ", "ಮಧ್ಯ ಯುರೋಪಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಮಧ್ಯ ಯುರೋಪಿಯನ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಪೂರ್ವ ಯುರೋಪಿಯನ್ ಸಮಯ", "ಪೂರ್ವ ಯುರೋಪಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪೂರ್ವ ಯುರೋಪಿಯನ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಪಶ್ಚಿಮ ಯುರೋಪಿಯನ್ ಸಮಯ", "ಪಶ್ಚಿಮ ಯುರೋಪಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪಶ್ಚಿಮ ಯುರೋಪಿಯನ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"", "ಗ್ರೀನ್\u200cವಿಚ್ ಸರಾಸರಿ ಕಾಲಮಾನ", ""},
+		{"", "ಗಲ್ಫ್ ಪ್ರಮಾಣಿತ ಸಮಯ", ""},
+		{"ಹವಾಯಿ-ಅಲ್ಯುಟಿಯನ್ ಸಮಯ", "ಹವಾಯಿ-ಅಲ್ಯುಟಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಹವಾಯಿ-ಅಲ್ಯುಟಿಯನ್ ಹಗಲು ಸಮಯ"},
+		{"ಹಾಂಗ್ ಕಾಂಗ್ ಸಮಯ", "ಹಾಂಗ್ ಕಾಂಗ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಹಾಂಗ್ ಕಾಂಗ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"", "ಭಾರತೀಯ ಪ್ರಮಾಣಿತ ಸಮಯ", ""},
+		{"", "ಇಂಡೊಚೈನಾ ಸಮಯ", ""},
+		{"", "ಪಶ್ಚಿಮ ಇಂಡೋನೇಷಿಯ ಸಮಯ", ""},
+		{"ಇರಾನ್ ಸಮಯ", "ಇರಾನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಇರಾನ್ ಹಗಲು ಸಮಯ"},
+		{"ಇಸ್ರೇಲ್ ಸಮಯ", "ಇಸ್ರೇಲ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಇಸ್ರೇಲ್ ಹಗಲು ಸಮಯ"},
+		{"ಜಪಾನ್ ಸಮಯ", "ಜಪಾನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಜಪಾನ್ ಹಗಲು ಸಮಯ"},
+		{"ಕೊರಿಯನ್ ಸಮಯ", "ಕೊರಿಯನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಕೊರಿಯನ್ ಹಗಲು ಸಮಯ"},
+		{"", "ಮಲೇಷಿಯಾ ಸಮಯ", ""},
+		{"ಮಾಸ್ಕೋ ಸಮಯ", "ಮಾಸ್ಕೋ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಮಾಸ್ಕೋ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಸಮಯ", "ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ನ್ಯೂಜಿಲ್ಯಾಂಡ್ ಹಗಲು ಸಮಯ"},
+		{"ನ್ಯೂಫೌಂಡ್\u200cಲ್ಯಾಂಡ್ ಸಮಯ", "ನ್ಯೂಫೌಂಡ್\u200cಲ್ಯಾಂಡ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ನ್ಯೂಫೌಂಡ್\u200cಲ್ಯಾಂಡ್ ದಿನದ ಸಮಯ"},
+		{"ಪಾಕಿಸ್ತಾನ ಸಮಯ", "ಪಾಕಿಸ್ತಾನ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪಾಕಿಸ್ತಾನ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಪೆರು ಸಮಯ", "ಪೆರು ಪ್ರಮಾಣಿತ ಸಮಯ", "ಪೆರು ಬೇಸಿಗೆ ಸಮಯ"},
+		{"ಫಿಲಿಫೈನ್ ಸಮಯ", "ಫಿಲಿಫೈನ್ ಪ್ರಮಾಣಿತ ಸಮಯ", "ಫಿಲಿಫೈನ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"", "ಸಿಂಗಾಪುರ್ ಪ್ರಮಾಣಿತ ಸಮಯ", ""},
+		{"ತೈಪೆ ಸಮಯ", "ತೈಪೆ ಪ್ರಮಾಣಿತ ಸಮಯ", "ತೈಪೆ ಹಗಲು ಸಮಯ"},
+		{"", "ವೆನಿಜುವೆಲಾ ಸಮಯ", ""},
+		{"", "ಸಂಘಟಿತ ಸಾರ್ವತ್ರಿಕ ಸಮಯ", ""},
+		{"", "", "ಐರಿಷ್ ಪ್ರಮಾಣಿತ ಸಮಯ"},
+		{"", "", "ಬ್ರಿಟಿಷ್ ಬೇಸಿಗೆ ಸಮಯ"},
+		{"", "", ""},
+	},
+	"ko": {
+		{"", "중앙아프리카 시간", ""},
+		{"", "동아프리카 시간", ""},
+		{"", "남아프리카 시간", ""},
+		{"서아프리카 시간", "서아프리카 표준시", "서아프리카 하계 표준시"},
+		{"알래스카 시간", "알래스카 표준시", "알래스카 하계 표준시"},
+		{"미 중부 시간", "미 중부 표준시", "미 중부 하계 표준시"},
+		{"미 동부 시간", "미 동부 표준시", "미 동부 하계 표준시"},
+		{"미 산지 시간", "미 산악 표준시", "미 산지 하계 표준시"},
+		{"미 태평양 시간", "미 태평양 표준시", "미 태평양 하계 표준시"},
+		{"아라비아 시간", "아라비아 표준시", "아라비아 하계 표준시"},
+		{"아르헨티나 시간", "아르헨티나 표준시", "아르헨티나 하계 표준시"},
+		{"대서양 시간", "대서양 표준시", "미 대서양 하계 표준시"},
+		{"오스트레일리아 중부 시간", "오스트레일리아 중부 표준시", "오스트레일리아 중부 하계 표준시"},
+		{"오스트레일리아 동부 시간", "오스트레일리아 동부 표준시", "오스트레일리아 동부 하계 표준시"},
+		{"오스트레일리아 서부 시간", "오스트레일리아 서부 표준시", "오스트레일리아 서부 하계 표준시"},
+		{"방글라데시 시간", "방글라데시 표준시", "방글라데시 하계 표준시"},
+		{"브라질리아 시간", "브라질리아 표준시", "브라질리아 하계 표준시"},
+		{"칠레 시간", "칠레 표준시", "칠레 하계 표준시"},
+		{"중국 시간", "중국 표준시", "중국 하계 표준시"},
+		{"콜롬비아 시간", "콜롬비아 표준시", "콜롬비아 하계 표준시"},
+		{"중부 유럽 시간", "중부 유럽 표준시", "중부 유럽 하계 표준시"},
+		{"동유럽 시간", "동유럽 표준시", "동유럽 하계 표준시"},
+		{"서유럽 시간", "서유럽 표준시", "서유럽 하계 표준시"},
+		{"", "그리니치 표준시", ""},
+		{"", "걸프만 표준시", ""},
+		{"하와이 알류샨 시간", "하와이 알류샨 표준시", "하와이 알류샨 하계 표준시"},
+		{"홍콩 시간", "홍콩 표준시", "홍콩 하계 표준시"},
+		{"", "인도 표준시", ""},
+		{"", "인도차이나 시간", ""},
+		{"", "서부 인도네시아 시간", ""},
+		{"이란 시간", "이란 표준시", "이란 하계 표준시"},
+		{"이스라엘 시간", "이스라엘 표준시", "이스라엘 하계 표준시"},
+		{"일본 시간", "일본 표준시", "일본 하계 표준시"},
+		{"대한민국 시간", "대한민국 표준시", "대한민국 하계 표준시"},
+		{"", "말레이시아 시간", ""},
+		{"모스크바 시간", "모스크바 표준시", "모스크바 하계 표준시"},
+		{"뉴질랜드 시간", "뉴질랜드 표준시", "뉴질랜드 하계 표준시"},
+		{"뉴펀들랜드 시간", "뉴펀들랜드 표준시", "뉴펀들랜드 하계 표준시"},
+		{"파키스탄 시간", "파키스탄 표준시", "파키스탄 하계 표준시"},
+		{"페루 시간", "페루 표준시", "페루 하계 표준시"},
+		{"필리핀 시간", "필리핀 표준시", "필리핀 하계 표준시"},
+		{"", "싱가포르 표준시", ""},
+		{"대만 시간", "대만 표준시", "대만 하계 표준시"},
+		{"", "베네수엘라 시간", ""},
+		{"", "협정 세계시", ""},
+		{"", "", "아일랜드 표준시"},
+		{"", "", "영국 하계 표준시"},
+		{"", "", ""},
+	},
+	"kok": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "भारतीय समय", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "समन्वित वैश्विक वेळ", ""},
+		{"", "", "आयरिश प्रमाणित वेळ"},
+		{"", "", "ब्रिटिश ग्रीष्म वेळ"},
+		{"", "", ""},
+	},
+	"ks": {
+		{"", "مرکزی افریٖقا ٹایِم", ""},
+		{"", "مشرقی افریٖقا ٹایِم", ""},
+		{"", "جنوٗبی افریقا ٹایِم", ""},
+		{"مغربی افریٖقا ٹایِم", "مغربی افریٖقا سٹینڑاڑ ٹایِم", "مغربی افریٖقا سَمَر ٹایِم"},
+		{"اٮ۪لاسکا ٹایِم", "اٮ۪لاسکا سٹینڑاڑ ٹایِم", "اٮ۪لاسکا ڈےلایِٔٹ ٹایِم"},
+		{"مرکزی ٹایِم", "مرکزی سٹینڑاڑ ٹایِم", "مرکزی ڈےلایِٔٹ ٹایِم"},
+		{"مشرقی ٹایِم", "مشرقی سٹینڑاڑ ٹایِم", "مشرقی ڈےلایِٔٹ ٹایِم"},
+		{"ماونٹین ٹایِم", "ماونٹین سٹینڑاڑ ٹایِم", "ماونٹین ڈےلایِٔٹ ٹایِم"},
+		{"پیسِفِک ٹایِم", "پیسِفِک سٹینڑاڑ ٹایِم", "پیسِفِک ڈےلایِٔٹ ٹایِم"},
+		{"ارٮ۪بِیَن ٹایِم", "ارٮ۪بِیَن سٹینڑاڑ ٹایِم", "ارٮ۪بِیَن ڈےلایِٔٹ ٹایِم"},
+		{"ارجٮ۪نٹیٖنا ٹایِم", "ارجٮ۪نٹیٖنا سٹینڑاڑ ٹایِم", "ارجٮ۪نٹیٖنا سَمَر ٹایِم"},
+		{"اٮ۪ٹلانٹِک ٹایِم", "اٮ۪ٹلانٹِک سٹینڑاڑ ٹایِم", "اٮ۪ٹلانٹِک ڈےلایِٔٹ ٹایِم"},
+		{"مرکزی آسٹریلِیَن ٹایِم", "آسٹریلِیَن مرکزی سٹینڑاڑ ٹایِم", "آسٹریلِیَن مرکزی ڈےلایِٔٹ ٹایِم"},
+		{"مشرِقی آسٹریلِیا ٹایِم", "آسٹریلِیَن مشرقی سٹینڑاڑ ٹایِم", "آسٹریلِیَن مشرقی ڈےلایِٔٹ ٹایِم"},
+		{"مغرِبی آسٹریلِیا ٹایِم", "آسٹریلِیَن مغرِبی سٹینڑاڑ ٹایِم", "آسٹریلِیَن مغرِبیٖ ڈےلایٔٹ ٹایِم"},
+		{"بَنٛگلادیش ٹایِم", "بَنٛگلادیش سٹینڑاڑ ٹایِم", "بَنٛگلادیش سَمَر ٹایِم"},
+		{"برٮ۪سِلِیا ٹایِم", "برٮ۪سِلِیا سٹینڑاڑ ٹایِم", "برٮ۪سِلِیا سَمَر ٹایِم"},
+		{"چِلی ٹایِم", "چِلی سٹینڑاڑ ٹایِم", "چِلی سَمَر ٹایِم"},
+		{"چَینا ٹایِم", "چَینا سٹینڑاڑ ٹایِم", "چَینا ڈےلایِٔٹ ٹایِم"},
+		{"کولومبِیا ٹایِم", "کولومبِیا سٹینڑاڑ ٹایِم", "کولومبِیا سَمَر ٹایِم"},
+		{"مرکزی یوٗرپی ٹایِم", "مرکزی یوٗرپی سٹینڑاڑ ٹایِم", "مرکزی یوٗرپی سَمَر ٹایِم"},
+		{"مشرقی یوٗرپی ٹایِم", "مشرقی یوٗرپی سٹینڑاڑ ٹایِم", "مشرقی یوٗرپی سَمَر ٹایِم"},
+		{"مغرِبی یوٗرپی ٹایِم", "مغرِبی یوٗرپی سٹینڑاڑ ٹایِم", "مغرِبی یوٗرِپی سَمَر ٹایِم"},
+		{"", "گرٛیٖن وِچ میٖن ٹایِم", ""},
+		{"", "گَلف سٹینڑاڑ ٹایِم", ""},
+		{"حَواے اٮ۪لیوٗٹِیَن ٹایِم", "حَواے اٮ۪لیوٗٹِیَن سٹینڑاڑ ٹایِم", "حَواے اٮ۪لیوٗٹِیَن سَمَر ٹایِم"},
+		{"حانگ کانٛگ ٹایِم", "حانگ کانٛگ سٹینڑاڑ ٹایِم", "حانٛگ کانٛگ سَمَر ٹایِم"},
+		{"", "ہِنٛدوستان", ""},
+		{"", "اِنڑوچَینا ٹایِم", ""},
+		{"", "مغرِبی اِنڑونیشِیا ٹایِم", ""},
+		{"اِیٖرٲنۍ ٹایِم", "اِیٖرٲنۍ سٹینڑاڑ ٹایِم", "اِیٖرٲنی سَمَر ٹایِم"},
+		{"اِسرٲیِلی ٹایِم", "اِسرٲیِلی سٹینڑاڑ ٹایِم", "اِسرٲیِلی ڑےلایِٔٹ ٹایِم"},
+		{"جاپٲنۍ ٹایِم", "جاپٲنۍ سٹینڑاڑ ٹایِم", "جاپٲنۍ ڑےلایِٔٹ ٹایِم"},
+		{"کورِیا ٹایِم", "کورِیا سٹینڑاڑ ٹایِم", "کورِیا ڑےلایِٔٹ ٹایِم"},
+		{"", "مَلیشِیا ٹایِم", ""},
+		{"ماسکَو ٹایِم", "ماسکو سٹینڑاڑ ٹایِم", "ماسکو سَمَر ٹایِم"},
+		{"نِوزِلینڑ ٹایِم", "نِوزِلینڑ سٹینڑاڑ ٹایِم", "نِوزِلینڑ ڑےلایٔٹ ٹایِم"},
+		{"نیوٗ فاونڑلینڑ ٹایِم", "نیوٗ فاونڑلینڑ سٹینڑاڑ ٹایِم", "نیوٗ فاونڑ لینڑ ڑےلایِٔٹ ٹایِم"},
+		{"پاکِستان ٹایِم", "پاکِستان سٹینڑاڑ ٹایِم", "پاکِستان سَمَر ٹایِم"},
+		{"پٔروٗ ٹایِم", "پٔروٗ سٹینڑاڑ ٹایِم", "پٔروٗ سَمَر ٹایِم"},
+		{"پھِلِپایِن ٹایِم", "پھِلِپایِن سٹینڑاڑ ٹایِم", "پھِلِپایِن سَمَر ٹایِم"},
+		{"", "سِنٛگاپوٗر ٹایِم", ""},
+		{"", "", ""},
+		{"", "وٮ۪نٮ۪زیوٗلا ٹایِم", ""},
+		{"", "", ""},
+		{"", "", "اَیرِش سَمَر ٹایِم"},
+		{"", "", "برطٲنوی سَمَر ٹایِم"},
+		{"", "", ""},
+	},
+	"ky": {
+		{"", "Борбордук Африка убактысы", ""},
+		{"", "Чыгыш Африка убактысы", ""},
+		{"", "Түштүк Африка убактысы", ""},
+		{"Батыш Африка убактысы", "Батыш Африка кышкы убакыты", "Батыш Африка жайкы убактысы"},
+		{"Аляска убактысы", "Аляска кышкы убактысы", "Аляска жайкы убактысы"},
+		{"Түндүк Америка, борбордук убакыт", "Түндүк Америка, борбордук кышкы убактысы", "Түндүк Америка, борбордук жайкы убакыт"},
+		{"Түндүк Америка, чыгыш убактысы", "Түндүк Америка, чыгыш кышкы убактысы", "Түндүк Америка, чыгыш жайкы убактысы"},
+		{"Түндүк Америка, тоо убактысы", "Түндүк Америка, тоо кышкы убактысы", "Түндүк Америка, тоо жайкы убактысы"},
+		{"Түндүк Америка, Тынч океан убактысы", "Түндүк Америка, Тынч океан кышкы убактысы", "Түндүк Америка, Тынч океан жайкы убактысы"},
+		{"Арабия убактысы", "Арабия кышкы убакыты", "Арабия жайкы убакыты"},
+		{"Аргентина убактысы", "Аргентина кышкы убактысы", "Аргентина жайкы убактысы"},
+		{"Атлантика убактысы", "Атлантика кышкы убактысы", "Атлантика жайкы убактысы"},
+		{"Австралия борбордук убактысы", "Австралия борбордук кышкы убакыты", "Австралия борбордук жайкы убактысы"},
+		{"Австралия чыгыш убактысы", "Австралия чыгыш кышкы убакыты", "Австралия чыгыш жайкы убактысы"},
+		{"Австралия батыш убактысы", "Австралия батыш кышкы убакыты", "Австралия батыш жайкы убактысы"},
+		{"Бангладеш убактысы", "Бангладеш кышкы убакыты", "Бангладеш жайкы убактысы"},
+		{"Бразилия убактысы", "Бразилия кышкы убактысы", "Бразилия жайкы убактысы"},
+		{"Чили убактысы", "Чили кышкы убактысы", "Чили жайкы убактысы"},
+		{"Кытай убактысы", "Кытай кышкы убакыты", "Кытай жайкы убакыты"},
+		{"Колумбия убактысы", "Колумбия кышкы убактысы", "Колумбия жайкы убактысы"},
+		{"Борбордук Европа убактысы", "Борбордук Европа кышкы убакыты", "Борбордук Европа жайкы убактысы"},
+		{"Чыгыш Европа убактысы", "Чыгыш Европа кышкы убакыты", "Чыгыш Европа жайкы убактысы"},
+		{"Батыш Европа убактысы", "Батыш Европа кышкы убакыты", "Батыш Европа жайкы убактысы"},
+		{"", "GMT, кышкы убакыты", ""},
+		{"", "Персия булуңунун жайкы убакыты", ""},
+		{"Гавайи-Алеут убактысы", "Гавайи-Алеут кышкы убактысы", "Гавайи-Алеут жайкы убактысы"},
+		{"Гонконг убактысы", "Гонконг кышкы убакыты", "Гонконг жайкы убактысы"},
+		{"", "Индия убактысы", ""},
+		{"", "Индокытай убактысы", ""},
+		{"", "Батыш Индонезия убактысы", ""},
+		{"Иран убактысы", "Иран кышкы убакыты", "Иран күндүзгү убактысы"},
+		{"Израиль убакыты", "Израиль кышкы убакыты", "Израиль жайкы убакыты"},
+		{"Жапон убактысы", "Жапон кышкы убакыты", "Жапон жайкы убактысы"},
+		{"Корея убактысы", "Корея кышкы убакыты", "Корея жайкы убакыты"},
+		{"", "Малайзия убактысы", ""},
+		{"Москва убактысы", "Москва кышкы убакыты", "Москва жайкы убактысы"},
+		{"Жаӊы Зеландия убактысы", "Жаӊы Зеландия кышкы убакыты", "Жаңы Зеландия жайкы убакыты"},
+		{"Нюфаундлэнд убактысы", "Нюфаундлэнд кышкы убактысы", "Нюфаундлэнд жайкы убактысы"},
+		{"Пакистан убактысы", "Пакистан кышкы убакыты", "Пакистан жайкы убактысы"},
+		{"Перу убактысы", "Перу кышкы убактысы", "Перу жайкы убактысы"},
+		{"Филиппин аралдарынын убактысы", "Филиппин аралдарынын кышкы убактысы", "Филиппин аралдарынын жайкы убактысы"},
+		{"", "Сингапур убактысы", ""},
+		{"Тайпей убактысы", "Тайпей кышкы убакыты", "Тайпей жайкы убакыты"},
+		{"", "Венесуэла убактысы", ""},
+		{"", "Бирдиктүү дүйнөлүк убакыт", ""},
+		{"", "", "Ирландия кышкы убакыты"},
+		{"", "", "Британия жайкы убактысы"},
+		{"", "", ""},
+	},
+	"lb": {
+		{"", "Zentralafrikanesch Zäit", ""},
+		{"", "Ostafrikanesch Zäit", ""},
+		{"", "Südafrikanesch Zäit", ""},
+		{"Westafrikanesch Zäit", "Westafrikanesch Normalzäit", "Westafrikanesch Summerzäit"},
+		{"Alaska-Zäit", "Alaska-Normalzäit", "Alaska-Summerzäit"},
+		{"Nordamerikanesch Inlandzäit", "Nordamerikanesch Inland-Normalzäit", "Nordamerikanesch Inland-Summerzäit"},
+		{"Nordamerikanesch Ostküstenzäit", "Nordamerikanesch Ostküsten-Normalzäit", "Nordamerikanesch Ostküsten-Summerzäit"},
+		{"Rocky-Mountain-Zäit", "Rocky-Mountain-Normalzäit", "Rocky-Mountain-Summerzäit"},
+		{"Nordamerikanesch Westküstenzäit", "Nordamerikanesch Westküsten-Normalzäit", "Nordamerikanesch Westküsten-Summerzäit"},
+		{"Arabesch Zäit", "Arabesch Normalzäit", "Arabesch Summerzäit"},
+		{"Argentinesch Zäit", "Argentinesch Normalzäit", "Argentinesch Summerzäit"},
+		{"Atlantik-Zäit", "Atlantik-Normalzäit", "Atlantik-Summerzäit"},
+		{"Zentralaustralesch Zäit", "Zentralaustralesch Normalzäit", "Zentralaustralesch Summerzäit"},
+		{"Ostaustralesch Zäit", "Ostaustralesch Normalzäit", "Ostaustralesch Summerzäit"},
+		{"Westaustralesch Zäit", "Westaustralesch Normalzäit", "Westaustralesch Summerzäit"},
+		{"Bangladesch-Zäit", "Bangladesch-Normalzäit", "Bangladesch-Summerzäit"},
+		{"Brasília-Zäit", "Brasília-Normalzäit", "Brasília-Summerzäit"},
+		{"Chilenesch Zäit", "Chilenesch Normalzäit", "Chilenesch Summerzäit"},
+		{"Chinesesch Zäit", "Chinesesch Normalzäit", "Chinesesch Summerzäit"},
+		{"Kolumbianesch Zäit", "Kolumbianesch Normalzäit", "Kolumbianesch Summerzäit"},
+		{"Mëtteleuropäesch Zäit", "Mëtteleuropäesch Normalzäit", "Mëtteleuropäesch Summerzäit"},
+		{"Osteuropäesch Zäit", "Osteuropäesch Normalzäit", "Osteuropäesch Summerzäit"},
+		{"Westeuropäesch Zäit", "Westeuropäesch Normalzäit", "Westeuropäesch Summerzäit"},
+		{"", "Mëttler Greenwich-Zäit", ""},
+		{"", "Golf-Zäit", ""},
+		{"Hawaii-Aleuten-Zäit", "Hawaii-Aleuten-Normalzäit", "Hawaii-Aleuten-Summerzäit"},
+		{"Hong-Kong-Zäit", "Hong-Kong-Normalzäit", "Hong-Kong-Summerzäit"},
+		{"", "Indesch Zäit", ""},
+		{"", "Indochina-Zäit", ""},
+		{"", "Westindonesesch Zäit", ""},
+		{"Iranesch Zäit", "Iranesch Normalzäit", "Iranesch Summerzäit"},
+		{"Israelesch Zäit", "Israelesch Normalzäit", "Israelesch Summerzäit"},
+		{"Japanesch Zäit", "Japanesch Normalzäit", "Japanesch Summerzäit"},
+		{"Koreanesch Zäit", "Koreanesch Normalzäit", "Koreanesch Summerzäit"},
+		{"", "Malaysesch Zäit", ""},
+		{"Moskauer Zäit", "Moskauer Normalzäit", "Moskauer Summerzäit"},
+		{"Neiséiland-Zäit", "Neiséiland-Normalzäit", "Neiséiland-Summerzäit"},
+		{"Neifundland-Zäit", "Neifundland-Normalzäit", "Neifundland-Summerzäit"},
+		{"Pakistanesch Zäit", "Pakistanesch Normalzäit", "Pakistanesch Summerzäit"},
+		{"Peruanesch Zäit", "Peruanesch Normalzäit", "Peruanesch Summerzäit"},
+		{"Philippinnesch Zäit", "Philippinnesch Normalzäit", "Philippinnesch Summerzäit"},
+		{"", "Singapur-Standardzäit", ""},
+		{"Taipei-Zäit", "Taipei-Normalzäit", "Taipei-Summerzäit"},
+		{"", "Venezuela-Zäit", ""},
+		{"", "", ""},
+		{"", "", "Iresch Summerzäit"},
+		{"", "", "Britesch Summerzäit"},
+		{"", "", ""},
+	},
+	"lo": {
+		{"", "ເວ\u200bລາ\u200bອາ\u200bຟຣິ\u200bກາ\u200bກາງ", ""},
+		{"", "ເວ\u200bລາ\u200bອາ\u200bຟຣິ\u200bກາ\u200bຕາ\u200bເວັນ\u200bອອກ", ""},
+		{"", "ເວ\u200bລາ\u200bອາ\u200bຟຣິ\u200bກາ\u200bໃຕ້", ""},
+		{"ເວ\u200bລາ\u200bອາ\u200bຟຣິ\u200bກາ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bອາ\u200bຟຣິ\u200bກາ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bອາ\u200bຟຣິ\u200bກາ\u200bຕາ\u200bເວັນ\u200bຕົກ"},
+		{"ເວລາອະແລສກາ", "ເວລາມາດຕະຖານອະແລສກາ", "ເວລາກາງເວັນອະແລສກາ"},
+		{"ເວລາກາງ", "ເວລາມາດຕະຖານກາງ", "ເວລາກາງເວັນກາງ"},
+		{"ເວລາຕາເວັນອອກ", "ເວລາມາດຕະຖານຕາເວັນອອກ", "ເວລາກາງເວັນຕາເວັນອອກ"},
+		{"ເວລາແຖບພູເຂົາ", "ເວລາມາດຕະຖານແຖບພູເຂົາ", "ເວລາກາງເວັນແຖບພູເຂົາ"},
+		{"ເວລາແປຊິຟິກ", "ເວລາມາດຕະຖານແປຊິຟິກ", "ເວລາກາງເວັນແປຊິຟິກ"},
+		{"ເວ\u200bລາ\u200bອາ\u200bຣາ\u200bບຽນ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານອາ\u200bຣາ\u200bບຽນ", "ເວລາກາງເວັນອາຣາບຽນ"},
+		{"ເວ\u200bລາ\u200bອາ\u200bເຈ\u200bທິ\u200bນາ", "\u200bເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານອາ\u200bເຈນ\u200bທິ\u200bນາ", "\u200bເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bອາ\u200bເຈນ\u200bທິ\u200bນາ"},
+		{"ເວລາຂອງອາແລນຕິກ", "ເວລາມາດຕະຖານຂອງອາແລນຕິກ", "ເວລາກາງເວັນຂອງອາແລນຕິກ"},
+		{"ເວ\u200bລາອອ\u200bສ\u200bເຕຣ\u200bເລຍ\u200bກາງ", "ເວ\u200bລາມາດ\u200bຕະ\u200bຖານອອ\u200bສ\u200bເຕຣ\u200bເລຍ\u200bກ\u200bາງ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bອອສ\u200bເຕຣ\u200bເລຍ\u200bກາງ"},
+		{"ເວ\u200bລາອອສ\u200bເຕຣ\u200bລຽນ\u200bຕາ\u200bເວັນ\u200bອອກ", "ເວ\u200bລາ\u200bມາດຕະຖານ\u200b\u200b\u200bອອສ\u200bເຕຣ\u200bລຽນ\u200bຕາ\u200bເວັນ\u200bອອກ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bອອສ\u200bເຕຣ\u200bລຽນ\u200bຕາ\u200bເວັນ\u200bອອກ"},
+		{"ເວ\u200bລາ\u200bອອສ\u200bເຕຣ\u200bເລຍ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bມາ\u200bດ\u200bຕະ\u200bຖານອອສ\u200bເຕຣ\u200bລຽນ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bອອສ\u200bເຕຣ\u200bລຽນ\u200bຕາ\u200bເວັນ\u200bຕົກ"},
+		{"ເວລາ ບັງກະລາເທດ", "ເວລາມາດຕະຖານ ບັງກະລາເທດ", "ເວລາ ລະດູຮ້ອນ ບັງກະລາເທດ"},
+		{"ເວລາຕາມເຂດບຣາຊິເລຍ", "ເວລາມາດຕາຖານເບຣຊີເລຍ", "ເວລາຕາມເຂດລະດູຮ້ອນຕາມເຂດບຣາຊີເລຍ"},
+		{"ເວ\u200bລາ\u200bຊິ\u200bລີ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານຊິ\u200bລີ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນຊິ\u200bລີ"},
+		{"ເວ\u200bລາ\u200bຈີນ", "ເວລາມາດຕະຖານຈີນ", "\u200bເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bຈີນ"},
+		{"ເວລາໂຄລໍາເບຍ", "ເວລາມາດຕະຖານໂຄລຳເບຍ", "ເວລາລະດູຮ້ອນໂຄລໍາເບຍ"},
+		{"ເວ\u200bລາ\u200bຢູ\u200bໂຣບ\u200bກາງ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bຢູ\u200bໂຣບກາງ", "\u200bເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bຢູ\u200bໂຣບ\u200bກາງ"},
+		{"ເວ\u200bລາ\u200bຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bອອກ", "ເວ\u200bລາ\u200bມາ\u200bດ\u200bຕະ\u200bຖານ\u200bຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bອອກ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bອອກ"},
+		{"ເວ\u200bລາ\u200bຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bຕົກ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນຢູ\u200bໂຣບ\u200bຕາ\u200bເວັນ\u200bຕົກ"},
+		{"", "ເວ\u200bລາກຣີນ\u200bວິ\u200bຊ", ""},
+		{"", "ເວ\u200bລາ\u200bກູ\u200bລ\u200b໌ຟ", ""},
+		{"ເວລາຮາວາຍ-ເອລູທຽນ", "ເວລາມາດຕະຖານຮາວາຍ-ເອລູທຽນ", "ເວລາຕອນທ່ຽງຮາວາຍ-ເອລູທຽນ"},
+		{"ເວ\u200bລາ\u200bຮອງ\u200bກົງ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bຮອງ\u200bກົງ", "\u200bເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bຮອງ\u200bກົງ"},
+		{"", "ເວລາ ອິນເດຍ", ""},
+		{"", "ເວລາອິນດູຈີນ", ""},
+		{"", "ເວ\u200bລາ\u200bອິນ\u200bໂດ\u200bເນ\u200bເຊຍ\u200bຕາ\u200bເວັນ\u200bຕົກ", ""},
+		{"ເວ\u200bລາ\u200bອີ\u200bຣານ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານອີ\u200bຣານ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bອີ\u200bຣາ\u200bນ"},
+		{"ເວ\u200bລາ\u200bອິ\u200bສ\u200bຣາ\u200bເອວ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານອິ\u200bສ\u200bຣາ\u200bເອວ", "ເວລາກາງເວັນອິສຣາເອວ"},
+		{"ເວ\u200bລາ\u200bຍີ່\u200bປຸ່ນ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bຍີ່\u200bປຸ່ນ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bຍີ່\u200bປຸ່ນ"},
+		{"ເວລາເກົາຫຼີ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bເກົາ\u200bຫລີ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bເກົາ\u200bຫລີ"},
+		{"", "ເວ\u200bລາ\u200bມາ\u200bເລ\u200bເຊຍ", ""},
+		{"ເວ\u200bລາ\u200bມອ\u200bສ\u200bໂຄ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານມອ\u200bສ\u200bໂຄ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນມອ\u200bສ\u200bໂຄ"},
+		{"ເວ\u200bລາ\u200bນິວ\u200bຊີ\u200bແລນ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານນິວ\u200bຊີ\u200bແລນ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່ຽງ\u200bນິວ\u200bຊີ\u200bແລນ"},
+		{"ເວ\u200bລາ\u200bນິວ\u200bຟາວ\u200bແລນ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bນິວ\u200bຟາວ\u200bແລນ", "ເວລາກາງເວັນນິວຟາວແລນ"},
+		{"ເວ\u200bລາ\u200bປາ\u200bກີສຖານ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bປາ\u200bກີສຖານ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bປາ\u200bກີ\u200bສ\u200bຖານ"},
+		{"ເວ\u200bລາ\u200bເປ\u200bຣູ", "ເວ\u200bລາ\u200b\u200bມາ\u200bດ\u200bຕະ\u200bຖານເປ\u200bຣູ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bເປ\u200bຣູ"},
+		{"\u200bເວ\u200bລາ\u200bຟິ\u200bລິບ\u200bປິນ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bຟິ\u200bລິບ\u200bປິນ", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bຟິ\u200bລິບ\u200bປິນ"},
+		{"", "ເວ\u200bລາ\u200bສິງ\u200bກະ\u200bໂປ", ""},
+		{"ເວ\u200bລາ\u200bໄທ\u200bເປ", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bໄທ\u200bເປ", "ເວ\u200bລາ\u200bຕອນ\u200bທ່\u200bຽງ\u200bໄທ\u200bເປ"},
+		{"", "ເວ\u200bລາ\u200bເວ\u200bເນ\u200bຊູ\u200bເອ\u200bລາ", ""},
+		{"", "ເວລາສາກົນເຊີງພິກັດ", ""},
+		{"", "", "ເວ\u200bລາ\u200bມາດ\u200bຕະ\u200bຖານ\u200bໄອ\u200bຣິ\u200bຊ"},
+		{"", "", "ເວ\u200bລາ\u200bລະ\u200bດູ\u200bຮ້ອນ\u200bອັງ\u200bກິດ"},
+		{"", "", ""},
+	},
+	"lt": {
+		{"", "Centrinės Afrikos laikas", ""},
+		{"", "Rytų Afrikos laikas", ""},
+		{"", "Pietų Afrikos laikas", ""},
+		{"Vakarų Afrikos laikas", "Vakarų Afrikos žiemos laikas", "Vakarų Afrikos vasaros laikas"},
+		{"Aliaskos laikas", "Aliaskos žiemos laikas", "Aliaskos vasaros laikas"},
+		{"Šiaurės Amerikos centro laikas", "Šiaurės Amerikos centro žiemos laikas", "Šiaurės Amerikos centro vasaros laikas"},
+		{"Šiaurės Amerikos rytų laikas", "Šiaurės Amerikos rytų žiemos laikas", "Šiaurės Amerikos rytų vasaros laikas"},
+		{"Šiaurės Amerikos kalnų laikas", "Šiaurės Amerikos kalnų žiemos laikas", "Šiaurės Amerikos kalnų vasaros laikas"},
+		{"Šiaurės Amerikos Ramiojo vandenyno laikas", "Šiaurės Amerikos Ramiojo vandenyno žiemos laikas", "Šiaurės Amerikos Ramiojo vandenyno vasaros laikas"},
+		{"Arabijos laikas", "Arabijos žiemos laikas", "Arabijos vasaros laikas"},
+		{"Argentinos laikas", "Argentinos žiemos laikas", "Argentinos vasaros laikas"},
+		{"Atlanto laikas", "Atlanto žiemos laikas", "Atlanto vasaros laikas"},
+		{"Centrinės Australijos laikas", "Centrinės Australijos žiemos laikas", "Centrinės Australijos vasaros laikas"},
+		{"Rytų Australijos laikas", "Rytų Australijos žiemos laikas", "Rytų Australijos vasaros laikas"},
+		{"Vakarų Australijos laikas", "Vakarų Australijos žiemos laikas", "Vakarų Australijos vasaros laikas"},
+		{"Bangladešo laikas", "Bangladešo žiemos laikas", "Bangladešo vasaros laikas"},
+		{"Brazilijos laikas", "Brazilijos žiemos laikas", "Brazilijos vasaros laikas"},
+		{"Čilės laikas", "Čilės žiemos laikas", "Čilės vasaros laikas"},
+		{"Kinijos laikas", "Kinijos žiemos laikas", "Kinijos vasaros laikas"},
+		{"Kolumbijos laikas", "Kolumbijos žiemos laikas", "Kolumbijos vasaros laikas"},
+		{"Vidurio Europos laikas", "Vidurio Europos žiemos laikas", "Vidurio Europos vasaros laikas"},
+		{"Rytų Europos laikas", "Rytų Europos žiemos laikas", "Rytų Europos vasaros laikas"},
+		{"Vakarų Europos laikas", "Vakarų Europos žiemos laikas", "Vakarų Europos vasaros laikas"},
+		{"", "Grinvičo laikas", ""},
+		{"", "Persijos įlankos laikas", ""},
+		{"Havajų-Aleutų laikas", "Havajų–Aleutų žiemos laikas", "Havajų–Aleutų vasaros laikas"},
+		{"Honkongo laikas", "Honkongo žiemos laikas", "Honkongo vasaros laikas"},
+		{"", "Indijos laikas", ""},
+		{"", "Indokinijos laikas", ""},
+		{"", "Vakarų Indonezijos laikas", ""},
+		{"Irano laikas", "Irano žiemos laikas", "Irano vasaros laikas"},
+		{"Izraelio laikas", "Izraelio žiemos laikas", "Izraelio vasaros laikas"},
+		{"Japonijos laikas", "Japonijos žiemos laikas", "Japonijos vasaros laikas"},
+		{"Korėjos laikas", "Korėjos žiemos laikas", "Korėjos vasaros laikas"},
+		{"", "Malaizijos laikas", ""},
+		{"Maskvos laikas", "Maskvos žiemos laikas", "Maskvos vasaros laikas"},
+		{"Naujosios Zelandijos laikas", "Naujosios Zelandijos žiemos laikas", "Naujosios Zelandijos vasaros laikas"},
+		{"Niufaundlendo laikas", "Niufaundlendo žiemos laikas", "Niufaundlendo vasaros laikas"},
+		{"Pakistano laikas", "Pakistano žiemos laikas", "Pakistano vasaros laikas"},
+		{"Peru laikas", "Peru žiemos laikas", "Peru vasaros laikas"},
+		{"Filipinų laikas", "Filipinų žiemos laikas", "Filipinų vasaros laikas"},
+		{"", "Singapūro laikas", ""},
+		{"Taipėjaus laikas", "Taipėjaus žiemos laikas", "Taipėjaus vasaros laikas"},
+		{"", "Venesuelos laikas", ""},
+		{"", "pasaulio suderintasis laikas", ""},
+		{"", "", "Airijos vasaros laikas"},
+		{"", "", "Britanijos vasaros laikas"},
+		{"", "", ""},
+	},
+	"lv": {
+		{"", "Centrālāfrikas laiks", ""},
+		{"", "Austrumāfrikas laiks", ""},
+		{"", "Dienvidāfrikas ziemas laiks", ""},
+		{"Rietumāfrikas laiks", "Rietumāfrikas ziemas laiks", "Rietumāfrikas vasaras laiks"},
+		{"Aļaskas laiks", "Aļaskas ziemas laiks", "Aļaskas vasaras laiks"},
+		{"Centrālais laiks", "Centrālais ziemas laiks", "Centrālais vasaras laiks"},
+		{"Austrumu laiks", "Austrumu ziemas laiks", "Austrumu vasaras laiks"},
+		{"Kalnu laiks", "Kalnu ziemas laiks", "Kalnu vasaras laiks"},
+		{"Klusā okeāna laiks", "Klusā okeāna ziemas laiks", "Klusā okeāna vasaras laiks"},
+		{"Arābijas pussalas laiks", "Arābijas pussalas ziemas laiks", "Arābijas pussalas vasaras laiks"},
+		{"Argentīnas laiks", "Argentīnas ziemas laiks", "Argentīnas vasaras laiks"},
+		{"Atlantijas laiks", "Atlantijas ziemas laiks", "Atlantijas vasaras laiks"},
+		{"Austrālijas centrālais laiks", "Austrālijas centrālais ziemas laiks", "Austrālijas centrālais vasaras laiks"},
+		{"Austrālijas austrumu laiks", "Austrālijas austrumu ziemas laiks", "Austrālijas austrumu vasaras laiks"},
+		{"Austrālijas rietumu laiks", "Austrālijas rietumu ziemas laiks", "Austrālijas rietumu vasaras laiks"},
+		{"Bangladešas laiks", "Bangladešas ziemas laiks", "Bangladešas vasaras laiks"},
+		{"Brazīlijas laiks", "Brazīlijas ziemas laiks", "Brazīlijas vasaras laiks"},
+		{"Čīles laiks", "Čīles ziemas laiks", "Čīles vasaras laiks"},
+		{"Ķīnas laiks", "Ķīnas ziemas laiks", "Ķīnas vasaras laiks"},
+		{"Kolumbijas laiks", "Kolumbijas ziemas laiks", "Kolumbijas vasaras laiks"},
+		{"Centrāleiropas laiks", "Centrāleiropas ziemas laiks", "Centrāleiropas vasaras laiks"},
+		{"Austrumeiropas laiks", "Austrumeiropas ziemas laiks", "Austrumeiropas vasaras laiks"},
+		{"Rietumeiropas laiks", "Rietumeiropas ziemas laiks", "Rietumeiropas vasaras laiks"},
+		{"", "Griničas laiks", ""},
+		{"", "Persijas līča laiks", ""},
+		{"Havaju–Aleutu laiks", "Havaju–Aleutu ziemas laiks", "Havaju–Aleutu vasaras laiks"},
+		{"Honkongas laiks", "Honkongas ziemas laiks", "Honkongas vasaras laiks"},
+		{"", "Indijas ziemas laiks", ""},
+		{"", "Indoķīnas laiks", ""},
+		{"", "Rietumindonēzijas laiks", ""},
+		{"Irānas laiks", "Irānas ziemas laiks", "Irānas vasaras laiks"},
+		{"Izraēlas laiks", "Izraēlas ziemas laiks", "Izraēlas vasaras laiks"},
+		{"Japānas laiks", "Japānas ziemas laiks", "Japānas vasaras laiks"},
+		{"Korejas laiks", "Korejas ziemas laiks", "Korejas vasaras laiks"},
+		{"", "Malaizijas laiks", ""},
+		{"Maskavas laiks", "Maskavas ziemas laiks", "Maskavas vasaras laiks"},
+		{"Jaunzēlandes laiks", "Jaunzēlandes ziemas laiks", "Jaunzēlandes vasaras laiks"},
+		{"Ņūfaundlendas laiks", "Ņūfaundlendas ziemas laiks", "Ņūfaundlendas vasaras laiks"},
+		{"Pakistānas laiks", "Pakistānas ziemas laiks", "Pakistānas vasaras laiks"},
+		{"Peru laiks", "Peru ziemas laiks", "Peru vasaras laiks"},
+		{"Filipīnu laiks", "Filipīnu ziemas laiks", "Filipīnu vasaras laiks"},
+		{"", "Singapūras laiks", ""},
+		{"Taibei laiks", "Taibei ziemas laiks", "Taibei vasaras laiks"},
+		{"", "Venecuēlas laiks", ""},
+		{"", "Universālais koordinētais laiks", ""},
+		{"", "", "Īrijas ziemas laiks"},
+		{"", "", "Lielbritānijas vasaras laiks"},
+		{"", "", ""},
+	},
+	"mk": {
+		{"", "Средноафриканско време", ""},
+		{"", "Источноафриканско време", ""},
+		{"", "Време во Јужноафриканска Република", ""},
+		{"Западноафриканско време", "Западноафриканско стандардно време", "Западноафриканско летно сметање на времето"},
+		{"Време во Алјаска", "Стандардно време во Алјаска", "Летно сметање на времето во Алјаска"},
+		{"Централно време во Северна Америка", "Централно стандардно време во Северна Америка", "Централно летно сметање на времето"},
+		{"Источно време", "Источно стандардно време", "Источно летно сметање на времето"},
+		{"Планинско време", "Планинско стандардно време", "Планинско летно сметање на времето"},
+		{"Пацифичко време", "Пацифичко стандардно време", "Пацифичко летно сметање на времето"},
+		{"Арапско време", "Стандардно арапско време", "Арапско летно сметање на времето"},
+		{"Време во Аргентина", "Стандардно време во Аргентина", "Летно сметање на времето во Аргентина"},
+		{"Атлантско време", "Атлантско стандардно време", "Атлантско летно сметање на времето"},
+		{"Време во Централна Австралија", "Стандардно време во Централна Австралија", "Летно сметање на времето во Централна Австралија"},
+		{"Време во Источна Австралија", "Стандардно време во Источна Австралија", "Летно сметање на времето во Источна Австралија"},
+		{"Време во Западна Австралија", "Стандардно време во Западна Австралија", "Летно сметање на времето во Западна Австралија"},
+		{"Време во Бангладеш", "Стандардно време во Бангладеш", "Летно време во Бангладеш"},
+		{"Време во Бразилија", "Стандардно време во Бразилија", "Летно сметање на времето во Бразилија"},
+		{"Време во Чиле", "Стандардно време во Чиле", "Летно сметање на времето во Чиле"},
+		{"Време во Кина", "Стандардно време во Кина", "Летно сметање на времето во Кина"},
+		{"Време во Колумбија", "Стандардно време во Колумбија", "Летно сметање на времето во Колумбија"},
+		{"Средноевропско време", "Средноевропско стандардно време", "Средноевропско летно време"},
+		{"Источноевропско време", "Источноевропско стандардно време", "Источноевропско летно време"},
+		{"Западноевропско време", "Западноевропско стандардно време", "Западноевропско летно време"},
+		{"", "Средно време по Гринич", ""},
+		{"", "Време на Мексиканскиот Залив", ""},
+		{"Време во Хаваи - Алеутски острови", "Стандардно време во Хаваи - Алеутски острови", "Летно сметање на времето во Хаваи - Алеутски острови"},
+		{"Време во Хонг Конг", "Стандардно време во Хонг Конг", "Летно време во Хонг Конг"},
+		{"", "Време во Индија", ""},
+		{"", "Време во Индокина", ""},
+		{"", "Време во Западна Индонезија", ""},
+		{"Време во Иран", "Стандардно време во Иран", "Летно сметање на времето во Иран"},
+		{"Време во Израел", "Стандардно време во Израел", "Летно сметање на времето во Израел"},
+		{"Време во Јапонија", "Стандардно време во Јапонија", "Летно сметање на времето во Јапонија"},
+		{"Време во Кореја", "Стандардно време во Кореја", "Летно сметање на времето во Кореја"},
+		{"", "Време во Малезија", ""},
+		{"Време во Москва", "Стандардно време во Москва", "Летно сметање на времето во Москва"},
+		{"Време во Нов Зеланд", "Стандардно време во Нов Зеланд", "Летно сметање на времето во Нов Зеланд"},
+		{"Време на Њуфаундленд", "Стандардно време на Њуфаундленд", "Летно сметање на времето на Њуфаундленд"},
+		{"Време во Пакистан", "Стандардно време во Пакистан", "Летно време во Пакистан"},
+		{"Време во Перу", "Стандардно време во Перу", "Летно сметање на времето во Перу"},
+		{"Време во Филипини", "Стандардно време во Филипини", "Летно време во Филипини"},
+		{"", "Време во Сингапур", ""},
+		{"Време во Тајпеј", "Стандардно време во Тајпеј", "Летно сметање на времето во Тајпеј"},
+		{"", "Време во Венецуела", ""},
+		{"", "Координирано универзално време", ""},
+		{"", "", "Ирско стандардно време"},
+		{"", "", "Британско летно време"},
+		{"", "", ""},
+	},
+	"ml": {
+		{"", "മധ്യ ആഫ്രിക്ക സമയം", ""},
+		{"", "കിഴക്കൻ ആഫ്രിക്ക സമയം", ""},
+		{"", "ദക്ഷിണാഫ്രിക്ക സ്റ്റാൻഡേർഡ് സമയം", ""},
+		{"പടിഞ്ഞാറൻ ആഫ്രിക്ക സമയം", "പടിഞ്ഞാറൻ ആഫ്രിക്ക സ്റ്റാൻഡേർഡ് സമയം", "പടിഞ്ഞാറൻ ആഫ്രിക്ക ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"അലാസ്\u200cക സമയം", "അലാസ്ക സ്റ്റാൻഡേർഡ് സമയം", "അലാസ്\u200cക ഡേലൈറ്റ് സമയം"},
+		{"വടക്കെ അമേരിക്കൻ സെൻട്രൽ സമയം", "വടക്കെ അമേരിക്കൻ സെൻട്രൽ സ്റ്റാൻഡേർഡ് സമയം", "വടക്കെ അമേരിക്കൻ സെൻട്രൽ ഡേലൈറ്റ് സമയം"},
+		{"വടക്കെ അമേരിക്കൻ കിഴക്കൻ സമയം", "വടക്കെ അമേരിക്കൻ കിഴക്കൻ സ്റ്റാൻഡേർഡ് സമയം", "വടക്കെ അമേരിക്കൻ കിഴക്കൻ ഡേലൈറ്റ് സമയം"},
+		{"വടക്കെ അമേരിക്കൻ മൌണ്ടൻ സമയം", "വടക്കെ അമേരിക്കൻ മൗണ്ടൻ സ്റ്റാൻഡേർഡ് സമയം", "വടക്കെ അമേരിക്കൻ മൗണ്ടൻ ഡേലൈറ്റ് സമയം"},
+		{"വടക്കെ അമേരിക്കൻ പസഫിക് സമയം", "വടക്കെ അമേരിക്കൻ പസഫിക് സ്റ്റാൻഡേർഡ് സമയം", "വടക്കെ അമേരിക്കൻ പസഫിക് ഡേലൈറ്റ് സമയം"},
+		{"അറേബ്യൻ സമയം", "അറേബ്യൻ സ്റ്റാൻഡേർഡ് സമയം", "അറേബ്യൻ ഡേലൈറ്റ് സമയം"},
+		{"അർജന്റീന സമയം", "അർജന്റീന സ്റ്റാൻഡേർഡ് സമയം", "അർജന്റീന ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"അറ്റ്\u200cലാന്റിക് സമയം", "അറ്റ്\u200cലാന്റിക് സ്റ്റാൻഡേർഡ് സമയം", "അറ്റ്\u200cലാന്റിക് ഡേലൈറ്റ് സമയം"},
+		{"സെൻട്രൽ ഓസ്ട്രേലിയ സമയം", "ഓസ്ട്രേലിയൻ സെൻട്രൽ സ്റ്റാൻഡേർഡ് സമയം", "ഓസ്ട്രേലിയൻ സെൻട്രൽ ഡേലൈറ്റ് സമയം"},
+		{"കിഴക്കൻ ഓസ്\u200cട്രേലിയ സമയം", "ഓസ്\u200cട്രേലിയൻ കിഴക്കൻ സ്റ്റാൻഡേർഡ് സമയം", "ഓസ്\u200cട്രേലിയൻ കിഴക്കൻ ഡേലൈറ്റ് സമയം"},
+		{"പടിഞ്ഞാറൻ ഓസ്\u200cട്രേലിയ സമയം", "ഓസ്\u200cട്രേലിയൻ പടിഞ്ഞാറൻ സ്റ്റാൻഡേർഡ് സമയം", "ഓസ്\u200cട്രേലിയൻ പടിഞ്ഞാറൻ ഡേലൈറ്റ് സമയം"},
+		{"ബംഗ്ലാദേശ് സമയം", "ബംഗ്ലാദേശ് സ്റ്റാൻഡേർഡ് സമയം", "ബംഗ്ലാദേശ് ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"ബ്രസീലിയ സമയം", "ബ്രസീലിയ സ്റ്റാൻഡേർഡ് സമയം", "ബ്രസീലിയ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"ചിലി സമയം", "ചിലി സ്റ്റാൻഡേർഡ് സമയം", "ചിലി ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"ചൈന സമയം", "ചൈന സ്റ്റാൻഡേർഡ് സമയം", "ചൈന ഡേലൈറ്റ് സമയം"},
+		{"കൊളംബിയ സമയം", "കൊളംബിയ സ്റ്റാൻഡേർഡ് സമയം", "കൊളംബിയ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"സെൻട്രൽ യൂറോപ്യൻ സമയം", "സെൻട്രൽ യൂറോപ്യൻ സ്റ്റാൻഡേർഡ് സമയം", "സെൻട്രൽ യൂറോപ്യൻ ഗ്രീഷ്മകാല സമയം"},
+		{"കിഴക്കൻ യൂറോപ്യൻ സമയം", "കിഴക്കൻ യൂറോപ്യൻ സ്റ്റാൻഡേർഡ് സമയം", "കിഴക്കൻ യൂറോപ്യൻ ഗ്രീഷ്മകാല സമയം"},
+		{"പടിഞ്ഞാറൻ യൂറോപ്യൻ സമയം", "പടിഞ്ഞാറൻ യൂറോപ്യൻ സ്റ്റാൻഡേർഡ് സമയം", "പടിഞ്ഞാറൻ യൂറോപ്യൻ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"", "ഗ്രീൻവിച്ച് മീൻ സമയം", ""},
+		{"", "ഗൾഫ് സ്റ്റാൻഡേർഡ് സമയം", ""},
+		{"ഹവായ്-അലൂഷ്യൻ സമയം", "ഹവായ്-അലൂഷ്യൻ സ്റ്റാൻഡേർഡ് സമയം", "ഹവായ്-അലൂഷ്യൻ ഡേലൈറ്റ് സമയം"},
+		{"ഹോങ്കോങ്ങ് സമയം", "ഹോങ്കോങ്ങ് സ്റ്റാൻഡേർഡ് സമയം", "ഹോങ്കോങ്ങ് ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"", "ഇന്ത്യൻ സ്റ്റാൻഡേർഡ് സമയം", ""},
+		{"", "ഇൻഡോചൈന സമയം", ""},
+		{"", "പടിഞ്ഞാറൻ ഇന്തോനേഷ്യ സമയം", ""},
+		{"ഇറാൻ സമയം", "ഇറാൻ സ്റ്റാൻഡേർഡ് സമയം", "ഇറാൻ ഡേലൈറ്റ് സമയം"},
+		{"ഇസ്രായേൽ സമയം", "ഇസ്രായേൽ സ്റ്റാൻഡേർഡ് സമയം", "ഇസ്രായേൽ ഡേലൈറ്റ് സമയം"},
+		{"ജപ്പാൻ സമയം", "ജപ്പാൻ സ്റ്റാൻഡേർഡ് സമയം", "ജപ്പാൻ ഡേലൈറ്റ് സമയം"},
+		{"കൊറിയൻ സമയം", "കൊറിയൻ സ്റ്റാൻഡേർഡ് സമയം", "കൊറിയൻ ഡേലൈറ്റ് സമയം"},
+		{"", "മലേഷ്യ സമയം", ""},
+		{"മോസ്കോ സമയം", "മോസ്കോ സ്റ്റാൻഡേർഡ് സമയം", "മോസ്\u200cകോ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"ന്യൂസിലാൻഡ് സമയം", "ന്യൂസിലാൻഡ് സ്റ്റാൻഡേർഡ് സമയം", "ന്യൂസിലാൻഡ് ഡേലൈറ്റ് സമയം"},
+		{"ന്യൂഫൗണ്ട്\u200cലാന്റ് സമയം", "ന്യൂഫൗണ്ട്\u200cലാന്റ് സ്റ്റാൻഡേർഡ് സമയം", "ന്യൂഫൗണ്ട്\u200cലാന്റ് ഡേലൈറ്റ് സമയം"},
+		{"പാക്കിസ്ഥാൻ സമയം", "പാക്കിസ്ഥാൻ സ്റ്റാൻഡേർഡ് സമയം", "പാക്കിസ്ഥാൻ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"പെറു സമയം", "പെറു സ്റ്റാൻഡേർഡ് സമയം", "പെറു ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"ഫിലിപ്പൈൻ സമയം", "ഫിലിപ്പൈൻ സ്റ്റാൻഡേർഡ് സമയം", "ഫിലിപ്പൈൻ ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"", "സിംഗപ്പൂർ സ്റ്റാൻഡേർഡ് സമയം", ""},
+		{"തായ്\u200cപെയ് സമയം", "തായ്\u200cപെയ് സ്റ്റാൻഡേർഡ് സമയം", "തായ്\u200cപെയ് ഡേലൈറ്റ് സമയം"},
+		{"", "വെനിസ്വേല സമയം", ""},
+		{"", "കോർഡിനേറ്റഡ് യൂണിവേഴ്\u200cസൽ ടൈം", ""},
+		{"", "", "ഐറിഷ് സ്റ്റാൻഡേർഡ് സമയം"},
+		{"", "", "ബ്രിട്ടീഷ് ഗ്രീഷ്\u200cമകാല സമയം"},
+		{"", "", ""},
+	},
+	"mn": {
+		{"", "Төв Африкийн цаг", ""},
+		{"", "Зүүн Африкийн цаг", ""},
+		{"", "Өмнөд Африкийн стандарт цаг", ""},
+		{"Баруун Африкийн цаг", "Баруун Африкийн стандарт цаг", "Баруун Африкийн зуны цаг"},
+		{"Аляскийн цаг", "Аляскийн стандарт цаг", "Аляскийн зуны цаг"},
+		{"Төв цаг", "Төв стандарт цаг", "Төв зуны цаг"},
+		{"Зүүн эргийн цаг", "Зүүн эргийн стандарт цаг", "Зүүн эргийн зуны цаг"},
+		{"Уулын цаг", "Уулын стандарт цаг", "Уулын зуны цаг"},
+		{"Номхон далайн цаг", "Номхон далайн стандарт цаг", "Номхон далайн зуны цаг"},
+		{"Арабын цаг", "Арабын стандарт цаг", "Арабын зуны цаг"},
+		{"Аргентины цаг", "Аргентины стандарт цаг", "Аргентины зуны цаг"},
+		{"Атлантын цаг", "Атлантын стандарт цаг", "Атлантын зуны цаг"},
+		{"Төв Австралийн цаг", "Төв Австралийн стандарт цаг", "Төв Австралийн зуны цаг"},
+		{"Зүүн Австралийн цаг", "Австралийн зүүн эргийн стандарт цаг", "Австралийн зүүн эргийн зуны цаг"},
+		{"Баруун Австралийн цаг", "Австралийн баруун эргийн стандарт цаг", "Австралийн баруун эргийн зуны цаг"},
+		{"Бангладешийн цаг", "Бангладешийн стандарт цаг", "Бангладешийн зуны цаг"},
+		{"Бразилийн цаг", "Бразилийн стандарт цаг", "Бразилийн зуны цаг"},
+		{"Чилийн цаг", "Чилийн стандарт цаг", "Чилийн зуны цаг"},
+		{"Хятадын цаг", "Хятадын стандарт цаг", "Хятадын зуны цаг"},
+		{"Колумбын цаг", "Колумбын стандарт цаг", "Колумбын зуны цаг"},
+		{"Төв Европын цаг", "Төв Европын стандарт цаг", "Төв Европын зуны цаг"},
+		{"Зүүн Европын цаг", "Зүүн Европын стандарт цаг", "Зүүн Европын зуны цаг"},
+		{"Баруун Европын цаг", "Баруун Европын стандарт цаг", "Баруун Европын зуны цаг"},
+		{"", "Гринвичийн цаг", ""},
+		{"", "Галфийн стандарт цаг", ""},
+		{"Хавай-Алеутын цаг", "Хавай-Алеутын стандарт цаг", "Хавай-Алеутын зуны цаг"},
+		{"Хонг Конгийн цаг", "Хонг Конгийн стандарт цаг", "Хонг Конгийн зуны цаг"},
+		{"", "Энэтхэгийн цаг", ""},
+		{"", "Энэтхэг-Хятадын хойгийн цаг", ""},
+		{"", "Баруун Индонезийн цаг", ""},
+		{"Ираны цаг", "Ираны стандарт цаг", "Ираны зуны цаг"},
+		{"Израилийн цаг", "Израилийн стандарт цаг", "Израилийн зуны цаг"},
+		{"Японы цаг", "Японы стандарт цаг", "Японы зуны цаг"},
+		{"Солонгосын цаг", "Солонгосын стандарт цаг", "Солонгосын зуны цаг"},
+		{"", "Малайзын цаг", ""},
+		{"Москвагийн цаг", "Москвагийн стандарт цаг", "Москвагийн зуны цаг"},
+		{"Шинэ Зеландын цаг", "Шинэ Зеландын стандарт цаг", "Шинэ Зеландын зуны цаг"},
+		{"Нью-Фаундлендын цаг", "Нью-Фаундлендын стандарт цаг", "Нью-Фаундлендын зуны цаг"},
+		{"Пакистаны цаг", "Пакистаны стандарт цаг", "Пакистаны зуны цаг"},
+		{"Перугийн цаг", "Перугийн стандарт цаг", "Перугийн зуны цаг"},
+		{"Филиппиний цаг", "Филиппиний стандарт цаг", "Филиппиний зуны цаг"},
+		{"", "Сингапурын цаг", ""},
+		{"Тайпейн цаг", "Тайпейн стандарт цаг", "Тайпейн зуны цаг"},
+		{"", "Венесуэлийн цаг", ""},
+		{"", "Олон улсын зохицуулалттай цаг", ""},
+		{"", "", "Ирландын стандарт цаг"},
+		{"", "", "Британийн зуны цаг"},
+		{"", "", ""},
+	},
+	"mr": {
+		{"", "मध्\u200dय आफ्रिका वेळ", ""},
+		{"", "पूर्व आफ्रिका वेळ", ""},
+		{"", "दक्षिण आफ्रिका प्रमाण वेळ", ""},
+		{"पश्चिम आफ्रिका वेळ", "पश्चिम आफ्रिका प्रमाण वेळ", "पश्चिम आफ्रिका उन्हाळी वेळ"},
+		{"अलास्का वेळ", "अलास्का प्रमाण वेळ", "अलास्का सूर्यप्रकाश वेळ"},
+		{"केंद्रीय वेळ", "केंद्रीय प्रमाण वेळ", "केंद्रीय सूर्यप्रकाश वेळ"},
+		{"पौर्वात्य वेळ", "पौर्वात्य प्रमाण वेळ", "पौर्वात्य सूर्यप्रकाश वेळ"},
+		{"पर्वतीय वेळ", "पर्वतीय प्रमाण वेळ", "पर्वतीय सूर्यप्रकाश वेळ"},
+		{"पॅसिफिक वेळ", "पॅसिफिक प्रमाण वेळ", "पॅसिफिक सूर्यप्रकाश वेळ"},
+		{"अरेबियन वेळ", "अरेबियन प्रमाण वेळ", "अरेबियन सूर्यप्रकाश वेळ"},
+		{"अर्जेंटिना वेळ", "अर्जेंटिना प्रमाण वेळ", "अर्जेंटिना उन्हाळी वेळ"},
+		{"अटलांटिक वेळ", "अटलांटिक प्रमाण वेळ", "अटलांटिक सूर्यप्रकाश वेळ"},
+		{"मध्य ऑस्ट्रेलिया वेळ", "ऑस्ट्रेलियन मध्य प्रमाण वेळ", "ऑस्ट्रेलियन मध्य सूर्यप्रकाश वेळ"},
+		{"पूर्व ऑस्ट्रेलिया वेळ", "ऑस्ट्रेलियन पूर्व प्रमाण वेळ", "ऑस्ट्रेलियन पूर्व सूर्यप्रकाश वेळ"},
+		{"पश्चिम ऑस्ट्रेलिया वेळ", "ऑस्ट्रेलियन पश्चिम प्रमाण वेळ", "ऑस्ट्रेलियन पश्चिम सूर्यप्रकाश वेळ"},
+		{"बांगलादेश वेळ", "बांगलादेश प्रमाण वेळ", "बांगलादेश उन्हाळी वेळ"},
+		{"ब्राझिलिया वेळ", "ब्राझिलिया प्रमाण वेळ", "ब्राझिलिया उन्हाळी वेळ"},
+		{"चिली वेळ", "चिली प्रमाण वेळ", "चिली उन्हाळी वेळ"},
+		{"चीनी वेळ", "चीनी प्रमाण वेळ", "चीनी सूर्यप्रकाश वेळ"},
+		{"कोलंबिया वेळ", "कोलंबिया प्रमाण वेळ", "कोलंबिया उन्हाळी वेळ"},
+		{"मध्\u200dय युरोपियन वेळ", "मध्\u200dय युरोपियन प्रमाण वेळ", "मध्\u200dय युरोपियन उन्हाळी वेळ"},
+		{"पूर्व युरोपियन वेळ", "पूर्व युरोपियन प्रमाण वेळ", "पूर्व युरोपियन उन्हाळी वेळ"},
+		{"पश्चिम युरोपियन वेळ", "पश्चिम युरोपियन प्रमाण वेळ", "पश्चिम युरोपियन उन्हाळी वेळ"},
+		{"", "ग्रीनिच प्रमाण वेळ", ""},
+		{"", "खाडी प्रमाण वेळ", ""},
+		{"हवाई-अलूशन वेळ", "हवाई-अलूशन प्रमाण वेळ", "हवाई-अलूशन सूर्यप्रकाश वेळ"},
+		{"हाँग काँग वेळ", "हाँग काँग प्रमाण वेळ", "हाँग काँग उन्हाळी वेळ"},
+		{"", "भारतीय प्रमाण वेळ", ""},
+		{"", "इंडोचायना वेळ", ""},
+		{"", "पश्चिमी इंडोनेशिया वेळ", ""},
+		{"इराण वेळ", "इराण प्रमाण वेळ", "इराण सूर्यप्रकाश वेळ"},
+		{"इस्रायल", "इस्रायल प्रमाण वेळ", "इस्रायल सूर्यप्रकाश वेळ"},
+		{"जपान वेळ", "जपान प्रमाण वेळ", "जपान सूर्यप्रकाश वेळ"},
+		{"कोरियन वेळ", "कोरियन प्रमाण वेळ", "कोरियन सूर्यप्रकाश वेळ"},
+		{"", "मलेशिया वेळ", ""},
+		{"मॉस्को वेळ", "मॉस्को प्रमाण वेळ", "मॉस्को उन्हाळी वेळ"},
+		{"न्यूझीलंड वेळ", "न्यूझीलंड प्रमाण वेळ", "न्यूझीलंड सूर्यप्रकाश वेळ"},
+		{"न्यू फाउंडलंड वेळ", "न्यू फाउंडलंड प्रमाण वेळ", "न्यू फाउंडलंड सूर्यप्रकाश वेळ"},
+		{"पाकिस्तान वेळ", "पाकिस्तान प्रमाण वेळ", "पाकिस्तान उन्हाळी वेळ"},
+		{"पेरु वेळ", "पेरु प्रमाण वेळ", "पेरु उन्हाळी वेळ"},
+		{"फिलिपाइन वेळ", "फिलिपाइन प्रमाण वेळ", "फिलिपाइन उन्हाळी वेळ"},
+		{"", "सिंगापूर प्रमाण वेळ", ""},
+		{"तैपेई वेळ", "तैपेई प्रमाण वेळ", "तैपेई सूर्यप्रकाश वेळ"},
+		{"", "व्हेनेझुएला वेळ", ""},
+		{"", "समन्वित वैश्विक वेळ", ""},
+		{"", "", "आयरिश प्रमाण वेळ"},
+		{"", "", "ब्रिटिश उन्हाळी वेळ"},
+		{"", "", ""},
+	},
+	"ms": {
+		{"", "Waktu Afrika Tengah", ""},
+		{"", "Waktu Afrika Timur", ""},
+		{"", "Waktu Piawai Afrika Selatan", ""},
+		{"Waktu Afrika Barat", "Waktu Piawai Afrika Barat", "Waktu Musim Panas Afrika Barat"},
+		{"Waktu Alaska", "Waktu Piawai Alaska", "Waktu Siang Alaska"},
+		{"Waktu Pusat", "Waktu Piawai Pusat", "Waktu Siang Tengah"},
+		{"Waktu Timur", "Waktu Piawai Timur", "Waktu Siang Timur"},
+		{"Waktu Pergunungan", "Waktu Piawai Pergunungan", "Waktu Hari Siang Pergunungan"},
+		{"Waktu Pasifik", "Waktu Piawai Pasifik", "Waktu Siang Pasifik"},
+		{"Waktu Arab", "Waktu Piawai Arab", "Waktu Siang Arab"},
+		{"Waktu Argentina", "Waktu Piawai Argentina", "Waktu Musim Panas Argentina"},
+		{"Waktu Atlantik", "Waktu Piawai Atlantik", "Waktu Siang Atlantik"},
+		{"Waktu Australia Tengah", "Waktu Piawai Australia Tengah", "Waktu Siang Australia Tengah"},
+		{"Waktu Australia Timur", "Waktu Piawai Timur Australia", "Waktu Siang Australia Timur"},
+		{"Waktu Australia Barat", "Waktu Piawai Australia Barat", "Waktu Siang Australia Barat"},
+		{"Waktu Bangladesh", "Waktu Piawai Bangladesh", "Waktu Musim Panas Bangladesh"},
+		{"Waktu Brasilia", "Waktu Piawai Brasilia", "Waktu Musim Panas Brasilia"},
+		{"Waktu Chile", "Waktu Piawai Chile", "Waktu Musim Panas Chile"},
+		{"Waktu China", "Waktu Piawai China", "Waktu Siang China"},
+		{"Waktu Colombia", "Waktu Piawai Colombia", "Waktu Musim Panas Colombia"},
+		{"Waktu Eropah Tengah", "Waktu Piawai Eropah Tengah", "Waktu Musim Panas Eropah Tengah"},
+		{"Waktu Eropah Timur", "Waktu Piawai Eropah Timur", "Waktu Musim Panas Eropah Timur"},
+		{"Waktu Eropah Barat", "Waktu Piawai Eropah Barat", "Waktu Musim Panas Eropah Barat"},
+		{"", "Waktu Min Greenwich", ""},
+		{"", "Waktu Piawai Teluk", ""},
+		{"Waktu Hawaii-Aleutian", "Waktu Piawai Hawaii-Aleutian", "Waktu Siang Hawaii-Aleutian"},
+		{"Waktu Hong Kong", "Waktu Piawai Hong Kong", "Waktu Musim Panas Hong Kong"},
+		{"", "Waktu Piawai India", ""},
+		{"", "Waktu Indochina", ""},
+		{"", "Waktu Indonesia Barat", ""},
+		{"Waktu Iran", "Waktu Piawai Iran", "Waktu Siang Iran"},
+		{"Waktu Israel", "Waktu Piawai Israel", "Waktu Siang Israel"},
+		{"Waktu Jepun", "Waktu Piawai Jepun", "Waktu Siang Jepun"},
+		{"Waktu Korea", "Waktu Piawai Korea", "Waktu Siang Korea"},
+		{"", "Waktu Malaysia", ""},
+		{"Waktu Moscow", "Waktu Piawai Moscow", "Waktu Musim Panas Moscow"},
+		{"Waktu New Zealand", "Waktu Piawai New Zealand", "Waktu Siang New Zealand"},
+		{"Waktu Newfoundland", "Waktu Piawai Newfoundland", "Waktu Siang Newfoundland"},
+		{"Waktu Pakistan", "Waktu Piawai Pakistan", "Waktu Musim Panas Pakistan"},
+		{"Waktu Peru", "Waktu Piawai Peru", "Waktu Musim Panas Peru"},
+		{"Waktu Filipina", "Waktu Piawai Filipina", "Waktu Musim Panas Filipina"},
+		{"", "Waktu Piawai Singapura", ""},
+		{"Waktu Taipei", "Waktu Piawai Taipei", "Waktu Siang Taipei"},
+		{"", "Waktu Venezuela", ""},
+		{"", "Waktu Universal Selaras", ""},
+		{"", "", "Waktu Piawai Ireland"},
+		{"", "", "Waktu Musim Panas British"},
+		{"", "", ""},
+	},
+	"mt": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Ħin Ċentrali Ewropew", "Ħin Ċentrali Ewropew Standard", "Ħin Ċentrali Ewropew tas-Sajf"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"my": {
+		{"", "အလယ်အာဖရိက အချိန်", ""},
+		{"", "အရှေ့အာဖရိက အချိန်", ""},
+		{"", "တောင်အာဖရိက အချိန်", ""},
+		{"အနောက်အာဖရိက အချိန်", "အနောက်အာဖရိက စံတော်ချိန်", "အနောက်အာဖရိက နွေရာသီ အချိန်"},
+		{"အလာစကာ အချိန်", "အလာစကာ စံတော်ချိန်", "အလာစကာ နွေရာသီ စံတော်ချိန်"},
+		{"မြောက်အမေရိက အလယ်ပိုင်းအချိန်", "မြောက်အမေရိက အလယ်ပိုင်းစံတော်ချိန်", "မြောက်အမေရိက အလယ်ပိုင်း နွေရာသီစံတော်ချိန်"},
+		{"မြောက်အမေရိက အရှေ့ပိုင်းအချိန်", "မြောက်အမေရိက အရှေ့ပိုင်းစံတော်ချိန်", "မြောက်အမေရိက အရှေ့ပိုင်း နွေရာသီစံတော်ချိန်"},
+		{"မြောက်အမေရိက တောင်တန်းဒေသအချိန်", "မြောက်အမေရိက တောင်တန်းဒေသစံတော်ချိန်", "မြောက်အမေရိက တောင်တန်းဒေသ နွေရာသီစံတော်ချိန်"},
+		{"မြောက်အမေရိက ပစိဖိတ်အချိန်", "မြောက်အမေရိက ပစိဖိတ်စံတော်ချိန်", "မြောက်အမေရိက ပစိဖိတ် နွေရာသီစံတော်ချိန်"},
+		{"အာရေဗျ အချိန်", "အာရေဗျ စံတော်ချိန်", "အာရေဗျ နွေရာသီ အချိန်"},
+		{"အာဂျင်တီးနား အချိန်", "အာဂျင်တီးနား စံတော်ချိန်", "အာဂျင်တီးနား နွေရာသီအချိန်"},
+		{"အတ္တလန်တစ် အချိန်", "အတ္တလန်တစ် စံတော်ချိန်", "အတ္တလန်တစ် နွေရာသီ စံတော်ချိန်"},
+		{"ဩစတြေးလျ အလယ်ပိုင်း အချိန်", "ဩစတြေးလျ အလယ်ပိုင်း စံတော်ချိန်", "ဩစတြေးလျ အလယ်ပိုင်း နွေရာသီ အချိန်"},
+		{"အရှေ့ဩစတြေးလျ အချိန်", "အရှေ့ဩစတြေးလျ စံတော်ချိန်", "အရှေ့ဩစတြေးလျ နွေရာသီ အချိန်"},
+		{"အနောက်ဩစတြေးလျ အချိန်", "အနောက်ဩစတြေးလျ စံတော်ချိန်", "ဩစတြေးလျ နွေရာသီ အချိန်"},
+		{"ဘင်္ဂလားဒေ့ရှ် အချိန်", "ဘင်္ဂလားဒေ့ရှ် စံတော်ချိန်", "ဘင်္ဂလားဒေ့ရှ် နွေရာသီ အချိန်"},
+		{"ဘရာဇီး အချိန်", "ဘရာဇီး စံတော်ချိန်", "ဘရာဇီး နွေရာသီ အချိန်"},
+		{"ချီလီ အချိန်", "ချီလီ စံတော်ချိန်", "ချီလီ နွေရာသီ အချိန်"},
+		{"တရုတ် အချိန်", "တရုတ် စံတော်ချိန်", "တရုတ် နွေရာသီ အချိန်"},
+		{"ကိုလံဘီယာ အချိန်", "ကိုလံဘီယာ စံတော်ချိန်", "ကိုလံဘီယာ နွေရာသီ အချိန်"},
+		{"ဥရောပအလယ်ပိုင်း အချိန်", "ဥရောပအလယ်ပိုင်း စံတော်ချိန်", "ဥရောပအလယ်ပိုင်း နွေရာသီ အချိန်"},
+		{"အရှေ့ဥရောပ အချိန်", "အရှေ့ဥရောပ စံတော်ချိန်", "အရှေ့ဥရောပ နွေရာသီ အချိန်"},
+		{"အနောက်ဥရောပ အချိန်", "အနောက်ဥရောပ စံတော်ချိန်", "အနောက်ဥရောပ နွေရာသီ အချိန်"},
+		{"", "ဂရင်းနစ် စံတော်ချိန်", ""},
+		{"", "ပင်လယ်ကွေ့ အချိန်", ""},
+		{"ဟာဝိုင်ယီ အယ်လူးရှန်း အချိန်", "ဟာဝိုင်ယီ အယ်လူးရှန်း စံတော်ချိန်", "ဟာဝိုင်ယီ အယ်လူးရှန်း နွေရာသီ စံတော်ချိန်"},
+		{"ဟောင်ကောင် အချိန်", "ဟောင်ကောင် စံတော်ချိန်", "ဟောင်ကောင် နွေရာသီ အချိန်"},
+		{"", "အိန္ဒိယ စံတော်ချိန်", ""},
+		{"", "အင်ဒိုချိုင်းနား အချိန်", ""},
+		{"", "အနောက်ပိုင်း အင်ဒိုနီးရှား အချိန်", ""},
+		{"အီရန် အချိန်", "အီရန် စံတော်ချိန်", "အီရန် နွေရာသီ အချိန်"},
+		{"အစ္စရေး အချိန်", "အစ္စရေး စံတော်ချိန်", "အစ္စရေး နွေရာသီ အချိန်"},
+		{"ဂျပန် အချိန်", "ဂျပန် စံတော်ချိန်", "ဂျပန် နွေရာသီ အချိန်"},
+		{"ကိုရီးယား အချိန်", "ကိုရီးယား စံတော်ချိန်", "ကိုရီးယား နွေရာသီ အချိန်"},
+		{"", "မလေးရှား အချိန်", ""},
+		{"မော်စကို အချိန်", "မော်စကို စံတော်ချိန်", "မော်စကို နွေရာသီ အချိန်"},
+		{"နယူးဇီလန် အချိန်", "နယူးဇီလန် စံတော်ချိန်", "နယူးဇီလန် နွေရာသီ အချိန်"},
+		{"နယူးဖောင်လန် အချိန်", "နယူးဖောင်လန် စံတော်ချိန်", "နယူးဖောင်လန် နွေရာသီ စံတော်ချိန်"},
+		{"ပါကစ္စတန် အချိန်", "ပါကစ္စတန် စံတော်ချိန်", "ပါကစ္စတန် နွေရာသီ အချိန်"},
+		{"ပီရူး အချိန်", "ပီရူး စံတော်ချိန်", "ပီရူး နွေရာသီ အချိန်"},
+		{"ဖိလစ်ပိုင် အချိန်", "ဖိလစ်ပိုင် စံတော်ချိန်", "ဖိလစ်ပိုင် နွေရာသီ အချိန်"},
+		{"", "စင်္ကာပူ အချိန်", ""},
+		{"ထိုင်ပေ အချိန်", "ထိုင်ပေ စံတော်ချိန်", "ထိုင်ပေ နွေရာသီ အချိန်"},
+		{"", "ဗင်နီဇွဲလား အချိန်", ""},
+		{"", "ညှိထားသည့် ကမ္ဘာ့ စံတော်ချိန်", ""},
+		{"", "", "အိုင်းရစ်ရှ် စံတော်ချိန်"},
+		{"", "", "ဗြိတိန် နွေရာသီ အချိန်"},
+		{"", "", ""},
+	},
+	"nb": {
+		{"", "sentralafrikansk tid", ""},
+		{"", "østafrikansk tid", ""},
+		{"", "sørafrikansk tid", ""},
+		{"vestafrikansk tid", "vestafrikansk normaltid", "vestafrikansk sommertid"},
+		{"alaskisk tid", "alaskisk normaltid", "alaskisk sommertid"},
+		{"tidssone for det sentrale Nord-Amerika", "normaltid for det sentrale Nord-Amerika", "sommertid for det sentrale Nord-Amerika"},
+		{"tidssone for den nordamerikanske østkysten", "normaltid for den nordamerikanske østkysten", "sommertid for den nordamerikanske østkysten"},
+		{"tidssone for Rocky Mountains (USA)", "normaltid for Rocky Mountains (USA)", "sommertid for Rocky Mountains (USA)"},
+		{"tidssone for den nordamerikanske Stillehavskysten", "normaltid for den nordamerikanske Stillehavskysten", "sommertid for den nordamerikanske Stillehavskysten"},
+		{"arabisk tid", "arabisk standardtid", "arabisk sommertid"},
+		{"argentinsk tid", "argentinsk normaltid", "argentinsk sommertid"},
+		{"tidssone for den nordamerikanske atlanterhavskysten", "normaltid for den nordamerikanske atlanterhavskysten", "sommertid for den nordamerikanske atlanterhavskysten"},
+		{"sentralaustralsk tid", "sentralaustralsk normaltid", "sentralaustralsk sommertid"},
+		{"østaustralsk tid", "østaustralsk normaltid", "østaustralsk sommertid"},
+		{"vestaustralsk tid", "vestaustralsk normaltid", "vestaustralsk sommertid"},
+		{"bangladeshisk tid", "bangladeshisk normaltid", "bangladeshisk sommertid"},
+		{"tidssone for Brasilia", "normaltid for Brasilia", "sommertid for Brasilia"},
+		{"chilensk tid", "chilensk normaltid", "chilensk sommertid"},
+		{"kinesisk tid", "kinesisk normaltid", "kinesisk sommertid"},
+		{"colombiansk tid", "colombiansk normaltid", "colombiansk sommertid"},
+		{"sentraleuropeisk tid", "sentraleuropeisk normaltid", "sentraleuropeisk sommertid"},
+		{"østeuropeisk tid", "østeuropeisk normaltid", "østeuropeisk sommertid"},
+		{"vesteuropeisk tid", "vesteuropeisk normaltid", "vesteuropeisk sommertid"},
+		{"", "Greenwich middeltid", ""},
+		{"", "tidssone for Persiabukta", ""},
+		{"tidssone for Hawaii og Aleutene", "normaltid for Hawaii og Aleutene", "sommertid for Hawaii og Aleutene"},
+		{"tidssone for Hongkong", "normaltid for Hongkong", "sommertid for Hongkong"},
+		{"", "indisk tid", ""},
+		{"", "indokinesisk tid", ""},
+		{"", "vestindonesisk tid", ""},
+		{"iransk tid", "iransk normaltid", "iransk sommertid"},
+		{"israelsk tid", "israelsk normaltid", "israelsk sommertid"},
+		{"japansk tid", "japansk normaltid", "japansk sommertid"},
+		{"koreansk tid", "koreansk normaltid", "koreansk sommertid"},
+		{"", "malaysisk tid", ""},
+		{"tidssone for Moskva", "normaltid for Moskva", "sommertid for Moskva"},
+		{"newzealandsk tid", "newzealandsk normaltid", "newzealandsk sommertid"},
+		{"tidssone for Newfoundland", "normaltid for Newfoundland", "sommertid for Newfoundland"},
+		{"pakistansk tid", "pakistansk normaltid", "pakistansk sommertid"},
+		{"peruansk tid", "peruansk normaltid", "peruansk sommertid"},
+		{"filippinsk tid", "filippinsk normaltid", "filippinsk sommertid"},
+		{"", "singaporsk tid", ""},
+		{"tidssone for Taipei", "normaltid for Taipei", "sommertid for Taipei"},
+		{"", "venezuelansk tid", ""},
+		{"", "koordinert universaltid", ""},
+		{"", "", "irsk sommertid"},
+		{"", "", "britisk sommertid"},
+		{"", "", ""},
+	},
+	"ne": {
+		{"", "केन्द्रीय अफ्रिकी समय", ""},
+		{"", "पूर्वी अफ्रिकी समय", ""},
+		{"", "दक्षिण अफ्रिकी समय", ""},
+		{"पश्चिम अफ्रिकी समय", "पश्चिम अफ्रिकी मानक समय", "पश्चिम अफ्रिकी ग्रीष्मकालीन समय"},
+		{"अलस्काको समय", "अलस्काको मानक समय", "अलस्काको दिवा समय"},
+		{"केन्द्रीय समय", "केन्द्रीय मानक समय", "केन्द्रीय दिवा समय"},
+		{"पूर्वी समय", "पूर्वी मानक समय", "पूर्वी दिवा समय"},
+		{"हिमाली समय", "हिमाली मानक समय", "हिमाली दिवा समय"},
+		{"प्यासिफिक समय", "प्यासिफिक मानक समय", "प्यासिफिक दिवा समय"},
+		{"अरबी समय", "अरबी मानक समय", "अरबी दिवा समय"},
+		{"अर्जेनटिनी समय", "अर्जेनटिनी मानक समय", "अर्जेनटिनी ग्रीष्मकालीन समय"},
+		{"एट्लान्टिक समय", "एट्लान्टिक मानक समय", "एट्लान्टिक दिवा समय"},
+		{"केन्द्रीय अस्ट्रेलिया समय", "केन्द्रीय अस्ट्रेलिया मानक समय", "केन्द्रीय अस्ट्रेलिया दिवा समय"},
+		{"पूर्वी अस्ट्रेलिया समय", "पूर्वी अस्ट्रेलिया मानक समय", "पूर्वी अस्ट्रेलिया दिवा समय"},
+		{"पश्चिमी अस्ट्रेलिया समय", "पश्चिमी अस्ट्रेलिया मानक समय", "पश्चिमी अस्ट्रेलिया दिवा समय"},
+		{"बंगलादेशी समय", "बंगलादेशी मानक समय", "बंगलादेशी ग्रीष्मकालीन समय"},
+		{"ब्राजिलीया समय", "ब्राजिलिया मानक समय", "ब्राजिलीया ग्रीष्मकालीन समय"},
+		{"चिली समय", "चिली मानक समय", "चिली ग्रीष्मकालीन समय"},
+		{"चीन समय", "चीन मानक समय", "चीन दिवा समय"},
+		{"कोलम्बियाली समय", "कोलम्बियाली मानक समय", "कोलम्बियाली ग्रीष्मकालीन समय"},
+		{"केन्द्रीय युरोपेली समय", "केन्द्रीय युरोपेली मानक समय", "केन्द्रीय युरोपेली ग्रीष्मकालीन समय"},
+		{"पूर्वी युरोपेली समय", "पूर्वी युरोपेली मानक समय", "पूर्वी युरोपेली ग्रीष्मकालीन समय"},
+		{"पश्चिमी युरोपेली समय", "पश्चिमी युरोपेली मानक समय", "युरोपेली ग्रीष्मकालीन समय"},
+		{"", "ग्रीनविच मिन समय", ""},
+		{"", "खाडी मानक समय", ""},
+		{"हवाई-एलुटियन समय", "हवाई-एलुटियन मानक समय", "हवाई-एलुटियन दिवा समय"},
+		{"हङकङ समय", "हङकङ मानक समय", "हङकङ ग्रीष्मकालीन समय"},
+		{"", "भारतीय मानक समय", ""},
+		{"", "इन्डोचाइना समय", ""},
+		{"", "पश्चिमी इन्डोनेशिया समय", ""},
+		{"इरानी समय", "इरानी मानक समय", "इरानी दिवा समय"},
+		{"इजरायल समय", "इजरायल मानक समय", "इजरायल दिवा समय"},
+		{"जापान समय", "जापान मानक समय", "जापान दिवा समय"},
+		{"कोरियाली समय", "कोरियाली मानक समय", "कोरियाली दिवा समय"},
+		{"", "मलेसिया समय", ""},
+		{"मस्को समय", "मस्को मानक समय", "मस्को ग्रीष्मकालीन समय"},
+		{"न्यूजिल्यान्ड समय", "न्यूजिल्यान्ड मानक समय", "न्यूजिल्यान्ड दिवा समय"},
+		{"न्यूफाउन्डल्यान्डको समय", "न्यूफाउनडल्यान्डको मानक समय", "न्यूफाउनल्यान्डको दिवा समय"},
+		{"पाकिस्तानी समय", "पाकिस्तानी मानक समय", "पाकिस्तानी ग्रीष्मकालीन समय"},
+		{"पेरु समय", "पेरू मानक समय", "पेरु ग्रीष्मकालीन समय"},
+		{"फिलिपिनी समय", "फिलिपिनी मानक समय", "फिलिपिनी ग्रीष्मकालीन समय"},
+		{"", "सिंगापुर मानक समय", ""},
+		{"ताइपेइ समय", "ताइपेइ मानक समय", "ताइपेइ दिवा समय"},
+		{"", "भेनेज्युएला समय", ""},
+		{"", "समन्वित विश्व समय", ""},
+		{"", "", "आइरिश मानक समय"},
+		{"", "", "बेलायती ग्रीष्मकालीन समय"},
+		{"", "", ""},
+	},
+	"nl": {
+		{"", "Centraal-Afrikaanse tijd", ""},
+		{"", "Oost-Afrikaanse tijd", ""},
+		{"", "Zuid-Afrikaanse tijd", ""},
+		{"West-Afrikaanse tijd", "West-Afrikaanse standaardtijd", "West-Afrikaanse zomertijd"},
+		{"Alaska-tijd", "Alaska-standaardtijd", "Alaska-zomertijd"},
+		{"Central-tijd", "Central-standaardtijd", "Central-zomertijd"},
+		{"Eastern-tijd", "Eastern-standaardtijd", "Eastern-zomertijd"},
+		{"Mountain-tijd", "Mountain-standaardtijd", "Mountain-zomertijd"},
+		{"Pacific-tijd", "Pacific-standaardtijd", "Pacific-zomertijd"},
+		{"Arabische tijd", "Arabische standaardtijd", "Arabische zomertijd"},
+		{"Argentijnse tijd", "Argentijnse standaardtijd", "Argentijnse zomertijd"},
+		{"Atlantic-tijd", "Atlantic-standaardtijd", "Atlantic-zomertijd"},
+		{"Midden-Australische tijd", "Midden-Australische standaardtijd", "Midden-Australische zomertijd"},
+		{"Oost-Australische tijd", "Oost-Australische standaardtijd", "Oost-Australische zomertijd"},
+		{"West-Australische tijd", "West-Australische standaardtijd", "West-Australische zomertijd"},
+		{"Bengalese tijd", "Bengalese standaardtijd", "Bengalese zomertijd"},
+		{"Braziliaanse tijd", "Braziliaanse standaardtijd", "Braziliaanse zomertijd"},
+		{"Chileense tijd", "Chileense standaardtijd", "Chileense zomertijd"},
+		{"Chinese tijd", "Chinese standaardtijd", "Chinese zomertijd"},
+		{"Colombiaanse tijd", "Colombiaanse standaardtijd", "Colombiaanse zomertijd"},
+		{"Midden-Europese tijd", "Midden-Europese standaardtijd", "Midden-Europese zomertijd"},
+		{"Oost-Europese tijd", "Oost-Europese standaardtijd", "Oost-Europese zomertijd"},
+		{"West-Europese tijd", "West-Europese standaardtijd", "West-Europese zomertijd"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Golf-standaardtijd", ""},
+		{"Hawaii-Aleoetische tijd", "Hawaii-Aleoetische standaardtijd", "Hawaii-Aleoetische zomertijd"},
+		{"Hongkongse tijd", "Hongkongse standaardtijd", "Hongkongse zomertijd"},
+		{"", "Indiase tijd", ""},
+		{"", "Indochinese tijd", ""},
+		{"", "West-Indonesische tijd", ""},
+		{"Iraanse tijd", "Iraanse standaardtijd", "Iraanse zomertijd"},
+		{"Israëlische tijd", "Israëlische standaardtijd", "Israëlische zomertijd"},
+		{"Japanse tijd", "Japanse standaardtijd", "Japanse zomertijd"},
+		{"Koreaanse tijd", "Koreaanse standaardtijd", "Koreaanse zomertijd"},
+		{"", "Maleisische tijd", ""},
+		{"Moskou-tijd", "Moskou-standaardtijd", "Moskou-zomertijd"},
+		{"Nieuw-Zeelandse tijd", "Nieuw-Zeelandse standaardtijd", "Nieuw-Zeelandse zomertijd"},
+		{"Newfoundland-tijd", "Newfoundland-standaardtijd", "Newfoundland-zomertijd"},
+		{"Pakistaanse tijd", "Pakistaanse standaardtijd", "Pakistaanse zomertijd"},
+		{"Peruaanse tijd", "Peruaanse standaardtijd", "Peruaanse zomertijd"},
+		{"Filipijnse tijd", "Filipijnse standaardtijd", "Filipijnse zomertijd"},
+		{"", "Singaporese standaardtijd", ""},
+		{"Taipei-tijd", "Taipei-standaardtijd", "Taipei-zomertijd"},
+		{"", "Venezolaanse tijd", ""},
+		{"", "Gecoördineerde wereldtijd", ""},
+		{"", "", "Ierse standaardtijd"},
+		{"", "", "Britse zomertijd"},
+		{"", "", ""},
+	},
+	"nn": {
+		{"", "sentralafrikansk tid", ""},
+		{"", "austafrikansk tid", ""},
+		{"", "sørafrikansk tid", ""},
+		{"vestafrikansk tid", "vestafrikansk standardtid", "vestafrikansk sommartid"},
+		{"alaskisk tid", "alaskisk normaltid", "alaskisk sumartid"},
+		{"tidssone for sentrale Nord-Amerika", "normaltid for sentrale Nord-Amerika", "sumartid for sentrale Nord-Amerika"},
+		{"tidssone for den nordamerikansk austkysten", "normaltid for den nordamerikansk austkysten", "sumartid for den nordamerikansk austkysten"},
+		{"tidssone for Rocky Mountains (USA)", "normaltid for Rocky Mountains (USA)", "sumartid for Rocky Mountains (USA)"},
+		{"tidssone for den nordamerikanske stillehavskysten", "normaltid for den nordamerikanske stillehavskysten", "sumartid for den nordamerikanske stillehavskysten"},
+		{"arabisk tid", "arabisk normaltid", "arabisk sumartid"},
+		{"argentinsk tid", "argentinsk normaltid", "argentinsk sumartid"},
+		{"tidssone for den nordamerikanske atlanterhavskysten", "normaltid for den nordamerikanske atlanterhavskysten", "sumartid for den nordamerikanske atlanterhavskysten"},
+		{"sentralaustralsk tid", "sentralaustralsk standardtid", "sentralaustralsk sommartid"},
+		{"austaustralsk tid", "austaustralsk standardtid", "austaustralsk sommartid"},
+		{"vestaustralsk tid", "vestaustralsk standardtid", "vestaustralsk sommartid"},
+		{"bangladeshisk tid", "bangladeshisk normaltid", "bangladeshisk sumartid"},
+		{"tidssone for Brasilia", "normaltid for Brasilia", "sumartid for Brasilia"},
+		{"chilensk tid", "chilensk normaltid", "chilensk sumartid"},
+		{"kinesisk tid", "kinesisk normaltid", "kinesisk sumartid"},
+		{"kolombiansk tid", "kolombiansk normaltid", "kolombiansk sumartid"},
+		{"sentraleuropeisk tid", "sentraleuropeisk standardtid", "sentraleuropeisk sommartid"},
+		{"austeuropeisk tid", "austeuropeisk standardtid", "austeuropeisk sommartid"},
+		{"vesteuropeisk tid", "vesteuropeisk standardtid", "vesteuropeisk sommartid"},
+		{"", "Greenwich middeltid", ""},
+		{"", "tidssone for Persiabukta", ""},
+		{"tidssone for Hawaii og Aleutene", "normaltid for Hawaii og Aleutene", "sumartid for Hawaii og Aleutene"},
+		{"hongkongkinesisk tid", "hongkongkinesisk normaltid", "hongkongkinesisk sumartid"},
+		{"", "indisk tid", ""},
+		{"", "indokinesisk tid", ""},
+		{"", "vestindonesisk tid", ""},
+		{"iransk tid", "iransk normaltid", "iransk sumartid"},
+		{"israelsk tid", "israelsk normaltid", "israelsk sumartid"},
+		{"japansk tid", "japansk normaltid", "japansk sumartid"},
+		{"koreansk tid", "koreansk normaltid", "koreansk sumartid"},
+		{"", "malaysisk tid", ""},
+		{"tidssone for Moskva", "normaltid for Moskva", "sumartid for Moskva"},
+		{"nyzealandsk tid", "nyzealandsk normaltid", "nyzealandsk sumartid"},
+		{"tidssone for Newfoundland", "normaltid for Newfoundland", "sumartid for Newfoundland"},
+		{"pakistansk tid", "pakistansk normaltid", "pakistansk sumartid"},
+		{"peruansk tid", "peruansk normaltid", "peruansk sumartid"},
+		{"filippinsk tid", "filippinsk normaltid", "filippinsk sumartid"},
+		{"", "singaporsk tid", ""},
+		{"tidssone for Taipei", "normaltid for Taipei", "sumartid for Taipei"},
+		{"", "venezuelansk tid", ""},
+		{"", "koordinert universaltid", ""},
+		{"", "", "irsk sumartid"},
+		{"", "", "britisk sumartid"},
+		{"", "", ""},
+	},
+	"or": {
+		{"", "ମଧ୍ୟ ଆଫ୍ରିକା ସମୟ", ""},
+		{"", "ପୂର୍ବ ଆଫ୍ରିକା ସମୟ", ""},
+		{"", "ଦକ୍ଷିଣ ଆଫ୍ରିକା ମାନାଙ୍କ ସମୟ", ""},
+		{"ପଶ୍ଚିମ ଆଫ୍ରିକା ସମୟ", "ପଶ୍ଚିମ ଆଫ୍ରିକା ମାନାଙ୍କ ସମୟ", "ପଶ୍ଚିମ ଆଫ୍ରିକା ଖରାଦିନ ସମୟ"},
+		{"ଆଲାସ୍କା ସମୟ", "ଆଲାସ୍କା ମାନାଙ୍କ ସମୟ", "ଆଲାସ୍କା ଦିବାଲୋକ ସମୟ"},
+		{"କେନ୍ଦ୍ରୀୟ ସମୟ", "କେନ୍ଦ୍ରୀୟ ମାନାଙ୍କ ସମୟ", "କେନ୍ଦ୍ରୀୟ ଦିବାଲୋକ ସମୟ"},
+		{"ପୂର୍ବାଞ୍ଚଳ ସମୟ", "ପୂର୍ବାଞ୍ଚଳ ମାନାଙ୍କ ସମୟ", "ପୂର୍ବାଞ୍ଚଳ ଦିବାଲୋକ ସମୟ"},
+		{"ପାର୍ବତ୍ୟ ସମୟ", "ପାର୍ବତ୍ୟ ମାନାଙ୍କ ସମୟ", "ପାର୍ବତ୍ୟ ଦିବାଲୋକ ସମୟ"},
+		{"ପାସିଫିକ୍ ସମୟ", "ପାସିଫିକ୍ ମାନାଙ୍କ ସମୟ", "ପାସିଫିକ୍ ଦିବାଲୋକ ସମୟ"},
+		{"ଆରବୀୟ ସମୟ", "ଆରବୀୟ ମାନାଙ୍କ ସମୟ", "ଆରବୀୟ ଦିବାଲୋକ ସମୟ"},
+		{"ଆର୍ଜେଣ୍ଟିନା ସମୟ", "ଆର୍ଜେଣ୍ଟିନା ମାନାଙ୍କ ସମୟ", "ଆର୍ଜେଣ୍ଟିନା ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ଆଟଲାଣ୍ଟିକ୍ ସମୟ", "ଆଟଲାଣ୍ଟିକ୍ ମାନାଙ୍କ ସମୟ", "ଆଟଲାଣ୍ଟିକ୍ ଦିବାଲୋକ ସମୟ"},
+		{"ମଧ୍ୟ ଅଷ୍ଟ୍ରେଲିଆ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ମଧ୍ୟ ମାନାଙ୍କ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ମଧ୍ୟ ଦିବାଲୋକ ସମୟ"},
+		{"ପୂର୍ବ ଅଷ୍ଟ୍ରେଲିଆ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ପୂର୍ବ ମାନାଙ୍କ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ପୂର୍ବ ଦିବାଲୋକ ସମୟ"},
+		{"ପଶ୍ଚିମ ଅଷ୍ଟ୍ରେଲିଆ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ପଶ୍ଚିମ ମାନାଙ୍କ ସମୟ", "ଅଷ୍ଟ୍ରେଲିୟ ପଶ୍ଚିମ ଦିବାଲୋକ ସମୟ"},
+		{"ବାଂଲାଦେଶ ସମୟ", "ବାଂଲାଦେଶ ମାନାଙ୍କ ସମୟ", "ବାଂଲାଦେଶ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ବ୍ରାସିଲିଆ ସମୟ", "ବ୍ରାସିଲିଆ ମାନାଙ୍କ ସମୟ", "ବ୍ରାସିଲିଆ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ଚିଲି ସମୟ", "ଚିଲି ମାନାଙ୍କ ସମୟ", "ଚିଲି ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ଚୀନ ସମୟ", "ଚୀନ ମାନାଙ୍କ ସମୟ", "ଚୀନ ଦିବାଲୋକ ସମୟ"},
+		{"କଲମ୍ବିଆ ସମୟ", "କଲମ୍ବିଆ ମାନାଙ୍କ ସମୟ", "କଲମ୍ବିଆ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"କେନ୍ଦ୍ରୀୟ ୟୁରୋପୀୟ ସମୟ", "କେନ୍ଦ୍ରୀୟ ୟୁରୋପୀୟ ମାନାଙ୍କ ସମୟ", "କେନ୍ଦ୍ରୀୟ ୟୁରୋପୀୟ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ପୂର୍ବାଞ୍ଚଳ ୟୁରୋପୀୟ ସମୟ", "ପୂର୍ବାଞ୍ଚଳ ୟୁରୋପୀୟ ମାନାଙ୍କ ସମୟ", "ପୂର୍ବାଞ୍ଚଳ ୟୁରୋପୀୟ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ପଶ୍ଚିମାଞ୍ଚଳ ୟୁରୋପୀୟ ସମୟ", "ପଶ୍ଚିମାଞ୍ଚଳ ୟୁରୋପୀୟ ମାନାଙ୍କ ସମୟ", "ପଶ୍ଚିମାଞ୍ଚଳ ୟୁରୋପୀୟ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"", "ଗ୍ରୀନୱିଚ୍ ମିନ୍ ସମୟ", ""},
+		{"", "ଗଲ୍ଫ ମାନାଙ୍କ ସମୟ", ""},
+		{"ହୱାଇ-ଆଲେଉଟିୟ ସମୟ", "ହୱାଇ-ଆଲେଉଟିୟ ମାନାଙ୍କ ସମୟ", "ହୱାଇ-ଆଲେଉଟିୟ ଦିବାଲୋକ ସମୟ"},
+		{"ହଂ କଂ ସମୟ", "ହଂ କଂ ମାନାଙ୍କ ସମୟ", "ହଂ କଂ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"", "ଭାରତ ମାନାଙ୍କ ସମୟ", ""},
+		{"", "ଇଣ୍ଡୋଚାଇନା ସମୟ", ""},
+		{"", "ପଶ୍ଚିମ ଇଣ୍ଡୋନେସିଆ ସମୟ", ""},
+		{"ଇରାନ ସମୟ", "ଇରାନ ମାନାଙ୍କ ସମୟ", "ଇରାନ ଦିବାଲୋକ ସମୟ"},
+		{"ଇସ୍ରାଏଲ ସମୟ", "ଇସ୍ରାଏଲ ମାନାଙ୍କ ସମୟ", "ଇସ୍ରାଏଲ ଦିବାଲୋକ ସମୟ"},
+		{"ଜାପାନ ସମୟ", "ଜାପାନ ମାନାଙ୍କ ସମୟ", "ଜାପାନ ଦିବାଲୋକ ସମୟ"},
+		{"କୋରିୟ ସମୟ", "କୋରିୟ ମାନାଙ୍କ ସମୟ", "କୋରିୟ ଦିବାଲୋକ ସମୟ"},
+		{"", "ମାଲେସିଆ ସମୟ", ""},
+		{"ମସ୍କୋ ସମୟ", "ମସ୍କୋ ମାନାଙ୍କ ସମୟ", "ମସ୍କୋ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ନ୍ୟୁଜିଲାଣ୍ଡ ସମୟ", "ନ୍ୟୁଜିଲାଣ୍ଡ ମାନାଙ୍କ ସମୟ", "ନ୍ୟୁଜିଲାଣ୍ଡ ଦିବାଲୋକ ସମୟ"},
+		{"ନ୍ୟୁଫାଉଣ୍ଡଲ୍ୟାଣ୍ଡ୍ ସମୟ", "ନ୍ୟୁଫାଉଣ୍ଡଲ୍ୟାଣ୍ଡ୍ ମାନାଙ୍କ ସମୟ", "ନ୍ୟୁଫାଉଣ୍ଡଲ୍ୟାଣ୍ଡ୍ ଦିବାଲୋକ ସମୟ"},
+		{"ପାକିସ୍ତାନ ସମୟ", "ପାକିସ୍ତାନ ମାନାଙ୍କ ସମୟ", "ପାକିସ୍ତାନ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ପେରୁ ସମୟ", "ପେରୁ ମାନାଙ୍କ ସମୟ", "ପେରୁ ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"ଫିଲିପାଇନ୍\u200c ସମୟ", "ଫିଲିପାଇନ୍\u200c ମାନାଙ୍କ ସମୟ", "ଫିଲିପାଇନ୍\u200c ଗ୍ରୀଷ୍ମକାଳ ସମୟ"},
+		{"", "ସିଙ୍ଗାପୁର୍\u200c ମାନାଙ୍କ ସମୟ", ""},
+		{"ତାଇପେଇ ସମୟ", "ତାଇପେଇ ମାନାଙ୍କ ସମୟ", "ତାଇପେଇ ଦିବାଲୋକ ସମୟ"},
+		{"", "ଭେନିଜୁଏଲା ସମୟ", ""},
+		{"", "ସମନ୍ୱିତ ସାର୍ବଜନୀନ ସମୟ", ""},
+		{"", "", "ଆଇରିଶ୍\u200c ମାନାଙ୍କ ସମୟ"},
+		{"", "", "ବ୍ରିଟିଶ୍\u200c ମାନାଙ୍କ ସମୟ"},
+		{"", "", ""},
+	},
+	"os": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Астӕуккаг Европӕйаг рӕстӕг", "Астӕуккаг Европӕйаг стандартон рӕстӕг", "Астӕуккаг Европӕйаг сӕрдыгон рӕстӕг"},
+		{"Скӕсӕн Европӕйаг рӕстӕг", "Скӕсӕн Европӕйаг стандартон рӕстӕг", "Скӕсӕн Европӕйаг сӕрдыгон рӕстӕг"},
+		{"Ныгъуылӕн Европӕйаг рӕстӕг", "Ныгъуылӕн Европӕйаг стандартон рӕстӕг", "Ныгъуылӕн Европӕйаг сӕрдыгон рӕстӕг"},
+		{"", "Гринвичы рӕстӕмбис рӕстӕг", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Мӕскуыйы рӕстӕг", "Мӕскуыйы стандартон рӕстӕг", "Мӕскуыйы сӕрдыгон рӕстӕг"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"pa": {
+		{"", "ਕੇਂਦਰੀ ਅਫਰੀਕਾ ਵੇਲਾ", ""},
+		{"", "ਪੂਰਬੀ ਅਫਰੀਕਾ ਵੇਲਾ", ""},
+		{"", "ਦੱਖਣੀ ਅਫ਼ਰੀਕਾ ਮਿਆਰੀ ਵੇਲਾ", ""},
+		{"ਪੱਛਮੀ ਅਫਰੀਕਾ ਵੇਲਾ", "ਪੱਛਮੀ ਅਫਰੀਕਾ ਮਿਆਰੀ ਵੇਲਾ", "ਪੱਛਮੀ ਅਫਰੀਕਾ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਅਲਾਸਕਾ ਵੇਲਾ", "ਅਲਾਸਕਾ ਮਿਆਰੀ ਵੇਲਾ", "ਅਲਾਸਕਾ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਉੱਤਰੀ ਅਮਰੀਕੀ ਕੇਂਦਰੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਕੇਂਦਰੀ ਮਿਆਰੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਕੇਂਦਰੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੂਰਬੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੂਰਬੀ ਮਿਆਰੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੂਰਬੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਉੱਤਰੀ ਅਮਰੀਕੀ ਮਾਉਂਟੇਨ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਮਾਉਂਟੇਨ ਮਿਆਰੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਮਾਉਂਟੇਨ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੈਸਿਫਿਕ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੈਸਿਫਿਕ ਮਿਆਰੀ ਵੇਲਾ", "ਉੱਤਰੀ ਅਮਰੀਕੀ ਪੈਸਿਫਿਕ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਅਰਬੀ ਵੇਲਾ", "ਅਰਬੀ ਮਿਆਰੀ ਵੇਲਾ", "ਅਰਬੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਅਰਜਨਟੀਨਾ ਵੇਲਾ", "ਅਰਜਨਟੀਨਾ ਮਿਆਰੀ ਵੇਲਾ", "ਅਰਜਨਟੀਨਾ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਅਟਲਾਂਟਿਕ ਵੇਲਾ", "ਅਟਲਾਂਟਿਕ ਮਿਆਰੀ ਵੇਲਾ", "ਅਟਲਾਂਟਿਕ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਕੇਂਦਰੀ ਆਸਟ੍ਰੇਲੀਆਈ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਕੇਂਦਰੀ ਮਿਆਰੀ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਕੇਂਦਰੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਪੂਰਬੀ ਆਸਟ੍ਰੇਲੀਆਈ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਪੂਰਬੀ ਮਿਆਰੀ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਪੂਰਬੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਪੱਛਮੀ ਆਸਟ੍ਰੇਲੀਆਈ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਪੱਛਮੀ ਮਿਆਰੀ ਵੇਲਾ", "ਆਸਟ੍ਰੇਲੀਆਈ ਪੱਛਮੀ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਬੰਗਲਾਦੇਸ਼ ਵੇਲਾ", "ਬੰਗਲਾਦੇਸ਼ ਮਿਆਰੀ ਵੇਲਾ", "ਬੰਗਲਾਦੇਸ਼ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਬ੍ਰਾਜ਼ੀਲੀਆ ਵੇਲਾ", "ਬ੍ਰਾਜ਼ੀਲੀਆ ਮਿਆਰੀ ਵੇਲਾ", "ਬ੍ਰਾਜ਼ੀਲੀਆ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਚਿਲੀ ਵੇਲਾ", "ਚਿਲੀ ਮਿਆਰੀ ਵੇਲਾ", "ਚਿਲੀ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਚੀਨ ਵੇਲਾ", "ਚੀਨ ਮਿਆਰੀ ਵੇਲਾ", "ਚੀਨ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਕੋਲੰਬੀਆ ਵੇਲਾ", "ਕੋਲੰਬੀਆ ਮਿਆਰੀ ਵੇਲਾ", "ਕੋਲੰਬੀਆ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਮੱਧ ਯੂਰਪੀ ਵੇਲਾ", "ਮੱਧ ਯੂਰਪੀ ਮਿਆਰੀ ਵੇਲਾ", "ਮੱਧ ਯੂਰਪੀ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਪੂਰਬੀ ਯੂਰਪੀ ਵੇਲਾ", "ਪੂਰਬੀ ਯੂਰਪੀ ਮਿਆਰੀ ਵੇਲਾ", "ਪੂਰਬੀ ਯੂਰਪੀ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਪੱਛਮੀ ਯੂਰਪੀ ਵੇਲਾ", "ਪੱਛਮੀ ਯੂਰਪੀ ਮਿਆਰੀ ਵੇਲਾ", "ਪੱਛਮੀ ਯੂਰਪੀ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"", "ਗ੍ਰੀਨਵਿਚ ਮੀਨ ਵੇਲਾ", ""},
+		{"", "ਖਾੜੀ ਮਿਆਰੀ ਵੇਲਾ", ""},
+		{"ਹਵਾਈ-ਅਲੇਯੂਸ਼ਿਅਨ ਵੇਲਾ", "ਹਵਾਈ-ਅਲੇਯੂਸ਼ਿਅਨ ਮਿਆਰੀ ਵੇਲਾ", "ਹਵਾਈ-ਅਲੇਯੂਸ਼ਿਅਨ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਹਾਂਗ ਕਾਂਗ ਵੇਲਾ", "ਹਾਂਗ ਕਾਂਗ ਮਿਆਰੀ ਵੇਲਾ", "ਹਾਂਗ ਕਾਂਗ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"", "ਭਾਰਤੀ ਮਿਆਰੀ ਵੇਲਾ", ""},
+		{"", "ਇੰਡੋਚਾਈਨਾ ਵੇਲਾ", ""},
+		{"", "ਪੱਛਮੀ ਇੰਡੋਨੇਸ਼ੀਆ ਵੇਲਾ", ""},
+		{"ਈਰਾਨ ਵੇਲਾ", "ਈਰਾਨ ਮਿਆਰੀ ਵੇਲਾ", "ਈਰਾਨ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਇਜ਼ਰਾਈਲ ਵੇਲਾ", "ਇਜ਼ਰਾਈਲ ਮਿਆਰੀ ਵੇਲਾ", "ਇਜ਼ਰਾਈਲ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਜਪਾਨ ਵੇਲਾ", "ਜਪਾਨ ਮਿਆਰੀ ਵੇਲਾ", "ਜਪਾਨ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਕੋਰੀਆਈ ਵੇਲਾ", "ਕੋਰੀਆਈ ਮਿਆਰੀ ਵੇਲਾ", "ਕੋਰੀਆਈ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"", "ਮਲੇਸ਼ੀਆ ਵੇਲਾ", ""},
+		{"ਮਾਸਕੋ ਵੇਲਾ", "ਮਾਸਕੋ ਮਿਆਰੀ ਵੇਲਾ", "ਮਾਸਕੋ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਨਿਊਜ਼ੀਲੈਂਡ ਵੇਲਾ", "ਨਿਊਜ਼ੀਲੈਂਡ ਮਿਆਰੀ ਵੇਲਾ", "ਨਿਊਜ਼ੀਲੈਂਡ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਨਿਊਫਾਉਂਡਲੈਂਡ ਵੇਲਾ", "ਨਿਊਫਾਉਂਡਲੈਂਡ ਮਿਆਰੀ ਵੇਲਾ", "ਨਿਊਫਾਉਂਡਲੈਂਡ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"ਪਾਕਿਸਤਾਨ ਵੇਲਾ", "ਪਾਕਿਸਤਾਨ ਮਿਆਰੀ ਵੇਲਾ", "ਪਾਕਿਸਤਾਨ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਪੇਰੂ ਵੇਲਾ", "ਪੇਰੂ ਮਿਆਰੀ ਵੇਲਾ", "ਪੇਰੂ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"ਫਿਲਿਪੀਨੀ ਵੇਲਾ", "ਫਿਲਿਪੀਨੀ ਮਿਆਰੀ ਵੇਲਾ", "ਫਿਲਿਪੀਨੀ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"", "ਸਿੰਗਾਪੁਰ ਮਿਆਰੀ ਵੇਲਾ", ""},
+		{"ਤੈਪਈ ਵੇਲਾ", "ਤੈਪਈ ਮਿਆਰੀ ਵੇਲਾ", "ਤੈਪਈ ਪ੍ਰਕਾਸ਼ ਵੇਲਾ"},
+		{"", "ਵੈਨੇਜ਼ੂਏਲਾ ਵੇਲਾ", ""},
+		{"", "ਕੋਔਰਡੀਨੇਟੇਡ ਵਿਆਪਕ ਵੇਲਾ", ""},
+		{"", "", "ਆਇਰਿਸ਼ ਮਿਆਰੀ ਵੇਲਾ"},
+		{"", "", "ਬ੍ਰਿਟਿਸ਼ ਗਰਮੀਆਂ ਦਾ ਵੇਲਾ"},
+		{"", "", ""},
+	},
+	"pl": {
+		{"", "czas środkowoafrykański", ""},
+		{"", "czas wschodnioafrykański", ""},
+		{"", "czas południowoafrykański", ""},
+		{"czas zachodnioafrykański", "czas zachodnioafrykański standardowy", "czas zachodnioafrykański letni"},
+		{"Alaska", "Alaska (czas standardowy)", "Alaska (czas letni)"},
+		{"czas środkowoamerykański", "czas środkowoamerykański standardowy", "czas środkowoamerykański letni"},
+		{"czas wschodnioamerykański", "czas wschodnioamerykański standardowy", "czas wschodnioamerykański letni"},
+		{"czas górski", "czas górski standardowy", "czas górski letni"},
+		{"czas pacyficzny", "czas pacyficzny standardowy", "czas pacyficzny letni"},
+		{"Półwysep Arabski", "Półwysep Arabski (czas standardowy)", "Półwysep Arabski (czas letni)"},
+		{"Argentyna", "Argentyna (czas standardowy)", "Argentyna (czas letni)"},
+		{"czas atlantycki", "czas atlantycki standardowy", "czas atlantycki letni"},
+		{"czas środkowoaustralijski", "czas środkowoaustralijski standardowy", "czas środkowoaustralijski letni"},
+		{"czas wschodnioaustralijski", "czas wschodnioaustralijski standardowy", "czas wschodnioaustralijski letni"},
+		{"czas zachodnioaustralijski", "czas zachodnioaustralijski standardowy", "czas zachodnioaustralijski letni"},
+		{"Bangladesz", "Bangladesz (czas standardowy)", "Bangladesz (czas letni)"},
+		{"Brasília", "Brasília (czas standardowy)", "Brasília (czas letni)"},
+		{"Chile", "Chile (czas standardowy)", "Chile (czas letni)"},
+		{"Chiny", "Chiny (czas standardowy)", "Chiny (czas letni)"},
+		{"Kolumbia", "Kolumbia (czas standardowy)", "Kolumbia (czas letni)"},
+		{"czas środkowoeuropejski", "czas środkowoeuropejski standardowy", "czas środkowoeuropejski letni"},
+		{"czas wschodnioeuropejski", "czas wschodnioeuropejski standardowy", "czas wschodnioeuropejski letni"},
+		{"czas zachodnioeuropejski", "czas zachodnioeuropejski standardowy", "czas zachodnioeuropejski letni"},
+		{"", "czas uniwersalny", ""},
+		{"", "Zatoka Perska", ""},
+		{"Hawaje-Aleuty", "Hawaje-Aleuty (czas standardowy)", "Hawaje-Aleuty (czas letni)"},
+		{"Hongkong", "Hongkong (czas standardowy)", "Hongkong (czas letni)"},
+		{"", "czas indyjski", ""},
+		{"", "czas indochiński", ""},
+		{"", "Indonezja Zachodnia", ""},
+		{"Iran", "Iran (czas standardowy)", "Iran (czas letni)"},
+		{"Izrael", "Izrael (czas standardowy)", "Izrael (czas letni)"},
+		{"Japonia", "Japonia (czas standardowy)", "Japonia (czas letni)"},
+		{"Korea", "Korea (czas standardowy)", "Korea (czas letni)"},
+		{"", "Malezja", ""},
+		{"czas moskiewski", "czas moskiewski standardowy", "czas moskiewski letni"},
+		{"Nowa Zelandia", "Nowa Zelandia (czas standardowy)", "Nowa Zelandia (czas letni)"},
+		{"Nowa Fundlandia", "Nowa Fundlandia (czas standardowy)", "Nowa Fundlandia (czas letni)"},
+		{"Pakistan", "Pakistan (czas standardowy)", "Pakistan (czas letni)"},
+		{"Peru", "Peru (czas standardowy)", "Peru (czas letni)"},
+		{"Filipiny", "Filipiny (czas standardowy)", "Filipiny (czas letni)"},
+		{"", "Singapur", ""},
+		{"Tajpej", "Tajpej (czas standardowy)", "Tajpej (czas letni)"},
+		{"", "Wenezuela", ""},
+		{"", "uniwersalny czas koordynowany", ""},
+		{"", "", "Irlandia (czas letni)"},
+		{"", "", "Brytyjski czas letni"},
+		{"", "", ""},
+	},
+	"ps": {
+		{"", "منځنی افريقا وخت", ""},
+		{"", "ختيځ افريقا وخت", ""},
+		{"", "جنوبي افريقا معياري وخت", ""},
+		{"لوېديځ افريقا وخت", "لویدیځ افریقایي معیاري وخت", "د افریقا افریقا لویدیځ وخت"},
+		{"الاسکا وخت", "الاسکا معياري وخت", "د الاسکا د ورځې روښانه کول"},
+		{"مرکزي وخت", "مرکزي معياري وخت", "مرکزي رڼا ورځې وخت"},
+		{"ختیځ وخت", "ختيځ معياري وخت", "ختيځ د رڼا ورځې وخت"},
+		{"د غره د وخت", "د غره معياري وخت", "د غره د رڼا ورځې وخت"},
+		{"پیسفک وخت", "د پیسفک معياري وخت", "پیسفک د رڼا ورځې وخت"},
+		{"عربي وخت", "عربي معیاري وخت", "د عربي ورځپاڼې وخت"},
+		{"ارجنټاین وخت", "ارجنټاین معیاری وخت", "ارجنټاین اوړي وخت"},
+		{"اتلانتیک د وخت", "اتلانتیک معياري وخت", "اتلانتیک د رڼا ورځې وخت"},
+		{"د مرکزي آسټر وخت", "د اسټرالیا مرکزي مرکزي معیار", "د آسټرالیا مرکزي مرکزی ورځ"},
+		{"د ختیځ آسټر وخت", "د آسټرالیا ختیځ معیاري وخت", "د اسټرالیا ختیځ ختیځ ورځی وخت"},
+		{"د لویدیځ آسټرالیا وخت", "د اسټرالیا لویدیز معیار", "د اسټرالیا لویدیځ د ورځې وخت"},
+		{"بنگله دېش وخت", "د بنګلادیش معیاري وخت", "د بنگله دیش د سمر وخت"},
+		{"برسلیا وخت", "برسلیا معیاری وخت", "برسلیا اوړي وخت"},
+		{"چلی وخت", "چلی معیاری وخت", "چلی اوړي وخت"},
+		{"چين وخت", "چین معیاري وخت", "د چين د رڼا ورځې وخت"},
+		{"کولمبیا وخت", "کولمبیا معیاری وخت", "کولمبیا اوړي وخت"},
+		{"منځنۍ اروپا وخت", "د مرکزي اروپا معیاري وخت", "د مرکزي اروپا د اوړي وخت"},
+		{"Eastern European Time", "Eastern European Standard Time", "Eastern European Summer Time"},
+		{"لوېديزې اروپا وخت", "د لودیځې اروپا معیاري وخت", "د لودیځې اورپا د اوړي وخت"},
+		{"", "گرينويچ وخت", ""},
+		{"", "د خلیج معياري وخت", ""},
+		{"هوایی الیوتین وخت", "هوایی الیوتین معیاری وخت", "هوایی الیوتین رڼا ورځې وخت"},
+		{"د هانګ کانګ د وخت", "د هانګ کانګ معياري وخت", "د هانګ کانګ اوړي وخت"},
+		{"", "د هند معیاري وخت", ""},
+		{"", "د اندوچینا وخت", ""},
+		{"", "د لویدیځ اندونیزیا وخت", ""},
+		{"د ایران وخت", "د ایران معياري وخت", "د ایران د ورځې وخت"},
+		{"د اسراییل وخت", "د اسراییل معياري وخت", "د اسراییلو د ورځې وخت"},
+		{"جاپان د وخت", "د جاپان معياري وخت", "جاپان د رڼا ورځې وخت"},
+		{"کوريا وخت", "کوريا معياري وخت", "د کوریا د ورځې د ورځې وخت"},
+		{"", "ملائیشیا وخت", ""},
+		{"ماسکو وخت", "ماسکو معياري وخت", "ماسکو سمر وخت"},
+		{"د نیوزی لینڈ وخت", "د نیوزی لینڈ معیاري وخت", "د نیوزی لینڈ د ورځې د رڼا وخت"},
+		{"د نوي فیلډلینډ وخت", "د نوي فیلډلینډ معیاری وخت", "د نوي فیلډلینډ رڼا ورځې وخت"},
+		{"د پاکستان وخت", "د پاکستان معیاري وخت", "د پاکستان سمر وخت"},
+		{"پیرو وخت", "پیرو معياري وخت", "پیرو اوړي وخت"},
+		{"د فلپین وخت", "فلپین معياري وخت", "د فلپین سمر وخت"},
+		{"", "د سنګاپور معیاري وخت", ""},
+		{"تاپي وخت", "تاپي معياري وخت", "تاپي د ورځې د رڼا وخت"},
+		{"", "وینزویلا وخت", ""},
+		{"", "همغږۍ نړیواله موده", ""},
+		{"", "", "ایراني معیاري وخ"},
+		{"", "", "د انګلستان سمر وخت"},
+		{"", "", ""},
+	},
+	"pt": {
+		{"", "Horário da África Central", ""},
+		{"", "Horário da África Oriental", ""},
+		{"", "Horário da África do Sul", ""},
+		{"Horário da África Ocidental", "Horário Padrão da África Ocidental", "Horário de Verão da África Ocidental"},
+		{"Horário do Alasca", "Horário Padrão do Alasca", "Horário de Verão do Alasca"},
+		{"Horário Central", "Horário Padrão Central", "Horário de Verão Central"},
+		{"Horário do Leste", "Horário Padrão do Leste", "Horário de Verão do Leste"},
+		{"Horário das Montanhas", "Horário Padrão das Montanhas", "Horário de Verão das Montanhas"},
+		{"Horário do Pacífico", "Horário Padrão do Pacífico", "Horário de Verão do Pacífico"},
+		{"Horário da Arábia", "Horário Padrão da Arábia", "Horário de Verão da Arábia"},
+		{"Horário da Argentina", "Horário Padrão da Argentina", "Horário de Verão da Argentina"},
+		{"Horário do Atlântico", "Horário Padrão do Atlântico", "Horário de Verão do Atlântico"},
+		{"Horário da Austrália Central", "Horário Padrão da Austrália Central", "Horário de Verão da Austrália Central"},
+		{"Horário da Austrália Oriental", "Horário Padrão da Austrália Oriental", "Horário de Verão da Austrália Oriental"},
+		{"Horário da Austrália Ocidental", "Horário Padrão da Austrália Ocidental", "Horário de Verão da Austrália Ocidental"},
+		{"Horário de Bangladesh", "Horário Padrão de Bangladesh", "Horário de Verão de Bangladesh"},
+		{"Horário de Brasília", "Horário Padrão de Brasília", "Horário de Verão de Brasília"},
+		{"Horário do Chile", "Horário Padrão do Chile", "Horário de Verão do Chile"},
+		{"Horário da China", "Horário Padrão da China", "Horário de Verão da China"},
+		{"Horário da Colômbia", "Horário Padrão da Colômbia", "Horário de Verão da Colômbia"},
+		{"Horário da Europa Central", "Horário Padrão da Europa Central", "Horário de Verão da Europa Central"},
+		{"Horário da Europa Oriental", "Horário Padrão da Europa Oriental", "Horário de Verão da Europa Oriental"},
+		{"Horário da Europa Ocidental", "Horário Padrão da Europa Ocidental", "Horário de Verão da Europa Ocidental"},
+		{"", "Horário do Meridiano de Greenwich", ""},
+		{"", "Horário do Golfo", ""},
+		{"Horário do Havaí e Ilhas Aleutas", "Horário Padrão do Havaí e Ilhas Aleutas", "Horário de Verão do Havaí e Ilhas Aleutas"},
+		{"Horário de Hong Kong", "Horário Padrão de Hong Kong", "Horário de Verão de Hong Kong"},
+		{"", "Horário Padrão da Índia", ""},
+		{"", "Horário da Indochina", ""},
+		{"", "Horário da Indonésia Ocidental", ""},
+		{"Horário do Irã", "Horário Padrão do Irã", "Horário de Verão do Irã"},
+		{"Horário de Israel", "Horário Padrão de Israel", "Horário de Verão de Israel"},
+		{"Horário do Japão", "Horário Padrão do Japão", "Horário de Verão do Japão"},
+		{"Horário da Coreia", "Horário Padrão da Coreia", "Horário de Verão da Coreia"},
+		{"", "Horário da Malásia", ""},
+		{"Horário de Moscou", "Horário Padrão de Moscou", "Horário de Verão de Moscou"},
+		{"Horário da Nova Zelândia", "Horário Padrão da Nova Zelândia", "Horário de Verão da Nova Zelândia"},
+		{"Horário da Terra Nova", "Horário Padrão da Terra Nova", "Horário de Verão da Terra Nova"},
+		{"Horário do Paquistão", "Horário Padrão do Paquistão", "Horário de Verão do Paquistão"},
+		{"Horário do Peru", "Horário Padrão do Peru", "Horário de Verão do Peru"},
+		{"Horário das Filipinas", "Horário Padrão das Filipinas", "Horário de Verão das Filipinas"},
+		{"", "Horário Padrão de Cingapura", ""},
+		{"Horário de Taipei", "Horário Padrão de Taipei", "Horário de Verão de Taipei"},
+		{"", "Horário da Venezuela", ""},
+		{"", "Horário Universal Coordenado", ""},
+		{"", "", "Horário Padrão da Irlanda"},
+		{"", "", "Horário de Verão Britânico"},
+		{"", "", ""},
+	},
+	"ro": {
+		{"", "Ora Africii Centrale", ""},
+		{"", "Ora Africii Orientale", ""},
+		{"", "Ora Africii Meridionale", ""},
+		{"Ora Africii Occidentale", "Ora standard a Africii Occidentale", "Ora de vară a Africii Occidentale"},
+		{"Ora din Alaska", "Ora standard din Alaska", "Ora de vară din Alaska"},
+		{"Ora centrală nord-americană", "Ora standard centrală nord-americană", "Ora de vară centrală nord-americană"},
+		{"Ora orientală nord-americană", "Ora standard orientală nord-americană", "Ora de vară orientală nord-americană"},
+		{"Ora zonei montane nord-americane", "Ora standard în zona montană nord-americană", "Ora de vară în zona montană nord-americană"},
+		{"Ora zonei Pacific nord-americane", "Ora standard în zona Pacific nord-americană", "Ora de vară în zona Pacific nord-americană"},
+		{"Ora arabă", "Ora standard arabă", "Ora de vară arabă"},
+		{"Ora Argentinei", "Ora standard a Argentinei", "Ora de vară a Argentinei"},
+		{"Ora zonei Atlantic nord-americane", "Ora standard în zona Atlantic nord-americană", "Ora de vară în zona Atlantic nord-americană"},
+		{"Ora Australiei Centrale", "Ora standard a Australiei Centrale", "Ora de vară a Australiei Centrale"},
+		{"Ora Australiei Orientale", "Ora standard a Australiei Orientale", "Ora de vară a Australiei Orientale"},
+		{"Ora Australiei Occidentale", "Ora standard a Australiei Occidentale", "Ora de vară a Australiei Occidentale"},
+		{"Ora din Bangladesh", "Ora standard din Bangladesh", "Ora de vară din Bangladesh"},
+		{"Ora Brasiliei", "Ora standard a Brasiliei", "Ora de vară a Brasiliei"},
+		{"Ora din Chile", "Ora standard din Chile", "Ora de vară din Chile"},
+		{"Ora Chinei", "Ora standard a Chinei", "Ora de vară a Chinei"},
+		{"Ora Columbiei", "Ora standard a Columbiei", "Ora de vară a Columbiei"},
+		{"Ora Europei Centrale", "Ora standard a Europei Centrale", "Ora de vară a Europei Centrale"},
+		{"Ora Europei de Est", "Ora standard a Europei de Est", "Ora de vară a Europei de Est"},
+		{"Ora Europei de Vest", "Ora standard a Europei de Vest", "Ora de vară a Europei de Vest"},
+		{"", "Ora de Greenwhich", ""},
+		{"", "Ora standard a Golfului", ""},
+		{"Ora din Hawaii-Aleutine", "Ora standard din Hawaii-Aleutine", "Ora de vară din Hawaii-Aleutine"},
+		{"Ora din Hong Kong", "Ora standard din Hong Kong", "Ora de vară din Hong Kong"},
+		{"", "Ora Indiei", ""},
+		{"", "Ora Indochinei", ""},
+		{"", "Ora Indoneziei de Vest", ""},
+		{"Ora Iranului", "Ora standard a Iranului", "Ora de vară a Iranului"},
+		{"Ora Israelului", "Ora standard a Israelului", "Ora de vară a Israelului"},
+		{"Ora Japoniei", "Ora standard a Japoniei", "Ora de vară a Japoniei"},
+		{"Ora Coreei", "Ora standard a Coreei", "Ora de vară a Coreei"},
+		{"", "Ora din Malaysia", ""},
+		{"Ora Moscovei", "Ora standard a Moscovei", "Ora de vară a Moscovei"},
+		{"Ora Noii Zeelande", "Ora standard a Noii Zeelande", "Ora de vară a Noii Zeelande"},
+		{"Ora din Newfoundland", "Ora standard din Newfoundland", "Ora de vară din Newfoundland"},
+		{"Ora Pakistanului", "Ora standard a Pakistanului", "Ora de vară a Pakistanului"},
+		{"Ora din Peru", "Ora standard din Peru", "Ora de vară din Peru"},
+		{"Ora din Filipine", "Ora standard din Filipine", "Ora de vară din Filipine"},
+		{"", "Ora din Singapore", ""},
+		{"Ora din Taipei", "Ora standard din Taipei", "Ora de vară din Taipei"},
+		{"", "Ora Venezuelei", ""},
+		{"", "Timpul universal coordonat", ""},
+		{"", "", "Ora de vară a Irlandei"},
+		{"", "", "Ora de vară britanică"},
+		{"", "", ""},
+	},
+	"ru": {
+		{"", "Центральная Африка", ""},
+		{"", "Восточная Африка", ""},
+		{"", "Южная Африка", ""},
+		{"Западная Африка", "Западная Африка, стандартное время", "Западная Африка, летнее время"},
+		{"Аляска", "Аляска, стандартное время", "Аляска, летнее время"},
+		{"Центральная Америка", "Центральная Америка, стандартное время", "Центральная Америка, летнее время"},
+		{"Восточная Америка", "Восточная Америка, стандартное время", "Восточная Америка, летнее время"},
+		{"Горное время (Северная Америка)", "Стандартное горное время (Северная Америка)", "Летнее горное время (Северная Америка)"},
+		{"Тихоокеанское время", "Тихоокеанское стандартное время", "Тихоокеанское летнее время"},
+		{"Саудовская Аравия", "Саудовская Аравия, стандартное время", "Саудовская Аравия, летнее время"},
+		{"Аргентина", "Аргентина, стандартное время", "Аргентина, летнее время"},
+		{"Атлантическое время", "Атлантическое стандартное время", "Атлантическое летнее время"},
+		{"Центральная Австралия", "Центральная Австралия, стандартное время", "Центральная Австралия, летнее время"},
+		{"Восточная Австралия", "Восточная Австралия, стандартное время", "Восточная Австралия, летнее время"},
+		{"Западная Австралия", "Западная Австралия, стандартное время", "Западная Австралия, летнее время"},
+		{"Бангладеш", "Бангладеш, стандартное время", "Бангладеш, летнее время"},
+		{"Бразилия", "Бразилия, стандартное время", "Бразилия, летнее время"},
+		{"Чили", "Чили, стандартное время", "Чили, летнее время"},
+		{"Китай", "Китай, стандартное время", "Китай, летнее время"},
+		{"Колумбия", "Колумбия, стандартное время", "Колумбия, летнее время"},
+		{"Центральная Европа", "Центральная Европа, стандартное время", "Центральная Европа, летнее время"},
+		{"Восточная Европа", "Восточная Европа, стандартное время", "Восточная Европа, летнее время"},
+		{"Западная Европа", "Западная Европа, стандартное время", "Западная Европа, летнее время"},
+		{"", "Среднее время по Гринвичу", ""},
+		{"", "Персидский залив", ""},
+		{"Гавайско-алеутское время", "Гавайско-алеутское стандартное время", "Гавайско-алеутское летнее время"},
+		{"Гонконг", "Гонконг, стандартное время", "Гонконг, летнее время"},
+		{"", "Индия", ""},
+		{"", "Индокитай", ""},
+		{"", "Западная Индонезия", ""},
+		{"Иран", "Иран, стандартное время", "Иран, летнее время"},
+		{"Израиль", "Израиль, стандартное время", "Израиль, летнее время"},
+		{"Япония", "Япония, стандартное время", "Япония, летнее время"},
+		{"Корея", "Корея, стандартное время", "Корея, летнее время"},
+		{"", "Малайзия", ""},
+		{"Москва", "Москва, стандартное время", "Москва, летнее время"},
+		{"Новая Зеландия", "Новая Зеландия, стандартное время", "Новая Зеландия, летнее время"},
+		{"Ньюфаундленд", "Ньюфаундленд, стандартное время", "Ньюфаундленд, летнее время"},
+		{"Пакистан", "Пакистан, стандартное время", "Пакистан, летнее время"},
+		{"Перу", "Перу, стандартное время", "Перу, летнее время"},
+		{"Филиппины", "Филиппины, стандартное время", "Филиппины, летнее время"},
+		{"", "Сингапур", ""},
+		{"Тайвань", "Тайвань, стандартное время", "Тайвань, летнее время"},
+		{"", "Венесуэла", ""},
+		{"", "Всемирное координированное время", ""},
+		{"", "", "Ирландия, стандартное время"},
+		{"", "", "Великобритания, летнее время"},
+		{"", "", ""},
+	},
+	"sd": {
+		{"", "مرڪزي آفريقا جو وقت", ""},
+		{"", "اوڀر آفريڪا جو وقت", ""},
+		{"", "ڏکڻ آفريڪا جو معياري وقت", ""},
+		{"اولهه آفريقا جو وقت", "اولهه آفريقا جو معياري وقت", "اولهه آفريقا جي اونهاري جو وقت"},
+		{"الاسڪا جو وقت", "الاسڪا جو معياري وقت", "الاسڪا جي ڏينهن جو وقت"},
+		{"مرڪزي وقت", "مرڪزي معياري وقت", "مرڪزي ڏينهن جو وقت"},
+		{"مشرقي وقت", "مشرقي معياري وقت", "مشرقي ڏينهن جو وقت"},
+		{"پهاڙي وقت", "پهاڙي معياري وقت", "پهاڙي ڏينهن جو وقت"},
+		{"پيسيفڪ وقت", "پيسيفڪ معياري وقت", "پيسيفڪ ڏينهن جو وقت"},
+		{"عربين جو وقت", "عربين جو معياري وقت", "عربين جي ڏينهن جو وقت"},
+		{"ارجنٽائن وقت", "ارجنٽائن معياري وقت", "ارجنٽائن جي اونهاري جو وقت"},
+		{"ايٽلانٽڪ جو وقت", "ايٽلانٽڪ جو معياري وقت", "ايٽلانٽڪ جي ڏينهن جو وقت"},
+		{"مرڪزي آسٽريليا جو وقت", "آسٽريليا جو مرڪزي معياري وقت", "آسٽريليا جو مرڪزي ڏينهن جو وقت"},
+		{"اوڀر آسٽريليا جو وقت", "آسٽريليا جو مشرقي معياري وقت", "آسٽريليا جو مشرقي ڏينهن جو وقت"},
+		{"مغربي آسٽريليا جو وقت", "آسٽريليا جو مغربي معياري وقت", "آسٽريليا جو مغربي ڏينهن جو وقت"},
+		{"بنگلاديش جو وقت", "بنگلاديش جو معياري وقت", "بنگلاديش جي اونهاري جو وقت"},
+		{"براسیلیا جو وقت", "براسيليا جو معياري وقت", "براسيليا جي اونهاري جو وقت"},
+		{"چلي جو وقت", "چلي جو معياري وقت", "چلي جي اونهاري جو وقت"},
+		{"چائنا جو وقت", "چائنا جو معياري وقت", "چائنا جي ڏينهن جو وقت"},
+		{"ڪولمبيا جو وقت", "ڪولمبيا جو معياري وقت", "ڪولمبيا جي اونهاري جو وقت"},
+		{"مرڪزي يورپي وقت", "مرڪزي يورپي معياري وقت", "مرڪزي يورپي اونهاري جو وقت"},
+		{"مشرقي يورپي وقت", "مشرقي يورپي معياري وقت", "مشرقي يورپي اونهاري جو وقت"},
+		{"مغربي يورپي وقت", "مغربي يورپي معياري وقت", "مغربي يورپي ڏينهن جو وقت"},
+		{"", "گرين وچ مين ٽائيم", ""},
+		{"", "خلج معياري وقت", ""},
+		{"هوائي اليوٽين جو وقت", "هوائي اليوٽين جو معياري وقت", "هوائي اليوٽين جي ڏينهن جو وقت"},
+		{"هانگ ڪانگ جو وقت", "هانگ ڪانگ جو معياري وقت", "هانگ ڪانگ جي اونهاري جو وقت"},
+		{"", "ڀارت جو معياري وقت", ""},
+		{"", "انڊو چائنا جو وقت", ""},
+		{"", "اولهه انڊونيشيا جو وقت", ""},
+		{"ايران جو وقت", "ايران جو معياري وقت", "ايران جي ڏينهن جو وقت"},
+		{"اسرائيل جو وقت", "اسرائيل جو معياري وقت", "اسرائيل جي ڏينهن جو وقت"},
+		{"جاپان جو وقت", "جاپان جو معياري وقت", "جاپان جي ڏينهن جو وقت"},
+		{"ڪوريا جو وقت", "ڪوريا جو معياري وقت", "ڪوريا جي ڏينهن جو وقت"},
+		{"", "ملائيشيا جو وقت", ""},
+		{"ماسڪو جو وقت", "ماسڪو جو معياري وقت", "ماسڪو جي ڏينهن جي وقت"},
+		{"نيوزيلينڊ جو وقت", "نيوزيلينڊ جو معياري وقت", "نيوزيلينڊ جي ڏينهن جو وقت"},
+		{"نيو فائونڊ لينڊ جو وقت", "نيو فائونڊ لينڊ جو معياري وقت", "نيو فائونڊ لينڊ جي ڏينهن جو وقت"},
+		{"پاڪستان جو وقت", "پاڪستان جو معياري وقت", "پاڪستان جي اونهاري جو وقت"},
+		{"پيرو جو وقت", "پيرو جو معياري وقت", "پيرو جي اونهاري جو وقت"},
+		{"فلپائن جو وقت", "فلپائن جو معياري وقت", "فلپائن جي اونهاري جو وقت"},
+		{"", "سنگاپور جو معياري وقت", ""},
+		{"تائپي جو وقت", "تائپي جو معياري وقت", "تائپي جي ڏينهن جو وقت"},
+		{"", "وينزويلا جو وقت", ""},
+		{"", "گڏيل دنياوي وقت", ""},
+		{"", "", "آئرش جو معياري وقت"},
+		{"", "", "برطانيا جي اونهاري جو وقت"},
+		{"", "", ""},
+	},
+	"se": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"gaska-Eurohpá áigi", "gaska-Eurohpá dábálašáigi", "gaska-Eurohpá geassiáigi"},
+		{"nuorti-Eurohpá áigi", "nuorti-Eurohpá dábálašáigi", "nuorti-Eurohpá geassiáigi"},
+		{"oarje-Eurohpá áigi", "oarje-Eurohpá dábálašáigi", "oarje-Eurohpá geassiáigi"},
+		{"", "Greenwich gaskka áigi", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Moskva-áigi", "Moskva-dábálašáigi", "Moskva-geassiáigi"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"si": {
+		{"", "මධ්\u200dයම අප්\u200dරිකානු වේලාව", ""},
+		{"", "නැගෙනහිර අප්\u200dරිකානු වේලාව", ""},
+		{"", "දකුණු අප්\u200dරිකානු වේලාව", ""},
+		{"බටහිර අප්\u200dරිකානු වේලාව", "බටහිර අප්\u200dරිකානු සම්මත වේලාව", "බටහිර අප්\u200dරිකානු ග්\u200dරීෂ්ම කාලය"},
+		{"ඇලස්කා වේලාව", "ඇලස්කා සම්මත වේලාව", "ඇලස්කා දිවාආලෝක වේලාව"},
+		{"උතුරු ඇමරිකානු මධ්\u200dයම වේලාව", "උතුරු ඇමරිකානු මධ්\u200dයම සම්මත වේලාව", "උතුරු ඇමරිකානු මධ්\u200dයම දිවාආලෝක වේලාව"},
+		{"උතුරු ඇමරිකානු නැගෙනහිර වේලාව", "උතුරු ඇමරිකානු නැගෙනහිර සම්මත වේලාව", "උතුරු ඇමරිකානු නැගෙනහිර දිවාආලෝක වේලාව"},
+		{"උතුරු ඇමරිකානු කඳුකර වේලාව", "උතුරු ඇමරිකානු කඳුකර සම්මත වේලාව", "උතුරු ඇමරිකානු කඳුකර දිවාආලෝක වේලාව"},
+		{"උතුරු ඇමරිකානු පැසිෆික් වේලාව", "උතුරු ඇමරිකානු පැසිෆික් සම්මත වේලාව", "උතුරු ඇමරිකානු පැසිෆික් දිවාආලෝක වේලාව"},
+		{"අරාබි වේලාව", "අරාබි සම්මත වේලාව", "අරාබි දහවල් වේලාව"},
+		{"ආර්ජන්ටිනා වේලාව", "ආර්ජන්ටිනා සම්මත වේලාව", "ආර්ජන්ටිනා ග්\u200dරීෂ්ම කාලය"},
+		{"අත්ලාන්තික් වේලාව", "අත්ලාන්තික් සම්මත වේලාව", "අත්ලාන්තික් දිවාආලෝක වේලාව"},
+		{"මධ්\u200dයම ඕස්ට්\u200dරේලියානු වේලාව", "ඕස්ට්\u200dරේලියානු සම්මත වේලාව", "මධ්\u200dයම ඔස්ට්\u200dරේලියානු දහවල් වේලාව"},
+		{"නැගෙනහිර ඕස්ට්\u200dරේලියානු වේලාව", "නැගෙනහිර ඕස්ට්\u200dරේලියානු සම්මත වේලාව", "නැඟෙනහිර ඕස්ට්\u200dරේලියානු දහවල් වේලාව"},
+		{"බටහිර ඕස්ට්\u200dරේලියානු වේලාව", "බටහිර ඕස්ට්\u200dරේලියානු සම්මත වේලාව", "බටහිර ඔස්ට්\u200dරේලියානු දහවල් වේලාව"},
+		{"බංගලාදේශ වේලාව", "බංගලාදේශ සම්මත වේලාව", "බංගලාදේශ ග්\u200dරීෂ්ම කාලය"},
+		{"බ්\u200dරසීල වේලාව", "බ්\u200dරසීල සම්මත වේලාව", "බ්\u200dරසීල ග්\u200dරීෂ්ම කාලය"},
+		{"චිලී වේලාව", "චිලී සම්මත වේලාව", "චිලී ග්\u200dරීෂ්ම කාලය"},
+		{"චීන වේලාව", "චීන සම්මත වේලාව", "චීන දහවල් වේලාව"},
+		{"කොලොම්බියා වේලාව", "කොලොම්බියා සම්මත වේලාව", "කොලොම්බියා ග්\u200dරීෂ්ම කාලය"},
+		{"මධ්\u200dයම යුරෝපීය වේලාව", "මධ්\u200dයම යුරෝපීය සම්මත වේලාව", "මධ්\u200dයම යුරෝපීය ග්\u200dරීෂ්ම වේලාව"},
+		{"නැගෙනහිර යුරෝපීය වේලාව", "නැගෙනහිර යුරෝපීය සම්මත වේලාව", "නැගෙනහිර යුරෝපීය ග්\u200dරීෂ්ම වේලාව"},
+		{"බටහිර යුරෝපීය වේලාව", "බටහිර යුරෝපීය සම්මත වේලාව", "බටහිර යුරෝපීය ග්\u200dරීෂ්ම වේලාව"},
+		{"", "ග්\u200dරිනිච් මධ්\u200dයම වේලාව", ""},
+		{"", "ගල්ෆ් වේලාව", ""},
+		{"හවායි-අලෙයුතියාන් වේලාව", "හවායි-අලෙයුතියාන් සම්මත වේලාව", "හවායි-අලෙයුතියාන් දිවාආලෝක වේලාව"},
+		{"හොංකොං වේලාව", "හොංකොං සම්මත වේලාව", "හොංකොං ග්\u200dරීෂ්ම වේලාව"},
+		{"", "ඉන්දියානු වේලාව", ""},
+		{"", "ඉන්දුචීන වේලාව", ""},
+		{"", "බටහිර ඉන්දුනීසියානු වේලාව", ""},
+		{"ඉරාන වේලාව", "ඉරාන සම්මත වේලාව", "ඉරාන දිවා කාලය"},
+		{"ඊශ්\u200dරායල වේලාව", "ඊශ්\u200dරායල සම්මත වේලාව", "ඊශ්\u200dරායල දහවල් වේලාව"},
+		{"ජපාන වේලාව", "ජපාන සම්මත වේලාව", "ජපාන දහවල් වේලාව"},
+		{"කොරියානු වේලාව", "කොරියානු සම්මත වේලාව", "කොරියානු දහවල් වේලාව"},
+		{"", "මැලේසියානු වේලාව", ""},
+		{"මොස්කව් වේලාව", "මොස්කව් සම්මත වේලාව", "මොස්කව් ග්\u200dරීෂ්ම වේලාව"},
+		{"නවසීලන්ත වේලාව", "නවසීලන්ත සම්මත වේලාව", "නවසීලන්ත දිවා වේලාව"},
+		{"නිව්ෆවුන්ලන්ත වේලාව", "නිව්ෆවුන්ලන්ත සම්මත වේලාව", "නිව්ෆවුන්ලන්ත දිවාආලෝක වේලාව"},
+		{"පාකිස්ථාන වේලාව", "පාකිස්ථාන සම්මත වේලාව", "පාකිස්ථාන ග්\u200dරීෂ්ම කාලය"},
+		{"පේරු වේලාව", "පේරු සම්මත වේලාව", "පේරු ග්\u200dරීෂ්ම කාලය"},
+		{"පිලිපීන වේලාව", "පිලිපීන සම්මත වේලාව", "පිලිපීන ග්\u200dරීෂ්ම වේලාව"},
+		{"", "සිංගප්පුරු වේලාව", ""},
+		{"තායිපේ වේලාව", "තායිපේ සම්මත වේලාව", "තායිපේ දහවල් වේලාව"},
+		{"", "වෙනිසියුලා වේලාව", ""},
+		{"", "සමකක්ෂ සාර්ව වේලාව", ""},
+		{"", "", "අයර්ලන්ත සම්මත වේලාව"},
+		{"", "", "බ්\u200dරිතාන්\u200dය ගිම්හාන වේලාව"},
+		{"", "", ""},
+	},
+	"sk": {
+		{"", "stredoafrický čas", ""},
+		{"", "východoafrický čas", ""},
+		{"", "juhoafrický čas", ""},
+		{"západoafrický čas", "západoafrický štandardný čas", "západoafrický letný čas"},
+		{"aljašský čas", "aljašský štandardný čas", "aljašský letný čas"},
+		{"severoamerický centrálny čas", "severoamerický centrálny štandardný čas", "severoamerický centrálny letný čas"},
+		{"severoamerický východný čas", "severoamerický východný štandardný čas", "severoamerický východný letný čas"},
+		{"severoamerický horský čas", "severoamerický horský štandardný čas", "severoamerický horský letný čas"},
+		{"severoamerický tichomorský čas", "severoamerický tichomorský štandardný čas", "severoamerický tichomorský letný čas"},
+		{"arabský čas", "arabský štandardný čas", "arabský letný čas"},
+		{"argentínsky čas", "argentínsky štandardný čas", "argentínsky letný čas"},
+		{"atlantický čas", "atlantický štandardný čas", "atlantický letný čas"},
+		{"stredoaustrálsky čas", "stredoaustrálsky štandardný čas", "stredoaustrálsky letný čas"},
+		{"východoaustrálsky čas", "východoaustrálsky štandardný čas", "východoaustrálsky letný čas"},
+		{"západoaustrálsky čas", "západoaustrálsky štandardný čas", "západoaustrálsky letný čas"},
+		{"bangladéšsky čas", "bangladéšsky štandardný čas", "bangladéšsky letný čas"},
+		{"brazílsky čas", "brazílsky štandardný čas", "brazílsky letný čas"},
+		{"čilský čas", "čilský štandardný čas", "čilský letný čas"},
+		{"čínsky čas", "čínsky štandardný čas", "čínsky letný čas"},
+		{"kolumbijský čas", "kolumbijský štandardný čas", "kolumbijský letný čas"},
+		{"stredoeurópsky čas", "stredoeurópsky štandardný čas", "stredoeurópsky letný čas"},
+		{"východoeurópsky čas", "východoeurópsky štandardný čas", "východoeurópsky letný čas"},
+		{"západoeurópsky čas", "západoeurópsky štandardný čas", "západoeurópsky letný čas"},
+		{"", "greenwichský čas", ""},
+		{"", "štandardný čas Perzského zálivu", ""},
+		{"havajsko-aleutský čas", "havajsko-aleutský štandardný čas", "havajsko-aleutský letný čas"},
+		{"hongkonský čas", "hongkonský štandardný čas", "hongkonský letný čas"},
+		{"", "indický čas", ""},
+		{"", "indočínsky čas", ""},
+		{"", "západoindonézsky čas", ""},
+		{"iránsky čas", "iránsky štandardný čas", "iránsky letný čas"},
+		{"izraelský čas", "izraelský štandardný čas", "izraelský letný čas"},
+		{"japonský čas", "japonský štandardný čas", "japonský letný čas"},
+		{"kórejský čas", "kórejský štandardný čas", "kórejský letný čas"},
+		{"", "malajzijský čas", ""},
+		{"moskovský čas", "moskovský štandardný čas", "moskovský letný čas"},
+		{"novozélandský čas", "novozélandský štandardný čas", "novozélandský letný čas"},
+		{"newfoundlandský čas", "newfoundlandský štandardný čas", "newfoundlandský letný čas"},
+		{"pakistanský čas", "pakistanský štandardný čas", "pakistanský letný čas"},
+		{"peruánsky čas", "peruánsky štandardný čas", "peruánsky letný čas"},
+		{"filipínsky čas", "filipínsky štandardný čas", "filipínsky letný čas"},
+		{"", "singapurský štandardný čas", ""},
+		{"tchajpejský čas", "tchajpejský štandardný čas", "tchajpejský letný čas"},
+		{"", "venezuelský čas", ""},
+		{"", "koordinovaný svetový čas", ""},
+		{"", "", "írsky štandardný čas"},
+		{"", "", "britský letný čas"},
+		{"", "", ""},
+	},
+	"sl": {
+		{"", "Centralnoafriški čas", ""},
+		{"", "Vzhodnoafriški čas", ""},
+		{"", "Južnoafriški čas", ""},
+		{"Zahodnoafriški čas", "Zahodnoafriški standardni čas", "Zahodnoafriški poletni čas"},
+		{"Aljaški čas", "Aljaški standardni čas", "Aljaški poletni čas"},
+		{"Centralni čas", "Centralni standardni čas", "Centralni poletni čas"},
+		{"Vzhodni čas", "Vzhodni standardni čas", "Vzhodni poletni čas"},
+		{"Gorski čas", "Gorski standardni čas", "Gorski poletni čas"},
+		{"Pacifiški čas", "Pacifiški standardni čas", "Pacifiški poletni čas"},
+		{"Arabski čas", "Arabski standardni čas", "Arabski poletni čas"},
+		{"Argentinski čas", "Argentinski standardni čas", "Argentinski poletni čas"},
+		{"Atlantski čas", "Atlantski standardni čas", "Atlantski poletni čas"},
+		{"Avstralski centralni čas", "Avstralski centralni standardni čas", "Avstralski centralni poletni čas"},
+		{"Avstralski vzhodni čas", "Avstralski vzhodni standardni čas", "Avstralski vzhodni poletni čas"},
+		{"Avstralski zahodni čas", "Avstralski zahodni standardni čas", "Avstralski zahodni poletni čas"},
+		{"Bangladeški čas", "Bangladeški standardni čas", "Bangladeški poletni čas"},
+		{"Brasilski čas", "Brasilski standardni čas", "Brasilski poletni čas"},
+		{"Čilski čas", "Čilski standardni čas", "Čilski poletni čas"},
+		{"Kitajski čas", "Kitajski standardni čas", "Kitajski poletni čas"},
+		{"Kolumbijski čas", "Kolumbijski standardni čas", "Kolumbijski poletni čas"},
+		{"Srednjeevropski čas", "Srednjeevropski standardni čas", "Srednjeevropski poletni čas"},
+		{"Vzhodnoevropski čas", "Vzhodnoevropski standardni čas", "Vzhodnoevropski poletni čas"},
+		{"Zahodnoevropski čas", "Zahodnoevropski standardni čas", "Zahodnoevropski poletni čas"},
+		{"", "Greenwiški srednji čas", ""},
+		{"", "Zalivski standardni čas", ""},
+		{"Havajski aleutski čas", "Havajski aleutski standardni čas", "Havajski aleutski poletni čas"},
+		{"Hongkonški čas", "Hongkonški standardni čas", "Hongkonški poletni čas"},
+		{"", "Indijski standardni čas", ""},
+		{"", "Indokitajski čas", ""},
+		{"", "Indonezijski zahodni čas", ""},
+		{"Iranski čas", "Iranski standardni čas", "Iranski poletni čas"},
+		{"Izraelski čas", "Izraelski standardni čas", "Izraelski poletni čas"},
+		{"Japonski čas", "Japonski standardni čas", "Japonski poletni čas"},
+		{"Korejski čas", "Korejski standardni čas", "Korejski poletni čas"},
+		{"", "Malezijski čas", ""},
+		{"Moskovski čas", "Moskovski standardni čas", "Moskovski poletni čas"},
+		{"Novozelandski čas", "Novozelandski standardni čas", "Novozelandski poletni čas"},
+		{"Novofundlandski čas", "Novofundlandski standardni čas", "Novofundlandski poletni čas"},
+		{"Pakistanski čas", "Pakistanski standardni čas", "Pakistanski poletni čas"},
+		{"Perujski čas", "Perujski standardni čas", "Perujski poletni čas"},
+		{"Filipinski čas", "Filipinski standardni čas", "Filipinski poletni čas"},
+		{"", "Singapurski standardni čas", ""},
+		{"Tajpejski čas", "Tajpejski standardni čas", "Tajpejski poletni čas"},
+		{"", "Venezuelski čas", ""},
+		{"", "Univerzalni koordinirani čas", ""},
+		{"", "", "irski standardni čas"},
+		{"", "", "britanski poletni čas"},
+		{"", "", ""},
+	},
+	"so": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Waqtiga Kolambiya", "Waqtiyada Caadiga ah ee kolambiya", "Waqtiyada Xagaaga Kolambiya"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"sq": {
+		{"", "Ora e Afrikës Qendrore", ""},
+		{"", "Ora e Afrikës Lindore", ""},
+		{"", "Ora standarde e Afrikës Jugore", ""},
+		{"Ora e Afrikës Perëndimore", "Ora standarde e Afrikës Perëndimore", "Ora verore e Afrikës Perëndimore"},
+		{"Ora e Alaskës", "Ora standarde e Alaskës", "Ora verore e Alsaskës"},
+		{"Ora e SHBA-së Qendrore", "Ora standarde e SHBA-së Qendrore", "Ora verore e SHBA-së Qendrore"},
+		{"Ora e SHBA-së Lindore", "Ora standarde e SHBA-së Lindore", "Ora verore e SHBA-së Lindore"},
+		{"Ora e Territoreve Amerikane të Brezit Malor", "Ora standarde e Territoreve Amerikane të Brezit Malor", "Ora verore e Territoreve Amerikane të Brezit Malor"},
+		{"Ora e Territoreve Amerikane të Bregut të Paqësorit", "Ora standarde e Territoreve Amerikane të Bregut të Paqësorit", "Ora verore e Territoreve Amerikane të Bregut të Paqësorit"},
+		{"Ora arabe", "Ora standarde arabe", "Ora verore arabe"},
+		{"Ora e Argjentinës", "Ora standarde e Argjentinës", "Ora verore e Argjentinës"},
+		{"Ora e Atlantikut", "Ora standarde e Atlantikut", "Ora verore e Atlantikut"},
+		{"Ora e Australisë Qendrore", "Ora standarde e Australisë Qendrore", "Ora verore e Australisë Qendrore"},
+		{"Ora e Australisë Lindore", "Ora standarde e Australisë Lindore", "Ora verore e Australisë Lindore"},
+		{"Ora e Australisë Perëndimore", "Ora standarde e Australisë Perëndimore", "Ora verore e Australisë Perëndimore"},
+		{"Ora e Bangladeshit", "Ora standarde e Bangladeshit", "Ora verore e Bangladeshit"},
+		{"Ora e Brasilës", "Ora standarde e Brasilës", "Ora verore e Brasilës"},
+		{"Ora e Kilit", "Ora standarde e Kilit", "Ora verore e Kilit"},
+		{"Ora e Kinës", "Ora standarde e Kinës", "Ora verore e Kinës"},
+		{"Ora e Kolumbisë", "Ora standarde e Kolumbisë", "Ora verore e Kolumbisë"},
+		{"Ora e Evropës Qendrore", "Ora standarde e Evropës Qendrore", "Ora verore e Evropës Qendrore"},
+		{"Ora e Evropës Lindore", "Ora standarde e Evropës Lindore", "Ora verore e Evropës Lindore"},
+		{"Ora e Evropës Perëndimore", "Ora standarde e Evropës Perëndimore", "Ora verore e Evropës Perëndimore"},
+		{"", "Ora e Grinuiçit", ""},
+		{"", "Ora e Gjirit", ""},
+		{"Ora e Ishujve Hauai-Aleutian", "Ora standarde e Ishujve Hauai-Aleutian", "Ora verore e Ishujve Hauai-Aleutian"},
+		{"Ora e Hong-Kongut", "Ora standarde e Hong-Kongut", "Ora verore e Hong-Kongut"},
+		{"", "Ora standarde e Indisë", ""},
+		{"", "Ora e Indokinës", ""},
+		{"", "Ora e Indonezisë Perëndimore", ""},
+		{"Ora e Iranit", "Ora standarde e Iranit", "Ora verore e Iranit"},
+		{"Ora e Izraelit", "Ora standarde e Izraelit", "Ora verore e Izraelit"},
+		{"Ora e Japonisë", "Ora standarde e Japonisë", "Ora verore e Japonisë"},
+		{"Ora koreane", "Ora standarde koreane", "Ora verore koreane"},
+		{"", "Ora e Malajzisë", ""},
+		{"Ora e Moskës", "Ora standarde e Moskës", "Ora verore e Moskës"},
+		{"Ora e Zelandës së Re", "Ora standarde e Zelandës së Re", "Ora verore e Zelandës së Re"},
+		{"Ora e Njufaundlendit [Tokës së Re]", "Ora standarde e Njufaundlendit [Tokës së Re]", "Ora verore e Njufaundlendit [Tokës së Re]"},
+		{"Ora e Pakistanit", "Ora standarde e Pakistanit", "Ora verore e Pakistanit"},
+		{"Ora e Perusë", "Ora standarde e Perusë", "Ora verore e Perusë"},
+		{"Ora e Filipineve", "Ora standarde e Filipineve", "Ora verore e Filipineve"},
+		{"", "Ora e Singaporit", ""},
+		{"Ora e Tajpeit", "Ora standarde e Tajpeit", "Ora verore e Tajpeit"},
+		{"", "Ora e Venezuelës", ""},
+		{"", "Ora universale e koordinuar", ""},
+		{"", "", "Ora strandarde e Irlandës"},
+		{"", "", "Ora verore britanike"},
+		{"", "", ""},
+	},
+	"sr": {
+		{"", "Централно-афричко време", ""},
+		{"", "Источно-афричко време", ""},
+		{"", "Јужно-афричко време", ""},
+		{"Западно-афричко време", "Западно-афричко стандардно време", "Западно-афричко летње време"},
+		{"Аљаска", "Аљаска, стандардно време", "Аљаска, летње време"},
+		{"Северноамеричко централно време", "Северноамеричко централно стандардно време", "Северноамеричко централно летње време"},
+		{"Северноамеричко источно време", "Северноамеричко источно стандардно време", "Северноамеричко источно летње време"},
+		{"Северноамеричко планинско време", "Северноамеричко планинско стандардно време", "Северноамеричко планинско летње време"},
+		{"Северноамеричко пацифичко време", "Северноамеричко пацифичко стандардно време", "Северноамеричко пацифичко летње време"},
+		{"Арабијско време", "Арабијско стандардно време", "Арабијско летње време"},
+		{"Аргентина време", "Аргентина, стандардно време", "Аргентина, летње време"},
+		{"Атлантско време", "Атлантско стандардно време", "Атлантско летње време"},
+		{"Аустралијско централно време", "Аустралијско централно стандардно време", "Аустралијско централно летње време"},
+		{"Аустралијско источно време", "Аустралијско источно стандардно време", "Аустралијско источно летње време"},
+		{"Аустралијско западно време", "Аустралијско западно стандардно време", "Аустралијско западно летње време"},
+		{"Бангладеш време", "Бангладеш, стандардно време", "Бангладеш, летње време"},
+		{"Бразилија време", "Бразилија, стандардно време", "Бразилија, летње време"},
+		{"Чиле време", "Чиле, стандардно време", "Чиле, летње време"},
+		{"Кина време", "Кинеско стандардно време", "Кина, летње време"},
+		{"Колумбија време", "Колумбија, стандардно време", "Колумбија, летње време"},
+		{"Средњеевропско време", "Средњеевропско стандардно време", "Средњеевропско летње време"},
+		{"Источноевропско време", "Источноевропско стандардно време", "Источноевропско летње време"},
+		{"Западноевропско време", "Западноевропско стандардно време", "Западноевропско летње време"},
+		{"", "Средње време по Гриничу", ""},
+		{"", "Заливско време", ""},
+		{"Хавајско-алеутско време", "Хавајско-алеутско стандардно време", "Хавајско-алеутско летње време"},
+		{"Хонг Конг време", "Хонг Конг, стандардно време", "Хонг Конг, летње време"},
+		{"", "Индијско стандардно време", ""},
+		{"", "Индокина време", ""},
+		{"", "Западно-индонезијско време", ""},
+		{"Иран време", "Иран, стандардно време", "Иран, летње време"},
+		{"Израелско време", "Израелско стандардно време", "Израелско летње време"},
+		{"Јапанско време", "Јапанско стандардно време", "Јапанско летње време"},
+		{"Корејско време", "Корејско стандардно време", "Корејско летње време"},
+		{"", "Малезија време", ""},
+		{"Москва време", "Москва, стандардно време", "Москва, летње време"},
+		{"Нови Зеланд време", "Нови Зеланд, стандардно време", "Нови Зеланд, летње време"},
+		{"Њуфаундленд", "Њуфаундленд, стандардно време", "Њуфаундленд, летње време"},
+		{"Пакистан време", "Пакистан, стандардно време", "Пакистан, летње време"},
+		{"Перу време", "Перу, стандардно време", "Перу, летње време"},
+		{"Филипини време", "Филипини, стандардно време", "Филипини, летње време"},
+		{"", "Сингапур, стандардно време", ""},
+		{"Тајпеј време", "Тајпеј, стандардно време", "Тајпеј, летње време"},
+		{"", "Венецуела време", ""},
+		{"", "Координисано универзално време", ""},
+		{"", "", "Ирска, стандардно време"},
+		{"", "", "Британија, летње време"},
+		{"", "", ""},
+	},
+	"sv": {
+		{"", "centralafrikansk tid", ""},
+		{"", "östafrikansk tid", ""},
+		{"", "sydafrikansk tid", ""},
+		{"västafrikansk tid", "västafrikansk normaltid", "västafrikansk sommartid"},
+		{"Alaskatid", "Alaska, normaltid", "Alaska, sommartid"},
+		{"centralnordamerikansk tid", "centralnordamerikansk normaltid", "centralnordamerikansk sommartid"},
+		{"östnordamerikansk tid", "östnordamerikansk normaltid", "östnordamerikansk sommartid"},
+		{"Klippiga bergentid", "Klippiga bergen, normaltid", "Klippiga bergen, sommartid"},
+		{"västnordamerikansk tid", "västnordamerikansk normaltid", "västnordamerikansk sommartid"},
+		{"saudiarabisk tid", "saudiarabisk normaltid", "saudiarabisk sommartid"},
+		{"östargentinsk tid", "östargentinsk normaltid", "östargentinsk sommartid"},
+		{"nordamerikansk atlanttid", "nordamerikansk atlantnormaltid", "nordamerikansk atlantsommartid"},
+		{"centralaustralisk tid", "centralaustralisk normaltid", "centralaustralisk sommartid"},
+		{"östaustralisk tid", "östaustralisk normaltid", "östaustralisk sommartid"},
+		{"västaustralisk tid", "västaustralisk normaltid", "västaustralisk sommartid"},
+		{"bangladeshisk tid", "bangladeshisk normaltid", "bangladeshisk sommartid"},
+		{"Brasiliatid", "Brasilia, normaltid", "Brasilia, sommartid"},
+		{"chilensk tid", "chilensk normaltid", "chilensk sommartid"},
+		{"kinesisk tid", "kinesisk normaltid", "kinesisk sommartid"},
+		{"colombiansk tid", "colombiansk normaltid", "colombiansk sommartid"},
+		{"centraleuropeisk tid", "centraleuropeisk normaltid", "centraleuropeisk sommartid"},
+		{"östeuropeisk tid", "östeuropeisk normaltid", "östeuropeisk sommartid"},
+		{"västeuropeisk tid", "västeuropeisk normaltid", "västeuropeisk sommartid"},
+		{"", "Greenwichtid", ""},
+		{"", "Persiska vikentid", ""},
+		{"Honolulutid", "Honolulu, normaltid", "Honolulu, sommartid"},
+		{"Hongkongtid", "Hongkong, normaltid", "Hongkong, sommartid"},
+		{"", "indisk tid", ""},
+		{"", "indokinesisk tid", ""},
+		{"", "västindonesisk tid", ""},
+		{"iransk tid", "iransk normaltid", "iransk sommartid"},
+		{"israelisk tid", "israelisk normaltid", "israelisk sommartid"},
+		{"japansk tid", "japansk normaltid", "japansk sommartid"},
+		{"koreansk tid", "koreansk normaltid", "koreansk sommartid"},
+		{"", "malaysisk tid", ""},
+		{"Moskvatid", "Moskva, normaltid", "Moskva, sommartid"},
+		{"nyzeeländsk tid", "nyzeeländsk normaltid", "nyzeeländsk sommartid"},
+		{"Newfoundlandtid", "Newfoundland, normaltid", "Newfoundland, sommartid"},
+		{"pakistansk tid", "pakistansk normaltid", "pakistansk sommartid"},
+		{"peruansk tid", "peruansk normaltid", "peruansk sommartid"},
+		{"filippinsk tid", "filippinsk normaltid", "filippinsk sommartid"},
+		{"", "Singaporetid", ""},
+		{"Taipeitid", "Taipei, normaltid", "Taipei, sommartid"},
+		{"", "venezuelansk tid", ""},
+		{"", "Koordinerad universell tid", ""},
+		{"", "", "irländsk sommartid"},
+		{"", "", "brittisk sommartid"},
+		{"", "", ""},
+	},
+	"sw": {
+		{"", "Saa za Afrika ya Kati", ""},
+		{"", "Saa za Afrika Mashariki", ""},
+		{"", "Saa za Wastani za Afrika Kusini", ""},
+		{"Saa za Afrika Magharibi", "Saa za Wastani za Afrika Magharibi", "Saa za Majira ya joto za Afrika Magharibi"},
+		{"Saa za Alaska", "Saa za Wastani za Alaska", "Saa za Mchana za Alaska"},
+		{"Saa za Kati", "Saa za Wastani za Kati", "Saa za Mchana za Kati"},
+		{"Saa za Mashariki", "Saa za Wastani za Mashariki", "Saa za Mchana za Mashariki"},
+		{"Saa za Mountain", "Saa za Wastani za Mountain", "Saa za Mchana za Mountain"},
+		{"Saa za Pasifiki", "Saa za Wastani za Pasifiki", "Saa za Mchana za Pasifiki"},
+		{"Saa za Uarabuni", "Saa za Wastani za Uarabuni", "Saa za Mchana za Arabiani"},
+		{"Saa za Argentina", "Saa za Wastani za Argentina", "Saa za Majira ya joto za Argentina"},
+		{"Saa za Atlantiki", "Saa za Wastani za Atlantiki", "Saa za Mchana za Atlantiki"},
+		{"Saa za Australia ya Kati", "Saa za Wastani za Australia ya Kati", "Saa za Mchana za Australia ya Kati"},
+		{"Saa za Australia Mashariki", "Saa za Wastani za Mashariki mwa Australia", "Saa za Mchana za Mashariki mwa Australia"},
+		{"Saa za Australia Magharibi", "Saa za Wastani za Australia Magharibi", "Saa za Mchana za Australia Magharibi"},
+		{"Saa za Bangladesh", "Saa za Wastani za Bangladesh", "Saa za Majira ya joto za Bangladesh"},
+		{"Saa za Brasilia", "Saa za Wastani za Brasilia", "Saa za Majira ya joto za Brasilia"},
+		{"Saa za Chile", "Saa za Wastani za Chile", "Saa za Majira ya joto za Chile"},
+		{"Saa za Uchina", "Saa za Wastani za Uchina", "Saa za Mchana za Uchina"},
+		{"Saa za Colombia", "Saa za Wastani za Colombia", "Saa za Majira ya joto za Colombia"},
+		{"Saa za Ulaya ya Kati", "Saa za Wastani za Ulaya ya kati", "Saa za Majira ya joto za Ulaya ya Kati"},
+		{"Saa za Mashariki mwa Ulaya", "Saa za Wastani za Mashariki mwa Ulaya", "Saa za Majira ya joto za Mashariki mwa Ulaya"},
+		{"Saa za Magharibi mwa Ulaya", "Saa za Wastani za Magharibi mwa Ulaya", "Saa za Majira ya joto za Magharibi mwa Ulaya"},
+		{"", "Saa za Greenwich", ""},
+		{"", "Saa za Wastani za Gulf", ""},
+		{"Saa za Hawaii-Aleutian", "Saa za Wastani za Hawaii-Aleutian", "Saa za Mchana za Hawaii-Aleutian"},
+		{"Saa za Hong Kong", "Saa za Wastani za Hong Kong", "Saa za Majira ya joto za Hong Kong"},
+		{"", "Saa Wastani za India", ""},
+		{"", "Saa za Indochina", ""},
+		{"", "Saa za Magharibi mwa Indonesia", ""},
+		{"Saa za Iran", "Saa za Wastani za Iran", "Saa za Mchana za Iran"},
+		{"Saa za Israeli", "Saa za Wastani za Israeli", "Saa za Mchana za Israeli"},
+		{"Saa za Japan", "Saa Wastani za Japan", "Saa za Mchana za Japan"},
+		{"Saa za Korea", "Saa za Wastani za Korea", "Saa za Mchana za Korea"},
+		{"", "Saa za Malaysia", ""},
+		{"Saa za Moscow", "Saa za Wastani za Moscow", "Saa za Majira ya joto za Moscow"},
+		{"Saa za New Zealand", "Saa za Wastani za New Zealand", "Saa za Mchana za New Zealand"},
+		{"Saa za Newfoundland", "Saa za Wastani za Newfoundland", "Saa za Mchana za Newfoundland"},
+		{"Saa za Pakistan", "Saa za Wastani za Pakistan", "Saa za Majira ya joto za Pakistan"},
+		{"Saa za Peru", "Saa za Wastani za Peru", "Saa za Majira ya joto za Peru"},
+		{"Saa za Ufilipino", "Saa za Wastani za Ufilipino", "Saa za Majira ya joto za Ufilipino"},
+		{"", "Saa za Wastani za Singapore", ""},
+		{"Saa za Taipei", "Saa za Wastani za Taipei", "Saa za Mchana za Taipei"},
+		{"", "Saa za Venezuela", ""},
+		{"", "Mfumo wa kuratibu saa ulimwenguni", ""},
+		{"", "", "Saa za Majira ya Joto za Ayalandi"},
+		{"", "", "Saa za Majira ya Joto za Uingereza"},
+		{"", "", ""},
+	},
+	"ta": {
+		{"", "மத்திய ஆப்பிரிக்க நேரம்", ""},
+		{"", "கிழக்கு ஆப்பிரிக்க நேரம்", ""},
+		{"", "தென் ஆப்பிரிக்க நிலையான நேரம்", ""},
+		{"மேற்கு ஆப்பிரிக்க நேரம்", "மேற்கு ஆப்பிரிக்க நிலையான நேரம்", "மேற்கு ஆப்பிரிக்க கோடை நேரம்"},
+		{"அலாஸ்கா நேரம்", "அலாஸ்கா நிலையான நேரம்", "அலாஸ்கா பகலொளி நேரம்"},
+		{"மத்திய நேரம்", "மத்திய நிலையான நேரம்", "மத்திய பகலொளி நேரம்"},
+		{"கிழக்கத்திய நேரம்", "கிழக்கத்திய நிலையான நேரம்", "கிழக்கத்திய பகலொளி நேரம்"},
+		{"மவுன்டைன் நேரம்", "மவுன்டைன் நிலையான நேரம்", "மவுன்டைன் பகலொளி நேரம்"},
+		{"பசிபிக் நேரம்", "பசிபிக் நிலையான நேரம்", "பசிபிக் பகலொளி நேரம்"},
+		{"அரேபிய நேரம்", "அரேபிய நிலையான நேரம்", "அரேபிய பகலொளி நேரம்"},
+		{"அர்ஜென்டினா நேரம்", "அர்ஜென்டினா நிலையான நேரம்", "அர்ஜென்டினா கோடை நேரம்"},
+		{"அட்லாண்டிக் நேரம்", "அட்லாண்டிக் நிலையான நேரம்", "அட்லாண்டிக் பகலொளி நேரம்"},
+		{"மத்திய ஆஸ்திரேலிய நேரம்", "ஆஸ்திரேலியன் மத்திய நிலையான நேரம்", "ஆஸ்திரேலியன் மத்திய பகலொளி நேரம்"},
+		{"கிழக்கத்திய ஆஸ்திரேலிய நேரம்", "ஆஸ்திரேலியன் கிழக்கத்திய நிலையான நேரம்", "ஆஸ்திரேலியன் கிழக்கத்திய பகலொளி நேரம்"},
+		{"மேற்கத்திய ஆஸ்திரேலிய நேரம்", "ஆஸ்திரேலியன் மேற்கத்திய நிலையான நேரம்", "ஆஸ்திரேலியன் மேற்கத்திய பகலொளி நேரம்"},
+		{"வங்கதேச நேரம்", "வங்கதேச நிலையான நேரம்", "வங்கதேச கோடை நேரம்"},
+		{"பிரேசிலியா நேரம்", "பிரேசிலியா நிலையான நேரம்", "பிரேசிலியா கோடை நேரம்"},
+		{"சிலி நேரம்", "சிலி நிலையான நேரம்", "சிலி கோடை நேரம்"},
+		{"சீன நேரம்", "சீன நிலையான நேரம்", "சீன பகலொளி நேரம்"},
+		{"கொலம்பியா நேரம்", "கொலம்பியா நிலையான நேரம்", "கொலம்பியா கோடை நேரம்"},
+		{"மத்திய ஐரோப்பிய நேரம்", "மத்திய ஐரோப்பிய நிலையான நேரம்", "மத்திய ஐரோப்பிய கோடை நேரம்"},
+		{"கிழக்கத்திய ஐரோப்பிய நேரம்", "கிழக்கத்திய ஐரோப்பிய நிலையான நேரம்", "கிழக்கத்திய ஐரோப்பிய கோடை நேரம்"},
+		{"மேற்கத்திய ஐரோப்பிய நேரம்", "மேற்கத்திய ஐரோப்பிய நிலையான நேரம்", "மேற்கத்திய ஐரோப்பிய கோடை நேரம்"},
+		{"", "கிரீன்விச் சராசரி நேரம்", ""},
+		{"", "வளைகுடா நிலையான நேரம்", ""},
+		{"ஹவாய்-அலேஷியன் நேரம்", "ஹவாய்-அலேஷியன் நிலையான நேரம்", "ஹவாய்-அலேஷியன் பகலொளி நேரம்"},
+		{"ஹாங்காங் நேரம்", "ஹாங்காங் நிலையான நேரம்", "ஹாங்காங் கோடை நேரம்"},
+		{"", "இந்திய நிலையான நேரம்", ""},
+		{"", "இந்தோசீன நேரம்", ""},
+		{"", "மேற்கத்திய இந்தோனேசிய நேரம்", ""},
+		{"ஈரான் நேரம்", "ஈரான் நிலையான நேரம்", "ஈரான் பகலொளி நேரம்"},
+		{"இஸ்ரேல் நேரம்", "இஸ்ரேல் நிலையான நேரம்", "இஸ்ரேல் பகலொளி நேரம்"},
+		{"ஜப்பான் நேரம்", "ஜப்பான் நிலையான நேரம்", "ஜப்பான் பகலொளி நேரம்"},
+		{"கொரிய நேரம்", "கொரிய நிலையான நேரம்", "கொரிய பகலொளி நேரம்"},
+		{"", "மலேஷிய நேரம்", ""},
+		{"மாஸ்கோ நேரம்", "மாஸ்கோ நிலையான நேரம்", "மாஸ்கோ கோடை நேரம்"},
+		{"நியூசிலாந்து நேரம்", "நியூசிலாந்து நிலையான நேரம்", "நியூசிலாந்து பகலொளி நேரம்"},
+		{"நியூஃபவுண்ட்லாந்து நேரம்", "நியூஃபவுண்ட்லாந்து நிலையான நேரம்", "நியூஃபவுண்ட்லாந்து பகலொளி நேரம்"},
+		{"பாகிஸ்தான் நேரம்", "பாகிஸ்தான் நிலையான நேரம்", "பாகிஸ்தான் கோடை நேரம்"},
+		{"பெரு நேரம்", "பெரு நிலையான நேரம்", "பெரு கோடை நேரம்"},
+		{"பிலிப்பைன் நேரம்", "பிலிப்பைன் நிலையான நேரம்", "பிலிப்பைன் கோடை நேரம்"},
+		{"", "சிங்கப்பூர் நிலையான நேரம்", ""},
+		{"தாய்பே நேரம்", "தாய்பே நிலையான நேரம்", "தாய்பே பகலொளி நேரம்"},
+		{"", "வெனிசுலா நேரம்", ""},
+		{"", "ஒருங்கிணைந்த சர்வதேச நேரம்", ""},
+		{"", "", "ஐரிஷ் நிலையான நேரம்"},
+		{"", "", "பிரிட்டிஷ் கோடை நேரம்"},
+		{"", "", ""},
+	},
+	"te": {
+		{"", "సెంట్రల్ ఆఫ్రికా సమయం", ""},
+		{"", "తూర్పు ఆఫ్రికా సమయం", ""},
+		{"", "దక్షిణ ఆఫ్రికా ప్రామాణిక సమయం", ""},
+		{"పశ్చిమ ఆఫ్రికా సమయం", "పశ్చిమ ఆఫ్రికా ప్రామాణిక సమయం", "పశ్చిమ ఆఫ్రికా వేసవి సమయం"},
+		{"అలాస్కా సమయం", "అలాస్కా ప్రామాణిక సమయం", "అలాస్కా పగటి వెలుతురు సమయం"},
+		{"మధ్యమ సమయం", "మధ్యమ ప్రామాణిక సమయం", "మధ్యమ పగటి వెలుతురు సమయం"},
+		{"తూర్పు సమయం", "తూర్పు ప్రామాణిక సమయం", "తూర్పు పగటి వెలుతురు సమయం"},
+		{"మౌంటెయిన్ సమయం", "మౌంటెయిన్ ప్రామాణిక సమయం", "మౌంటెయిన్ పగటి వెలుతురు సమయం"},
+		{"పసిఫిక్ సమయం", "పసిఫిక్ ప్రామాణిక సమయం", "పసిఫిక్ పగటి వెలుతురు సమయం"},
+		{"అరేబియన్ సమయం", "అరేబియన్ ప్రామాణిక సమయం", "అరేబియన్ పగటి వెలుతురు సమయం"},
+		{"అర్జెంటీనా సమయం", "అర్జెంటీనా ప్రామాణిక సమయం", "ఆర్జెంటీనా వేసవి సమయం"},
+		{"అట్లాంటిక్ సమయం", "అట్లాంటిక్ ప్రామాణిక సమయం", "అట్లాంటిక్ పగటి వెలుతురు సమయం"},
+		{"ఆస్ట్రేలియా మధ్యమ సమయం", "ఆస్ట్రేలియా మధ్యమ ప్రామాణిక సమయం", "ఆస్ట్రేలియా మధ్యమ పగటి వెలుతురు సమయం"},
+		{"తూర్పు ఆస్ట్రేలియా సమయం", "ఆస్ట్రేలియన్ తూర్పు ప్రామాణిక సమయం", "ఆస్ట్రేలియన్ తూర్పు పగటి వెలుతురు సమయం"},
+		{"పశ్చిమ ఆస్ట్రేలియా సమయం", "ఆస్ట్రేలియన్ పశ్చిమ ప్రామాణిక సమయం", "ఆస్ట్రేలియన్ పశ్చిమ పగటి వెలుతురు సమయం"},
+		{"బంగ్లాదేశ్ సమయం", "బంగ్లాదేశ్ ప్రామాణిక సమయం", "బంగ్లాదేశ్ వేసవి సమయం"},
+		{"బ్రెజిలియా సమయం", "బ్రెజిలియా ప్రామాణిక సమయం", "బ్రెజిలియా వేసవి సమయం"},
+		{"చిలీ సమయం", "చిలీ ప్రామాణిక సమయం", "చిలీ వేసవి సమయం"},
+		{"చైనా సమయం", "చైనా ప్రామాణిక సమయం", "చైనా పగటి వెలుతురు సమయం"},
+		{"కొలంబియా సమయం", "కొలంబియా ప్రామాణిక సమయం", "కొలంబియా వేసవి సమయం"},
+		{"సెంట్రల్ యూరోపియన్ సమయం", "సెంట్రల్ యూరోపియన్ ప్రామాణిక సమయం", "సెంట్రల్ యూరోపియన్ వేసవి సమయం"},
+		{"తూర్పు యూరోపియన్ సమయం", "తూర్పు యూరోపియన్ ప్రామాణిక సమయం", "తూర్పు యూరోపియన్ వేసవి సమయం"},
+		{"పశ్చిమ యూరోపియన్ సమయం", "పశ్చిమ యూరోపియన్ ప్రామాణిక సమయం", "పశ్చిమ యూరోపియన్ వేసవి సమయం"},
+		{"", "గ్రీన్\u200cవిచ్ సగటు సమయం", ""},
+		{"", "గల్ఫ్ ప్రామాణిక సమయం", ""},
+		{"హవాయ్-అల్యూషియన్ సమయం", "హవాయ్-అల్యూషియన్ ప్రామాణిక సమయం", "హవాయ్-అల్యూషియన్ పగటి వెలుతురు సమయం"},
+		{"హాంకాంగ్ సమయం", "హాంకాంగ్ ప్రామాణిక సమయం", "హాంకాంగ్ వేసవి సమయం"},
+		{"", "భారతదేశ సమయం", ""},
+		{"", "ఇండోచైనా సమయం", ""},
+		{"", "పశ్చిమ ఇండోనేషియా సమయం", ""},
+		{"ఇరాన్ సమయం", "ఇరాన్ ప్రామాణిక సమయం", "ఇరాన్ పగటి వెలుతురు సమయం"},
+		{"ఇజ్రాయిల్ సమయం", "ఇజ్రాయిల్ ప్రామాణిక సమయం", "ఇజ్రాయిల్ పగటి వెలుతురు సమయం"},
+		{"జపాన్ సమయం", "జపాన్ ప్రామాణిక సమయం", "జపాన్ పగటి వెలుతురు సమయం"},
+		{"కొరియన్ సమయం", "కొరియన్ ప్రామాణిక సమయం", "కొరియన్ పగటి వెలుతురు సమయం"},
+		{"", "మలేషియా సమయం", ""},
+		{"మాస్కో సమయం", "మాస్కో ప్రామాణిక సమయం", "మాస్కో వేసవి సమయం"},
+		{"న్యూజిల్యాండ్ సమయం", "న్యూజిల్యాండ్ ప్రామాణిక సమయం", "న్యూజిల్యాండ్ పగటి వెలుతురు సమయం"},
+		{"న్యూఫౌండ్\u200cల్యాండ్ సమయం", "న్యూఫౌండ్\u200cల్యాండ్ ప్రామాణిక సమయం", "న్యూఫౌండ్\u200cల్యాండ్ పగటి వెలుతురు సమయం"},
+		{"పాకిస్తాన్ సమయం", "పాకిస్తాన్ ప్రామాణిక సమయం", "పాకిస్తాన్ వేసవి సమయం"},
+		{"పెరూ సమయం", "పెరూ ప్రామాణిక సమయం", "పెరూ వేసవి సమయం"},
+		{"ఫిలిప్పైన్ సమయం", "ఫిలిప్పైన్ ప్రామాణిక సమయం", "ఫిలిప్పైన్ వేసవి సమయం"},
+		{"", "సింగపూర్ ప్రామాణిక సమయం", ""},
+		{"తైపీ సమయం", "తైపీ ప్రామాణిక సమయం", "తైపీ పగటి వెలుతురు సమయం"},
+		{"", "వెనిజులా సమయం", ""},
+		{"", "సమన్వయ సార్వజనీన సమయం", ""},
+		{"", "", "ఐరిష్ ప్రామాణిక సమయం"},
+		{"", "", "బ్రిటీష్ వేసవి సమయం"},
+		{"", "", ""},
+	},
+	"tg": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Вақти марказӣ", "Вақти стандартии марказӣ", "Вақти рӯзонаи марказӣ"},
+		{"Вақти шарқӣ", "Вақти стандартии шарқӣ", "Вақти рӯзонаи шарқӣ"},
+		{"Вақти кӯҳӣ", "Вақти стандартии кӯҳӣ", "Вақти рӯзонаи кӯҳӣ"},
+		{"Вақти Уёнуси Ором", "Вақти стандартии Уқёнуси Ором", "Вақти рӯзонаи Уқёнуси Ором"},
+		{"", "", ""},
+		{"", "", ""},
+		{"Вақти атлантикӣ", "Вақти стандартии атлантикӣ", "Вақти рӯзонаи атлантикӣ"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Вақти аврупоии марказӣ", "Вақти стандартии аврупоии марказӣ", "Вақти тобистонаи аврупоии марказӣ"},
+		{"Вақти аврупоии шарқӣ", "Вақти стандартии аврупоии шарқӣ", "Вақти тобистонаи аврупоии шарқӣ"},
+		{"Вақти аврупоии ғарбӣ", "Вақти стандартии аврупоии ғарбӣ", "Вақти тобистонаи аврупоии ғарбӣ"},
+		{"", "Ба вақти Гринвич", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "Вақти ҷаҳонии ҳамоҳангсозӣ", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"th": {
+		{"", "เวลาแอฟริกากลาง", ""},
+		{"", "เวลาแอฟริกาตะวันออก", ""},
+		{"", "เวลาแอฟริกาใต้", ""},
+		{"เวลาแอฟริกาตะวันตก", "เวลามาตรฐานแอฟริกาตะวันตก", "เวลาฤดูร้อนแอฟริกาตะวันตก"},
+		{"เวลาอะแลสกา", "เวลามาตรฐานอะแลสกา", "เวลาออมแสงของอะแลสกา"},
+		{"เวลาตอนกลางในอเมริกาเหนือ", "เวลามาตรฐานตอนกลางในอเมริกาเหนือ", "เวลาออมแสงตอนกลางในอเมริกาเหนือ"},
+		{"เวลาทางตะวันออกในอเมริกาเหนือ", "เวลามาตรฐานทางตะวันออกในอเมริกาเหนือ", "เวลาออมแสงทางตะวันออกในอเมริกาเหนือ"},
+		{"เวลาแถบภูเขาในอเมริกาเหนือ", "เวลามาตรฐานแถบภูเขาในอเมริกาเหนือ", "เวลาออมแสงแถบภูเขาในอเมริกาเหนือ"},
+		{"เวลาแปซิฟิกในอเมริกาเหนือ", "เวลามาตรฐานแปซิฟิกในอเมริกาเหนือ", "เวลาออมแสงแปซิฟิกในอเมริกาเหนือ"},
+		{"เวลาอาหรับ", "เวลามาตรฐานอาหรับ", "เวลาออมแสงอาหรับ"},
+		{"เวลาอาร์เจนตินา", "เวลามาตรฐานอาร์เจนตินา", "เวลาฤดูร้อนอาร์เจนตินา"},
+		{"เวลาแอตแลนติก", "เวลามาตรฐานแอตแลนติก", "เวลาออมแสงของแอตแลนติก"},
+		{"เวลาออสเตรเลียกลาง", "เวลามาตรฐานทางตอนกลางของออสเตรเลีย", "เวลาออมแสงทางตอนกลางของออสเตรเลีย"},
+		{"เวลาออสเตรเลียตะวันออก", "เวลามาตรฐานทางตะวันออกของออสเตรเลีย", "เวลาออมแสงทางตะวันออกของออสเตรเลีย"},
+		{"เวลาออสเตรเลียตะวันตก", "เวลามาตรฐานทางตะวันตกของออสเตรเลีย", "เวลาออมแสงทางตะวันตกของออสเตรเลีย"},
+		{"เวลาบังกลาเทศ", "เวลามาตรฐานบังกลาเทศ", "เวลาฤดูร้อนบังกลาเทศ"},
+		{"เวลาบราซิเลีย", "เวลามาตรฐานบราซิเลีย", "เวลาฤดูร้อนบราซิเลีย"},
+		{"เวลาชิลี", "เวลามาตรฐานชิลี", "เวลาฤดูร้อนชิลี"},
+		{"เวลาจีน", "เวลามาตรฐานจีน", "เวลาออมแสงจีน"},
+		{"เวลาโคลอมเบีย", "เวลามาตรฐานโคลอมเบีย", "เวลาฤดูร้อนโคลอมเบีย"},
+		{"เวลายุโรปกลาง", "เวลามาตรฐานยุโรปกลาง", "เวลาฤดูร้อนยุโรปกลาง"},
+		{"เวลายุโรปตะวันออก", "เวลามาตรฐานยุโรปตะวันออก", "เวลาฤดูร้อนยุโรปตะวันออก"},
+		{"เวลายุโรปตะวันตก", "เวลามาตรฐานยุโรปตะวันตก", "เวลาฤดูร้อนยุโรปตะวันตก"},
+		{"", "เวลามาตรฐานกรีนิช", ""},
+		{"", "เวลากัลฟ์", ""},
+		{"เวลาฮาวาย-อะลูเชียน", "เวลามาตรฐานฮาวาย-อะลูเชียน", "เวลาออมแสงฮาวาย-อะลูเชียน"},
+		{"เวลาฮ่องกง", "เวลามาตรฐานฮ่องกง", "เวลาฤดูร้อนฮ่องกง"},
+		{"", "เวลาอินเดีย", ""},
+		{"", "เวลาอินโดจีน", ""},
+		{"", "เวลาอินโดนีเซียฝั่งตะวันตก", ""},
+		{"เวลาอิหร่าน", "เวลามาตรฐานอิหร่าน", "เวลาออมแสงอิหร่าน"},
+		{"เวลาอิสราเอล", "เวลามาตรฐานอิสราเอล", "เวลาออมแสงอิสราเอล"},
+		{"เวลาญี่ปุ่น", "เวลามาตรฐานญี่ปุ่น", "เวลาออมแสงญี่ปุ่น"},
+		{"เวลาเกาหลี", "เวลามาตรฐานเกาหลี", "เวลาออมแสงเกาหลี"},
+		{"", "เวลามาเลเซีย", ""},
+		{"เวลามอสโก", "เวลามาตรฐานมอสโก", "เวลาฤดูร้อนมอสโก"},
+		{"เวลานิวซีแลนด์", "เวลามาตรฐานนิวซีแลนด์", "เวลาออมแสงนิวซีแลนด์"},
+		{"เวลานิวฟันด์แลนด์", "เวลามาตรฐานนิวฟันด์แลนด์", "เวลาออมแสงนิวฟันด์แลนด์"},
+		{"เวลาปากีสถาน", "เวลามาตรฐานปากีสถาน", "เวลาฤดูร้อนปากีสถาน"},
+		{"เวลาเปรู", "เวลามาตรฐานเปรู", "เวลาฤดูร้อนเปรู"},
+		{"เวลาฟิลิปปินส์", "เวลามาตรฐานฟิลิปปินส์", "เวลาฤดูร้อนฟิลิปปินส์"},
+		{"", "เวลาสิงคโปร์", ""},
+		{"เวลาไทเป", "เวลามาตรฐานไทเป", "เวลาออมแสงไทเป"},
+		{"", "เวลาเวเนซุเอลา", ""},
+		{"", "เวลาสากลเชิงพิกัด", ""},
+		{"", "", "เวลามาตรฐานไอร์แลนด์"},
+		{"", "", "เวลาฤดูร้อนอังกฤษ"},
+		{"", "", ""},
+	},
+	"tk": {
+		{"", "Merkezi Afrika wagty", ""},
+		{"", "Gündogar Afrika wagty", ""},
+		{"", "Günorta Afrika standart wagty", ""},
+		{"Günbatar Afrika wagty", "Günbatar Afrika standart wagty", "Günbatar Afrika tomusky wagty"},
+		{"Alýaska wagty", "Alýaska standart wagty", "Alýaska tomusky wagty"},
+		{"Merkezi Amerika", "Merkezi Amerika standart wagty", "Merkezi Amerika tomusky wagty"},
+		{"Demirgazyk Amerika gündogar wagty", "Demirgazyk Amerika gündogar standart wagty", "Demirgazyk Amerika gündogar tomusky wagty"},
+		{"Demirgazyk Amerika dag wagty", "Demirgazyk Amerika dag standart wagty", "Demirgazyk Amerika dag tomusky wagty"},
+		{"Demirgazyk Amerika Ýuwaş umman wagty", "Demirgazyk Amerika Ýuwaş umman standart wagty", "Demirgazyk Amerika Ýuwaş umman tomusky wagty"},
+		{"Arap ýurtlary wagty", "Arap ýurtlary standart wagty", "Arap ýurtlary tomusky wagty"},
+		{"Argentina wagty", "Argentina standart wagty", "Argentina tomusky wagty"},
+		{"Atlantik wagty", "Atlantik standart wagty", "Atlantik tomusky wagty"},
+		{"Merkezi Awstraliýa wagty", "Merkezi Awstraliýa standart wagty", "Merkezi Awstraliýa tomusky wagty"},
+		{"Gündogar Awstraliýa wagty", "Gündogar Awstraliýa standart wagty", "Gündogar Awstraliýa tomusky wagty"},
+		{"Günbatar Awstraliýa wagty", "Günbatar Awstraliýa standart wagty", "Günbatar Awstraliýa tomusky wagty"},
+		{"Bangladeş wagty", "Bangladeş standart wagty", "Bangladeş tomusky wagty"},
+		{"Braziliýa wagty", "Braziliýa standart wagty", "Braziliýa tomusky wagty"},
+		{"Çili wagty", "Çili standart wagty", "Çili tomusky wagty"},
+		{"Hytaý wagty", "Hytaý standart wagty", "Hytaý tomusky wagty"},
+		{"Kolumbiýa wagty", "Kolumbiýa standart wagty", "Kolumbiýa tomusky wagty"},
+		{"Merkezi Ýewropa wagty", "Merkezi Ýewropa standart wagty", "Merkezi Ýewropa tomusky wagty"},
+		{"Gündogar Ýewropa wagty", "Gündogar Ýewropa standart wagty", "Gündogar Ýewropa tomusky wagty"},
+		{"Günbatar Ýewropa wagty", "Günbatar Ýewropa standart wagty", "Günbatar Ýewropa tomusky wagty"},
+		{"", "Grinwiç boýunça orta wagt", ""},
+		{"", "Pars aýlagy standart wagty", ""},
+		{"Gawaý-Aleut wagty", "Gawaý-Aleut standart wagty", "Gawaý-Aleut tomusky wagty"},
+		{"Gonkong wagty", "Gonkong standart wagty", "Gonkong tomusky wagty"},
+		{"", "Hindistan standart wagty", ""},
+		{"", "Hindihytaý wagty", ""},
+		{"", "Günbatar Indoneziýa wagty", ""},
+		{"Eýran wagty", "Eýran standart wagty", "Eýran tomusky wagty"},
+		{"Ysraýyl wagty", "Ysraýyl standart wagty", "Ysraýyl tomusky wagty"},
+		{"Ýaponiýa wagty", "Ýaponiýa standart wagty", "Ýaponiýa tomusky wagty"},
+		{"Koreýa wagty", "Koreýa standart wagty", "Koreýa tomusky wagty"},
+		{"", "Malaýziýa wagty", ""},
+		{"Moskwa wagty", "Moskwa standart wagty", "Moskwa tomusky wagty"},
+		{"Täze Zelandiýa wagty", "Täze Zelandiýa standart wagty", "Täze Zelandiýa tomusky wagty"},
+		{"Nýufaundlend wagty", "Nýufaundlend standart wagty", "Nýufaundlend tomusky wagty"},
+		{"Pakistan wagty", "Pakistan standart wagty", "Pakistan tomusky wagty"},
+		{"Peru wagty", "Peru standart wagty", "Peru tomusky wagty"},
+		{"Filippinler wagty", "Filippinler standart wagty", "Filippinler tomusky wagty"},
+		{"", "Singapur wagty", ""},
+		{"Taýbeý wagty", "Taýbeý standart wagty", "Taýbeý tomusky wagty"},
+		{"", "Wenesuela wagty", ""},
+		{"", "Utgaşdyrylýan ähliumumy wagt", ""},
+		{"", "", "Irlandiýa standart wagty"},
+		{"", "", "Beýik Britaniýa tomusky wagty"},
+		{"", "", ""},
+	},
+	"tl": {
+		{"", "Oras sa Gitnang Africa", ""},
+		{"", "Oras sa Silangang Africa", ""},
+		{"", "Oras sa Timog Africa", ""},
+		{"Oras sa Kanlurang Africa", "Standard na Oras sa Kanlurang Africa", "Oras sa Tag-init ng Kanlurang Africa"},
+		{"Oras sa Alaska", "Standard na Oras sa Alaska", "Daylight Time sa Alaska"},
+		{"Sentral na Oras", "Sentral na Karaniwang Oras", "Sentral na Daylight Time"},
+		{"Eastern Time", "Eastern na Standard na Oras", "Eastern Daylight Time"},
+		{"Oras sa Bundok", "Standard na Oras sa Bundok", "Daylight Time sa Bundok"},
+		{"Oras sa Pasipiko", "Standard na Oras sa Pasipiko", "Daylight Time sa Pasipiko"},
+		{"Oras sa Arabia", "Standard na Oras sa Arabia", "Daylight Time sa Arabia"},
+		{"Oras sa Argentina", "Standard na Oras sa Argentina", "Oras sa Tag-init ng Argentina"},
+		{"Oras sa Atlantiko", "Standard na Oras sa Atlantiko", "Daylight Time sa Atlantiko"},
+		{"Oras sa Gitnang Australya", "Standard na Oras sa Gitnang Australya", "Daylight Time sa Gitnang Australya"},
+		{"Oras sa Silangang Australya", "Standard na Oras sa Silangang Australya", "Daylight Time sa Silangang Australya"},
+		{"Oras sa Kanlurang Australya", "Standard na Oras sa Kanlurang Australya", "Daylight Time sa Kanlurang Australya"},
+		{"Oras sa Bangladesh", "Standard na Oras sa Bangladesh", "Oras sa Tag-init ng Bangladesh"},
+		{"Oras sa Brasilia", "Standard na Oras sa Brasilia", "Oras sa Tag-init ng Brasilia"},
+		{"Oras sa Chile", "Standard na Oras sa Chile", "Oras sa Tag-init ng Chile"},
+		{"Oras sa China", "Standard na Oras sa China", "Daylight Time sa China"},
+		{"Oras sa Colombia", "Standard na Oras sa Colombia", "Oras sa Tag-init ng Colombia"},
+		{"Oras sa Gitnang Europe", "Standard na Oras sa Gitnang Europe", "Oras sa Tag-init ng Gitnang Europe"},
+		{"Oras sa Silangang Europe", "Standard na Oras sa Silangang Europe", "Oras sa Tag-init ng Silangang Europe"},
+		{"Oras sa Kanlurang Europe", "Standard na Oras sa Kanlurang Europe", "Oras sa Tag-init ng Kanlurang Europe"},
+		{"", "Greenwich Mean Time", ""},
+		{"", "Oras sa Gulf", ""},
+		{"Oras sa Hawaii-Aleutian", "Standard na Oras sa Hawaii-Aleutian", "Oras sa Tag-init ng Hawaii-Aleutian"},
+		{"Oras sa Hong Kong", "Standard na Oras sa Hong Kong", "Oras sa Tag-init ng Hong Kong"},
+		{"", "Standard na Oras sa Bhutan", ""},
+		{"", "Oras sa Indochina", ""},
+		{"", "Oras sa Kanlurang Indonesia", ""},
+		{"Oras sa Iran", "Standard na Oras sa Iran", "Daylight Time sa Iran"},
+		{"Oras sa Israel", "Standard na Oras sa Israel", "Daylight Time sa Israel"},
+		{"Oras sa Japan", "Standard na Oras sa Japan", "Daylight Time sa Japan"},
+		{"Oras sa Korea", "Standard na Oras sa Korea", "Daylight Time sa Korea"},
+		{"", "Oras sa Malaysia", ""},
+		{"Oras sa Moscow", "Standard na Oras sa Moscow", "Oras sa Tag-init ng Moscow"},
+		{"Oras sa New Zealand", "Standard na Oras sa New Zealand", "Daylight Time sa New Zealand"},
+		{"Oras sa Newfoundland", "Standard na Oras sa Newfoundland", "Daylight Time sa Newfoundland"},
+		{"Oras sa Pakistan", "Standard na Oras sa Pakistan", "Oras sa Tag-init ng Pakistan"},
+		{"Oras sa Peru", "Standard na Oras sa Peru", "Oras sa Tag-init ng Peru"},
+		{"Oras sa Pilipinas", "Standard na Oras sa Pilipinas", "Oras sa Tag-init ng Pilipinas"},
+		{"", "Standard na Oras sa Singapore", ""},
+		{"Oras sa Taipei", "Standard na Oras sa Taipei", "Daylight Time sa Taipei"},
+		{"", "Oras sa Venezuela", ""},
+		{"", "Coordinated Universal Time", ""},
+		{"", "", "Standard na Oras sa Ireland"},
+		{"", "", "Oras sa Tag-init ng Britain"},
+		{"", "", ""},
+	},
+	"tr": {
+		{"", "Orta Afrika Saati", ""},
+		{"", "Doğu Afrika Saati", ""},
+		{"", "Güney Afrika Standart Saati", ""},
+		{"Batı Afrika Saati", "Batı Afrika Standart Saati", "Batı Afrika Yaz Saati"},
+		{"Alaska Saati", "Alaska Standart Saati", "Alaska Yaz Saati"},
+		{"Kuzey Amerika Merkezi Saati", "Kuzey Amerika Merkezi Standart Saati", "Kuzey Amerika Merkezi Yaz Saati"},
+		{"Kuzey Amerika Doğu Saati", "Kuzey Amerika Doğu Standart Saati", "Kuzey Amerika Doğu Yaz Saati"},
+		{"Kuzey Amerika Dağ Saati", "Kuzey Amerika Dağ Standart Saati", "Kuzey Amerika Dağ Yaz Saati"},
+		{"Kuzey Amerika Pasifik Saati", "Kuzey Amerika Pasifik Standart Saati", "Kuzey Amerika Pasifik Yaz Saati"},
+		{"Arabistan Saati", "Arabistan Standart Saati", "Arabistan Yaz Saati"},
+		{"Arjantin Saati", "Arjantin Standart Saati", "Arjantin Yaz Saati"},
+		{"Atlantik Saati", "Atlantik Standart Saati", "Atlantik Yaz Saati"},
+		{"Orta Avustralya Saati", "Orta Avustralya Standart Saati", "Orta Avustralya Yaz Saati"},
+		{"Doğu Avustralya Saati", "Doğu Avustralya Standart Saati", "Doğu Avustralya Yaz Saati"},
+		{"Batı Avustralya Saati", "Batı Avustralya Standart Saati", "Batı Avustralya Yaz Saati"},
+		{"Bangladeş Saati", "Bangladeş Standart Saati", "Bangladeş Yaz Saati"},
+		{"Brasilia Saati", "Brasilia Standart Saati", "Brasilia Yaz Saati"},
+		{"Şili Saati", "Şili Standart Saati", "Şili Yaz Saati"},
+		{"Çin Saati", "Çin Standart Saati", "Çin Yaz Saati"},
+		{"Kolombiya Saati", "Kolombiya Standart Saati", "Kolombiya Yaz Saati"},
+		{"Orta Avrupa Saati", "Orta Avrupa Standart Saati", "Orta Avrupa Yaz Saati"},
+		{"Doğu Avrupa Saati", "Doğu Avrupa Standart Saati", "Doğu Avrupa Yaz Saati"},
+		{"Batı Avrupa Saati", "Batı Avrupa Standart Saati", "Batı Avrupa Yaz Saati"},
+		{"", "Greenwich Ortalama Saati", ""},
+		{"", "Körfez Saati", ""},
+		{"Hawaii-Aleut Saati", "Hawaii-Aleut Standart Saati", "Hawaii-Aleut Yaz Saati"},
+		{"Hong Kong Saati", "Hong Kong Standart Saati", "Hong Kong Yaz Saati"},
+		{"", "Hindistan Standart Saati", ""},
+		{"", "Hindiçin Saati", ""},
+		{"", "Batı Endonezya Saati", ""},
+		{"İran Saati", "İran Standart Saati", "İran Yaz Saati"},
+		{"İsrail Saati", "İsrail Standart Saati", "İsrail Yaz Saati"},
+		{"Japonya Saati", "Japonya Standart Saati", "Japonya Yaz Saati"},
+		{"Kore Saati", "Kore Standart Saati", "Kore Yaz Saati"},
+		{"", "Malezya Saati", ""},
+		{"Moskova Saati", "Moskova Standart Saati", "Moskova Yaz Saati"},
+		{"Yeni Zelanda Saati", "Yeni Zelanda Standart Saati", "Yeni Zelanda Yaz Saati"},
+		{"Newfoundland Saati", "Newfoundland Standart Saati", "Newfoundland Yaz Saati"},
+		{"Pakistan Saati", "Pakistan Standart Saati", "Pakistan Yaz Saati"},
+		{"Peru Saati", "Peru Standart Saati", "Peru Yaz Saati"},
+		{"Filipinler Saati", "Filipinler Standart Saati", "Filipinler Yaz Saati"},
+		{"", "Singapur Standart Saati", ""},
+		{"Taipei Saati", "Taipei Standart Saati", "Taipei Yaz Saati"},
+		{"", "Venezuela Saati", ""},
+		{"", "Eş Güdümlü Evrensel Zaman", ""},
+		{"", "", "İrlanda Standart Saati"},
+		{"", "", "İngiltere Yaz Saati"},
+		{"", "", ""},
+	},
+	"tt": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Төньяк Америка үзәк вакыты", "Төньяк Америка гадәти үзәк вакыты", "Төньяк Америка җәйге үзәк вакыты"},
+		{"Төньяк Америка көнчыгыш вакыты", "Төньяк Америка гадәти көнчыгыш вакыты", "Төньяк Америка җәйге көнчыгыш вакыты"},
+		{"Төньяк Америка тау вакыты", "Төньяк Америка гадәти тау вакыты", "Төньяк Америка җәйге тау вакыты"},
+		{"Төньяк Америка Тын океан вакыты", "Төньяк Америка гадәти Тын океан вакыты", "Төньяк Америка җәйге Тын океан вакыты"},
+		{"", "", ""},
+		{"", "", ""},
+		{"Төньяк Америка атлантик вакыты", "Төньяк Америка гадәти атлантик вакыты", "Төньяк Америка җәйге атлантик вакыты"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Үзәк Европа вакыты", "гадәти Үзәк Европа вакыты", "җәйге Үзәк Европа вакыты"},
+		{"Көнчыгыш Европа вакыты", "гадәти Көнчыгыш Европа вакыты", "җәйге Көнчыгыш Европа вакыты"},
+		{"Көнбатыш Европа вакыты", "гадәти Көнбатыш Европа вакыты", "җәйге Көнбатыш Европа вакыты"},
+		{"", "Гринвич уртача вакыты", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "Бөтендөнья килештерелгән вакыты", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"ug": {
+		{"", "ئوتتۇرا ئافرىقا ۋاقتى", ""},
+		{"", "شەرقىي ئافرىقا ۋاقتى", ""},
+		{"", "جەنۇبىي ئافرىقا ئۆلچەملىك ۋاقتى", ""},
+		{"غەربىي ئافرىقا ۋاقتى", "غەربىي ئافرىقا ئۆلچەملىك ۋاقتى", "غەربىي ئافرىقا يازلىق ۋاقتى"},
+		{"ئالياسكا ۋاقتى", "ئالياسكا ئۆلچەملىك ۋاقتى", "ئالياسكا يازلىق ۋاقتى"},
+		{"ئوتتۇرا قىسىم ۋاقتى", "ئوتتۇرا قىسىم ئۆلچەملىك ۋاقتى", "ئوتتۇرا قىسىم يازلىق ۋاقتى"},
+		{"شەرقىي قىسىم ۋاقتى", "شەرقىي قىسىم ئۆلچەملىك ۋاقتى", "شەرقىي قىسىم يازلىق ۋاقتى"},
+		{"تاغ ۋاقتى", "تاغ ئۆلچەملىك ۋاقتى", "تاغ يازلىق ۋاقتى"},
+		{"تىنچ ئوكيان ۋاقتى", "تىنچ ئوكيان ئۆلچەملىك ۋاقتى", "تىنچ ئوكيان يازلىق ۋاقتى"},
+		{"ئەرەب ۋاقتى", "ئەرەب ئۆلچەملىك ۋاقتى", "ئەرەب يازلىق ۋاقتى"},
+		{"ئارگېنتىنا ۋاقتى", "ئارگېنتىنا ئۆلچەملىك ۋاقتى", "ئارگېنتىنا يازلىق ۋاقتى"},
+		{"ئاتلانتىك ئوكيان ۋاقتى", "ئاتلانتىك ئوكيان ئۆلچەملىك ۋاقتى", "ئاتلانتىك ئوكيان يازلىق ۋاقتى"},
+		{"ئاۋسترالىيە ئوتتۇرا قىسىم ۋاقتى", "ئاۋسترالىيە ئوتتۇرا قىسىم ئۆلچەملىك ۋاقتى", "ئاۋسترالىيە ئوتتۇرا قىسىم يازلىق ۋاقتى"},
+		{"ئاۋسترالىيە شەرقىي قىسىم ۋاقتى", "ئاۋسترالىيە شەرقىي قىسىم ئۆلچەملىك ۋاقتى", "ئاۋسترالىيە شەرقىي قىسىم يازلىق ۋاقتى"},
+		{"ئاۋسترالىيە غەربىي قىسىم ۋاقتى", "ئاۋسترالىيە غەربىي قىسىم ئۆلچەملىك ۋاقتى", "ئاۋسترالىيە غەربىي قىسىم يازلىق ۋاقتى"},
+		{"باڭلادىش ۋاقتى", "باڭلادىش ئۆلچەملىك ۋاقتى", "باڭلادىش يازلىق ۋاقتى"},
+		{"بىرازىلىيە ۋاقتى", "بىرازىلىيە ئۆلچەملىك ۋاقتى", "بىرازىلىيە يازلىق ۋاقتى"},
+		{"چىلى ۋاقتى", "چىلى ئۆلچەملىك ۋاقتى", "چىلى يازلىق ۋاقتى"},
+		{"جۇڭگو ۋاقتى", "جۇڭگو ئۆلچەملىك ۋاقتى", "جۇڭگو يازلىق ۋاقتى"},
+		{"كولومبىيە ۋاقتى", "كولومبىيە ئۆلچەملىك ۋاقتى", "كولومبىيە يازلىق ۋاقتى"},
+		{"ئوتتۇرا ياۋروپا ۋاقتى", "ئوتتۇرا ياۋروپا ئۆلچەملىك ۋاقتى", "ئوتتۇرا ياۋروپا يازلىق ۋاقتى"},
+		{"شەرقىي ياۋروپا ۋاقتى", "شەرقىي ياۋروپا ئۆلچەملىك ۋاقتى", "شەرقىي ياۋروپا يازلىق ۋاقتى"},
+		{"غەربىي ياۋروپا ۋاقتى", "غەربىي ياۋروپا ئۆلچەملىك ۋاقتى", "غەربىي ياۋروپا يازلىق ۋاقتى"},
+		{"", "گىرىنۋىچ ۋاقتى", ""},
+		{"", "گۇلف ئۆلچەملىك ۋاقتى", ""},
+		{"ھاۋاي-ئالېيۇت ۋاقتى", "ھاۋاي-ئالېيۇت ئۆلچەملىك ۋاقتى", "ھاۋاي-ئالېيۇت يازلىق ۋاقتى"},
+		{"شياڭگاڭ ۋاقتى", "شياڭگاڭ ئۆلچەملىك ۋاقتى", "شياڭگاڭ يازلىق ۋاقتى"},
+		{"", "ھىندىستان ئۆلچەملىك ۋاقتى", ""},
+		{"", "ھىندى چىنى ۋاقتى", ""},
+		{"", "غەربىي ھىندونېزىيە ۋاقتى", ""},
+		{"ئىران ۋاقتى", "ئىران ئۆلچەملىك ۋاقتى", "ئىران يازلىق ۋاقتى"},
+		{"ئىسرائىلىيە ۋاقتى", "ئىسرائىلىيە ئۆلچەملىك ۋاقتى", "ئىسرائىلىيە يازلىق ۋاقتى"},
+		{"ياپونىيە ۋاقتى", "ياپونىيە ئۆلچەملىك ۋاقتى", "ياپونىيە يازلىق ۋاقتى"},
+		{"كورىيە ۋاقتى", "كورىيە ئۆلچەملىك ۋاقتى", "كورىيە يازلىق ۋاقتى"},
+		{"", "مالايشىيا ۋاقتى", ""},
+		{"موسكۋا ۋاقتى", "موسكۋا ئۆلچەملىك ۋاقتى", "موسكۋا يازلىق ۋاقتى"},
+		{"يېڭى زېلاندىيە ۋاقتى", "يېڭى زېلاندىيە ئۆلچەملىك ۋاقتى", "يېڭى زېلاندىيە يازلىق ۋاقتى"},
+		{"نىۋفوئۇنلاند ۋاقتى", "نىۋفوئۇنلاند ئۆلچەملىك ۋاقتى", "نىۋفوئۇنلاند يازلىق ۋاقتى"},
+		{"پاكىستان ۋاقتى", "پاكىستان ئۆلچەملىك ۋاقتى", "پاكىستان يازلىق ۋاقتى"},
+		{"پېرۇ ۋاقتى", "پېرۇ ئۆلچەملىك ۋاقتى", "پېرۇ يازلىق ۋاقتى"},
+		{"فىلىپپىن ۋاقتى", "فىلىپپىن ئۆلچەملىك ۋاقتى", "فىلىپپىن يازلىق ۋاقتى"},
+		{"", "سىنگاپور ۋاقتى", ""},
+		{"تەيبېي ۋاقتى", "تەيبېي ئۆلچەملىك ۋاقتى", "تەيبېي يازلىق ۋاقتى"},
+		{"", "ۋېنېزۇئېلا ۋاقتى", ""},
+		{"", "", ""},
+		{"", "", "ئىرېلاند يازلىق ۋاقتى"},
+		{"", "", "ئەنگلىيە يازلىق ۋاقتى"},
+		{"", "", ""},
+	},
+	"uk": {
+		{"", "за центральноафриканським часом", ""},
+		{"", "за східноафриканським часом", ""},
+		{"", "за південноафриканським часом", ""},
+		{"за західноафриканським часом", "за західноафриканським стандартним часом", "за західноафриканським літнім часом"},
+		{"за часом на Алясці", "за стандартним часом на Алясці", "за літнім часом на Алясці"},
+		{"за північноамериканським центральним часом", "за північноамериканським центральним стандартним часом", "за північноамериканським центральним літнім часом"},
+		{"за північноамериканським східним часом", "за північноамериканським східним стандартним часом", "за північноамериканським східним літнім часом"},
+		{"за північноамериканським гірським часом", "за північноамериканським гірським стандартним часом", "за північноамериканським гірським літнім часом"},
+		{"за північноамериканським тихоокеанським часом", "за північноамериканським тихоокеанським стандартним часом", "за північноамериканським тихоокеанським літнім часом"},
+		{"за арабським часом", "за арабським стандартним часом", "за арабським літнім часом"},
+		{"за аргентинським часом", "за стандартним аргентинським часом", "за літнім аргентинським часом"},
+		{"за атлантичним часом", "за атлантичним стандартним часом", "за атлантичним літнім часом"},
+		{"за центральноавстралійським часом", "за стандартним центральноавстралійським часом", "за літнім центральноавстралійським часом"},
+		{"за східноавстралійським часом", "за стандартним східноавстралійським часом", "за літнім східноавстралійським часом"},
+		{"за західноавстралійським часом", "за стандартним західноавстралійським часом", "за літнім західноавстралійським часом"},
+		{"за часом у Бангладеш", "за стандартним часом у Бангладеш", "за літнім часом у Бангладеш"},
+		{"за бразильським часом", "за стандартним бразильським часом", "за літнім бразильським часом"},
+		{"за чилійським часом", "за стандартним чилійським часом", "за літнім чилійським часом"},
+		{"за китайським часом", "за китайським стандартним часом", "за китайським літнім часом"},
+		{"за колумбійським часом", "за стандартним колумбійським часом", "за літнім колумбійським часом"},
+		{"за центральноєвропейським часом", "за центральноєвропейським стандартним часом", "за центральноєвропейським літнім часом"},
+		{"за східноєвропейським часом", "за східноєвропейським стандартним часом", "за східноєвропейським літнім часом"},
+		{"за західноєвропейським часом", "за західноєвропейським стандартним часом", "за західноєвропейським літнім часом"},
+		{"", "за Ґрінвічем", ""},
+		{"", "за часом Перської затоки", ""},
+		{"за гавайсько-алеутським часом", "за стандартним гавайсько-алеутським часом", "за літнім гавайсько-алеутським часом"},
+		{"за часом у Гонконзі", "за стандартним часом у Гонконзі", "за літнім часом у Гонконзі"},
+		{"", "за індійським стандартним часом", ""},
+		{"", "за часом в Індокитаї", ""},
+		{"", "за західноіндонезійським часом", ""},
+		{"за іранським часом", "за іранським стандартним часом", "за іранським літнім часом"},
+		{"за ізраїльським часом", "за ізраїльським стандартним часом", "за ізраїльським літнім часом"},
+		{"за японським часом", "за японським стандартним часом", "за японським літнім часом"},
+		{"за корейським часом", "за корейським стандартним часом", "за корейським літнім часом"},
+		{"", "за часом у Малайзії", ""},
+		{"за московським часом", "за московським стандартним часом", "за московським літнім часом"},
+		{"за часом у Новій Зеландії", "за стандартним часом у Новій Зеландії", "за літнім часом у Новій Зеландії"},
+		{"за часом на острові Ньюфаундленд", "за стандартним часом на острові Ньюфаундленд", "за літнім часом у Ньюфаундленд"},
+		{"за часом у Пакистані", "за стандартним часом у Пакистані", "за літнім часом у Пакистані"},
+		{"за часом у Перу", "за стандартним часом у Перу", "за літнім часом у Перу"},
+		{"за часом на Філіппінах", "за стандартним часом на Філіппінах", "за літнім часом на Філіппінах"},
+		{"", "за часом у Сінґапурі", ""},
+		{"за часом у Тайбеї", "за стандартним часом у Тайбеї", "за літнім часом у Тайбеї"},
+		{"", "за часом у Венесуелі", ""},
+		{"", "за всесвітнім координованим часом", ""},
+		{"", "", "за літнім часом в Ірландії"},
+		{"", "", "за літнім часом у Великій Британії"},
+		{"", "", ""},
+	},
+	"ur": {
+		{"", "وسطی افریقہ ٹائم", ""},
+		{"", "مشرقی افریقہ ٹائم", ""},
+		{"", "جنوبی افریقہ سٹینڈرڈ ٹائم", ""},
+		{"مغربی افریقہ ٹائم", "مغربی افریقہ سٹینڈرڈ ٹائم", "مغربی افریقہ سمر ٹائم"},
+		{"الاسکا ٹائم", "الاسکا اسٹینڈرڈ ٹائم", "الاسکا ڈے لائٹ ٹائم"},
+		{"سنٹرل ٹائم", "سنٹرل اسٹینڈرڈ ٹائم", "سنٹرل ڈے لائٹ ٹائم"},
+		{"ایسٹرن ٹائم", "ایسٹرن اسٹینڈرڈ ٹائم", "ایسٹرن ڈے لائٹ ٹائم"},
+		{"ماؤنٹین ٹائم", "ماؤنٹین اسٹینڈرڈ ٹائم", "ماؤنٹین ڈے لائٹ ٹائم"},
+		{"پیسفک ٹائم", "پیسفک اسٹینڈرڈ ٹائم", "پیسفک ڈے لائٹ ٹائم"},
+		{"عرب کا وقت", "عرب کا معیاری وقت", "عرب ڈے لائٹ ٹائم"},
+		{"ارجنٹینا ٹائم", "ارجنٹینا سٹینڈرڈ ٹائم", "ارجنٹینا سمر ٹائم"},
+		{"اٹلانٹک ٹائم", "اٹلانٹک اسٹینڈرڈ ٹائم", "اٹلانٹک ڈے لائٹ ٹائم"},
+		{"سنٹرل آسٹریلیا ٹائم", "آسٹریلین سنٹرل اسٹینڈرڈ ٹائم", "آسٹریلین سنٹرل ڈے لائٹ ٹائم"},
+		{"ایسٹرن آسٹریلیا ٹائم", "آسٹریلین ایسٹرن اسٹینڈرڈ ٹائم", "آسٹریلین ایسٹرن ڈے لائٹ ٹائم"},
+		{"ویسٹرن آسٹریلیا ٹائم", "آسٹریلیا ویسٹرن اسٹینڈرڈ ٹائم", "آسٹریلین ویسٹرن ڈے لائٹ ٹائم"},
+		{"بنگلہ دیش کا وقت", "بنگلہ دیش کا معیاری وقت", "بنگلہ دیش کا موسم گرما کا وقت"},
+		{"برازیلیا ٹائم", "برازیلیا اسٹینڈرڈ ٹائم", "برازیلیا سمر ٹائم"},
+		{"چلی کا وقت", "چلی کا معیاری وقت", "چلی کا موسم گرما کا وقت"},
+		{"چین ٹائم", "چین سٹینڈرڈ ٹائم", "چینی ڈے لائٹ ٹائم"},
+		{"کولمبیا ٹائم", "کولمبیا کا معیاری وقت", "کولمبیا کا موسم گرما کا وقت"},
+		{"وسط یورپ کا وقت", "وسطی یورپ کا معیاری وقت", "وسطی یورپ کا موسم گرما کا وقت"},
+		{"مشرقی یورپ کا وقت", "مشرقی یورپ کا معیاری وقت", "مشرقی یورپ کا موسم گرما کا وقت"},
+		{"مغربی یورپ کا وقت", "مغربی یورپ کا معیاری وقت", "مغربی یورپ کا موسم گرما کا وقت"},
+		{"", "گرین وچ کا اصل وقت", ""},
+		{"", "خلیج کا معیاری وقت", ""},
+		{"ہوائی الیوٹیئن ٹائم", "ہوائی الیوٹیئن اسٹینڈرڈ ٹائم", "ہوائی الیوٹیئن ڈے لائٹ ٹائم"},
+		{"ہانگ کانگ ٹائم", "ہانگ کانگ سٹینڈرڈ ٹائم", "ہانگ کانگ سمر ٹائم"},
+		{"", "ہندوستان کا معیاری وقت", ""},
+		{"", "ہند چین ٹائم", ""},
+		{"", "مغربی انڈونیشیا ٹائم", ""},
+		{"ایران کا وقت", "ایران کا معیاری وقت", "ایران ڈے لائٹ ٹائم"},
+		{"اسرائیل کا وقت", "اسرائیل کا معیاری وقت", "اسرائیل ڈے لائٹ ٹائم"},
+		{"جاپان ٹائم", "جاپان سٹینڈرڈ ٹائم", "جاپان ڈے لائٹ ٹائم"},
+		{"کوریا ٹائم", "کوریا سٹینڈرڈ ٹائم", "کوریا ڈے لائٹ ٹائم"},
+		{"", "ملیشیا ٹائم", ""},
+		{"ماسکو ٹائم", "ماسکو اسٹینڈرڈ ٹائم", "ماسکو سمر ٹائم"},
+		{"نیوزی لینڈ کا وقت", "نیوزی لینڈ کا معیاری وقت", "نیوزی لینڈ ڈے لائٹ ٹائم"},
+		{"نیو فاؤنڈ لینڈ ٹائم", "نیو فاؤنڈ لینڈ اسٹینڈرڈ ٹائم", "نیو فاؤنڈ لینڈ ڈے لائٹ ٹائم"},
+		{"پاکستان کا وقت", "پاکستان کا معیاری وقت", "پاکستان کا موسم گرما کا وقت"},
+		{"پیرو کا وقت", "پیرو کا معیاری وقت", "پیرو کا موسم گرما کا وقت"},
+		{"فلپائن ٹائم", "فلپائن سٹینڈرڈ ٹائم", "فلپائن سمر ٹائم"},
+		{"", "سنگاپور سٹینڈرڈ ٹائم", ""},
+		{"تائی پیئی ٹائم", "تائی پیئی اسٹینڈرڈ ٹائم", "تئی پیئی ڈے لائٹ ٹائم"},
+		{"", "وینزوئیلا کا وقت", ""},
+		{"", "کوآرڈینیٹڈ یونیورسل ٹائم", ""},
+		{"", "", "آئرش اسٹینڈرڈ ٹائم"},
+		{"", "", "برٹش سمر ٹائم"},
+		{"", "", ""},
+	},
+	"uz": {
+		{"", "Markaziy Afrika vaqti", ""},
+		{"", "Sharqiy Afrika vaqti", ""},
+		{"", "Janubiy Afrika standart vaqti", ""},
+		{"Gʻarbiy Afrika vaqti", "Gʻarbiy Afrika standart vaqti", "Gʻarbiy Afrika yozgi vaqti"},
+		{"Alyaska vaqti", "Alyaska standart vaqti", "Alyaska yozgi vaqti"},
+		{"Markaziy Amerika vaqti", "Markaziy Amerika standart vaqti", "Markaziy Amerika yozgi vaqti"},
+		{"Sharqiy Amerika vaqti", "Sharqiy Amerika standart vaqti", "Sharqiy Amerika yozgi vaqti"},
+		{"Tog‘ vaqti (AQSH)", "Tog‘ standart vaqti (AQSH)", "Tog‘ yozgi vaqti (AQSH)"},
+		{"Tinch okeani vaqti", "Tinch okeani standart vaqti", "Tinch okeani yozgi vaqti"},
+		{"Saudiya Arabistoni vaqti", "Saudiya Arabistoni standart vaqti", "Saudiya Arabistoni yozgi vaqti"},
+		{"Argentina vaqti", "Argentina standart vaqti", "Argentina yozgi vaqti"},
+		{"Atlantika vaqti", "Atlantika standart vaqti", "Atlantika yozgi vaqti"},
+		{"Markaziy Avstraliya vaqti", "Markaziy Avstraliya standart vaqti", "Markaziy Avstraliya yozgi vaqti"},
+		{"Sharqiy Avstraliya vaqti", "Sharqiy Avstraliya standart vaqti", "Sharqiy Avstraliya yozgi vaqti"},
+		{"G‘arbiy Avstraliya vaqti", "G‘arbiy Avstraliya standart vaqti", "G‘arbiy Avstraliya yozgi vaqti"},
+		{"Bangladesh vaqti", "Bangladesh standart vaqti", "Bangladesh yozgi vaqti"},
+		{"Braziliya vaqti", "Braziliya standart vaqti", "Braziliya yozgi vaqti"},
+		{"Chili vaqti", "Chili standart vaqti", "Chili yozgi vaqti"},
+		{"Xitoy vaqti", "Xitoy standart vaqti", "Xitoy yozgi vaqti"},
+		{"Kolumbiya vaqti", "Kolumbiya standart vaqti", "Kolumbiya yozgi vaqti"},
+		{"Markaziy Yevropa vaqti", "Markaziy Yevropa standart vaqti", "Markaziy Yevropa yozgi vaqti"},
+		{"Sharqiy Yevropa vaqti", "Sharqiy Yevropa standart vaqti", "Sharqiy Yevropa yozgi vaqti"},
+		{"G‘arbiy Yevropa vaqti", "G‘arbiy Yevropa standart vaqti", "G‘arbiy Yevropa yozgi vaqti"},
+		{"", "Grinvich o‘rtacha vaqti", ""},
+		{"", "Fors ko‘rfazi standart vaqti", ""},
+		{"Gavayi-aleut vaqti", "Gavayi-aleut standart vaqti", "Gavayi-aleut yozgi vaqti"},
+		{"Gonkong vaqti", "Gonkong standart vaqti", "Gonkong yozgi vaqti"},
+		{"", "Hindiston standart vaqti", ""},
+		{"", "Hindixitoy vaqti", ""},
+		{"", "Gʻarbiy Indoneziya vaqti", ""},
+		{"Eron vaqti", "Eron standart vaqti", "Eron yozgi vaqti"},
+		{"Isroil vaqti", "Isroil standart vaqti", "Isroil yozgi vaqti"},
+		{"Yaponiya vaqti", "Yaponiya standart vaqti", "Yaponiya yozgi vaqti"},
+		{"Koreya vaqti", "Koreya standart vaqti", "Koreya yozgi vaqti"},
+		{"", "Malayziya vaqti", ""},
+		{"Moskva vaqti", "Moskva standart vaqti", "Moskva yozgi vaqti"},
+		{"Yangi Zelandiya vaqti", "Yangi Zelandiya standart vaqti", "Yangi Zelandiya yozgi vaqti"},
+		{"Nyufaundlend vaqti", "Nyufaundlend standart vaqti", "Nyufaundlend yozgi vaqti"},
+		{"Pokiston vaqti", "Pokiston standart vaqti", "Pokiston yozgi vaqti"},
+		{"Peru vaqti", "Peru standart vaqti", "Peru yozgi vaqti"},
+		{"Filippin vaqti", "Filippin standart vaqti", "Filippin yozgi vaqti"},
+		{"", "Singapur vaqti", ""},
+		{"Tayvan vaqti", "Tayvan standart vaqti", "Tayvan yozgi vaqti"},
+		{"", "Venesuela vaqti", ""},
+		{"", "Koordinatali universal vaqt", ""},
+		{"", "", "Irlandiya yozgi vaqti"},
+		{"", "", "Britaniya yozgi vaqti"},
+		{"", "", ""},
+	},
+	"vi": {
+		{"", "Giờ Trung Phi", ""},
+		{"", "Giờ Đông Phi", ""},
+		{"", "Giờ Chuẩn Nam Phi", ""},
+		{"Giờ Tây Phi", "Giờ Chuẩn Tây Phi", "Giờ Mùa Hè Tây Phi"},
+		{"Giờ Alaska", "Giờ Chuẩn Alaska", "Giờ Mùa Hè Alaska"},
+		{"Giờ miền Trung", "Giờ chuẩn miền Trung", "Giờ mùa hè miền Trung"},
+		{"Giờ miền Đông", "Giờ chuẩn miền Đông", "Giờ mùa hè miền Đông"},
+		{"Giờ miền núi", "Giờ chuẩn miền núi", "Giờ mùa hè miền núi"},
+		{"Giờ Thái Bình Dương", "Giờ chuẩn Thái Bình Dương", "Giờ mùa hè Thái Bình Dương"},
+		{"Giờ Ả Rập", "Giờ chuẩn Ả Rập", "Giờ Mùa Hè Ả Rập"},
+		{"Giờ Argentina", "Giờ Chuẩn Argentina", "Giờ Mùa Hè Argentina"},
+		{"Giờ Đại Tây Dương", "Giờ Chuẩn Đại Tây Dương", "Giờ mùa hè Đại Tây Dương"},
+		{"Giờ Miền Trung Australia", "Giờ Chuẩn Miền Trung Australia", "Giờ Mùa Hè Miền Trung Australia"},
+		{"Giờ Miền Đông Australia", "Giờ Chuẩn Miền Đông Australia", "Giờ Mùa Hè Miền Đông Australia"},
+		{"Giờ Miền Tây Australia", "Giờ Chuẩn Miền Tây Australia", "Giờ Mùa Hè Miền Tây Australia"},
+		{"Giờ Bangladesh", "Giờ Chuẩn Bangladesh", "Giờ Mùa Hè Bangladesh"},
+		{"Giờ Brasilia", "Giờ Chuẩn Brasilia", "Giờ Mùa Hè Brasilia"},
+		{"Giờ Chile", "Giờ Chuẩn Chile", "Giờ Mùa Hè Chile"},
+		{"Giờ Trung Quốc", "Giờ Chuẩn Trung Quốc", "Giờ Mùa Hè Trung Quốc"},
+		{"Giờ Colombia", "Giờ Chuẩn Colombia", "Giờ Mùa Hè Colombia"},
+		{"Giờ Trung Âu", "Giờ chuẩn Trung Âu", "Giờ mùa hè Trung Âu"},
+		{"Giờ Đông Âu", "Giờ chuẩn Đông Âu", "Giờ mùa hè Đông Âu"},
+		{"Giờ Tây Âu", "Giờ Chuẩn Tây Âu", "Giờ mùa hè Tây Âu"},
+		{"", "Giờ Trung bình Greenwich", ""},
+		{"", "Giờ Chuẩn Vùng Vịnh", ""},
+		{"Giờ Hawaii-Aleut", "Giờ Chuẩn Hawaii-Aleut", "Giờ Mùa Hè Hawaii-Aleut"},
+		{"Giờ Hồng Kông", "Giờ Chuẩn Hồng Kông", "Giờ Mùa Hè Hồng Kông"},
+		{"", "Giờ Chuẩn Ấn Độ", ""},
+		{"", "Giờ Đông Dương", ""},
+		{"", "Giờ Miền Tây Indonesia", ""},
+		{"Giờ Iran", "Giờ Chuẩn Iran", "Giờ Mùa Hè Iran"},
+		{"Giờ Israel", "Giờ Chuẩn Israel", "Giờ Mùa Hè Israel"},
+		{"Giờ Nhật Bản", "Giờ Chuẩn Nhật Bản", "Giờ Mùa Hè Nhật Bản"},
+		{"Giờ Hàn Quốc", "Giờ Chuẩn Hàn Quốc", "Giờ Mùa Hè Hàn Quốc"},
+		{"", "Giờ Malaysia", ""},
+		{"Giờ Matxcơva", "Giờ Chuẩn Matxcơva", "Giờ Mùa Hè Matxcơva"},
+		{"Giờ New Zealand", "Giờ Chuẩn New Zealand", "Giờ Mùa Hè New Zealand"},
+		{"Giờ Newfoundland", "Giờ Chuẩn Newfoundland", "Giờ Mùa Hè Newfoundland"},
+		{"Giờ Pakistan", "Giờ Chuẩn Pakistan", "Giờ Mùa Hè Pakistan"},
+		{"Giờ Peru", "Giờ Chuẩn Peru", "Giờ Mùa Hè Peru"},
+		{"Giờ Philippin", "Giờ Chuẩn Philippin", "Giờ Mùa Hè Philippin"},
+		{"", "Giờ Singapore", ""},
+		{"Giờ Đài Bắc", "Giờ Chuẩn Đài Bắc", "Giờ Mùa Hè Đài Bắc"},
+		{"", "Giờ Venezuela", ""},
+		{"", "Giờ Phối hợp Quốc tế", ""},
+		{"", "", "Giờ chuẩn Ai-len"},
+		{"", "", "Giờ Mùa Hè Anh"},
+		{"", "", ""},
+	},
+	"wae": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Atlantiši Zit", "Atlantiši Standardzit", "Atlantiši Summerzit"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"Mitteleuropäiši Zit", "Mitteleuropäiši Standardzit", "Mitteleuropäiši Summerzit"},
+		{"Ošteuropäiši Zit", "Ošteuropäiši Standardzit", "Ošteuropäiši Summerzit"},
+		{"Wešteuropäiši Zit", "Wešteuropäiši Standardzit", "Wešteuropäiši Summerzit"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"wo": {
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"CT (waxtu sàntaral)", "CST (waxtu estàndaaru sàntaraal)", "CDT (waxtu bëccëgu sàntaraal"},
+		{"ET waxtu penku", "EST (waxtu estàndaaru penku)", "EDT (waxtu bëccëgu penku)"},
+		{"MT (waxtu tundu)", "MST (waxtu estàndaaru tundu)", "MDT (waxtu bëccëgu tundu)"},
+		{"PT (waxtu pasifik)", "PST (waxtu estàndaaru pasifik)", "PDT (waxtu bëccëgu pasifik)"},
+		{"", "", ""},
+		{"", "", ""},
+		{"AT (waxtu atlàntik)", "AST (waxtu estàndaaru penku)", "ADT (waxtu bëccëgu atlàntik)"},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"CTE (waxtu ëroop sàntaraal)", "CEST (waxtu estàndaaru ëroop sàntaraal)", "CEST (waxtu ete wu ëroop sàntaraal)"},
+		{"EET (waxtu ëroop u penku)", "EEST (waxtu estàndaaru ëroop u penku)", "EEST (waxtu ete wu ëroop u penku)"},
+		{"WET (waxtu ëroop u sowwu-jant", "WEST (waxtu estàndaaru ëroop u sowwu-jant)", "WEST (waxtu ete wu ëroop u sowwu-jant)"},
+		{"", "GMT (waxtu Greenwich)", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "CUT (waxtu iniwelsel yuñ boole)", ""},
+		{"", "", ""},
+		{"", "", ""},
+		{"", "", ""},
+	},
+	"yue": {
+		{"", "中非時間", ""},
+		{"", "東非時間", ""},
+		{"", "南非標準時間", ""},
+		{"西非時間", "西非標準時間", "西非夏令時間"},
+		{"阿拉斯加時間", "阿拉斯加標準時間", "阿拉斯加夏令時間"},
+		{"中部時間", "中部標準時間", "中部夏令時間"},
+		{"東部時間", "東部標準時間", "東部夏令時間"},
+		{"山區時間", "山區標準時間", "山區夏令時間"},
+		{"太平洋時間", "太平洋標準時間", "太平洋夏令時間"},
+		{"阿拉伯時間", "阿拉伯標準時間", "阿拉伯夏令時間"},
+		{"阿根廷時間", "阿根廷標準時間", "阿根廷夏令時間"},
+		{"大西洋時間", "大西洋標準時間", "大西洋夏令時間"},
+		{"澳洲中部時間", "澳洲中部標準時間", "澳洲中部夏令時間"},
+		{"澳洲東部時間", "澳洲東部標準時間", "澳洲東部夏令時間"},
+		{"澳洲西部時間", "澳洲西部標準時間", "澳洲西部夏令時間"},
+		{"孟加拉時間", "孟加拉標準時間", "孟加拉夏令時間"},
+		{"巴西利亞時間", "巴西利亞標準時間", "巴西利亞夏令時間"},
+		{"智利時間", "智利標準時間", "智利夏令時間"},
+		{"中國時間", "中國標準時間", "中國夏令時間"},
+		{"哥倫比亞時間", "哥倫比亞標準時間", "哥倫比亞夏令時間"},
+		{"中歐時間", "中歐標準時間", "中歐夏令時間"},
+		{"東歐時間", "東歐標準時間", "東歐夏令時間"},
+		{"西歐時間", "西歐標準時間", "西歐夏令時間"},
+		{"", "格林威治標準時間", ""},
+		{"", "波斯灣海域標準時間", ""},
+		{"夏威夷-阿留申時間", "夏威夷-阿留申標準時間", "夏威夷-阿留申夏令時間"},
+		{"香港時間", "香港標準時間", "香港夏令時間"},
+		{"", "印度標準時間", ""},
+		{"", "印度支那時間", ""},
+		{"", "印尼西部時間", ""},
+		{"伊朗時間", "伊朗標準時間", "伊朗夏令時間"},
+		{"以色列時間", "以色列標準時間", "以色列夏令時間"},
+		{"日本時間", "日本標準時間", "日本夏令時間"},
+		{"韓國時間", "韓國標準時間", "韓國夏令時間"},
+		{"", "馬來西亞時間", ""},
+		{"莫斯科時間", "莫斯科標準時間", "莫斯科夏令時間"},
+		{"紐西蘭時間", "紐西蘭標準時間", "紐西蘭夏令時間"},
+		{"紐芬蘭時間", "紐芬蘭標準時間", "紐芬蘭夏令時間"},
+		{"巴基斯坦時間", "巴基斯坦標準時間", "巴基斯坦夏令時間"},
+		{"秘魯時間", "秘魯標準時間", "秘魯夏令時間"},
+		{"菲律賓時間", "菲律賓標準時間", "菲律賓夏令時間"},
+		{"", "新加坡標準時間", ""},
+		{"台北時間", "台北標準時間", "台北夏令時間"},
+		{"", "委內瑞拉時間", ""},
+		{"", "協調世界時間", ""},
+		{"", "", "愛爾蘭標準時間"},
+		{"", "", "英國夏令時間"},
+		{"", "", ""},
+	},
+	"zh": {
+		{"", "中部非洲时间", ""},
+		{"", "东部非洲时间", ""},
+		{"", "南非标准时间", ""},
+		{"西部非洲时间", "西部非洲标准时间", "西部非洲夏令时间"},
+		{"阿拉斯加时间", "阿拉斯加标准时间", "阿拉斯加夏令时间"},
+		{"北美中部时间", "北美中部标准时间", "北美中部夏令时间"},
+		{"北美东部时间", "北美东部标准时间", "北美东部夏令时间"},
+		{"北美山区时间", "北美山区标准时间", "北美山区夏令时间"},
+		{"北美太平洋时间", "北美太平洋标准时间", "北美太平洋夏令时间"},
+		{"阿拉伯时间", "阿拉伯标准时间", "阿拉伯夏令时间"},
+		{"阿根廷时间", "阿根廷标准时间", "阿根廷夏令时间"},
+		{"大西洋时间", "大西洋标准时间", "大西洋夏令时间"},
+		{"澳大利亚中部时间", "澳大利亚中部标准时间", "澳大利亚中部夏令时间"},
+		{"澳大利亚东部时间", "澳大利亚东部标准时间", "澳大利亚东部夏令时间"},
+		{"澳大利亚西部时间", "澳大利亚西部标准时间", "澳大利亚西部夏令时间"},
+		{"孟加拉时间", "孟加拉标准时间", "孟加拉夏令时间"},
+		{"巴西利亚时间", "巴西利亚标准时间", "巴西利亚夏令时间"},
+		{"智利时间", "智利标准时间", "智利夏令时间"},
+		{"中国时间", "中国标准时间", "中国夏令时间"},
+		{"哥伦比亚时间", "哥伦比亚标准时间", "哥伦比亚夏令时间"},
+		{"中欧时间", "中欧标准时间", "中欧夏令时间"},
+		{"东欧时间", "东欧标准时间", "东欧夏令时间"},
+		{"西欧时间", "西欧标准时间", "西欧夏令时间"},
+		{"", "格林尼治标准时间", ""},
+		{"", "海湾标准时间", ""},
+		{"夏威夷-阿留申时间", "夏威夷-阿留申标准时间", "夏威夷-阿留申夏令时间"},
+		{"香港时间", "香港标准时间", "香港夏令时间"},
+		{"", "印度时间", ""},
+		{"", "印度支那时间", ""},
+		{"", "印度尼西亚西部时间", ""},
+		{"伊朗时间", "伊朗标准时间", "伊朗夏令时间"},
+		{"以色列时间", "以色列标准时间", "以色列夏令时间"},
+		{"日本时间", "日本标准时间", "日本夏令时间"},
+		{"韩国时间", "韩国标准时间", "韩国夏令时间"},
+		{"", "马来西亚时间", ""},
+		{"莫斯科时间", "莫斯科标准时间", "莫斯科夏令时间"},
+		{"新西兰时间", "新西兰标准时间", "新西兰夏令时间"},
+		{"纽芬兰时间", "纽芬兰标准时间", "纽芬兰夏令时间"},
+		{"巴基斯坦时间", "巴基斯坦标准时间", "巴基斯坦夏令时间"},
+		{"秘鲁时间", "秘鲁标准时间", "秘鲁夏令时间"},
+		{"菲律宾时间", "菲律宾标准时间", "菲律宾夏令时间"},
+		{"", "新加坡标准时间", ""},
+		{"台北时间", "台北标准时间", "台北夏令时间"},
+		{"", "委内瑞拉时间", ""},
+		{"", "协调世界时", ""},
+		{"", "", "爱尔兰标准时间"},
+		{"", "", "英国夏令时间"},
+		{"", "", ""},
+	},
+	"zu": {
+		{"", "Isikhathi sase-Central Africa", ""},
+		{"", "Isikhathi saseMpumalanga Afrika", ""},
+		{"", "Isikhathi esijwayelekile saseNingizimu Afrika", ""},
+		{"Isikhathi saseNtshonalanga Afrika", "Isikhathi esijwayelekile saseNtshonalanga Afrika", "Isikhathi sasehlobo saseNtshonalanga Afrika"},
+		{"Isikhathi sase-Alaska", "Isikhathi sase-Alaska esijwayelekile", "Isikhathi sase-Alaska sasemini"},
+		{"Isikhathi sase-North American Central", "Isikhathi sase-North American Central esijwayelekile", "Isikhathi sase-North American Central sasemini"},
+		{"Isikhathi sase-North American East", "Isikhathi sase-North American East esijwayelekile", "Isikhathi sase-North American East sasemini"},
+		{"Isikhathi sase-North American Mountain", "Isikhathi sase-North American Mountain esijwayelekile", "Isikhathi sase-North American Mountain sasemini"},
+		{"Isikhathi sase-North American Pacific", "Isikhathi sase-North American Pacific esijwayelekile", "Isikhathi sase-North American Pacific sasemini"},
+		{"Isikhathi sase-Arabian", "Isikhathi esivamile sase-Arabian", "Isikhathi semini sase-Arabian"},
+		{"Isikhathi sase-Argentina", "Isikhathi sase-Argentina esijwayelekile", "Isikhathi sase-Argentina sasehlobo"},
+		{"Isikhathi sase-Atlantic", "Isikhathi sase-Atlantic esijwayelekile", "Isikhathi sase-Atlantic sasemini"},
+		{"Isikhathi sase-Central Australia", "Isikhathi sase-Australian Central esivamile", "Isikhathi sase-Australian Central sasemini"},
+		{"Isikhathi sase-Eastern Australia", "Isikhathi esivamile sase-Australian East", "Isikhathi sasemini sase-Australian East"},
+		{"Isikhathi sase-Western Australia", "Isikhathi sase-Australian Western esivamile", "Isikhathi sase-Australian Western sasemini"},
+		{"Isikhathi sase-Bangladesh", "Isikhathi sase-Bangladesh esivamile", "Isikhathi sase-Bangladesh sasehlobo"},
+		{"Isikhathi sase-Brasilia", "Isikhathi sase-Brasilia esijwayelekile", "Isikhathi sase-Brasilia sasehlobo"},
+		{"Isikhathi sase-Chile", "Isikhathi sase-Chile esijwayelekile", "Isikhathi sase-Chile sasehlobo"},
+		{"Isikhathi sase-China", "Isikhathi esivamile sase-China", "Isikhathi semini sase-China"},
+		{"Isikhathi sase-Colombia", "Isikhathi sase-Colombia esijwayelekile", "Isikhathi sase-Colombia sasehlobo"},
+		{"Isikhathi sase-Central Europe", "Isikhathi esijwayelekile sase-Central Europe", "Isikhathi sasehlobo sase-Central Europe"},
+		{"Isikhathi sase-Eastern Europe", "Isikhathi esijwayelekile sase-Eastern Europe", "Isikhathi sasehlobo sase-Eastern Europe"},
+		{"Isikhathi sase-Western Europe", "Isikhathi esijwayelekile sase-Western Europe", "Isikhathi sasehlobo sase-Western Europe"},
+		{"", "Isikhathi sase-Greenwich Mean", ""},
+		{"", "Isikhathi esivamile sase-Gulf", ""},
+		{"Isikhathi sase-Hawaii-Aleutia", "Isikhathi sase-Hawaii-Aleutia esijwayelekile", "Isikhathi sase-Hawaii-Aleutia sasemini"},
+		{"Isikhathi sase-Hong Kong", "Isikhathi esivamile sase-Hong Kong", "Isikhathi sehlobo sase-Hong Kong"},
+		{"", "Isikhathi sase-India esivamile", ""},
+		{"", "Isikhathi sase-Indochina", ""},
+		{"", "Isikhathi sase-Western Indonesia", ""},
+		{"Isikhathi sase-Iran", "Isikhathi sase-Iran esivamile", "Isikhathi sase-Iran sasemini"},
+		{"Isikhathi sase-Israel", "Isikhathi esivamile sase-Israel", "Isikhathi sasemini sakwa-Israel"},
+		{"Isikhathi sase-Japan", "Isikhathi esivamile sase-Japan", "Isikhathi semini sase-Japan"},
+		{"Isikhathi sase-Korea", "Isikhathi esisezengeni sase-Korea", "Isikhathi semini sase-Korea"},
+		{"", "Isikhathi sase-Malaysia", ""},
+		{"Isikhathi sase-Moscow", "Isikhathi sase-Moscow esijwayelekile", "Isikhathi sasehlobo e-Moscow"},
+		{"Isikhathi sase-New Zealand", "Isikhathi esivamile sase-New Zealand", "Isikhathi sasemini sase-New Zealand"},
+		{"Isikhathi sase-Newfoundland", "Isikhathi sase-Newfoundland esijwayelekile", "Isikhathi sase-Newfoundland sasemini"},
+		{"Isikhathi sase-Pakistan", "Isikhathi sase-Pakistan esivamile", "Isikhathi sase-Pakistan sasehlobo"},
+		{"Isikhathi sase-Peru", "Isikhathi sase-Peru esijwayelekile", "Isikhathi sase-Peru sasehlobo"},
+		{"Isikhathi sase-Philippine", "Isikhathi esivamile sase-Philippine", "Isikhathi sehlobo sase-Philippine"},
+		{"", "Isikhathi esivamile sase-Singapore", ""},
+		{"Isikhathi sase-Taipei", "Isikhathi esivamile sase-Taipei", "Isikhathi semini sase-Taipei"},
+		{"", "Isikhathi sase-Venezuela", ""},
+		{"", "isikhathi somhlaba esididiyelwe", ""},
+		{"", "", "isikhathi sase-Irish esivamile"},
+		{"", "", "isikhathi sase-British sasehlobo"},
+		{"", "", ""},
+	},
+}
+
+// The localized GMT formats of each base language
+var zoneGMTFormats = map[string]zoneGMTFormat{
+	"aa":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"af":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"am":  {"ጂ ኤም ቲ{0}", "ጂ ኤም ቲ", "+HHmm;-HHmm"},
+	"an":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ar":  {"غرينتش{0}", "غرينتش", "+HH:mm;-HH:mm"},
+	"as":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ast": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"az":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"be":  {"GMT{0}", "GMT", "+HH.mm;-HH.mm"},
+	"bem": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ber": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"bg":  {"Гринуич{0}", "Гринуич", "+HH:mm;-HH:mm"},
+	"bho": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"bn":  {"GMT {0}", "GMT", "+HH:mm;-HH:mm"},
+	"bo":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"br":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"brx": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"bs":  {"GMT {0}", "GMT", "+HH:mm; -HH:mm"},
+	"byn": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ca":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"crh": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"cs":  {"GMT{0}", "GMT", "+H:mm;-H:mm"},
+	"csb": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"cv":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"cy":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"da":  {"GMT{0}", "GMT", "+HH.mm;-HH.mm"},
+	"de":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"dv":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"dz":  {"ཇི་ཨེམ་ཏི་{0}", "ཇི་ཨེམ་ཊི་", "+HH:mm;-HH:mm"},
+	"el":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"en":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"eo":  {"UTC{0}", "UTC", "+HH:mm;−HH:mm"},
+	"es":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"et":  {"GMT {0}", "GMT", "+HH:mm;−HH:mm"},
+	"eu":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"fa":  {"{0} گرینویچ", "گرینویچ", "\u200e+HH:mm;\u200e−HH:mm"},
+	"ff":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"fi":  {"UTC{0}", "UTC", "+H.mm;-H.mm"},
+	"fil": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"fo":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"fr":  {"UTC{0}", "UTC", "+HH:mm;−HH:mm"},
+	"fur": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"fy":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ga":  {"MAG{0}", "MAG", "+HH:mm;-HH:mm"},
+	"gd":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"gez": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"gl":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"gu":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"gv":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ha":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"he":  {"GMT{0}\u200e", "GMT", "\u200e+HH:mm;-HH:mm\u200e"},
+	"hi":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"hne": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"hr":  {"GMT{0}", "GMT", "+HH:mm; -HH:mm"},
+	"hsb": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ht":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"hu":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"hy":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ia":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"id":  {"GMT{0}", "GMT", "+HH.mm;-HH.mm"},
+	"ig":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ik":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"is":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"it":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"iu":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ja":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ka":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"kk":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"kl":  {"GMT{0}", "GMT", "+HH:mm;−HH:mm"},
+	"km":  {"ម៉ោង\u200bសកល {0}", "ម៉ោង\u200bសកល", "+HH:mm;-HH:mm"},
+	"kn":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ko":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"kok": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ks":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ku":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"kw":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ky":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"lb":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"lg":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"lij": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"lo":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"lt":  {"GMT{0}", "GMT", "+HH:mm;−HH:mm"},
+	"lv":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mai": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mg":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mhr": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mi":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mk":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ml":  {"ജിഎംടി {0}", "ജിഎംടി", "+HH:mm;-HH:mm"},
+	"mn":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mr":  {"[GMT]{0}", "[GMT]", "+HH:mm;-HH:mm"},
+	"ms":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"mt":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"my":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nan": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nb":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ne":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nl":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nn":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nr":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"nso": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"oc":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"om":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"or":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"os":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"pa":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"pap": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"pl":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ps":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"pt":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ro":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ru":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"rw":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sa":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sc":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sd":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"se":  {"UTC{0}", "UTC", "+HH:mm;−HH:mm"},
+	"shs": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"si":  {"ග්\u200dරිමවේ{0}", "ග්\u200dරිමවේ", "+HH.mm;-HH.mm"},
+	"sid": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sk":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sl":  {"GMT{0}", "GMT", "+HH.mm;-HH.mm"},
+	"so":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sq":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sr":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ss":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"st":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"sv":  {"GMT{0}", "GMT", "+HH:mm;−HH:mm"},
+	"sw":  {"GMT {0}", "GMT", "+HH:mm;-HH:mm"},
+	"ta":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"te":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tg":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"th":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ti":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tig": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tk":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tl":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tn":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tr":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ts":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"tt":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ug":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"uk":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"unm": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ur":  {"GMT {0}", "GMT", "+HH:mm;-HH:mm"},
+	"uz":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"ve":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"vi":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"wa":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"wae": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"wal": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"wo":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"xh":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"yi":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"yo":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"yue": {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"zh":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+	"zu":  {"GMT{0}", "GMT", "+HH:mm;-HH:mm"},
+}
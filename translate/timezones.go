@@ -0,0 +1,78 @@
+//Localized time zone names for DateTime variables (from CLDR metazones)
+//go:build !gol10n_minimal
+
+package translate
+
+//go:generate go run gen_timezoneNames.go
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Time zone names for a metazone
+type zoneNames struct {
+	generic, standard, daylight string
+}
+
+// The localized GMT format of a language, which is used for time zones without names. Example: “GMT{0}”, “GMT”, “+HH:mm;-HH:mm”
+type zoneGMTFormat struct {
+	format, zeroFormat, hourFormat string
+}
+
+// The GMT format of languages that are not in zoneGMTFormats (CLDR’s root locale)
+var defaultZoneGMTFormat = zoneGMTFormat{"GMT{0}", "GMT", "+HH:mm;-HH:mm"}
+
+// Returns the localized name of a time’s time zone. If isGeneric, the name does not specify standard or daylight time. Time zones without a name in the language use the language’s localized GMT format. Example: “GMT-04:00”
+func localizedZoneName(baseLanguage string, t time.Time, isGeneric bool) string {
+	//Get the name from the time zone’s own names, and then from its metazone
+	if names, ok := zoneNameTable[baseLanguage]; ok {
+		zoneName := t.Location().String()
+		for _, table := range []map[string]uint8{zoneOwnNames, zoneToMetazone} {
+			if i, ok := table[zoneName]; ok {
+				if name := names[i].get(t, isGeneric); len(name) != 0 {
+					return name
+				}
+			}
+		}
+	}
+
+	return localizedGMTName(baseLanguage, t)
+}
+
+// Returns the name for the time. Generic names fall back to the standard name, as CLDR does not give generic names to metazones without daylight time
+func (names zoneNames) get(t time.Time, isGeneric bool) string {
+	switch {
+	case isGeneric:
+		return cond(len(names.generic) != 0, names.generic, names.standard)
+	case t.IsDST():
+		return names.daylight
+	default:
+		return names.standard
+	}
+}
+
+// Returns the time’s offset in the language’s localized GMT format
+func localizedGMTName(baseLanguage string, t time.Time) string {
+	format, ok := zoneGMTFormats[baseLanguage]
+	if !ok {
+		format = defaultZoneGMTFormat
+	}
+	_, offset := t.Zone()
+	if offset == 0 {
+		return format.zeroFormat
+	}
+
+	//The hour format has the positive and negative formats separated by a semicolon
+	hourFormat := format.hourFormat
+	if positive, negative, found := strings.Cut(hourFormat, ";"); found {
+		hourFormat = cond(offset < 0, negative, positive)
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+	hours, minutes := offset/3600, offset/60%60
+	hourStr := strings.NewReplacer("HH", fmt.Sprintf("%02d", hours), "H", fmt.Sprint(hours), "mm", fmt.Sprintf("%02d", minutes)).Replace(hourFormat)
+	return strings.Replace(format.format, "{0}", hourStr, 1)
+}
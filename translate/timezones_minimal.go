@@ -0,0 +1,12 @@
+//Time zone names for gol10n_minimal builds, which do not include the CLDR time zone names
+//go:build gol10n_minimal
+
+package translate
+
+import "time"
+
+// Returns the time zone abbreviation, as gol10n_minimal builds do not include localized time zone names
+func localizedZoneName(_ string, t time.Time, _ bool) string {
+	abbreviation, _ := t.Zone()
+	return abbreviation
+}
@@ -0,0 +1,75 @@
+//Tests of localized time zone names
+//go:build !gol10n_read_compiled_only && !gol10n_minimal
+
+package translate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	_ "time/tzdata" //So the tests do not depend on the system’s time zone database
+)
+
+// Returns a time in New York during daylight time, and one in winter
+func loadTimeZoneTestTimes(t *testing.T) (summer, winter time.Time) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return time.Date(2024, 7, 4, 12, 0, 0, 0, loc), time.Date(2024, 1, 4, 12, 0, 0, 0, loc)
+}
+
+// Renders %EZ and %OZ through a language
+func TestLocalizedZoneNameRender(t *testing.T) {
+	summer, _ := loadTimeZoneTestTimes(t)
+	for _, test := range []struct {
+		lang, specific, generic string
+	}{
+		{"en-US", "Eastern Daylight Time", "Eastern Time"},
+		{"fr-FR", "heure d’été de l’Est", "heure de l’Est nord-américain"},
+		{"he-IL", "שעון החוף המזרחי (קיץ)", "שעון החוף המזרחי"},
+		{"ar-SA", "التوقيت الصيفي الشرقي لأمريكا الشمالية", "التوقيت الشرقي لأمريكا الشمالية"},
+		{"th-TH", "เวลาออมแสงทางตะวันออกในอเมริกาเหนือ", "เวลาทางตะวันออกในอเมริกาเหนือ"},
+	} {
+		l, _, err := ParseTranslationText([]byte(fmt.Sprintf("Settings:\n  LanguageName: Test\n  LanguageIdentifier: %s\n  MissingPluralRule: M\nNS:\n  Zone:\n    ^: \"{{.T!%%EZ}}|{{.T!%%OZ}}\"\n    T: DateTime\n", test.lang)))
+		if err != nil {
+			t.Fatalf("%s: %v", test.lang, err)
+		}
+		expected := test.specific + "|" + test.generic
+		if str, err := l.Get(l.dict.namespaces["NS"].ids["Zone"], summer); err != nil || str != expected {
+			t.Errorf("%s = %q, %v; expected %q", test.lang, str, err, expected)
+		}
+	}
+}
+
+func TestLocalizedZoneName(t *testing.T) {
+	_, winter := loadTimeZoneTestTimes(t)
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		lang      string
+		t         time.Time
+		isGeneric bool
+		expected  string
+	}{
+		{"fr", winter, false, "heure normale de l’Est nord-américain"},
+		{"he", winter, false, "שעון החוף המזרחי (חורף)"},
+
+		//Time zones with their own names, and metazones without generic names
+		{"en", time.Date(2024, 7, 4, 12, 0, 0, 0, london), false, "British Summer Time"},
+		{"en", time.Date(2024, 1, 4, 12, 0, 0, 0, london), false, "Greenwich Mean Time"},
+		{"en", time.Date(2024, 1, 4, 12, 0, 0, 0, london), true, "Greenwich Mean Time"},
+		{"en", time.Date(2024, 1, 4, 12, 0, 0, 0, time.UTC), false, "Coordinated Universal Time"},
+
+		//Time zones without names use the localized GMT format
+		{"en", time.Date(2024, 1, 4, 12, 0, 0, 0, time.FixedZone("X", -(4*60+30)*60)), false, "GMT-04:30"},
+		{"fr", time.Date(2024, 1, 4, 12, 0, 0, 0, time.FixedZone("X", 2*60*60)), false, "UTC+02:00"},
+		{"ar", time.Date(2024, 1, 4, 12, 0, 0, 0, time.FixedZone("X", 0)), false, "غرينتش"},
+	} {
+		if got := localizedZoneName(test.lang, test.t, test.isGeneric); got != test.expected {
+			t.Errorf("%s %s (generic=%v) = %q; expected %q", test.lang, test.t, test.isGeneric, got, test.expected)
+		}
+	}
+}
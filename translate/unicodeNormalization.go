@@ -0,0 +1,149 @@
+//Unicode normalization of translation text files
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"strconv"
+	"strings"
+)
+
+// A translation text file rebuilt with its keys and values NFC normalized
+type nfcMapSlice []nfcItem
+type nfcItem struct {
+	name  string
+	value interface{} //Either *nfcMapSlice, a string, or nil (for values that are not strings)
+}
+
+func (ms *nfcMapSlice) getValue(paramName string) (val tpItem, ok bool) {
+	for _, v := range *ms {
+		if v.name == paramName {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (ms *nfcMapSlice) toMap() map[string]tpItem {
+	retVal := make(map[string]tpItem, len(*ms))
+	for _, v := range *ms {
+		retVal[v.name] = v
+	}
+	return retVal
+}
+
+func (ms *nfcMapSlice) toOrdered() []tpItem {
+	ret := make([]tpItem, len(*ms))
+	for i, v := range *ms {
+		ret[i] = v
+	}
+	return ret
+}
+
+func (ms *nfcMapSlice) getLength() uint {
+	return ulen(*ms)
+}
+
+func (i nfcItem) getName() string {
+	return i.name
+}
+
+func (i nfcItem) getObject() (val tpMap, ok bool) {
+	val, ok = i.value.(*nfcMapSlice)
+	return
+}
+
+func (i nfcItem) getString() (val string, ok bool) {
+	if val, ok = i.value.(string); !ok {
+		return returnBlankStrOnErr, false
+	}
+	return
+}
+
+// Returns if a Settings.UnicodeNormalization value is NFC (otherwise it is None)
+func getIsNFCNormalization(unicodeNormalization string) (bool, error) {
+	switch strings.ToLower(unicodeNormalization) {
+	case "none":
+		return false, nil
+	case "nfc":
+		return true, nil
+	default:
+		return false, errors.New("Must be None or NFC")
+	}
+}
+
+// Returns the top level object with all of its keys and values NFC normalized if its Settings.UnicodeNormalization is NFC (otherwise it is returned as is).
+//
+// Keys that differ only by their normalization form (which look identical) are warned about. If the file is normalized they are errors instead, as they would become the same key.
+func normalizeTextFile(topObj tpMap) (newTopObj tpMap, errs, warnings []string) {
+	//Get the setting
+	isNFC := false
+	if settingsItem, ok := topObj.getValue("Settings"); !ok {
+		//Missing settings are handled by the caller
+	} else if settingsObj, ok := settingsItem.getObject(); !ok {
+		//Invalid settings are handled by the caller
+	} else if _unicodeNormalization, err := getSetting(settingsObj, "UnicodeNormalization"); err != nil {
+		//Ignore error on optional variables
+	} else if isNFC, err = getIsNFCNormalization(_unicodeNormalization); err != nil {
+		return topObj, []string{"Settings.UnicodeNormalization is not valid: " + err.Error()}, nil
+	}
+
+	//Check the keys, and rebuild the file if normalizing
+	addKeyIssue := func(path, key1, key2 string) {
+		issue := fmt.Sprintf("Keys %s and %s differ only by Unicode normalization form", strconv.QuoteToASCII(key1), strconv.QuoteToASCII(key2))
+		if len(path) != 0 {
+			issue = path + ": " + issue
+		}
+		if isNFC {
+			errs = append(errs, issue)
+		} else {
+			warnings = append(warnings, issue)
+		}
+	}
+	var normalizeObj func(obj tpMap, path string) *nfcMapSlice
+	normalizeObj = func(obj tpMap, path string) *nfcMapSlice {
+		items := obj.toOrdered()
+		seenKeys := make(map[string]string, len(items))
+		var newObj nfcMapSlice
+		if isNFC {
+			newObj = make(nfcMapSlice, 0, len(items))
+		}
+		for _, item := range items {
+			//Check the key against the other keys
+			name := item.getName()
+			nfcName := norm.NFC.String(name)
+			if prevName, ok := seenKeys[nfcName]; ok && prevName != name {
+				addKeyIssue(path, prevName, name)
+				continue
+			}
+			seenKeys[nfcName] = name
+
+			//Check the children, and normalize the item
+			childObj, isObj := item.getObject()
+			var newChild *nfcMapSlice
+			if isObj {
+				newChild = normalizeObj(childObj, strings.TrimPrefix(path+"."+nfcName, "."))
+			}
+			if !isNFC {
+				continue
+			}
+			newItem := nfcItem{nfcName, nil}
+			if isObj {
+				newItem.value = newChild
+			} else if str, ok := item.getString(); ok {
+				newItem.value = norm.NFC.String(str)
+			}
+			newObj = append(newObj, newItem)
+		}
+		return &newObj
+	}
+	newObj := normalizeObj(topObj, "")
+
+	if !isNFC {
+		return topObj, errs, warnings
+	}
+	return newObj, errs, warnings
+}
@@ -0,0 +1,123 @@
+//Record translation requests and report translation usage
+
+package translate
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// UsageRecorder counts the translation requests of languages, so translations can be ranked by how often they are requested (see Report()). Languages are added with Language.RecordUsage(). It is safe for concurrent use.
+type UsageRecorder struct {
+	mutex     sync.Mutex
+	languages []*Language //In the order they started recording
+}
+
+// UsageEntry is the number of times a translation was requested in a language. See UsageRecorder.Report()
+type UsageEntry struct {
+	LanguageIdentifier string
+	Rank               uint32 //1 is the language’s most requested translation. Translations with the same count share a rank
+	Namespace          string
+	TranslationID      string
+	Count              uint64
+}
+
+// UsageReportFormat is the file format written by UsageRecorder.WriteReport()
+type UsageReportFormat uint8
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	URF_CSV  UsageReportFormat = iota //CSV with a header row
+	URF_JSON                          //JSON array of UsageEntry objects
+)
+
+// NewUsageRecorder creates an empty UsageRecorder
+func NewUsageRecorder() *UsageRecorder {
+	return &UsageRecorder{}
+}
+
+// RecordUsage counts the language’s translation requests in the recorder. Languages created from it afterward with With() share its counts. Requests for embedded translations are not counted, and a request answered by a fallback language is counted for the requested language.
+//
+// Recording a language that is already recorded (by the same recorder) keeps its counts. This must not be called while the language is in use by other goroutines.
+func (l *Language) RecordUsage(r *UsageRecorder) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, lang := range r.languages {
+		if lang == l {
+			return
+		}
+	}
+	l.usageCounts = make([]uint64, l.NumTranslations())
+	r.languages = append(r.languages, l)
+}
+
+// Reset sets all of the recorded counts back to 0
+func (r *UsageRecorder) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, l := range r.languages {
+		for i := range l.usageCounts {
+			atomic.StoreUint64(&l.usageCounts[i], 0)
+		}
+	}
+}
+
+// Report returns the request counts of every translation of the recorded languages, ranked per language from most to least requested. Translations with the same count are in dictionary order, and translations that were never requested are included with a count of 0. The languages are in the order they started recording.
+func (r *UsageRecorder) Report() []UsageEntry {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var entries []UsageEntry
+	for _, l := range r.languages {
+		//Get the names of the translations
+		names := make([][2]string, len(l.usageCounts))
+		for nsName, n := range l.dict.namespaces {
+			for tidName, index := range n.ids {
+				if uint(index) < ulen(names) {
+					names[index] = [2]string{nsName, tidName}
+				}
+			}
+		}
+
+		//Rank the translations
+		langEntries := make([]UsageEntry, len(l.usageCounts))
+		for i := range l.usageCounts {
+			langEntries[i] = UsageEntry{l.languageIdentifier, 0, names[i][0], names[i][1], atomic.LoadUint64(&l.usageCounts[i])}
+		}
+		sort.SliceStable(langEntries, func(i, j int) bool { return langEntries[i].Count > langEntries[j].Count })
+		for i := range langEntries {
+			if i != 0 && langEntries[i].Count == langEntries[i-1].Count {
+				langEntries[i].Rank = langEntries[i-1].Rank
+			} else {
+				langEntries[i].Rank = uint32(i + 1)
+			}
+		}
+		entries = append(entries, langEntries...)
+	}
+	return entries
+}
+
+// WriteReport writes Report() in the given format. The CSV columns are: Language, Rank, Namespace, Translation ID, Count
+func (r *UsageRecorder) WriteReport(w io.Writer, format UsageReportFormat) error {
+	entries := r.Report()
+	if format == URF_JSON {
+		if entries == nil {
+			entries = []UsageEntry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(entries)
+	}
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"Language", "Rank", "Namespace", "Translation ID", "Count"})
+	for _, e := range entries {
+		_ = cw.Write([]string{e.LanguageIdentifier, strconv.FormatUint(uint64(e.Rank), 10), e.Namespace, e.TranslationID, strconv.FormatUint(e.Count, 10)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -4,6 +4,7 @@ package translate
 
 import (
 	"reflect"
+	"sort"
 	"unsafe"
 )
 
@@ -31,16 +32,8 @@ func retErrWithStr(err error) (string, error) {
 
 // -----------------------Convert any type to a byte array-----------------------
 func any2b[T any](val *T) []byte {
-	return any2bLen(val, 1)
-}
-func any2bLen[T any](val *T, length uint) []byte {
 	//goland:noinspection GoRedundantConversion
-	return unsafe.Slice((*byte)(unsafe.Pointer(val)), uint(unsafe.Sizeof(*val))*length)
-}
-
-// -------------------------Convert a pointer to *uint32-------------------------
-func p2uint32p[T any](b *T) *uint32 {
-	return (*uint32)(unsafe.Pointer(b))
+	return unsafe.Slice((*byte)(unsafe.Pointer(val)), unsafe.Sizeof(*val))
 }
 
 //-----------------------Missing go language functionality----------------------
@@ -50,6 +43,24 @@ func twoToOne[V1 any, V2 any](v1 V1, _ V2) V1 {
 	return v1
 }
 
+// Conditional
+func cond[T any](isTrue bool, ifTrue, ifFalse T) T {
+	if isTrue {
+		return ifTrue
+	}
+	return ifFalse
+}
+
+// Returns the keys of the map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // ------------------------Pull length as uint and uint32------------------------
 func ulen[S ~[]E, E any](v S) uint {
 	return uint(len(v))
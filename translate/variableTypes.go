@@ -0,0 +1,80 @@
+//Public names of the variable types, for tooling that validates translation text files
+
+package translate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VariableType is the type of a variable in translation text files. Its value is the type’s name as it is written in translation text files
+type VariableType string
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	VT_Anything            VariableType = "Anything"
+	VT_String              VariableType = "String"
+	VT_Integer             VariableType = "Integer"
+	VT_Binary              VariableType = "Binary"
+	VT_Octal               VariableType = "Octal"
+	VT_HexLower            VariableType = "HexLower"
+	VT_HexUpper            VariableType = "HexUpper"
+	VT_Scientific          VariableType = "Scientific"
+	VT_Floating            VariableType = "Floating"
+	VT_Bool                VariableType = "Bool"
+	VT_DateTime            VariableType = "DateTime"
+	VT_Currency            VariableType = "Currency"
+	VT_IntegerWithSymbols  VariableType = "IntegerWithSymbols"
+	VT_FloatWithSymbols    VariableType = "FloatWithSymbols"
+	VT_StaticTranslation   VariableType = "StaticTranslation" //Only created through embedded translations ({{*Namespace.TranslationID}}), so it cannot be declared (see VariableType.IsDeclarable())
+	VT_VariableTranslation VariableType = "VariableTranslation"
+	VT_Spellout            VariableType = "Spellout"
+	VT_Ordinal             VariableType = "Ordinal"
+	VT_Quote               VariableType = "Quote"
+	VT_Template            VariableType = "Template"
+)
+
+// VariableTypes returns all the variable types
+func VariableTypes() []VariableType {
+	ret := make([]VariableType, len(variableTypeNames))
+	for i, name := range variableTypeNames {
+		ret[i] = VariableType(name)
+	}
+	return ret
+}
+
+// ParseVariableType returns the variable type of the name, which is not case sensitive (the same as in translation text files)
+func ParseVariableType(name string) (VariableType, error) {
+	if vt, ok := getVariableType(name); ok {
+		return VariableType(variableTypeNames[vt]), nil
+	}
+	return "", fmt.Errorf("Invalid variable type “%s”", name)
+}
+
+// IsDeclarable returns if variables can be declared with the type in translation text files. Only VT_StaticTranslation cannot be
+func (vt VariableType) IsDeclarable() bool {
+	v, ok := getVariableType(string(vt))
+	return ok && v != vtStaticTranslation
+}
+
+// CanBePluralCount returns if the PluralCount variable can be changed to the type, which is true for the integer types
+func (vt VariableType) CanBePluralCount() bool {
+	v, ok := getVariableType(string(vt))
+	return ok && pluralCountTypes[v]
+}
+
+// RequiresSpecifier returns if variables of the type require a specifier (a value after an exclamation mark) in translation strings, like the strftime format of VT_DateTime
+func (vt VariableType) RequiresSpecifier() bool {
+	v, ok := getVariableType(string(vt))
+	return ok && v == vtDateTime
+}
+
+// Returns the variable type of the name, which is not case sensitive
+func getVariableType(name string) (variableType, bool) {
+	for vt, vtName := range variableTypeNames {
+		if strings.EqualFold(name, vtName) {
+			return variableType(vt), true
+		}
+	}
+	return 0, false
+}
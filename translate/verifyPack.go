@@ -0,0 +1,285 @@
+//Verify compiled language packs without loading them
+
+package translate
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+// PackProblemKind is the category of a PackProblem
+type PackProblemKind uint8
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	PP_ReadError          PackProblemKind = iota //The file could not be read or decompressed
+	PP_Corrupt                                   //The file is not a valid compiled file
+	PP_LimitExceeded                             //A soft limit was exceeded, or the file is larger than its header allows
+	PP_DictionaryMismatch                        //The language file was not compiled with the given dictionary
+	PP_InvalidSettings                           //A language setting is invalid
+	PP_DuplicateLanguage                         //More than 1 language file has the same language identifier
+	PP_MissingFallback                           //The fallback language is not in the pack
+)
+
+var packProblemKindNames = []string{"ReadError", "Corrupt", "LimitExceeded", "DictionaryMismatch", "InvalidSettings", "DuplicateLanguage", "MissingFallback"}
+
+// String returns the name of the PackProblemKind
+func (k PackProblemKind) String() string {
+	if uint(k) < ulen(packProblemKindNames) {
+		return packProblemKindNames[k]
+	}
+	return "Unknown"
+}
+
+// PackProblem is a problem found by VerifyPack
+type PackProblem struct {
+	Kind    PackProblemKind
+	Message string
+}
+
+// Error returns the problem in the format “Kind: Message”
+func (p PackProblem) Error() string {
+	return p.Kind.String() + ": " + p.Message
+}
+
+// PackReport is the result of VerifyPack
+type PackReport struct {
+	//Information from the dictionary (if it could be read)
+	NumNamespaces   uint32
+	NumTranslations uint32
+
+	DictionaryProblems []PackProblem
+	Languages          []PackLanguageReport //In the same order as the language readers given to VerifyPack
+}
+
+// PackLanguageReport is the part of a PackReport for a single language file
+type PackLanguageReport struct {
+	//Information from the settings (if they could be read)
+	Name               string
+	LanguageIdentifier string
+	FallbackName       string
+
+	Problems []PackProblem
+}
+
+// OK returns if no problems were found
+func (r *PackReport) OK() bool {
+	if len(r.DictionaryProblems) != 0 {
+		return false
+	}
+	for _, l := range r.Languages {
+		if len(l.Problems) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Err returns all problems joined as a single error, or nil if no problems were found
+func (r *PackReport) Err() error {
+	if r.OK() {
+		return nil
+	}
+
+	var errs []string
+	for _, p := range r.DictionaryProblems {
+		errs = append(errs, "Dictionary: "+p.Error())
+	}
+	for i, l := range r.Languages {
+		for _, p := range l.Problems {
+			errs = append(errs, fmt.Sprintf("Language #%d (%s): %s", i, l.LanguageIdentifier, p.Error()))
+		}
+	}
+	return errors.New(strings.Join(errs, "\n"))
+}
+
+// VerifyPack checks a compiled dictionary file and compiled language files (gzip compressed or not) without loading them or touching the stored dictionary, and returns a report of all problems found.
+// It checks that the files are complete and valid, that the languages were compiled with the dictionary, that their settings are valid, that soft limits are not exceeded, and that their fallback languages are included.
+//
+// This is meant to be run before swapping in language packs that were downloaded at runtime.
+func VerifyPack(dictReader io.Reader, langReaders ...io.Reader) PackReport {
+	report := PackReport{Languages: make([]PackLanguageReport, len(langReaders))}
+
+	//Verify the dictionary
+	var dict *languageDict
+	if b, problem := readPackFile(dictReader, true); problem != nil {
+		report.DictionaryProblems = append(report.DictionaryProblems, *problem)
+	} else if _dict, problems := verifyPackDictionary(b); len(problems) != 0 {
+		report.DictionaryProblems = problems
+	} else {
+		dict = _dict
+		report.NumNamespaces = ulen32(dict.namespacesInOrder)
+		for _, n := range dict.namespaces {
+			report.NumTranslations += ulen32m(n.ids)
+		}
+	}
+
+	//Verify the languages
+	for i, r := range langReaders {
+		if b, problem := readPackFile(r, false); problem != nil {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, *problem)
+		} else {
+			report.Languages[i] = verifyPackLanguage(b, dict)
+		}
+	}
+
+	//Check for duplicate and missing languages
+	languageIndexes := make(map[string]int, len(report.Languages))
+	for i, l := range report.Languages {
+		if len(l.LanguageIdentifier) == 0 {
+			continue
+		} else if firstIndex, ok := languageIndexes[l.LanguageIdentifier]; ok {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, PackProblem{PP_DuplicateLanguage, fmt.Sprintf("Language identifier “%s” is also used by language #%d", l.LanguageIdentifier, firstIndex)})
+		} else {
+			languageIndexes[l.LanguageIdentifier] = i
+		}
+	}
+	for i, l := range report.Languages {
+		if len(l.FallbackName) == 0 {
+			continue
+		} else if _, ok := languageIndexes[l.FallbackName]; !ok {
+			report.Languages[i].Problems = append(report.Languages[i].Problems, PackProblem{PP_MissingFallback, fmt.Sprintf("Fallback language “%s” is not in the pack", l.FallbackName)})
+		}
+	}
+
+	return report
+}
+
+// How many bytes a pack file can have past the size given in its header before reading it stops. Files within this are still read, so their size mismatch is reported
+const packFileSizeSlack = 64 * 1024
+
+// Reads a whole file, decompressing it if it is gzip compressed. Reading stops with a problem once the file is larger than the size given in its header (plus packFileSizeSlack), so a small compressed file cannot decompress into an unbounded amount of memory
+func readPackFile(r io.Reader, isDictionary bool) ([]byte, *PackProblem) {
+	if r == nil {
+		return nil, &PackProblem{PP_ReadError, "Reader is nil"}
+	}
+
+	//Check for the gzip magic number
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		if gr, err := gzip.NewReader(br); err != nil {
+			return nil, &PackProblem{PP_ReadError, "Could not decompress: " + err.Error()}
+		} else {
+			br = bufio.NewReader(gr)
+		}
+	}
+
+	//Read the file up to its size limit
+	head, _ := br.Peek(int(cond(isDictionary, size_storeDictHeader, size_storeHeader)))
+	limit := packFileSizeLimit(head, isDictionary)
+	if b, err := io.ReadAll(io.LimitReader(br, int64(limit)+1)); err != nil {
+		return nil, &PackProblem{PP_ReadError, err.Error()}
+	} else if uint64(len(b)) > limit {
+		return nil, &PackProblem{PP_LimitExceeded, fmt.Sprintf("File is larger than the size given in its header plus %d bytes (%d)", packFileSizeSlack, limit)}
+	} else {
+		return b, nil
+	}
+}
+
+// Returns the most bytes a pack file with the header can have. This is the size given in its header (if the header is complete and within the soft limits) plus packFileSizeSlack
+func packFileSizeLimit(head []byte, isDictionary bool) uint64 {
+	size := uint64(len(head))
+	if isDictionary {
+		var header storeDictHeader
+		if len(head) == int(header.binarySize()) {
+			header.fromLittleEndian(head)
+			if header.checkSoftCaps() == nil {
+				size = header.getCompiledFileSize()
+			}
+		}
+	} else {
+		var header storeHeader
+		if len(head) == int(header.binarySize()) {
+			header.fromLittleEndian(head)
+			if header.hasValidTranslationStringByteLength() && header.checkSoftCaps() == nil {
+				size = header.getCompiledFileSize()
+			}
+		}
+	}
+	return size + packFileSizeSlack
+}
+
+func verifyPackDictionary(b []byte) (*languageDict, []PackProblem) {
+	//Check the header
+	var header storeDictHeader
+	if len(b) < int(header.binarySize()) {
+		return nil, []PackProblem{{PP_Corrupt, "File ended early"}}
+	}
+	header.fromLittleEndian(b)
+	if _, err := readFormatVersion(header.fileType, header.formatVersion, "DT"); err != nil {
+		return nil, []PackProblem{{PP_Corrupt, "Not a compiled dictionary file: " + err.Error()}}
+	} else if err := header.checkSoftCaps(); err != nil {
+		return nil, []PackProblem{{PP_LimitExceeded, err.Error()}}
+	} else if header.getCompiledFileSize() != uint64(len(b)) {
+		return nil, []PackProblem{{PP_Corrupt, fmt.Sprintf("File size (%d) does not match the size given in its header (%d)", len(b), header.getCompiledFileSize())}}
+	}
+
+	//Read the dictionary
+	var dict languageDict
+	if err := dict.fromCompiledFile(bytes.NewReader(b)); err != nil {
+		return nil, []PackProblem{{PP_Corrupt, err.Error()}}
+	}
+	return &dict, nil
+}
+
+func verifyPackLanguage(b []byte, dict *languageDict) (report PackLanguageReport) {
+	addProblem := func(kind PackProblemKind, message string) PackLanguageReport {
+		report.Problems = append(report.Problems, PackProblem{kind, message})
+		return report
+	}
+
+	//Check the header
+	var header storeHeader
+	headerSize := uint64(header.binarySize())
+	if uint64(len(b)) < headerSize {
+		return addProblem(PP_Corrupt, "File ended early")
+	}
+	header.fromLittleEndian(b)
+	if _, err := header.readFormatVersion(); err != nil {
+		return addProblem(PP_Corrupt, "Not a compiled language file: "+err.Error())
+	} else if !header.hasValidTranslationStringByteLength() {
+		return addProblem(PP_Corrupt, "Invalid translation string size")
+	} else if err := header.checkSoftCaps(); err != nil {
+		return addProblem(PP_LimitExceeded, err.Error())
+	} else if header.getCompiledFileSize() != uint64(len(b)) {
+		return addProblem(PP_Corrupt, fmt.Sprintf("File size (%d) does not match the size given in its header (%d)", len(b), header.getCompiledFileSize()))
+	}
+
+	//Check the settings, which directly follow the header
+	if settingsValues, _, _, err, _ := parseCompiledSettings(b[headerSize : headerSize+uint64(header.settingsSize)]); err != nil {
+		addProblem(PP_InvalidSettings, err.Error())
+	} else {
+		report.Name, report.LanguageIdentifier, report.FallbackName = settingsValues[0], settingsValues[1], settingsValues[2]
+	}
+
+	//The rest of the checks require the dictionary
+	if dict == nil {
+		return
+	} else if !bytes.Equal(header.hash[:], dict.hash) {
+		return addProblem(PP_DictionaryMismatch, ErrDictionaryDoesNotMatch)
+	} else if len(report.Problems) != 0 {
+		return
+	}
+
+	//The translation strings of encrypted files cannot be read without their key, so only their checksum is confirmed
+	if header.isEncrypted() {
+		if binary.LittleEndian.Uint32(b[len(b)-int(size_checksum):]) != crc32.Checksum(b[:len(b)-int(size_checksum)], checksumTable) {
+			addProblem(PP_Corrupt, "Checksum does not match, so the file is corrupt")
+		}
+		return
+	}
+
+	//Read the whole language
+	var l Language
+	if err := l.fromCompiledFile(bytes.NewReader(b), dict, nil, nil); err != nil {
+		addProblem(PP_Corrupt, err.Error())
+	}
+	return
+}
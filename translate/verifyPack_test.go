@@ -0,0 +1,40 @@
+//Tests of verifying compiled language packs
+
+package translate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+// Returns the data gzip compressed
+func gzipPackTestData(t *testing.T, data []byte) *bytes.Reader {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(b.Bytes())
+}
+
+// A small compressed file must not be decompressed past the size given in its header
+func TestVerifyPackDecompressionLimit(t *testing.T) {
+	large := make([]byte, 16*1024*1024)
+	report := VerifyPack(gzipPackTestData(t, large), gzipPackTestData(t, large))
+	if len(report.DictionaryProblems) != 1 || report.DictionaryProblems[0].Kind != PP_LimitExceeded {
+		t.Errorf("Dictionary problems = %v; expected a limit exceeded problem", report.DictionaryProblems)
+	}
+	if len(report.Languages) != 1 || len(report.Languages[0].Problems) != 1 || report.Languages[0].Problems[0].Kind != PP_LimitExceeded {
+		t.Errorf("Language problems = %v; expected a limit exceeded problem", report.Languages)
+	}
+
+	//Files within the limit are still read and checked
+	report = VerifyPack(gzipPackTestData(t, make([]byte, 100)))
+	if len(report.DictionaryProblems) != 1 || report.DictionaryProblems[0].Kind != PP_Corrupt {
+		t.Errorf("Dictionary problems = %v; expected a corrupt problem", report.DictionaryProblems)
+	}
+}
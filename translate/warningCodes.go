@@ -0,0 +1,45 @@
+//Warning codes that categorize compile warnings
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import "strings"
+
+// Warning codes start the warnings of their categories, so they can be told apart from other warnings (see WarningCode()). WC_BrokenEmbed is also a warning code
+//
+//goland:noinspection GoSnakeCaseUsage
+const (
+	WC_MissingTranslation = "[MissingTranslation]" //A Translation ID is missing from a namespace of a non-default language
+	WC_MissingNamespace   = "[MissingNamespace]"   //A namespace is missing from a non-default language (with the “Warn” MissingNamespaces policy)
+	WC_ExtraTranslation   = "[ExtraTranslation]"   //A non-default language has a Translation ID that is not in the default language
+	WC_ExtraNamespace     = "[ExtraNamespace]"     //A non-default language has a namespace that is not in the default language
+	WC_VariableMismatch   = "[VariableMismatch]"   //A translation’s variables (or those it falls back to) do not match the default language’s
+	WC_Other              = "[Other]"              //Not a code that starts warnings. WarningCode() returns it for warnings without a code
+)
+
+// WarningCode returns the warning code that starts the warning (like WC_MissingTranslation), or WC_Other if it does not start with one
+func WarningCode(warning string) string {
+	if code, _, ok := splitWarningCode(warning); ok {
+		return code
+	}
+	return WC_Other
+}
+
+// Splits the warning code from the start of the warning
+func splitWarningCode(warning string) (code, rest string, ok bool) {
+	if !strings.HasPrefix(warning, "[") {
+		return "", warning, false
+	} else if end := strings.Index(warning, "] "); end == -1 || strings.ContainsAny(warning[1:end], "[ ") {
+		return "", warning, false
+	} else {
+		return warning[:end+1], warning[end+2:], true
+	}
+}
+
+// Prefixes the warning with the location (like “Namespace.TranslationID”), keeping its warning code at the start
+func prefixWarning(location, warning string) string {
+	if code, rest, ok := splitWarningCode(warning); ok {
+		return code + " " + location + ": " + rest
+	}
+	return location + ": " + warning
+}
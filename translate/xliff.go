@@ -0,0 +1,347 @@
+//Exchange translation text files with translation vendors through XLIFF 2.0
+//go:build !gol10n_read_compiled_only
+
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// XLIFF 2.0 constants
+const (
+	xliffVersion = "2.0"
+	xliffFileID  = "gol10n"
+
+	xliffNoteSettings    = "settings"     //File note: The target language’s Settings object as JSON
+	xliffNoteMetadata    = "metadata"     //Namespace notes: The namespace metadata from the source language (informational only)
+	xliffNoteVariable    = "variable"     //Translation ID notes: The note ID is the variable name and the text is its type
+	xliffNoteVariableDoc = "variable-doc" //Translation ID notes: A variable’s name, type, and example value for translators (informational only)
+
+	xliffStateInitial    = "initial"
+	xliffStateTranslated = "translated"
+)
+
+// ---------------------------------XLIFF document---------------------------------
+type xliffDoc struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string      `xml:"version,attr"`
+	SrcLang string      `xml:"srcLang,attr"`
+	TrgLang string      `xml:"trgLang,attr,omitempty"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	ID     string       `xml:"id,attr"`
+	Notes  *xliffNotes  `xml:"notes,omitempty"`
+	Groups []xliffGroup `xml:"group"`
+}
+
+type xliffNotes struct {
+	Notes []xliffNote `xml:"note"`
+}
+
+type xliffNote struct {
+	ID       string `xml:"id,attr,omitempty"`
+	Category string `xml:"category,attr,omitempty"`
+	Text     string `xml:",chardata"`
+}
+
+// Groups are used for both namespaces (top level groups) and Translation IDs (groups inside namespaces)
+type xliffGroup struct {
+	ID     string       `xml:"id,attr"`
+	Name   string       `xml:"name,attr,omitempty"`
+	Notes  *xliffNotes  `xml:"notes,omitempty"`
+	Groups []xliffGroup `xml:"group"`
+	Units  []xliffUnit  `xml:"unit"`
+}
+
+// Each plural rule of a Translation ID is its own unit. The unit name is the plural rule
+type xliffUnit struct {
+	ID       string         `xml:"id,attr"`
+	Name     string         `xml:"name,attr,omitempty"`
+	Segments []xliffSegment `xml:"segment"`
+}
+
+type xliffSegment struct {
+	State  string     `xml:"state,attr,omitempty"`
+	Source xliffText  `xml:"source"`
+	Target *xliffText `xml:"target"`
+}
+
+type xliffText struct {
+	Space  string `xml:"xml:space,attr,omitempty"`
+	Text   string `xml:",chardata"`
+	Inline []struct {
+		XMLName xml.Name
+	} `xml:",any"` //Inline markup is not supported, so it is only read to give an error
+}
+
+// ExportXLIFF writes an XLIFF 2.0 document for translating the source (default) language text file into the target language.
+//
+// The target file is optional (nil) and its existing translations become the XLIFF targets. Its language identifier must match targetLanguageIdentifier, which can be blank if a target file is given.
+//
+// The document layout is:
+//   - Each namespace is a group whose notes hold the namespace metadata
+//   - Each Translation ID is a group inside its namespace whose notes hold the variables (the note IDs are the variable names, and the texts are their types). Each variable also has a note documenting its name, type, and an example value for translators
+//   - Each plural rule is a unit inside its Translation ID whose name is the plural rule. The plural rules are taken from the target file if it has the Translation ID, and otherwise from the source file
+//   - The target’s Settings object is stored as JSON in a file note
+//
+// Properties starting with a “\” are not exported. Translations are exported as they appear in the text files (escape sequences and variables are not processed).
+func ExportXLIFF(w io.Writer, sourceFile io.Reader, sourceType LanguageTextFile, targetLanguageIdentifier string, targetFile io.Reader, targetType LanguageTextFile) error {
+	//Read the source file
+	srcObj, _, srcLang, err := readTextFileTop(sourceFile, sourceType)
+	if err != nil {
+		return fmt.Errorf("Source file: %s", err.Error())
+	}
+
+	//Read the target file. If it does not exist, the settings only have the language identifier
+	var trgObj tpMap
+	var settingsJSON []byte
+	if targetFile != nil {
+		var trgSettings tpMap
+		var trgLang string
+		if trgObj, trgSettings, trgLang, err = readTextFileTop(targetFile, targetType); err != nil {
+			return fmt.Errorf("Target file: %s", err.Error())
+		} else if len(targetLanguageIdentifier) == 0 {
+			targetLanguageIdentifier = trgLang
+		} else if trgLang != targetLanguageIdentifier {
+			return fmt.Errorf("Target file: Language identifier “%s” does not match “%s”", trgLang, targetLanguageIdentifier)
+		}
+
+		var buf, compactBuf bytes.Buffer
+		if err := formatJsonObject(&buf, yamlItem{Key: "Settings", Value: toYamlMapSlice(trgSettings)}, 0); err != nil {
+			return fmt.Errorf("Target file: %s", err.Error())
+		} else if err := json.Compact(&compactBuf, buf.Bytes()); err != nil {
+			return fmt.Errorf("Target file: %s", err.Error())
+		}
+		settingsJSON = compactBuf.Bytes()
+	} else if len(targetLanguageIdentifier) == 0 {
+		return errors.New("A target language identifier or target file is required")
+	} else {
+		settingsJSON = []byte(`{"LanguageIdentifier":` + formatQuoteString(targetLanguageIdentifier) + `}`)
+	}
+
+	//Create the document
+	doc := xliffDoc{Version: xliffVersion, SrcLang: srcLang, TrgLang: targetLanguageIdentifier}
+	file := xliffFile{
+		ID:    xliffFileID,
+		Notes: &xliffNotes{[]xliffNote{{Category: xliffNoteSettings, Text: b2s(settingsJSON)}}},
+	}
+
+	//Add the namespaces
+	for _, nsItem := range srcObj.toOrdered() {
+		nsName := nsItem.getName()
+		if nsName == "Settings" {
+			continue
+		}
+		nsObj, ok := nsItem.getObject()
+		if !ok {
+			return fmt.Errorf("Source file: Namespace “%s” is not an object", nsName)
+		}
+		var trgNsObj tpMap
+		if trgObj != nil {
+			if trgNsItem, ok := trgObj.getValue(nsName); ok {
+				trgNsObj, _ = trgNsItem.getObject()
+			}
+		}
+		nsGroup := xliffGroup{ID: nsName, Name: nsName}
+
+		//Add the Translation IDs
+		for _, tidItem := range nsObj.toOrdered() {
+			tidName := tidItem.getName()
+			path := nsName + "." + tidName
+
+			//Metadata is added as notes
+			if tidName == namespaceMetadataName {
+				if metaObj, ok := tidItem.getObject(); ok {
+					nsGroup.Notes = &xliffNotes{}
+					for _, metaItem := range metaObj.toOrdered() {
+						metaVal, _ := metaItem.getString()
+						nsGroup.Notes.Notes = append(nsGroup.Notes.Notes, xliffNote{Category: xliffNoteMetadata, Text: metaItem.getName() + ": " + metaVal})
+					}
+				}
+				continue
+			}
+
+			//Get the source and target properties
+			srcRules, vars, err := readTranslationIDProps(tidItem, path)
+			if err != nil {
+				return fmt.Errorf("Source file: %s", err.Error())
+			}
+			var trgRules []textProp
+			if trgNsObj != nil {
+				if trgTidItem, ok := trgNsObj.getValue(tidName); ok {
+					var trgVars []textProp
+					if trgRules, trgVars, err = readTranslationIDProps(trgTidItem, path); err != nil {
+						return fmt.Errorf("Target file: %s", err.Error())
+					} else if len(trgRules) != 0 {
+						vars = trgVars
+					}
+				}
+			}
+
+			//Add the Translation ID group and its variables
+			tidGroup := xliffGroup{ID: path, Name: tidName}
+			if len(vars) != 0 {
+				tidGroup.Notes = &xliffNotes{}
+				for _, v := range vars {
+					tidGroup.Notes.Notes = append(tidGroup.Notes.Notes, xliffNote{ID: v.name, Category: xliffNoteVariable, Text: v.value})
+				}
+				for _, v := range vars {
+					tidGroup.Notes.Notes = append(tidGroup.Notes.Notes, xliffNote{Category: xliffNoteVariableDoc, Text: variableDoc(v)})
+				}
+			}
+
+			//Add a unit for each plural rule
+			for i, rule := range cond(len(trgRules) != 0, trgRules, srcRules) {
+				seg := xliffSegment{
+					State:  xliffStateInitial,
+					Source: xliffText{Space: "preserve", Text: findRuleText(srcRules, rule.name)},
+				}
+				if len(trgRules) != 0 {
+					seg.State, seg.Target = xliffStateTranslated, &xliffText{Space: "preserve", Text: rule.value}
+				}
+				tidGroup.Units = append(tidGroup.Units, xliffUnit{ID: path + "." + strconv.Itoa(i+1), Name: rule.name, Segments: []xliffSegment{seg}})
+			}
+			nsGroup.Groups = append(nsGroup.Groups, tidGroup)
+		}
+		file.Groups = append(file.Groups, nsGroup)
+	}
+	doc.Files = []xliffFile{file}
+
+	//Write the document
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// ImportXLIFF reads an XLIFF 2.0 document created by ExportXLIFF() and returns the target language text file in the canonical layout of the file type (see Format()). It also returns the target language identifier.
+//
+// Units without a target (or with an empty target in the “initial” state) are left out, so they use the fallback language. Translation IDs and namespaces without any translated units are also left out. If the document has no Settings note, the Settings object only has the language identifier.
+//
+// Inline markup (like <ph>) in targets is not supported. TOML, i18next JSON, and custom format files cannot be written.
+func (lf LanguageTextFile) ImportXLIFF(r io.Reader) (fileText []byte, languageIdentifier string, err error) {
+	//Read the document
+	var doc xliffDoc
+	if !lf.CanBeWritten() {
+		return nil, "", errors.New("TOML, i18next JSON, and custom format files cannot be written")
+	} else if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, "", errors.New("Error parsing XLIFF file: " + err.Error())
+	} else if doc.Version != xliffVersion {
+		return nil, "", fmt.Errorf("XLIFF version must be %s", xliffVersion)
+	} else if len(doc.TrgLang) == 0 {
+		return nil, "", errors.New("XLIFF file is missing its target language (trgLang)")
+	}
+
+	//Create the Settings object from the settings note
+	settings := yamlMapSlice{{Key: "LanguageIdentifier", Value: doc.TrgLang}}
+	for _, file := range doc.Files {
+		for _, note := range xliffGetNotes(file.Notes, xliffNoteSettings) {
+			var obj tpMap
+			if item, err := LF_JSON.readTopItem(strings.NewReader(note.Text)); err != nil {
+				return nil, "", fmt.Errorf("Settings note: %s", err.Error())
+			} else if _obj, ok := item.getObject(); !ok {
+				return nil, "", errors.New("Settings note: Must be an object")
+			} else {
+				obj = _obj
+			}
+			if langIdent, err := getSetting(obj, "LanguageIdentifier"); err != nil {
+				return nil, "", fmt.Errorf("Settings note: %s", err.Error())
+			} else if langIdent != doc.TrgLang {
+				return nil, "", fmt.Errorf("Settings note: Language identifier “%s” does not match “%s”", langIdent, doc.TrgLang)
+			}
+			settings = toYamlMapSlice(obj)
+		}
+	}
+	top := yamlMapSlice{{Key: "Settings", Value: settings}}
+
+	//Add the namespaces
+	for _, file := range doc.Files {
+		for _, nsGroup := range file.Groups {
+			nsName := cond(len(nsGroup.Name) != 0, nsGroup.Name, nsGroup.ID)
+			var nsMS yamlMapSlice
+			for _, tidGroup := range nsGroup.Groups {
+				tidName := cond(len(tidGroup.Name) != 0, tidGroup.Name, tidGroup.ID[strings.LastIndexByte(tidGroup.ID, '.')+1:])
+				path := nsName + "." + tidName
+
+				//Get the translated rules
+				var rules yamlMapSlice
+				for _, unit := range tidGroup.Units {
+					if text, ok, err := xliffUnitTarget(unit); err != nil {
+						return nil, "", fmt.Errorf("%s: %s", path, err.Error())
+					} else if ok {
+						rules = append(rules, yaml.MapItem{Key: cond(len(unit.Name) != 0, unit.Name, "^"), Value: text})
+					}
+				}
+				if len(rules) == 0 {
+					continue
+				}
+
+				//Add the Translation ID. A single ^ rule without variables is stored as a string
+				variables := xliffGetNotes(tidGroup.Notes, xliffNoteVariable)
+				if len(rules) == 1 && rules[0].Key == "^" && len(variables) == 0 {
+					nsMS = append(nsMS, yaml.MapItem{Key: tidName, Value: rules[0].Value})
+					continue
+				}
+				for _, v := range variables {
+					if len(v.ID) == 0 {
+						return nil, "", fmt.Errorf("%s: Variable notes must have an ID", path)
+					}
+					rules = append(rules, yaml.MapItem{Key: v.ID, Value: v.Text})
+				}
+				nsMS = append(nsMS, yaml.MapItem{Key: tidName, Value: rules})
+			}
+			if len(nsMS) != 0 {
+				top = append(top, yaml.MapItem{Key: nsName, Value: nsMS})
+			}
+		}
+	}
+
+	//Write the file
+	if fileText, err = lf.formatTopItem(yamlItem{Key: "TOP", Value: top}); err != nil {
+		return nil, "", err
+	}
+	return fileText, doc.TrgLang, nil
+}
+
+// Returns the notes of the given category
+func xliffGetNotes(notes *xliffNotes, category string) (ret []xliffNote) {
+	if notes == nil {
+		return nil
+	}
+	for _, n := range notes.Notes {
+		if n.Category == category {
+			ret = append(ret, n)
+		}
+	}
+	return
+}
+
+// Returns the target text of a unit (its segments joined), and if the unit is translated
+func xliffUnitTarget(unit xliffUnit) (string, bool, error) {
+	var sb strings.Builder
+	for _, seg := range unit.Segments {
+		if seg.Target == nil || (len(seg.Target.Text) == 0 && (len(seg.State) == 0 || seg.State == xliffStateInitial)) {
+			return "", false, nil
+		} else if len(seg.Target.Inline) != 0 {
+			return "", false, fmt.Errorf("Unit “%s” has unsupported inline markup <%s>", unit.ID, seg.Target.Inline[0].XMLName.Local)
+		}
+		sb.WriteString(seg.Target.Text)
+	}
+	return sb.String(), len(unit.Segments) != 0, nil
+}
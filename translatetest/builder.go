@@ -0,0 +1,249 @@
+//Build languages in memory without translation files
+//go:build !gol10n_read_compiled_only
+
+package translatetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"strings"
+)
+
+// Builder creates a set of languages in memory. The first language is the default language.
+//
+// Each Build() creates its languages in their own translate.Dictionary, so it does not touch the package level dictionary and can be called concurrently.
+type Builder struct {
+	languages []*LanguageBuilder
+}
+
+// LanguageBuilder holds the settings and namespaces of a language
+type LanguageBuilder struct {
+	settings   []Prop
+	namespaces []*NamespaceBuilder
+}
+
+// NamespaceBuilder holds the Translation IDs of a namespace
+type NamespaceBuilder struct {
+	name           string
+	translationIDs []translationIDBuilder
+}
+
+type translationIDBuilder struct {
+	name  string
+	props []Prop
+}
+
+// Prop is a property under a Translation ID (or a setting). It is either a plurality rule and its translation, or a variable name and its type.
+type Prop struct {
+	Key, Value string
+}
+
+// Text returns the “^” (default) plurality rule with the given translation
+func Text(translation string) Prop {
+	return Prop{"^", translation}
+}
+
+// Rule returns a plurality rule with the given translation
+func Rule(rule, translation string) Prop {
+	return Prop{rule, translation}
+}
+
+// Var returns a variable with the given name and type (see the Variable Names section of the translation file documentation)
+func Var(name, varType string) Prop {
+	return Prop{name, varType}
+}
+
+// NewBuilder creates a new Builder with the default language
+func NewBuilder(defaultLanguageIdentifier string) *Builder {
+	b := &Builder{}
+	b.Language(defaultLanguageIdentifier)
+	return b
+}
+
+// Default returns the default language
+func (b *Builder) Default() *LanguageBuilder {
+	return b.languages[0]
+}
+
+// Language returns the language with the given identifier, creating it if it does not exist
+func (b *Builder) Language(languageIdentifier string) *LanguageBuilder {
+	for _, lb := range b.languages {
+		if lb.identifier() == languageIdentifier {
+			return lb
+		}
+	}
+
+	lb := &LanguageBuilder{settings: []Prop{
+		{"LanguageName", languageIdentifier},
+		{"LanguageIdentifier", languageIdentifier},
+		{"MissingPluralRule", "MISSING_PLURAL_RULE"},
+	}}
+	b.languages = append(b.languages, lb)
+	return lb
+}
+
+func (lb *LanguageBuilder) identifier() string {
+	return lb.getSetting("LanguageIdentifier")
+}
+func (lb *LanguageBuilder) getSetting(name string) string {
+	for _, s := range lb.settings {
+		if s.Key == name {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// Setting sets a value in the language’s Settings section (like LanguageName, MissingPluralRule, or FallbackLanguage)
+func (lb *LanguageBuilder) Setting(name, value string) *LanguageBuilder {
+	for i, s := range lb.settings {
+		if s.Key == name {
+			lb.settings[i].Value = value
+			return lb
+		}
+	}
+	lb.settings = append(lb.settings, Prop{name, value})
+	return lb
+}
+
+// Namespace returns the namespace with the given name, creating it if it does not exist
+func (lb *LanguageBuilder) Namespace(name string) *NamespaceBuilder {
+	for _, nb := range lb.namespaces {
+		if nb.name == name {
+			return nb
+		}
+	}
+
+	nb := &NamespaceBuilder{name: name}
+	lb.namespaces = append(lb.namespaces, nb)
+	return nb
+}
+
+// Add adds a Translation ID with its properties (see Text, Rule, and Var). Properties are kept in the given order
+func (nb *NamespaceBuilder) Add(translationID string, props ...Prop) *NamespaceBuilder {
+	nb.translationIDs = append(nb.translationIDs, translationIDBuilder{translationID, props})
+	return nb
+}
+
+// JSON returns the language as a JSON translation text file
+func (lb *LanguageBuilder) JSON() []byte {
+	var buf bytes.Buffer
+	writeStr := func(s string) {
+		b, _ := json.Marshal(s)
+		buf.Write(b)
+	}
+	writeProps := func(props []Prop) {
+		buf.WriteByte('{')
+		for i, p := range props {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			writeStr(p.Key)
+			buf.WriteByte(':')
+			writeStr(p.Value)
+		}
+		buf.WriteByte('}')
+	}
+
+	buf.WriteString(`{"Settings":`)
+	writeProps(lb.settings)
+	for _, nb := range lb.namespaces {
+		buf.WriteByte(',')
+		writeStr(nb.name)
+		buf.WriteString(":{")
+		for i, tid := range nb.translationIDs {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			writeStr(tid.name)
+			buf.WriteByte(':')
+			writeProps(tid.props)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes()
+}
+
+// Build loads all the languages and assigns their fallbacks. The returned map is keyed by language identifier. Warnings are returned prefixed by their language identifier.
+func (b *Builder) Build() (languages map[string]*translate.Language, warnings []string, err error) {
+	//Load the languages into their own dictionary
+	dict := translate.NewDictionary()
+	languages = make(map[string]*translate.Language, len(b.languages))
+	var errs []string
+	for i, lb := range b.languages {
+		var l *translate.Language
+		var warn []string
+		var err error
+		if i == 0 {
+			l, warn, err = dict.LoadDefaultText(translate.LF_JSON, bytes.NewReader(lb.JSON()), false)
+		} else {
+			l, warn, err = dict.LoadText(translate.LF_JSON, bytes.NewReader(lb.JSON()), false)
+		}
+		for _, w := range warn {
+			warnings = append(warnings, lb.identifier()+": "+w)
+		}
+		if err != nil {
+			//If the default language fails, nothing else can be loaded
+			if i == 0 {
+				return nil, warnings, fmt.Errorf("%s: %w", lb.identifier(), err)
+			}
+			errs = append(errs, lb.identifier()+": "+err.Error())
+			continue
+		}
+		languages[lb.identifier()] = l
+	}
+	if len(errs) != 0 {
+		return nil, warnings, errors.New(strings.Join(errs, "\n"))
+	}
+
+	//Assign fallbacks. A language can only be assigned once its fallback has been assigned
+	defaultLang := languages[b.languages[0].identifier()]
+	for assigned, numAssigned := map[string]bool{defaultLang.LanguageIdentifier(): true}, 1; numAssigned < len(languages); {
+		numAssignedBefore := numAssigned
+		for ident, l := range languages {
+			fallbackIdent := cond(len(l.FallbackName()) == 0, defaultLang.LanguageIdentifier(), l.FallbackName())
+			if assigned[ident] || !assigned[fallbackIdent] {
+				continue
+			}
+			if err := l.SetFallback(languages[fallbackIdent]); err != nil {
+				return nil, warnings, fmt.Errorf("%s: %w", ident, err)
+			}
+			assigned[ident] = true
+			numAssigned++
+		}
+		if numAssigned == numAssignedBefore {
+			return nil, warnings, errors.New("Fallback languages are missing or have a loop")
+		}
+	}
+
+	//Check the variables used through fallback languages, and the embedded translations
+	for _, lb := range b.languages {
+		lang := languages[lb.identifier()]
+		for _, w := range append(lang.CheckFallbackVariables(), lang.CheckEmbeddedTranslations()...) {
+			warnings = append(warnings, lb.identifier()+": "+w)
+		}
+	}
+
+	return languages, warnings, nil
+}
+
+// MustBuild calls Build and panics on error. Warnings are ignored
+func (b *Builder) MustBuild() map[string]*translate.Language {
+	languages, _, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return languages
+}
+
+func cond[T any](isTrue bool, ifTrue, ifFalse T) T {
+	if isTrue {
+		return ifTrue
+	}
+	return ifFalse
+}
@@ -0,0 +1,140 @@
+//A fake Translator that records lookups
+//go:build !gol10n_read_compiled_only
+
+package translatetest
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Lookup is a recorded call to a FakeLanguage
+type Lookup struct {
+	Index         translate.TransIndex //Only set for non-named lookups
+	Namespace     string               //Only set for named lookups
+	TranslationID string               //Only set for named lookups
+	IsPlural      bool
+	PluralCount   uint
+	IsMust        bool
+	Args          []interface{}
+}
+
+// String returns the lookup in the format “Namespace.TranslationID[#PluralCount](Args)” or “#Index[#PluralCount](Args)”
+func (lu Lookup) String() string {
+	var sb strings.Builder
+	if len(lu.Namespace) != 0 || len(lu.TranslationID) != 0 {
+		sb.WriteString(lu.Namespace + "." + lu.TranslationID)
+	} else {
+		sb.WriteString(fmt.Sprintf("#%d", lu.Index))
+	}
+	if lu.IsPlural {
+		sb.WriteString(fmt.Sprintf("#%d", lu.PluralCount))
+	}
+	sb.WriteByte('(')
+	for i, arg := range lu.Args {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprint(arg))
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// FakeLanguage is a Translator that records all lookups. It is safe for concurrent use.
+type FakeLanguage struct {
+	//Respond returns the result of a lookup. If nil, the Lookup’s String() is returned.
+	//Must functions return an empty string on error, like translate.Language.
+	//AppendGet and FGet functions append or write the returned string, and nothing on error.
+	Respond func(lu Lookup) (string, error)
+
+	mutex   sync.Mutex
+	lookups []Lookup
+}
+
+// Lookups returns a copy of all recorded lookups
+func (fl *FakeLanguage) Lookups() []Lookup {
+	fl.mutex.Lock()
+	defer fl.mutex.Unlock()
+	return append([]Lookup(nil), fl.lookups...)
+}
+
+// Reset erases all recorded lookups
+func (fl *FakeLanguage) Reset() {
+	fl.mutex.Lock()
+	defer fl.mutex.Unlock()
+	fl.lookups = nil
+}
+
+func (fl *FakeLanguage) record(lu Lookup) (string, error) {
+	fl.mutex.Lock()
+	fl.lookups = append(fl.lookups, lu)
+	fl.mutex.Unlock()
+
+	if fl.Respond == nil {
+		return lu.String(), nil
+	}
+	return fl.Respond(lu)
+}
+func (fl *FakeLanguage) recordAppend(dst []byte, lu Lookup) ([]byte, error) {
+	str, err := fl.record(lu)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, str...), nil
+}
+func (fl *FakeLanguage) recordWrite(w io.Writer, lu Lookup) (int, error) {
+	str, err := fl.record(lu)
+	if err != nil {
+		return 0, err
+	}
+	return io.WriteString(w, str)
+}
+func (fl *FakeLanguage) recordMust(lu Lookup) string {
+	lu.IsMust = true
+	str, err := fl.record(lu)
+	if err != nil {
+		return ""
+	}
+	return str
+}
+
+func (fl *FakeLanguage) Get(index translate.TransIndex, args ...interface{}) (string, error) {
+	return fl.record(Lookup{Index: index, Args: args})
+}
+func (fl *FakeLanguage) GetPlural(index translate.TransIndex, pluralCount uint, args ...interface{}) (string, error) {
+	return fl.record(Lookup{Index: index, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
+func (fl *FakeLanguage) MustGet(index translate.TransIndex, args ...interface{}) string {
+	return fl.recordMust(Lookup{Index: index, Args: args})
+}
+func (fl *FakeLanguage) MustGetPlural(index translate.TransIndex, pluralCount uint, args ...interface{}) string {
+	return fl.recordMust(Lookup{Index: index, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
+func (fl *FakeLanguage) GetNamed(namespace, translationID string, args ...interface{}) (string, error) {
+	return fl.record(Lookup{Namespace: namespace, TranslationID: translationID, Args: args})
+}
+func (fl *FakeLanguage) GetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) (string, error) {
+	return fl.record(Lookup{Namespace: namespace, TranslationID: translationID, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
+func (fl *FakeLanguage) MustGetNamed(namespace string, translationID string, args ...interface{}) string {
+	return fl.recordMust(Lookup{Namespace: namespace, TranslationID: translationID, Args: args})
+}
+func (fl *FakeLanguage) MustGetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) string {
+	return fl.recordMust(Lookup{Namespace: namespace, TranslationID: translationID, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
+func (fl *FakeLanguage) AppendGet(dst []byte, index translate.TransIndex, args ...interface{}) ([]byte, error) {
+	return fl.recordAppend(dst, Lookup{Index: index, Args: args})
+}
+func (fl *FakeLanguage) AppendGetPlural(dst []byte, index translate.TransIndex, pluralCount uint, args ...interface{}) ([]byte, error) {
+	return fl.recordAppend(dst, Lookup{Index: index, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
+func (fl *FakeLanguage) FGet(w io.Writer, index translate.TransIndex, args ...interface{}) (int, error) {
+	return fl.recordWrite(w, Lookup{Index: index, Args: args})
+}
+func (fl *FakeLanguage) FGetPlural(w io.Writer, index translate.TransIndex, pluralCount uint, args ...interface{}) (int, error) {
+	return fl.recordWrite(w, Lookup{Index: index, IsPlural: true, PluralCount: pluralCount, Args: args})
+}
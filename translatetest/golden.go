@@ -0,0 +1,94 @@
+//Golden file assertions for Get() output
+//go:build !gol10n_read_compiled_only
+
+package translatetest
+
+import (
+	"fmt"
+	"github.com/dakusan/gol10n/translate"
+	"os"
+	"strings"
+	"testing"
+)
+
+// UpdateGoldenEnv is the environment variable that, when not blank, makes AssertGolden (re)write golden files instead of comparing against them
+const UpdateGoldenEnv = "GOL10N_UPDATE_GOLDEN"
+
+// GoldenCase is a translation lookup to run against every language
+type GoldenCase struct {
+	Namespace     string
+	TranslationID string
+	IsPlural      bool
+	PluralCount   uint
+	Args          []interface{}
+}
+
+// String returns the case in the format Namespace.TranslationID[#PluralCount]
+func (gc GoldenCase) String() string {
+	if gc.IsPlural {
+		return fmt.Sprintf("%s.%s#%d", gc.Namespace, gc.TranslationID, gc.PluralCount)
+	}
+	return gc.Namespace + "." + gc.TranslationID
+}
+
+// GoldenOutput returns the output of all cases for all languages, one line per case and language, in the format “LanguageIdentifier<TAB>Case<TAB>Result”. Errors are output as “ERROR: $Error”
+func GoldenOutput(languages []*translate.Language, cases ...GoldenCase) string {
+	var sb strings.Builder
+	for _, l := range languages {
+		for _, gc := range cases {
+			var str string
+			var err error
+			if gc.IsPlural {
+				str, err = l.GetPluralNamed(gc.Namespace, gc.TranslationID, gc.PluralCount, gc.Args...)
+			} else {
+				str, err = l.GetNamed(gc.Namespace, gc.TranslationID, gc.Args...)
+			}
+			if err != nil {
+				str = "ERROR: " + err.Error()
+			}
+			sb.WriteString(l.LanguageIdentifier() + "\t" + gc.String() + "\t" + strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\t", "\\t").Replace(str) + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// AssertGolden compares the GoldenOutput of the languages and cases against the golden file. If the UpdateGoldenEnv environment variable is set, the golden file is written instead.
+func AssertGolden(t testing.TB, goldenFilePath string, languages []*translate.Language, cases ...GoldenCase) {
+	t.Helper()
+	output := GoldenOutput(languages, cases...)
+
+	//Update the golden file
+	if len(os.Getenv(UpdateGoldenEnv)) != 0 {
+		if err := os.WriteFile(goldenFilePath, []byte(output), 0644); err != nil {
+			t.Fatalf("Could not write golden file “%s”: %s", goldenFilePath, err.Error())
+		}
+		return
+	}
+
+	//Read the golden file
+	var expected string
+	if b, err := os.ReadFile(goldenFilePath); err != nil {
+		t.Fatalf("Could not read golden file “%s” (set %s=1 to create it): %s", goldenFilePath, UpdateGoldenEnv, err.Error())
+		return
+	} else {
+		expected = string(b)
+	}
+
+	//Report the differing lines
+	if output == expected {
+		return
+	}
+	outputLines, expectedLines := strings.Split(output, "\n"), strings.Split(expected, "\n")
+	for i := 0; i < len(outputLines) || i < len(expectedLines); i++ {
+		var outputLine, expectedLine string
+		if i < len(outputLines) {
+			outputLine = outputLines[i]
+		}
+		if i < len(expectedLines) {
+			expectedLine = expectedLines[i]
+		}
+		if outputLine != expectedLine {
+			t.Errorf("Golden file “%s” line %d mismatch:\n\twant: %s\n\t got: %s", goldenFilePath, i+1, expectedLine, outputLine)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+//Package documentation and the Translator interface
+//go:build !gol10n_read_compiled_only
+
+/*
+Package translatetest contains helpers for testing code that uses the translate package.
+
+  - Builder creates languages in memory from Go code, so tests do not need fixture translation text files or compiled files.
+  - AssertGolden compares Get() output across languages against a golden file.
+  - FakeLanguage is a Translator that records all lookups made against it.
+*/
+package translatetest
+
+import (
+	"github.com/dakusan/gol10n/translate"
+	"io"
+)
+
+// Translator is the set of translation functions on translate.Language. Code that accepts a Translator instead of a *translate.Language can be given a FakeLanguage in tests.
+type Translator interface {
+	Get(index translate.TransIndex, args ...interface{}) (string, error)
+	GetPlural(index translate.TransIndex, pluralCount uint, args ...interface{}) (string, error)
+	MustGet(index translate.TransIndex, args ...interface{}) string
+	MustGetPlural(index translate.TransIndex, pluralCount uint, args ...interface{}) string
+	GetNamed(namespace, translationID string, args ...interface{}) (string, error)
+	GetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) (string, error)
+	MustGetNamed(namespace string, translationID string, args ...interface{}) string
+	MustGetPluralNamed(namespace string, translationID string, pluralCount uint, args ...interface{}) string
+	AppendGet(dst []byte, index translate.TransIndex, args ...interface{}) ([]byte, error)
+	AppendGetPlural(dst []byte, index translate.TransIndex, pluralCount uint, args ...interface{}) ([]byte, error)
+	FGet(w io.Writer, index translate.TransIndex, args ...interface{}) (int, error)
+	FGetPlural(w io.Writer, index translate.TransIndex, pluralCount uint, args ...interface{}) (int, error)
+}
+
+// Make sure the real and fake languages implement Translator
+var (
+	_ Translator = (*translate.Language)(nil)
+	_ Translator = (*FakeLanguage)(nil)
+)
@@ -5,6 +5,7 @@
 package watch
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/dakusan/gol10n/execute"
@@ -21,8 +22,8 @@ import (
 // ReturnData is the data that is returned through a channel from watch.Execute when it processes files
 type ReturnData struct {
 	Type    ReturnType
-	Files   execute.ProcessedFileList //Only on ReturnType=WR_ProcessedDirectory
-	Err     error                     //Only on ReturnType=WR_ProcessedDirectory or WR_ProcessedFile or WR_ErroredOut
+	Files   execute.ProcessedFileList //Only on ReturnType=WR_ProcessedDirectory or WR_Summary
+	Err     error                     //Only on ReturnType=WR_ProcessedDirectory or WR_Summary or WR_ProcessedFile or WR_ErroredOut, or WR_CloseRequested when the context was cancelled
 	Message string                    //Only on ReturnType=WR_Message or WR_ProcessedFile
 }
 
@@ -34,24 +35,36 @@ const (
 	WR_ProcessedDirectory                   //Directory() was called due to initialization or default language update
 	WR_ProcessedFile                        //A single file was updated. Message contains the filename. Error is filled on error.
 	WR_ErroredOut                           //The watch could not be started or has closed
-	WR_CloseRequested                       //Process close was requested
+	WR_CloseRequested                       //Process close was requested, or the context was cancelled (Err holds the context’s error)
+	WR_Summary                              //Single files were updated and no more changes occurred for SummaryQuietPeriod. Directory() was called so Files holds the state of all the languages
 )
 
+// SummaryQuietPeriod is how long no files must change after single files are updated before a WR_Summary is sent
+const SummaryQuietPeriod = time.Second * 2
+
 // Execute processes all files in the InputPath directory.
 //
 // It continually watches the directory for relevant changes in its own goroutine, and only processes and updates the necessary files when a change is detected.
-func Execute(settings *execute.ProcessSettings) <-chan ReturnData {
+//
+// The watch stops (with a WR_CloseRequested) when the context is cancelled. Processing that is running is then stopped through execute.ProcessSettings.DirectoryCtx().
+func Execute(ctx context.Context, settings *execute.ProcessSettings) <-chan ReturnData {
 	ret := make(chan ReturnData, 10)
-	go execWatchReal(settings, ret)
+	go execWatchReal(ctx, settings, ret)
 	return ret
 }
 
-func execWatchReal(settings *execute.ProcessSettings, ret chan<- ReturnData) {
+func execWatchReal(ctx context.Context, settings *execute.ProcessSettings, ret chan<- ReturnData) {
 	//Send a message ReturnData
 	sendMessage := func(message string) {
 		ret <- ReturnData{WR_Message, nil, nil, message}
 	}
 
+	//Only the OS can be watched
+	if settings.FS != nil {
+		ret <- ReturnData{WR_ErroredOut, nil, errors.New("Cannot watch a ProcessSettings.FS"), ""}
+		return
+	}
+
 	//Create the watcher
 	var watcher *fsnotify.Watcher
 	if _watcher, err := fsnotify.NewWatcher(); err != nil {
@@ -68,7 +81,7 @@ func execWatchReal(settings *execute.ProcessSettings, ret chan<- ReturnData) {
 
 	//Execute the primary Directory() function first before we start watching
 	{
-		langs, err := settings.Directory()
+		langs, err := settings.DirectoryCtx(ctx)
 		ret <- ReturnData{WR_ProcessedDirectory, langs, err, ""}
 	}
 
@@ -78,6 +91,20 @@ func execWatchReal(settings *execute.ProcessSettings, ret chan<- ReturnData) {
 	recentWatches := make(map[string]*bool) //If the bool pointer is set to true then the event is cancelled
 	var recentWatchesMutex sync.RWMutex
 
+	//Files are processed one at a time. After single files are updated, a summary of all the languages is sent once the changes settle
+	var processMutex sync.Mutex
+	summaryTimer := time.AfterFunc(SummaryQuietPeriod, func() {
+		processMutex.Lock()
+		defer processMutex.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		langs, err := settings.DirectoryCtx(ctx)
+		ret <- ReturnData{WR_Summary, langs, err, ""}
+	})
+	summaryTimer.Stop()
+	defer summaryTimer.Stop()
+
 	//Handle os shutdown signal
 	shutdownSignal := make(chan os.Signal, 1)
 	signal.Notify(shutdownSignal, os.Interrupt)
@@ -111,7 +138,7 @@ func execWatchReal(settings *execute.ProcessSettings, ret chan<- ReturnData) {
 				continue
 			} else if dotLoc := strings.LastIndexByte(fName, '.'); dotLoc == -1 {
 				continue
-			} else if ext := fName[dotLoc+1:]; ext != execute.YAML_Extension && ext != execute.JSON_Extension {
+			} else if ext := fName[dotLoc+1:]; !execute.IsTextFileExtension(ext) {
 				continue
 			} else {
 				langIdent = fName[0:dotLoc]
@@ -145,27 +172,40 @@ func execWatchReal(settings *execute.ProcessSettings, ret chan<- ReturnData) {
 				delete(recentWatches, eventKey)
 				recentWatchesMutex.Unlock()
 
-				//Send message about change and process the file
+				//Send message about change and process the file. Changes restart the wait for the summary
+				summaryTimer.Stop()
+				processMutex.Lock()
+				defer processMutex.Unlock()
+				if ctx.Err() != nil {
+					return
+				}
 				sendMessage(fmt.Sprintf("%s: Change (%s) occurred on “%s”", time.Now().Format("2006-01-02 15:04:05"), event.Op.String(), fName))
-				processFile(langIdent, fName, settings, ret)
+				if !processFile(ctx, langIdent, fName, settings, ret) {
+					summaryTimer.Reset(SummaryQuietPeriod)
+				}
 			}()
 		case <-shutdownSignal:
 			ret <- ReturnData{WR_CloseRequested, nil, nil, ""}
 			return
+		case <-ctx.Done():
+			ret <- ReturnData{WR_CloseRequested, nil, ctx.Err(), ""}
+			return
 		}
 	}
 }
 
-func processFile(langIdent, fName string, settings *execute.ProcessSettings, ret chan<- ReturnData) {
+// Returns if the full directory was processed
+func processFile(ctx context.Context, langIdent, fName string, settings *execute.ProcessSettings, ret chan<- ReturnData) bool {
 	//If this is the default language then clear the dictionary and run a full Directory() call
 	if langIdent == settings.DefaultLanguage {
 		translate.LanguageFile(translate.LF_YAML).ClearCurrentDictionary()
-		langs, err := settings.Directory()
+		langs, err := settings.DirectoryCtx(ctx)
 		ret <- ReturnData{WR_ProcessedDirectory, langs, err, ""}
-		return
+		return true
 	}
 
 	//Process the file normally
 	//While this could cause a problem if there were multiple text files with the same language identifier, I don't think that’s an edge case I really need to worry about right here
 	ret <- ReturnData{WR_ProcessedFile, nil, settings.FileCompileOnly(langIdent), fName}
+	return false
 }